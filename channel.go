@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/frame"
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
@@ -18,17 +19,18 @@ const (
 )
 
 type datagramReader struct {
-	r   io.ReadCloser
-	buf []byte
-	pos int
+	r       io.ReadCloser
+	bufSize int
+	buf     []byte
+	pos     int
 }
 
 func (r *datagramReader) ReadPacket() error {
 	if r.buf == nil {
-		r.buf = make([]byte, datagramReadBufferSize)
+		r.buf = make([]byte, r.bufSize)
 	}
 
-	n, err := r.r.Read(r.buf[:datagramReadBufferSize])
+	n, err := r.r.Read(r.buf[:r.bufSize])
 	if n == 0 && err != nil {
 		return err
 	}
@@ -48,6 +50,13 @@ func (r *datagramReader) Read(p []byte) (n int, err error) {
 	return n, nil
 }
 
+func (r *datagramReader) remaining() int {
+	return len(r.buf) - r.pos
+}
+
+// frameBytes is an encoded frame.
+type frameBytes []byte
+
 type readWriter struct {
 	io.Reader
 	io.Writer
@@ -64,13 +73,15 @@ func randomByte() (byte, error) {
 // For instance, a TCP client endpoint creates a single channel, while a TCP
 // server endpoint creates a channel for each incoming connection.
 type Channel struct {
-	node       *Node
-	endpoint   Endpoint
-	label      string
-	rwc        io.ReadWriteCloser
-	isDatagram bool
+	node          *Node
+	endpoint      Endpoint
+	label         string
+	rwc           io.ReadWriteCloser
+	isDatagram    bool
+	writeBatching *WriteBatching
 
 	datagramReader  *datagramReader
+	batchWriter     *batchWriter
 	ctx             context.Context
 	ctxCancel       func()
 	frameReadWriter *frame.ReadWriter
@@ -79,6 +90,7 @@ type Channel struct {
 
 	// in
 	chWrite chan any
+	chFlush chan struct{}
 
 	// out
 	done chan struct{}
@@ -90,21 +102,39 @@ func (ch *Channel) initialize() error {
 		return err
 	}
 
+	var w io.Writer = ch.rwc
+	if ch.writeBatching != nil {
+		ch.batchWriter = &batchWriter{
+			WriteBatching: *ch.writeBatching,
+			w:             ch.rwc,
+		}
+		ch.batchWriter.initialize()
+		w = ch.batchWriter
+	}
+
 	var rw io.ReadWriter
 	if ch.isDatagram {
-		ch.datagramReader = &datagramReader{r: ch.rwc}
+		ch.datagramReader = &datagramReader{
+			r:       ch.rwc,
+			bufSize: ch.node.DatagramReadBufferSize,
+		}
 		rw = &readWriter{
 			Reader: ch.datagramReader,
-			Writer: ch.rwc,
+			Writer: w,
 		}
 	} else {
-		rw = ch.rwc
+		rw = &readWriter{
+			Reader: ch.rwc,
+			Writer: w,
+		}
 	}
 
 	ch.frameReadWriter = &frame.ReadWriter{
-		ByteReadWriter: rw,
-		DialectRW:      ch.node.dialectRW,
-		InKey:          ch.node.InKey,
+		ByteReadWriter:      rw,
+		DialectRW:           ch.node.dialectRW,
+		MessageTable:        ch.node.MessageTable,
+		DropUnknownMessages: ch.node.DropUnknownMessages,
+		InKey:               ch.node.InKey,
 	}
 	err = ch.frameReadWriter.Initialize()
 	if err != nil {
@@ -131,6 +161,9 @@ func (ch *Channel) initialize() error {
 
 	ch.ctx, ch.ctxCancel = context.WithCancel(context.Background())
 	ch.chWrite = make(chan any, writeBufferSize)
+	if ch.batchWriter != nil {
+		ch.chFlush = make(chan struct{}, 1)
+	}
 	ch.done = make(chan struct{})
 
 	return nil
@@ -199,71 +232,153 @@ func (ch *Channel) runReader() error {
 	// and allow clients to write messages before starting listening to events
 	ch.node.pushEvent(&EventChannelOpen{ch})
 
+	if ch.isDatagram {
+		for {
+			err := ch.readDatagram()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	for {
+		fr, err := ch.frameReadWriter.Read()
+		if err != nil {
+			var eerr frame.ReadError
+			if errors.As(err, &eerr) {
+				ch.node.pushEvent(&EventParseError{err, ch})
+				continue
+			}
+			return err
+		}
+
+		ch.processFrame(fr)
+	}
+}
+
+// readDatagram reads a datagram and decodes all the frames it contains.
+// Some implementations pack multiple frames into a single datagram.
+func (ch *Channel) readDatagram() error {
+	err := ch.datagramReader.ReadPacket()
+	if err != nil {
+		return err
+	}
+
+	skipped := 0
+
+	for ch.datagramReader.remaining() > 0 || ch.frameReadWriter.BufByteReader.Buffered() > 0 {
+		var byts []byte
+		byts, err = ch.frameReadWriter.BufByteReader.Peek(1)
+		if err != nil {
+			break
+		}
+
+		// skip bytes until the beginning of a frame
+		if byts[0] != frame.V1MagicByte && byts[0] != frame.V2MagicByte {
+			ch.frameReadWriter.BufByteReader.Discard(1) //nolint:errcheck
+			skipped++
+			continue
+		}
+
+		if skipped > 0 {
+			ch.node.pushEvent(&EventParseError{fmt.Errorf("skipped %d bytes", skipped), ch})
+			skipped = 0
+		}
+
 		var fr frame.Frame
+		fr, err = ch.frameReadWriter.Read()
+		if err != nil {
+			ch.node.pushEvent(&EventParseError{err, ch})
+			continue
+		}
+
+		ch.processFrame(fr)
+	}
+
+	if skipped > 0 {
+		ch.node.pushEvent(&EventParseError{fmt.Errorf("skipped %d bytes", skipped), ch})
+	}
 
-		if ch.isDatagram {
-			skipped := len(ch.datagramReader.buf) - ch.datagramReader.pos
-			err := ch.datagramReader.ReadPacket()
+	return nil
+}
+
+func (ch *Channel) processFrame(fr frame.Frame) {
+	evt := &EventFrame{fr, ch}
+
+	if ch.node.nodeStreamRequest != nil {
+		ch.node.nodeStreamRequest.onEventFrame(evt)
+	}
+
+	ch.node.pushEvent(evt)
+}
+
+func (ch *Channel) runWriter(writerTerminate chan struct{}) error {
+	var batchTimer <-chan time.Time
+	if ch.batchWriter != nil {
+		batchTimer = ch.batchWriter.timer.C
+	}
+
+	for {
+		select {
+		case what := <-ch.chWrite:
+			err := ch.writeItem(what)
 			if err != nil {
 				return err
 			}
 
-			n := ch.frameReadWriter.BufByteReader.Buffered()
-			skipped += n
-			ch.frameReadWriter.BufByteReader.Discard(n) //nolint:errcheck
-
-			if skipped > 0 {
-				ch.node.pushEvent(&EventParseError{fmt.Errorf("skipped %d bytes", skipped), ch})
+		case <-ch.chFlush:
+			// write frames that were queued before the flush request
+			err := ch.writeQueued()
+			if err != nil {
+				return err
 			}
 
-			fr, err = ch.frameReadWriter.Read()
+			err = ch.batchWriter.flush()
 			if err != nil {
-				ch.node.pushEvent(&EventParseError{err, ch})
-				continue
+				return err
 			}
-		} else {
-			var err error
-			fr, err = ch.frameReadWriter.Read()
+
+		case <-batchTimer:
+			err := ch.batchWriter.flush()
 			if err != nil {
-				var eerr frame.ReadError
-				if errors.As(err, &eerr) {
-					ch.node.pushEvent(&EventParseError{err, ch})
-					continue
-				}
 				return err
 			}
+
+		case <-writerTerminate:
+			if ch.batchWriter != nil {
+				ch.batchWriter.flush() //nolint:errcheck
+			}
+			return nil
 		}
+	}
+}
 
-		evt := &EventFrame{fr, ch}
+func (ch *Channel) writeItem(what any) error {
+	switch wh := what.(type) {
+	case message.Message:
+		return ch.streamWriter.Write(wh)
 
-		if ch.node.nodeStreamRequest != nil {
-			ch.node.nodeStreamRequest.onEventFrame(evt)
-		}
+	case *streamwriter.SharedMessage:
+		return ch.streamWriter.WriteShared(wh)
 
-		ch.node.pushEvent(evt)
+	case frameBytes:
+		_, err := ch.frameReadWriter.ByteWriter.Write(wh)
+		return err
 	}
+
+	return nil
 }
 
-func (ch *Channel) runWriter(writerTerminate chan struct{}) error {
+func (ch *Channel) writeQueued() error {
 	for {
 		select {
 		case what := <-ch.chWrite:
-			switch wh := what.(type) {
-			case message.Message:
-				err := ch.streamWriter.Write(wh)
-				if err != nil {
-					return err
-				}
-
-			case frame.Frame:
-				err := ch.frameReadWriter.Write(wh)
-				if err != nil {
-					return err
-				}
+			err := ch.writeItem(what)
+			if err != nil {
+				return err
 			}
 
-		case <-writerTerminate:
+		default:
 			return nil
 		}
 	}
@@ -286,3 +401,17 @@ func (ch *Channel) write(what any) {
 	default: // buffer is full
 	}
 }
+
+// flush requests the writer to write the current batch.
+// Unlike write, it never drops the request, since a pending request
+// also covers the current one.
+func (ch *Channel) flush() {
+	if ch.chFlush == nil {
+		return
+	}
+
+	select {
+	case ch.chFlush <- struct{}{}:
+	default: // a flush is already pending
+	}
+}
@@ -40,11 +40,12 @@ func (cp *channelProvider) run() {
 		}
 
 		ch := &Channel{
-			node:       cp.node,
-			endpoint:   cp.endpoint,
-			label:      label,
-			rwc:        rwc,
-			isDatagram: cp.endpoint.isDatagram(),
+			node:          cp.node,
+			endpoint:      cp.endpoint,
+			label:         label,
+			rwc:           rwc,
+			isDatagram:    cp.endpoint.isDatagram(),
+			writeBatching: cp.endpoint.writeBatching(),
 		}
 		err = ch.initialize()
 		if err != nil {
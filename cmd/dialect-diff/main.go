@@ -0,0 +1,107 @@
+// dialect-diff command.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/bluenviron/gomavlib/v4/internal/standarddialects"
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// exit code returned when there are breaking changes.
+const exitCodeBreaking = 2
+
+var cli struct {
+	JSON bool   `help:"Print changes in JSON format"`
+	Old  string `arg:"" help:"Old dialect: path or url pointing to a XML Mavlink dialect, or name of a standard dialect"`
+	New  string `arg:"" help:"New dialect: path or url pointing to a XML Mavlink dialect, or name of a standard dialect"`
+}
+
+func isXML(addr string) bool {
+	return strings.HasSuffix(addr, ".xml")
+}
+
+func loadDialect(addr string) (*dialect.Dialect, error) {
+	if isXML(addr) {
+		return conversion.LoadDialect(addr)
+	}
+
+	d, ok := standarddialects.Dialects[addr]
+	if !ok {
+		return nil, fmt.Errorf("'%s' is neither a XML definition nor a standard dialect (%s)",
+			addr, strings.Join(standarddialects.Names(), ", "))
+	}
+
+	return d, nil
+}
+
+func diff(oldAddr string, newAddr string) ([]*conversion.DialectChange, error) {
+	oldD, err := loadDialect(oldAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	newD, err := loadDialect(newAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return conversion.DiffDialects(oldD, newD)
+}
+
+func run(args []string) (int, error) {
+	parser, err := kong.New(&cli,
+		kong.Description("Compare two Mavlink dialects and report differences. "+
+			"The exit code is 2 in case of breaking changes."),
+		kong.UsageOnError())
+	if err != nil {
+		return 1, err
+	}
+
+	_, err = parser.Parse(args)
+	if err != nil {
+		return 1, err
+	}
+
+	changes, err := diff(cli.Old, cli.New)
+	if err != nil {
+		return 1, err
+	}
+
+	if cli.JSON {
+		if changes == nil {
+			changes = []*conversion.DialectChange{}
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(changes)
+		if err != nil {
+			return 1, err
+		}
+	} else {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+	}
+
+	if conversion.HasBreakingChanges(changes) {
+		return exitCodeBreaking, nil
+	}
+
+	return 0, nil
+}
+
+func main() {
+	code, err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testOldDialect = `<?xml version="1.0"?>
+<mavlink>
+  <messages>
+    <message id="1" name="A_MESSAGE">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testNewDialect = `<?xml version="1.0"?>
+<mavlink>
+  <messages>
+    <message id="1" name="A_MESSAGE">
+      <field type="uint8_t" name="a">a</field>
+      <extensions/>
+      <field type="uint8_t" name="b">b</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestRun(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	oldPath := filepath.Join(dir, "old.xml")
+	err = os.WriteFile(oldPath, []byte(testOldDialect), 0o644)
+	require.NoError(t, err)
+
+	newPath := filepath.Join(dir, "new.xml")
+	err = os.WriteFile(newPath, []byte(testNewDialect), 0o644)
+	require.NoError(t, err)
+
+	code, err := run([]string{oldPath, newPath})
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+
+	code, err = run([]string{"--json", newPath, oldPath})
+	require.NoError(t, err)
+	require.Equal(t, exitCodeBreaking, code)
+
+	code, err = run([]string{"common", "common"})
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+
+	_, err = run([]string{"common", "notexisting"})
+	require.Error(t, err)
+}
@@ -0,0 +1,214 @@
+// dialect-export command.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/bluenviron/gomavlib/v4/internal/standarddialects"
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// program used to export dialects defined in Go packages.
+var tplProgram = template.Must(template.New("").Parse(
+	`package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+{{- range .Includes }}
+{{- if .Standard }}
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/{{ .Standard }}"
+{{- end }}
+{{- end }}
+
+	dialectpkg "{{ .Package }}"
+)
+
+func load(path string) *dialect.Dialect {
+	d, err := conversion.LoadDialect(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
+		os.Exit(1)
+	}
+	return d
+}
+
+func main() {
+	buf, err := conversion.ExportDialect(dialectpkg.Dialect, conversion.ExportOptions{
+		Includes: []string{
+{{- range .Includes }}
+			{{ printf "%q" .Path }},
+{{- end }}
+		},
+		IncludedDialects: []*dialect.Dialect{
+{{- range .Includes }}
+{{- if .Standard }}
+			{{ .Standard }}.Dialect,
+{{- else }}
+			load({{ printf "%q" .Path }}),
+{{- end }}
+{{- end }}
+		},
+		Dialect: {{ .Dialect }},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
+		os.Exit(1)
+	}
+
+	os.Stdout.Write(buf)
+}
+`))
+
+var cli struct {
+	Include []string `help:"Included definition, whose messages and enums are not exported (can be repeated)"`
+	Dialect int      `help:"Dialect number"`
+	Output  string   `short:"o" help:"Output file (default: standard output)"`
+	Name    string   `arg:"" help:"Name of a standard dialect, or import path of a Go package with a Dialect variable"`
+}
+
+type include struct {
+	Path     string
+	Standard string
+}
+
+// standardName returns the name of the standard dialect that corresponds to an included definition,
+// or an empty string if there's none.
+func standardName(inc string) string {
+	name := strings.ToLower(strings.ReplaceAll(strings.TrimSuffix(path.Base(inc), ".xml"), "_", ""))
+	if _, ok := standarddialects.Dialects[name]; ok {
+		return name
+	}
+	return ""
+}
+
+func includes() []*include {
+	ret := make([]*include, len(cli.Include))
+	for i, inc := range cli.Include {
+		ret[i] = &include{
+			Path:     inc,
+			Standard: standardName(inc),
+		}
+	}
+	return ret
+}
+
+func exportStandard() ([]byte, error) {
+	d, ok := standarddialects.Dialects[cli.Name]
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a standard dialect (%s)",
+			cli.Name, strings.Join(standarddialects.Names(), ", "))
+	}
+
+	var includedDialects []*dialect.Dialect
+
+	for _, inc := range includes() {
+		if inc.Standard != "" {
+			includedDialects = append(includedDialects, standarddialects.Dialects[inc.Standard])
+			continue
+		}
+
+		included, err := conversion.LoadDialect(inc.Path)
+		if err != nil {
+			return nil, err
+		}
+		includedDialects = append(includedDialects, included)
+	}
+
+	return conversion.ExportDialect(d, conversion.ExportOptions{
+		Includes:         cli.Include,
+		IncludedDialects: includedDialects,
+		Dialect:          cli.Dialect,
+	})
+}
+
+// exportPackage exports a dialect contained in a Go package, by building and running a program
+// that imports the package. The program is built into a directory of the module of the working directory.
+func exportPackage() ([]byte, error) {
+	dir, err := os.MkdirTemp(".", "dialect-export-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	f, err := os.Create(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tplProgram.Execute(f, map[string]any{
+		"Package":  cli.Name,
+		"Includes": includes(),
+		"Dialect":  cli.Dialect,
+	})
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", "run", "./"+filepath.Base(dir))
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("unable to export package %s: %w", cli.Name, err)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func run(args []string) error {
+	parser, err := kong.New(&cli,
+		kong.Description("Convert a Mavlink dialect from Go format to XML format. "+
+			"Dialects defined in Go packages are exported by building a program that imports them, "+
+			"therefore the command must be launched inside a Go module that requires the package."),
+		kong.UsageOnError())
+	if err != nil {
+		return err
+	}
+
+	_, err = parser.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+
+	if strings.Contains(cli.Name, "/") {
+		buf, err = exportPackage()
+	} else {
+		buf, err = exportStandard()
+	}
+	if err != nil {
+		return err
+	}
+
+	if cli.Output == "" {
+		_, err = os.Stdout.Write(buf)
+		return err
+	}
+
+	return os.WriteFile(cli.Output, buf, 0o644)
+}
+
+func main() {
+	err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "minimal.xml")
+
+	err = run([]string{"--dialect", "1", "-o", fpath, "minimal"})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(fpath)
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `<message id="0" name="HEARTBEAT">`)
+	require.Contains(t, string(buf), `<enum name="MAV_TYPE">`)
+
+	err = run([]string{"notexisting"})
+	require.Error(t, err)
+}
+
+func TestRunIncludes(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "ardupilotmega.xml")
+
+	err = run([]string{"--include", "common.xml", "-o", fpath, "ardupilotmega"})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(fpath)
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `<include>common.xml</include>`)
+	require.NotContains(t, string(buf), `name="HEARTBEAT"`)
+	require.Contains(t, string(buf), `name="SENSOR_OFFSETS"`)
+
+	err = run([]string{"--include", filepath.Join(dir, "notexisting.xml"), "ardupilotmega"})
+	require.Error(t, err)
+}
+
+func TestRunPackage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "standard.xml")
+
+	err = run([]string{
+		"--include", "minimal.xml",
+		"-o", fpath,
+		"github.com/bluenviron/gomavlib/v4/pkg/dialects/standard",
+	})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(fpath)
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `<include>minimal.xml</include>`)
+	require.NotContains(t, string(buf), `name="HEARTBEAT"`)
+	require.Contains(t, string(buf), `name="AUTOPILOT_VERSION"`)
+}
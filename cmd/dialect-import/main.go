@@ -11,14 +11,23 @@ import (
 )
 
 var cli struct {
-	Link bool   `help:"Link included definitions instead of including them into the main definition"`
-	XML  string `arg:"" help:"Path or url pointing to a XML Mavlink dialect"`
+	Link              bool     `help:"Link included definitions instead of including them into the main definition"`
+	ExcludeWIP        bool     `name:"exclude-wip" help:"Exclude messages, enums and entries that are work in progress"`
+	ExcludeDeprecated bool     `name:"exclude-deprecated" help:"Exclude messages, enums and entries that are deprecated"`
+	Include           []string `short:"I" help:"Directory in which included definitions are searched (can be repeated)"`
+	OutputDir         string   `short:"o" help:"Directory in which packages are created"`
+	Package           string   `help:"Name of the package (only with a single XML)"`
+	ImportPrefix      string   `help:"Import path prefix of linked packages" default:"${defaultImportPrefix}"`
+	SourceRevision    string   `help:"Revision of the definitions, recorded into the generated code"`
+	Wireshark         bool     `help:"Generate a Wireshark Lua dissector instead of Go code"`
+	XML               []string `arg:"" help:"Paths or urls pointing to XML Mavlink dialects"`
 }
 
 func run(args []string) error {
 	parser, err := kong.New(&cli,
 		kong.Description("Convert Mavlink dialects from XML format to Go format."),
-		kong.UsageOnError())
+		kong.UsageOnError(),
+		kong.Vars{"defaultImportPrefix": conversion.DefaultImportPrefix})
 	if err != nil {
 		return err
 	}
@@ -28,7 +37,32 @@ func run(args []string) error {
 		return err
 	}
 
-	return conversion.Convert(cli.XML, cli.Link)
+	if cli.Package != "" && len(cli.XML) > 1 {
+		return fmt.Errorf("--package can't be used with multiple XML files")
+	}
+
+	convert := conversion.ConvertWithOptions
+	if cli.Wireshark {
+		convert = conversion.ConvertWireshark
+	}
+
+	for _, xml := range cli.XML {
+		err = convert(xml, conversion.ConvertOptions{
+			Link:              cli.Link,
+			ExcludeWIP:        cli.ExcludeWIP,
+			ExcludeDeprecated: cli.ExcludeDeprecated,
+			IncludeDirs:       cli.Include,
+			OutputDir:         cli.OutputDir,
+			PkgName:           cli.Package,
+			ImportPrefix:      cli.ImportPrefix,
+			SourceRevision:    cli.SourceRevision,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", xml, err)
+		}
+	}
+
+	return nil
 }
 
 func main() {
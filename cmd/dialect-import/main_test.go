@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -59,3 +60,88 @@ func TestRun(t *testing.T) {
 	_, err = os.Stat("testdialect/message_a_message.go")
 	require.NoError(t, err)
 }
+
+const testIncludingDialect = `<?xml version="1.0"?>
+<mavlink>
+  <include>testdialect.xml</include>
+  <dialect>1</dialect>
+  <messages>
+    <message id="43001" name="B_MESSAGE">
+      <description>Another message.</description>
+      <field type="uint8_t" name="b_field" enum="A_TYPE">which anomaly has been detected.</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestRunOptions(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.MkdirAll(filepath.Join("defs", "sub"), 0o755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join("defs", "testdialect.xml"), []byte(testDialect), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join("defs", "sub", "including.xml"), []byte(testIncludingDialect), 0o644)
+	require.NoError(t, err)
+
+	err = run([]string{
+		"--link",
+		"-I", "defs",
+		"-o", "out",
+		"--import-prefix", "example.com/mymodule/dialects",
+		filepath.Join("defs", "testdialect.xml"),
+		filepath.Join("defs", "sub", "including.xml"),
+	})
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join("out", "testdialect", "message_a_message.go"))
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join("out", "including", "message_a_message.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `"example.com/mymodule/dialects/testdialect"`)
+
+	_, err = os.Stat(filepath.Join("out", "including", "message_b_message.go"))
+	require.NoError(t, err)
+
+	err = run([]string{
+		"--package", "mypkg",
+		filepath.Join("defs", "sub", "including.xml"),
+	})
+	require.Error(t, err)
+
+	err = run([]string{
+		"-I", "defs",
+		"--package", "mypkg",
+		filepath.Join("defs", "sub", "including.xml"),
+	})
+	require.NoError(t, err)
+
+	buf, err = os.ReadFile(filepath.Join("mypkg", "dialect.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "package mypkg\n")
+}
+
+func TestRunWireshark(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.WriteFile("testdialect.xml", []byte(testDialect), 0o644)
+	require.NoError(t, err)
+
+	err = run([]string{"--wireshark", "-o", "out", "testdialect.xml"})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join("out", "testdialect.lua"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `payload_fns[43000] = function(buffer, offset, length, tree, isV2)`)
+}
@@ -0,0 +1,72 @@
+// dialect-lint command.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+)
+
+var cli struct {
+	Include []string `short:"I" help:"Additional directory where included definitions are searched"`
+	V1      bool     `name:"v1" help:"Check compatibility with Mavlink v1 (message IDs up to 255)"`
+	Strict  bool     `help:"Treat warnings as errors"`
+	Ignore  []string `help:"Problems to ignore, in the format RULE or RULE:TARGET (i.e. enum-overflow:MESSAGE.field)"`
+	JSON    bool     `help:"Print diagnostics in JSON format"`
+	XMLs    []string `arg:"" help:"Paths or urls pointing to XML Mavlink definitions"`
+}
+
+func run(args []string) (int, error) {
+	parser, err := kong.New(&cli,
+		kong.Description("Check Mavlink definitions for errors. "+
+			"The exit code is 1 in case of errors."),
+		kong.UsageOnError())
+	if err != nil {
+		return 1, err
+	}
+
+	_, err = parser.Parse(args)
+	if err != nil {
+		return 1, err
+	}
+
+	diags := []*conversion.LintDiagnostic{}
+	for _, xml := range cli.XMLs {
+		diags = append(diags, conversion.Lint(xml, conversion.LintOptions{
+			IncludeDirs: cli.Include,
+			V1:          cli.V1,
+			Ignore:      cli.Ignore,
+		})...)
+	}
+
+	if cli.JSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(diags)
+		if err != nil {
+			return 1, err
+		}
+	} else {
+		for _, d := range diags {
+			fmt.Println(d)
+		}
+	}
+
+	if conversion.HasLintErrors(diags) || (cli.Strict && len(diags) != 0) {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func main() {
+	code, err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
+	}
+	os.Exit(code)
+}
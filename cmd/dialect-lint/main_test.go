@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testValidDefinition = `<?xml version="1.0"?>
+<mavlink>
+  <messages>
+    <message id="300" name="A_MESSAGE">
+      <description>A message.</description>
+      <field type="uint8_t" name="a"></field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testInvalidDefinition = `<?xml version="1.0"?>
+<mavlink>
+  <messages>
+    <message id="1" name="A_MESSAGE">
+      <description>A message.</description>
+      <field type="uint8_t" name="a" enum="UNDEFINED">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestRun(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	validPath := filepath.Join(dir, "valid.xml")
+	err = os.WriteFile(validPath, []byte(testValidDefinition), 0o644)
+	require.NoError(t, err)
+
+	invalidPath := filepath.Join(dir, "invalid.xml")
+	err = os.WriteFile(invalidPath, []byte(testInvalidDefinition), 0o644)
+	require.NoError(t, err)
+
+	code, err := run([]string{validPath})
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+
+	code, err = run([]string{"--strict", validPath})
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+
+	code, err = run([]string{"--v1", validPath})
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+
+	code, err = run([]string{"--json", validPath, invalidPath})
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+
+	code, err = run([]string{"--ignore", "undefined-enum:A_MESSAGE.a", invalidPath})
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// checkDefinitions checks that definitions are complete, by checking that
+// MISSION_ITEM of common.xml is deprecated, as it is in the mavlink repository since 2020-06.
+// Definitions that lack this information would produce dialects without Deprecated markers.
+func checkDefinitions(defsDir string) error {
+	fpath := filepath.Join(defsDir, "common.xml")
+	_, err := os.Stat(fpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	d, err := conversion.LoadDialect(fpath)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range d.Messages {
+		var md *message.MessageMetadata
+		md, err = message.GetMetadata(msg)
+		if err != nil {
+			return err
+		}
+
+		if md.Name == "MISSION_ITEM" {
+			if !md.Deprecated {
+				return fmt.Errorf("MISSION_ITEM of common.xml is not deprecated, definitions are incomplete")
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func listFiles(dir string) (map[string]struct{}, error) {
+	files := make(map[string]struct{})
+
+	err := filepath.WalkDir(dir, func(fpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, fpath)
+		if err != nil {
+			return err
+		}
+		files[rel] = struct{}{}
+		return nil
+	})
+
+	return files, err
+}
+
+// compareDirs checks that committed code is equal to generated code.
+func compareDirs(committedDir string, generatedDir string) error {
+	committed, err := listFiles(committedDir)
+	if err != nil {
+		return err
+	}
+
+	generated, err := listFiles(generatedDir)
+	if err != nil {
+		return err
+	}
+
+	var diffs []string
+
+	for fpath := range generated {
+		if _, ok := committed[fpath]; !ok {
+			diffs = append(diffs, fpath+" is missing")
+			continue
+		}
+
+		var buf1 []byte
+		buf1, err = os.ReadFile(filepath.Join(committedDir, fpath))
+		if err != nil {
+			return err
+		}
+
+		var buf2 []byte
+		buf2, err = os.ReadFile(filepath.Join(generatedDir, fpath))
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(buf1, buf2) {
+			diffs = append(diffs, fpath+" differs")
+		}
+	}
+
+	for fpath := range committed {
+		if _, ok := generated[fpath]; !ok {
+			diffs = append(diffs, fpath+" is not generated")
+		}
+	}
+
+	if len(diffs) != 0 {
+		sort.Strings(diffs)
+		for _, d := range diffs {
+			fmt.Fprintf(os.Stderr, "%s\n", filepath.Join(committedDir, d))
+		}
+		return fmt.Errorf("generated code does not match definitions (%d files)", len(diffs))
+	}
+
+	fmt.Fprintf(os.Stderr, "generated code matches definitions\n")
+	return nil
+}
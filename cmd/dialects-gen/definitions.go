@@ -0,0 +1,267 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	maxInboundJSONSize       = 128 * 1024
+	maxInboundDefinitionSize = 10 * 1024 * 1024
+	definitionsPath          = "message_definitions/v1.0/"
+)
+
+func download(addr string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("bad return code: %v", res.StatusCode)
+	}
+
+	return res.Body, nil
+}
+
+func downloadJSON(addr string, data any) error {
+	body, err := download(addr)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return json.NewDecoder(&customLimitReader{body, maxInboundJSONSize}).Decode(data)
+}
+
+func writeDefinition(dir string, name string, r io.Reader) error {
+	buf, err := io.ReadAll(&customLimitReader{r, maxInboundDefinitionSize})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, name), buf, 0o644)
+}
+
+// downloadDefinitions downloads the definitions of a git reference (commit, tag or branch)
+// of the mavlink repository into dir, and returns the commit they belong to.
+func downloadDefinitions(dir string, ref string) (string, error) {
+	var res struct {
+		Sha string `json:"sha"`
+	}
+	err := downloadJSON("https://api.github.com/repos/mavlink/mavlink/commits/"+url.PathEscape(ref), &res)
+	if err != nil {
+		return "", err
+	}
+
+	var files []struct {
+		Name string `json:"name"`
+	}
+	err = downloadJSON("https://api.github.com/repos/mavlink/mavlink/contents/message_definitions/v1.0?ref="+res.Sha,
+		&files)
+	if err != nil {
+		return "", err
+	}
+
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name, ".xml") {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "downloading %s\n", f.Name)
+
+		var body io.ReadCloser
+		body, err = download("https://raw.githubusercontent.com/mavlink/mavlink/" + res.Sha + "/" +
+			definitionsPath + f.Name)
+		if err != nil {
+			return "", err
+		}
+
+		err = writeDefinition(dir, f.Name, body)
+		body.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return res.Sha, nil
+}
+
+// definitionName returns the file name of a definition contained in an archive,
+// or an empty string if the entry is not a definition.
+func definitionName(entry string) string {
+	dir, name := path.Split(entry)
+	if !strings.HasSuffix(dir, definitionsPath) || !strings.HasSuffix(name, ".xml") {
+		return ""
+	}
+	return name
+}
+
+// archiveRoot returns the top directory of an entry of an archive.
+func archiveRoot(entry string) string {
+	root, _, _ := strings.Cut(entry, "/")
+	return root
+}
+
+type archiveInfo struct {
+	// SHA256 hash of the archive
+	hash string
+
+	// top directory of definitions
+	root string
+}
+
+// checkRevision checks that the archive belongs to a revision.
+// Revisions in the "sha256:" format are compared with the hash of the archive,
+// while commits are compared with the top directory of archives downloaded from GitHub
+// (i.e. mavlink-COMMIT).
+func (a *archiveInfo) checkRevision(revision string) error {
+	if strings.HasPrefix(revision, "sha256:") {
+		if revision != "sha256:"+a.hash {
+			return fmt.Errorf("archive hash is sha256:%s, while revision is %s", a.hash, revision)
+		}
+		return nil
+	}
+
+	if a.root != "mavlink-"+revision {
+		return fmt.Errorf("archive contains '%s', while revision is %s", a.root, revision)
+	}
+	return nil
+}
+
+func extractTarGz(f *os.File, dir string) (int, string, error) {
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, "", err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	count := 0
+	root := ""
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return count, root, nil
+		}
+		if err != nil {
+			return 0, "", err
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := definitionName(hdr.Name)
+		if name == "" {
+			continue
+		}
+
+		err = writeDefinition(dir, name, tr)
+		if err != nil {
+			return 0, "", err
+		}
+		count++
+		root = archiveRoot(hdr.Name)
+	}
+}
+
+func extractZip(fpath string, dir string) (int, string, error) {
+	zr, err := zip.OpenReader(fpath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer zr.Close()
+
+	count := 0
+	root := ""
+
+	for _, zf := range zr.File {
+		name := definitionName(zf.Name)
+		if name == "" {
+			continue
+		}
+
+		r, err := zf.Open()
+		if err != nil {
+			return 0, "", err
+		}
+
+		err = writeDefinition(dir, name, r)
+		r.Close()
+		if err != nil {
+			return 0, "", err
+		}
+		count++
+		root = archiveRoot(zf.Name)
+	}
+
+	return count, root, nil
+}
+
+// extractArchive extracts definitions from an archive of the mavlink repository into dir.
+func extractArchive(fpath string, dir string) (*archiveInfo, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	var root string
+
+	switch {
+	case strings.HasSuffix(fpath, ".tar.gz") || strings.HasSuffix(fpath, ".tgz"):
+		count, root, err = extractTarGz(f, dir)
+
+	case strings.HasSuffix(fpath, ".zip"):
+		count, root, err = extractZip(fpath, dir)
+
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %s", fpath)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("no definitions found in archive")
+	}
+
+	return &archiveInfo{
+		hash: hex.EncodeToString(h.Sum(nil)),
+		root: root,
+	}, nil
+}
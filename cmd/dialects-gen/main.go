@@ -2,19 +2,18 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
-)
 
-const (
-	maxInboundJSONSize = 128 * 1024
+	"github.com/alecthomas/kong"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
 )
 
 var tplTest = template.Must(template.New("").Parse(
@@ -90,6 +89,12 @@ func TestDialect(t *testing.T) {
 	err := d.Initialize()
 	require.NoError(t, err)
 }
+
+func TestMessageTable(t *testing.T) {
+	table, err := dialect.NewMessageTable(dialectpkg.Dialect)
+	require.NoError(t, err)
+	require.Equal(t, table, dialectpkg.MessageTable)
+}
 `))
 
 var tplEnumTest = template.Must(template.New("").Parse(
@@ -125,6 +130,30 @@ func TestEnum_{{ .Name }}(t *testing.T) {
 }
 `))
 
+// reFirstEntry matches the first entry of an enum, preceded by its comment,
+// that can contain empty lines (i.e. before a Deprecated: paragraph).
+var reFirstEntry = regexp.MustCompile("const \\(\\n" +
+	"(\\t//( .+?)?\\n)*" +
+	"\\t(.*?) (.*?) = (.*?)\\n")
+
+// revisionFile is the file of the dialects directory that contains
+// the revision of the definitions that dialects are generated from.
+const revisionFile = "REVISION"
+
+// readPinnedRevision reads the revision pinned in the dialects directory,
+// or returns an empty string if there's none.
+func readPinnedRevision(dialectsDir string) (string, error) {
+	buf, err := os.ReadFile(filepath.Join(dialectsDir, revisionFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(buf)), nil
+}
+
 func writeTemplate(fpath string, tpl *template.Template, args map[string]any) error {
 	f, err := os.Create(fpath)
 	if err != nil {
@@ -143,35 +172,23 @@ func shellCommand(cmdstr string) error {
 	return cmd.Run()
 }
 
-func downloadJSON(addr string, data any) error {
-	req, err := http.NewRequest(http.MethodGet, addr, nil)
-	if err != nil {
-		return err
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-
-	return json.NewDecoder(&customLimitReader{res.Body, maxInboundJSONSize}).Decode(data)
-}
-
-func processDialect(commit string, name string) error {
+func processDialect(defsDir string, outDir string, name string, revision string) error {
 	fmt.Fprintf(os.Stderr, "[%s]\n", name)
 
-	err := shellCommand(fmt.Sprintf("go run ../../cmd/dialect-import --link %s",
-		"https://raw.githubusercontent.com/mavlink/mavlink/"+commit+"/message_definitions/v1.0/"+name+".xml",
-	))
+	err := conversion.ConvertWithOptions(filepath.Join(defsDir, name+".xml"), conversion.ConvertOptions{
+		Link:           true,
+		OutputDir:      outDir,
+		SourceRevision: revision,
+	})
 	if err != nil {
 		return err
 	}
 
 	pkgName := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	pkgDir := filepath.Join(outDir, pkgName)
 
 	err = writeTemplate(
-		"./"+pkgName+"/dialect_test.go",
+		filepath.Join(pkgDir, "dialect_test.go"),
 		tplDialectTest,
 		map[string]any{
 			"PkgName": pkgName,
@@ -180,7 +197,7 @@ func processDialect(commit string, name string) error {
 		return err
 	}
 
-	entries, err := os.ReadDir(pkgName)
+	entries, err := os.ReadDir(pkgDir)
 	if err != nil {
 		return err
 	}
@@ -191,7 +208,7 @@ func processDialect(commit string, name string) error {
 		}
 
 		var buf []byte
-		buf, err = os.ReadFile(filepath.Join(pkgName, f.Name()))
+		buf, err = os.ReadFile(filepath.Join(pkgDir, f.Name()))
 		if err != nil {
 			return err
 		}
@@ -201,10 +218,7 @@ func processDialect(commit string, name string) error {
 			continue
 		}
 
-		rx := regexp.MustCompile("const \\(\\n" +
-			"(\\t// .+?\\n)*" +
-			"\\t(.*?) (.*?) = (.*?)\\n")
-		matches := rx.FindStringSubmatch(str)
+		matches := reFirstEntry.FindStringSubmatch(str)
 		if matches == nil {
 			return fmt.Errorf("first entry of %s not found", f.Name())
 		}
@@ -215,12 +229,12 @@ func processDialect(commit string, name string) error {
 		enumName = strings.ToUpper(enumName)
 
 		err = writeTemplate(
-			"./"+pkgName+"/"+strings.ReplaceAll(f.Name(), ".go", "_test.go"),
+			filepath.Join(pkgDir, strings.ReplaceAll(f.Name(), ".go", "_test.go")),
 			tplEnumTest,
 			map[string]any{
 				"PkgName":    pkgName,
 				"Name":       enumName,
-				"FirstEntry": matches[2],
+				"FirstEntry": matches[3],
 			})
 		if err != nil {
 			return err
@@ -231,57 +245,183 @@ func processDialect(commit string, name string) error {
 	return nil
 }
 
-func run() error {
-	err := shellCommand("rm -rf pkg/dialects/*/")
+// generate generates all dialects contained in defsDir into outDir.
+func generate(defsDir string, outDir string, revision string) error {
+	entries, err := os.ReadDir(defsDir)
 	if err != nil {
 		return err
 	}
 
-	os.Mkdir(filepath.Join("pkg", "dialects"), 0o755)
-	os.Chdir(filepath.Join("pkg", "dialects"))
+	var names []string
+	for _, f := range entries {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".xml") {
+			names = append(names, strings.TrimSuffix(f.Name(), ".xml"))
+		}
+	}
+
+	if len(names) == 0 {
+		return fmt.Errorf("no definitions found in '%s'", defsDir)
+	}
+
+	sort.Strings(names)
 
-	var res struct {
-		Sha string `json:"sha"`
+	for _, name := range names {
+		err = processDialect(defsDir, outDir, name, revision)
+		if err != nil {
+			return err
+		}
 	}
-	err = downloadJSON("https://api.github.com/repos/mavlink/mavlink/commits/master", &res)
+
+	err = writeTemplate(
+		filepath.Join(outDir, "package_test.go"),
+		tplTest,
+		map[string]any{})
 	if err != nil {
 		return err
 	}
 
-	var files []struct {
-		Name string `json:"name"`
+	if revision != "" {
+		return os.WriteFile(filepath.Join(outDir, revisionFile), []byte(revision+"\n"), 0o644)
 	}
-	err = downloadJSON("https://api.github.com/repos/mavlink/mavlink/contents/message_definitions/v1.0?ref="+res.Sha,
-		&files)
+
+	return nil
+}
+
+var cli struct {
+	Definitions string `help:"Directory that contains XML definitions (message_definitions/v1.0 of the mavlink repository)"`
+	Archive     string `help:"Archive of the mavlink repository (.tar.gz or .zip) from which XML definitions are read"`
+	Revision    string `help:"Commit, tag or branch of definitions, or sha256:HASH of an archive (default: pinned)"`
+	Check       bool   `help:"Check that code in pkg/dialects matches definitions, without changing it"`
+	FormatCmd   string `help:"Command used to format generated code in check mode" default:"golangci-lint fmt"`
+}
+
+func run(args []string) error {
+	parser, err := kong.New(&cli,
+		kong.Description("Generate standard dialects. By default, definitions of the pinned revision "+
+			"are downloaded from the mavlink repository."),
+		kong.UsageOnError())
 	if err != nil {
 		return err
 	}
 
-	for _, f := range files {
-		if !strings.HasSuffix(f.Name, ".xml") {
-			continue
+	_, err = parser.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	dialectsDir := filepath.Join("pkg", "dialects")
+
+	defsDir := cli.Definitions
+	revision := cli.Revision
+
+	if revision == "" {
+		revision, err = readPinnedRevision(dialectsDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case cli.Definitions != "" && cli.Archive != "":
+		return fmt.Errorf("--definitions and --archive can't be used together")
+
+	case cli.Definitions != "":
+
+	case cli.Archive != "":
+		defsDir, err = os.MkdirTemp("", "dialects-gen")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(defsDir)
+
+		var info *archiveInfo
+		info, err = extractArchive(cli.Archive, defsDir)
+		if err != nil {
+			return err
+		}
+
+		if revision != "" {
+			err = info.checkRevision(revision)
+			if err != nil {
+				return err
+			}
+		} else {
+			revision = "sha256:" + info.hash
+		}
+
+	default:
+		if revision == "" {
+			return fmt.Errorf("no revision is pinned in %s, use --revision to choose one (i.e. --revision master)",
+				filepath.Join(dialectsDir, revisionFile))
 		}
-		name := f.Name[:len(f.Name)-len(".xml")]
 
-		err = processDialect(res.Sha, name)
+		if strings.HasPrefix(revision, "sha256:") {
+			return fmt.Errorf("revision %s is the hash of an archive, use --archive to read it", revision)
+		}
+
+		defsDir, err = os.MkdirTemp("", "dialects-gen")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(defsDir)
+
+		revision, err = downloadDefinitions(defsDir, revision)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = writeTemplate(
-		"package_test.go",
-		tplTest,
-		map[string]any{})
+	err = checkDefinitions(defsDir)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if cli.Check {
+		// generate into a directory of the module, in order to format code with the module configuration
+		var outDir string
+		outDir, err = os.MkdirTemp(".", "dialects-check-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(outDir)
+
+		err = generate(defsDir, outDir, revision)
+		if err != nil {
+			return err
+		}
+
+		err = shellCommand(cli.FormatCmd + " " + outDir)
+		if err != nil {
+			return err
+		}
+
+		return compareDirs(dialectsDir, outDir)
+	}
+
+	entries, err := os.ReadDir(dialectsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, f := range entries {
+		if f.IsDir() {
+			err = os.RemoveAll(filepath.Join(dialectsDir, f.Name()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = os.MkdirAll(dialectsDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	return generate(defsDir, dialectsDir, revision)
 }
 
 func main() {
-	err := run()
+	err := run(os.Args[1:])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "ERR: %s\n", err)
 		os.Exit(1)
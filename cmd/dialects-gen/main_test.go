@@ -0,0 +1,236 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testArchiveFiles = map[string]string{
+	"mavlink-1234/message_definitions/v1.0/minimal.xml": "<mavlink></mavlink>",
+	"mavlink-1234/message_definitions/v1.0/common.xml":  "<mavlink><include>minimal.xml</include></mavlink>",
+	"mavlink-1234/README.md":                            "readme",
+}
+
+func TestExtractArchive(t *testing.T) {
+	for _, ca := range []string{"tar.gz", "zip"} {
+		t.Run(ca, func(t *testing.T) {
+			dir, err := os.MkdirTemp("", "dialects-gen")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			var buf bytes.Buffer
+
+			if ca == "tar.gz" {
+				gw := gzip.NewWriter(&buf)
+				tw := tar.NewWriter(gw)
+				for name, content := range testArchiveFiles {
+					err = tw.WriteHeader(&tar.Header{
+						Name:     name,
+						Typeflag: tar.TypeReg,
+						Mode:     0o644,
+						Size:     int64(len(content)),
+					})
+					require.NoError(t, err)
+					_, err = tw.Write([]byte(content))
+					require.NoError(t, err)
+				}
+				require.NoError(t, tw.Close())
+				require.NoError(t, gw.Close())
+			} else {
+				zw := zip.NewWriter(&buf)
+				for name, content := range testArchiveFiles {
+					w, err2 := zw.Create(name)
+					require.NoError(t, err2)
+					_, err2 = w.Write([]byte(content))
+					require.NoError(t, err2)
+				}
+				require.NoError(t, zw.Close())
+			}
+
+			archivePath := filepath.Join(dir, "mavlink."+ca)
+			err = os.WriteFile(archivePath, buf.Bytes(), 0o644)
+			require.NoError(t, err)
+
+			defsDir := filepath.Join(dir, "defs")
+			err = os.Mkdir(defsDir, 0o755)
+			require.NoError(t, err)
+
+			info, err := extractArchive(archivePath, defsDir)
+			require.NoError(t, err)
+			require.Len(t, info.hash, 64)
+			require.Equal(t, "mavlink-1234", info.root)
+
+			entries, err := os.ReadDir(defsDir)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+
+			content, err := os.ReadFile(filepath.Join(defsDir, "common.xml"))
+			require.NoError(t, err)
+			require.Equal(t, "<mavlink><include>minimal.xml</include></mavlink>", string(content))
+		})
+	}
+}
+
+func TestArchiveCheckRevision(t *testing.T) {
+	info := &archiveInfo{
+		hash: "94f5c6ed8e8950da4cce5873d44835c6539eecd392fd2bd67e056ca9f0fa3678",
+		root: "mavlink-1234",
+	}
+
+	err := info.checkRevision("1234")
+	require.NoError(t, err)
+
+	err = info.checkRevision("sha256:94f5c6ed8e8950da4cce5873d44835c6539eecd392fd2bd67e056ca9f0fa3678")
+	require.NoError(t, err)
+
+	err = info.checkRevision("5678")
+	require.EqualError(t, err, "archive contains 'mavlink-1234', while revision is 5678")
+
+	err = info.checkRevision("sha256:0000")
+	require.EqualError(t, err, "archive hash is "+
+		"sha256:94f5c6ed8e8950da4cce5873d44835c6539eecd392fd2bd67e056ca9f0fa3678, while revision is sha256:0000")
+}
+
+func TestCompareDirs(t *testing.T) {
+	dir, err := os.MkdirTemp("", "dialects-gen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, sub := range []string{"committed", "generated"} {
+		err = os.MkdirAll(filepath.Join(dir, sub, "common"), 0o755)
+		require.NoError(t, err)
+
+		err = os.WriteFile(filepath.Join(dir, sub, "common", "dialect.go"), []byte("package common\n"), 0o644)
+		require.NoError(t, err)
+	}
+
+	err = compareDirs(filepath.Join(dir, "committed"), filepath.Join(dir, "generated"))
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "generated", "common", "dialect.go"), []byte("package other\n"), 0o644)
+	require.NoError(t, err)
+
+	err = compareDirs(filepath.Join(dir, "committed"), filepath.Join(dir, "generated"))
+	require.EqualError(t, err, "generated code does not match definitions (1 files)")
+}
+
+func TestCheckDefinitions(t *testing.T) {
+	for _, ca := range []string{"deprecated", "not deprecated"} {
+		t.Run(ca, func(t *testing.T) {
+			dir, err := os.MkdirTemp("", "dialects-gen")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			deprecated := ""
+			if ca == "deprecated" {
+				deprecated = `<deprecated since="2020-06" replaced_by="MISSION_ITEM_INT">Use MISSION_ITEM_INT.</deprecated>`
+			}
+
+			err = os.WriteFile(filepath.Join(dir, "common.xml"), []byte(`<?xml version="1.0"?>
+<mavlink>
+  <messages>
+    <message id="39" name="MISSION_ITEM">
+      `+deprecated+`
+      <field type="uint16_t" name="seq">Sequence</field>
+    </message>
+  </messages>
+</mavlink>
+`), 0o644)
+			require.NoError(t, err)
+
+			err = checkDefinitions(dir)
+
+			if ca == "deprecated" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, "MISSION_ITEM of common.xml is not deprecated, definitions are incomplete")
+			}
+		})
+	}
+}
+
+const testDeprecatedFirstEntry = `<?xml version="1.0"?>
+<mavlink>
+  <version>3</version>
+  <enums>
+    <enum name="A_TYPE">
+      <entry value="0" name="A_TYPE_OLD">
+        <deprecated since="2021-01" replaced_by="A_TYPE_NEW">Use A_TYPE_NEW.</deprecated>
+        <description>Old entry.</description>
+      </entry>
+      <entry value="1" name="A_TYPE_NEW">
+        <description>New entry.</description>
+      </entry>
+    </enum>
+  </enums>
+</mavlink>
+`
+
+func TestGenerateDeprecatedFirstEntry(t *testing.T) {
+	dir, err := os.MkdirTemp("", "dialects-gen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defsDir := filepath.Join(dir, "defs")
+	err = os.Mkdir(defsDir, 0o755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(defsDir, "test.xml"), []byte(testDeprecatedFirstEntry), 0o644)
+	require.NoError(t, err)
+
+	outDir := filepath.Join(dir, "out")
+	err = os.Mkdir(outDir, 0o755)
+	require.NoError(t, err)
+
+	err = generate(defsDir, outDir, "")
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(outDir, "test", "enum_a_type_test.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "dialectpkg.A_TYPE_OLD.MarshalText()")
+}
+
+func TestGenerateRevision(t *testing.T) {
+	dir, err := os.MkdirTemp("", "dialects-gen")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defsDir := filepath.Join(dir, "defs")
+	err = os.Mkdir(defsDir, 0o755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(defsDir, "test.xml"), []byte(testDeprecatedFirstEntry), 0o644)
+	require.NoError(t, err)
+
+	outDir := filepath.Join(dir, "out")
+	err = os.Mkdir(outDir, 0o755)
+	require.NoError(t, err)
+
+	revision, err := readPinnedRevision(outDir)
+	require.NoError(t, err)
+	require.Equal(t, "", revision)
+
+	err = generate(defsDir, outDir, "1234abcd")
+	require.NoError(t, err)
+
+	revision, err = readPinnedRevision(outDir)
+	require.NoError(t, err)
+	require.Equal(t, "1234abcd", revision)
+
+	buf, err := os.ReadFile(filepath.Join(outDir, "test", "dialect.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "// Source revision: 1234abcd\n")
+}
+
+func TestRunNoRevision(t *testing.T) {
+	err := run([]string{"--check"})
+	require.EqualError(t, err, "no revision is pinned in "+filepath.Join("pkg", "dialects", "REVISION")+
+		", use --revision to choose one (i.e. --revision master)")
+}
@@ -11,5 +11,6 @@ type Endpoint interface {
 	close()
 	oneChannelAtAtime() bool
 	isDatagram() bool
+	writeBatching() *WriteBatching
 	provide() (string, io.ReadWriteCloser, error)
 }
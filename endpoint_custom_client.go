@@ -25,6 +25,9 @@ type EndpointCustomClient struct {
 	// whether the connection is datagram-based (e.g. UDP).
 	IsDatagram bool
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	node      *Node
 	ctx       context.Context
 	ctxCancel func()
@@ -51,6 +54,10 @@ func (e *EndpointCustomClient) isDatagram() bool {
 	return e.IsDatagram
 }
 
+func (e *EndpointCustomClient) writeBatching() *WriteBatching {
+	return e.WriteBatching
+}
+
 func (e *EndpointCustomClient) connect() (io.ReadWriteCloser, error) {
 	timedContext, timedContextClose := context.WithTimeout(e.ctx, e.node.ReadTimeout)
 	nconn, err := e.Connect(timedContext)
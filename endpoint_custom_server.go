@@ -23,6 +23,9 @@ type EndpointCustomServer struct {
 	// whether the connection is datagram-based (e.g. UDP).
 	IsDatagram bool
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	node      *Node
 	listener  net.Listener
 	terminate chan struct{}
@@ -57,6 +60,10 @@ func (e *EndpointCustomServer) isDatagram() bool {
 	return e.IsDatagram
 }
 
+func (e *EndpointCustomServer) writeBatching() *WriteBatching {
+	return e.WriteBatching
+}
+
 func (e *EndpointCustomServer) provide() (string, io.ReadWriteCloser, error) {
 	nconn, err := e.listener.Accept()
 	if err != nil {
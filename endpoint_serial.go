@@ -60,6 +60,9 @@ type EndpointSerial struct {
 	// baud rate (i.e: 57600)
 	Baud int
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	EndpointCustomClient
 }
 
@@ -72,7 +75,8 @@ func (e *EndpointSerial) init(node *Node) error {
 			}
 			return &rwcToConn{rwc}, nil
 		},
-		Label: "serial:" + e.Device,
+		Label:         "serial:" + e.Device,
+		WriteBatching: e.WriteBatching,
 	}
 	return e.EndpointCustomClient.init(node)
 }
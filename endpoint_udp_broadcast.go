@@ -82,6 +82,9 @@ type EndpointUDPBroadcast struct {
 	// from the broadcast address.
 	LocalAddress string
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	EndpointCustomClient
 }
 
@@ -131,8 +134,9 @@ func (e *EndpointUDPBroadcast) init(node *Node) error {
 				broadcastAddr: broadcastAddr,
 			}}, nil
 		},
-		Label:      "udp:" + broadcastAddr.String(),
-		IsDatagram: true,
+		Label:         "udp:" + broadcastAddr.String(),
+		IsDatagram:    true,
+		WriteBatching: e.WriteBatching,
 	}
 	return e.EndpointCustomClient.init(node)
 }
@@ -12,6 +12,9 @@ type EndpointUDPClient struct {
 	// domain name or IP of the server to connect to, example: 1.2.3.4:5600
 	Address string
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	EndpointCustomClient
 }
 
@@ -20,8 +23,9 @@ func (e *EndpointUDPClient) init(node *Node) error {
 		Connect: func(ctx context.Context) (net.Conn, error) {
 			return (&net.Dialer{}).DialContext(ctx, "udp4", e.Address)
 		},
-		Label:      "udp:" + e.Address,
-		IsDatagram: true,
+		Label:         "udp:" + e.Address,
+		IsDatagram:    true,
+		WriteBatching: e.WriteBatching,
 	}
 	return e.EndpointCustomClient.init(node)
 }
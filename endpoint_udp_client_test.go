@@ -1,6 +1,7 @@
 package gomavlib //nolint:dupl
 
 import (
+	"bytes"
 	"net"
 	"testing"
 
@@ -253,3 +254,123 @@ func TestEndpointUDPClientDatagramRecovery(t *testing.T) {
 
 	<-serverDone
 }
+
+func TestEndpointUDPClientDatagramMultipleFrames(t *testing.T) {
+	pc, err := net.ListenPacket("udp4", "127.0.0.1:5604")
+	require.NoError(t, err)
+	defer pc.Close()
+
+	dialectRW := &dialect.ReadWriter{Dialect: testDialect}
+	err = dialectRW.Initialize()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	fw := &frame.Writer{
+		ByteWriter: &buf,
+		DialectRW:  dialectRW,
+	}
+	err = fw.Initialize()
+	require.NoError(t, err)
+
+	sw := &streamwriter.Writer{
+		FrameWriter: fw,
+		Version:     streamwriter.V2,
+		SystemID:    11,
+	}
+	err = sw.Initialize()
+	require.NoError(t, err)
+
+	for i := range 3 {
+		err = sw.Write(&MessageHeartbeat{
+			Type:           6,
+			Autopilot:      5,
+			BaseMode:       4,
+			CustomMode:     uint32(i),
+			SystemStatus:   2,
+			MavlinkVersion: 1,
+		})
+		require.NoError(t, err)
+
+		if i == 1 {
+			buf.Write([]byte{0xff, 0xff})
+		}
+	}
+
+	buf.Write([]byte{0xff, 0xff, 0xff})
+
+	// jumbo datagram, larger than the default buffer size
+	for i := range 40 {
+		err = sw.Write(&MessageHeartbeat{
+			Type:           6,
+			Autopilot:      5,
+			BaseMode:       4,
+			CustomMode:     uint32(3 + i),
+			SystemStatus:   2,
+			MavlinkVersion: 1,
+		})
+		require.NoError(t, err)
+	}
+
+	serverDone := make(chan struct{})
+
+	go func() {
+		defer close(serverDone)
+
+		buf2 := make([]byte, 4096)
+		_, clientAddr, err2 := pc.ReadFrom(buf2)
+		require.NoError(t, err2)
+
+		byts := buf.Bytes()
+
+		_, err2 = pc.WriteTo(byts[:21*3+5], clientAddr)
+		require.NoError(t, err2)
+
+		_, err2 = pc.WriteTo(byts[21*3+5:], clientAddr)
+		require.NoError(t, err2)
+	}()
+
+	node := &Node{
+		Dialect:                testDialect,
+		OutVersion:             V2,
+		OutSystemID:            10,
+		Endpoints:              []Endpoint{&EndpointUDPClient{Address: "127.0.0.1:5604"}},
+		HeartbeatDisable:       true,
+		DatagramReadBufferSize: 2048,
+	}
+	err = node.Initialize()
+	require.NoError(t, err)
+	defer node.Close()
+
+	evt := <-node.Events()
+	require.Equal(t, &EventChannelOpen{
+		Channel: evt.(*EventChannelOpen).Channel,
+	}, evt)
+
+	err = node.WriteMessageAll(testMessage)
+	require.NoError(t, err)
+
+	for i := range 43 {
+		evt = <-node.Events()
+		fr, ok := evt.(*EventFrame)
+		require.True(t, ok)
+		require.Equal(t, uint32(i), fr.Message().(*MessageHeartbeat).CustomMode)
+		require.Equal(t, byte(i), fr.Frame.GetSequenceNumber())
+
+		switch i {
+		case 1:
+			evt = <-node.Events()
+			parseErr, ok2 := evt.(*EventParseError)
+			require.True(t, ok2)
+			require.EqualError(t, parseErr.Error, "skipped 2 bytes")
+
+		case 2:
+			evt = <-node.Events()
+			parseErr, ok2 := evt.(*EventParseError)
+			require.True(t, ok2)
+			require.EqualError(t, parseErr.Error, "skipped 3 bytes")
+		}
+	}
+
+	<-serverDone
+}
@@ -15,6 +15,9 @@ type EndpointUDPServer struct {
 	// listen address, example: 0.0.0.0:5600
 	Address string
 
+	// (optional) coalesces outgoing frames into batches.
+	WriteBatching *WriteBatching
+
 	EndpointCustomServer
 }
 
@@ -28,8 +31,9 @@ func (e *EndpointUDPServer) init(node *Node) error {
 
 			return udp.Listen("udp4", addr)
 		},
-		Label:      "udp:" + e.Address,
-		IsDatagram: true,
+		Label:         "udp:" + e.Address,
+		IsDatagram:    true,
+		WriteBatching: e.WriteBatching,
 	}
 	return e.EndpointCustomServer.init(node)
 }
@@ -0,0 +1,55 @@
+// Package main contains an example.
+package main
+
+import (
+	"log"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+)
+
+// this example shows how to:
+// 1) load a dialect from a XML definition at runtime, without generating code.
+// 2) create a node which understands the dialect.
+// 3) print incoming messages and read their fields by name.
+
+func main() {
+	// load a dialect from a XML definition and its includes
+	dialect, err := conversion.LoadDialect("mydialect.xml")
+	if err != nil {
+		panic(err)
+	}
+
+	// create a node which understands the dialect
+	node := &gomavlib.Node{
+		Endpoints: []gomavlib.Endpoint{
+			&gomavlib.EndpointSerial{
+				Device: "/dev/ttyUSB0",
+				Baud:   57600,
+			},
+		},
+		Dialect:     dialect,
+		OutVersion:  gomavlib.V2, // change to V1 if you're unable to communicate with the target
+		OutSystemID: 10,
+	}
+	err = node.Initialize()
+	if err != nil {
+		panic(err)
+	}
+	defer node.Close()
+
+	// print incoming messages
+	for evt := range node.Events() {
+		if frm, ok := evt.(*gomavlib.EventFrame); ok {
+			if msg, ok2 := frm.Message().(*dynamic.Message); ok2 {
+				log.Printf("received: %s\n", msg)
+
+				if msg.Definition().Name == "HEARTBEAT" {
+					v, _ := msg.Get("custom_mode")
+					log.Printf("custom mode: %v\n", v)
+				}
+			}
+		}
+	}
+}
@@ -2,17 +2,16 @@
 package main
 
 import (
-	"encoding/json"
-	"fmt"
 	"log"
 
 	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
 	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
 )
 
 // this example shows how to:
 // 1) create a node which communicates with a serial endpoint.
-// 2) encode incoming messages into JSON.
+// 2) encode incoming messages into JSON, by using MAVLink field names and enum labels.
 // 3) print messages in the console.
 
 func main() {
@@ -34,23 +33,30 @@ func main() {
 	}
 	defer node.Close()
 
+	// create a dialect ReadWriter, that is able to encode messages into JSON
+	dialectRW := &dialect.ReadWriter{Dialect: common.Dialect}
+	err = dialectRW.Initialize()
+	if err != nil {
+		panic(err)
+	}
+
 	for evt := range node.Events() {
 		if frm, ok := evt.(*gomavlib.EventFrame); ok {
+			// skip messages that are not in the dialect
+			mrw := dialectRW.GetMessage(frm.Message().GetID())
+			if mrw == nil {
+				continue
+			}
+
 			// encode incoming messages
 			var enc []byte
-			enc, err = json.Marshal(struct {
-				Type    string
-				Content any
-			}{
-				Type:    fmt.Sprintf("%T", frm.Message()),
-				Content: filterFloats(frm.Message()),
-			})
+			enc, err = mrw.EncodeJSON(frm.Message())
 			if err != nil {
 				panic(err)
 			}
 
 			// print messages in the console
-			log.Printf("%s\n", enc)
+			log.Printf("%s %s\n", mrw.Name(), enc)
 		}
 	}
 }
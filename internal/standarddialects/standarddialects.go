@@ -0,0 +1,60 @@
+// Package standarddialects contains the standard dialects, indexed by name.
+package standarddialects
+
+import (
+	"sort"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/all"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/asluav"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/avssuas"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/csairlink"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/cubepilot"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/development"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/icarous"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/loweheiser"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/marsh"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/minimal"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/paparazzi"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/pythonarraytest"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/standard"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/stemstudios"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/storm32"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/test"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/uavionix"
+)
+
+// Dialects are the standard dialects, indexed by name.
+var Dialects = map[string]*dialect.Dialect{
+	"all":             all.Dialect,
+	"ardupilotmega":   ardupilotmega.Dialect,
+	"asluav":          asluav.Dialect,
+	"avssuas":         avssuas.Dialect,
+	"common":          common.Dialect,
+	"csairlink":       csairlink.Dialect,
+	"cubepilot":       cubepilot.Dialect,
+	"development":     development.Dialect,
+	"icarous":         icarous.Dialect,
+	"loweheiser":      loweheiser.Dialect,
+	"marsh":           marsh.Dialect,
+	"minimal":         minimal.Dialect,
+	"paparazzi":       paparazzi.Dialect,
+	"pythonarraytest": pythonarraytest.Dialect,
+	"standard":        standard.Dialect,
+	"stemstudios":     stemstudios.Dialect,
+	"storm32":         storm32.Dialect,
+	"test":            test.Dialect,
+	"uavionix":        uavionix.Dialect,
+}
+
+// Names returns the sorted names of standard dialects.
+func Names() []string {
+	names := make([]string, 0, len(Dialects))
+	for name := range Dialects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,49 @@
+package gomavlib
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// fieldDefToGo converts a field name of the XML definition into the name of the generated field.
+func fieldDefToGo(in string) string {
+	parts := strings.Split(in, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// newMessageWithFields allocates a message of the same kind of tpl,
+// that can be either generated or dynamic, and fills the given integer fields.
+func newMessageWithFields(tpl message.Message, fields map[string]uint64) message.Message {
+	if dm, ok := tpl.(*dynamic.Message); ok {
+		m := dm.Definition().NewMessage()
+		for name, v := range fields {
+			m.Set(name, v) //nolint:errcheck
+		}
+		return m
+	}
+
+	m := reflect.New(reflect.TypeOf(tpl).Elem())
+	for name, v := range fields {
+		m.Elem().FieldByName(fieldDefToGo(name)).SetUint(v)
+	}
+	return m.Interface().(message.Message)
+}
+
+// getUintField returns the value of an integer field of a message,
+// that can be either generated or dynamic.
+func getUintField(msg message.Message, name string) uint64 {
+	if dm, ok := msg.(*dynamic.Message); ok {
+		v, _ := dm.Get(name)
+		return reflect.ValueOf(v).Uint()
+	}
+
+	return reflect.ValueOf(msg).Elem().FieldByName(fieldDefToGo(name)).Uint()
+}
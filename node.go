@@ -15,12 +15,14 @@ package gomavlib
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
 	"github.com/bluenviron/gomavlib/v4/pkg/frame"
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
+	"github.com/bluenviron/gomavlib/v4/pkg/streamwriter"
 )
 
 var (
@@ -48,6 +50,14 @@ type Node struct {
 	// If not provided, messages are decoded in the MessageRaw struct.
 	Dialect *dialect.Dialect
 
+	// (optional) table used to validate the checksum and the length
+	// of incoming messages that are not in the dialect.
+	// Generated dialects provide one in the MessageTable variable.
+	MessageTable dialect.MessageTable
+
+	// (optional) discard incoming messages that are neither in the dialect nor in MessageTable.
+	DropUnknownMessages bool
+
 	// (optional) secret key used to validate incoming frames.
 	// Non signed frames are discarded, as well as frames with a version < 2.0.
 	InKey *frame.V2Key
@@ -63,6 +73,10 @@ type Node struct {
 	// (optional) secret key used to sign outgoing frames.
 	// This feature requires a version >= 2.0.
 	OutKey *frame.V2Key
+	// (optional) validate outgoing messages against their definition
+	// (enum values, string and array lengths, minimum and maximum values)
+	// and return an error when they are not valid.
+	OutValidate bool
 
 	// (optional) disables the periodic sending of heartbeats to open channels.
 	HeartbeatDisable bool
@@ -90,12 +104,17 @@ type Node struct {
 	// (optional) timeout before closing idle connections.
 	// It defaults to 60 seconds.
 	IdleTimeout time.Duration
+	// (optional) size of the buffer used to read datagrams (i.e. UDP packets).
+	// Datagrams that exceed this size are truncated.
+	// It defaults to 512 bytes.
+	DatagramReadBufferSize int
 
 	//
 	// private
 	//
 
 	dialectRW         *dialect.ReadWriter
+	frameEncoder      *frame.Writer
 	wg                sync.WaitGroup
 	channelProviders  map[*channelProvider]struct{}
 	channels          map[*Channel]struct{}
@@ -108,6 +127,7 @@ type Node struct {
 	chWriteTo      chan writeToReq
 	chWriteAll     chan any
 	chWriteExcept  chan writeExceptReq
+	chFlush        chan struct{}
 	terminate      chan struct{}
 
 	// out
@@ -156,6 +176,9 @@ func (n *Node) Initialize() error {
 	if n.IdleTimeout == 0 {
 		n.IdleTimeout = 60 * time.Second
 	}
+	if n.DatagramReadBufferSize == 0 {
+		n.DatagramReadBufferSize = datagramReadBufferSize
+	}
 
 	var dialectRW *dialect.ReadWriter
 	if n.Dialect != nil {
@@ -167,6 +190,16 @@ func (n *Node) Initialize() error {
 	}
 
 	n.dialectRW = dialectRW
+
+	n.frameEncoder = &frame.Writer{
+		ByteWriter: io.Discard,
+		DialectRW:  dialectRW,
+	}
+	err := n.frameEncoder.Initialize()
+	if err != nil {
+		return err
+	}
+
 	n.channelProviders = make(map[*channelProvider]struct{})
 	n.channels = make(map[*Channel]struct{})
 	n.chNewChannel = make(chan *Channel)
@@ -174,6 +207,7 @@ func (n *Node) Initialize() error {
 	n.chWriteTo = make(chan writeToReq)
 	n.chWriteAll = make(chan any)
 	n.chWriteExcept = make(chan writeExceptReq)
+	n.chFlush = make(chan struct{})
 	n.terminate = make(chan struct{})
 	n.chEvent = make(chan Event)
 	n.done = make(chan struct{})
@@ -207,7 +241,7 @@ func (n *Node) Initialize() error {
 	n.nodeHeartbeat = &nodeHeartbeat{
 		node: n,
 	}
-	err := n.nodeHeartbeat.initialize()
+	err = n.nodeHeartbeat.initialize()
 	if err != nil {
 		if errors.Is(err, errSkip) {
 			n.nodeHeartbeat = nil
@@ -282,6 +316,11 @@ outer:
 				}
 			}
 
+		case <-n.chFlush:
+			for ch := range n.channels {
+				ch.flush()
+			}
+
 		case <-n.terminate:
 			break outer
 		}
@@ -377,6 +416,13 @@ func (n *Node) encodeMessage(msg message.Message) (message.Message, error) {
 			return nil, fmt.Errorf("message is not in the dialect")
 		}
 
+		if n.OutValidate {
+			err := mp.Validate(msg, n.Dialect.Enums)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		msgRaw := mp.Write(msg, n.OutVersion == V2)
 		return msgRaw, nil
 	}
@@ -413,6 +459,8 @@ func (n *Node) WriteMessageTo(channel *Channel, m message.Message) error {
 }
 
 // WriteMessageAll writes a message to all channels.
+// The message is encoded once and the resulting bytes are shared among channels
+// that use the same sequence number.
 func (n *Node) WriteMessageAll(m message.Message) error {
 	m, err := n.encodeMessage(m)
 	if err != nil {
@@ -420,7 +468,7 @@ func (n *Node) WriteMessageAll(m message.Message) error {
 	}
 
 	select {
-	case n.chWriteAll <- m:
+	case n.chWriteAll <- &streamwriter.SharedMessage{Message: m}:
 	case <-n.terminate:
 	}
 
@@ -435,7 +483,7 @@ func (n *Node) WriteMessageExcept(exceptChannel *Channel, m message.Message) err
 	}
 
 	select {
-	case n.chWriteExcept <- writeExceptReq{exceptChannel, m}:
+	case n.chWriteExcept <- writeExceptReq{exceptChannel, &streamwriter.SharedMessage{Message: m}}:
 	case <-n.terminate:
 	}
 
@@ -446,13 +494,13 @@ func (n *Node) WriteMessageExcept(exceptChannel *Channel, m message.Message) err
 // This function is intended only for routing pre-existing frames to other nodes,
 // since all frame fields must be filled manually.
 func (n *Node) WriteFrameTo(channel *Channel, fr frame.Frame) error {
-	err := n.encodeFrame(fr)
+	byts, err := n.frameEncoder.Encode(fr)
 	if err != nil {
 		return err
 	}
 
 	select {
-	case n.chWriteTo <- writeToReq{channel, fr}:
+	case n.chWriteTo <- writeToReq{channel, frameBytes(byts)}:
 	case <-n.terminate:
 	}
 
@@ -463,13 +511,13 @@ func (n *Node) WriteFrameTo(channel *Channel, fr frame.Frame) error {
 // This function is intended only for routing pre-existing frames to other nodes,
 // since all frame fields must be filled manually.
 func (n *Node) WriteFrameAll(fr frame.Frame) error {
-	err := n.encodeFrame(fr)
+	byts, err := n.frameEncoder.Encode(fr)
 	if err != nil {
 		return err
 	}
 
 	select {
-	case n.chWriteAll <- fr:
+	case n.chWriteAll <- frameBytes(byts):
 	case <-n.terminate:
 	}
 
@@ -480,19 +528,29 @@ func (n *Node) WriteFrameAll(fr frame.Frame) error {
 // This function is intended only for routing pre-existing frames to other nodes,
 // since all frame fields must be filled manually.
 func (n *Node) WriteFrameExcept(exceptChannel *Channel, fr frame.Frame) error {
-	err := n.encodeFrame(fr)
+	byts, err := n.frameEncoder.Encode(fr)
 	if err != nil {
 		return err
 	}
 
 	select {
-	case n.chWriteExcept <- writeExceptReq{exceptChannel, fr}:
+	case n.chWriteExcept <- writeExceptReq{exceptChannel, frameBytes(byts)}:
 	case <-n.terminate:
 	}
 
 	return nil
 }
 
+// Flush writes immediately frames that are waiting in the batches of channels
+// with WriteBatching enabled. It can be called after writing latency-sensitive
+// messages, like commands.
+func (n *Node) Flush() {
+	select {
+	case n.chFlush <- struct{}{}:
+	case <-n.terminate:
+	}
+}
+
 func (n *Node) pushEvent(evt Event) {
 	select {
 	case n.chEvent <- evt:
@@ -1,7 +1,6 @@
 package gomavlib
 
 import (
-	"reflect"
 	"time"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
@@ -74,14 +73,15 @@ func (h *nodeHeartbeat) run() {
 	for {
 		select {
 		case <-ticker.C:
-			m := reflect.New(reflect.TypeOf(h.msgHeartbeat).Elem())
-			m.Elem().FieldByName("Type").SetUint(uint64(h.node.HeartbeatSystemType))
-			m.Elem().FieldByName("Autopilot").SetUint(uint64(h.node.HeartbeatAutopilotType))
-			m.Elem().FieldByName("BaseMode").SetUint(0)
-			m.Elem().FieldByName("CustomMode").SetUint(0)
-			m.Elem().FieldByName("SystemStatus").SetUint(4) // MAV_STATE_ACTIVE
-			m.Elem().FieldByName("MavlinkVersion").SetUint(uint64(h.node.Dialect.Version))
-			h.node.WriteMessageAll(m.Interface().(message.Message)) //nolint:errcheck
+			m := newMessageWithFields(h.msgHeartbeat, map[string]uint64{
+				"type":            uint64(h.node.HeartbeatSystemType),
+				"autopilot":       uint64(h.node.HeartbeatAutopilotType),
+				"base_mode":       0,
+				"custom_mode":     0,
+				"system_status":   4, // MAV_STATE_ACTIVE
+				"mavlink_version": uint64(h.node.Dialect.Version),
+			})
+			h.node.WriteMessageAll(m) //nolint:errcheck
 
 		case <-h.terminate:
 			return
@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
 )
 
 func TestNodeHeartbeat(t *testing.T) {
@@ -37,3 +41,58 @@ func TestNodeHeartbeat(t *testing.T) {
 	_, ok = fr.Message().(*MessageHeartbeat)
 	require.Equal(t, true, ok)
 }
+
+func TestNodeHeartbeatDynamic(t *testing.T) {
+	def := &dynamic.MessageDefinition{
+		ID:   0,
+		Name: "HEARTBEAT",
+		Fields: []*dynamic.FieldDefinition{
+			{Name: "type", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_TYPE"}},
+			{Name: "autopilot", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_AUTOPILOT"}},
+			{Name: "base_mode", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_MODE_FLAG"}},
+			{Name: "custom_mode", Type: "uint32_t"},
+			{Name: "system_status", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_STATE"}},
+			{Name: "mavlink_version", Type: "uint8_t"},
+		},
+	}
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	node1 := &Node{
+		Dialect:          testDialect,
+		OutVersion:       V2,
+		OutSystemID:      10,
+		Endpoints:        []Endpoint{&EndpointUDPServer{Address: "127.0.0.1:5600"}},
+		HeartbeatDisable: true,
+	}
+	err = node1.Initialize()
+	require.NoError(t, err)
+	defer node1.Close()
+
+	node2 := &Node{
+		Dialect: &dialect.Dialect{
+			Version:  3,
+			Messages: []message.Message{def.NewMessage()},
+		},
+		OutVersion:             V2,
+		OutSystemID:            11,
+		Endpoints:              []Endpoint{&EndpointUDPClient{Address: "127.0.0.1:5600"}},
+		HeartbeatPeriod:        500 * time.Millisecond,
+		HeartbeatSystemType:    2,
+		HeartbeatAutopilotType: 3,
+	}
+	err = node2.Initialize()
+	require.NoError(t, err)
+	defer node2.Close()
+
+	<-node1.Events()
+	evt := <-node1.Events()
+	fr, ok := evt.(*EventFrame)
+	require.Equal(t, true, ok)
+	require.Equal(t, &MessageHeartbeat{
+		Type:           2,
+		Autopilot:      3,
+		SystemStatus:   4,
+		MavlinkVersion: 3,
+	}, fr.Message())
+}
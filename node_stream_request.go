@@ -1,7 +1,6 @@
 package gomavlib
 
 import (
-	"reflect"
 	"sync"
 	"time"
 
@@ -112,7 +111,7 @@ func (sr *nodeStreamRequest) run() {
 func (sr *nodeStreamRequest) onEventFrame(evt *EventFrame) {
 	// message must be heartbeat and sender must be an ardupilot device
 	if evt.Message().GetID() != 0 ||
-		reflect.ValueOf(evt.Message()).Elem().FieldByName("Autopilot").Uint() != 3 {
+		getUintField(evt.Message(), "autopilot") != 3 {
 		return
 	}
 
@@ -153,13 +152,14 @@ func (sr *nodeStreamRequest) onEventFrame(evt *EventFrame) {
 		}
 
 		for _, stream := range streams {
-			m := reflect.New(reflect.TypeOf(sr.msgRequestDataStream).Elem())
-			m.Elem().FieldByName("TargetSystem").SetUint(uint64(evt.SystemID()))
-			m.Elem().FieldByName("TargetComponent").SetUint(uint64(evt.ComponentID()))
-			m.Elem().FieldByName("ReqStreamId").SetUint(uint64(stream))
-			m.Elem().FieldByName("ReqMessageRate").SetUint(uint64(sr.node.StreamRequestFrequency))
-			m.Elem().FieldByName("StartStop").SetUint(uint64(1))
-			sr.node.WriteMessageTo(evt.Channel, m.Interface().(message.Message)) //nolint:errcheck
+			m := newMessageWithFields(sr.msgRequestDataStream, map[string]uint64{
+				"target_system":    uint64(evt.SystemID()),
+				"target_component": uint64(evt.ComponentID()),
+				"req_stream_id":    uint64(stream),
+				"req_message_rate": uint64(sr.node.StreamRequestFrequency),
+				"start_stop":       1,
+			})
+			sr.node.WriteMessageTo(evt.Channel, m) //nolint:errcheck
 		}
 
 		sr.node.pushEvent(&EventStreamRequested{
@@ -668,3 +668,35 @@ func TestNodeWriteSameToMultiple(t *testing.T) {
 		}, evt)
 	}
 }
+
+func TestNodeWriteValidate(t *testing.T) {
+	node := &Node{
+		Dialect: &dialect.Dialect{
+			Version:  3,
+			Messages: []message.Message{&MessageHeartbeat{}},
+			Enums: map[string]*message.EnumMetadata{
+				"MAV_TYPE": {
+					Name: "MAV_TYPE",
+					Entries: []*message.EnumEntryMetadata{
+						{Name: "MAV_TYPE_GENERIC", Value: 0},
+						{Name: "MAV_TYPE_FIXED_WING", Value: 1},
+					},
+				},
+			},
+		},
+		OutVersion:       V2,
+		OutSystemID:      11,
+		OutValidate:      true,
+		Endpoints:        []Endpoint{&EndpointUDPServer{Address: "127.0.0.1:5600"}},
+		HeartbeatDisable: true,
+	}
+	err := node.Initialize()
+	require.NoError(t, err)
+	defer node.Close()
+
+	err = node.WriteMessageAll(testMessage)
+	require.EqualError(t, err, "field 'type' of message HEARTBEAT: value 7 is not defined by enum MAV_TYPE")
+
+	err = node.WriteMessageAll(&MessageHeartbeat{Type: 1})
+	require.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+package compmetadata
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cache stores downloaded files by their CRC32.
+type Cache interface {
+	// returns a file, and whether it was found.
+	Get(crc uint32) ([]byte, bool)
+
+	// stores a file.
+	Set(crc uint32, buf []byte) error
+}
+
+// MemoryCache is a Cache that stores files in memory.
+type MemoryCache struct {
+	mutex sync.RWMutex
+	files map[uint32][]byte
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(crc uint32) ([]byte, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	buf, ok := c.files[crc]
+	return buf, ok
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(crc uint32, buf []byte) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.files == nil {
+		c.files = make(map[uint32][]byte)
+	}
+	c.files[crc] = buf
+	return nil
+}
+
+// DirCache is a Cache that stores files in a directory.
+type DirCache struct {
+	// path of the directory. It is created if it does not exist.
+	Path string
+}
+
+func (c *DirCache) filePath(crc uint32) string {
+	return filepath.Join(c.Path, fmt.Sprintf("%08x", crc))
+}
+
+// Get implements Cache.
+func (c *DirCache) Get(crc uint32) ([]byte, bool) {
+	buf, err := os.ReadFile(c.filePath(crc))
+	if err != nil {
+		return nil, false
+	}
+	return buf, true
+}
+
+// Set implements Cache.
+func (c *DirCache) Set(crc uint32, buf []byte) error {
+	err := os.MkdirAll(c.Path, 0o755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.filePath(crc), buf, 0o644)
+}
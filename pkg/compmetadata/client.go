@@ -0,0 +1,362 @@
+// Package compmetadata contains a client of the component metadata service.
+//
+// Specification: https://mavlink.io/en/services/component_information.html
+package compmetadata
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/events"
+	"github.com/bluenviron/gomavlib/v4/pkg/ftp"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+const (
+	defaultTimeout = 1 * time.Second
+	defaultRetries = 3
+)
+
+var xzMagic = []byte{0xFD, '7', 'z', 'X', 'Z', 0x00}
+
+// Metadata is the metadata of a component.
+type Metadata struct {
+	// general metadata.
+	General *General
+
+	// content of metadata files, decompressed, by type.
+	Files map[common.COMP_METADATA_TYPE][]byte
+
+	// parameters. They are filled when the component provides parameter metadata.
+	Parameters []*Parameter
+
+	// definitions of events. They are filled when the component provides event metadata.
+	Events *events.Definitions
+}
+
+// Client is a client of the component metadata service.
+// It requests the general metadata of a component, downloads
+// referenced files with MAVLink FTP and verifies their CRC.
+// Messages must be passed to Handle(), that can be called from a different goroutine:
+//
+//	go func() {
+//		for evt := range node.Events() {
+//			client.Handle(evt)
+//		}
+//	}()
+//
+//	md, err := client.Get(ctx)
+type Client struct {
+	// function used to send messages to the component.
+	Write func(msg message.Message) error
+
+	// system and component ID of the component.
+	TargetSystem    uint8
+	TargetComponent uint8
+
+	// (optional) cache of downloaded files.
+	Cache Cache
+
+	// (optional) function used to decompress files compressed with xz.
+	// The standard library does not provide a xz decoder,
+	// therefore a pure-Go one (i.e. github.com/ulikunitz/xz) must be plugged in.
+	// If not provided, compressed files cannot be read.
+	DecompressXZ func(r io.Reader) (io.Reader, error)
+
+	// (optional) time to wait for a response. It defaults to 1 second.
+	Timeout time.Duration
+
+	// (optional) number of times a request is repeated before failing. It defaults to 3.
+	Retries int
+
+	mutex      sync.Mutex
+	ftpClients map[uint8]*ftp.Client
+	responses  chan *common.MessageComponentMetadata
+}
+
+// Initialize initializes a Client.
+func (c *Client) Initialize() error {
+	if c.Write == nil {
+		return fmt.Errorf("Write is not set")
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.Retries == 0 {
+		c.Retries = defaultRetries
+	}
+
+	c.ftpClients = make(map[uint8]*ftp.Client)
+	c.responses = make(chan *common.MessageComponentMetadata, 1)
+
+	return nil
+}
+
+// Handle processes an event of a Node.
+func (c *Client) Handle(evt gomavlib.Event) {
+	if evt, ok := evt.(*gomavlib.EventFrame); ok {
+		c.HandleMessage(evt.SystemID(), evt.ComponentID(), evt.Message())
+	}
+}
+
+// HandleMessage processes a message sent by a system and component.
+func (c *Client) HandleMessage(systemID uint8, componentID uint8, msg message.Message) {
+	if systemID != c.TargetSystem {
+		return
+	}
+
+	switch msg := msg.(type) {
+	case *common.MessageFileTransferProtocol:
+		c.mutex.Lock()
+		fc, ok := c.ftpClients[componentID]
+		c.mutex.Unlock()
+
+		if ok {
+			fc.HandleMessage(systemID, componentID, msg)
+		}
+
+	case *common.MessageComponentMetadata:
+		if componentID == c.TargetComponent {
+			select {
+			case c.responses <- msg:
+			default:
+			}
+		}
+
+	// deprecated message, that contains the same informations
+	case *common.MessageComponentInformation:
+		if componentID == c.TargetComponent {
+			select {
+			case c.responses <- &common.MessageComponentMetadata{
+				TimeBootMs: msg.TimeBootMs,
+				FileCrc:    msg.GeneralMetadataFileCrc,
+				Uri:        msg.GeneralMetadataUri,
+			}:
+			default:
+			}
+		}
+	}
+}
+
+func (c *Client) ftpClient(componentID uint8) (*ftp.Client, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if fc, ok := c.ftpClients[componentID]; ok {
+		return fc, nil
+	}
+
+	fc := &ftp.Client{
+		Write:           c.Write,
+		TargetSystem:    c.TargetSystem,
+		TargetComponent: componentID,
+		Timeout:         c.Timeout,
+		Retries:         c.Retries,
+	}
+	err := fc.Initialize()
+	if err != nil {
+		return nil, err
+	}
+
+	c.ftpClients[componentID] = fc
+	return fc, nil
+}
+
+func (c *Client) requestComponentMetadata(ctx context.Context) (*common.MessageComponentMetadata, error) {
+	// discard responses that were received before the request, i.e. the ones
+	// requested by other clients or the ones of previous requests that timed out.
+	select {
+	case <-c.responses:
+	default:
+	}
+
+	for range c.Retries + 1 {
+		err := c.Write(&common.MessageCommandLong{
+			TargetSystem:    c.TargetSystem,
+			TargetComponent: c.TargetComponent,
+			Command:         common.MAV_CMD_REQUEST_MESSAGE,
+			Param1:          float32((&common.MessageComponentMetadata{}).GetID()),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		timer := time.NewTimer(c.Timeout)
+
+		select {
+		case msg := <-c.responses:
+			timer.Stop()
+			return msg, nil
+
+		case <-timer.C:
+
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("COMPONENT_METADATA not received")
+}
+
+// parseMFTPURI parses a URI in the format mftp://[;comp=<id>]<path>.
+func (c *Client) parseMFTPURI(uri string) (uint8, string, error) {
+	path, ok := strings.CutPrefix(uri, "mftp://")
+	if !ok {
+		return 0, "", fmt.Errorf("unsupported URI '%s'", uri)
+	}
+
+	componentID := c.TargetComponent
+
+	if strings.HasPrefix(path, "[;comp=") {
+		end := strings.IndexByte(path, ']')
+		if end < 0 {
+			return 0, "", fmt.Errorf("invalid URI '%s'", uri)
+		}
+
+		tmp, err := strconv.ParseUint(path[len("[;comp="):end], 10, 8)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid URI '%s'", uri)
+		}
+
+		componentID = uint8(tmp)
+		path = path[end+1:]
+	}
+
+	return componentID, path, nil
+}
+
+// Download downloads a file, verifies its CRC32 and decompresses it.
+// If crc is not zero, the file is stored into and read from the cache.
+func (c *Client) Download(ctx context.Context, uri string, crc uint32) ([]byte, error) {
+	var buf []byte
+
+	if c.Cache != nil && crc != 0 {
+		if cached, ok := c.Cache.Get(crc); ok && crc32.ChecksumIEEE(cached) == crc {
+			buf = cached
+		}
+	}
+
+	if buf == nil {
+		componentID, path, err := c.parseMFTPURI(uri)
+		if err != nil {
+			return nil, err
+		}
+
+		fc, err := c.ftpClient(componentID)
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err = fc.ReadFile(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if crc != 0 {
+			if sum := crc32.ChecksumIEEE(buf); sum != crc {
+				return nil, fmt.Errorf("CRC of %s is %08x, expected %08x", uri, sum, crc)
+			}
+
+			if c.Cache != nil {
+				err = c.Cache.Set(crc, buf)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return c.decompress(uri, buf)
+}
+
+func (c *Client) decompress(uri string, buf []byte) ([]byte, error) {
+	if !bytes.HasPrefix(buf, xzMagic) {
+		return buf, nil
+	}
+
+	if c.DecompressXZ == nil {
+		return nil, fmt.Errorf("%s is compressed with xz, but DecompressXZ is not set", uri)
+	}
+
+	r, err := c.DecompressXZ(bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+func (c *Client) downloadMetadataType(ctx context.Context, mt *MetadataType) ([]byte, error) {
+	buf, err := c.Download(ctx, mt.URI, mt.FileCRC)
+	if err != nil && mt.URIFallback != "" {
+		var err2 error
+		buf, err2 = c.Download(ctx, mt.URIFallback, mt.FileCRCFallback)
+		if err2 == nil {
+			err = nil
+		}
+	}
+	return buf, err
+}
+
+// Get requests the general metadata of the component and downloads
+// files that it references. Files that are not hosted on the vehicle are skipped.
+func (c *Client) Get(ctx context.Context) (*Metadata, error) {
+	res, err := c.requestComponentMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := c.Download(ctx, res.Uri, res.FileCrc)
+	if err != nil {
+		return nil, fmt.Errorf("general metadata: %w", err)
+	}
+
+	general, err := ParseGeneral(bytes.NewReader(buf))
+	if err != nil {
+		return nil, fmt.Errorf("general metadata: %w", err)
+	}
+
+	md := &Metadata{
+		General: general,
+		Files:   map[common.COMP_METADATA_TYPE][]byte{common.COMP_METADATA_TYPE_GENERAL: buf},
+	}
+
+	for _, mt := range general.MetadataTypes {
+		if !strings.HasPrefix(mt.URI, "mftp://") && !strings.HasPrefix(mt.URIFallback, "mftp://") {
+			continue
+		}
+
+		buf, err = c.downloadMetadataType(ctx, mt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", mt.Type, err)
+		}
+
+		md.Files[mt.Type] = buf
+
+		switch mt.Type {
+		case common.COMP_METADATA_TYPE_PARAMETER:
+			md.Parameters, err = ParseParameters(bytes.NewReader(buf))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", mt.Type, err)
+			}
+
+		case common.COMP_METADATA_TYPE_EVENTS:
+			md.Events, err = events.ParseDefinitions(bytes.NewReader(buf))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", mt.Type, err)
+			}
+		}
+	}
+
+	return md, nil
+}
@@ -0,0 +1,290 @@
+package compmetadata_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/compmetadata"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+const testParameters = `{
+  "version": 1,
+  "parameters": [
+    {
+      "name": "COM_ARM_CHK",
+      "type": "Int32",
+      "default": 1,
+      "group": "Commander",
+      "shortDesc": "Arming checks",
+      "min": 0,
+      "max": 1,
+      "values": [
+        {"value": 0, "description": "Disabled"},
+        {"value": 1, "description": "Enabled"}
+      ]
+    }
+  ]
+}`
+
+const testEvents = `{
+  "version": 2,
+  "components": {
+    "1": {
+      "namespace": "px4",
+      "event_groups": {
+        "default": {
+          "events": {
+            "16": {"name": "takeoff", "message": "Takeoff"}
+          }
+        }
+      }
+    }
+  }
+}`
+
+// testVehicle is a minimal vehicle that provides component metadata.
+type testVehicle struct {
+	files       map[string][]byte
+	generalURI  string
+	client      *compmetadata.Client
+	session     []byte
+	ftpRequests int
+}
+
+func (v *testVehicle) write(msg message.Message) error {
+	switch msg := msg.(type) {
+	case *common.MessageCommandLong:
+		if msg.Command == common.MAV_CMD_REQUEST_MESSAGE && msg.Param1 == 397 {
+			v.client.HandleMessage(1, 1, &common.MessageComponentMetadata{
+				FileCrc: crc32.ChecksumIEEE(v.files["/general.json"]),
+				Uri:     v.generalURI,
+			})
+		}
+
+	case *common.MessageFileTransferProtocol:
+		v.ftpRequests++
+		v.handleFTP(msg.TargetComponent, msg.Payload)
+	}
+
+	return nil
+}
+
+func (v *testVehicle) handleFTP(componentID uint8, req [251]uint8) {
+	opcode := common.MAV_FTP_OPCODE(req[3])
+
+	var res [251]uint8
+	binary.LittleEndian.PutUint16(res[0:], binary.LittleEndian.Uint16(req[0:])+1)
+	res[2] = req[2]
+	res[3] = uint8(common.MAV_FTP_OPCODE_ACK)
+	res[5] = uint8(opcode)
+	copy(res[8:12], req[8:12])
+
+	nak := func(code common.MAV_FTP_ERR) {
+		res[3] = uint8(common.MAV_FTP_OPCODE_NAK)
+		res[4] = 1
+		res[12] = uint8(code)
+	}
+
+	switch opcode {
+	case common.MAV_FTP_OPCODE_OPENFILERO:
+		f, ok := v.files[string(req[12:12+req[4]])]
+		if !ok {
+			nak(common.MAV_FTP_ERR_FILENOTFOUND)
+			break
+		}
+		v.session = f
+		res[4] = 4
+		binary.LittleEndian.PutUint32(res[12:], uint32(len(f)))
+
+	case common.MAV_FTP_OPCODE_READFILE:
+		offset := int(binary.LittleEndian.Uint32(req[8:]))
+		if offset >= len(v.session) {
+			nak(common.MAV_FTP_ERR_EOF)
+			break
+		}
+		n := copy(res[12:12+min(int(req[4]), 239)], v.session[offset:])
+		res[4] = uint8(n)
+
+	case common.MAV_FTP_OPCODE_TERMINATESESSION:
+		v.session = nil
+
+	default:
+		nak(common.MAV_FTP_ERR_UNKNOWNCOMMAND)
+	}
+
+	v.client.HandleMessage(1, componentID, &common.MessageFileTransferProtocol{Payload: res})
+}
+
+func newTestVehicle() *testVehicle {
+	files := map[string][]byte{
+		"/params.json": []byte(testParameters),
+		"/events.json": []byte(testEvents),
+	}
+
+	files["/general.json"] = []byte(fmt.Sprintf(`{
+  "version": 1,
+  "vendorName": "testvendor",
+  "modelName": "testmodel",
+  "metadataTypes": [
+    {"type": 1, "uri": "mftp://missing.json", "uriFallback": "mftp:///params.json", "fileCrcFallback": %d},
+    {"type": 4, "uri": "mftp://[;comp=1]/events.json", "fileCrc": %d},
+    {"type": 2, "uri": "https://example.com/commands.json"}
+  ]
+}`, crc32.ChecksumIEEE(files["/params.json"]), crc32.ChecksumIEEE(files["/events.json"])))
+
+	return &testVehicle{
+		files:      files,
+		generalURI: "mftp:///general.json",
+	}
+}
+
+func newTestClient(t *testing.T, v *testVehicle, cache compmetadata.Cache) *compmetadata.Client {
+	c := &compmetadata.Client{
+		Write:           v.write,
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Cache:           cache,
+		Timeout:         50 * time.Millisecond,
+		Retries:         1,
+	}
+	err := c.Initialize()
+	require.NoError(t, err)
+	v.client = c
+	return c
+}
+
+func TestClientGet(t *testing.T) {
+	v := newTestVehicle()
+	c := newTestClient(t, v, nil)
+
+	md, err := c.Get(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "testvendor", md.General.VendorName)
+	require.Equal(t, "testmodel", md.General.ModelName)
+	require.Len(t, md.General.MetadataTypes, 3)
+
+	require.Equal(t, v.files["/general.json"], md.Files[common.COMP_METADATA_TYPE_GENERAL])
+	require.Equal(t, v.files["/params.json"], md.Files[common.COMP_METADATA_TYPE_PARAMETER])
+	require.Equal(t, v.files["/events.json"], md.Files[common.COMP_METADATA_TYPE_EVENTS])
+	require.NotContains(t, md.Files, common.COMP_METADATA_TYPE_COMMANDS)
+
+	require.Len(t, md.Parameters, 1)
+	require.Equal(t, "COM_ARM_CHK", md.Parameters[0].Name)
+	require.Equal(t, "Arming checks", md.Parameters[0].ShortDescription)
+	require.Equal(t, 1.0, *md.Parameters[0].Max)
+	require.Len(t, md.Parameters[0].Values, 2)
+
+	require.NotNil(t, md.Events)
+	require.Equal(t, "takeoff", md.Events.Get(1<<24|16).Name)
+}
+
+func TestClientStaleResponse(t *testing.T) {
+	v := newTestVehicle()
+	c := newTestClient(t, v, nil)
+
+	// response that was not requested by the client
+	c.HandleMessage(1, 1, &common.MessageComponentMetadata{
+		Uri: "mftp:///stale.json",
+	})
+
+	md, err := c.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "testvendor", md.General.VendorName)
+}
+
+func TestClientCache(t *testing.T) {
+	v := newTestVehicle()
+	cache := &compmetadata.DirCache{Path: t.TempDir()}
+	c := newTestClient(t, v, cache)
+
+	_, err := c.Get(context.Background())
+	require.NoError(t, err)
+	requests := v.ftpRequests
+
+	buf, ok := cache.Get(crc32.ChecksumIEEE(v.files["/params.json"]))
+	require.True(t, ok)
+	require.Equal(t, v.files["/params.json"], buf)
+
+	// files are read from the cache
+	c = newTestClient(t, v, cache)
+	md, err := c.Get(context.Background())
+	require.NoError(t, err)
+	require.Len(t, md.Parameters, 1)
+
+	// only the missing file is requested again
+	require.Less(t, v.ftpRequests-requests, requests)
+}
+
+func TestClientDownloadErrors(t *testing.T) {
+	v := newTestVehicle()
+	c := newTestClient(t, v, nil)
+
+	_, err := c.Download(context.Background(), "https://example.com/a.json", 0)
+	require.EqualError(t, err, "unsupported URI 'https://example.com/a.json'")
+
+	_, err = c.Download(context.Background(), "mftp://[;comp=a]/a.json", 0)
+	require.EqualError(t, err, "invalid URI 'mftp://[;comp=a]/a.json'")
+
+	_, err = c.Download(context.Background(), "mftp:///params.json", 1234)
+	require.EqualError(t, err, fmt.Sprintf("CRC of mftp:///params.json is %08x, expected 000004d2",
+		crc32.ChecksumIEEE(v.files["/params.json"])))
+}
+
+func TestClientDecompress(t *testing.T) {
+	v := newTestVehicle()
+	v.files["/compressed.json.xz"] = append([]byte{0xFD, '7', 'z', 'X', 'Z', 0x00}, []byte("content")...)
+	c := newTestClient(t, v, nil)
+
+	_, err := c.Download(context.Background(), "mftp:///compressed.json.xz", 0)
+	require.EqualError(t, err, "mftp:///compressed.json.xz is compressed with xz, but DecompressXZ is not set")
+
+	c.DecompressXZ = func(r io.Reader) (io.Reader, error) {
+		buf, err2 := io.ReadAll(r)
+		if err2 != nil {
+			return nil, err2
+		}
+		return bytes.NewReader(buf[6:]), nil
+	}
+
+	buf, err := c.Download(context.Background(), "mftp:///compressed.json.xz", 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("content"), buf)
+}
+
+func TestClientNoResponse(t *testing.T) {
+	c := &compmetadata.Client{
+		Write:   func(message.Message) error { return nil },
+		Timeout: 10 * time.Millisecond,
+		Retries: 1,
+	}
+	err := c.Initialize()
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background())
+	require.EqualError(t, err, "COMPONENT_METADATA not received")
+}
+
+func TestMemoryCache(t *testing.T) {
+	c := &compmetadata.MemoryCache{}
+
+	_, ok := c.Get(1)
+	require.False(t, ok)
+
+	err := c.Set(1, []byte("test"))
+	require.NoError(t, err)
+
+	buf, ok := c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, []byte("test"), buf)
+}
@@ -0,0 +1,80 @@
+package compmetadata
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+// MetadataType is a metadata file referenced by the general metadata.
+type MetadataType struct {
+	// type of metadata.
+	Type common.COMP_METADATA_TYPE
+
+	// URI of the file.
+	URI string
+
+	// CRC32 of the file. Zero if unknown.
+	FileCRC uint32
+
+	// (optional) URI used when the file is not available at URI.
+	URIFallback string
+
+	// (optional) CRC32 of the fallback file.
+	FileCRCFallback uint32
+}
+
+// General is the general metadata of a component.
+type General struct {
+	Version         int
+	VendorName      string
+	ModelName       string
+	FirmwareVersion string
+	HardwareVersion string
+	MetadataTypes   []*MetadataType
+}
+
+type generalJSON struct {
+	Version         int    `json:"version"`
+	VendorName      string `json:"vendorName"`
+	ModelName       string `json:"modelName"`
+	FirmwareVersion string `json:"firmwareVersion"`
+	HardwareVersion string `json:"hardwareVersion"`
+	MetadataTypes   []struct {
+		Type            uint8  `json:"type"`
+		URI             string `json:"uri"`
+		FileCRC         uint32 `json:"fileCrc"`
+		URIFallback     string `json:"uriFallback"`
+		FileCRCFallback uint32 `json:"fileCrcFallback"`
+	} `json:"metadataTypes"`
+}
+
+// ParseGeneral parses a general metadata file.
+func ParseGeneral(r io.Reader) (*General, error) {
+	var in generalJSON
+	err := json.NewDecoder(r).Decode(&in)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &General{
+		Version:         in.Version,
+		VendorName:      in.VendorName,
+		ModelName:       in.ModelName,
+		FirmwareVersion: in.FirmwareVersion,
+		HardwareVersion: in.HardwareVersion,
+	}
+
+	for _, mt := range in.MetadataTypes {
+		g.MetadataTypes = append(g.MetadataTypes, &MetadataType{
+			Type:            common.COMP_METADATA_TYPE(mt.Type),
+			URI:             mt.URI,
+			FileCRC:         mt.FileCRC,
+			URIFallback:     mt.URIFallback,
+			FileCRCFallback: mt.FileCRCFallback,
+		})
+	}
+
+	return g, nil
+}
@@ -0,0 +1,74 @@
+package compmetadata
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ParameterValue is a value of a parameter that has a description.
+type ParameterValue struct {
+	Value       float64 `json:"value"`
+	Description string  `json:"description"`
+}
+
+// ParameterBit is a bit of a bitmask parameter.
+type ParameterBit struct {
+	Index       int    `json:"index"`
+	Description string `json:"description"`
+}
+
+// Parameter is the metadata of a parameter.
+type Parameter struct {
+	// name of the parameter.
+	Name string `json:"name"`
+
+	// type of the parameter (i.e. Int32, Float).
+	Type string `json:"type"`
+
+	// default value.
+	Default float64 `json:"default"`
+
+	// group and category, that can be used to organize parameters.
+	Group    string `json:"group"`
+	Category string `json:"category"`
+
+	// descriptions.
+	ShortDescription string `json:"shortDesc"`
+	LongDescription  string `json:"longDesc"`
+
+	// units of the value.
+	Units string `json:"units"`
+
+	// (optional) limits and increment of the value.
+	Min       *float64 `json:"min"`
+	Max       *float64 `json:"max"`
+	Increment *float64 `json:"increment"`
+
+	// number of decimal places to show.
+	DecimalPlaces int `json:"decimalPlaces"`
+
+	// whether a reboot is required to apply changes.
+	RebootRequired bool `json:"rebootRequired"`
+
+	// whether the parameter is changed by the component itself.
+	Volatile bool `json:"volatile"`
+
+	// (optional) allowed values.
+	Values []*ParameterValue `json:"values"`
+
+	// (optional) bits, in case of bitmasks.
+	Bitmask []*ParameterBit `json:"bitmask"`
+}
+
+// ParseParameters parses a parameter metadata file.
+func ParseParameters(r io.Reader) ([]*Parameter, error) {
+	var in struct {
+		Parameters []*Parameter `json:"parameters"`
+	}
+	err := json.NewDecoder(r).Decode(&in)
+	if err != nil {
+		return nil, err
+	}
+
+	return in.Parameters, nil
+}
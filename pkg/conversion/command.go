@@ -0,0 +1,668 @@
+package conversion
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+var reNonAlnum = regexp.MustCompile("[^A-Za-z0-9]+")
+
+var tplCommand = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive,misspell,govet,lll
+package {{ .PkgName }}
+
+{{- if .Imports }}
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{- end }}
+
+// Cmd{{ .Cmd.Name }} is the {{ .Cmd.OrigName }} command.
+{{- range .Cmd.Description }}
+//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+type Cmd{{ .Cmd.Name }} struct {
+{{- range .Cmd.Fields }}
+{{- range .Description }}
+	//{{ if . }} {{ . }}{{ end }}
+{{- end }}
+	{{ .Name }} {{ .Type }}
+{{- end }}
+}
+
+// MAVLinkCommand returns the ID of the command.
+func (*Cmd{{ .Cmd.Name }}) MAVLinkCommand() MAV_CMD {
+	return {{ .Cmd.Value }}
+}
+
+{{- if .Cmd.LongLines }}
+
+// ToCommandLong encodes the command into a COMMAND_LONG message.
+func (c *Cmd{{ .Cmd.Name }}) ToCommandLong(targetSystem uint8, targetComponent uint8) *MessageCommandLong {
+	return &MessageCommandLong{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Command:         {{ .Cmd.Value }},
+{{- range .Cmd.LongLines }}
+		{{ . }}
+{{- end }}
+	}
+}
+
+// FromCommandLong decodes the command from a COMMAND_LONG message.
+func (c *Cmd{{ .Cmd.Name }}) FromCommandLong(m *MessageCommandLong) error {
+	if m.Command != {{ .Cmd.Value }} {
+		return fmt.Errorf("wrong command: expected {{ .Cmd.Value }}, got %d", uint64(m.Command))
+	}
+{{- range .Cmd.FromLongLines }}
+	{{ . }}
+{{- end }}
+	return nil
+}
+{{- end }}
+
+{{- if .Cmd.IntLines }}
+
+// ToCommandInt encodes the command into a COMMAND_INT message.
+func (c *Cmd{{ .Cmd.Name }}) ToCommandInt(targetSystem uint8, targetComponent uint8, frame {{ .FrameType }}) *MessageCommandInt {
+	return &MessageCommandInt{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Frame:           frame,
+		Command:         {{ .Cmd.Value }},
+{{- range .Cmd.IntLines }}
+		{{ . }}
+{{- end }}
+	}
+}
+
+// FromCommandInt decodes the command from a COMMAND_INT message.
+func (c *Cmd{{ .Cmd.Name }}) FromCommandInt(m *MessageCommandInt) error {
+	if m.Command != {{ .Cmd.Value }} {
+		return fmt.Errorf("wrong command: expected {{ .Cmd.Value }}, got %d", uint64(m.Command))
+	}
+{{- range .Cmd.FromIntLines }}
+	{{ . }}
+{{- end }}
+	return nil
+}
+{{- end }}
+
+{{- if .Cmd.MissionLines }}
+
+// ToMissionItemInt encodes the command into a MISSION_ITEM_INT message.
+func (c *Cmd{{ .Cmd.Name }}) ToMissionItemInt(targetSystem uint8, targetComponent uint8, seq uint16, frame {{ .FrameType }}) *MessageMissionItemInt {
+	return &MessageMissionItemInt{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Seq:             seq,
+		Frame:           frame,
+		Command:         {{ .Cmd.Value }},
+{{- range .Cmd.MissionLines }}
+		{{ . }}
+{{- end }}
+	}
+}
+
+// FromMissionItemInt decodes the command from a MISSION_ITEM_INT message.
+func (c *Cmd{{ .Cmd.Name }}) FromMissionItemInt(m *MessageMissionItemInt) error {
+	if m.Command != {{ .Cmd.Value }} {
+		return fmt.Errorf("wrong command: expected {{ .Cmd.Value }}, got %d", uint64(m.Command))
+	}
+{{- range .Cmd.FromIntLines }}
+	{{ . }}
+{{- end }}
+	return nil
+}
+{{- end }}
+`))
+
+var tplCommandLink = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive
+package {{ .PkgName }}
+
+import (
+	"{{ .ImportPrefix }}/{{ .DefName }}"
+)
+
+// Cmd{{ .Name }} is the {{ .OrigName }} command.
+type Cmd{{ .Name }} = {{ .DefName }}.Cmd{{ .Name }}
+`))
+
+var tplCommandHelpers = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive
+package {{ .PkgName }}
+
+import (
+	"math"
+)
+
+// cmdIsGlobalFrame returns whether a MAV_FRAME is global,
+// i.e. whether x and y of COMMAND_INT and MISSION_ITEM_INT are latitude and longitude.
+func cmdIsGlobalFrame(frame uint64) bool {
+	switch frame {
+	case {{ range $i, $f := .GlobalFrames }}{{ if $i }},
+		{{ end }}uint64({{ $f }}){{ end }}:
+		return true
+	}
+	return false
+}
+
+// cmdLocationToInt encodes a coordinate into x or y of COMMAND_INT and MISSION_ITEM_INT.
+// Latitude and longitude are expressed in degrees * 1e7, local positions in meters * 1e4.
+func cmdLocationToInt(v float64, frame uint64) int32 {
+	if cmdIsGlobalFrame(frame) {
+		return int32(math.Round(v * 1e7))
+	}
+	return int32(math.Round(v * 1e4))
+}
+
+// cmdLocationFromInt decodes a coordinate from x or y of COMMAND_INT and MISSION_ITEM_INT.
+func cmdLocationFromInt(v int32, frame uint64) float64 {
+	if cmdIsGlobalFrame(frame) {
+		return float64(v) / 1e7
+	}
+	return float64(v) / 1e4
+}
+`))
+
+type cmdParamKind int
+
+const (
+	cmdParamAbsent cmdParamKind = iota
+	cmdParamFloat
+	cmdParamEnum
+	cmdParamLocation
+)
+
+type outCommandField struct {
+	Description []string
+	Name        string
+	Type        string
+}
+
+type outCommandParam struct {
+	kind    cmdParamKind
+	field   string
+	enum    string
+	isNaN   bool
+	literal string
+}
+
+type outCommand struct {
+	OrigName      string
+	Name          string
+	Value         uint64
+	Description   []string
+	Fields        []*outCommandField
+	LongLines     []string
+	FromLongLines []string
+	IntLines      []string
+	FromIntLines  []string
+	MissionLines  []string
+
+	usesMath    bool
+	usesHelpers bool
+}
+
+// isLocationParam returns whether param 5 or 6 of a command contains a coordinate.
+func isLocationParam(p *definitionEnumEntryParam) bool {
+	if p.Units == "degE7" {
+		return true
+	}
+
+	label := strings.ToLower(p.Label)
+	return strings.Contains(label, "latitude") || strings.Contains(label, "longitude") ||
+		strings.Contains(label, "position") || label == "x" || label == "y"
+}
+
+// isLocationEntry returns whether params 5 and 6 of a command are a position,
+// that is scaled when encoded into x and y of COMMAND_INT and MISSION_ITEM_INT.
+// hasLocation defaults to true, but is omitted by many commands that do not have a position,
+// therefore when it is missing, params are inspected.
+func isLocationEntry(entry *definitionEnumEntry) bool {
+	switch entry.HasLocation {
+	case "true":
+		return true
+
+	case "false":
+		return false
+	}
+
+	for _, p := range entry.Params {
+		if (p.Index == 5 || p.Index == 6) && !p.Reserved && isLocationParam(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyParamDescription(desc string) bool {
+	desc = strings.ToLower(strings.Trim(strings.TrimSpace(desc), "."))
+	return desc == "" || desc == "empty" || desc == "reserved"
+}
+
+func commandFieldName(label string, index int, used map[string]struct{}) string {
+	var name string
+	for _, part := range reNonAlnum.Split(label, -1) {
+		if part != "" {
+			name += strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "Param" + strconv.Itoa(index) + name
+	}
+
+	// avoid conflicts with other fields and with methods
+	if _, ok := used[name]; ok || strings.HasPrefix(name, "To") || strings.HasPrefix(name, "From") ||
+		name == "MAVLinkCommand" {
+		name += strconv.Itoa(index)
+	}
+	used[name] = struct{}{}
+
+	return name
+}
+
+func commandName(value *outEnumValue) string {
+	return dialectNameDefToGo(strings.TrimPrefix(value.Name, "MAV_CMD_"))
+}
+
+func processCommand(
+	value *outEnumValue,
+	enums map[string]*outEnum,
+	hasLong bool,
+	hasInt bool,
+	hasMission bool,
+) *outCommand {
+	cmd := &outCommand{
+		OrigName:    value.Name,
+		Name:        commandName(value),
+		Value:       value.Value,
+		Description: value.Description,
+	}
+
+	var params [8]*outCommandParam
+	used := make(map[string]struct{})
+
+	for i := 1; i <= 7; i++ {
+		params[i] = &outCommandParam{kind: cmdParamAbsent, literal: "0"}
+	}
+
+	for _, p := range value.params {
+		if p.Index < 1 || p.Index > 7 {
+			continue
+		}
+
+		if p.Reserved || (p.Label == "" && isEmptyParamDescription(p.Description)) {
+			switch p.Default {
+			case "", "0":
+			case "NaN":
+				params[p.Index].isNaN = true
+			default:
+				if _, err := strconv.ParseFloat(p.Default, 64); err == nil {
+					params[p.Index].literal = p.Default
+				}
+			}
+			continue
+		}
+
+		par := &outCommandParam{
+			field: commandFieldName(p.Label, p.Index, used),
+		}
+		field := &outCommandField{
+			Name:        par.field,
+			Description: parseDescription(p.Description),
+		}
+
+		switch {
+		case p.Enum != "" && enums[p.Enum] != nil:
+			par.kind = cmdParamEnum
+			par.enum = p.Enum
+			field.Type = p.Enum
+
+		case (p.Index == 5 || p.Index == 6) && value.hasLocation:
+			par.kind = cmdParamLocation
+			field.Type = "float64"
+
+		default:
+			par.kind = cmdParamFloat
+			field.Type = "float32"
+		}
+
+		if p.Units != "" {
+			field.Description = append(field.Description, "Units: "+p.Units)
+		}
+
+		params[p.Index] = par
+		cmd.Fields = append(cmd.Fields, field)
+	}
+
+	floatExpr := func(p *outCommandParam) string {
+		switch p.kind {
+		case cmdParamFloat:
+			return "c." + p.field
+		case cmdParamEnum, cmdParamLocation:
+			return "float32(c." + p.field + ")"
+		}
+		if p.isNaN {
+			cmd.usesMath = true
+			return "float32(math.NaN())"
+		}
+		return p.literal
+	}
+
+	fromFloatLine := func(p *outCommandParam, src string) string {
+		switch p.kind {
+		case cmdParamFloat:
+			return "c." + p.field + " = " + src
+		case cmdParamEnum:
+			return "c." + p.field + " = " + p.enum + "(" + src + ")"
+		case cmdParamLocation:
+			return "c." + p.field + " = float64(" + src + ")"
+		}
+		return ""
+	}
+
+	intExpr := func(p *outCommandParam) string {
+		switch p.kind {
+		case cmdParamFloat, cmdParamEnum:
+			return "int32(c." + p.field + ")"
+		case cmdParamLocation:
+			cmd.usesHelpers = true
+			return "cmdLocationToInt(c." + p.field + ", uint64(frame))"
+		}
+		if p.isNaN {
+			cmd.usesMath = true
+			return "math.MaxInt32"
+		}
+		f, _ := strconv.ParseFloat(p.literal, 64)
+		return strconv.FormatInt(int64(f), 10)
+	}
+
+	fromIntLine := func(p *outCommandParam, src string) string {
+		switch p.kind {
+		case cmdParamFloat:
+			return "c." + p.field + " = float32(" + src + ")"
+		case cmdParamEnum:
+			return "c." + p.field + " = " + p.enum + "(" + src + ")"
+		case cmdParamLocation:
+			cmd.usesHelpers = true
+			return "c." + p.field + " = cmdLocationFromInt(" + src + ", uint64(m.Frame))"
+		}
+		return ""
+	}
+
+	if hasLong {
+		for i := 1; i <= 7; i++ {
+			cmd.LongLines = append(cmd.LongLines, "Param"+strconv.Itoa(i)+": "+floatExpr(params[i])+",")
+			if l := fromFloatLine(params[i], "m.Param"+strconv.Itoa(i)); l != "" {
+				cmd.FromLongLines = append(cmd.FromLongLines, l)
+			}
+		}
+	}
+
+	if hasInt || hasMission {
+		var lines []string
+		for i := 1; i <= 4; i++ {
+			lines = append(lines, "Param"+strconv.Itoa(i)+": "+floatExpr(params[i])+",")
+			if l := fromFloatLine(params[i], "m.Param"+strconv.Itoa(i)); l != "" {
+				cmd.FromIntLines = append(cmd.FromIntLines, l)
+			}
+		}
+
+		lines = append(lines,
+			"X: "+intExpr(params[5])+",",
+			"Y: "+intExpr(params[6])+",",
+			"Z: "+floatExpr(params[7])+",")
+
+		for i, src := range []string{"m.X", "m.Y"} {
+			if l := fromIntLine(params[5+i], src); l != "" {
+				cmd.FromIntLines = append(cmd.FromIntLines, l)
+			}
+		}
+		if l := fromFloatLine(params[7], "m.Z"); l != "" {
+			cmd.FromIntLines = append(cmd.FromIntLines, l)
+		}
+
+		if hasInt {
+			cmd.IntLines = lines
+		}
+		if hasMission {
+			cmd.MissionLines = lines
+		}
+	}
+
+	return cmd
+}
+
+type commandTargets struct {
+	hasLong      bool
+	hasInt       bool
+	hasMission   bool
+	globalFrames []string
+}
+
+// getCommandTargets returns the messages that commands can be encoded into,
+// among the ones of a set of definitions. When defNames is nil, all definitions are used.
+func getCommandTargets(
+	outDefs []*outDefinition,
+	frameEnum *outEnum,
+	defNames map[string]struct{},
+) *commandTargets {
+	isIncluded := func(name string) bool {
+		if defNames == nil {
+			return true
+		}
+		_, ok := defNames[name]
+		return ok
+	}
+
+	t := &commandTargets{}
+
+	for _, def := range outDefs {
+		if !isIncluded(def.Name) {
+			continue
+		}
+
+		for _, msg := range def.Messages {
+			switch msg.OrigName {
+			case "COMMAND_LONG":
+				t.hasLong = true
+
+			case "COMMAND_INT":
+				t.hasInt = true
+
+			case "MISSION_ITEM_INT":
+				t.hasMission = true
+			}
+		}
+	}
+
+	// global frames are taken from MAV_FRAME, that is available in every package
+	// that contains COMMAND_INT or MISSION_ITEM_INT
+	if frameEnum != nil {
+		for _, v := range frameEnum.Values {
+			if isIncluded(v.defName) && strings.HasPrefix(v.Name, "MAV_FRAME_GLOBAL") {
+				t.globalFrames = append(t.globalFrames, v.Name)
+			}
+		}
+	}
+
+	if len(t.globalFrames) == 0 {
+		t.hasInt = false
+		t.hasMission = false
+	}
+
+	return t
+}
+
+// includedDefinitions returns the names of a definition and of the definitions it includes, recursively.
+func includedDefinitions(outDefs []*outDefinition, name string) map[string]struct{} {
+	byName := make(map[string]*outDefinition)
+	for _, def := range outDefs {
+		byName[def.Name] = def
+	}
+
+	ret := make(map[string]struct{})
+
+	var visit func(n string)
+	visit = func(n string) {
+		if _, ok := ret[n]; ok {
+			return
+		}
+		ret[n] = struct{}{}
+
+		if def, ok := byName[n]; ok {
+			for _, inc := range def.includes {
+				visit(inc)
+			}
+		}
+	}
+	visit(name)
+
+	return ret
+}
+
+func writeCommands(
+	dir string,
+	defName string,
+	outDefs []*outDefinition,
+	enums map[string]*outEnum,
+	link bool,
+	importPrefix string,
+) error {
+	enum, ok := enums["MAV_CMD"]
+	if !ok {
+		return nil
+	}
+
+	msgs := make(map[string]*outMessage)
+	for _, def := range outDefs {
+		for _, msg := range def.Messages {
+			msgs[msg.OrigName] = msg
+		}
+	}
+
+	targets := getCommandTargets(outDefs, enums["MAV_FRAME"], nil)
+
+	// frame fields have the MAV_FRAME type of the package that contains the message
+	frameType := "MAV_FRAME"
+	var frameImport string
+	for _, name := range []string{"COMMAND_INT", "MISSION_ITEM_INT"} {
+		if msg := msgs[name]; msg != nil && link && msg.DefName != defName {
+			frameType = msg.DefName + ".MAV_FRAME"
+			frameImport = importPrefix + "/" + msg.DefName
+		}
+	}
+
+	processed := make(map[string]struct{})
+	usesHelpers := false
+
+	for _, value := range enum.Values {
+		if _, ok := processed[value.Name]; ok {
+			continue
+		}
+		processed[value.Name] = struct{}{}
+
+		// the layout of commands whose parameters are not described is unknown
+		if len(value.params) == 0 {
+			continue
+		}
+
+		fpath := filepath.Join(dir, "cmd_"+strings.ToLower(strings.TrimPrefix(value.Name, "MAV_CMD_"))+".go")
+
+		// in case of linked definitions, commands of included definitions are aliases of the commands
+		// of the package of the included definition, as long as they can be encoded into the same messages.
+		if link && value.defName != defName {
+			defTargets := getCommandTargets(outDefs, enums["MAV_FRAME"],
+				includedDefinitions(outDefs, value.defName))
+
+			if defTargets.hasLong == targets.hasLong &&
+				defTargets.hasInt == targets.hasInt &&
+				defTargets.hasMission == targets.hasMission {
+				err := writeFormattedTemplate(fpath, tplCommandLink, map[string]any{
+					"PkgName":      defName,
+					"ImportPrefix": importPrefix,
+					"DefName":      value.defName,
+					"Name":         commandName(value),
+					"OrigName":     value.Name,
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		cmd := processCommand(value, enums, targets.hasLong, targets.hasInt, targets.hasMission)
+
+		var imports []string
+		if targets.hasLong || targets.hasInt || targets.hasMission {
+			imports = append(imports, "fmt")
+		}
+		if cmd.usesMath {
+			imports = append(imports, "math")
+		}
+		if frameImport != "" && (targets.hasInt || targets.hasMission) {
+			imports = append(imports, frameImport)
+		}
+		sort.Strings(imports)
+
+		if cmd.usesHelpers {
+			usesHelpers = true
+		}
+
+		err := writeFormattedTemplate(fpath, tplCommand, map[string]any{
+			"PkgName":   defName,
+			"Cmd":       cmd,
+			"Imports":   imports,
+			"FrameType": frameType,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if usesHelpers {
+		return writeFormattedTemplate(
+			filepath.Join(dir, "cmd_helpers.go"),
+			tplCommandHelpers,
+			map[string]any{
+				"PkgName":      defName,
+				"GlobalFrames": targets.globalFrames,
+			})
+	}
+
+	return nil
+}
+
+// writeFormattedTemplate executes a template and writes its output, formatted with gofmt.
+func writeFormattedTemplate(fpath string, tpl *template.Template, args map[string]any) error {
+	var buf bytes.Buffer
+	err := tpl.Execute(&buf, args)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(fpath), err)
+	}
+
+	return os.WriteFile(fpath, formatted, 0o644)
+}
@@ -3,7 +3,10 @@ package conversion
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"html"
 	"io"
 	"net/http"
 	"net/url"
@@ -15,6 +18,8 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
 )
 
 const (
@@ -29,6 +34,11 @@ var (
 var tplDialect = template.Must(template.New("").Parse(
 	`// Package {{ .PkgName }} contains the {{ .PkgName }} dialect.
 //
+{{- if .SourceRevision }}
+// Source revision: {{ .SourceRevision }}
+{{- end }}
+// Definitions hash (SHA256): {{ .Hash }}
+//
 //autogenerated:yes
 package {{ .PkgName }}
 
@@ -51,6 +61,115 @@ var dial = &dialect.Dialect{
 {{- end }}
 {{- end }}
 	},
+	Enums: enums,
+}
+`))
+
+var tplMessageTable = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive
+package {{ .PkgName }}
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// MessageTable contains the CRC extra, the length and the target offsets
+// of all messages of the dialect. It can be used to validate and route frames
+// without decoding them.
+var MessageTable = dialect.MessageTable{
+{{- range .Messages }}
+	{{ .ID }}: {CRCExtra: {{ .CRCExtra }}, MinLength: {{ .SizeNormal }}, MaxLength: {{ .SizeExtended }}, ` +
+		`TargetSystemOffset: {{ .TargetSystemOffset }}, TargetComponentOffset: {{ .TargetComponentOffset }}},
+{{- end }}
+}
+`))
+
+var tplMetadata = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive,misspell,lll
+package {{ .PkgName }}
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+{{- range .Messages }}
+	{{ .ID }}: {
+		ID:          {{ .ID }},
+		Name:        {{ printf "%q" .Name }},
+		Description: {{ printf "%q" .Description }},
+{{- if .Deprecated }}
+		Deprecated:  true,
+{{- end }}
+{{- if .DeprecatedSince }}
+		DeprecatedSince: {{ printf "%q" .DeprecatedSince }},
+{{- end }}
+{{- if .ReplacedBy }}
+		ReplacedBy: {{ printf "%q" .ReplacedBy }},
+{{- end }}
+{{- if .WIP }}
+		WIP:         true,
+{{- end }}
+		Fields: []*message.FieldMetadata{
+{{- range .Fields }}
+			{Name: {{ printf "%q" .Name }}, Type: {{ printf "%q" .Type }}
+{{- if .ArrayLength }}, ArrayLength: {{ .ArrayLength }}{{ end }}
+{{- if .Extension }}, Extension: true{{ end }}
+{{- if .Enum }}, Enum: {{ printf "%q" .Enum }}{{ end }}
+{{- if .Bitmask }}, Bitmask: true{{ end }}
+{{- if .Units }}, Units: {{ printf "%q" .Units }}{{ end }}
+{{- if .Description }}, Description: {{ printf "%q" .Description }}{{ end }}
+{{- if .Invalid }}, Invalid: {{ printf "%q" .Invalid }}{{ end }}
+{{- if .Multiplier }}, Multiplier: {{ printf "%q" .Multiplier }}{{ end }}
+{{- if .MinValue }}, MinValue: {{ printf "%q" .MinValue }}{{ end }}
+{{- if .MaxValue }}, MaxValue: {{ printf "%q" .MaxValue }}{{ end }}
+{{- if .Increment }}, Increment: {{ printf "%q" .Increment }}{{ end }}
+{{- if .Instance }}, Instance: true{{ end }}
+{{- if .PrintFormat }}, PrintFormat: {{ printf "%q" .PrintFormat }}{{ end }}},
+{{- end }}
+		},
+	},
+{{- end }}
+}
+`))
+
+var tplEnums = template.Must(template.New("").Parse(
+	`//autogenerated:yes
+//nolint:revive,misspell,lll
+package {{ .PkgName }}
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{
+{{- range .Enums }}
+	{{ printf "%q" .Name }}: {
+		Name:        {{ printf "%q" .Name }},
+		Description: {{ printf "%q" .Description }},
+{{- if .Bitmask }}
+		Bitmask:     true,
+{{- end }}
+{{- if .Deprecated }}
+		Deprecated:  true,
+{{- end }}
+{{- if .WIP }}
+		WIP:         true,
+{{- end }}
+		Entries: []*message.EnumEntryMetadata{
+{{- range .Entries }}
+			{Name: {{ printf "%q" .Name }}, Value: {{ .Value }}
+{{- if .Description }}, Description: {{ printf "%q" .Description }}{{ end }}
+{{- if .Deprecated }}, Deprecated: true{{ end }}
+{{- if .WIP }}, WIP: true{{ end }}},
+{{- end }}
+		},
+	},
+{{- end }}
 }
 `))
 
@@ -62,11 +181,11 @@ package {{ .PkgName }}
 {{- if .Link }}
 
 import (
-	"github.com/bluenviron/gomavlib/v4/pkg/dialects/{{ .Enum.DefName }}"
+	"{{ .ImportPrefix }}/{{ .Enum.DefName }}"
 )
 
 {{- range .Enum.Description }}
-// {{ . }}
+//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 type {{ .Enum.Name }} = {{ .Enum.DefName }}.{{ .Enum.Name }}
 
@@ -74,7 +193,7 @@ const (
 {{- $en := .Enum }}
 {{- range .Enum.Values }}
 {{- range .Description }}
-		// {{ . }}
+		//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 		{{ .Name }} {{ $en.Name }} = {{ $en.DefName }}.{{ .Name }}
 {{- end }}
@@ -91,7 +210,7 @@ import (
 )
 
 {{- range .Enum.Description }}
-// {{ . }}
+//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 type {{ .Enum.Name }} uint64
 
@@ -99,19 +218,17 @@ const (
 {{- $pn := .Enum.Name }}
 {{- range .Enum.Values }}
 {{- range .Description }}
-	// {{ . }}
+	//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 	{{ .Name }} {{ $pn }} = {{ .Value }}
 {{- end }}
 )
 
-{{- if .Enum.Bitmask }}
 var values_{{ .Enum.Name }} = []{{ .Enum.Name }}{
 {{- range .Enum.Values }}
 	{{ .Name }},
 {{- end }}
 }
-{{- end }}
 
 var value_to_label_{{ .Enum.Name }} = map[{{ .Enum.Name }}]string{
 {{- range .Enum.Values }}
@@ -178,6 +295,45 @@ func (e {{ .Enum.Name }}) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func ({{ .Enum.Name }}) Values() []{{ .Enum.Name }} {
+	return append([]{{ .Enum.Name }}(nil), values_{{ .Enum.Name }}...)
+}
+{{- if .Enum.Bitmask }}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e {{ .Enum.Name }}) IsValid() bool {
+	var mask {{ .Enum.Name }}
+	for _, val := range values_{{ .Enum.Name }} {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e {{ .Enum.Name }}) Flags() []{{ .Enum.Name }} {
+	var flags []{{ .Enum.Name }}
+	for _, val := range values_{{ .Enum.Name }} {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e {{ .Enum.Name }}) Has(flags {{ .Enum.Name }}) bool {
+	return e&flags == flags
+}
+{{- else }}
+
+// IsValid returns whether the value is defined by the enum.
+func (e {{ .Enum.Name }}) IsValid() bool {
+	_, ok := value_to_label_{{ .Enum.Name }}[e]
+	return ok
+}
+{{- end }}
 {{- end }}
 `))
 
@@ -189,23 +345,32 @@ package {{ .PkgName }}
 {{- if .Link }}
 
 import (
-	"github.com/bluenviron/gomavlib/v4/pkg/dialects/{{ .Msg.DefName }}"
+	"{{ .ImportPrefix }}/{{ .Msg.DefName }}"
 )
 
 {{- range .Msg.Description }}
-// {{ . }}
+//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 type Message{{ .Msg.Name }} = {{ .Msg.DefName }}.Message{{ .Msg.Name }}
 
 {{- else }}
 
+{{- if .Msg.Imports }}
+
+import (
+{{- range .Msg.Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{ end }}
+
 {{- range .Msg.Description }}
-// {{ . }}
+//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 type Message{{ .Msg.Name }} struct {
 {{- range .Msg.Fields }}
 {{- range .Description }}
-	// {{ . }}
+	//{{ if . }} {{ . }}{{ end }}
 {{- end }}
 	{{ .Line }}
 {{- end }}
@@ -216,6 +381,38 @@ func (*Message{{ .Msg.Name }}) GetID() uint32 {
 	return {{ .Msg.ID }}
 }
 
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*Message{{ .Msg.Name }}) MAVLinkCRCExtra() byte {
+	return {{ .Msg.CRCExtra }}
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*Message{{ .Msg.Name }}) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return {{ .Msg.SizeExtended }}
+	}
+	return {{ .Msg.SizeNormal }}
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *Message{{ .Msg.Name }}) MarshalMAVLink(buf []byte, isV2 bool) {
+{{- range .Msg.MarshalLines }}
+	{{ . }}
+{{- end }}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *Message{{ .Msg.Name }}) UnmarshalMAVLink(buf []byte, isV2 bool) {
+{{- range .Msg.UnmarshalLines }}
+	{{ . }}
+{{- end }}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*Message{{ .Msg.Name }}) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[{{ .Msg.ID }}]
+}
+
 {{- end }}
 `))
 
@@ -274,6 +471,37 @@ func parseDescription(in string) []string {
 	return lines
 }
 
+// appendStatus appends to a description comment lines that describe
+// whether an item is deprecated or work in progress.
+func appendStatus(lines []string, deprecated *definitionDeprecated, wip bool) []string {
+	if deprecated != nil {
+		line := "Deprecated:"
+		if deprecated.Since != "" {
+			line += " since " + deprecated.Since + ","
+		}
+		if deprecated.ReplacedBy != "" {
+			line += " replaced by " + deprecated.ReplacedBy + "."
+		} else {
+			line += " this item will be removed."
+		}
+
+		if len(lines) != 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, line)
+		lines = append(lines, parseDescription(deprecated.Description)...)
+	}
+
+	if wip {
+		if len(lines) != 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "WIP: this item is a work in progress and may change or be removed.")
+	}
+
+	return lines
+}
+
 func uintPow(base, exp uint64) uint64 {
 	result := uint64(1)
 	for {
@@ -294,6 +522,13 @@ type outEnumValue struct {
 	Value       uint64
 	Name        string
 	Description []string
+
+	defName     string
+	description string
+	hasLocation bool
+	params      []*definitionEnumEntryParam
+	deprecated  bool
+	wip         bool
 }
 
 type outEnum struct {
@@ -302,26 +537,80 @@ type outEnum struct {
 	Description []string
 	Values      []*outEnumValue
 	Bitmask     bool
+
+	description string
+	deprecated  bool
+	wip         bool
 }
 
 type outField struct {
 	Description []string
 	Line        string
+
+	name      string
+	origName  string
+	wireType  string
+	arrayLen  int
+	enum      string
+	extension bool
+	metadata  *message.FieldMetadata
 }
 
 type outMessage struct {
-	DefName     string
-	OrigName    string
-	Name        string
-	Description []string
-	ID          int
-	Fields      []*outField
+	DefName               string
+	OrigName              string
+	Name                  string
+	Description           []string
+	ID                    int
+	Fields                []*outField
+	CRCExtra              byte
+	SizeNormal            int
+	SizeExtended          int
+	TargetSystemOffset    int
+	TargetComponentOffset int
+	Metadata              *message.MessageMetadata
+	Imports               []string
+	MarshalLines          []string
+	UnmarshalLines        []string
+
+	deprecated bool
+	wip        bool
 }
 
 type outDefinition struct {
 	Name     string
 	Enums    []*outEnum
 	Messages []*outMessage
+
+	includes []string
+	hash     [sha256.Size]byte
+}
+
+// DefaultImportPrefix is the default import path prefix of linked dialects.
+const DefaultImportPrefix = "github.com/bluenviron/gomavlib/v4/pkg/dialects"
+
+func isRemoteAddr(defAddr string) bool {
+	u, err := url.ParseRequestURI(defAddr)
+	return err == nil && u.Scheme != ""
+}
+
+// resolveInclude finds a local included definition.
+// It is searched in the directory of the including definition, in include directories
+// and in the current directory.
+func resolveInclude(addrPath string, subDefAddr string, includeDirs []string) string {
+	candidates := []string{filepath.Join(addrPath, subDefAddr)}
+	for _, dir := range includeDirs {
+		candidates = append(candidates, filepath.Join(dir, subDefAddr))
+	}
+	candidates = append(candidates, subDefAddr)
+
+	for _, c := range candidates {
+		if _, err := os.Stat(c); err == nil {
+			return c
+		}
+	}
+
+	return candidates[0]
 }
 
 func processDefinition(
@@ -329,6 +618,8 @@ func processDefinition(
 	processedDefs map[string]struct{},
 	isRemote bool,
 	defAddr string,
+	includeDirs []string,
+	logW io.Writer,
 ) ([]*outDefinition, error) {
 	// skip already processed
 	if _, ok := processedDefs[defAddr]; ok {
@@ -336,7 +627,7 @@ func processDefinition(
 	}
 	processedDefs[defAddr] = struct{}{}
 
-	fmt.Fprintf(os.Stderr, "processing definition %s\n", defAddr)
+	fmt.Fprintf(logW, "processing definition %s\n", defAddr)
 
 	content, err := getDefinition(isRemote, defAddr)
 	if err != nil {
@@ -351,19 +642,23 @@ func processDefinition(
 	addrPath, _ := filepath.Split(defAddr)
 
 	var outDefs []*outDefinition
+	var includes []string
 
 	// includes
 	for _, subDefAddr := range def.Includes {
 		// prepend url to remote address
 		if isRemote {
 			subDefAddr = addrPath + subDefAddr
+		} else {
+			subDefAddr = resolveInclude(addrPath, subDefAddr, includeDirs)
 		}
 		var subDefs []*outDefinition
-		subDefs, err = processDefinition(version, processedDefs, isRemote, subDefAddr)
+		subDefs, err = processDefinition(version, processedDefs, isRemote, subDefAddr, includeDirs, logW)
 		if err != nil {
 			return nil, err
 		}
 		outDefs = append(outDefs, subDefs...)
+		includes = append(includes, defAddrToName(subDefAddr))
 	}
 
 	// version (process it after includes, in order to allow overriding it)
@@ -372,7 +667,9 @@ func processDefinition(
 	}
 
 	outDef := &outDefinition{
-		Name: defAddrToName(defAddr),
+		Name:     defAddrToName(defAddr),
+		includes: includes,
+		hash:     sha256.Sum256(content),
 	}
 
 	// enums
@@ -380,8 +677,11 @@ func processDefinition(
 		oute := &outEnum{
 			DefName:     outDef.Name,
 			Name:        enum.Name,
-			Description: parseDescription(enum.Description),
+			Description: appendStatus(parseDescription(enum.Description), enum.Deprecated, enum.WIP != nil),
 			Bitmask:     enum.Bitmask,
+			description: html.UnescapeString(strings.Join(parseDescription(enum.Description), " ")),
+			deprecated:  enum.Deprecated != nil,
+			wip:         enum.WIP != nil,
 		}
 
 		for _, entry := range enum.Entries {
@@ -433,7 +733,13 @@ func processDefinition(
 			oute.Values = append(oute.Values, &outEnumValue{
 				Value:       v,
 				Name:        entry.Name,
-				Description: parseDescription(entry.Description),
+				Description: appendStatus(parseDescription(entry.Description), entry.Deprecated, entry.WIP != nil),
+				defName:     outDef.Name,
+				description: html.UnescapeString(strings.Join(parseDescription(entry.Description), " ")),
+				hasLocation: isLocationEntry(entry),
+				params:      entry.Params,
+				deprecated:  entry.Deprecated != nil,
+				wip:         entry.WIP != nil,
 			})
 		}
 
@@ -509,15 +815,55 @@ func processMessage(defName string, msgDef *definitionMessage) (*outMessage, err
 		outMsg.Fields = append(outMsg.Fields, outField)
 	}
 
+	outMsg.Metadata = &message.MessageMetadata{
+		ID:          uint32(msgDef.ID),
+		Name:        msgDef.Name,
+		Description: html.UnescapeString(strings.Join(outMsg.Description, " ")),
+		Deprecated:  msgDef.Deprecated != nil,
+		WIP:         msgDef.WIP,
+	}
+	if msgDef.Deprecated != nil {
+		outMsg.Metadata.DeprecatedSince = msgDef.Deprecated.Since
+		outMsg.Metadata.ReplacedBy = msgDef.Deprecated.ReplacedBy
+	}
+	for _, f := range outMsg.Fields {
+		outMsg.Metadata.Fields = append(outMsg.Metadata.Fields, f.metadata)
+	}
+
+	outMsg.Description = appendStatus(outMsg.Description, msgDef.Deprecated, msgDef.WIP)
+	outMsg.deprecated = msgDef.Deprecated != nil
+	outMsg.wip = msgDef.WIP
+
+	generateMarshalers(outMsg)
+
 	return outMsg, nil
 }
 
 func processField(fieldDef *dialectField) (*outField, error) {
 	outF := &outField{
 		Description: parseDescription(fieldDef.Description),
+		origName:    fieldDef.Name,
+		enum:        fieldDef.Enum,
+		extension:   fieldDef.Extension,
 	}
 	tags := make(map[string]string)
 
+	outF.metadata = &message.FieldMetadata{
+		Name:        fieldDef.Name,
+		Extension:   fieldDef.Extension,
+		Enum:        fieldDef.Enum,
+		Bitmask:     fieldDef.Display == "bitmask",
+		Units:       fieldDef.Units,
+		Description: html.UnescapeString(strings.Join(parseDescription(fieldDef.Description), " ")),
+		Invalid:     fieldDef.Invalid,
+		Multiplier:  fieldDef.Multiplier,
+		MinValue:    fieldDef.MinValue,
+		MaxValue:    fieldDef.MaxValue,
+		Increment:   fieldDef.Increment,
+		Instance:    fieldDef.Instance,
+		PrintFormat: fieldDef.PrintFormat,
+	}
+
 	newname := dialectNameDefToGo(fieldDef.Name)
 
 	// name conversion is not univoque: add tag
@@ -526,6 +872,7 @@ func processField(fieldDef *dialectField) (*outField, error) {
 	}
 
 	outF.Line += newname
+	outF.name = newname
 
 	typ := fieldDef.Type
 	arrayLen := ""
@@ -545,8 +892,17 @@ func processField(fieldDef *dialectField) (*outField, error) {
 			arrayLen = matches[2]
 			typ = matches[1]
 		}
+		outF.arrayLen, _ = strconv.Atoi(matches[2])
+	} else if typ == "char" {
+		// a single char is handled like a string of length 1,
+		// consistently with message.ReadWriter
+		outF.arrayLen = 1
 	}
 
+	outF.wireType = typ
+	outF.metadata.Type = typ
+	outF.metadata.ArrayLength = outF.arrayLen
+
 	// extension
 	if fieldDef.Extension {
 		tags["mavext"] = "true"
@@ -554,7 +910,7 @@ func processField(fieldDef *dialectField) (*outField, error) {
 
 	typ = dialectTypeToGo[typ]
 	if typ == "" {
-		return nil, fmt.Errorf("unknown type: %s", typ)
+		return nil, fmt.Errorf("unknown type: %s", fieldDef.Type)
 	}
 
 	outF.Line += " "
@@ -579,16 +935,29 @@ func processField(fieldDef *dialectField) (*outField, error) {
 	return outF, nil
 }
 
+// definitionsHash computes a hash of the content of definitions,
+// in order to check whether generated code is up to date.
+func definitionsHash(outDefs []*outDefinition) string {
+	h := sha256.New()
+	for _, def := range outDefs {
+		h.Write(def.hash[:])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func writeDialect(
 	dir string,
 	defName string,
 	version string,
+	sourceRevision string,
 	outDefs []*outDefinition,
 	enums map[string]*outEnum,
 ) error {
 	var buf bytes.Buffer
 	err := tplDialect.Execute(&buf, map[string]any{
-		"PkgName": defName,
+		"PkgName":        defName,
+		"SourceRevision": sourceRevision,
+		"Hash":           definitionsHash(outDefs),
 		"Version": func() int {
 			ret, _ := strconv.Atoi(version)
 			return ret
@@ -603,17 +972,150 @@ func writeDialect(
 	return os.WriteFile(filepath.Join(dir, "dialect.go"), buf.Bytes(), 0o644)
 }
 
+func writeMessageTable(
+	dir string,
+	defName string,
+	outDefs []*outDefinition,
+) error {
+	var msgs []*outMessage
+	for _, def := range outDefs {
+		msgs = append(msgs, def.Messages...)
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].ID < msgs[j].ID
+	})
+
+	var buf bytes.Buffer
+	err := tplMessageTable.Execute(&buf, map[string]any{
+		"PkgName":  defName,
+		"Messages": msgs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "messagetable.go"), buf.Bytes(), 0o644)
+}
+
+func writeMetadata(
+	dir string,
+	defName string,
+	outDefs []*outDefinition,
+	link bool,
+) error {
+	var msgs []*message.MessageMetadata
+	for _, def := range outDefs {
+		for _, msg := range def.Messages {
+			// skip messages that are defined in other packages
+			if link && defName != msg.DefName {
+				continue
+			}
+			msgs = append(msgs, msg.Metadata)
+		}
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].ID < msgs[j].ID
+	})
+
+	var buf bytes.Buffer
+	err := tplMetadata.Execute(&buf, map[string]any{
+		"PkgName":  defName,
+		"Messages": msgs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "metadata.go"), buf.Bytes(), 0o644)
+}
+
+// enumsMetadata returns metadata of enums, sorted by name.
+func enumsMetadata(enums map[string]*outEnum) []*message.EnumMetadata {
+	ret := make([]*message.EnumMetadata, 0, len(enums))
+
+	for _, enum := range enums {
+		md := &message.EnumMetadata{
+			Name:        enum.Name,
+			Description: enum.description,
+			Bitmask:     enum.Bitmask,
+			Deprecated:  enum.deprecated,
+			WIP:         enum.wip,
+		}
+
+		for _, v := range enum.Values {
+			md.Entries = append(md.Entries, &message.EnumEntryMetadata{
+				Name:        v.Name,
+				Value:       v.Value,
+				Description: v.description,
+				Deprecated:  v.deprecated,
+				WIP:         v.wip,
+			})
+		}
+
+		ret = append(ret, md)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+
+	return ret
+}
+
+func writeEnums(
+	dir string,
+	defName string,
+	enums map[string]*outEnum,
+) error {
+	var buf bytes.Buffer
+	err := tplEnums.Execute(&buf, map[string]any{
+		"PkgName": defName,
+		"Enums":   enumsMetadata(enums),
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "enums.go"), buf.Bytes(), 0o644)
+}
+
+// fillBitmasks marks fields that use bitmask enums.
+func fillBitmasks(outDefs []*outDefinition) {
+	bitmasks := make(map[string]struct{})
+	for _, def := range outDefs {
+		for _, enum := range def.Enums {
+			if enum.Bitmask {
+				bitmasks[enum.Name] = struct{}{}
+			}
+		}
+	}
+
+	for _, def := range outDefs {
+		for _, msg := range def.Messages {
+			for _, f := range msg.Fields {
+				if _, ok := bitmasks[f.enum]; ok {
+					f.metadata.Bitmask = true
+				}
+			}
+		}
+	}
+}
+
 func writeEnum(
 	dir string,
 	defName string,
 	enum *outEnum,
 	link bool,
+	importPrefix string,
 ) error {
 	var buf bytes.Buffer
 	err := tplEnum.Execute(&buf, map[string]any{
-		"PkgName": defName,
-		"Enum":    enum,
-		"Link":    link && defName != enum.DefName,
+		"PkgName":      defName,
+		"Enum":         enum,
+		"Link":         link && defName != enum.DefName,
+		"ImportPrefix": importPrefix,
 	})
 	if err != nil {
 		return err
@@ -627,12 +1129,14 @@ func writeMessage(
 	defName string,
 	msg *outMessage,
 	link bool,
+	importPrefix string,
 ) error {
 	var buf bytes.Buffer
 	err := tplMessage.Execute(&buf, map[string]any{
-		"PkgName": defName,
-		"Msg":     msg,
-		"Link":    link && defName != msg.DefName,
+		"PkgName":      defName,
+		"Msg":          msg,
+		"Link":         link && defName != msg.DefName,
+		"ImportPrefix": importPrefix,
 	})
 	if err != nil {
 		return err
@@ -641,27 +1145,120 @@ func writeMessage(
 	return os.WriteFile(filepath.Join(dir, "message_"+strings.ToLower(msg.OrigName)+".go"), buf.Bytes(), 0o644)
 }
 
-// Convert converts a XML definition into a Golang definition.
-func Convert(path string, link bool) error {
-	version := ""
-	processedDefs := make(map[string]struct{})
-	_, err := url.ParseRequestURI(path)
-	isRemote := (err == nil)
-	defName := defAddrToName(path)
+// excludeItems removes messages, enums and enum entries that are work in progress or deprecated.
+// Enums that are used by remaining messages are kept.
+func excludeItems(outDefs []*outDefinition, wip bool, deprecated bool) {
+	excluded := func(isDeprecated bool, isWIP bool) bool {
+		return (wip && isWIP) || (deprecated && isDeprecated)
+	}
 
-	_, err = os.Stat(defName)
-	if !os.IsNotExist(err) {
-		return fmt.Errorf("directory '%s' already exists", defName)
+	usedEnums := make(map[string]struct{})
+
+	for _, def := range outDefs {
+		var msgs []*outMessage
+		for _, msg := range def.Messages {
+			if !excluded(msg.deprecated, msg.wip) {
+				msgs = append(msgs, msg)
+				for _, f := range msg.Fields {
+					if f.enum != "" {
+						usedEnums[f.enum] = struct{}{}
+					}
+				}
+			}
+		}
+		def.Messages = msgs
 	}
 
-	os.Mkdir(defName, 0o755)
+	for _, def := range outDefs {
+		var enums []*outEnum
+		for _, enum := range def.Enums {
+			if _, ok := usedEnums[enum.Name]; !ok && excluded(enum.deprecated, enum.wip) {
+				continue
+			}
+
+			var values []*outEnumValue
+			for _, v := range enum.Values {
+				if !excluded(v.deprecated, v.wip) {
+					values = append(values, v)
+				}
+			}
+			enum.Values = values
+
+			enums = append(enums, enum)
+		}
+		def.Enums = enums
+	}
+}
+
+// ConvertOptions contains options of ConvertWithOptions.
+type ConvertOptions struct {
+	// link included definitions instead of including them into the main definition.
+	Link bool
+
+	// (optional) exclude messages, enums and enum entries that are work in progress.
+	ExcludeWIP bool
+
+	// (optional) exclude messages, enums and enum entries that are deprecated.
+	// Enums that are used by remaining messages are kept.
+	ExcludeDeprecated bool
+
+	// (optional) directories in which included definitions are searched,
+	// in addition to the directory of the including definition.
+	IncludeDirs []string
+
+	// (optional) directory in which the package directory is created.
+	// It defaults to the current directory.
+	OutputDir string
+
+	// (optional) name of the package.
+	// It defaults to the name of the definition, without underscores.
+	PkgName string
+
+	// (optional) import path prefix of linked packages.
+	// It defaults to DefaultImportPrefix.
+	ImportPrefix string
+
+	// (optional) revision of the definitions (i.e. a commit of the mavlink repository),
+	// that is recorded into the generated code together with a hash of the definitions.
+	SourceRevision string
+}
+
+// parseDefinitions parses a definition and its includes, applies options
+// and merges enums together.
+func parseDefinitions(
+	path string,
+	defName string,
+	opts ConvertOptions,
+) (string, []*outDefinition, map[string]*outEnum, error) {
+	version := ""
+	processedDefs := make(map[string]struct{})
+	isRemote := isRemoteAddr(path)
 
 	// parse all definitions recursively
-	outDefs, err := processDefinition(&version, processedDefs, isRemote, path)
+	outDefs, err := processDefinition(&version, processedDefs, isRemote, path, opts.IncludeDirs, os.Stderr)
 	if err != nil {
-		return err
+		return "", nil, nil, err
 	}
 
+	// the main definition is the last one, and is placed into the package
+	rootDef := outDefs[len(outDefs)-1]
+	rootDef.Name = defName
+	for _, enum := range rootDef.Enums {
+		enum.DefName = defName
+		for _, v := range enum.Values {
+			v.defName = defName
+		}
+	}
+	for _, msg := range rootDef.Messages {
+		msg.DefName = defName
+	}
+
+	if opts.ExcludeWIP || opts.ExcludeDeprecated {
+		excludeItems(outDefs, opts.ExcludeWIP, opts.ExcludeDeprecated)
+	}
+
+	fillBitmasks(outDefs)
+
 	// merge enums together
 	enums := make(map[string]*outEnum)
 	for _, def := range outDefs {
@@ -675,13 +1272,73 @@ func Convert(path string, link bool) error {
 		}
 	}
 
-	err = writeDialect(defName, defName, version, outDefs, enums)
+	return version, outDefs, enums, nil
+}
+
+// Convert converts a XML definition into a Golang definition.
+func Convert(path string, link bool) error {
+	return ConvertWithOptions(path, ConvertOptions{Link: link})
+}
+
+// ConvertWithOptions converts a XML definition into a Golang definition, with options.
+func ConvertWithOptions(path string, opts ConvertOptions) error {
+	link := opts.Link
+
+	defName := opts.PkgName
+	if defName == "" {
+		defName = defAddrToName(path)
+	}
+
+	importPrefix := opts.ImportPrefix
+	if importPrefix == "" {
+		importPrefix = DefaultImportPrefix
+	}
+	importPrefix = strings.TrimSuffix(importPrefix, "/")
+
+	dir := filepath.Join(opts.OutputDir, defName)
+
+	_, err := os.Stat(dir)
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("directory '%s' already exists", dir)
+	}
+
+	version, outDefs, enums, err := parseDefinitions(path, defName, opts)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	err = writeDialect(dir, defName, version, opts.SourceRevision, outDefs, enums)
+	if err != nil {
+		return err
+	}
+
+	err = writeMessageTable(dir, defName, outDefs)
+	if err != nil {
+		return err
+	}
+
+	err = writeMetadata(dir, defName, outDefs, link)
+	if err != nil {
+		return err
+	}
+
+	err = writeEnums(dir, defName, enums)
+	if err != nil {
+		return err
+	}
+
+	err = writeCommands(dir, defName, outDefs, enums, link, importPrefix)
 	if err != nil {
 		return err
 	}
 
 	for _, enum := range enums {
-		err = writeEnum(defName, defName, enum, link)
+		err = writeEnum(dir, defName, enum, link, importPrefix)
 		if err != nil {
 			return err
 		}
@@ -689,7 +1346,7 @@ func Convert(path string, link bool) error {
 
 	for _, def := range outDefs {
 		for _, msg := range def.Messages {
-			err = writeMessage(defName, defName, msg, link)
+			err = writeMessage(dir, defName, msg, link, importPrefix)
 			if err != nil {
 				return err
 			}
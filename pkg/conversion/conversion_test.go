@@ -1,7 +1,14 @@
 package conversion_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -46,7 +53,7 @@ const testDialect = `<?xml version="1.0"?>
       <description>Detected anomaly info measured by onboard sensors and actuators. </description>
       <field type="uint8_t" name="test_uint8" enum="A_TYPE">a test uint8</field>
 	  <field type="char[16]" name="Test_string">a test string</field>
-	  <field type="uint32_t[4]" name="test_array">a test array</field>
+	  <field type="uint32_t[4]" name="test_array" units="mm" invalid="[UINT32_MAX]">a test array</field>
 	  <extensions/>
       <field type="uint8_t" name="mission_type" enum="MAV_MISSION_TYPE">a test extension</field>
     </message>
@@ -57,6 +64,13 @@ const testDialect = `<?xml version="1.0"?>
 var testMessageGo = `//autogenerated:yes
 //nolint:revive,misspell,govet,lll
 package testdialect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Detected anomaly info measured by onboard sensors and actuators.
 type MessageAMessage struct {
 	// a test uint8
@@ -73,6 +87,54 @@ type MessageAMessage struct {
 func (*MessageAMessage) GetID() uint32 {
 	return 43000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAMessage) MAVLinkCRCExtra() byte {
+	return 170
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAMessage) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 34
+	}
+	return 33
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAMessage) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.TestArray {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[16] = byte(m.TestUint8)
+	copy(buf[17:33], m.TestString)
+	if !isV2 {
+		return
+	}
+	buf[33] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAMessage) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.TestArray {
+		m.TestArray[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.TestUint8 = A_TYPE(buf[16])
+	if n := bytes.IndexByte(buf[17:33], 0); n >= 0 {
+		m.TestString = string(buf[17:17+n])
+	} else {
+		m.TestString = string(buf[17:33])
+	}
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[33])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAMessage) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[43000]
+}
 `
 
 var testEnumGo = `//autogenerated:yes
@@ -107,6 +169,22 @@ const (
 	BIT63 A_TYPE = 9223372036854775808
 )
 
+var values_A_TYPE = []A_TYPE{
+	A,
+	B,
+	C,
+	D,
+	E,
+	BIT0,
+	BIT4,
+	BIT8,
+	BIT16,
+	BIT60,
+	BIT61,
+	BIT62,
+	BIT63,
+}
+
 var value_to_label_A_TYPE = map[A_TYPE]string{
 	A: "A",
 	B: "B",
@@ -164,6 +242,89 @@ func (e A_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (A_TYPE) Values() []A_TYPE {
+	return append([]A_TYPE(nil), values_A_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e A_TYPE) IsValid() bool {
+	_, ok := value_to_label_A_TYPE[e]
+	return ok
+}
+`
+
+var testMessageTableGo = `//autogenerated:yes
+//nolint:revive
+package testdialect
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// MessageTable contains the CRC extra, the length and the target offsets
+// of all messages of the dialect. It can be used to validate and route frames
+// without decoding them.
+var MessageTable = dialect.MessageTable{
+	43000: {CRCExtra: 170, MinLength: 33, MaxLength: 34, TargetSystemOffset: -1, TargetComponentOffset: -1},
+}
+`
+
+var testMetadataGo = `//autogenerated:yes
+//nolint:revive,misspell,lll
+package testdialect
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	43000: {
+		ID:          43000,
+		Name:        "A_MESSAGE",
+		Description: "Detected anomaly info measured by onboard sensors and actuators.",
+		Fields: []*message.FieldMetadata{
+			{Name: "test_uint8", Type: "uint8_t", Enum: "A_TYPE", Description: "a test uint8"},
+			{Name: "Test_string", Type: "char", ArrayLength: 16, Description: "a test string"},
+			{Name: "test_array", Type: "uint32_t", ArrayLength: 4, Units: "mm", Description: "a test array", Invalid: "[UINT32_MAX]"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "a test extension"},
+		},
+	},
+}
+`
+
+var testEnumsGo = `//autogenerated:yes
+//nolint:revive,misspell,lll
+package testdialect
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{
+	"A_TYPE": {
+		Name:        "A_TYPE",
+		Description: "Detected Anomaly Types.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "A", Value: 0, Description: "A."},
+			{Name: "B", Value: 1, Description: "B."},
+			{Name: "C", Value: 2, Description: "C."},
+			{Name: "D", Value: 3, Description: "D."},
+			{Name: "E", Value: 4, Description: "E"},
+			{Name: "BIT0", Value: 1},
+			{Name: "BIT4", Value: 16},
+			{Name: "BIT8", Value: 256},
+			{Name: "BIT16", Value: 65536},
+			{Name: "BIT60", Value: 1152921504606846976},
+			{Name: "BIT61", Value: 2305843009213693952},
+			{Name: "BIT62", Value: 4611686018427387904},
+			{Name: "BIT63", Value: 9223372036854775808},
+		},
+	},
+}
 `
 
 func TestConversion(t *testing.T) {
@@ -186,4 +347,453 @@ func TestConversion(t *testing.T) {
 	buf, err = os.ReadFile("testdialect/enum_a_type.go")
 	require.NoError(t, err)
 	require.Equal(t, testEnumGo, string(buf))
+
+	buf, err = os.ReadFile("testdialect/messagetable.go")
+	require.NoError(t, err)
+	require.Equal(t, testMessageTableGo, string(buf))
+
+	buf, err = os.ReadFile("testdialect/metadata.go")
+	require.NoError(t, err)
+	require.Equal(t, testMetadataGo, string(buf))
+
+	buf, err = os.ReadFile("testdialect/enums.go")
+	require.NoError(t, err)
+	require.Equal(t, testEnumsGo, string(buf))
+}
+
+func TestConversionSourceRevision(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.WriteFile("testdialect.xml", []byte(testDialect), 0o644)
+	require.NoError(t, err)
+
+	err = conversion.ConvertWithOptions("testdialect.xml", conversion.ConvertOptions{
+		SourceRevision: "1234abcd",
+	})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile("testdialect/dialect.go")
+	require.NoError(t, err)
+
+	defHash := sha256.Sum256([]byte(testDialect))
+	hash := sha256.Sum256(defHash[:])
+
+	require.True(t, strings.HasPrefix(string(buf), "// Package testdialect contains the testdialect dialect.\n"+
+		"//\n"+
+		"// Source revision: 1234abcd\n"+
+		"// Definitions hash (SHA256): "+hex.EncodeToString(hash[:])+"\n"+
+		"//\n"+
+		"//autogenerated:yes\n"))
+}
+
+const testCommandDialect = `<?xml version="1.0"?>
+<mavlink>
+  <version>0</version>
+  <dialect>0</dialect>
+  <enums>
+    <enum name="MAV_FRAME">
+      <entry value="0" name="MAV_FRAME_GLOBAL"/>
+      <entry value="1" name="MAV_FRAME_LOCAL_NED"/>
+      <entry value="3" name="MAV_FRAME_GLOBAL_RELATIVE_ALT"/>
+    </enum>
+    <enum name="MAV_CMD">
+      <entry value="22" name="MAV_CMD_NAV_TAKEOFF">
+        <description>Takeoff from ground / hand.</description>
+        <param index="1" label="Pitch" units="deg">Minimum pitch.</param>
+        <param index="2">Empty</param>
+        <param index="3" label="Horizontal Speed" reserved="true" default="NaN"/>
+        <param index="4" label="Yaw" units="deg">Yaw angle.</param>
+        <param index="5" label="Latitude">Latitude</param>
+        <param index="6" label="Longitude">Longitude</param>
+        <param index="7" label="Altitude" units="m">Altitude</param>
+      </entry>
+      <entry value="512" name="MAV_CMD_REQUEST_MESSAGE">
+        <description>Request the target system(s) emit a single instance of a specified message.</description>
+        <param index="1" label="Message ID">The MAVLink message ID of the requested message.</param>
+        <param index="5" label="Req Param 4">Use for index ID, if required.</param>
+        <param index="6" label="Req Param 5">Use for index ID, if required.</param>
+      </entry>
+      <entry value="600" name="MAV_CMD_UNDESCRIBED">
+        <description>A command whose parameters are not described.</description>
+      </entry>
+    </enum>
+  </enums>
+  <messages>
+    <message id="75" name="COMMAND_INT">
+      <description>Send a command with up to seven parameters to the MAV, where params 5 and 6 are integers.</description>
+      <field type="uint8_t" name="target_system">System ID</field>
+      <field type="uint8_t" name="target_component">Component ID</field>
+      <field type="uint8_t" name="frame" enum="MAV_FRAME">The coordinate system of the COMMAND.</field>
+      <field type="uint16_t" name="command" enum="MAV_CMD">The scheduled action for the mission item.</field>
+      <field type="uint8_t" name="current">Not used.</field>
+      <field type="uint8_t" name="autocontinue">Not used (set 0).</field>
+      <field type="float" name="param1">PARAM1</field>
+      <field type="float" name="param2">PARAM2</field>
+      <field type="float" name="param3">PARAM3</field>
+      <field type="float" name="param4">PARAM4</field>
+      <field type="int32_t" name="x">PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7</field>
+      <field type="int32_t" name="y">PARAM6 / local: y position in meters * 1e4, global: longitude in degrees * 10^7</field>
+      <field type="float" name="z">PARAM7 / z position</field>
+    </message>
+    <message id="76" name="COMMAND_LONG">
+      <description>Send a command with up to seven parameters to the MAV.</description>
+      <field type="uint8_t" name="target_system">System which should execute the command</field>
+      <field type="uint8_t" name="target_component">Component which should execute the command</field>
+      <field type="uint16_t" name="command" enum="MAV_CMD">Command ID</field>
+      <field type="uint8_t" name="confirmation">Confirmation</field>
+      <field type="float" name="param1">Parameter 1</field>
+      <field type="float" name="param2">Parameter 2</field>
+      <field type="float" name="param3">Parameter 3</field>
+      <field type="float" name="param4">Parameter 4</field>
+      <field type="float" name="param5">Parameter 5</field>
+      <field type="float" name="param6">Parameter 6</field>
+      <field type="float" name="param7">Parameter 7</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+var testCommandGo = `//autogenerated:yes
+//nolint:revive,misspell,govet,lll
+package testcommands
+
+import (
+	"fmt"
+	"math"
+)
+
+// CmdNavTakeoff is the MAV_CMD_NAV_TAKEOFF command.
+// Takeoff from ground / hand.
+type CmdNavTakeoff struct {
+	// Minimum pitch.
+	// Units: deg
+	Pitch float32
+	// Yaw angle.
+	// Units: deg
+	Yaw float32
+	// Latitude
+	Latitude float64
+	// Longitude
+	Longitude float64
+	// Altitude
+	// Units: m
+	Altitude float32
+}
+
+// MAVLinkCommand returns the ID of the command.
+func (*CmdNavTakeoff) MAVLinkCommand() MAV_CMD {
+	return 22
+}
+
+// ToCommandLong encodes the command into a COMMAND_LONG message.
+func (c *CmdNavTakeoff) ToCommandLong(targetSystem uint8, targetComponent uint8) *MessageCommandLong {
+	return &MessageCommandLong{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Command:         22,
+		Param1:          c.Pitch,
+		Param2:          0,
+		Param3:          float32(math.NaN()),
+		Param4:          c.Yaw,
+		Param5:          float32(c.Latitude),
+		Param6:          float32(c.Longitude),
+		Param7:          c.Altitude,
+	}
+}
+
+// FromCommandLong decodes the command from a COMMAND_LONG message.
+func (c *CmdNavTakeoff) FromCommandLong(m *MessageCommandLong) error {
+	if m.Command != 22 {
+		return fmt.Errorf("wrong command: expected 22, got %d", uint64(m.Command))
+	}
+	c.Pitch = m.Param1
+	c.Yaw = m.Param4
+	c.Latitude = float64(m.Param5)
+	c.Longitude = float64(m.Param6)
+	c.Altitude = m.Param7
+	return nil
+}
+
+// ToCommandInt encodes the command into a COMMAND_INT message.
+func (c *CmdNavTakeoff) ToCommandInt(targetSystem uint8, targetComponent uint8, frame MAV_FRAME) *MessageCommandInt {
+	return &MessageCommandInt{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Frame:           frame,
+		Command:         22,
+		Param1:          c.Pitch,
+		Param2:          0,
+		Param3:          float32(math.NaN()),
+		Param4:          c.Yaw,
+		X:               cmdLocationToInt(c.Latitude, uint64(frame)),
+		Y:               cmdLocationToInt(c.Longitude, uint64(frame)),
+		Z:               c.Altitude,
+	}
+}
+
+// FromCommandInt decodes the command from a COMMAND_INT message.
+func (c *CmdNavTakeoff) FromCommandInt(m *MessageCommandInt) error {
+	if m.Command != 22 {
+		return fmt.Errorf("wrong command: expected 22, got %d", uint64(m.Command))
+	}
+	c.Pitch = m.Param1
+	c.Yaw = m.Param4
+	c.Latitude = cmdLocationFromInt(m.X, uint64(m.Frame))
+	c.Longitude = cmdLocationFromInt(m.Y, uint64(m.Frame))
+	c.Altitude = m.Z
+	return nil
+}
+`
+
+var testCommandRequestMessageInt = `// ToCommandInt encodes the command into a COMMAND_INT message.
+func (c *CmdRequestMessage) ToCommandInt(targetSystem uint8, targetComponent uint8, frame MAV_FRAME) *MessageCommandInt {
+	return &MessageCommandInt{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Frame:           frame,
+		Command:         512,
+		Param1:          c.MessageID,
+		Param2:          0,
+		Param3:          0,
+		Param4:          0,
+		X:               int32(c.ReqParam4),
+		Y:               int32(c.ReqParam5),
+		Z:               0,
+	}
+}
+`
+
+var testCommandHelpersGo = `// cmdIsGlobalFrame returns whether a MAV_FRAME is global,
+// i.e. whether x and y of COMMAND_INT and MISSION_ITEM_INT are latitude and longitude.
+func cmdIsGlobalFrame(frame uint64) bool {
+	switch frame {
+	case uint64(MAV_FRAME_GLOBAL),
+		uint64(MAV_FRAME_GLOBAL_RELATIVE_ALT):
+		return true
+	}
+	return false
+}
+`
+
+func TestConversionCommands(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.WriteFile("testcommands.xml", []byte(testCommandDialect), 0o644)
+	require.NoError(t, err)
+
+	err = conversion.Convert("testcommands.xml", true)
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile("testcommands/cmd_nav_takeoff.go")
+	require.NoError(t, err)
+	require.Equal(t, testCommandGo, string(buf))
+
+	// params 5 and 6 of commands without a location are not scaled
+	buf, err = os.ReadFile("testcommands/cmd_request_message.go")
+	require.NoError(t, err)
+	require.Contains(t, string(buf), testCommandRequestMessageInt)
+
+	// global frames are taken from MAV_FRAME
+	buf, err = os.ReadFile("testcommands/cmd_helpers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(buf), testCommandHelpersGo)
+
+	_, err = os.Stat("testcommands/cmd_undescribed.go")
+	require.True(t, os.IsNotExist(err))
+}
+
+const testCommandVendorDialect = `<?xml version="1.0"?>
+<mavlink>
+  <include>cmdbase.xml</include>
+  <version>0</version>
+  <dialect>0</dialect>
+  <enums>
+    <enum name="MAV_CMD">
+      <entry value="31000" name="MAV_CMD_VENDOR_GOTO">
+        <description>Go to a position.</description>
+        <param index="1" label="Speed" units="m/s">Speed.</param>
+        <param index="5" label="Latitude">Latitude</param>
+        <param index="6" label="Longitude">Longitude</param>
+      </entry>
+    </enum>
+  </enums>
+  <messages>
+    <message id="31000" name="VENDOR_STATUS">
+      <description>Vendor status.</description>
+      <field type="uint8_t" name="status">Status</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestConversionCommandsLinkCompile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	// generate into a directory of the module, in order to build code with the module
+	_, file, _, _ := runtime.Caller(0)
+	pkgDir := filepath.Dir(file)
+
+	dir, err := os.MkdirTemp(pkgDir, "commands-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = os.WriteFile(filepath.Join(dir, "cmdbase.xml"), []byte(testCommandDialect), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "cmdvendor.xml"), []byte(testCommandVendorDialect), 0o644)
+	require.NoError(t, err)
+
+	importPrefix := "github.com/bluenviron/gomavlib/v4/pkg/conversion/" + filepath.Base(dir)
+
+	for _, name := range []string{"cmdbase.xml", "cmdvendor.xml"} {
+		err = conversion.ConvertWithOptions(filepath.Join(dir, name), conversion.ConvertOptions{
+			Link:         true,
+			OutputDir:    dir,
+			ImportPrefix: importPrefix,
+		})
+		require.NoError(t, err)
+	}
+
+	// commands of included definitions are not duplicated
+	buf, err := os.ReadFile(filepath.Join(dir, "cmdvendor", "cmd_nav_takeoff.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "type CmdNavTakeoff = cmdbase.CmdNavTakeoff\n")
+
+	buf, err = os.ReadFile(filepath.Join(dir, "cmdvendor", "cmd_vendor_goto.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "type CmdVendorGoto struct {\n")
+
+	cmd := exec.Command("go", "vet", "./"+filepath.Base(dir)+"/...")
+	cmd.Dir = pkgDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+const testStatusDialect = `<?xml version="1.0"?>
+<mavlink>
+  <version>0</version>
+  <dialect>0</dialect>
+  <enums>
+    <enum name="A_TYPE">
+      <entry value="0" name="A">
+        <description>A.</description>
+      </entry>
+      <entry value="1" name="B">
+        <deprecated since="2021-01" replaced_by="A">Use A.</deprecated>
+        <description>B.</description>
+      </entry>
+      <entry value="2" name="C">
+        <wip/>
+        <description>C.</description>
+      </entry>
+    </enum>
+  </enums>
+  <messages>
+    <message id="1" name="OLD_MESSAGE">
+      <deprecated since="2020-06" replaced_by="NEW_MESSAGE"/>
+      <description>An old message.</description>
+      <field type="uint8_t" name="a" enum="A_TYPE">a</field>
+    </message>
+    <message id="2" name="NEW_MESSAGE">
+      <wip/>
+      <description>A new message.</description>
+      <field type="uint8_t" name="a">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+var testStatusMessageGo = `//autogenerated:yes
+//nolint:revive,misspell,govet,lll
+package teststatus
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// An old message.
+//
+// Deprecated: since 2020-06, replaced by NEW_MESSAGE.
+type MessageOldMessage struct {
+`
+
+func TestConversionStatus(t *testing.T) {
+	for _, ca := range []struct {
+		name     string
+		opts     conversion.ConvertOptions
+		messages []string
+		labels   []string
+	}{
+		{
+			"default",
+			conversion.ConvertOptions{},
+			[]string{"old_message", "new_message"},
+			[]string{"A", "B", "C"},
+		},
+		{
+			"exclude wip",
+			conversion.ConvertOptions{ExcludeWIP: true},
+			[]string{"old_message"},
+			[]string{"A", "B"},
+		},
+		{
+			"exclude deprecated",
+			conversion.ConvertOptions{ExcludeDeprecated: true},
+			[]string{"new_message"},
+			[]string{"A", "C"},
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			dir, err := os.MkdirTemp("", "gomavlib")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			os.Chdir(dir)
+
+			err = os.WriteFile("teststatus.xml", []byte(testStatusDialect), 0o644)
+			require.NoError(t, err)
+
+			err = conversion.ConvertWithOptions("teststatus.xml", ca.opts)
+			require.NoError(t, err)
+
+			for _, name := range []string{"old_message", "new_message"} {
+				_, err = os.Stat("teststatus/message_" + name + ".go")
+				require.Equal(t, slices.Contains(ca.messages, name), err == nil)
+			}
+
+			buf, err := os.ReadFile("teststatus/enum_a_type.go")
+			require.NoError(t, err)
+
+			for _, label := range []string{"A", "B", "C"} {
+				require.Equal(t, slices.Contains(ca.labels, label),
+					strings.Contains(string(buf), "\t"+label+" A_TYPE = "))
+			}
+
+			if ca.name == "default" {
+				require.Contains(t, string(buf), "\t// B.\n\t//\n\t// Deprecated: since 2021-01, replaced by A.\n\t// Use A.\n")
+				require.Contains(t, string(buf), "\t// C.\n\t//\n\t// WIP: this item is a work in progress and may change or be removed.\n")
+
+				buf, err = os.ReadFile("teststatus/message_old_message.go")
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(string(buf), testStatusMessageGo))
+
+				buf, err = os.ReadFile("teststatus/metadata.go")
+				require.NoError(t, err)
+				require.Contains(t, string(buf), "\t\tDeprecated:  true,\n"+
+					"\t\tDeprecatedSince: \"2020-06\",\n"+
+					"\t\tReplacedBy: \"NEW_MESSAGE\",\n")
+				require.Contains(t, string(buf), "\t\tWIP:         true,\n")
+			}
+		})
+	}
 }
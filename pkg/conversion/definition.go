@@ -5,10 +5,30 @@ import (
 	"strconv"
 )
 
+type definitionDeprecated struct {
+	Since       string `xml:"since,attr"`
+	ReplacedBy  string `xml:"replaced_by,attr"`
+	Description string `xml:",chardata"`
+}
+
+type definitionEnumEntryParam struct {
+	Index       int    `xml:"index,attr"`
+	Label       string `xml:"label,attr"`
+	Units       string `xml:"units,attr"`
+	Enum        string `xml:"enum,attr"`
+	Reserved    bool   `xml:"reserved,attr"`
+	Default     string `xml:"default,attr"`
+	Description string `xml:",chardata"`
+}
+
 type definitionEnumEntry struct {
-	Value       string `xml:"value,attr"`
-	Name        string `xml:"name,attr"`
-	Description string `xml:"description"`
+	Value       string                      `xml:"value,attr"`
+	Name        string                      `xml:"name,attr"`
+	Description string                      `xml:"description"`
+	HasLocation string                      `xml:"hasLocation,attr"`
+	Params      []*definitionEnumEntryParam `xml:"param"`
+	Deprecated  *definitionDeprecated       `xml:"deprecated"`
+	WIP         *struct{}                   `xml:"wip"`
 }
 
 type definitionEnum struct {
@@ -16,6 +36,8 @@ type definitionEnum struct {
 	Description string                 `xml:"description"`
 	Entries     []*definitionEnumEntry `xml:"entry"`
 	Bitmask     bool                   `xml:"bitmask,attr"`
+	Deprecated  *definitionDeprecated  `xml:"deprecated"`
+	WIP         *struct{}              `xml:"wip"`
 }
 
 type dialectField struct {
@@ -23,6 +45,15 @@ type dialectField struct {
 	Type        string `xml:"type,attr"`
 	Name        string `xml:"name,attr"`
 	Enum        string `xml:"enum,attr"`
+	Units       string `xml:"units,attr"`
+	Display     string `xml:"display,attr"`
+	Invalid     string `xml:"invalid,attr"`
+	Multiplier  string `xml:"multiplier,attr"`
+	MinValue    string `xml:"minValue,attr"`
+	MaxValue    string `xml:"maxValue,attr"`
+	Increment   string `xml:"increment,attr"`
+	Instance    bool   `xml:"instance,attr"`
+	PrintFormat string `xml:"print_format,attr"`
 	Description string `xml:",innerxml"`
 }
 
@@ -31,6 +62,8 @@ type definitionMessage struct {
 	Name        string
 	Description string
 	Fields      []*dialectField
+	Deprecated  *definitionDeprecated
+	WIP         bool
 }
 
 // UnmarshalXML implements xml.Unmarshaler
@@ -62,6 +95,16 @@ func (m *definitionMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 					return err
 				}
 
+			case "deprecated":
+				m.Deprecated = &definitionDeprecated{}
+				err := d.DecodeElement(m.Deprecated, &se)
+				if err != nil {
+					return err
+				}
+
+			case "wip":
+				m.WIP = true
+
 			case "extensions":
 				inExtensions = true
 
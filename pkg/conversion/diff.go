@@ -0,0 +1,471 @@
+package conversion
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// DialectChangeKind is the kind of a DialectChange.
+type DialectChangeKind string
+
+// kinds of changes.
+const (
+	DialectChangeMessageAdded       DialectChangeKind = "message_added"
+	DialectChangeMessageRemoved     DialectChangeKind = "message_removed"
+	DialectChangeIDCollision        DialectChangeKind = "id_collision"
+	DialectChangeCRCExtra           DialectChangeKind = "crc_extra_changed"
+	DialectChangeFieldsReordered    DialectChangeKind = "fields_reordered"
+	DialectChangeExtensionAdded     DialectChangeKind = "extension_added"
+	DialectChangeExtensionRemoved   DialectChangeKind = "extension_removed"
+	DialectChangeExtensionChanged   DialectChangeKind = "extension_changed"
+	DialectChangeEnumAdded          DialectChangeKind = "enum_added"
+	DialectChangeEnumRemoved        DialectChangeKind = "enum_removed"
+	DialectChangeEnumEntryAdded     DialectChangeKind = "enum_entry_added"
+	DialectChangeEnumEntryRemoved   DialectChangeKind = "enum_entry_removed"
+	DialectChangeEnumValueChanged   DialectChangeKind = "enum_value_changed"
+	DialectChangeEnumBitmaskChanged DialectChangeKind = "enum_bitmask_changed"
+	DialectChangeVersionChanged     DialectChangeKind = "version_changed"
+)
+
+// DialectChange is a difference between two dialects.
+type DialectChange struct {
+	// kind of the change.
+	Kind DialectChangeKind `json:"kind"`
+
+	// whether the change breaks communication between the two dialects.
+	Breaking bool `json:"breaking"`
+
+	// ID of the message, in case of message changes.
+	MessageID uint32 `json:"messageId,omitempty"`
+
+	// name of the message, in case of message changes.
+	Message string `json:"message,omitempty"`
+
+	// name of the field, in case of field changes.
+	Field string `json:"field,omitempty"`
+
+	// name of the enum, in case of enum changes.
+	Enum string `json:"enum,omitempty"`
+
+	// name of the enum entry, in case of enum entry changes.
+	Entry string `json:"entry,omitempty"`
+
+	// human-readable description of the change.
+	Description string `json:"description"`
+}
+
+// String implements fmt.Stringer.
+func (c *DialectChange) String() string {
+	var b strings.Builder
+
+	if c.Breaking {
+		b.WriteString("BREAKING ")
+	}
+	b.WriteString(string(c.Kind))
+
+	switch {
+	case c.Message != "":
+		fmt.Fprintf(&b, " %s (%d)", c.Message, c.MessageID)
+		if c.Field != "" {
+			b.WriteString("." + c.Field)
+		}
+
+	case c.Enum != "":
+		b.WriteString(" " + c.Enum)
+		if c.Entry != "" {
+			b.WriteString("." + c.Entry)
+		}
+	}
+
+	b.WriteString(": " + c.Description)
+	return b.String()
+}
+
+// HasBreakingChanges returns whether changes contain at least a breaking change.
+func HasBreakingChanges(changes []*DialectChange) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+type diffMessage struct {
+	id         uint32
+	name       string
+	crcExtra   byte
+	fields     []*message.FieldMetadata
+	extensions []*message.FieldMetadata
+}
+
+func newDiffMessages(d *dialect.Dialect) (map[uint32][]*diffMessage, error) {
+	ret := make(map[uint32][]*diffMessage)
+
+	for _, msg := range d.Messages {
+		rw := &message.ReadWriter{Message: msg}
+		err := rw.Initialize()
+		if err != nil {
+			return nil, err
+		}
+
+		md, err := message.GetMetadata(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		dm := &diffMessage{
+			id:       msg.GetID(),
+			name:     rw.Name(),
+			crcExtra: rw.CRCExtra(),
+		}
+
+		for _, f := range md.Fields {
+			if f.Extension {
+				dm.extensions = append(dm.extensions, f)
+			} else {
+				dm.fields = append(dm.fields, f)
+			}
+		}
+
+		ret[dm.id] = append(ret[dm.id], dm)
+	}
+
+	return ret, nil
+}
+
+func fieldSignature(f *message.FieldMetadata) string {
+	if f.ArrayLength != 0 {
+		return fmt.Sprintf("%s %s[%d]", f.Name, f.Type, f.ArrayLength)
+	}
+	return f.Name + " " + f.Type
+}
+
+func fieldSignatures(fields []*message.FieldMetadata) []string {
+	ret := make([]string, len(fields))
+	for i, f := range fields {
+		ret[i] = fieldSignature(f)
+	}
+	return ret
+}
+
+func describeFieldChanges(oldFields []string, newFields []string) string {
+	oldSet := make(map[string]struct{})
+	for _, f := range oldFields {
+		oldSet[f] = struct{}{}
+	}
+	newSet := make(map[string]struct{})
+	for _, f := range newFields {
+		newSet[f] = struct{}{}
+	}
+
+	var parts []string
+	for _, f := range oldFields {
+		if _, ok := newSet[f]; !ok {
+			parts = append(parts, "removed '"+f+"'")
+		}
+	}
+	for _, f := range newFields {
+		if _, ok := oldSet[f]; !ok {
+			parts = append(parts, "added '"+f+"'")
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func sameElements(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a2 := append([]string(nil), a...)
+	b2 := append([]string(nil), b...)
+	sort.Strings(a2)
+	sort.Strings(b2)
+	for i := range a2 {
+		if a2[i] != b2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func diffMessageFields(oldMsg *diffMessage, newMsg *diffMessage) []*DialectChange {
+	var changes []*DialectChange
+
+	change := func(kind DialectChangeKind, breaking bool, field string, desc string) {
+		changes = append(changes, &DialectChange{
+			Kind:        kind,
+			Breaking:    breaking,
+			MessageID:   newMsg.id,
+			Message:     newMsg.name,
+			Field:       field,
+			Description: desc,
+		})
+	}
+
+	oldFields := fieldSignatures(oldMsg.fields)
+	newFields := fieldSignatures(newMsg.fields)
+
+	if oldMsg.crcExtra != newMsg.crcExtra {
+		if sameElements(oldFields, newFields) {
+			change(DialectChangeFieldsReordered, true, "",
+				fmt.Sprintf("fields were reordered, CRC extra changed from %d to %d", oldMsg.crcExtra, newMsg.crcExtra))
+		} else {
+			desc := fmt.Sprintf("CRC extra changed from %d to %d", oldMsg.crcExtra, newMsg.crcExtra)
+			if fc := describeFieldChanges(oldFields, newFields); fc != "" {
+				desc += " (" + fc + ")"
+			}
+			change(DialectChangeCRCExtra, true, "", desc)
+		}
+	}
+
+	// extensions are not covered by the CRC extra, therefore their order must be checked
+	for i, f := range newMsg.extensions {
+		if i >= len(oldMsg.extensions) {
+			change(DialectChangeExtensionAdded, false, f.Name,
+				"extension '"+fieldSignature(f)+"' added")
+			continue
+		}
+
+		if fieldSignature(f) != fieldSignature(oldMsg.extensions[i]) {
+			change(DialectChangeExtensionChanged, true, f.Name,
+				fmt.Sprintf("extension at position %d changed from '%s' to '%s'",
+					i, fieldSignature(oldMsg.extensions[i]), fieldSignature(f)))
+		}
+	}
+
+	for i := len(newMsg.extensions); i < len(oldMsg.extensions); i++ {
+		f := oldMsg.extensions[i]
+		change(DialectChangeExtensionRemoved, true, f.Name,
+			"extension '"+fieldSignature(f)+"' removed")
+	}
+
+	return changes
+}
+
+func diffMessages(oldD *dialect.Dialect, newD *dialect.Dialect) ([]*DialectChange, error) {
+	oldMsgs, err := newDiffMessages(oldD)
+	if err != nil {
+		return nil, err
+	}
+
+	newMsgs, err := newDiffMessages(newD)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[uint32]struct{})
+	for id := range oldMsgs {
+		ids[id] = struct{}{}
+	}
+	for id := range newMsgs {
+		ids[id] = struct{}{}
+	}
+
+	sortedIDs := make([]uint32, 0, len(ids))
+	for id := range ids {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Slice(sortedIDs, func(i, j int) bool {
+		return sortedIDs[i] < sortedIDs[j]
+	})
+
+	var changes []*DialectChange
+
+	for _, id := range sortedIDs {
+		olds := oldMsgs[id]
+		news := newMsgs[id]
+
+		if len(news) > 1 {
+			var names []string
+			for _, m := range news {
+				names = append(names, m.name)
+			}
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeIDCollision,
+				Breaking:    true,
+				MessageID:   id,
+				Message:     news[0].name,
+				Description: fmt.Sprintf("ID is used by multiple messages (%s)", strings.Join(names, ", ")),
+			})
+			continue
+		}
+
+		switch {
+		case len(news) == 0:
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeMessageRemoved,
+				Breaking:    true,
+				MessageID:   id,
+				Message:     olds[0].name,
+				Description: "message removed",
+			})
+
+		case len(olds) == 0:
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeMessageAdded,
+				MessageID:   id,
+				Message:     news[0].name,
+				Description: "message added",
+			})
+
+		case olds[0].name != news[0].name:
+			changes = append(changes, &DialectChange{
+				Kind:      DialectChangeIDCollision,
+				Breaking:  true,
+				MessageID: id,
+				Message:   news[0].name,
+				Description: fmt.Sprintf("ID was used by %s and is now used by %s",
+					olds[0].name, news[0].name),
+			})
+
+		default:
+			changes = append(changes, diffMessageFields(olds[0], news[0])...)
+		}
+	}
+
+	return changes, nil
+}
+
+// DiffDialects compares two dialects and returns their differences.
+// Enums are compared only when both dialects provide them.
+func DiffDialects(oldD *dialect.Dialect, newD *dialect.Dialect) ([]*DialectChange, error) {
+	changes, err := diffMessages(oldD, newD)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldD.Enums != nil && newD.Enums != nil {
+		changes = append(changes, diffEnums(oldD.Enums, newD.Enums)...)
+	}
+
+	if oldD.Version != newD.Version {
+		changes = append(changes, &DialectChange{
+			Kind:        DialectChangeVersionChanged,
+			Description: fmt.Sprintf("version changed from %d to %d", oldD.Version, newD.Version),
+		})
+	}
+
+	return changes, nil
+}
+
+func diffEnums(oldEnums map[string]*message.EnumMetadata, newEnums map[string]*message.EnumMetadata) []*DialectChange {
+	names := make(map[string]struct{})
+	for name := range oldEnums {
+		names[name] = struct{}{}
+	}
+	for name := range newEnums {
+		names[name] = struct{}{}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var changes []*DialectChange
+
+	for _, name := range sortedNames {
+		oldEnum, inOld := oldEnums[name]
+		newEnum, inNew := newEnums[name]
+
+		switch {
+		case !inOld:
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeEnumAdded,
+				Enum:        name,
+				Description: "enum added",
+			})
+			continue
+
+		case !inNew:
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeEnumRemoved,
+				Breaking:    true,
+				Enum:        name,
+				Description: "enum removed",
+			})
+			continue
+		}
+
+		if oldEnum.Bitmask != newEnum.Bitmask {
+			desc := "enum is now a bitmask"
+			if !newEnum.Bitmask {
+				desc = "enum is not a bitmask anymore"
+			}
+			changes = append(changes, &DialectChange{
+				Kind:        DialectChangeEnumBitmaskChanged,
+				Breaking:    true,
+				Enum:        name,
+				Description: desc,
+			})
+		}
+
+		newByName := make(map[string]uint64)
+		for _, e := range newEnum.Entries {
+			newByName[e.Name] = e.Value
+		}
+		oldByName := make(map[string]uint64)
+		for _, e := range oldEnum.Entries {
+			oldByName[e.Name] = e.Value
+		}
+
+		for _, e := range oldEnum.Entries {
+			nv, ok := newByName[e.Name]
+			switch {
+			case !ok:
+				changes = append(changes, &DialectChange{
+					Kind:        DialectChangeEnumEntryRemoved,
+					Breaking:    true,
+					Enum:        name,
+					Entry:       e.Name,
+					Description: fmt.Sprintf("entry with value %d removed", e.Value),
+				})
+
+			case nv != e.Value:
+				changes = append(changes, &DialectChange{
+					Kind:        DialectChangeEnumValueChanged,
+					Breaking:    true,
+					Enum:        name,
+					Entry:       e.Name,
+					Description: fmt.Sprintf("value changed from %d to %d", e.Value, nv),
+				})
+			}
+		}
+
+		for _, e := range newEnum.Entries {
+			if _, ok := oldByName[e.Name]; !ok {
+				changes = append(changes, &DialectChange{
+					Kind:        DialectChangeEnumEntryAdded,
+					Enum:        name,
+					Entry:       e.Name,
+					Description: fmt.Sprintf("entry with value %d added", e.Value),
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// DiffDefinitions compares two XML definitions, together with their includes,
+// and returns their differences. Both messages and enums are compared.
+func DiffDefinitions(oldPath string, newPath string) ([]*DialectChange, error) {
+	oldD, err := LoadDialect(oldPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", oldPath, err)
+	}
+
+	newD, err := LoadDialect(newPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", newPath, err)
+	}
+
+	return DiffDialects(oldD, newD)
+}
@@ -0,0 +1,174 @@
+package conversion_test
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+const testDiffOld = `<?xml version="1.0"?>
+<mavlink>
+  <version>3</version>
+  <enums>
+    <enum name="A_TYPE">
+      <entry value="0" name="A"/>
+      <entry value="1" name="B"/>
+      <entry value="2" name="C"/>
+    </enum>
+    <enum name="OLD_TYPE">
+      <entry value="0" name="OLD"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="1" name="UNCHANGED">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+    <message id="2" name="REMOVED">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+    <message id="3" name="CHANGED">
+      <field type="uint8_t" name="a">a</field>
+      <field type="uint16_t" name="b">b</field>
+    </message>
+    <message id="4" name="REORDERED">
+      <field type="uint8_t" name="a">a</field>
+      <field type="uint8_t" name="b">b</field>
+    </message>
+    <message id="5" name="EXTENDED">
+      <field type="uint8_t" name="a">a</field>
+      <extensions/>
+      <field type="uint8_t" name="b">b</field>
+      <field type="uint8_t" name="c">c</field>
+    </message>
+    <message id="6" name="RENAMED">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testDiffNew = `<?xml version="1.0"?>
+<mavlink>
+  <version>3</version>
+  <enums>
+    <enum name="A_TYPE">
+      <entry value="0" name="A"/>
+      <entry value="3" name="C"/>
+      <entry value="4" name="D"/>
+    </enum>
+    <enum name="NEW_TYPE">
+      <entry value="0" name="NEW"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="1" name="UNCHANGED">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+    <message id="3" name="CHANGED">
+      <field type="uint8_t" name="a">a</field>
+      <field type="uint32_t" name="b">b</field>
+    </message>
+    <message id="4" name="REORDERED">
+      <field type="uint8_t" name="b">b</field>
+      <field type="uint8_t" name="a">a</field>
+    </message>
+    <message id="5" name="EXTENDED">
+      <field type="uint8_t" name="a">a</field>
+      <extensions/>
+      <field type="uint8_t" name="b">b</field>
+      <field type="int8_t" name="c">c</field>
+      <field type="uint8_t" name="d">d</field>
+    </message>
+    <message id="6" name="OTHER">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+    <message id="7" name="ADDED">
+      <field type="uint8_t" name="a">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestDiffDefinitions(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = os.WriteFile(filepath.Join(dir, "old.xml"), []byte(testDiffOld), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "new.xml"), []byte(testDiffNew), 0o644)
+	require.NoError(t, err)
+
+	changes, err := conversion.DiffDefinitions(filepath.Join(dir, "old.xml"), filepath.Join(dir, "new.xml"))
+	require.NoError(t, err)
+
+	var strs []string
+	for _, c := range changes {
+		strs = append(strs, c.String())
+	}
+
+	require.Equal(t, []string{
+		"BREAKING message_removed REMOVED (2): message removed",
+		"BREAKING crc_extra_changed CHANGED (3): CRC extra changed from 22 to 98 " +
+			"(removed 'b uint16_t', added 'b uint32_t')",
+		"BREAKING fields_reordered REORDERED (4): fields were reordered, CRC extra changed from 39 to 12",
+		"BREAKING extension_changed EXTENDED (5).c: extension at position 1 changed from 'c uint8_t' to 'c int8_t'",
+		"extension_added EXTENDED (5).d: extension 'd uint8_t' added",
+		"BREAKING id_collision OTHER (6): ID was used by RENAMED and is now used by OTHER",
+		"message_added ADDED (7): message added",
+		"BREAKING enum_entry_removed A_TYPE.B: entry with value 1 removed",
+		"BREAKING enum_value_changed A_TYPE.C: value changed from 2 to 3",
+		"enum_entry_added A_TYPE.D: entry with value 4 added",
+		"enum_added NEW_TYPE: enum added",
+		"BREAKING enum_removed OLD_TYPE: enum removed",
+	}, strs)
+
+	require.True(t, conversion.HasBreakingChanges(changes))
+
+	changes, err = conversion.DiffDefinitions(filepath.Join(dir, "old.xml"), filepath.Join(dir, "old.xml"))
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestDiffDialects(t *testing.T) {
+	changes, err := conversion.DiffDialects(common.Dialect, common.Dialect)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.False(t, conversion.HasBreakingChanges(changes))
+}
+
+func TestDiffDialectsEnums(t *testing.T) {
+	newD := *common.Dialect
+	newD.Enums = maps.Clone(common.Dialect.Enums)
+
+	landedState := *newD.Enums["MAV_LANDED_STATE"]
+	landedState.Bitmask = true
+	landedState.Entries = landedState.Entries[:len(landedState.Entries)-1]
+	newD.Enums["MAV_LANDED_STATE"] = &landedState
+
+	changes, err := conversion.DiffDialects(common.Dialect, &newD)
+	require.NoError(t, err)
+
+	strs := make([]string, 0, len(changes))
+	for _, c := range changes {
+		strs = append(strs, c.String())
+	}
+
+	require.Equal(t, []string{
+		"BREAKING enum_bitmask_changed MAV_LANDED_STATE: enum is now a bitmask",
+		"BREAKING enum_entry_removed MAV_LANDED_STATE.MAV_LANDED_STATE_LANDING: entry with value 4 removed",
+	}, strs)
+
+	// enums are not compared when a dialect does not provide them
+	newD.Enums = nil
+	changes, err = conversion.DiffDialects(common.Dialect, &newD)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
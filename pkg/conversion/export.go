@@ -0,0 +1,418 @@
+package conversion
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+var tplExport = template.Must(template.New("").Parse(
+	`<?xml version="1.0"?>
+<mavlink>
+{{- range .Includes }}
+  <include>{{ . }}</include>
+{{- end }}
+  <version>{{ .Version }}</version>
+  <dialect>{{ .Dialect }}</dialect>
+{{- if .Enums }}
+  <enums>
+{{- range .Enums }}
+    <enum{{ .Attrs }}>
+{{- if .Deprecated }}
+      <deprecated/>
+{{- end }}
+{{- if .WIP }}
+      <wip/>
+{{- end }}
+{{- if .Description }}
+      <description>{{ .Description }}</description>
+{{- end }}
+{{- range .Entries }}
+      <entry{{ .Attrs }}>
+{{- if .Deprecated }}
+        <deprecated/>
+{{- end }}
+{{- if .WIP }}
+        <wip/>
+{{- end }}
+{{- if .Description }}
+        <description>{{ .Description }}</description>
+{{- end }}
+      </entry>
+{{- end }}
+    </enum>
+{{- end }}
+  </enums>
+{{- end }}
+  <messages>
+{{- range .Messages }}
+    <message id="{{ .ID }}" name="{{ .Name }}">
+{{- if .Deprecated }}
+      {{ .Deprecated }}
+{{- end }}
+{{- if .WIP }}
+      <wip/>
+{{- end }}
+{{- if .Description }}
+      <description>{{ .Description }}</description>
+{{- end }}
+{{- range .Fields }}
+{{- if .FirstExtension }}
+      <extensions/>
+{{- end }}
+      <field{{ .Attrs }}>{{ .Description }}</field>
+{{- end }}
+    </message>
+{{- end }}
+  </messages>
+</mavlink>
+`))
+
+type exportEnumEntry struct {
+	Attrs       string
+	Description string
+	Deprecated  bool
+	WIP         bool
+}
+
+type exportEnum struct {
+	Attrs       string
+	Description string
+	Deprecated  bool
+	WIP         bool
+	Entries     []*exportEnumEntry
+}
+
+type exportField struct {
+	Attrs          string
+	Description    string
+	FirstExtension bool
+}
+
+type exportMessage struct {
+	ID          uint32
+	Name        string
+	Description string
+	Deprecated  string
+	WIP         bool
+	Fields      []*exportField
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s)) //nolint:errcheck
+	return buf.String()
+}
+
+func exportAttrs(attrs [][2]string) string {
+	ret := ""
+	for _, attr := range attrs {
+		if attr[1] != "" {
+			ret += " " + attr[0] + "=\"" + xmlEscape(attr[1]) + "\""
+		}
+	}
+	return ret
+}
+
+func exportType(f *message.FieldMetadata) string {
+	// a single char is handled like a string of length 1
+	if f.ArrayLength == 0 || (f.Type == "char" && f.ArrayLength == 1) {
+		return f.Type
+	}
+	return f.Type + "[" + strconv.Itoa(f.ArrayLength) + "]"
+}
+
+func exportEnumFromMetadata(md *message.EnumMetadata) *exportEnum {
+	bitmask := ""
+	if md.Bitmask {
+		bitmask = "true"
+	}
+
+	ee := &exportEnum{
+		Attrs: exportAttrs([][2]string{
+			{"name", md.Name},
+			{"bitmask", bitmask},
+		}),
+		Description: xmlEscape(md.Description),
+		Deprecated:  md.Deprecated,
+		WIP:         md.WIP,
+	}
+
+	for _, entry := range md.Entries {
+		ee.Entries = append(ee.Entries, &exportEnumEntry{
+			Attrs: exportAttrs([][2]string{
+				{"value", strconv.FormatUint(entry.Value, 10)},
+				{"name", entry.Name},
+			}),
+			Description: xmlEscape(entry.Description),
+			Deprecated:  entry.Deprecated,
+			WIP:         entry.WIP,
+		})
+	}
+
+	return ee
+}
+
+func exportMessageFromMetadata(md *message.MessageMetadata) *exportMessage {
+	em := &exportMessage{
+		ID:          md.ID,
+		Name:        md.Name,
+		Description: xmlEscape(md.Description),
+		WIP:         md.WIP,
+	}
+
+	if md.Deprecated {
+		em.Deprecated = "<deprecated" + exportAttrs([][2]string{
+			{"since", md.DeprecatedSince},
+			{"replaced_by", md.ReplacedBy},
+		}) + "/>"
+	}
+
+	inExtensions := false
+
+	for _, f := range md.Fields {
+		ef := &exportField{
+			Description: xmlEscape(f.Description),
+		}
+
+		if f.Extension && !inExtensions {
+			inExtensions = true
+			ef.FirstExtension = true
+		}
+
+		display := ""
+		if f.Bitmask {
+			display = "bitmask"
+		}
+
+		instance := ""
+		if f.Instance {
+			instance = "true"
+		}
+
+		ef.Attrs = exportAttrs([][2]string{
+			{"type", exportType(f)},
+			{"name", f.Name},
+			{"enum", f.Enum},
+			{"display", display},
+			{"units", f.Units},
+			{"invalid", f.Invalid},
+			{"multiplier", f.Multiplier},
+			{"minValue", f.MinValue},
+			{"maxValue", f.MaxValue},
+			{"increment", f.Increment},
+			{"instance", instance},
+			{"print_format", f.PrintFormat},
+		})
+
+		em.Fields = append(em.Fields, ef)
+	}
+
+	return em
+}
+
+// verifyExport checks that messages of an exported definition
+// have the same CRC extra and size of the original ones.
+func verifyExport(buf []byte, rws []*message.ReadWriter) error {
+	def, err := definitionDecode(buf)
+	if err != nil {
+		return err
+	}
+
+	for i, msgDef := range def.Messages {
+		outMsg, err := processMessage("", msgDef)
+		if err != nil {
+			return fmt.Errorf("message %s: %w", msgDef.Name, err)
+		}
+
+		rw := rws[i]
+
+		if outMsg.CRCExtra != rw.CRCExtra() {
+			return fmt.Errorf("message %s: CRC extra of the exported definition (%d) does not match "+
+				"the one of the message (%d)", msgDef.Name, outMsg.CRCExtra, rw.CRCExtra())
+		}
+
+		if outMsg.SizeNormal != rw.Size(false) || outMsg.SizeExtended != rw.Size(true) {
+			return fmt.Errorf("message %s: size of the exported definition does not match "+
+				"the one of the message", msgDef.Name)
+		}
+	}
+
+	return nil
+}
+
+// ExportOptions contains options of ExportDialect.
+type ExportOptions struct {
+	// (optional) definitions included by the exported definition (i.e. "common.xml").
+	// When set without IncludedDialects, they are supposed to contain enums used by messages,
+	// and enums of the dialect are not exported.
+	Includes []string
+
+	// (optional) dialects that correspond to Includes.
+	// Their messages, enums and enum entries are not exported.
+	IncludedDialects []*dialect.Dialect
+
+	// (optional) dialect number.
+	Dialect int
+}
+
+// includedItems contains messages and enums of included dialects.
+type includedItems struct {
+	crcExtras map[uint32]byte
+	entries   map[string]map[string]struct{}
+}
+
+func newIncludedItems(dialects []*dialect.Dialect) (*includedItems, error) {
+	ret := &includedItems{
+		crcExtras: make(map[uint32]byte),
+		entries:   make(map[string]map[string]struct{}),
+	}
+
+	for _, d := range dialects {
+		for _, msg := range d.Messages {
+			rw := &message.ReadWriter{Message: msg}
+			err := rw.Initialize()
+			if err != nil {
+				return nil, fmt.Errorf("included message %T: %w", msg, err)
+			}
+			ret.crcExtras[msg.GetID()] = rw.CRCExtra()
+		}
+
+		for name, enum := range d.Enums {
+			entries, ok := ret.entries[name]
+			if !ok {
+				entries = make(map[string]struct{})
+				ret.entries[name] = entries
+			}
+			for _, entry := range enum.Entries {
+				entries[entry.Name] = struct{}{}
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// exportedEnum returns the part of an enum that is not defined by included dialects,
+// or nil if the whole enum is defined by them.
+func (inc *includedItems) exportedEnum(md *message.EnumMetadata) *message.EnumMetadata {
+	entries, ok := inc.entries[md.Name]
+	if !ok {
+		return md
+	}
+
+	// entries are added to an enum of the included definitions
+	ret := &message.EnumMetadata{
+		Name:    md.Name,
+		Bitmask: md.Bitmask,
+	}
+	for _, entry := range md.Entries {
+		if _, included := entries[entry.Name]; !included {
+			ret.Entries = append(ret.Entries, entry)
+		}
+	}
+
+	if len(ret.Entries) == 0 {
+		return nil
+	}
+	return ret
+}
+
+// ExportDialect converts a dialect into a XML definition.
+// Names, array lengths, enums and extensions of fields are obtained from the
+// mavname, mavlen, mavenum and mavext struct tags. Descriptions, units and other
+// attributes are exported when messages provide metadata.
+// When there are no includes, or when included dialects are provided,
+// enums are obtained from Dialect.Enums and every enum used by messages
+// must be present there or in included dialects.
+// The CRC extra of exported messages is checked against the one of original messages.
+func ExportDialect(d *dialect.Dialect, opts ExportOptions) ([]byte, error) {
+	inc, err := newIncludedItems(opts.IncludedDialects)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*exportMessage
+	var rws []*message.ReadWriter
+	exportEnums := len(opts.Includes) == 0 || len(opts.IncludedDialects) != 0
+	dialectEnums := d.Enums
+
+	for _, msg := range d.Messages {
+		rw := &message.ReadWriter{Message: msg}
+		err = rw.Initialize()
+		if err != nil {
+			return nil, fmt.Errorf("message %T: %w", msg, err)
+		}
+
+		if crcExtra, ok := inc.crcExtras[msg.GetID()]; ok {
+			if crcExtra != rw.CRCExtra() {
+				return nil, fmt.Errorf("message %T: ID %d is used by a different message of included dialects",
+					msg, msg.GetID())
+			}
+			continue
+		}
+
+		var md *message.MessageMetadata
+		md, err = message.GetMetadata(msg)
+		if err != nil {
+			return nil, fmt.Errorf("message %T: %w", msg, err)
+		}
+
+		msgs = append(msgs, exportMessageFromMetadata(md))
+		rws = append(rws, rw)
+
+		if exportEnums {
+			for _, f := range md.Fields {
+				if f.Enum == "" {
+					continue
+				}
+				_, inDialect := dialectEnums[f.Enum]
+				_, inIncludes := inc.entries[f.Enum]
+				if !inDialect && !inIncludes {
+					return nil, fmt.Errorf("message %T: enum %s is not part of the dialect", msg, f.Enum)
+				}
+			}
+		}
+	}
+
+	var enums []*exportEnum
+
+	if exportEnums {
+		names := make([]string, 0, len(dialectEnums))
+		for name := range dialectEnums {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if enum := inc.exportedEnum(dialectEnums[name]); enum != nil {
+				enums = append(enums, exportEnumFromMetadata(enum))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	err = tplExport.Execute(&buf, map[string]any{
+		"Includes": opts.Includes,
+		"Version":  d.Version,
+		"Dialect":  opts.Dialect,
+		"Enums":    enums,
+		"Messages": msgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyExport(buf.Bytes(), rws)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
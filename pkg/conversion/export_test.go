@@ -0,0 +1,280 @@
+package conversion_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/minimal"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+type MY_ENUM uint64 //nolint:revive
+
+type MessageMyCustom struct {
+	Param1 uint8
+	Param2 uint32   `mavname:"PARAM_2"`
+	Values [4]int16 `mavname:"values_ptr"`
+	Text   string   `mavlen:"10"`
+	Char   string
+	Mode   MY_ENUM    `mavenum:"uint8"`
+	Modes  [2]MY_ENUM `mavenum:"uint16"`
+	Ext    float32    `mavext:"true"`
+	Ext2   string     `mavext:"true" mavlen:"4"`
+}
+
+func (*MessageMyCustom) GetID() uint32 {
+	return 304
+}
+
+type invalidMessage struct{}
+
+func (*invalidMessage) GetID() uint32 {
+	return 305
+}
+
+type MessageOtherHeartbeat struct {
+	Value uint8
+}
+
+func (*MessageOtherHeartbeat) GetID() uint32 {
+	return 0
+}
+
+const testExportXML = `<?xml version="1.0"?>
+<mavlink>
+  <include>minimal.xml</include>
+  <version>3</version>
+  <dialect>5</dialect>
+  <messages>
+    <message id="304" name="MY_CUSTOM">
+      <field type="uint8_t" name="param1"></field>
+      <field type="uint32_t" name="PARAM_2"></field>
+      <field type="int16_t[4]" name="values_ptr"></field>
+      <field type="char[10]" name="text"></field>
+      <field type="char" name="char"></field>
+      <field type="uint8_t" name="mode" enum="MY_ENUM"></field>
+      <field type="uint16_t[2]" name="modes" enum="MY_ENUM"></field>
+      <extensions/>
+      <field type="float" name="ext"></field>
+      <field type="char[4]" name="ext2"></field>
+    </message>
+    <message id="0" name="HEARTBEAT">
+      <description>The heartbeat message shows that a system or component is present and responding. The type and autopilot fields (along with the message component id), allow the receiving system to treat further messages from this system appropriately (e.g. by laying out the user interface based on the autopilot). This microservice is documented at https://mavlink.io/en/services/heartbeat.html</description>
+      <field type="uint8_t" name="type" enum="MAV_TYPE">Vehicle or component type. For a flight controller component the vehicle type (quadrotor, helicopter, etc.). For other components the component type (e.g. camera, gimbal, etc.). This should be used in preference to component id for identifying the component type.</field>
+      <field type="uint8_t" name="autopilot" enum="MAV_AUTOPILOT">Autopilot type / class. Use MAV_AUTOPILOT_INVALID for components that are not flight controllers.</field>
+      <field type="uint8_t" name="base_mode" enum="MAV_MODE_FLAG" display="bitmask">System mode bitmap.</field>
+      <field type="uint32_t" name="custom_mode">A bitfield for use for autopilot-specific flags</field>
+      <field type="uint8_t" name="system_status" enum="MAV_STATE">System status flag.</field>
+      <field type="uint8_t" name="mavlink_version">MAVLink version, not writable by user, gets added by protocol because of magic data type: uint8_t_mavlink_version</field>
+    </message>
+    <message id="43000" name="A_MESSAGE">
+      <deprecated since="2024-01" replaced_by="B_MESSAGE"/>
+      <wip/>
+      <description>A message &amp; its description.</description>
+      <field type="uint64_t" name="timestamp" units="us">Timestamp.</field>
+      <field type="uint16_t" name="flags" enum="A_FLAGS" display="bitmask" invalid="0">Flags &lt;bitmask&gt;.</field>
+      <field type="float[3]" name="values" units="m" invalid="[NaN]">Values.</field>
+      <field type="uint8_t" name="id" instance="true">Instance.</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testExportSource = `<?xml version="1.0"?>
+<mavlink>
+  <enums>
+    <enum name="A_FLAGS" bitmask="true">
+      <entry value="1" name="A_FLAGS_X"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="43000" name="A_MESSAGE">
+      <description>A message &amp; its description.</description>
+      <deprecated since="2024-01" replaced_by="B_MESSAGE"/>
+      <wip/>
+      <field type="uint64_t" name="timestamp" units="us">Timestamp.</field>
+      <field type="uint16_t" name="flags" enum="A_FLAGS" invalid="0">Flags &lt;bitmask&gt;.</field>
+      <field type="float[3]" name="values" units="m" invalid="[NaN]">Values.</field>
+      <field type="uint8_t" name="id" instance="true">Instance.</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestExportDialect(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// messages loaded from XML provide metadata
+	sourcePath := filepath.Join(dir, "source.xml")
+	err = os.WriteFile(sourcePath, []byte(testExportSource), 0o644)
+	require.NoError(t, err)
+
+	source, err := conversion.LoadDialect(sourcePath)
+	require.NoError(t, err)
+
+	d := &dialect.Dialect{
+		Version: 3,
+		Messages: []message.Message{
+			&MessageMyCustom{},
+			&minimal.MessageHeartbeat{},
+			source.Messages[0],
+		},
+	}
+
+	buf, err := conversion.ExportDialect(d, conversion.ExportOptions{
+		Includes: []string{"minimal.xml"},
+		Dialect:  5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, testExportXML, string(buf))
+
+	// load the exported definition and compare CRC extras
+	// enums are defined in the included definition
+	err = os.WriteFile(filepath.Join(dir, "minimal.xml"), []byte(`<?xml version="1.0"?><mavlink></mavlink>`), 0o644)
+	require.NoError(t, err)
+
+	fpath := filepath.Join(dir, "exported.xml")
+	err = os.WriteFile(fpath, buf, 0o644)
+	require.NoError(t, err)
+
+	d2, err := conversion.LoadDialect(fpath)
+	require.NoError(t, err)
+	require.Len(t, d2.Messages, 3)
+
+	for i, msg := range d.Messages {
+		rw1 := &message.ReadWriter{Message: msg}
+		err = rw1.Initialize()
+		require.NoError(t, err)
+
+		rw2 := &message.ReadWriter{Message: d2.Messages[i]}
+		err = rw2.Initialize()
+		require.NoError(t, err)
+
+		require.Equal(t, rw1.CRCExtra(), rw2.CRCExtra())
+		require.Equal(t, rw1.Fields(), rw2.Fields())
+	}
+}
+
+func TestExportDialectEnums(t *testing.T) {
+	buf, err := conversion.ExportDialect(common.Dialect, conversion.ExportOptions{})
+	require.NoError(t, err)
+	require.Contains(t, string(buf), `<enum name="MAV_MODE_FLAG" bitmask="true">`)
+	require.Contains(t, string(buf), `<entry value="0" name="MAV_FRAME_GLOBAL">`)
+
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "common.xml")
+	err = os.WriteFile(fpath, buf, 0o644)
+	require.NoError(t, err)
+
+	d, err := conversion.LoadDialect(fpath)
+	require.NoError(t, err)
+	require.Equal(t, len(common.Dialect.Enums), len(d.Enums))
+
+	for name, enum := range common.Dialect.Enums {
+		enum2 := d.Enums[name]
+		require.NotNil(t, enum2, name)
+		require.Equal(t, enum.Bitmask, enum2.Bitmask, name)
+		require.Equal(t, enum.Entries, enum2.Entries, name)
+	}
+}
+
+func TestExportDialectIncludedDialects(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	buf, err := conversion.ExportDialect(common.Dialect, conversion.ExportOptions{})
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, "common.xml"), buf, 0o644)
+	require.NoError(t, err)
+
+	buf, err = conversion.ExportDialect(ardupilotmega.Dialect, conversion.ExportOptions{
+		Includes:         []string{"common.xml"},
+		IncludedDialects: []*dialect.Dialect{common.Dialect},
+	})
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), `name="HEARTBEAT"`)
+	require.NotContains(t, string(buf), `name="MAV_TYPE"`)
+	require.Contains(t, string(buf), `<message id="150" name="SENSOR_OFFSETS">`)
+	require.Contains(t, string(buf), `<enum name="MAV_CMD">`)
+
+	fpath := filepath.Join(dir, "ardupilotmega.xml")
+	err = os.WriteFile(fpath, buf, 0o644)
+	require.NoError(t, err)
+
+	d, err := conversion.LoadDialect(fpath)
+	require.NoError(t, err)
+	require.Equal(t, len(ardupilotmega.Dialect.Messages), len(d.Messages))
+	require.Equal(t, len(ardupilotmega.Dialect.Enums), len(d.Enums))
+
+	for name, enum := range ardupilotmega.Dialect.Enums {
+		require.ElementsMatch(t, enum.Entries, d.Enums[name].Entries, name)
+	}
+
+	_, err = conversion.ExportDialect(&dialect.Dialect{
+		Messages: []message.Message{&MessageOtherHeartbeat{}},
+	}, conversion.ExportOptions{
+		Includes:         []string{"minimal.xml"},
+		IncludedDialects: []*dialect.Dialect{minimal.Dialect},
+	})
+	require.EqualError(t, err, "message *conversion_test.MessageOtherHeartbeat: "+
+		"ID 0 is used by a different message of included dialects")
+}
+
+func TestExportDialectCompile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	buf, err := conversion.ExportDialect(common.Dialect, conversion.ExportOptions{})
+	require.NoError(t, err)
+
+	// generate into a directory of the module, in order to build code with the module
+	_, file, _, _ := runtime.Caller(0)
+	pkgDir := filepath.Dir(file)
+
+	dir, err := os.MkdirTemp(pkgDir, "export-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "common.xml")
+	err = os.WriteFile(fpath, buf, 0o644)
+	require.NoError(t, err)
+
+	err = conversion.ConvertWithOptions(fpath, conversion.ConvertOptions{
+		OutputDir: dir,
+	})
+	require.NoError(t, err)
+
+	cmd := exec.Command("go", "build", "./"+filepath.Base(dir)+"/common")
+	cmd.Dir = pkgDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestExportDialectError(t *testing.T) {
+	_, err := conversion.ExportDialect(&dialect.Dialect{
+		Messages: []message.Message{&invalidMessage{}},
+	}, conversion.ExportOptions{})
+	require.EqualError(t, err, "message *conversion_test.invalidMessage: struct name must begin with 'Message'")
+
+	_, err = conversion.ExportDialect(&dialect.Dialect{
+		Messages: []message.Message{&MessageMyCustom{}},
+	}, conversion.ExportOptions{})
+	require.EqualError(t, err, "message *conversion_test.MessageMyCustom: enum MY_ENUM is not part of the dialect")
+}
@@ -0,0 +1,631 @@
+package conversion
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LintSeverity is the severity of a LintDiagnostic.
+type LintSeverity string
+
+// severities.
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+// LintDiagnostic is a problem found in a definition.
+type LintDiagnostic struct {
+	// path of the definition.
+	File string `json:"file"`
+
+	// line of the definition.
+	Line int `json:"line"`
+
+	// severity of the problem.
+	Severity LintSeverity `json:"severity"`
+
+	// identifier of the rule that found the problem.
+	Rule string `json:"rule"`
+
+	// name of the enum, entry, message or field (MESSAGE.field) the problem refers to, if any.
+	Target string `json:"target,omitempty"`
+
+	// description of the problem.
+	Message string `json:"message"`
+}
+
+// String implements fmt.Stringer.
+func (d *LintDiagnostic) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s (%s)", d.File, d.Line, d.Severity, d.Message, d.Rule)
+}
+
+// LintOptions contains options of Lint.
+type LintOptions struct {
+	// (optional) directories in which included definitions are searched,
+	// in addition to the directory of the including definition.
+	IncludeDirs []string
+
+	// (optional) whether the dialect is used with Mavlink v1,
+	// that supports message IDs up to 255 only.
+	V1 bool
+
+	// (optional) problems to ignore, in the format RULE or RULE:TARGET,
+	// where TARGET is the name of an enum, entry, message or field (MESSAGE.field).
+	// For instance, enum-overflow:GIMBAL_DEVICE_INFORMATION.cap_flags.
+	Ignore []string
+}
+
+// HasLintErrors returns whether diagnostics contain at least an error.
+func HasLintErrors(diags []*LintDiagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	maxMessageID   = 1<<24 - 1
+	maxMessageIDV1 = 255
+	maxPayloadSize = 255
+)
+
+var reLintName = regexp.MustCompile("^[A-Za-z][A-Za-z0-9_]*$")
+
+// children allowed in each element; others are reported as warnings.
+var allowedChildren = map[string]map[string]struct{}{
+	"mavlink": {
+		"include": {}, "version": {}, "dialect": {}, "enums": {}, "messages": {},
+	},
+	"enums":    {"enum": {}},
+	"messages": {"message": {}},
+	"enum":     {"description": {}, "entry": {}, "deprecated": {}, "wip": {}},
+	"entry":    {"description": {}, "param": {}, "deprecated": {}, "wip": {}},
+	"message":  {"description": {}, "field": {}, "extensions": {}, "deprecated": {}, "wip": {}},
+}
+
+// lintElement is a XML element with its position.
+type lintElement struct {
+	name     string
+	attrs    map[string]string
+	line     int
+	text     string
+	children []*lintElement
+}
+
+func (e *lintElement) child(name string) *lintElement {
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (e *lintElement) description() string {
+	if d := e.child("description"); d != nil {
+		return strings.TrimSpace(d.text)
+	}
+	// fields and params use their content as description
+	return strings.TrimSpace(e.text)
+}
+
+func parseLintTree(content []byte) (*lintElement, error) {
+	dec := xml.NewDecoder(bytes.NewReader(content))
+
+	var root *lintElement
+	var stack []*lintElement
+	offset := int64(0)
+	line := 1
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		switch tt := tok.(type) {
+		case xml.StartElement:
+			el := &lintElement{
+				name:  tt.Name.Local,
+				attrs: make(map[string]string),
+				line:  line,
+			}
+			for _, a := range tt.Attr {
+				el.attrs[a.Name.Local] = a.Value
+			}
+
+			if len(stack) == 0 {
+				root = el
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, el)
+			}
+			stack = append(stack, el)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if len(stack) != 0 {
+				stack[len(stack)-1].text += string(tt)
+			}
+		}
+
+		newOffset := dec.InputOffset()
+		line += bytes.Count(content[offset:newOffset], []byte("\n"))
+		offset = newOffset
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	return root, nil
+}
+
+type lintMessage struct {
+	file string
+	el   *lintElement
+}
+
+type lintEnumEntry struct {
+	file  string
+	el    *lintElement
+	value uint64
+}
+
+type lintEnum struct {
+	entries []*lintEnumEntry
+}
+
+type linter struct {
+	opts          LintOptions
+	processedDefs map[string]struct{}
+	diags         []*LintDiagnostic
+	messages      []*lintMessage
+	enums         map[string]*lintEnum
+}
+
+func (l *linter) add(
+	file string,
+	line int,
+	sev LintSeverity,
+	rule string,
+	target string,
+	format string,
+	args ...any,
+) {
+	l.diags = append(l.diags, &LintDiagnostic{
+		File:     file,
+		Line:     line,
+		Severity: sev,
+		Rule:     rule,
+		Target:   target,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) isIgnored(d *LintDiagnostic) bool {
+	for _, ign := range l.opts.Ignore {
+		rule, target, hasTarget := strings.Cut(ign, ":")
+		if rule == d.Rule && (!hasTarget || target == d.Target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *linter) removeIgnored() {
+	n := 0
+	for _, d := range l.diags {
+		if !l.isIgnored(d) {
+			l.diags[n] = d
+			n++
+		}
+	}
+	l.diags = l.diags[:n]
+}
+
+func (l *linter) checkAttrs(file string, el *lintElement, names ...string) bool {
+	ok := true
+	for _, name := range names {
+		if el.attrs[name] == "" {
+			l.add(file, el.line, LintError, "schema", "", "%s is missing the '%s' attribute", el.name, name)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (l *linter) checkChildren(file string, el *lintElement) {
+	allowed, ok := allowedChildren[el.name]
+	if !ok {
+		return
+	}
+
+	for _, c := range el.children {
+		if _, ok = allowed[c.name]; !ok {
+			l.add(file, c.line, LintWarning, "schema", "", "unexpected element '%s' in '%s'", c.name, el.name)
+		}
+		l.checkChildren(file, c)
+	}
+}
+
+func (l *linter) processDefinition(isRemote bool, defAddr string, parentAddr string, parentLine int) {
+	if _, ok := l.processedDefs[defAddr]; ok {
+		return
+	}
+	l.processedDefs[defAddr] = struct{}{}
+
+	content, err := getDefinition(isRemote, defAddr)
+	if err != nil {
+		// report the error in the including definition
+		if parentAddr != "" {
+			l.add(parentAddr, parentLine, LintError, "include", "", "%s: %v", defAddr, err)
+		} else {
+			l.add(defAddr, 0, LintError, "include", "", "%v", err)
+		}
+		return
+	}
+
+	root, err := parseLintTree(content)
+	if err != nil {
+		var serr *xml.SyntaxError
+		if errors.As(err, &serr) {
+			l.add(defAddr, serr.Line, LintError, "syntax", "", "%s", serr.Msg)
+		} else {
+			l.add(defAddr, 0, LintError, "syntax", "", "%v", err)
+		}
+		return
+	}
+
+	if root.name != "mavlink" {
+		l.add(defAddr, root.line, LintError, "schema", "", "root element is '%s' instead of 'mavlink'", root.name)
+		return
+	}
+
+	l.checkChildren(defAddr, root)
+
+	addrPath, _ := filepath.Split(defAddr)
+
+	for _, c := range root.children {
+		if c.name != "include" {
+			continue
+		}
+
+		subDefAddr := strings.TrimSpace(c.text)
+		if isRemote {
+			subDefAddr = addrPath + subDefAddr
+		} else {
+			subDefAddr = resolveInclude(addrPath, subDefAddr, l.opts.IncludeDirs)
+		}
+		l.processDefinition(isRemote, subDefAddr, defAddr, c.line)
+	}
+
+	if enums := root.child("enums"); enums != nil {
+		for _, enum := range enums.children {
+			if enum.name == "enum" {
+				l.processEnum(defAddr, enum)
+			}
+		}
+	}
+
+	if messages := root.child("messages"); messages != nil {
+		for _, msg := range messages.children {
+			if msg.name == "message" {
+				l.processMessage(defAddr, msg)
+			}
+		}
+	}
+}
+
+func parseEnumValue(v string) (uint64, error) {
+	switch {
+	case strings.HasPrefix(v, "0b"):
+		return strconv.ParseUint(v[2:], 2, 64)
+
+	case strings.HasPrefix(v, "0x"):
+		return strconv.ParseUint(v[2:], 16, 64)
+
+	case strings.Contains(v, "**"):
+		parts := strings.SplitN(v, "**", 2)
+
+		x, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		y, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		return uintPow(x, y), nil
+	}
+
+	return strconv.ParseUint(v, 10, 64)
+}
+
+func (l *linter) checkGoIdentifier(file string, el *lintElement, name string) {
+	if token.IsKeyword(name) || !token.IsIdentifier(name) {
+		l.add(file, el.line, LintError, "go-identifier", name, "%s name '%s' is not a valid Go identifier", el.name, name)
+	}
+}
+
+func (l *linter) processEnum(file string, el *lintElement) {
+	if !l.checkAttrs(file, el, "name") {
+		return
+	}
+
+	name := el.attrs["name"]
+	l.checkGoIdentifier(file, el, name)
+
+	enum, ok := l.enums[name]
+	if !ok {
+		enum = &lintEnum{}
+		l.enums[name] = enum
+
+		if el.description() == "" {
+			l.add(file, el.line, LintWarning, "missing-description", name, "enum %s has no description", name)
+		}
+	}
+
+	for _, entry := range el.children {
+		if entry.name != "entry" || !l.checkAttrs(file, entry, "name", "value") {
+			continue
+		}
+
+		entryName := entry.attrs["name"]
+		l.checkGoIdentifier(file, entry, entryName)
+
+		v, err := parseEnumValue(entry.attrs["value"])
+		if err != nil {
+			l.add(file, entry.line, LintError, "schema", entryName, "entry %s has an invalid value '%s'",
+				entryName, entry.attrs["value"])
+			continue
+		}
+
+		duplicate := false
+		for _, prev := range enum.entries {
+			if prev.el.attrs["name"] == entryName {
+				l.add(file, entry.line, LintError, "duplicate-enum-entry", entryName,
+					"entry %s of enum %s is already defined at %s:%d", entryName, name, prev.file, prev.el.line)
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+
+		if entry.description() == "" && entry.child("param") == nil {
+			l.add(file, entry.line, LintWarning, "missing-description", entryName, "entry %s has no description", entryName)
+		}
+
+		enum.entries = append(enum.entries, &lintEnumEntry{file: file, el: entry, value: v})
+	}
+}
+
+func (l *linter) processMessage(file string, el *lintElement) {
+	if !l.checkAttrs(file, el, "name", "id") {
+		return
+	}
+
+	name := el.attrs["name"]
+
+	if !reMsgName.MatchString(name) {
+		l.add(file, el.line, LintError, "schema", name, "message name '%s' is not uppercase", name)
+	}
+
+	id, err := strconv.ParseUint(el.attrs["id"], 10, 32)
+	if err != nil {
+		l.add(file, el.line, LintError, "schema", name, "message %s has an invalid ID '%s'", name, el.attrs["id"])
+		return
+	}
+
+	switch {
+	case id > maxMessageID:
+		l.add(file, el.line, LintError, "message-id-range", name, "ID of message %s is greater than %d", name, maxMessageID)
+
+	case l.opts.V1 && id > maxMessageIDV1:
+		l.add(file, el.line, LintError, "message-id-range", name,
+			"ID of message %s is greater than %d and can't be used with Mavlink v1", name, maxMessageIDV1)
+	}
+
+	if el.description() == "" {
+		l.add(file, el.line, LintWarning, "missing-description", name, "message %s has no description", name)
+	}
+
+	l.messages = append(l.messages, &lintMessage{file: file, el: el})
+}
+
+func fieldTypeRange(typ string) (uint64, bool) {
+	switch typ {
+	case "uint8_t", "int8_t":
+		return 1<<8 - 1, true
+	case "uint16_t", "int16_t":
+		return 1<<16 - 1, true
+	case "uint32_t", "int32_t":
+		return 1<<32 - 1, true
+	case "uint64_t", "int64_t":
+		return 1<<64 - 1, true
+	}
+	return 0, false
+}
+
+func (l *linter) checkMessage(m *lintMessage) {
+	name := m.el.attrs["name"]
+	goNames := make(map[string]string)
+	payloadSize := 0
+	payloadSizeExt := 0
+	inExtensions := false
+
+	for _, f := range m.el.children {
+		if f.name == "extensions" {
+			inExtensions = true
+			continue
+		}
+		if f.name != "field" || !l.checkAttrs(m.file, f, "name", "type") {
+			continue
+		}
+
+		fieldName := f.attrs["name"]
+
+		if !reLintName.MatchString(fieldName) {
+			l.add(m.file, f.line, LintError, "schema", name+"."+fieldName,
+				"field name '%s' of message %s is invalid", fieldName, name)
+			continue
+		}
+
+		goName := dialectNameDefToGo(fieldName)
+		if prev, ok := goNames[goName]; ok {
+			l.add(m.file, f.line, LintError, "go-identifier", name+"."+fieldName,
+				"fields '%s' and '%s' of message %s are both converted into '%s'", prev, fieldName, name, goName)
+		}
+		goNames[goName] = fieldName
+
+		typ := f.attrs["type"]
+		arrayLen := 0
+
+		if matches := reTypeIsArray.FindStringSubmatch(typ); matches != nil {
+			typ = matches[1]
+			arrayLen, _ = strconv.Atoi(matches[2])
+
+			if typ == "uint8_t_mavlink_version" {
+				l.add(m.file, f.line, LintError, "unsupported-array", name+"."+fieldName,
+					"field '%s' of message %s is an array of unsupported type %s", fieldName, name, typ)
+				continue
+			}
+
+			if arrayLen == 0 {
+				l.add(m.file, f.line, LintError, "unsupported-array", name+"."+fieldName,
+					"field '%s' of message %s is an array of length zero", fieldName, name)
+			}
+		}
+
+		if typ == "uint8_t_mavlink_version" {
+			typ = "uint8_t"
+		}
+
+		size, ok := dialectTypeSizes[typ]
+		if !ok {
+			l.add(m.file, f.line, LintError, "schema", name+"."+fieldName, "field '%s' of message %s has unknown type '%s'",
+				fieldName, name, f.attrs["type"])
+			continue
+		}
+
+		if arrayLen != 0 {
+			size *= arrayLen
+		}
+		if inExtensions {
+			payloadSizeExt += size
+		} else {
+			payloadSize += size
+		}
+
+		if strings.TrimSpace(f.text) == "" {
+			l.add(m.file, f.line, LintWarning, "missing-description", name+"."+fieldName,
+				"field '%s' of message %s has no description", fieldName, name)
+		}
+
+		enumName := f.attrs["enum"]
+		if enumName == "" {
+			continue
+		}
+
+		enum, ok := l.enums[enumName]
+		if !ok {
+			l.add(m.file, f.line, LintError, "undefined-enum", name+"."+fieldName,
+				"field '%s' of message %s references undefined enum %s", fieldName, name, enumName)
+			continue
+		}
+
+		maxValue, ok := fieldTypeRange(typ)
+		if !ok {
+			l.add(m.file, f.line, LintError, "schema", name+"."+fieldName,
+				"field '%s' of message %s has type %s, that can't be an enum", fieldName, name, typ)
+			continue
+		}
+
+		for _, entry := range enum.entries {
+			if entry.value > maxValue {
+				l.add(m.file, f.line, LintError, "enum-overflow", name+"."+fieldName,
+					"value %d of entry %s (%s:%d) overflows field '%s' of message %s, of type %s",
+					entry.value, entry.el.attrs["name"], entry.file, entry.el.line, fieldName, name, typ)
+			}
+		}
+	}
+
+	if payloadSize+payloadSizeExt > maxPayloadSize {
+		l.add(m.file, m.el.line, LintError, "payload-size", name,
+			"payload of message %s is %d bytes, more than %d", name, payloadSize+payloadSizeExt, maxPayloadSize)
+	}
+}
+
+func (l *linter) checkMessages() {
+	byID := make(map[string]*lintMessage)
+	byGoName := make(map[string]*lintMessage)
+
+	for _, m := range l.messages {
+		id := m.el.attrs["id"]
+		name := m.el.attrs["name"]
+
+		if prev, ok := byID[id]; ok {
+			l.add(m.file, m.el.line, LintError, "duplicate-message-id", name,
+				"ID %s of message %s is already used by message %s at %s:%d",
+				id, name, prev.el.attrs["name"], prev.file, prev.el.line)
+		} else {
+			byID[id] = m
+		}
+
+		goName := dialectNameDefToGo(name)
+		if prev, ok := byGoName[goName]; ok {
+			l.add(m.file, m.el.line, LintError, "duplicate-message-name", name,
+				"message %s conflicts with message %s at %s:%d",
+				name, prev.el.attrs["name"], prev.file, prev.el.line)
+		} else {
+			byGoName[goName] = m
+		}
+
+		l.checkMessage(m)
+	}
+}
+
+// Lint checks a XML definition and its includes, and returns problems found.
+// Errors are problems that prevent the definition from being used,
+// warnings are problems that should be fixed.
+func Lint(path string, opts LintOptions) []*LintDiagnostic {
+	l := &linter{
+		opts:          opts,
+		processedDefs: make(map[string]struct{}),
+		enums:         make(map[string]*lintEnum),
+	}
+
+	l.processDefinition(isRemoteAddr(path), path, "", 0)
+	l.checkMessages()
+	l.removeIgnored()
+
+	sort.SliceStable(l.diags, func(i, j int) bool {
+		if l.diags[i].File != l.diags[j].File {
+			return l.diags[i].File < l.diags[j].File
+		}
+		return l.diags[i].Line < l.diags[j].Line
+	})
+
+	return l.diags
+}
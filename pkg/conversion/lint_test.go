@@ -0,0 +1,174 @@
+package conversion_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+)
+
+const testLintIncluded = `<?xml version="1.0"?>
+<mavlink>
+  <enums>
+    <enum name="SMALL_ENUM">
+      <description>An enum.</description>
+      <entry value="1" name="SMALL_ENUM_A">
+        <description>A.</description>
+      </entry>
+    </enum>
+    <enum name="BIG_ENUM">
+      <description>An enum.</description>
+      <entry value="300" name="BIG_ENUM_A">
+        <description>A.</description>
+      </entry>
+    </enum>
+  </enums>
+  <messages>
+    <message id="1" name="FIRST">
+      <description>A message.</description>
+      <field type="uint8_t" name="a">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testLintMain = `<?xml version="1.0"?>
+<mavlink>
+  <include>included.xml</include>
+  <include>missing.xml</include>
+  <enums>
+    <enum name="type">
+      <entry value="1" name="TYPE_A"/>
+      <entry value="abc" name="TYPE_B"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="1" name="SECOND">
+      <description>A message.</description>
+      <field type="uint8_t" name="a" enum="BIG_ENUM">a</field>
+      <field type="uint8_t" name="b" enum="UNDEFINED_ENUM">b</field>
+      <field type="uint8_t_mavlink_version[2]" name="c">c</field>
+      <field type="uint8_t" name="e_f">e</field>
+      <field type="uint8_t" name="E_F">e</field>
+      <field type="float128_t" name="g">g</field>
+      <field type="uint8_t" name="h" enum="SMALL_ENUM"></field>
+      <unknown/>
+    </message>
+    <message id="300" name="THIRD">
+      <description>A message.</description>
+      <field type="uint8_t[255]" name="a">a</field>
+      <field type="uint8_t" name="b">b</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestLint(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = os.WriteFile(filepath.Join(dir, "included.xml"), []byte(testLintIncluded), 0o644)
+	require.NoError(t, err)
+
+	mainPath := filepath.Join(dir, "main.xml")
+	err = os.WriteFile(mainPath, []byte(testLintMain), 0o644)
+	require.NoError(t, err)
+
+	diags := conversion.Lint(mainPath, conversion.LintOptions{V1: true})
+
+	var strs []string
+	for _, d := range diags {
+		rel, err2 := filepath.Rel(dir, d.File)
+		require.NoError(t, err2)
+		d.File = rel
+		strs = append(strs, d.String())
+	}
+
+	require.Equal(t, []string{
+		"main.xml:4: error: " + filepath.Join(dir, "missing.xml") +
+			": unable to open: open " + filepath.Join(dir, "missing.xml") + ": no such file or directory (include)",
+		"main.xml:6: error: enum name 'type' is not a valid Go identifier (go-identifier)",
+		"main.xml:6: warning: enum type has no description (missing-description)",
+		"main.xml:7: warning: entry TYPE_A has no description (missing-description)",
+		"main.xml:8: error: entry TYPE_B has an invalid value 'abc' (schema)",
+		"main.xml:12: error: ID 1 of message SECOND is already used by message FIRST at " +
+			filepath.Join(dir, "included.xml") + ":18 (duplicate-message-id)",
+		"main.xml:14: error: value 300 of entry BIG_ENUM_A (" + filepath.Join(dir, "included.xml") +
+			":12) overflows field 'a' of message SECOND, of type uint8_t (enum-overflow)",
+		"main.xml:15: error: field 'b' of message SECOND references undefined enum UNDEFINED_ENUM (undefined-enum)",
+		"main.xml:16: error: field 'c' of message SECOND is an array of unsupported type " +
+			"uint8_t_mavlink_version (unsupported-array)",
+		"main.xml:18: error: fields 'e_f' and 'E_F' of message SECOND are both converted into 'EF' (go-identifier)",
+		"main.xml:19: error: field 'g' of message SECOND has unknown type 'float128_t' (schema)",
+		"main.xml:20: warning: field 'h' of message SECOND has no description (missing-description)",
+		"main.xml:21: warning: unexpected element 'unknown' in 'message' (schema)",
+		"main.xml:23: error: ID of message THIRD is greater than 255 and can't be used with Mavlink v1 (message-id-range)",
+		"main.xml:23: error: payload of message THIRD is 256 bytes, more than 255 (payload-size)",
+	}, strs)
+
+	require.True(t, conversion.HasLintErrors(diags))
+
+	diags = conversion.Lint(filepath.Join(dir, "included.xml"), conversion.LintOptions{})
+	require.Empty(t, diags)
+	require.False(t, conversion.HasLintErrors(diags))
+}
+
+func TestLintIgnore(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = os.WriteFile(filepath.Join(dir, "included.xml"), []byte(testLintIncluded), 0o644)
+	require.NoError(t, err)
+
+	mainPath := filepath.Join(dir, "main.xml")
+	err = os.WriteFile(mainPath, []byte(testLintMain), 0o644)
+	require.NoError(t, err)
+
+	diags := conversion.Lint(mainPath, conversion.LintOptions{
+		Ignore: []string{
+			"enum-overflow:SECOND.a",
+			"missing-description",
+			"go-identifier:OTHER",
+		},
+	})
+
+	rules := make(map[string]int)
+	for _, d := range diags {
+		rules[d.Rule]++
+	}
+
+	require.NotContains(t, rules, "enum-overflow")
+	require.NotContains(t, rules, "missing-description")
+	require.Equal(t, 2, rules["go-identifier"])
+
+	diags = conversion.Lint(mainPath, conversion.LintOptions{
+		Ignore: []string{"enum-overflow:SECOND.b"},
+	})
+
+	rules = make(map[string]int)
+	for _, d := range diags {
+		rules[d.Rule]++
+	}
+
+	require.Equal(t, 1, rules["enum-overflow"])
+}
+
+func TestLintSyntaxError(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "main.xml")
+	err = os.WriteFile(fpath, []byte("<mavlink>\n<messages>\n</mavlink>\n"), 0o644)
+	require.NoError(t, err)
+
+	diags := conversion.Lint(fpath, conversion.LintOptions{})
+	require.Len(t, diags, 1)
+	require.Equal(t, 3, diags[0].Line)
+	require.Equal(t, "syntax", diags[0].Rule)
+}
@@ -0,0 +1,112 @@
+package conversion
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// LoadDialect loads a XML definition and its includes into a dialect,
+// without generating code.
+// Messages of the dialect are *dynamic.Message.
+func LoadDialect(path string) (*dialect.Dialect, error) {
+	version := ""
+	processedDefs := make(map[string]struct{})
+	isRemote := isRemoteAddr(path)
+
+	// parse all definitions recursively
+	outDefs, err := processDefinition(&version, processedDefs, isRemote, path, nil, io.Discard)
+	if err != nil {
+		return nil, err
+	}
+
+	fillBitmasks(outDefs)
+
+	// merge enums together
+	enums := make(map[string]*dynamic.EnumDefinition)
+	outEnums := make(map[string]*outEnum)
+	for _, def := range outDefs {
+		for _, defEnum := range def.Enums {
+			if existing, ok := outEnums[defEnum.Name]; ok {
+				existing.Values = append(existing.Values, defEnum.Values...)
+			} else {
+				outEnums[defEnum.Name] = &outEnum{
+					Name:        defEnum.Name,
+					Bitmask:     defEnum.Bitmask,
+					Values:      append([]*outEnumValue(nil), defEnum.Values...),
+					description: defEnum.description,
+					deprecated:  defEnum.deprecated,
+					wip:         defEnum.wip,
+				}
+			}
+
+			enum, ok := enums[defEnum.Name]
+			if !ok {
+				enum = &dynamic.EnumDefinition{
+					Name:    defEnum.Name,
+					Bitmask: defEnum.Bitmask,
+				}
+				enums[defEnum.Name] = enum
+			}
+
+			for _, v := range defEnum.Values {
+				enum.Entries = append(enum.Entries, &dynamic.EnumEntry{
+					Name:  v.Name,
+					Value: v.Value,
+				})
+			}
+		}
+	}
+
+	ret := &dialect.Dialect{}
+	ret.Version, _ = strconv.Atoi(version)
+
+	ret.Enums = make(map[string]*message.EnumMetadata)
+	for _, md := range enumsMetadata(outEnums) {
+		ret.Enums[md.Name] = md
+	}
+
+	for _, def := range outDefs {
+		for _, msg := range def.Messages {
+			msgDef := &dynamic.MessageDefinition{
+				ID:       uint32(msg.ID),
+				Name:     msg.OrigName,
+				Metadata: msg.Metadata,
+			}
+
+			for _, f := range msg.Fields {
+				fieldDef := &dynamic.FieldDefinition{
+					Name:        f.origName,
+					Type:        f.wireType,
+					ArrayLength: f.arrayLen,
+					Extension:   f.extension,
+				}
+
+				if f.enum != "" {
+					enum, ok := enums[f.enum]
+					if !ok {
+						// enum is referenced but not defined: values are still handled as enums
+						enum = &dynamic.EnumDefinition{Name: f.enum}
+						enums[f.enum] = enum
+					}
+					fieldDef.Enum = enum
+				}
+
+				msgDef.Fields = append(msgDef.Fields, fieldDef)
+			}
+
+			err = msgDef.Initialize()
+			if err != nil {
+				return nil, fmt.Errorf("message %s: %w", msg.OrigName, err)
+			}
+
+			ret.Messages = append(ret.Messages, msgDef.NewMessage())
+		}
+	}
+
+	return ret, nil
+}
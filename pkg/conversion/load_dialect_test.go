@@ -0,0 +1,222 @@
+package conversion_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+const testLoadDialectMinimal = `<?xml version="1.0"?>
+<mavlink>
+  <version>3</version>
+  <enums>
+    <enum name="MAV_AUTOPILOT">
+      <entry value="3" name="MAV_AUTOPILOT_ARDUPILOTMEGA"/>
+    </enum>
+    <enum name="MAV_MODE_FLAG" bitmask="true">
+      <entry value="128" name="MAV_MODE_FLAG_SAFETY_ARMED"/>
+      <entry value="64" name="MAV_MODE_FLAG_MANUAL_INPUT_ENABLED"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="0" name="HEARTBEAT">
+      <field type="uint8_t" name="type" enum="MAV_TYPE">a</field>
+      <field type="uint8_t" name="autopilot" enum="MAV_AUTOPILOT">a</field>
+      <field type="uint8_t" name="base_mode" enum="MAV_MODE_FLAG" display="bitmask">a</field>
+      <field type="uint32_t" name="custom_mode" units="ms" invalid="UINT32_MAX">A custom mode &amp; more.</field>
+      <field type="uint8_t" name="system_status" enum="MAV_STATE">a</field>
+      <field type="uint8_t_mavlink_version" name="mavlink_version">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testLoadDialectMain = `<?xml version="1.0"?>
+<mavlink>
+  <include>minimal.xml</include>
+  <version>4</version>
+  <enums>
+    <enum name="MAV_AUTOPILOT">
+      <entry value="12" name="MAV_AUTOPILOT_PX4"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="253" name="STATUSTEXT">
+      <field type="uint8_t" name="severity" enum="MAV_SEVERITY">a</field>
+      <field type="char[50]" name="text">a</field>
+      <extensions/>
+      <field type="uint16_t" name="id">a</field>
+      <field type="uint8_t" name="chunk_seq">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestLoadDialect(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.WriteFile("minimal.xml", []byte(testLoadDialectMinimal), 0o644)
+	require.NoError(t, err)
+
+	err = os.WriteFile("main.xml", []byte(testLoadDialectMain), 0o644)
+	require.NoError(t, err)
+
+	d, err := conversion.LoadDialect("main.xml")
+	require.NoError(t, err)
+	require.Equal(t, 4, d.Version)
+	require.Equal(t, 2, len(d.Messages))
+
+	rw := &dialect.ReadWriter{Dialect: d}
+	err = rw.Initialize()
+	require.NoError(t, err)
+
+	heartbeatRW := rw.GetMessage(0)
+	require.Equal(t, byte(50), heartbeatRW.CRCExtra())
+
+	statustextRW := rw.GetMessage(253)
+	require.Equal(t, byte(83), statustextRW.CRCExtra())
+
+	genRW := &message.ReadWriter{Message: &common.MessageHeartbeat{}}
+	err = genRW.Initialize()
+	require.NoError(t, err)
+
+	raw := genRW.Write(&common.MessageHeartbeat{
+		Type:           2,
+		Autopilot:      common.MAV_AUTOPILOT_PX4,
+		BaseMode:       common.MAV_MODE_FLAG_SAFETY_ARMED | common.MAV_MODE_FLAG_MANUAL_INPUT_ENABLED,
+		CustomMode:     65536,
+		SystemStatus:   4,
+		MavlinkVersion: 3,
+	}, true)
+
+	msg, err := heartbeatRW.Read(raw, true)
+	require.NoError(t, err)
+
+	dm := msg.(*dynamic.Message)
+
+	v, _ := dm.Get("custom_mode")
+	require.Equal(t, uint32(65536), v)
+
+	v, _ = dm.Get("autopilot")
+	require.Equal(t, "MAV_AUTOPILOT_PX4", dm.Definition().Field("autopilot").Enum.Label(v.(uint64)))
+
+	md, err := message.GetMetadata(dm)
+	require.NoError(t, err)
+	require.Equal(t, &message.FieldMetadata{
+		Name:        "custom_mode",
+		Type:        "uint32_t",
+		Units:       "ms",
+		Description: "A custom mode & more.",
+		Invalid:     "UINT32_MAX",
+	}, md.Field("custom_mode"))
+	require.Equal(t, &message.FieldMetadata{
+		Name:        "base_mode",
+		Type:        "uint8_t",
+		Enum:        "MAV_MODE_FLAG",
+		Bitmask:     true,
+		Description: "a",
+	}, md.Field("base_mode"))
+
+	require.Equal(t, "HEARTBEAT{type: 2, autopilot: MAV_AUTOPILOT_PX4, "+
+		"base_mode: MAV_MODE_FLAG_SAFETY_ARMED | MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, "+
+		"custom_mode: 65536, system_status: 4, mavlink_version: 3}", dm.String())
+
+	require.Equal(t, &message.EnumMetadata{
+		Name: "MAV_AUTOPILOT",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_AUTOPILOT_ARDUPILOTMEGA", Value: 3},
+			{Name: "MAV_AUTOPILOT_PX4", Value: 12},
+		},
+	}, d.Enums[md.Field("autopilot").Enum])
+	require.True(t, d.Enums["MAV_MODE_FLAG"].Bitmask)
+}
+
+// SYS_STATUS as defined by the mavlink repository, with units and invalid values.
+const testLoadDialectSysStatus = `<?xml version="1.0"?>
+<mavlink>
+  <version>3</version>
+  <messages>
+    <message id="1" name="SYS_STATUS">
+      <field type="uint32_t" name="onboard_control_sensors_present" display="bitmask">a</field>
+      <field type="uint32_t" name="onboard_control_sensors_enabled" display="bitmask">a</field>
+      <field type="uint32_t" name="onboard_control_sensors_health" display="bitmask">a</field>
+      <field type="uint16_t" name="load" units="d%">a</field>
+      <field type="uint16_t" name="voltage_battery" units="mV" invalid="UINT16_MAX">Battery voltage.</field>
+      <field type="int16_t" name="current_battery" units="cA" invalid="-1">Battery current.</field>
+      <field type="int8_t" name="battery_remaining" units="%" invalid="-1">Battery energy remaining.</field>
+      <field type="uint16_t" name="drop_rate_comm" units="c%">a</field>
+      <field type="uint16_t" name="errors_comm">a</field>
+      <field type="uint16_t" name="errors_count1">a</field>
+      <field type="uint16_t" name="errors_count2">a</field>
+      <field type="uint16_t" name="errors_count3">a</field>
+      <field type="uint16_t" name="errors_count4">a</field>
+      <extensions/>
+      <field type="uint32_t" name="onboard_control_sensors_present_extended" display="bitmask">a</field>
+      <field type="uint32_t" name="onboard_control_sensors_enabled_extended" display="bitmask">a</field>
+      <field type="uint32_t" name="onboard_control_sensors_health_extended" display="bitmask">a</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+func TestLoadDialectInvalid(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Chdir(dir)
+
+	err = os.WriteFile("sysstatus.xml", []byte(testLoadDialectSysStatus), 0o644)
+	require.NoError(t, err)
+
+	d, err := conversion.LoadDialect("sysstatus.xml")
+	require.NoError(t, err)
+
+	rw := &dialect.ReadWriter{Dialect: d}
+	err = rw.Initialize()
+	require.NoError(t, err)
+
+	genRW := &message.ReadWriter{Message: &common.MessageSysStatus{}}
+	err = genRW.Initialize()
+	require.NoError(t, err)
+
+	raw := genRW.Write(&common.MessageSysStatus{
+		Load:             500,
+		VoltageBattery:   math.MaxUint16,
+		CurrentBattery:   -1,
+		BatteryRemaining: 80,
+	}, true)
+
+	msg, err := rw.GetMessage(1).Read(raw, true)
+	require.NoError(t, err)
+
+	dm := msg.(*dynamic.Message)
+
+	md, err := message.GetMetadata(dm)
+	require.NoError(t, err)
+
+	for _, ca := range []struct {
+		field   string
+		invalid bool
+	}{
+		{"load", false},
+		{"voltage_battery", true},
+		{"current_battery", true},
+		{"battery_remaining", false},
+	} {
+		v, _ := dm.Get(ca.field)
+		require.Equal(t, ca.invalid, md.Field(ca.field).IsInvalid(v), ca.field)
+	}
+}
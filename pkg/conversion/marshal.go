@@ -0,0 +1,262 @@
+package conversion
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/x25"
+)
+
+var dialectTypeSizes = map[string]int{
+	"double":   8,
+	"uint64_t": 8,
+	"int64_t":  8,
+	"float":    4,
+	"uint32_t": 4,
+	"int32_t":  4,
+	"uint16_t": 2,
+	"int16_t":  2,
+	"uint8_t":  1,
+	"int8_t":   1,
+	"char":     1,
+}
+
+// wireFields returns fields in wire order, as described in
+// https://mavlink.io/en/guide/serialization.html#field_reordering
+func wireFields(fields []*outField) []*outField {
+	ret := make([]*outField, len(fields))
+	copy(ret, fields)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if !ret[i].extension && !ret[j].extension {
+			return dialectTypeSizes[ret[i].wireType] > dialectTypeSizes[ret[j].wireType]
+		}
+		return !ret[i].extension && ret[j].extension
+	})
+
+	return ret
+}
+
+// crcExtra computes the CRC extra of a message, whose fields are in wire order, as described in
+// https://mavlink.io/en/guide/serialization.html#crc_extra
+func crcExtra(msgName string, fields []*outField) byte {
+	h := x25.New()
+	h.Write([]byte(msgName + " "))
+
+	for _, f := range fields {
+		// skip extensions
+		if f.extension {
+			continue
+		}
+
+		h.Write([]byte(f.wireType + " "))
+		h.Write([]byte(f.origName + " "))
+
+		if f.arrayLen > 0 {
+			h.Write([]byte{byte(f.arrayLen)})
+		}
+	}
+
+	sum := h.Sum16()
+	return byte((sum & 0xFF) ^ (sum >> 8))
+}
+
+func (f *outField) wireSize() int {
+	if f.arrayLen > 0 {
+		return dialectTypeSizes[f.wireType] * f.arrayLen
+	}
+	return dialectTypeSizes[f.wireType]
+}
+
+func encodeValueLine(wireType string, enum bool, pos string, v string) string {
+	switch wireType {
+	case "uint8_t":
+		if enum {
+			return "buf[" + pos + "] = byte(" + v + ")"
+		}
+		return "buf[" + pos + "] = " + v
+
+	case "int8_t":
+		return "buf[" + pos + "] = byte(" + v + ")"
+
+	case "uint16_t":
+		if enum {
+			v = "uint16(" + v + ")"
+		}
+		return "binary.LittleEndian.PutUint16(buf[" + pos + ":], " + v + ")"
+
+	case "int16_t":
+		return "binary.LittleEndian.PutUint16(buf[" + pos + ":], uint16(" + v + "))"
+
+	case "uint32_t":
+		if enum {
+			v = "uint32(" + v + ")"
+		}
+		return "binary.LittleEndian.PutUint32(buf[" + pos + ":], " + v + ")"
+
+	case "int32_t":
+		return "binary.LittleEndian.PutUint32(buf[" + pos + ":], uint32(" + v + "))"
+
+	case "uint64_t":
+		if enum {
+			v = "uint64(" + v + ")"
+		}
+		return "binary.LittleEndian.PutUint64(buf[" + pos + ":], " + v + ")"
+
+	case "int64_t":
+		return "binary.LittleEndian.PutUint64(buf[" + pos + ":], uint64(" + v + "))"
+
+	case "float":
+		return "binary.LittleEndian.PutUint32(buf[" + pos + ":], math.Float32bits(" + v + "))"
+
+	default: // double
+		return "binary.LittleEndian.PutUint64(buf[" + pos + ":], math.Float64bits(" + v + "))"
+	}
+}
+
+func decodeValueExpr(wireType string, enum string, pos string) string {
+	var v string
+
+	switch wireType {
+	case "uint8_t":
+		v = "buf[" + pos + "]"
+
+	case "int8_t":
+		if enum != "" {
+			v = "buf[" + pos + "]"
+		} else {
+			v = "int8(buf[" + pos + "])"
+		}
+
+	case "uint16_t":
+		v = "binary.LittleEndian.Uint16(buf[" + pos + ":])"
+
+	case "int16_t":
+		v = "int16(binary.LittleEndian.Uint16(buf[" + pos + ":]))"
+
+	case "uint32_t":
+		v = "binary.LittleEndian.Uint32(buf[" + pos + ":])"
+
+	case "int32_t":
+		if enum != "" {
+			v = "binary.LittleEndian.Uint32(buf[" + pos + ":])"
+		} else {
+			v = "int32(binary.LittleEndian.Uint32(buf[" + pos + ":]))"
+		}
+
+	case "uint64_t":
+		v = "binary.LittleEndian.Uint64(buf[" + pos + ":])"
+
+	case "int64_t":
+		v = "int64(binary.LittleEndian.Uint64(buf[" + pos + ":]))"
+
+	case "float":
+		v = "math.Float32frombits(binary.LittleEndian.Uint32(buf[" + pos + ":]))"
+
+	default: // double
+		v = "math.Float64frombits(binary.LittleEndian.Uint64(buf[" + pos + ":]))"
+	}
+
+	if enum != "" {
+		v = enum + "(" + v + ")"
+	}
+
+	return v
+}
+
+func posExpr(offset int, size int) string {
+	ret := "i"
+	if size > 1 {
+		ret += "*" + strconv.Itoa(size)
+	}
+	if offset > 0 {
+		ret = strconv.Itoa(offset) + "+" + ret
+	}
+	return ret
+}
+
+// generateMarshalers fills fields of outMessage that are needed to generate
+// reflection-free marshal and unmarshal methods.
+func generateMarshalers(outMsg *outMessage) {
+	fields := wireFields(outMsg.Fields)
+	outMsg.CRCExtra = crcExtra(outMsg.OrigName, fields)
+
+	outMsg.TargetSystemOffset = -1
+	outMsg.TargetComponentOffset = -1
+
+	imports := map[string]struct{}{
+		"github.com/bluenviron/gomavlib/v4/pkg/message": {},
+	}
+	offset := 0
+	inExtensions := false
+
+	for _, f := range fields {
+		if f.wireType == "uint8_t" && f.arrayLen == 0 {
+			switch f.origName {
+			case "target_system":
+				outMsg.TargetSystemOffset = offset
+			case "target_component":
+				outMsg.TargetComponentOffset = offset
+			}
+		}
+
+		if f.extension && !inExtensions {
+			inExtensions = true
+			outMsg.MarshalLines = append(outMsg.MarshalLines, "if !isV2 {", "\treturn", "}")
+			outMsg.UnmarshalLines = append(outMsg.UnmarshalLines, "if !isV2 {", "\treturn", "}")
+		}
+
+		typeSize := dialectTypeSizes[f.wireType]
+		enum := f.enum
+
+		switch {
+		case f.wireType == "char":
+			end := strconv.Itoa(offset + f.arrayLen)
+			imports["bytes"] = struct{}{}
+
+			outMsg.MarshalLines = append(outMsg.MarshalLines,
+				"copy(buf["+strconv.Itoa(offset)+":"+end+"], m."+f.name+")")
+			outMsg.UnmarshalLines = append(outMsg.UnmarshalLines,
+				"if n := bytes.IndexByte(buf["+strconv.Itoa(offset)+":"+end+"], 0); n >= 0 {",
+				"\tm."+f.name+" = string(buf["+strconv.Itoa(offset)+":"+strconv.Itoa(offset)+"+n])",
+				"} else {",
+				"\tm."+f.name+" = string(buf["+strconv.Itoa(offset)+":"+end+"])",
+				"}")
+
+		case f.arrayLen > 0:
+			outMsg.MarshalLines = append(outMsg.MarshalLines,
+				"for i, v := range m."+f.name+" {",
+				"\t"+encodeValueLine(f.wireType, enum != "", posExpr(offset, typeSize), "v"),
+				"}")
+			outMsg.UnmarshalLines = append(outMsg.UnmarshalLines,
+				"for i := range m."+f.name+" {",
+				"\tm."+f.name+"[i] = "+decodeValueExpr(f.wireType, enum, posExpr(offset, typeSize)),
+				"}")
+
+		default:
+			outMsg.MarshalLines = append(outMsg.MarshalLines,
+				encodeValueLine(f.wireType, enum != "", strconv.Itoa(offset), "m."+f.name))
+			outMsg.UnmarshalLines = append(outMsg.UnmarshalLines,
+				"m."+f.name+" = "+decodeValueExpr(f.wireType, enum, strconv.Itoa(offset)))
+		}
+
+		if typeSize > 1 {
+			imports["encoding/binary"] = struct{}{}
+		}
+		if f.wireType == "float" || f.wireType == "double" {
+			imports["math"] = struct{}{}
+		}
+
+		offset += f.wireSize()
+		if !f.extension {
+			outMsg.SizeNormal = offset
+		}
+	}
+
+	outMsg.SizeExtended = offset
+
+	for imp := range imports {
+		outMsg.Imports = append(outMsg.Imports, imp)
+	}
+	sort.Strings(outMsg.Imports)
+}
@@ -0,0 +1,424 @@
+package conversion
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"text/template"
+)
+
+var tplWireshark = template.Must(template.New("").Parse(
+	`-- Wireshark dissector of the {{ .Name }} dialect.
+-- Code generated by gomavlib. DO NOT EDIT.
+{{- if .SourceRevision }}
+-- Source revision: {{ .SourceRevision }}
+{{- end }}
+-- Definitions hash (SHA256): {{ .Hash }}
+--
+-- Copy this file into the Wireshark plugin directory, or load it with:
+-- wireshark -X lua_script:{{ .Name }}.lua
+
+mavlink_proto = Proto("mavlink_proto", "MAVLink protocol")
+local f = mavlink_proto.fields
+
+local messageName = {
+{{- range .Messages }}
+    [{{ .ID }}] = "{{ .OrigName }}",
+{{- end }}
+}
+
+local enumEntryName = {
+{{- range .Enums }}
+    ["{{ .Name }}"] = {
+{{- range .Values }}
+        [{{ .Value }}] = "{{ .Name }}",
+{{- end }}
+    },
+{{- end }}
+}
+
+f.magic = ProtoField.uint8("mavlink_proto.magic", "Magic value / version", base.HEX)
+f.length = ProtoField.uint8("mavlink_proto.length", "Payload length")
+f.incompatibility_flag = ProtoField.uint8("mavlink_proto.incompatibility_flag", "Incompatibility flag", base.HEX)
+f.compatibility_flag = ProtoField.uint8("mavlink_proto.compatibility_flag", "Compatibility flag", base.HEX)
+f.sequence = ProtoField.uint8("mavlink_proto.seq", "Packet sequence")
+f.sysid = ProtoField.uint8("mavlink_proto.sysid", "System id")
+f.compid = ProtoField.uint8("mavlink_proto.compid", "Component id")
+f.msgid = ProtoField.uint24("mavlink_proto.msgid", "Message id", base.DEC, messageName)
+f.crc = ProtoField.uint16("mavlink_proto.crc", "Message CRC", base.HEX)
+f.signature_link = ProtoField.uint8("mavlink_proto.signature_link", "Link id")
+f.signature_time = ProtoField.uint64("mavlink_proto.signature_time", "Time")
+f.signature_signature = ProtoField.bytes("mavlink_proto.signature_signature", "Signature")
+{{- range .Messages }}
+{{- range .Fields }}
+f.{{ .Var }} = ProtoField.{{ .Type }}("mavlink_proto.{{ .Var }}", {{ .Label }}{{ .Args }})
+{{- range .Flags }}
+f.{{ .Var }} = ProtoField.bool("mavlink_proto.{{ .Var }}", "{{ .Name }}", {{ .Bits }}, nil, {{ .Mask }})
+{{- end }}
+{{- end }}
+{{- end }}
+
+-- pads a payload with the zeros that are removed by Mavlink v2.
+local function padded_payload(buffer, offset, length, size)
+    local bytes = ByteArray.new()
+    if length > 0 then
+        bytes = buffer(offset, length):bytes()
+    end
+    if bytes:len() < size then
+        bytes:set_size(size)
+    end
+    return bytes:tvb("Untruncated payload")
+end
+
+local payload_fns = {}
+{{- range .Messages }}
+
+-- {{ .OrigName }}
+payload_fns[{{ .ID }}] = function(buffer, offset, length, tree, isV2)
+    local size = {{ .SizeNormal }}
+{{- if ne .SizeNormal .SizeExtended }}
+    if isV2 then
+        size = {{ .SizeExtended }}
+    end
+{{- end }}
+    local tvb = padded_payload(buffer, offset, length, size)
+{{- range .Lines }}
+    {{ . }}
+{{- end }}
+end
+{{- end }}
+
+-- dissects a single frame. It returns the frame length and the message name,
+-- or the opposite of the number of missing bytes.
+local function dissect_frame(buffer, offset, tree)
+    local remaining = buffer:len() - offset
+    local isV2 = buffer(offset, 1):uint() == 0xfd
+    local headerLen = 6
+    if isV2 then
+        headerLen = 10
+    end
+    if remaining < headerLen then
+        return remaining - headerLen
+    end
+
+    local length = buffer(offset + 1, 1):uint()
+    local signed = isV2 and buffer(offset + 2, 1):bitfield(7, 1) == 1
+    local frameLen = headerLen + length + 2
+    if signed then
+        frameLen = frameLen + 13
+    end
+    if remaining < frameLen then
+        return remaining - frameLen
+    end
+
+    local subtree = tree:add(mavlink_proto, buffer(offset, frameLen), "MAVLink Protocol (" .. frameLen .. ")")
+    local header = subtree:add(buffer(offset, headerLen), "Header")
+    header:add(f.magic, buffer(offset, 1))
+    header:add(f.length, buffer(offset + 1, 1))
+    local pos = offset + 2
+    if isV2 then
+        header:add(f.incompatibility_flag, buffer(pos, 1))
+        header:add(f.compatibility_flag, buffer(pos + 1, 1))
+        pos = pos + 2
+    end
+    header:add(f.sequence, buffer(pos, 1))
+    header:add(f.sysid, buffer(pos + 1, 1))
+    header:add(f.compid, buffer(pos + 2, 1))
+    local msgid
+    if isV2 then
+        header:add_le(f.msgid, buffer(pos + 3, 3))
+        msgid = buffer(pos + 3, 3):le_uint()
+    else
+        header:add(f.msgid, buffer(pos + 3, 1))
+        msgid = buffer(pos + 3, 1):uint()
+    end
+    pos = offset + headerLen
+
+    local name = messageName[msgid] or ("UNKNOWN (" .. msgid .. ")")
+    local payload = subtree:add(buffer(pos, length), "Payload: " .. name)
+    local fn = payload_fns[msgid]
+    if fn ~= nil then
+        fn(buffer, pos, length, payload, isV2)
+    end
+    pos = pos + length
+
+    subtree:add_le(f.crc, buffer(pos, 2))
+    pos = pos + 2
+
+    if signed then
+        local signature = subtree:add(buffer(pos, 13), "Signature")
+        signature:add(f.signature_link, buffer(pos, 1))
+        signature:add_le(f.signature_time, buffer(pos + 1, 6))
+        signature:add(f.signature_signature, buffer(pos + 7, 6))
+    end
+
+    return frameLen, name
+end
+
+function mavlink_proto.dissector(buffer, pinfo, tree)
+    local offset = 0
+    local names = {}
+
+    while offset < buffer:len() do
+        local magic = buffer(offset, 1):uint()
+        if magic ~= 0xfe and magic ~= 0xfd then
+            -- skip bytes that do not belong to a frame
+            offset = offset + 1
+        else
+            local n, name = dissect_frame(buffer, offset, tree)
+            if n < 0 then
+                -- frame is split between multiple TCP segments
+                pinfo.desegment_offset = offset
+                pinfo.desegment_len = -n
+                break
+            end
+            names[#names + 1] = name
+            offset = offset + n
+        end
+    end
+
+    pinfo.cols.protocol = mavlink_proto.name
+    pinfo.cols.info = table.concat(names, ", ")
+end
+
+local udp_port = DissectorTable.get("udp.port")
+udp_port:add(14550, mavlink_proto)
+udp_port:add(14580, mavlink_proto)
+DissectorTable.get("tcp.port"):add(5760, mavlink_proto)
+`))
+
+var dialectTypeToLua = map[string]string{
+	"double":   "double",
+	"uint64_t": "uint64",
+	"int64_t":  "int64",
+	"float":    "float",
+	"uint32_t": "uint32",
+	"int32_t":  "int32",
+	"uint16_t": "uint16",
+	"int16_t":  "int16",
+	"uint8_t":  "uint8",
+	"int8_t":   "int8",
+	"char":     "string",
+}
+
+type wiresharkFlag struct {
+	Var  string
+	Name string
+	Bits int
+	Mask uint64
+}
+
+type wiresharkField struct {
+	Var   string
+	Type  string
+	Label string
+	Args  string
+	Flags []*wiresharkFlag
+}
+
+type wiresharkMessage struct {
+	ID           int
+	OrigName     string
+	SizeNormal   int
+	SizeExtended int
+	Fields       []*wiresharkField
+	Lines        []string
+}
+
+func processWiresharkField(
+	f *outField,
+	varName string,
+	label string,
+	enums map[string]*outEnum,
+) *wiresharkField {
+	wf := &wiresharkField{
+		Var:   varName,
+		Type:  dialectTypeToLua[f.wireType],
+		Label: strconv.Quote(label),
+	}
+
+	if f.wireType == "char" || f.wireType == "float" || f.wireType == "double" {
+		return wf
+	}
+
+	size := dialectTypeSizes[f.wireType]
+	enum, ok := enums[f.enum]
+
+	switch {
+	// value strings and bitmasks are supported by fields up to 32 bits
+	case !ok || size > 4:
+
+	case enum.Bitmask:
+		wf.Args = ", base.HEX"
+
+		if f.wireType[0] == 'u' {
+			for _, v := range enum.Values {
+				if v.Value != 0 && v.Value&(v.Value-1) == 0 && v.Value < uint64(1)<<(size*8) {
+					wf.Flags = append(wf.Flags, &wiresharkFlag{
+						Var:  varName + "_" + v.Name,
+						Name: v.Name,
+						Bits: size * 8,
+						Mask: v.Value,
+					})
+				}
+			}
+		}
+
+	default:
+		wf.Args = ", base.DEC, enumEntryName." + enum.Name
+	}
+
+	return wf
+}
+
+func processWiresharkMessage(msg *outMessage, enums map[string]*outEnum) *wiresharkMessage {
+	wm := &wiresharkMessage{
+		ID:           msg.ID,
+		OrigName:     msg.OrigName,
+		SizeNormal:   msg.SizeNormal,
+		SizeExtended: msg.SizeExtended,
+	}
+
+	offset := 0
+	inExtensions := false
+	itemDeclared := false
+
+	for _, f := range wireFields(msg.Fields) {
+		if f.extension && !inExtensions {
+			inExtensions = true
+			wm.Lines = append(wm.Lines, "if not isV2 then", "    return", "end")
+		}
+
+		label := f.origName
+		if f.enum != "" {
+			label += " (" + f.enum + ")"
+		}
+		if f.metadata.Units != "" {
+			label += " [" + f.metadata.Units + "]"
+		}
+
+		prefix := msg.OrigName + "_" + f.origName
+		typeSize := dialectTypeSizes[f.wireType]
+
+		var fields []*wiresharkField
+		var positions []string
+
+		if f.wireType == "char" || f.arrayLen == 0 {
+			fields = append(fields, processWiresharkField(f, prefix, label, enums))
+			positions = append(positions, fmt.Sprintf("tvb(%d, %d)", offset, f.wireSize()))
+		} else {
+			for i := range f.arrayLen {
+				fields = append(fields, processWiresharkField(f,
+					prefix+"_"+strconv.Itoa(i), f.origName+"["+strconv.Itoa(i)+"]", enums))
+				positions = append(positions, fmt.Sprintf("tvb(%d, %d)", offset+i*typeSize, typeSize))
+			}
+		}
+
+		for i, wf := range fields {
+			if wf.Flags != nil {
+				decl := "item = "
+				if !itemDeclared {
+					decl = "local item = "
+					itemDeclared = true
+				}
+				wm.Lines = append(wm.Lines, decl+"tree:add_le(f."+wf.Var+", "+positions[i]+")")
+				for _, flag := range wf.Flags {
+					wm.Lines = append(wm.Lines, "item:add_le(f."+flag.Var+", "+positions[i]+")")
+				}
+			} else {
+				wm.Lines = append(wm.Lines, "tree:add_le(f."+wf.Var+", "+positions[i]+")")
+			}
+		}
+
+		wm.Fields = append(wm.Fields, fields...)
+		offset += f.wireSize()
+	}
+
+	return wm
+}
+
+// writeWireshark writes a Wireshark Lua dissector.
+func writeWireshark(
+	fpath string,
+	defName string,
+	sourceRevision string,
+	outDefs []*outDefinition,
+	enums map[string]*outEnum,
+) error {
+	var msgs []*wiresharkMessage
+	for _, def := range outDefs {
+		for _, msg := range def.Messages {
+			msgs = append(msgs, processWiresharkMessage(msg, enums))
+		}
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].ID < msgs[j].ID
+	})
+
+	// entries are provided only to fields up to 32 bits
+	luaEnums := make([]*outEnum, 0, len(enums))
+	for _, enum := range enums {
+		luaEnum := &outEnum{Name: enum.Name}
+		for _, v := range enum.Values {
+			if v.Value <= math.MaxUint32 {
+				luaEnum.Values = append(luaEnum.Values, v)
+			}
+		}
+		luaEnums = append(luaEnums, luaEnum)
+	}
+
+	sort.Slice(luaEnums, func(i, j int) bool {
+		return luaEnums[i].Name < luaEnums[j].Name
+	})
+
+	var buf bytes.Buffer
+	err := tplWireshark.Execute(&buf, map[string]any{
+		"Name":           defName,
+		"SourceRevision": sourceRevision,
+		"Hash":           definitionsHash(outDefs),
+		"Messages":       msgs,
+		"Enums":          luaEnums,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fpath, buf.Bytes(), 0o644)
+}
+
+// ConvertWireshark converts a XML definition into a Wireshark Lua dissector,
+// that decodes frames, signatures, enums and bitmasks of the dialect.
+// The dissector is written into <OutputDir>/<PkgName>.lua.
+// Options Link and ImportPrefix are ignored.
+func ConvertWireshark(path string, opts ConvertOptions) error {
+	defName := opts.PkgName
+	if defName == "" {
+		defName = defAddrToName(path)
+	}
+
+	fpath := filepath.Join(opts.OutputDir, defName+".lua")
+
+	_, err := os.Stat(fpath)
+	if !os.IsNotExist(err) {
+		return fmt.Errorf("file '%s' already exists", fpath)
+	}
+
+	_, outDefs, enums, err := parseDefinitions(path, defName, opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputDir != "" {
+		err = os.MkdirAll(opts.OutputDir, 0o755)
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeWireshark(fpath, defName, opts.SourceRevision, outDefs, enums)
+}
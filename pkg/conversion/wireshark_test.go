@@ -0,0 +1,244 @@
+package conversion_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/conversion"
+)
+
+const testWiresharkDialect = `<?xml version="1.0"?>
+<mavlink>
+  <enums>
+    <enum name="A_TYPE">
+      <entry value="0" name="A_TYPE_A"/>
+      <entry value="1" name="A_TYPE_B"/>
+    </enum>
+    <enum name="A_FLAGS" bitmask="true">
+      <entry value="1" name="A_FLAGS_X"/>
+      <entry value="2" name="A_FLAGS_Y"/>
+      <entry value="256" name="A_FLAGS_Z"/>
+    </enum>
+  </enums>
+  <messages>
+    <message id="43000" name="A_MESSAGE">
+      <field type="uint8_t" name="type" enum="A_TYPE">a type</field>
+      <field type="uint8_t" name="flags" enum="A_FLAGS">flags</field>
+      <field type="char[4]" name="name">a name</field>
+      <field type="int16_t[2]" name="values" units="mm">values</field>
+      <field type="uint32_t" name="time" units="ms">time</field>
+      <extensions/>
+      <field type="float" name="ext">an extension</field>
+    </message>
+  </messages>
+</mavlink>
+`
+
+const testWiresharkLua = `-- Wireshark dissector of the testdialect dialect.
+-- Code generated by gomavlib. DO NOT EDIT.
+-- Source revision: 1234abcd
+-- Definitions hash (SHA256): 70c84982604a2628dac6697519fe0e5690fa0cd82fceabc1a97f988b70a40b48
+--
+-- Copy this file into the Wireshark plugin directory, or load it with:
+-- wireshark -X lua_script:testdialect.lua
+
+mavlink_proto = Proto("mavlink_proto", "MAVLink protocol")
+local f = mavlink_proto.fields
+
+local messageName = {
+    [43000] = "A_MESSAGE",
+}
+
+local enumEntryName = {
+    ["A_FLAGS"] = {
+        [1] = "A_FLAGS_X",
+        [2] = "A_FLAGS_Y",
+        [256] = "A_FLAGS_Z",
+    },
+    ["A_TYPE"] = {
+        [0] = "A_TYPE_A",
+        [1] = "A_TYPE_B",
+    },
+}
+
+f.magic = ProtoField.uint8("mavlink_proto.magic", "Magic value / version", base.HEX)
+f.length = ProtoField.uint8("mavlink_proto.length", "Payload length")
+f.incompatibility_flag = ProtoField.uint8("mavlink_proto.incompatibility_flag", "Incompatibility flag", base.HEX)
+f.compatibility_flag = ProtoField.uint8("mavlink_proto.compatibility_flag", "Compatibility flag", base.HEX)
+f.sequence = ProtoField.uint8("mavlink_proto.seq", "Packet sequence")
+f.sysid = ProtoField.uint8("mavlink_proto.sysid", "System id")
+f.compid = ProtoField.uint8("mavlink_proto.compid", "Component id")
+f.msgid = ProtoField.uint24("mavlink_proto.msgid", "Message id", base.DEC, messageName)
+f.crc = ProtoField.uint16("mavlink_proto.crc", "Message CRC", base.HEX)
+f.signature_link = ProtoField.uint8("mavlink_proto.signature_link", "Link id")
+f.signature_time = ProtoField.uint64("mavlink_proto.signature_time", "Time")
+f.signature_signature = ProtoField.bytes("mavlink_proto.signature_signature", "Signature")
+f.A_MESSAGE_time = ProtoField.uint32("mavlink_proto.A_MESSAGE_time", "time [ms]")
+f.A_MESSAGE_values_0 = ProtoField.int16("mavlink_proto.A_MESSAGE_values_0", "values[0]")
+f.A_MESSAGE_values_1 = ProtoField.int16("mavlink_proto.A_MESSAGE_values_1", "values[1]")
+f.A_MESSAGE_type = ProtoField.uint8("mavlink_proto.A_MESSAGE_type", "type (A_TYPE)", base.DEC, enumEntryName.A_TYPE)
+f.A_MESSAGE_flags = ProtoField.uint8("mavlink_proto.A_MESSAGE_flags", "flags (A_FLAGS)", base.HEX)
+f.A_MESSAGE_flags_A_FLAGS_X = ProtoField.bool("mavlink_proto.A_MESSAGE_flags_A_FLAGS_X", "A_FLAGS_X", 8, nil, 1)
+f.A_MESSAGE_flags_A_FLAGS_Y = ProtoField.bool("mavlink_proto.A_MESSAGE_flags_A_FLAGS_Y", "A_FLAGS_Y", 8, nil, 2)
+f.A_MESSAGE_name = ProtoField.string("mavlink_proto.A_MESSAGE_name", "name")
+f.A_MESSAGE_ext = ProtoField.float("mavlink_proto.A_MESSAGE_ext", "ext")
+
+-- pads a payload with the zeros that are removed by Mavlink v2.
+local function padded_payload(buffer, offset, length, size)
+    local bytes = ByteArray.new()
+    if length > 0 then
+        bytes = buffer(offset, length):bytes()
+    end
+    if bytes:len() < size then
+        bytes:set_size(size)
+    end
+    return bytes:tvb("Untruncated payload")
+end
+
+local payload_fns = {}
+
+-- A_MESSAGE
+payload_fns[43000] = function(buffer, offset, length, tree, isV2)
+    local size = 14
+    if isV2 then
+        size = 18
+    end
+    local tvb = padded_payload(buffer, offset, length, size)
+    tree:add_le(f.A_MESSAGE_time, tvb(0, 4))
+    tree:add_le(f.A_MESSAGE_values_0, tvb(4, 2))
+    tree:add_le(f.A_MESSAGE_values_1, tvb(6, 2))
+    tree:add_le(f.A_MESSAGE_type, tvb(8, 1))
+    local item = tree:add_le(f.A_MESSAGE_flags, tvb(9, 1))
+    item:add_le(f.A_MESSAGE_flags_A_FLAGS_X, tvb(9, 1))
+    item:add_le(f.A_MESSAGE_flags_A_FLAGS_Y, tvb(9, 1))
+    tree:add_le(f.A_MESSAGE_name, tvb(10, 4))
+    if not isV2 then
+        return
+    end
+    tree:add_le(f.A_MESSAGE_ext, tvb(14, 4))
+end
+
+-- dissects a single frame. It returns the frame length and the message name,
+-- or the opposite of the number of missing bytes.
+local function dissect_frame(buffer, offset, tree)
+    local remaining = buffer:len() - offset
+    local isV2 = buffer(offset, 1):uint() == 0xfd
+    local headerLen = 6
+    if isV2 then
+        headerLen = 10
+    end
+    if remaining < headerLen then
+        return remaining - headerLen
+    end
+
+    local length = buffer(offset + 1, 1):uint()
+    local signed = isV2 and buffer(offset + 2, 1):bitfield(7, 1) == 1
+    local frameLen = headerLen + length + 2
+    if signed then
+        frameLen = frameLen + 13
+    end
+    if remaining < frameLen then
+        return remaining - frameLen
+    end
+
+    local subtree = tree:add(mavlink_proto, buffer(offset, frameLen), "MAVLink Protocol (" .. frameLen .. ")")
+    local header = subtree:add(buffer(offset, headerLen), "Header")
+    header:add(f.magic, buffer(offset, 1))
+    header:add(f.length, buffer(offset + 1, 1))
+    local pos = offset + 2
+    if isV2 then
+        header:add(f.incompatibility_flag, buffer(pos, 1))
+        header:add(f.compatibility_flag, buffer(pos + 1, 1))
+        pos = pos + 2
+    end
+    header:add(f.sequence, buffer(pos, 1))
+    header:add(f.sysid, buffer(pos + 1, 1))
+    header:add(f.compid, buffer(pos + 2, 1))
+    local msgid
+    if isV2 then
+        header:add_le(f.msgid, buffer(pos + 3, 3))
+        msgid = buffer(pos + 3, 3):le_uint()
+    else
+        header:add(f.msgid, buffer(pos + 3, 1))
+        msgid = buffer(pos + 3, 1):uint()
+    end
+    pos = offset + headerLen
+
+    local name = messageName[msgid] or ("UNKNOWN (" .. msgid .. ")")
+    local payload = subtree:add(buffer(pos, length), "Payload: " .. name)
+    local fn = payload_fns[msgid]
+    if fn ~= nil then
+        fn(buffer, pos, length, payload, isV2)
+    end
+    pos = pos + length
+
+    subtree:add_le(f.crc, buffer(pos, 2))
+    pos = pos + 2
+
+    if signed then
+        local signature = subtree:add(buffer(pos, 13), "Signature")
+        signature:add(f.signature_link, buffer(pos, 1))
+        signature:add_le(f.signature_time, buffer(pos + 1, 6))
+        signature:add(f.signature_signature, buffer(pos + 7, 6))
+    end
+
+    return frameLen, name
+end
+
+function mavlink_proto.dissector(buffer, pinfo, tree)
+    local offset = 0
+    local names = {}
+
+    while offset < buffer:len() do
+        local magic = buffer(offset, 1):uint()
+        if magic ~= 0xfe and magic ~= 0xfd then
+            -- skip bytes that do not belong to a frame
+            offset = offset + 1
+        else
+            local n, name = dissect_frame(buffer, offset, tree)
+            if n < 0 then
+                -- frame is split between multiple TCP segments
+                pinfo.desegment_offset = offset
+                pinfo.desegment_len = -n
+                break
+            end
+            names[#names + 1] = name
+            offset = offset + n
+        end
+    end
+
+    pinfo.cols.protocol = mavlink_proto.name
+    pinfo.cols.info = table.concat(names, ", ")
+end
+
+local udp_port = DissectorTable.get("udp.port")
+udp_port:add(14550, mavlink_proto)
+udp_port:add(14580, mavlink_proto)
+DissectorTable.get("tcp.port"):add(5760, mavlink_proto)
+`
+
+func TestConversionWireshark(t *testing.T) {
+	dir, err := os.MkdirTemp("", "gomavlib")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	xmlPath := filepath.Join(dir, "testdialect.xml")
+	err = os.WriteFile(xmlPath, []byte(testWiresharkDialect), 0o644)
+	require.NoError(t, err)
+
+	err = conversion.ConvertWireshark(xmlPath, conversion.ConvertOptions{
+		OutputDir:      dir,
+		SourceRevision: "1234abcd",
+	})
+	require.NoError(t, err)
+
+	buf, err := os.ReadFile(filepath.Join(dir, "testdialect.lua"))
+	require.NoError(t, err)
+	require.Equal(t, testWiresharkLua, string(buf))
+
+	err = conversion.ConvertWireshark(xmlPath, conversion.ConvertOptions{OutputDir: dir})
+	require.EqualError(t, err, "file '"+filepath.Join(dir, "testdialect.lua")+"' already exists")
+}
@@ -12,4 +12,8 @@ type Dialect struct {
 
 	// Messages contains the messages of the dialect.
 	Messages []message.Message
+
+	// (optional) enums of the dialect, indexed by name.
+	// They can be used to find the enum of a field through FieldMetadata.Enum.
+	Enums map[string]*message.EnumMetadata
 }
@@ -0,0 +1,241 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// MergePolicy is the policy used to resolve conflicts between messages
+// that have the same ID and a different definition.
+type MergePolicy int
+
+// merge policies.
+const (
+	// return an error.
+	MergePolicyError MergePolicy = iota
+
+	// keep the message of the dialect that comes first.
+	MergePolicyKeepFirst
+
+	// keep the message of the dialect that comes last.
+	MergePolicyKeepLast
+)
+
+// MergeConflict is a conflict between two messages that have the same ID
+// and a different definition.
+type MergeConflict struct {
+	// ID of the messages.
+	ID uint32
+
+	// message that is already part of the merged dialect.
+	Existing message.Message
+
+	// message that is being merged.
+	Incoming message.Message
+
+	// human-readable differences between the two messages.
+	Differences []string
+}
+
+// String implements fmt.Stringer.
+func (c *MergeConflict) String() string {
+	return fmt.Sprintf("message %d (%T, %T): %s", c.ID, c.Existing, c.Incoming, strings.Join(c.Differences, ", "))
+}
+
+// MergeError is the error returned when there are conflicts and
+// the policy is MergePolicyError.
+type MergeError struct {
+	Conflicts []*MergeConflict
+}
+
+// Error implements the error interface.
+func (e *MergeError) Error() string {
+	strs := make([]string, len(e.Conflicts))
+	for i, c := range e.Conflicts {
+		strs[i] = c.String()
+	}
+	return "conflicting messages: " + strings.Join(strs, "; ")
+}
+
+func formatField(f *message.Field) string {
+	ret := f.Type
+	if f.ArrayLength > 0 {
+		ret += fmt.Sprintf("[%d]", f.ArrayLength)
+	}
+	if f.IsEnum {
+		ret += " enum"
+	}
+	if f.IsExtension {
+		ret += " extension"
+	}
+	return ret
+}
+
+func messageDifferences(existing *message.ReadWriter, incoming *message.ReadWriter) []string {
+	var diffs []string
+
+	if existing.Name() != incoming.Name() {
+		diffs = append(diffs, fmt.Sprintf("name %s != %s", existing.Name(), incoming.Name()))
+	}
+
+	if existing.CRCExtra() != incoming.CRCExtra() {
+		diffs = append(diffs, fmt.Sprintf("CRC extra %d != %d", existing.CRCExtra(), incoming.CRCExtra()))
+	}
+
+	existingFields := make(map[string]*message.Field)
+	for _, f := range existing.Fields() {
+		existingFields[f.Name] = f
+	}
+
+	incomingFields := make(map[string]*message.Field)
+	for _, f := range incoming.Fields() {
+		incomingFields[f.Name] = f
+	}
+
+	for _, f := range existing.Fields() {
+		f2, ok := incomingFields[f.Name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("field '%s' removed", f.Name))
+		} else if *f != *f2 {
+			diffs = append(diffs, fmt.Sprintf("field '%s' changed from %s to %s", f.Name, formatField(f), formatField(f2)))
+		}
+	}
+
+	for _, f := range incoming.Fields() {
+		if _, ok := existingFields[f.Name]; !ok {
+			diffs = append(diffs, fmt.Sprintf("field '%s' added", f.Name))
+		}
+	}
+
+	// same fields, different wire order
+	if len(diffs) == 0 {
+		for i, f := range existing.Fields() {
+			if f.Name != incoming.Fields()[i].Name {
+				diffs = append(diffs, "fields are in a different order")
+				break
+			}
+		}
+	}
+
+	return diffs
+}
+
+// Merger merges multiple dialects into a single one.
+// Messages that have the same ID and the same definition are deduplicated.
+type Merger struct {
+	// (optional) policy used to resolve conflicts.
+	// It defaults to MergePolicyError.
+	Policy MergePolicy
+
+	// (optional) function called when a conflict is found.
+	OnConflict func(c *MergeConflict)
+}
+
+// mergeEnums merges enums of multiple dialects.
+// Entries of enums with the same name are merged together.
+func mergeEnums(dialects []*Dialect) map[string]*message.EnumMetadata {
+	var ret map[string]*message.EnumMetadata
+
+	for _, d := range dialects {
+		for name, enum := range d.Enums {
+			if ret == nil {
+				ret = make(map[string]*message.EnumMetadata)
+			}
+
+			existing, ok := ret[name]
+			if !ok {
+				existing = &message.EnumMetadata{
+					Name:        enum.Name,
+					Description: enum.Description,
+					Bitmask:     enum.Bitmask,
+				}
+				ret[name] = existing
+			}
+
+			for _, entry := range enum.Entries {
+				if existing.EntryByName(entry.Name) == nil {
+					existing.Entries = append(existing.Entries, entry)
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// Merge merges dialects.
+// Version of the resulting dialect is the one of the first dialect.
+// Enums with the same name are merged together.
+func (m *Merger) Merge(dialects ...*Dialect) (*Dialect, error) {
+	ret := &Dialect{}
+
+	if len(dialects) != 0 {
+		ret.Version = dialects[0].Version
+	}
+
+	type entry struct {
+		pos int
+		rw  *message.ReadWriter
+	}
+	entries := make(map[uint32]*entry)
+	var conflicts []*MergeConflict
+
+	for _, d := range dialects {
+		for _, msg := range d.Messages {
+			rw := &message.ReadWriter{Message: msg}
+			err := rw.Initialize()
+			if err != nil {
+				return nil, fmt.Errorf("message %T: %w", msg, err)
+			}
+
+			existing, ok := entries[msg.GetID()]
+			if !ok {
+				entries[msg.GetID()] = &entry{
+					pos: len(ret.Messages),
+					rw:  rw,
+				}
+				ret.Messages = append(ret.Messages, msg)
+				continue
+			}
+
+			diffs := messageDifferences(existing.rw, rw)
+			if len(diffs) == 0 {
+				continue
+			}
+
+			c := &MergeConflict{
+				ID:          msg.GetID(),
+				Existing:    existing.rw.Message,
+				Incoming:    msg,
+				Differences: diffs,
+			}
+			conflicts = append(conflicts, c)
+
+			if m.OnConflict != nil {
+				m.OnConflict(c)
+			}
+
+			if m.Policy == MergePolicyKeepLast {
+				existing.rw = rw
+				ret.Messages[existing.pos] = msg
+			}
+		}
+	}
+
+	if m.Policy == MergePolicyError && len(conflicts) != 0 {
+		return nil, &MergeError{Conflicts: conflicts}
+	}
+
+	ret.Enums = mergeEnums(dialects)
+
+	return ret, nil
+}
+
+// Merge merges dialects with the default settings of Merger,
+// returning an error when there are conflicts.
+func Merge(dialects ...*Dialect) (*Dialect, error) {
+	m := &Merger{}
+	return m.Merge(dialects...)
+}
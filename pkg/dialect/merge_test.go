@@ -0,0 +1,100 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+type MessageVendorHeartbeat struct {
+	Type       MAV_TYPE `mavenum:"uint8"`
+	CustomMode uint16
+	Status     uint8
+}
+
+func (*MessageVendorHeartbeat) GetID() uint32 {
+	return 0
+}
+
+type MessageVendorData struct {
+	Value uint32
+}
+
+func (*MessageVendorData) GetID() uint32 {
+	return 50000
+}
+
+func TestMerge(t *testing.T) {
+	d, err := dialect.Merge(common.Dialect, ardupilotmega.Dialect, &dialect.Dialect{
+		Version:  3,
+		Messages: []message.Message{&MessageVendorData{}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, common.Dialect.Version, d.Version)
+	require.Equal(t, len(ardupilotmega.Dialect.Messages)+1, len(d.Messages))
+
+	rw := &dialect.ReadWriter{Dialect: d}
+	err = rw.Initialize()
+	require.NoError(t, err)
+	require.NotNil(t, rw.GetMessage(50000))
+}
+
+func TestMergeConflict(t *testing.T) {
+	d1 := &dialect.Dialect{Version: 3, Messages: []message.Message{&MessageHeartbeat{}}}
+	d2 := &dialect.Dialect{Version: 3, Messages: []message.Message{&MessageVendorHeartbeat{}}}
+
+	_, err := dialect.Merge(d1, d2)
+	require.EqualError(t, err, "conflicting messages: message 0 (*dialect_test.MessageHeartbeat, "+
+		"*dialect_test.MessageVendorHeartbeat): name HEARTBEAT != VENDOR_HEARTBEAT, CRC extra 50 != 193, "+
+		"field 'custom_mode' changed from uint32_t to uint16_t, field 'autopilot' removed, "+
+		"field 'base_mode' removed, field 'system_status' removed, field 'mavlink_version' removed, "+
+		"field 'status' added")
+
+	var conflicts []*dialect.MergeConflict
+
+	m := &dialect.Merger{
+		Policy: dialect.MergePolicyKeepFirst,
+		OnConflict: func(c *dialect.MergeConflict) {
+			conflicts = append(conflicts, c)
+		},
+	}
+	d, err := m.Merge(d1, d2)
+	require.NoError(t, err)
+	require.Equal(t, []message.Message{&MessageHeartbeat{}}, d.Messages)
+	require.Equal(t, 1, len(conflicts))
+	require.Equal(t, uint32(0), conflicts[0].ID)
+
+	m = &dialect.Merger{
+		Policy: dialect.MergePolicyKeepLast,
+	}
+	d, err = m.Merge(d1, d2)
+	require.NoError(t, err)
+	require.Equal(t, []message.Message{&MessageVendorHeartbeat{}}, d.Messages)
+}
+
+func TestMergeEnums(t *testing.T) {
+	d, err := dialect.Merge(&dialect.Dialect{Enums: map[string]*message.EnumMetadata{
+		"A_TYPE": {Name: "A_TYPE", Entries: []*message.EnumEntryMetadata{
+			{Name: "A_TYPE_A", Value: 0},
+		}},
+	}}, &dialect.Dialect{Enums: map[string]*message.EnumMetadata{
+		"A_TYPE": {Name: "A_TYPE", Entries: []*message.EnumEntryMetadata{
+			{Name: "A_TYPE_A", Value: 0},
+			{Name: "A_TYPE_B", Value: 1},
+		}},
+		"B_TYPE": {Name: "B_TYPE", Bitmask: true},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*message.EnumMetadata{
+		"A_TYPE": {Name: "A_TYPE", Entries: []*message.EnumEntryMetadata{
+			{Name: "A_TYPE_A", Value: 0},
+			{Name: "A_TYPE_B", Value: 1},
+		}},
+		"B_TYPE": {Name: "B_TYPE", Bitmask: true},
+	}, d.Enums)
+}
@@ -0,0 +1,94 @@
+package dialect
+
+import (
+	"fmt"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+var fieldTypeSizes = map[string]int{
+	"double":   8,
+	"uint64_t": 8,
+	"int64_t":  8,
+	"float":    4,
+	"uint32_t": 4,
+	"int32_t":  4,
+	"uint16_t": 2,
+	"int16_t":  2,
+	"uint8_t":  1,
+	"int8_t":   1,
+	"char":     1,
+}
+
+// MessageEntry contains the properties of a message that are needed
+// to validate and route frames without decoding them.
+type MessageEntry struct {
+	// CRC extra of the message.
+	CRCExtra byte
+
+	// length of the payload without extensions.
+	// It is the exact length of v1 payloads.
+	MinLength byte
+
+	// length of the payload with extensions.
+	// It is the maximum length of v2 payloads, that can be shorter since trailing zeros are truncated.
+	MaxLength byte
+
+	// offset of the target_system field inside the payload, or -1 if the field is not present.
+	TargetSystemOffset int
+
+	// offset of the target_component field inside the payload, or -1 if the field is not present.
+	TargetComponentOffset int
+}
+
+// MessageTable maps message IDs to their properties.
+// It is independent of the message structs,
+// therefore it can be used to validate frames of any known message.
+type MessageTable map[uint32]MessageEntry
+
+// NewMessageTable allocates a MessageTable that contains the messages of a dialect.
+func NewMessageTable(d *Dialect) (MessageTable, error) {
+	t := make(MessageTable)
+
+	for _, m := range d.Messages {
+		if _, ok := t[m.GetID()]; ok {
+			return nil, fmt.Errorf("duplicate message with id %d", m.GetID())
+		}
+
+		rw := &message.ReadWriter{Message: m}
+		err := rw.Initialize()
+		if err != nil {
+			return nil, fmt.Errorf("message %T: %w", m, err)
+		}
+
+		e := MessageEntry{
+			CRCExtra:              rw.CRCExtra(),
+			MinLength:             byte(rw.Size(false)),
+			MaxLength:             byte(rw.Size(true)),
+			TargetSystemOffset:    -1,
+			TargetComponentOffset: -1,
+		}
+
+		offset := 0
+		for _, f := range rw.Fields() {
+			if f.Type == "uint8_t" && f.ArrayLength == 0 {
+				switch f.Name {
+				case "target_system":
+					e.TargetSystemOffset = offset
+				case "target_component":
+					e.TargetComponentOffset = offset
+				}
+			}
+
+			if f.ArrayLength > 0 {
+				offset += fieldTypeSizes[f.Type] * f.ArrayLength
+			} else {
+				offset += fieldTypeSizes[f.Type]
+			}
+		}
+
+		t[m.GetID()] = e
+	}
+
+	return t, nil
+}
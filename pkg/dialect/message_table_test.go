@@ -0,0 +1,32 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+func TestNewMessageTable(t *testing.T) {
+	table, err := dialect.NewMessageTable(common.Dialect)
+	require.NoError(t, err)
+	require.Equal(t, len(common.Dialect.Messages), len(table))
+
+	require.Equal(t, dialect.MessageEntry{
+		CRCExtra:              50,
+		MinLength:             9,
+		MaxLength:             9,
+		TargetSystemOffset:    -1,
+		TargetComponentOffset: -1,
+	}, table[0])
+
+	require.Equal(t, dialect.MessageEntry{
+		CRCExtra:              152,
+		MinLength:             33,
+		MaxLength:             33,
+		TargetSystemOffset:    30,
+		TargetComponentOffset: 31,
+	}, table[76])
+}
@@ -10,12 +10,14 @@ import (
 type ReadWriter struct {
 	Dialect *Dialect
 
-	messageRWs map[uint32]*message.ReadWriter
+	messageRWs       map[uint32]*message.ReadWriter
+	messageRWsByName map[string]*message.ReadWriter
 }
 
 // Initialize initializes a ReadWriter.
 func (rw *ReadWriter) Initialize() error {
 	rw.messageRWs = make(map[uint32]*message.ReadWriter)
+	rw.messageRWsByName = make(map[string]*message.ReadWriter)
 
 	for _, m := range rw.Dialect.Messages {
 		if _, ok := rw.messageRWs[m.GetID()]; ok {
@@ -29,6 +31,7 @@ func (rw *ReadWriter) Initialize() error {
 		}
 
 		rw.messageRWs[m.GetID()] = de
+		rw.messageRWsByName[de.Name()] = de
 	}
 
 	return nil
@@ -42,3 +45,33 @@ func (rw *ReadWriter) GetMessage(id uint32) *message.ReadWriter {
 	}
 	return mrw
 }
+
+// GetMessageByName returns the ReadWriter of a message,
+// given its name as written in the XML definition.
+func (rw *ReadWriter) GetMessageByName(name string) *message.ReadWriter {
+	mrw, ok := rw.messageRWsByName[name]
+	if !ok {
+		return nil
+	}
+	return mrw
+}
+
+// EncodeJSON encodes a message into JSON.
+// See message.ReadWriter.EncodeJSON for details.
+func (rw *ReadWriter) EncodeJSON(msg message.Message) ([]byte, error) {
+	mrw := rw.GetMessage(msg.GetID())
+	if mrw == nil {
+		return nil, fmt.Errorf("message with id %d is not in the dialect", msg.GetID())
+	}
+	return mrw.EncodeJSON(msg)
+}
+
+// DecodeJSON decodes a message from JSON, given its name as written in the XML definition.
+// See message.ReadWriter.DecodeJSON for details.
+func (rw *ReadWriter) DecodeJSON(name string, buf []byte) (message.Message, error) {
+	mrw := rw.GetMessageByName(name)
+	if mrw == nil {
+		return nil, fmt.Errorf("message '%s' is not in the dialect", name)
+	}
+	return mrw.DecodeJSON(buf)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
 )
 
@@ -37,7 +38,7 @@ func (*Invalid) GetID() uint32 {
 
 func TestReadWriter(t *testing.T) {
 	rw := &dialect.ReadWriter{
-		Dialect: &dialect.Dialect{3, []message.Message{&MessageHeartbeat{}}},
+		Dialect: &dialect.Dialect{Version: 3, Messages: []message.Message{&MessageHeartbeat{}}},
 	}
 	err := rw.Initialize()
 	require.NoError(t, err)
@@ -57,7 +58,7 @@ func TestReadWriterErrors(t *testing.T) {
 	}{
 		{
 			"duplicate message",
-			&dialect.Dialect{3, []message.Message{
+			&dialect.Dialect{Version: 3, Messages: []message.Message{
 				&MessageHeartbeat{},
 				&MessageHeartbeat{},
 			}},
@@ -65,7 +66,7 @@ func TestReadWriterErrors(t *testing.T) {
 		},
 		{
 			"invalid message",
-			&dialect.Dialect{3, []message.Message{
+			&dialect.Dialect{Version: 3, Messages: []message.Message{
 				&Invalid{},
 			}},
 			"message *dialect_test.Invalid: struct name must begin with 'Message'",
@@ -80,3 +81,26 @@ func TestReadWriterErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestReadWriterJSON(t *testing.T) {
+	rw := &dialect.ReadWriter{Dialect: common.Dialect}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	require.NotNil(t, rw.GetMessageByName("HEARTBEAT"))
+	require.Nil(t, rw.GetMessageByName("MISSING"))
+
+	enc, err := rw.EncodeJSON(&common.MessageSystemTime{TimeUnixUsec: 123, TimeBootMs: 456})
+	require.NoError(t, err)
+	require.Equal(t, `{"time_unix_usec":123,"time_boot_ms":456}`, string(enc))
+
+	dec, err := rw.DecodeJSON("SYSTEM_TIME", enc)
+	require.NoError(t, err)
+	require.Equal(t, &common.MessageSystemTime{TimeUnixUsec: 123, TimeBootMs: 456}, dec)
+
+	_, err = rw.DecodeJSON("MISSING", enc)
+	require.EqualError(t, err, "message 'MISSING' is not in the dialect")
+
+	_, err = rw.EncodeJSON(&message.MessageRaw{ID: 60000})
+	require.EqualError(t, err, "message with id 60000 is not in the dialect")
+}
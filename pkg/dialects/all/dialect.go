@@ -1,5 +1,7 @@
 // Package all contains the all dialect.
 //
+// Definitions hash (SHA256): df9c594f5ad428a6d656ab98225cad0066e6cb4dc72949b25bcc8ca5a5473dc7
+//
 //autogenerated:yes
 package all
 
@@ -426,4 +428,5 @@ var dial = &dialect.Dialect{
 		&MessageLedStripState{},
 		// all
 	},
+	Enums: enums,
 }
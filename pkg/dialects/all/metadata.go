@@ -0,0 +1,10 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package all
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{}
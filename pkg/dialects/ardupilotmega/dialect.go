@@ -1,5 +1,7 @@
 // Package ardupilotmega contains the ardupilotmega dialect.
 //
+// Definitions hash (SHA256): eec62678a76b88861aabd3c18e3cee8166bddb53e9bc45ac68d3c8a742cf464e
+//
 //autogenerated:yes
 package ardupilotmega
 
@@ -350,4 +352,5 @@ var dial = &dialect.Dialect{
 		&MessageEscTelemetry_29To_32{},
 		&MessageNamedValueString{},
 	},
+	Enums: enums,
 }
@@ -20,6 +20,17 @@ const (
 	ACCELCAL_VEHICLE_POS_FAILED   ACCELCAL_VEHICLE_POS = 16777216
 )
 
+var values_ACCELCAL_VEHICLE_POS = []ACCELCAL_VEHICLE_POS{
+	ACCELCAL_VEHICLE_POS_LEVEL,
+	ACCELCAL_VEHICLE_POS_LEFT,
+	ACCELCAL_VEHICLE_POS_RIGHT,
+	ACCELCAL_VEHICLE_POS_NOSEDOWN,
+	ACCELCAL_VEHICLE_POS_NOSEUP,
+	ACCELCAL_VEHICLE_POS_BACK,
+	ACCELCAL_VEHICLE_POS_SUCCESS,
+	ACCELCAL_VEHICLE_POS_FAILED,
+}
+
 var value_to_label_ACCELCAL_VEHICLE_POS = map[ACCELCAL_VEHICLE_POS]string{
 	ACCELCAL_VEHICLE_POS_LEVEL:    "ACCELCAL_VEHICLE_POS_LEVEL",
 	ACCELCAL_VEHICLE_POS_LEFT:     "ACCELCAL_VEHICLE_POS_LEFT",
@@ -67,3 +78,14 @@ func (e ACCELCAL_VEHICLE_POS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ACCELCAL_VEHICLE_POS) Values() []ACCELCAL_VEHICLE_POS {
+	return append([]ACCELCAL_VEHICLE_POS(nil), values_ACCELCAL_VEHICLE_POS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ACCELCAL_VEHICLE_POS) IsValid() bool {
+	_, ok := value_to_label_ACCELCAL_VEHICLE_POS[e]
+	return ok
+}
@@ -22,6 +22,14 @@ const (
 	CAMERA_FEEDBACK_OPENLOOP CAMERA_FEEDBACK_FLAGS = 4
 )
 
+var values_CAMERA_FEEDBACK_FLAGS = []CAMERA_FEEDBACK_FLAGS{
+	CAMERA_FEEDBACK_PHOTO,
+	CAMERA_FEEDBACK_VIDEO,
+	CAMERA_FEEDBACK_BADEXPOSURE,
+	CAMERA_FEEDBACK_CLOSEDLOOP,
+	CAMERA_FEEDBACK_OPENLOOP,
+}
+
 var value_to_label_CAMERA_FEEDBACK_FLAGS = map[CAMERA_FEEDBACK_FLAGS]string{
 	CAMERA_FEEDBACK_PHOTO:       "CAMERA_FEEDBACK_PHOTO",
 	CAMERA_FEEDBACK_VIDEO:       "CAMERA_FEEDBACK_VIDEO",
@@ -63,3 +71,14 @@ func (e CAMERA_FEEDBACK_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_FEEDBACK_FLAGS) Values() []CAMERA_FEEDBACK_FLAGS {
+	return append([]CAMERA_FEEDBACK_FLAGS(nil), values_CAMERA_FEEDBACK_FLAGS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_FEEDBACK_FLAGS) IsValid() bool {
+	_, ok := value_to_label_CAMERA_FEEDBACK_FLAGS[e]
+	return ok
+}
@@ -26,6 +26,16 @@ const (
 	CAMERA_STATUS_TYPE_LOWSTOREV CAMERA_STATUS_TYPES = 6
 )
 
+var values_CAMERA_STATUS_TYPES = []CAMERA_STATUS_TYPES{
+	CAMERA_STATUS_TYPE_HEARTBEAT,
+	CAMERA_STATUS_TYPE_TRIGGER,
+	CAMERA_STATUS_TYPE_DISCONNECT,
+	CAMERA_STATUS_TYPE_ERROR,
+	CAMERA_STATUS_TYPE_LOWBATT,
+	CAMERA_STATUS_TYPE_LOWSTORE,
+	CAMERA_STATUS_TYPE_LOWSTOREV,
+}
+
 var value_to_label_CAMERA_STATUS_TYPES = map[CAMERA_STATUS_TYPES]string{
 	CAMERA_STATUS_TYPE_HEARTBEAT:  "CAMERA_STATUS_TYPE_HEARTBEAT",
 	CAMERA_STATUS_TYPE_TRIGGER:    "CAMERA_STATUS_TYPE_TRIGGER",
@@ -71,3 +81,14 @@ func (e CAMERA_STATUS_TYPES) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_STATUS_TYPES) Values() []CAMERA_STATUS_TYPES {
+	return append([]CAMERA_STATUS_TYPES(nil), values_CAMERA_STATUS_TYPES...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_STATUS_TYPES) IsValid() bool {
+	_, ok := value_to_label_CAMERA_STATUS_TYPES[e]
+	return ok
+}
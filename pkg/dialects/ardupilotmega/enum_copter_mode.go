@@ -65,6 +65,35 @@ const (
 	COPTER_MODE_TURTLE COPTER_MODE = 28
 )
 
+var values_COPTER_MODE = []COPTER_MODE{
+	COPTER_MODE_STABILIZE,
+	COPTER_MODE_ACRO,
+	COPTER_MODE_ALT_HOLD,
+	COPTER_MODE_AUTO,
+	COPTER_MODE_GUIDED,
+	COPTER_MODE_LOITER,
+	COPTER_MODE_RTL,
+	COPTER_MODE_CIRCLE,
+	COPTER_MODE_LAND,
+	COPTER_MODE_DRIFT,
+	COPTER_MODE_SPORT,
+	COPTER_MODE_FLIP,
+	COPTER_MODE_AUTOTUNE,
+	COPTER_MODE_POSHOLD,
+	COPTER_MODE_BRAKE,
+	COPTER_MODE_THROW,
+	COPTER_MODE_AVOID_ADSB,
+	COPTER_MODE_GUIDED_NOGPS,
+	COPTER_MODE_SMART_RTL,
+	COPTER_MODE_FLOWHOLD,
+	COPTER_MODE_FOLLOW,
+	COPTER_MODE_ZIGZAG,
+	COPTER_MODE_SYSTEMID,
+	COPTER_MODE_AUTOROTATE,
+	COPTER_MODE_AUTO_RTL,
+	COPTER_MODE_TURTLE,
+}
+
 var value_to_label_COPTER_MODE = map[COPTER_MODE]string{
 	COPTER_MODE_STABILIZE:    "COPTER_MODE_STABILIZE",
 	COPTER_MODE_ACRO:         "COPTER_MODE_ACRO",
@@ -148,3 +177,14 @@ func (e COPTER_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (COPTER_MODE) Values() []COPTER_MODE {
+	return append([]COPTER_MODE(nil), values_COPTER_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e COPTER_MODE) IsValid() bool {
+	_, ok := value_to_label_COPTER_MODE[e]
+	return ok
+}
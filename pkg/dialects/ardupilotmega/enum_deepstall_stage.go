@@ -27,6 +27,16 @@ const (
 	DEEPSTALL_STAGE_LAND DEEPSTALL_STAGE = 6
 )
 
+var values_DEEPSTALL_STAGE = []DEEPSTALL_STAGE{
+	DEEPSTALL_STAGE_FLY_TO_LANDING,
+	DEEPSTALL_STAGE_ESTIMATE_WIND,
+	DEEPSTALL_STAGE_WAIT_FOR_BREAKOUT,
+	DEEPSTALL_STAGE_FLY_TO_ARC,
+	DEEPSTALL_STAGE_ARC,
+	DEEPSTALL_STAGE_APPROACH,
+	DEEPSTALL_STAGE_LAND,
+}
+
 var value_to_label_DEEPSTALL_STAGE = map[DEEPSTALL_STAGE]string{
 	DEEPSTALL_STAGE_FLY_TO_LANDING:    "DEEPSTALL_STAGE_FLY_TO_LANDING",
 	DEEPSTALL_STAGE_ESTIMATE_WIND:     "DEEPSTALL_STAGE_ESTIMATE_WIND",
@@ -72,3 +82,14 @@ func (e DEEPSTALL_STAGE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (DEEPSTALL_STAGE) Values() []DEEPSTALL_STAGE {
+	return append([]DEEPSTALL_STAGE(nil), values_DEEPSTALL_STAGE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e DEEPSTALL_STAGE) IsValid() bool {
+	_, ok := value_to_label_DEEPSTALL_STAGE[e]
+	return ok
+}
@@ -17,6 +17,11 @@ const (
 	DEVICE_OP_BUSTYPE_SPI DEVICE_OP_BUSTYPE = 1
 )
 
+var values_DEVICE_OP_BUSTYPE = []DEVICE_OP_BUSTYPE{
+	DEVICE_OP_BUSTYPE_I2C,
+	DEVICE_OP_BUSTYPE_SPI,
+}
+
 var value_to_label_DEVICE_OP_BUSTYPE = map[DEVICE_OP_BUSTYPE]string{
 	DEVICE_OP_BUSTYPE_I2C: "DEVICE_OP_BUSTYPE_I2C",
 	DEVICE_OP_BUSTYPE_SPI: "DEVICE_OP_BUSTYPE_SPI",
@@ -52,3 +57,14 @@ func (e DEVICE_OP_BUSTYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (DEVICE_OP_BUSTYPE) Values() []DEVICE_OP_BUSTYPE {
+	return append([]DEVICE_OP_BUSTYPE(nil), values_DEVICE_OP_BUSTYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e DEVICE_OP_BUSTYPE) IsValid() bool {
+	_, ok := value_to_label_DEVICE_OP_BUSTYPE[e]
+	return ok
+}
@@ -18,6 +18,12 @@ const (
 	GIMBAL_AXIS_ROLL GIMBAL_AXIS = 2
 )
 
+var values_GIMBAL_AXIS = []GIMBAL_AXIS{
+	GIMBAL_AXIS_YAW,
+	GIMBAL_AXIS_PITCH,
+	GIMBAL_AXIS_ROLL,
+}
+
 var value_to_label_GIMBAL_AXIS = map[GIMBAL_AXIS]string{
 	GIMBAL_AXIS_YAW:   "GIMBAL_AXIS_YAW",
 	GIMBAL_AXIS_PITCH: "GIMBAL_AXIS_PITCH",
@@ -55,3 +61,14 @@ func (e GIMBAL_AXIS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GIMBAL_AXIS) Values() []GIMBAL_AXIS {
+	return append([]GIMBAL_AXIS(nil), values_GIMBAL_AXIS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GIMBAL_AXIS) IsValid() bool {
+	_, ok := value_to_label_GIMBAL_AXIS[e]
+	return ok
+}
@@ -18,6 +18,12 @@ const (
 	GIMBAL_AXIS_CALIBRATION_REQUIRED_FALSE GIMBAL_AXIS_CALIBRATION_REQUIRED = 2
 )
 
+var values_GIMBAL_AXIS_CALIBRATION_REQUIRED = []GIMBAL_AXIS_CALIBRATION_REQUIRED{
+	GIMBAL_AXIS_CALIBRATION_REQUIRED_UNKNOWN,
+	GIMBAL_AXIS_CALIBRATION_REQUIRED_TRUE,
+	GIMBAL_AXIS_CALIBRATION_REQUIRED_FALSE,
+}
+
 var value_to_label_GIMBAL_AXIS_CALIBRATION_REQUIRED = map[GIMBAL_AXIS_CALIBRATION_REQUIRED]string{
 	GIMBAL_AXIS_CALIBRATION_REQUIRED_UNKNOWN: "GIMBAL_AXIS_CALIBRATION_REQUIRED_UNKNOWN",
 	GIMBAL_AXIS_CALIBRATION_REQUIRED_TRUE:    "GIMBAL_AXIS_CALIBRATION_REQUIRED_TRUE",
@@ -55,3 +61,14 @@ func (e GIMBAL_AXIS_CALIBRATION_REQUIRED) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GIMBAL_AXIS_CALIBRATION_REQUIRED) Values() []GIMBAL_AXIS_CALIBRATION_REQUIRED {
+	return append([]GIMBAL_AXIS_CALIBRATION_REQUIRED(nil), values_GIMBAL_AXIS_CALIBRATION_REQUIRED...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GIMBAL_AXIS_CALIBRATION_REQUIRED) IsValid() bool {
+	_, ok := value_to_label_GIMBAL_AXIS_CALIBRATION_REQUIRED[e]
+	return ok
+}
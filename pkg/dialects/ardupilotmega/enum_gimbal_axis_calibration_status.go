@@ -18,6 +18,12 @@ const (
 	GIMBAL_AXIS_CALIBRATION_STATUS_FAILED GIMBAL_AXIS_CALIBRATION_STATUS = 2
 )
 
+var values_GIMBAL_AXIS_CALIBRATION_STATUS = []GIMBAL_AXIS_CALIBRATION_STATUS{
+	GIMBAL_AXIS_CALIBRATION_STATUS_IN_PROGRESS,
+	GIMBAL_AXIS_CALIBRATION_STATUS_SUCCEEDED,
+	GIMBAL_AXIS_CALIBRATION_STATUS_FAILED,
+}
+
 var value_to_label_GIMBAL_AXIS_CALIBRATION_STATUS = map[GIMBAL_AXIS_CALIBRATION_STATUS]string{
 	GIMBAL_AXIS_CALIBRATION_STATUS_IN_PROGRESS: "GIMBAL_AXIS_CALIBRATION_STATUS_IN_PROGRESS",
 	GIMBAL_AXIS_CALIBRATION_STATUS_SUCCEEDED:   "GIMBAL_AXIS_CALIBRATION_STATUS_SUCCEEDED",
@@ -55,3 +61,14 @@ func (e GIMBAL_AXIS_CALIBRATION_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GIMBAL_AXIS_CALIBRATION_STATUS) Values() []GIMBAL_AXIS_CALIBRATION_STATUS {
+	return append([]GIMBAL_AXIS_CALIBRATION_STATUS(nil), values_GIMBAL_AXIS_CALIBRATION_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GIMBAL_AXIS_CALIBRATION_STATUS) IsValid() bool {
+	_, ok := value_to_label_GIMBAL_AXIS_CALIBRATION_STATUS[e]
+	return ok
+}
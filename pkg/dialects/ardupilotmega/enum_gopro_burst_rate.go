@@ -30,6 +30,18 @@ const (
 	GOPRO_BURST_RATE_30_IN_6_SECOND GOPRO_BURST_RATE = 8
 )
 
+var values_GOPRO_BURST_RATE = []GOPRO_BURST_RATE{
+	GOPRO_BURST_RATE_3_IN_1_SECOND,
+	GOPRO_BURST_RATE_5_IN_1_SECOND,
+	GOPRO_BURST_RATE_10_IN_1_SECOND,
+	GOPRO_BURST_RATE_10_IN_2_SECOND,
+	GOPRO_BURST_RATE_10_IN_3_SECOND,
+	GOPRO_BURST_RATE_30_IN_1_SECOND,
+	GOPRO_BURST_RATE_30_IN_2_SECOND,
+	GOPRO_BURST_RATE_30_IN_3_SECOND,
+	GOPRO_BURST_RATE_30_IN_6_SECOND,
+}
+
 var value_to_label_GOPRO_BURST_RATE = map[GOPRO_BURST_RATE]string{
 	GOPRO_BURST_RATE_3_IN_1_SECOND:  "GOPRO_BURST_RATE_3_IN_1_SECOND",
 	GOPRO_BURST_RATE_5_IN_1_SECOND:  "GOPRO_BURST_RATE_5_IN_1_SECOND",
@@ -79,3 +91,14 @@ func (e GOPRO_BURST_RATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_BURST_RATE) Values() []GOPRO_BURST_RATE {
+	return append([]GOPRO_BURST_RATE(nil), values_GOPRO_BURST_RATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_BURST_RATE) IsValid() bool {
+	_, ok := value_to_label_GOPRO_BURST_RATE[e]
+	return ok
+}
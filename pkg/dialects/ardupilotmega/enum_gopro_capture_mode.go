@@ -28,6 +28,17 @@ const (
 	GOPRO_CAPTURE_MODE_UNKNOWN GOPRO_CAPTURE_MODE = 255
 )
 
+var values_GOPRO_CAPTURE_MODE = []GOPRO_CAPTURE_MODE{
+	GOPRO_CAPTURE_MODE_VIDEO,
+	GOPRO_CAPTURE_MODE_PHOTO,
+	GOPRO_CAPTURE_MODE_BURST,
+	GOPRO_CAPTURE_MODE_TIME_LAPSE,
+	GOPRO_CAPTURE_MODE_MULTI_SHOT,
+	GOPRO_CAPTURE_MODE_PLAYBACK,
+	GOPRO_CAPTURE_MODE_SETUP,
+	GOPRO_CAPTURE_MODE_UNKNOWN,
+}
+
 var value_to_label_GOPRO_CAPTURE_MODE = map[GOPRO_CAPTURE_MODE]string{
 	GOPRO_CAPTURE_MODE_VIDEO:      "GOPRO_CAPTURE_MODE_VIDEO",
 	GOPRO_CAPTURE_MODE_PHOTO:      "GOPRO_CAPTURE_MODE_PHOTO",
@@ -75,3 +86,14 @@ func (e GOPRO_CAPTURE_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_CAPTURE_MODE) Values() []GOPRO_CAPTURE_MODE {
+	return append([]GOPRO_CAPTURE_MODE(nil), values_GOPRO_CAPTURE_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_CAPTURE_MODE) IsValid() bool {
+	_, ok := value_to_label_GOPRO_CAPTURE_MODE[e]
+	return ok
+}
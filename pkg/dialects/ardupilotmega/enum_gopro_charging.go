@@ -16,6 +16,11 @@ const (
 	GOPRO_CHARGING_ENABLED GOPRO_CHARGING = 1
 )
 
+var values_GOPRO_CHARGING = []GOPRO_CHARGING{
+	GOPRO_CHARGING_DISABLED,
+	GOPRO_CHARGING_ENABLED,
+}
+
 var value_to_label_GOPRO_CHARGING = map[GOPRO_CHARGING]string{
 	GOPRO_CHARGING_DISABLED: "GOPRO_CHARGING_DISABLED",
 	GOPRO_CHARGING_ENABLED:  "GOPRO_CHARGING_ENABLED",
@@ -51,3 +56,14 @@ func (e GOPRO_CHARGING) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_CHARGING) Values() []GOPRO_CHARGING {
+	return append([]GOPRO_CHARGING(nil), values_GOPRO_CHARGING...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_CHARGING) IsValid() bool {
+	_, ok := value_to_label_GOPRO_CHARGING[e]
+	return ok
+}
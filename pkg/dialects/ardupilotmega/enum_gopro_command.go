@@ -46,6 +46,26 @@ const (
 	GOPRO_COMMAND_CHARGING GOPRO_COMMAND = 16
 )
 
+var values_GOPRO_COMMAND = []GOPRO_COMMAND{
+	GOPRO_COMMAND_POWER,
+	GOPRO_COMMAND_CAPTURE_MODE,
+	GOPRO_COMMAND_SHUTTER,
+	GOPRO_COMMAND_BATTERY,
+	GOPRO_COMMAND_MODEL,
+	GOPRO_COMMAND_VIDEO_SETTINGS,
+	GOPRO_COMMAND_LOW_LIGHT,
+	GOPRO_COMMAND_PHOTO_RESOLUTION,
+	GOPRO_COMMAND_PHOTO_BURST_RATE,
+	GOPRO_COMMAND_PROTUNE,
+	GOPRO_COMMAND_PROTUNE_WHITE_BALANCE,
+	GOPRO_COMMAND_PROTUNE_COLOUR,
+	GOPRO_COMMAND_PROTUNE_GAIN,
+	GOPRO_COMMAND_PROTUNE_SHARPNESS,
+	GOPRO_COMMAND_PROTUNE_EXPOSURE,
+	GOPRO_COMMAND_TIME,
+	GOPRO_COMMAND_CHARGING,
+}
+
 var value_to_label_GOPRO_COMMAND = map[GOPRO_COMMAND]string{
 	GOPRO_COMMAND_POWER:                 "GOPRO_COMMAND_POWER",
 	GOPRO_COMMAND_CAPTURE_MODE:          "GOPRO_COMMAND_CAPTURE_MODE",
@@ -111,3 +131,14 @@ func (e GOPRO_COMMAND) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_COMMAND) Values() []GOPRO_COMMAND {
+	return append([]GOPRO_COMMAND(nil), values_GOPRO_COMMAND...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_COMMAND) IsValid() bool {
+	_, ok := value_to_label_GOPRO_COMMAND[e]
+	return ok
+}
@@ -18,6 +18,12 @@ const (
 	GOPRO_FIELD_OF_VIEW_NARROW GOPRO_FIELD_OF_VIEW = 2
 )
 
+var values_GOPRO_FIELD_OF_VIEW = []GOPRO_FIELD_OF_VIEW{
+	GOPRO_FIELD_OF_VIEW_WIDE,
+	GOPRO_FIELD_OF_VIEW_MEDIUM,
+	GOPRO_FIELD_OF_VIEW_NARROW,
+}
+
 var value_to_label_GOPRO_FIELD_OF_VIEW = map[GOPRO_FIELD_OF_VIEW]string{
 	GOPRO_FIELD_OF_VIEW_WIDE:   "GOPRO_FIELD_OF_VIEW_WIDE",
 	GOPRO_FIELD_OF_VIEW_MEDIUM: "GOPRO_FIELD_OF_VIEW_MEDIUM",
@@ -55,3 +61,14 @@ func (e GOPRO_FIELD_OF_VIEW) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_FIELD_OF_VIEW) Values() []GOPRO_FIELD_OF_VIEW {
+	return append([]GOPRO_FIELD_OF_VIEW(nil), values_GOPRO_FIELD_OF_VIEW...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_FIELD_OF_VIEW) IsValid() bool {
+	_, ok := value_to_label_GOPRO_FIELD_OF_VIEW[e]
+	return ok
+}
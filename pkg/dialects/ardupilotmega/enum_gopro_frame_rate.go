@@ -40,6 +40,23 @@ const (
 	GOPRO_FRAME_RATE_12_5 GOPRO_FRAME_RATE = 13
 )
 
+var values_GOPRO_FRAME_RATE = []GOPRO_FRAME_RATE{
+	GOPRO_FRAME_RATE_12,
+	GOPRO_FRAME_RATE_15,
+	GOPRO_FRAME_RATE_24,
+	GOPRO_FRAME_RATE_25,
+	GOPRO_FRAME_RATE_30,
+	GOPRO_FRAME_RATE_48,
+	GOPRO_FRAME_RATE_50,
+	GOPRO_FRAME_RATE_60,
+	GOPRO_FRAME_RATE_80,
+	GOPRO_FRAME_RATE_90,
+	GOPRO_FRAME_RATE_100,
+	GOPRO_FRAME_RATE_120,
+	GOPRO_FRAME_RATE_240,
+	GOPRO_FRAME_RATE_12_5,
+}
+
 var value_to_label_GOPRO_FRAME_RATE = map[GOPRO_FRAME_RATE]string{
 	GOPRO_FRAME_RATE_12:   "GOPRO_FRAME_RATE_12",
 	GOPRO_FRAME_RATE_15:   "GOPRO_FRAME_RATE_15",
@@ -99,3 +116,14 @@ func (e GOPRO_FRAME_RATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_FRAME_RATE) Values() []GOPRO_FRAME_RATE {
+	return append([]GOPRO_FRAME_RATE(nil), values_GOPRO_FRAME_RATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_FRAME_RATE) IsValid() bool {
+	_, ok := value_to_label_GOPRO_FRAME_RATE[e]
+	return ok
+}
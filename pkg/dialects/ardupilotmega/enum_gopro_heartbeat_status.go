@@ -20,6 +20,13 @@ const (
 	GOPRO_HEARTBEAT_STATUS_ERROR GOPRO_HEARTBEAT_STATUS = 3
 )
 
+var values_GOPRO_HEARTBEAT_STATUS = []GOPRO_HEARTBEAT_STATUS{
+	GOPRO_HEARTBEAT_STATUS_DISCONNECTED,
+	GOPRO_HEARTBEAT_STATUS_INCOMPATIBLE,
+	GOPRO_HEARTBEAT_STATUS_CONNECTED,
+	GOPRO_HEARTBEAT_STATUS_ERROR,
+}
+
 var value_to_label_GOPRO_HEARTBEAT_STATUS = map[GOPRO_HEARTBEAT_STATUS]string{
 	GOPRO_HEARTBEAT_STATUS_DISCONNECTED: "GOPRO_HEARTBEAT_STATUS_DISCONNECTED",
 	GOPRO_HEARTBEAT_STATUS_INCOMPATIBLE: "GOPRO_HEARTBEAT_STATUS_INCOMPATIBLE",
@@ -59,3 +66,14 @@ func (e GOPRO_HEARTBEAT_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_HEARTBEAT_STATUS) Values() []GOPRO_HEARTBEAT_STATUS {
+	return append([]GOPRO_HEARTBEAT_STATUS(nil), values_GOPRO_HEARTBEAT_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_HEARTBEAT_STATUS) IsValid() bool {
+	_, ok := value_to_label_GOPRO_HEARTBEAT_STATUS[e]
+	return ok
+}
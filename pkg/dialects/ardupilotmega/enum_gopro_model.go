@@ -22,6 +22,14 @@ const (
 	GOPRO_MODEL_HERO_4_BLACK GOPRO_MODEL = 4
 )
 
+var values_GOPRO_MODEL = []GOPRO_MODEL{
+	GOPRO_MODEL_UNKNOWN,
+	GOPRO_MODEL_HERO_3_PLUS_SILVER,
+	GOPRO_MODEL_HERO_3_PLUS_BLACK,
+	GOPRO_MODEL_HERO_4_SILVER,
+	GOPRO_MODEL_HERO_4_BLACK,
+}
+
 var value_to_label_GOPRO_MODEL = map[GOPRO_MODEL]string{
 	GOPRO_MODEL_UNKNOWN:            "GOPRO_MODEL_UNKNOWN",
 	GOPRO_MODEL_HERO_3_PLUS_SILVER: "GOPRO_MODEL_HERO_3_PLUS_SILVER",
@@ -63,3 +71,14 @@ func (e GOPRO_MODEL) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_MODEL) Values() []GOPRO_MODEL {
+	return append([]GOPRO_MODEL(nil), values_GOPRO_MODEL...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_MODEL) IsValid() bool {
+	_, ok := value_to_label_GOPRO_MODEL[e]
+	return ok
+}
@@ -22,6 +22,14 @@ const (
 	GOPRO_PHOTO_RESOLUTION_12MP_WIDE GOPRO_PHOTO_RESOLUTION = 4
 )
 
+var values_GOPRO_PHOTO_RESOLUTION = []GOPRO_PHOTO_RESOLUTION{
+	GOPRO_PHOTO_RESOLUTION_5MP_MEDIUM,
+	GOPRO_PHOTO_RESOLUTION_7MP_MEDIUM,
+	GOPRO_PHOTO_RESOLUTION_7MP_WIDE,
+	GOPRO_PHOTO_RESOLUTION_10MP_WIDE,
+	GOPRO_PHOTO_RESOLUTION_12MP_WIDE,
+}
+
 var value_to_label_GOPRO_PHOTO_RESOLUTION = map[GOPRO_PHOTO_RESOLUTION]string{
 	GOPRO_PHOTO_RESOLUTION_5MP_MEDIUM: "GOPRO_PHOTO_RESOLUTION_5MP_MEDIUM",
 	GOPRO_PHOTO_RESOLUTION_7MP_MEDIUM: "GOPRO_PHOTO_RESOLUTION_7MP_MEDIUM",
@@ -63,3 +71,14 @@ func (e GOPRO_PHOTO_RESOLUTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PHOTO_RESOLUTION) Values() []GOPRO_PHOTO_RESOLUTION {
+	return append([]GOPRO_PHOTO_RESOLUTION(nil), values_GOPRO_PHOTO_RESOLUTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PHOTO_RESOLUTION) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PHOTO_RESOLUTION[e]
+	return ok
+}
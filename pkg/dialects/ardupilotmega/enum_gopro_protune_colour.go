@@ -16,6 +16,11 @@ const (
 	GOPRO_PROTUNE_COLOUR_NEUTRAL GOPRO_PROTUNE_COLOUR = 1
 )
 
+var values_GOPRO_PROTUNE_COLOUR = []GOPRO_PROTUNE_COLOUR{
+	GOPRO_PROTUNE_COLOUR_STANDARD,
+	GOPRO_PROTUNE_COLOUR_NEUTRAL,
+}
+
 var value_to_label_GOPRO_PROTUNE_COLOUR = map[GOPRO_PROTUNE_COLOUR]string{
 	GOPRO_PROTUNE_COLOUR_STANDARD: "GOPRO_PROTUNE_COLOUR_STANDARD",
 	GOPRO_PROTUNE_COLOUR_NEUTRAL:  "GOPRO_PROTUNE_COLOUR_NEUTRAL",
@@ -51,3 +56,14 @@ func (e GOPRO_PROTUNE_COLOUR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PROTUNE_COLOUR) Values() []GOPRO_PROTUNE_COLOUR {
+	return append([]GOPRO_PROTUNE_COLOUR(nil), values_GOPRO_PROTUNE_COLOUR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PROTUNE_COLOUR) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PROTUNE_COLOUR[e]
+	return ok
+}
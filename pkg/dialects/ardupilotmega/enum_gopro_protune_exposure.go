@@ -54,6 +54,30 @@ const (
 	GOPRO_PROTUNE_EXPOSURE_POS_5_0 GOPRO_PROTUNE_EXPOSURE = 20
 )
 
+var values_GOPRO_PROTUNE_EXPOSURE = []GOPRO_PROTUNE_EXPOSURE{
+	GOPRO_PROTUNE_EXPOSURE_NEG_5_0,
+	GOPRO_PROTUNE_EXPOSURE_NEG_4_5,
+	GOPRO_PROTUNE_EXPOSURE_NEG_4_0,
+	GOPRO_PROTUNE_EXPOSURE_NEG_3_5,
+	GOPRO_PROTUNE_EXPOSURE_NEG_3_0,
+	GOPRO_PROTUNE_EXPOSURE_NEG_2_5,
+	GOPRO_PROTUNE_EXPOSURE_NEG_2_0,
+	GOPRO_PROTUNE_EXPOSURE_NEG_1_5,
+	GOPRO_PROTUNE_EXPOSURE_NEG_1_0,
+	GOPRO_PROTUNE_EXPOSURE_NEG_0_5,
+	GOPRO_PROTUNE_EXPOSURE_ZERO,
+	GOPRO_PROTUNE_EXPOSURE_POS_0_5,
+	GOPRO_PROTUNE_EXPOSURE_POS_1_0,
+	GOPRO_PROTUNE_EXPOSURE_POS_1_5,
+	GOPRO_PROTUNE_EXPOSURE_POS_2_0,
+	GOPRO_PROTUNE_EXPOSURE_POS_2_5,
+	GOPRO_PROTUNE_EXPOSURE_POS_3_0,
+	GOPRO_PROTUNE_EXPOSURE_POS_3_5,
+	GOPRO_PROTUNE_EXPOSURE_POS_4_0,
+	GOPRO_PROTUNE_EXPOSURE_POS_4_5,
+	GOPRO_PROTUNE_EXPOSURE_POS_5_0,
+}
+
 var value_to_label_GOPRO_PROTUNE_EXPOSURE = map[GOPRO_PROTUNE_EXPOSURE]string{
 	GOPRO_PROTUNE_EXPOSURE_NEG_5_0: "GOPRO_PROTUNE_EXPOSURE_NEG_5_0",
 	GOPRO_PROTUNE_EXPOSURE_NEG_4_5: "GOPRO_PROTUNE_EXPOSURE_NEG_4_5",
@@ -127,3 +151,14 @@ func (e GOPRO_PROTUNE_EXPOSURE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PROTUNE_EXPOSURE) Values() []GOPRO_PROTUNE_EXPOSURE {
+	return append([]GOPRO_PROTUNE_EXPOSURE(nil), values_GOPRO_PROTUNE_EXPOSURE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PROTUNE_EXPOSURE) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PROTUNE_EXPOSURE[e]
+	return ok
+}
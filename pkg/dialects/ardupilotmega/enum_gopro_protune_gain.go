@@ -22,6 +22,14 @@ const (
 	GOPRO_PROTUNE_GAIN_6400 GOPRO_PROTUNE_GAIN = 4
 )
 
+var values_GOPRO_PROTUNE_GAIN = []GOPRO_PROTUNE_GAIN{
+	GOPRO_PROTUNE_GAIN_400,
+	GOPRO_PROTUNE_GAIN_800,
+	GOPRO_PROTUNE_GAIN_1600,
+	GOPRO_PROTUNE_GAIN_3200,
+	GOPRO_PROTUNE_GAIN_6400,
+}
+
 var value_to_label_GOPRO_PROTUNE_GAIN = map[GOPRO_PROTUNE_GAIN]string{
 	GOPRO_PROTUNE_GAIN_400:  "GOPRO_PROTUNE_GAIN_400",
 	GOPRO_PROTUNE_GAIN_800:  "GOPRO_PROTUNE_GAIN_800",
@@ -63,3 +71,14 @@ func (e GOPRO_PROTUNE_GAIN) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PROTUNE_GAIN) Values() []GOPRO_PROTUNE_GAIN {
+	return append([]GOPRO_PROTUNE_GAIN(nil), values_GOPRO_PROTUNE_GAIN...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PROTUNE_GAIN) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PROTUNE_GAIN[e]
+	return ok
+}
@@ -18,6 +18,12 @@ const (
 	GOPRO_PROTUNE_SHARPNESS_HIGH GOPRO_PROTUNE_SHARPNESS = 2
 )
 
+var values_GOPRO_PROTUNE_SHARPNESS = []GOPRO_PROTUNE_SHARPNESS{
+	GOPRO_PROTUNE_SHARPNESS_LOW,
+	GOPRO_PROTUNE_SHARPNESS_MEDIUM,
+	GOPRO_PROTUNE_SHARPNESS_HIGH,
+}
+
 var value_to_label_GOPRO_PROTUNE_SHARPNESS = map[GOPRO_PROTUNE_SHARPNESS]string{
 	GOPRO_PROTUNE_SHARPNESS_LOW:    "GOPRO_PROTUNE_SHARPNESS_LOW",
 	GOPRO_PROTUNE_SHARPNESS_MEDIUM: "GOPRO_PROTUNE_SHARPNESS_MEDIUM",
@@ -55,3 +61,14 @@ func (e GOPRO_PROTUNE_SHARPNESS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PROTUNE_SHARPNESS) Values() []GOPRO_PROTUNE_SHARPNESS {
+	return append([]GOPRO_PROTUNE_SHARPNESS(nil), values_GOPRO_PROTUNE_SHARPNESS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PROTUNE_SHARPNESS) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PROTUNE_SHARPNESS[e]
+	return ok
+}
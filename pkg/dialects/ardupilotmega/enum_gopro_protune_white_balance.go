@@ -22,6 +22,14 @@ const (
 	GOPRO_PROTUNE_WHITE_BALANCE_RAW GOPRO_PROTUNE_WHITE_BALANCE = 4
 )
 
+var values_GOPRO_PROTUNE_WHITE_BALANCE = []GOPRO_PROTUNE_WHITE_BALANCE{
+	GOPRO_PROTUNE_WHITE_BALANCE_AUTO,
+	GOPRO_PROTUNE_WHITE_BALANCE_3000K,
+	GOPRO_PROTUNE_WHITE_BALANCE_5500K,
+	GOPRO_PROTUNE_WHITE_BALANCE_6500K,
+	GOPRO_PROTUNE_WHITE_BALANCE_RAW,
+}
+
 var value_to_label_GOPRO_PROTUNE_WHITE_BALANCE = map[GOPRO_PROTUNE_WHITE_BALANCE]string{
 	GOPRO_PROTUNE_WHITE_BALANCE_AUTO:  "GOPRO_PROTUNE_WHITE_BALANCE_AUTO",
 	GOPRO_PROTUNE_WHITE_BALANCE_3000K: "GOPRO_PROTUNE_WHITE_BALANCE_3000K",
@@ -63,3 +71,14 @@ func (e GOPRO_PROTUNE_WHITE_BALANCE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_PROTUNE_WHITE_BALANCE) Values() []GOPRO_PROTUNE_WHITE_BALANCE {
+	return append([]GOPRO_PROTUNE_WHITE_BALANCE(nil), values_GOPRO_PROTUNE_WHITE_BALANCE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_PROTUNE_WHITE_BALANCE) IsValid() bool {
+	_, ok := value_to_label_GOPRO_PROTUNE_WHITE_BALANCE[e]
+	return ok
+}
@@ -16,6 +16,11 @@ const (
 	GOPRO_REQUEST_FAILED GOPRO_REQUEST_STATUS = 1
 )
 
+var values_GOPRO_REQUEST_STATUS = []GOPRO_REQUEST_STATUS{
+	GOPRO_REQUEST_SUCCESS,
+	GOPRO_REQUEST_FAILED,
+}
+
 var value_to_label_GOPRO_REQUEST_STATUS = map[GOPRO_REQUEST_STATUS]string{
 	GOPRO_REQUEST_SUCCESS: "GOPRO_REQUEST_SUCCESS",
 	GOPRO_REQUEST_FAILED:  "GOPRO_REQUEST_FAILED",
@@ -51,3 +56,14 @@ func (e GOPRO_REQUEST_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_REQUEST_STATUS) Values() []GOPRO_REQUEST_STATUS {
+	return append([]GOPRO_REQUEST_STATUS(nil), values_GOPRO_REQUEST_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_REQUEST_STATUS) IsValid() bool {
+	_, ok := value_to_label_GOPRO_REQUEST_STATUS[e]
+	return ok
+}
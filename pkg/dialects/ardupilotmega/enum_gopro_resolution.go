@@ -40,6 +40,23 @@ const (
 	GOPRO_RESOLUTION_4k_SUPERVIEW GOPRO_RESOLUTION = 13
 )
 
+var values_GOPRO_RESOLUTION = []GOPRO_RESOLUTION{
+	GOPRO_RESOLUTION_480p,
+	GOPRO_RESOLUTION_720p,
+	GOPRO_RESOLUTION_960p,
+	GOPRO_RESOLUTION_1080p,
+	GOPRO_RESOLUTION_1440p,
+	GOPRO_RESOLUTION_2_7k_17_9,
+	GOPRO_RESOLUTION_2_7k_16_9,
+	GOPRO_RESOLUTION_2_7k_4_3,
+	GOPRO_RESOLUTION_4k_16_9,
+	GOPRO_RESOLUTION_4k_17_9,
+	GOPRO_RESOLUTION_720p_SUPERVIEW,
+	GOPRO_RESOLUTION_1080p_SUPERVIEW,
+	GOPRO_RESOLUTION_2_7k_SUPERVIEW,
+	GOPRO_RESOLUTION_4k_SUPERVIEW,
+}
+
 var value_to_label_GOPRO_RESOLUTION = map[GOPRO_RESOLUTION]string{
 	GOPRO_RESOLUTION_480p:            "GOPRO_RESOLUTION_480p",
 	GOPRO_RESOLUTION_720p:            "GOPRO_RESOLUTION_720p",
@@ -99,3 +116,14 @@ func (e GOPRO_RESOLUTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_RESOLUTION) Values() []GOPRO_RESOLUTION {
+	return append([]GOPRO_RESOLUTION(nil), values_GOPRO_RESOLUTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GOPRO_RESOLUTION) IsValid() bool {
+	_, ok := value_to_label_GOPRO_RESOLUTION[e]
+	return ok
+}
@@ -63,3 +63,33 @@ func (e GOPRO_VIDEO_SETTINGS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GOPRO_VIDEO_SETTINGS_FLAGS) Values() []GOPRO_VIDEO_SETTINGS_FLAGS {
+	return append([]GOPRO_VIDEO_SETTINGS_FLAGS(nil), values_GOPRO_VIDEO_SETTINGS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e GOPRO_VIDEO_SETTINGS_FLAGS) IsValid() bool {
+	var mask GOPRO_VIDEO_SETTINGS_FLAGS
+	for _, val := range values_GOPRO_VIDEO_SETTINGS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e GOPRO_VIDEO_SETTINGS_FLAGS) Flags() []GOPRO_VIDEO_SETTINGS_FLAGS {
+	var flags []GOPRO_VIDEO_SETTINGS_FLAGS
+	for _, val := range values_GOPRO_VIDEO_SETTINGS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e GOPRO_VIDEO_SETTINGS_FLAGS) Has(flags GOPRO_VIDEO_SETTINGS_FLAGS) bool {
+	return e&flags == flags
+}
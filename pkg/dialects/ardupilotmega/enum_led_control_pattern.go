@@ -18,6 +18,12 @@ const (
 	LED_CONTROL_PATTERN_CUSTOM LED_CONTROL_PATTERN = 255
 )
 
+var values_LED_CONTROL_PATTERN = []LED_CONTROL_PATTERN{
+	LED_CONTROL_PATTERN_OFF,
+	LED_CONTROL_PATTERN_FIRMWAREUPDATE,
+	LED_CONTROL_PATTERN_CUSTOM,
+}
+
 var value_to_label_LED_CONTROL_PATTERN = map[LED_CONTROL_PATTERN]string{
 	LED_CONTROL_PATTERN_OFF:            "LED_CONTROL_PATTERN_OFF",
 	LED_CONTROL_PATTERN_FIRMWAREUPDATE: "LED_CONTROL_PATTERN_FIRMWAREUPDATE",
@@ -55,3 +61,14 @@ func (e LED_CONTROL_PATTERN) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (LED_CONTROL_PATTERN) Values() []LED_CONTROL_PATTERN {
+	return append([]LED_CONTROL_PATTERN(nil), values_LED_CONTROL_PATTERN...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e LED_CONTROL_PATTERN) IsValid() bool {
+	_, ok := value_to_label_LED_CONTROL_PATTERN[e]
+	return ok
+}
@@ -73,3 +73,33 @@ func (e LIMIT_MODULE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (LIMIT_MODULE) Values() []LIMIT_MODULE {
+	return append([]LIMIT_MODULE(nil), values_LIMIT_MODULE...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e LIMIT_MODULE) IsValid() bool {
+	var mask LIMIT_MODULE
+	for _, val := range values_LIMIT_MODULE {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e LIMIT_MODULE) Flags() []LIMIT_MODULE {
+	var flags []LIMIT_MODULE
+	for _, val := range values_LIMIT_MODULE {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e LIMIT_MODULE) Has(flags LIMIT_MODULE) bool {
+	return e&flags == flags
+}
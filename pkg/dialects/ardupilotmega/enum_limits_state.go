@@ -24,6 +24,15 @@ const (
 	LIMITS_RECOVERED LIMITS_STATE = 5
 )
 
+var values_LIMITS_STATE = []LIMITS_STATE{
+	LIMITS_INIT,
+	LIMITS_DISABLED,
+	LIMITS_ENABLED,
+	LIMITS_TRIGGERED,
+	LIMITS_RECOVERING,
+	LIMITS_RECOVERED,
+}
+
 var value_to_label_LIMITS_STATE = map[LIMITS_STATE]string{
 	LIMITS_INIT:       "LIMITS_INIT",
 	LIMITS_DISABLED:   "LIMITS_DISABLED",
@@ -67,3 +76,14 @@ func (e LIMITS_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (LIMITS_STATE) Values() []LIMITS_STATE {
+	return append([]LIMITS_STATE(nil), values_LIMITS_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e LIMITS_STATE) IsValid() bool {
+	_, ok := value_to_label_LIMITS_STATE[e]
+	return ok
+}
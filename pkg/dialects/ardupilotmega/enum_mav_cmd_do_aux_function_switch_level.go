@@ -18,6 +18,12 @@ const (
 	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_HIGH MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL = 2
 )
 
+var values_MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL = []MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL{
+	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_LOW,
+	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_MIDDLE,
+	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_HIGH,
+}
+
 var value_to_label_MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL = map[MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL]string{
 	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_LOW:    "MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_LOW",
 	MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_MIDDLE: "MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL_MIDDLE",
@@ -55,3 +61,14 @@ func (e MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL) Values() []MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL {
+	return append([]MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL(nil), values_MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL) IsValid() bool {
+	_, ok := value_to_label_MAV_CMD_DO_AUX_FUNCTION_SWITCH_LEVEL[e]
+	return ok
+}
@@ -26,6 +26,16 @@ const (
 	MAV_MODE_GIMBAL_RATE_CMD_TIMEOUT MAV_MODE_GIMBAL = 6
 )
 
+var values_MAV_MODE_GIMBAL = []MAV_MODE_GIMBAL{
+	MAV_MODE_GIMBAL_UNINITIALIZED,
+	MAV_MODE_GIMBAL_CALIBRATING_PITCH,
+	MAV_MODE_GIMBAL_CALIBRATING_ROLL,
+	MAV_MODE_GIMBAL_CALIBRATING_YAW,
+	MAV_MODE_GIMBAL_INITIALIZED,
+	MAV_MODE_GIMBAL_ACTIVE,
+	MAV_MODE_GIMBAL_RATE_CMD_TIMEOUT,
+}
+
 var value_to_label_MAV_MODE_GIMBAL = map[MAV_MODE_GIMBAL]string{
 	MAV_MODE_GIMBAL_UNINITIALIZED:     "MAV_MODE_GIMBAL_UNINITIALIZED",
 	MAV_MODE_GIMBAL_CALIBRATING_PITCH: "MAV_MODE_GIMBAL_CALIBRATING_PITCH",
@@ -71,3 +81,14 @@ func (e MAV_MODE_GIMBAL) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MODE_GIMBAL) Values() []MAV_MODE_GIMBAL {
+	return append([]MAV_MODE_GIMBAL(nil), values_MAV_MODE_GIMBAL...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MODE_GIMBAL) IsValid() bool {
+	_, ok := value_to_label_MAV_MODE_GIMBAL[e]
+	return ok
+}
@@ -17,6 +17,11 @@ const (
 	MAV_REMOTE_LOG_DATA_BLOCK_START MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS = 2147483646
 )
 
+var values_MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS = []MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS{
+	MAV_REMOTE_LOG_DATA_BLOCK_STOP,
+	MAV_REMOTE_LOG_DATA_BLOCK_START,
+}
+
 var value_to_label_MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS = map[MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS]string{
 	MAV_REMOTE_LOG_DATA_BLOCK_STOP:  "MAV_REMOTE_LOG_DATA_BLOCK_STOP",
 	MAV_REMOTE_LOG_DATA_BLOCK_START: "MAV_REMOTE_LOG_DATA_BLOCK_START",
@@ -52,3 +57,14 @@ func (e MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS) Values() []MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS {
+	return append([]MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS(nil), values_MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS) IsValid() bool {
+	_, ok := value_to_label_MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS[e]
+	return ok
+}
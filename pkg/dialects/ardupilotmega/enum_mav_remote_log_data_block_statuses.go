@@ -17,6 +17,11 @@ const (
 	MAV_REMOTE_LOG_DATA_BLOCK_ACK MAV_REMOTE_LOG_DATA_BLOCK_STATUSES = 1
 )
 
+var values_MAV_REMOTE_LOG_DATA_BLOCK_STATUSES = []MAV_REMOTE_LOG_DATA_BLOCK_STATUSES{
+	MAV_REMOTE_LOG_DATA_BLOCK_NACK,
+	MAV_REMOTE_LOG_DATA_BLOCK_ACK,
+}
+
 var value_to_label_MAV_REMOTE_LOG_DATA_BLOCK_STATUSES = map[MAV_REMOTE_LOG_DATA_BLOCK_STATUSES]string{
 	MAV_REMOTE_LOG_DATA_BLOCK_NACK: "MAV_REMOTE_LOG_DATA_BLOCK_NACK",
 	MAV_REMOTE_LOG_DATA_BLOCK_ACK:  "MAV_REMOTE_LOG_DATA_BLOCK_ACK",
@@ -52,3 +57,14 @@ func (e MAV_REMOTE_LOG_DATA_BLOCK_STATUSES) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_REMOTE_LOG_DATA_BLOCK_STATUSES) Values() []MAV_REMOTE_LOG_DATA_BLOCK_STATUSES {
+	return append([]MAV_REMOTE_LOG_DATA_BLOCK_STATUSES(nil), values_MAV_REMOTE_LOG_DATA_BLOCK_STATUSES...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_REMOTE_LOG_DATA_BLOCK_STATUSES) IsValid() bool {
+	_, ok := value_to_label_MAV_REMOTE_LOG_DATA_BLOCK_STATUSES[e]
+	return ok
+}
@@ -17,6 +17,13 @@ const (
 	OSD_PARAM_INVALID_PARAMETER       OSD_PARAM_CONFIG_ERROR = 3
 )
 
+var values_OSD_PARAM_CONFIG_ERROR = []OSD_PARAM_CONFIG_ERROR{
+	OSD_PARAM_SUCCESS,
+	OSD_PARAM_INVALID_SCREEN,
+	OSD_PARAM_INVALID_PARAMETER_INDEX,
+	OSD_PARAM_INVALID_PARAMETER,
+}
+
 var value_to_label_OSD_PARAM_CONFIG_ERROR = map[OSD_PARAM_CONFIG_ERROR]string{
 	OSD_PARAM_SUCCESS:                 "OSD_PARAM_SUCCESS",
 	OSD_PARAM_INVALID_SCREEN:          "OSD_PARAM_INVALID_SCREEN",
@@ -56,3 +63,14 @@ func (e OSD_PARAM_CONFIG_ERROR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (OSD_PARAM_CONFIG_ERROR) Values() []OSD_PARAM_CONFIG_ERROR {
+	return append([]OSD_PARAM_CONFIG_ERROR(nil), values_OSD_PARAM_CONFIG_ERROR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e OSD_PARAM_CONFIG_ERROR) IsValid() bool {
+	_, ok := value_to_label_OSD_PARAM_CONFIG_ERROR[e]
+	return ok
+}
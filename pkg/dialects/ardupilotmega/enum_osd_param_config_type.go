@@ -22,6 +22,18 @@ const (
 	OSD_PARAM_NUM_TYPES         OSD_PARAM_CONFIG_TYPE = 8
 )
 
+var values_OSD_PARAM_CONFIG_TYPE = []OSD_PARAM_CONFIG_TYPE{
+	OSD_PARAM_NONE,
+	OSD_PARAM_SERIAL_PROTOCOL,
+	OSD_PARAM_SERVO_FUNCTION,
+	OSD_PARAM_AUX_FUNCTION,
+	OSD_PARAM_FLIGHT_MODE,
+	OSD_PARAM_FAILSAFE_ACTION,
+	OSD_PARAM_FAILSAFE_ACTION_1,
+	OSD_PARAM_FAILSAFE_ACTION_2,
+	OSD_PARAM_NUM_TYPES,
+}
+
 var value_to_label_OSD_PARAM_CONFIG_TYPE = map[OSD_PARAM_CONFIG_TYPE]string{
 	OSD_PARAM_NONE:              "OSD_PARAM_NONE",
 	OSD_PARAM_SERIAL_PROTOCOL:   "OSD_PARAM_SERIAL_PROTOCOL",
@@ -71,3 +83,14 @@ func (e OSD_PARAM_CONFIG_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (OSD_PARAM_CONFIG_TYPE) Values() []OSD_PARAM_CONFIG_TYPE {
+	return append([]OSD_PARAM_CONFIG_TYPE(nil), values_OSD_PARAM_CONFIG_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e OSD_PARAM_CONFIG_TYPE) IsValid() bool {
+	_, ok := value_to_label_OSD_PARAM_CONFIG_TYPE[e]
+	return ok
+}
@@ -38,6 +38,25 @@ const (
 	PID_TUNING_YAW_ANGLE PID_TUNING_AXIS = 16
 )
 
+var values_PID_TUNING_AXIS = []PID_TUNING_AXIS{
+	PID_TUNING_ROLL,
+	PID_TUNING_PITCH,
+	PID_TUNING_YAW,
+	PID_TUNING_ACCZ,
+	PID_TUNING_STEER,
+	PID_TUNING_LANDING,
+	PID_TUNING_WHEEL_LEFT,
+	PID_TUNING_WHEEL_RIGHT,
+	PID_TUNING_SAIL_HEEL,
+	PID_TUNING_VEL_NORTH,
+	PID_TUNING_VEL_EAST,
+	PID_TUNING_VEL_DOWN,
+	PID_TUNING_POS_NORTH,
+	PID_TUNING_POS_EAST,
+	PID_TUNING_POS_DOWN,
+	PID_TUNING_YAW_ANGLE,
+}
+
 var value_to_label_PID_TUNING_AXIS = map[PID_TUNING_AXIS]string{
 	PID_TUNING_ROLL:        "PID_TUNING_ROLL",
 	PID_TUNING_PITCH:       "PID_TUNING_PITCH",
@@ -101,3 +120,14 @@ func (e PID_TUNING_AXIS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PID_TUNING_AXIS) Values() []PID_TUNING_AXIS {
+	return append([]PID_TUNING_AXIS(nil), values_PID_TUNING_AXIS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PID_TUNING_AXIS) IsValid() bool {
+	_, ok := value_to_label_PID_TUNING_AXIS[e]
+	return ok
+}
@@ -65,6 +65,35 @@ const (
 	PLANE_MODE_AUTOLAND PLANE_MODE = 26
 )
 
+var values_PLANE_MODE = []PLANE_MODE{
+	PLANE_MODE_MANUAL,
+	PLANE_MODE_CIRCLE,
+	PLANE_MODE_STABILIZE,
+	PLANE_MODE_TRAINING,
+	PLANE_MODE_ACRO,
+	PLANE_MODE_FLY_BY_WIRE_A,
+	PLANE_MODE_FLY_BY_WIRE_B,
+	PLANE_MODE_CRUISE,
+	PLANE_MODE_AUTOTUNE,
+	PLANE_MODE_AUTO,
+	PLANE_MODE_RTL,
+	PLANE_MODE_LOITER,
+	PLANE_MODE_TAKEOFF,
+	PLANE_MODE_AVOID_ADSB,
+	PLANE_MODE_GUIDED,
+	PLANE_MODE_INITIALIZING,
+	PLANE_MODE_QSTABILIZE,
+	PLANE_MODE_QHOVER,
+	PLANE_MODE_QLOITER,
+	PLANE_MODE_QLAND,
+	PLANE_MODE_QRTL,
+	PLANE_MODE_QAUTOTUNE,
+	PLANE_MODE_QACRO,
+	PLANE_MODE_THERMAL,
+	PLANE_MODE_LOITER_ALT_QLAND,
+	PLANE_MODE_AUTOLAND,
+}
+
 var value_to_label_PLANE_MODE = map[PLANE_MODE]string{
 	PLANE_MODE_MANUAL:           "PLANE_MODE_MANUAL",
 	PLANE_MODE_CIRCLE:           "PLANE_MODE_CIRCLE",
@@ -148,3 +177,14 @@ func (e PLANE_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PLANE_MODE) Values() []PLANE_MODE {
+	return append([]PLANE_MODE(nil), values_PLANE_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PLANE_MODE) IsValid() bool {
+	_, ok := value_to_label_PLANE_MODE[e]
+	return ok
+}
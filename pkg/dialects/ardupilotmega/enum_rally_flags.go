@@ -21,6 +21,13 @@ const (
 	ALT_FRAME RALLY_FLAGS = 24
 )
 
+var values_RALLY_FLAGS = []RALLY_FLAGS{
+	FAVORABLE_WIND,
+	LAND_IMMEDIATELY,
+	ALT_FRAME_VALID,
+	ALT_FRAME,
+}
+
 var value_to_label_RALLY_FLAGS = map[RALLY_FLAGS]string{
 	FAVORABLE_WIND:   "FAVORABLE_WIND",
 	LAND_IMMEDIATELY: "LAND_IMMEDIATELY",
@@ -60,3 +67,14 @@ func (e RALLY_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RALLY_FLAGS) Values() []RALLY_FLAGS {
+	return append([]RALLY_FLAGS(nil), values_RALLY_FLAGS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e RALLY_FLAGS) IsValid() bool {
+	_, ok := value_to_label_RALLY_FLAGS[e]
+	return ok
+}
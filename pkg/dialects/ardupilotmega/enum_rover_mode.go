@@ -41,6 +41,23 @@ const (
 	ROVER_MODE_INITIALIZING ROVER_MODE = 16
 )
 
+var values_ROVER_MODE = []ROVER_MODE{
+	ROVER_MODE_MANUAL,
+	ROVER_MODE_ACRO,
+	ROVER_MODE_STEERING,
+	ROVER_MODE_HOLD,
+	ROVER_MODE_LOITER,
+	ROVER_MODE_FOLLOW,
+	ROVER_MODE_SIMPLE,
+	ROVER_MODE_DOCK,
+	ROVER_MODE_CIRCLE,
+	ROVER_MODE_AUTO,
+	ROVER_MODE_RTL,
+	ROVER_MODE_SMART_RTL,
+	ROVER_MODE_GUIDED,
+	ROVER_MODE_INITIALIZING,
+}
+
 var value_to_label_ROVER_MODE = map[ROVER_MODE]string{
 	ROVER_MODE_MANUAL:       "ROVER_MODE_MANUAL",
 	ROVER_MODE_ACRO:         "ROVER_MODE_ACRO",
@@ -100,3 +117,14 @@ func (e ROVER_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ROVER_MODE) Values() []ROVER_MODE {
+	return append([]ROVER_MODE(nil), values_ROVER_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ROVER_MODE) IsValid() bool {
+	_, ok := value_to_label_ROVER_MODE[e]
+	return ok
+}
@@ -20,6 +20,13 @@ const (
 	SCRIPTING_CMD_STOP_AND_RESTART SCRIPTING_CMD = 3
 )
 
+var values_SCRIPTING_CMD = []SCRIPTING_CMD{
+	SCRIPTING_CMD_REPL_START,
+	SCRIPTING_CMD_REPL_STOP,
+	SCRIPTING_CMD_STOP,
+	SCRIPTING_CMD_STOP_AND_RESTART,
+}
+
 var value_to_label_SCRIPTING_CMD = map[SCRIPTING_CMD]string{
 	SCRIPTING_CMD_REPL_START:       "SCRIPTING_CMD_REPL_START",
 	SCRIPTING_CMD_REPL_STOP:        "SCRIPTING_CMD_REPL_STOP",
@@ -59,3 +66,14 @@ func (e SCRIPTING_CMD) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SCRIPTING_CMD) Values() []SCRIPTING_CMD {
+	return append([]SCRIPTING_CMD(nil), values_SCRIPTING_CMD...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SCRIPTING_CMD) IsValid() bool {
+	_, ok := value_to_label_SCRIPTING_CMD[e]
+	return ok
+}
@@ -28,6 +28,17 @@ const (
 	SECURE_COMMAND_FLASH_BOOTLOADER SECURE_COMMAND_OP = 7
 )
 
+var values_SECURE_COMMAND_OP = []SECURE_COMMAND_OP{
+	SECURE_COMMAND_GET_SESSION_KEY,
+	SECURE_COMMAND_GET_REMOTEID_SESSION_KEY,
+	SECURE_COMMAND_REMOVE_PUBLIC_KEYS,
+	SECURE_COMMAND_GET_PUBLIC_KEYS,
+	SECURE_COMMAND_SET_PUBLIC_KEYS,
+	SECURE_COMMAND_GET_REMOTEID_CONFIG,
+	SECURE_COMMAND_SET_REMOTEID_CONFIG,
+	SECURE_COMMAND_FLASH_BOOTLOADER,
+}
+
 var value_to_label_SECURE_COMMAND_OP = map[SECURE_COMMAND_OP]string{
 	SECURE_COMMAND_GET_SESSION_KEY:          "SECURE_COMMAND_GET_SESSION_KEY",
 	SECURE_COMMAND_GET_REMOTEID_SESSION_KEY: "SECURE_COMMAND_GET_REMOTEID_SESSION_KEY",
@@ -75,3 +86,14 @@ func (e SECURE_COMMAND_OP) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SECURE_COMMAND_OP) Values() []SECURE_COMMAND_OP {
+	return append([]SECURE_COMMAND_OP(nil), values_SECURE_COMMAND_OP...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SECURE_COMMAND_OP) IsValid() bool {
+	_, ok := value_to_label_SECURE_COMMAND_OP[e]
+	return ok
+}
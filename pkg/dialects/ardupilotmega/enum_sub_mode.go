@@ -35,6 +35,20 @@ const (
 	SUB_MODE_SURFTRAK SUB_MODE = 21
 )
 
+var values_SUB_MODE = []SUB_MODE{
+	SUB_MODE_STABILIZE,
+	SUB_MODE_ACRO,
+	SUB_MODE_ALT_HOLD,
+	SUB_MODE_AUTO,
+	SUB_MODE_GUIDED,
+	SUB_MODE_CIRCLE,
+	SUB_MODE_SURFACE,
+	SUB_MODE_POSHOLD,
+	SUB_MODE_MANUAL,
+	SUB_MODE_MOTORDETECT,
+	SUB_MODE_SURFTRAK,
+}
+
 var value_to_label_SUB_MODE = map[SUB_MODE]string{
 	SUB_MODE_STABILIZE:   "SUB_MODE_STABILIZE",
 	SUB_MODE_ACRO:        "SUB_MODE_ACRO",
@@ -88,3 +102,14 @@ func (e SUB_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SUB_MODE) Values() []SUB_MODE {
+	return append([]SUB_MODE(nil), values_SUB_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SUB_MODE) IsValid() bool {
+	_, ok := value_to_label_SUB_MODE[e]
+	return ok
+}
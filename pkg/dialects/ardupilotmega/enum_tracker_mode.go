@@ -27,6 +27,16 @@ const (
 	TRACKER_MODE_INITIALIZING TRACKER_MODE = 16
 )
 
+var values_TRACKER_MODE = []TRACKER_MODE{
+	TRACKER_MODE_MANUAL,
+	TRACKER_MODE_STOP,
+	TRACKER_MODE_SCAN,
+	TRACKER_MODE_SERVO_TEST,
+	TRACKER_MODE_GUIDED,
+	TRACKER_MODE_AUTO,
+	TRACKER_MODE_INITIALIZING,
+}
+
 var value_to_label_TRACKER_MODE = map[TRACKER_MODE]string{
 	TRACKER_MODE_MANUAL:       "TRACKER_MODE_MANUAL",
 	TRACKER_MODE_STOP:         "TRACKER_MODE_STOP",
@@ -72,3 +82,14 @@ func (e TRACKER_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (TRACKER_MODE) Values() []TRACKER_MODE {
+	return append([]TRACKER_MODE(nil), values_TRACKER_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e TRACKER_MODE) IsValid() bool {
+	_, ok := value_to_label_TRACKER_MODE[e]
+	return ok
+}
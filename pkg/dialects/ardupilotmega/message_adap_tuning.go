@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Adaptive Controller tuning information.
 type MessageAdapTuning struct {
 	// Axis.
@@ -36,3 +43,55 @@ type MessageAdapTuning struct {
 func (*MessageAdapTuning) GetID() uint32 {
 	return 11010
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAdapTuning) MAVLinkCRCExtra() byte {
+	return 46
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAdapTuning) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 49
+	}
+	return 49
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAdapTuning) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Desired))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Achieved))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Error))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Theta))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Omega))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Sigma))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.ThetaDot))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.OmegaDot))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.SigmaDot))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.F))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.FDot))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.U))
+	buf[48] = byte(m.Axis)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAdapTuning) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Desired = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Achieved = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Error = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Theta = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Omega = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Sigma = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.ThetaDot = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.OmegaDot = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.SigmaDot = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.F = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.FDot = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.U = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.Axis = PID_TUNING_AXIS(buf[48])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAdapTuning) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11010]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of DCM attitude estimator.
 type MessageAhrs struct {
 	// X gyro drift estimate.
@@ -24,3 +31,43 @@ type MessageAhrs struct {
 func (*MessageAhrs) GetID() uint32 {
 	return 163
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAhrs) MAVLinkCRCExtra() byte {
+	return 127
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAhrs) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Omegaix))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Omegaiy))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Omegaiz))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AccelWeight))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.RenormVal))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.ErrorRp))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.ErrorYaw))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Omegaix = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Omegaiy = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Omegaiz = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.AccelWeight = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.RenormVal = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.ErrorRp = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.ErrorYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAhrs) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[163]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of secondary AHRS filter if available.
 type MessageAhrs2 struct {
 	// Roll angle.
@@ -22,3 +29,41 @@ type MessageAhrs2 struct {
 func (*MessageAhrs2) GetID() uint32 {
 	return 178
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAhrs2) MAVLinkCRCExtra() byte {
+	return 47
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAhrs2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 24
+	}
+	return 24
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Altitude))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.Lng))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Altitude = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[20:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAhrs2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[178]
+}
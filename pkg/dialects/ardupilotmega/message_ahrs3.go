@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of third AHRS filter if available. This is for ANU research group (Ali and Sean).
 type MessageAhrs3 struct {
 	// Roll angle.
@@ -30,3 +37,49 @@ type MessageAhrs3 struct {
 func (*MessageAhrs3) GetID() uint32 {
 	return 182
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAhrs3) MAVLinkCRCExtra() byte {
+	return 229
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAhrs3) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 40
+	}
+	return 40
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs3) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Altitude))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.Lng))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.V1))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.V2))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.V3))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.V4))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAhrs3) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Altitude = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[20:]))
+	m.V1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.V2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.V3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.V4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAhrs3) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[182]
+}
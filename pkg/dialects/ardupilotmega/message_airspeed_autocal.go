@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Airspeed auto-calibration.
 type MessageAirspeedAutocal struct {
 	// GPS velocity north.
@@ -34,3 +41,53 @@ type MessageAirspeedAutocal struct {
 func (*MessageAirspeedAutocal) GetID() uint32 {
 	return 174
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAirspeedAutocal) MAVLinkCRCExtra() byte {
+	return 167
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAirspeedAutocal) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 48
+	}
+	return 48
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirspeedAutocal) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Vx))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Vy))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Vz))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.DiffPressure))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Eas2tas))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Ratio))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.StateX))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.StateY))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.StateZ))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Pax))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.Pby))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.Pcz))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirspeedAutocal) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Vx = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Vy = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Vz = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.DiffPressure = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Eas2tas = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Ratio = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.StateX = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.StateY = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.StateZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Pax = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Pby = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.Pcz = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAirspeedAutocal) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[174]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Angle of Attack and Side Slip Angle.
 type MessageAoaSsa struct {
 	// Timestamp (since boot or Unix epoch).
@@ -16,3 +23,35 @@ type MessageAoaSsa struct {
 func (*MessageAoaSsa) GetID() uint32 {
 	return 11020
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAoaSsa) MAVLinkCRCExtra() byte {
+	return 205
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAoaSsa) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAoaSsa) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Aoa))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Ssa))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAoaSsa) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Aoa = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Ssa = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAoaSsa) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11020]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Raw ADC output.
 type MessageApAdc struct {
 	// ADC output 1.
@@ -22,3 +28,41 @@ type MessageApAdc struct {
 func (*MessageApAdc) GetID() uint32 {
 	return 153
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageApAdc) MAVLinkCRCExtra() byte {
+	return 188
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageApAdc) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageApAdc) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Adc1)
+	binary.LittleEndian.PutUint16(buf[2:], m.Adc2)
+	binary.LittleEndian.PutUint16(buf[4:], m.Adc3)
+	binary.LittleEndian.PutUint16(buf[6:], m.Adc4)
+	binary.LittleEndian.PutUint16(buf[8:], m.Adc5)
+	binary.LittleEndian.PutUint16(buf[10:], m.Adc6)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageApAdc) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Adc1 = binary.LittleEndian.Uint16(buf[0:])
+	m.Adc2 = binary.LittleEndian.Uint16(buf[2:])
+	m.Adc3 = binary.LittleEndian.Uint16(buf[4:])
+	m.Adc4 = binary.LittleEndian.Uint16(buf[6:])
+	m.Adc5 = binary.LittleEndian.Uint16(buf[8:])
+	m.Adc6 = binary.LittleEndian.Uint16(buf[10:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageApAdc) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[153]
+}
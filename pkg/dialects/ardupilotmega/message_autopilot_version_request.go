@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request the autopilot version from the system/component.
 type MessageAutopilotVersionRequest struct {
 	// System ID.
@@ -14,3 +18,33 @@ type MessageAutopilotVersionRequest struct {
 func (*MessageAutopilotVersionRequest) GetID() uint32 {
 	return 183
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAutopilotVersionRequest) MAVLinkCRCExtra() byte {
+	return 85
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAutopilotVersionRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotVersionRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotVersionRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAutopilotVersionRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[183]
+}
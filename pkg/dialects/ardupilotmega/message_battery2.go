@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // 2nd Battery status
 type MessageBattery2 struct {
 	// Voltage.
@@ -14,3 +20,33 @@ type MessageBattery2 struct {
 func (*MessageBattery2) GetID() uint32 {
 	return 181
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageBattery2) MAVLinkCRCExtra() byte {
+	return 174
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageBattery2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBattery2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Voltage)
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.CurrentBattery))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBattery2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Voltage = binary.LittleEndian.Uint16(buf[0:])
+	m.CurrentBattery = int16(binary.LittleEndian.Uint16(buf[2:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageBattery2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[181]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera Capture Feedback.
 type MessageCameraFeedback struct {
 	// Image timestamp (since UNIX epoch), as passed in by CAMERA_STATUS message (or autopilot if no CCB).
@@ -38,3 +45,63 @@ type MessageCameraFeedback struct {
 func (*MessageCameraFeedback) GetID() uint32 {
 	return 180
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraFeedback) MAVLinkCRCExtra() byte {
+	return 52
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraFeedback) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 47
+	}
+	return 45
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraFeedback) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lng))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.AltMsl))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AltRel))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.FocLen))
+	binary.LittleEndian.PutUint16(buf[40:], m.ImgIdx)
+	buf[42] = m.TargetSystem
+	buf[43] = m.CamIdx
+	buf[44] = byte(m.Flags)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[45:], m.CompletedCaptures)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraFeedback) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.AltMsl = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.AltRel = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.FocLen = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.ImgIdx = binary.LittleEndian.Uint16(buf[40:])
+	m.TargetSystem = buf[42]
+	m.CamIdx = buf[43]
+	m.Flags = CAMERA_FEEDBACK_FLAGS(buf[44])
+	if !isV2 {
+		return
+	}
+	m.CompletedCaptures = binary.LittleEndian.Uint16(buf[45:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraFeedback) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[180]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera Event.
 type MessageCameraStatus struct {
 	// Image timestamp (since UNIX epoch, according to camera clock).
@@ -28,3 +35,47 @@ type MessageCameraStatus struct {
 func (*MessageCameraStatus) GetID() uint32 {
 	return 179
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraStatus) MAVLinkCRCExtra() byte {
+	return 189
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 29
+	}
+	return 29
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.P1))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.P2))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.P3))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.P4))
+	binary.LittleEndian.PutUint16(buf[24:], m.ImgIdx)
+	buf[26] = m.TargetSystem
+	buf[27] = m.CamIdx
+	buf[28] = byte(m.EventId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.P1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.P2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.P3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.P4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.ImgIdx = binary.LittleEndian.Uint16(buf[24:])
+	m.TargetSystem = buf[26]
+	m.CamIdx = buf[27]
+	m.EventId = CAMERA_STATUS_TYPES(buf[28])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[179]
+}
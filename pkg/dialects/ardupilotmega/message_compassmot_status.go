@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of compassmot calibration.
 type MessageCompassmotStatus struct {
 	// Throttle.
@@ -22,3 +29,41 @@ type MessageCompassmotStatus struct {
 func (*MessageCompassmotStatus) GetID() uint32 {
 	return 177
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCompassmotStatus) MAVLinkCRCExtra() byte {
+	return 240
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCompassmotStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCompassmotStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Current))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Compensationx))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Compensationy))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Compensationz))
+	binary.LittleEndian.PutUint16(buf[16:], m.Throttle)
+	binary.LittleEndian.PutUint16(buf[18:], m.Interference)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCompassmotStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Current = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Compensationx = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Compensationy = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Compensationz = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Throttle = binary.LittleEndian.Uint16(buf[16:])
+	m.Interference = binary.LittleEndian.Uint16(buf[18:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCompassmotStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[177]
+}
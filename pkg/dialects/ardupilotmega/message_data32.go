@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data packet, size 32.
 type MessageData32 struct {
 	// Data type.
@@ -16,3 +20,39 @@ type MessageData32 struct {
 func (*MessageData32) GetID() uint32 {
 	return 170
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageData32) MAVLinkCRCExtra() byte {
+	return 73
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageData32) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 34
+	}
+	return 34
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageData32) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.Type
+	buf[1] = m.Len
+	for i, v := range m.Data {
+		buf[2+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageData32) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Type = buf[0]
+	m.Len = buf[1]
+	for i := range m.Data {
+		m.Data[i] = buf[2+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageData32) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[170]
+}
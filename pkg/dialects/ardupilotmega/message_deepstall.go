@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Deepstall path planning.
 type MessageDeepstall struct {
 	// Landing latitude.
@@ -30,3 +37,49 @@ type MessageDeepstall struct {
 func (*MessageDeepstall) GetID() uint32 {
 	return 195
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDeepstall) MAVLinkCRCExtra() byte {
+	return 120
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDeepstall) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 37
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeepstall) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.LandingLat))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.LandingLon))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.PathLat))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.PathLon))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.ArcEntryLat))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.ArcEntryLon))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Altitude))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.ExpectedTravelDistance))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.CrossTrackError))
+	buf[36] = byte(m.Stage)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeepstall) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.LandingLat = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.LandingLon = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.PathLat = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.PathLon = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.ArcEntryLat = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.ArcEntryLon = int32(binary.LittleEndian.Uint32(buf[20:]))
+	m.Altitude = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.ExpectedTravelDistance = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.CrossTrackError = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Stage = DEEPSTALL_STAGE(buf[36])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDeepstall) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[195]
+}
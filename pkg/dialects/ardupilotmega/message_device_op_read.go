@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Read registers for a device.
 type MessageDeviceOpRead struct {
 	// System ID.
@@ -30,3 +37,59 @@ type MessageDeviceOpRead struct {
 func (*MessageDeviceOpRead) GetID() uint32 {
 	return 11000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDeviceOpRead) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDeviceOpRead) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 52
+	}
+	return 51
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpRead) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = byte(m.Bustype)
+	buf[7] = m.Bus
+	buf[8] = m.Address
+	copy(buf[9:49], m.Busname)
+	buf[49] = m.Regstart
+	buf[50] = m.Count
+	if !isV2 {
+		return
+	}
+	buf[51] = m.Bank
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpRead) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.Bustype = DEVICE_OP_BUSTYPE(buf[6])
+	m.Bus = buf[7]
+	m.Address = buf[8]
+	if n := bytes.IndexByte(buf[9:49], 0); n >= 0 {
+		m.Busname = string(buf[9 : 9+n])
+	} else {
+		m.Busname = string(buf[9:49])
+	}
+	m.Regstart = buf[49]
+	m.Count = buf[50]
+	if !isV2 {
+		return
+	}
+	m.Bank = buf[51]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDeviceOpRead) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11000]
+}
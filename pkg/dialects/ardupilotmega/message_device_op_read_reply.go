@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Read registers reply.
 type MessageDeviceOpReadReply struct {
 	// Request ID - copied from request.
@@ -22,3 +28,51 @@ type MessageDeviceOpReadReply struct {
 func (*MessageDeviceOpReadReply) GetID() uint32 {
 	return 11001
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDeviceOpReadReply) MAVLinkCRCExtra() byte {
+	return 15
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDeviceOpReadReply) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 136
+	}
+	return 135
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpReadReply) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	buf[4] = m.Result
+	buf[5] = m.Regstart
+	buf[6] = m.Count
+	for i, v := range m.Data {
+		buf[7+i] = v
+	}
+	if !isV2 {
+		return
+	}
+	buf[135] = m.Bank
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpReadReply) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.Result = buf[4]
+	m.Regstart = buf[5]
+	m.Count = buf[6]
+	for i := range m.Data {
+		m.Data[i] = buf[7+i]
+	}
+	if !isV2 {
+		return
+	}
+	m.Bank = buf[135]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDeviceOpReadReply) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11001]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Write registers reply.
 type MessageDeviceOpWriteReply struct {
 	// Request ID - copied from request.
@@ -14,3 +20,33 @@ type MessageDeviceOpWriteReply struct {
 func (*MessageDeviceOpWriteReply) GetID() uint32 {
 	return 11003
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDeviceOpWriteReply) MAVLinkCRCExtra() byte {
+	return 64
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDeviceOpWriteReply) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 5
+	}
+	return 5
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpWriteReply) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	buf[4] = m.Result
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDeviceOpWriteReply) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.Result = buf[4]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDeviceOpWriteReply) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11003]
+}
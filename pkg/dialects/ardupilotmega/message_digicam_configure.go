@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Configure on-board Camera Control System.
 type MessageDigicamConfigure struct {
 	// System ID.
@@ -32,3 +39,51 @@ type MessageDigicamConfigure struct {
 func (*MessageDigicamConfigure) GetID() uint32 {
 	return 154
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDigicamConfigure) MAVLinkCRCExtra() byte {
+	return 84
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDigicamConfigure) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 15
+	}
+	return 15
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDigicamConfigure) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.ExtraValue))
+	binary.LittleEndian.PutUint16(buf[4:], m.ShutterSpeed)
+	buf[6] = m.TargetSystem
+	buf[7] = m.TargetComponent
+	buf[8] = m.Mode
+	buf[9] = m.Aperture
+	buf[10] = m.Iso
+	buf[11] = m.ExposureType
+	buf[12] = m.CommandId
+	buf[13] = m.EngineCutOff
+	buf[14] = m.ExtraParam
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDigicamConfigure) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ExtraValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.ShutterSpeed = binary.LittleEndian.Uint16(buf[4:])
+	m.TargetSystem = buf[6]
+	m.TargetComponent = buf[7]
+	m.Mode = buf[8]
+	m.Aperture = buf[9]
+	m.Iso = buf[10]
+	m.ExposureType = buf[11]
+	m.CommandId = buf[12]
+	m.EngineCutOff = buf[13]
+	m.ExtraParam = buf[14]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDigicamConfigure) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[154]
+}
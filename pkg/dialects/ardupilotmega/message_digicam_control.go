@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control on-board Camera Control System to take shots.
 type MessageDigicamControl struct {
 	// System ID.
@@ -30,3 +37,49 @@ type MessageDigicamControl struct {
 func (*MessageDigicamControl) GetID() uint32 {
 	return 155
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDigicamControl) MAVLinkCRCExtra() byte {
+	return 22
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDigicamControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 13
+	}
+	return 13
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDigicamControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.ExtraValue))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = m.Session
+	buf[7] = m.ZoomPos
+	buf[8] = byte(m.ZoomStep)
+	buf[9] = m.FocusLock
+	buf[10] = m.Shot
+	buf[11] = m.CommandId
+	buf[12] = m.ExtraParam
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDigicamControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ExtraValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.Session = buf[6]
+	m.ZoomPos = buf[7]
+	m.ZoomStep = int8(buf[8])
+	m.FocusLock = buf[9]
+	m.Shot = buf[10]
+	m.CommandId = buf[11]
+	m.ExtraParam = buf[12]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDigicamControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[155]
+}
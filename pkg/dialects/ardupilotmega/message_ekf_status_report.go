@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // EKF Status message including flags and variances.
 type MessageEkfStatusReport struct {
 	// Flags.
@@ -24,3 +31,49 @@ type MessageEkfStatusReport struct {
 func (*MessageEkfStatusReport) GetID() uint32 {
 	return 193
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEkfStatusReport) MAVLinkCRCExtra() byte {
+	return 71
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEkfStatusReport) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 26
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEkfStatusReport) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.VelocityVariance))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.PosHorizVariance))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.PosVertVariance))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.CompassVariance))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.TerrainAltVariance))
+	binary.LittleEndian.PutUint16(buf[20:], uint16(m.Flags))
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[22:], math.Float32bits(m.AirspeedVariance))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEkfStatusReport) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.VelocityVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.PosHorizVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.PosVertVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.CompassVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.TerrainAltVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Flags = EKF_STATUS_FLAGS(binary.LittleEndian.Uint16(buf[20:]))
+	if !isV2 {
+		return
+	}
+	m.AirspeedVariance = math.Float32frombits(binary.LittleEndian.Uint32(buf[22:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEkfStatusReport) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[193]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ESC Telemetry Data for ESCs 17 to 20, matching data sent by BLHeli ESCs.
 type MessageEscTelemetry_17To_20 struct {
 	// Temperature.
@@ -22,3 +28,65 @@ type MessageEscTelemetry_17To_20 struct {
 func (*MessageEscTelemetry_17To_20) GetID() uint32 {
 	return 11041
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEscTelemetry_17To_20) MAVLinkCRCExtra() byte {
+	return 208
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEscTelemetry_17To_20) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 44
+	}
+	return 44
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscTelemetry_17To_20) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Voltage {
+		binary.LittleEndian.PutUint16(buf[i*2:], v)
+	}
+	for i, v := range m.Current {
+		binary.LittleEndian.PutUint16(buf[8+i*2:], v)
+	}
+	for i, v := range m.Totalcurrent {
+		binary.LittleEndian.PutUint16(buf[16+i*2:], v)
+	}
+	for i, v := range m.Rpm {
+		binary.LittleEndian.PutUint16(buf[24+i*2:], v)
+	}
+	for i, v := range m.Count {
+		binary.LittleEndian.PutUint16(buf[32+i*2:], v)
+	}
+	for i, v := range m.Temperature {
+		buf[40+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscTelemetry_17To_20) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Voltage {
+		m.Voltage[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+	for i := range m.Current {
+		m.Current[i] = binary.LittleEndian.Uint16(buf[8+i*2:])
+	}
+	for i := range m.Totalcurrent {
+		m.Totalcurrent[i] = binary.LittleEndian.Uint16(buf[16+i*2:])
+	}
+	for i := range m.Rpm {
+		m.Rpm[i] = binary.LittleEndian.Uint16(buf[24+i*2:])
+	}
+	for i := range m.Count {
+		m.Count[i] = binary.LittleEndian.Uint16(buf[32+i*2:])
+	}
+	for i := range m.Temperature {
+		m.Temperature[i] = buf[40+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEscTelemetry_17To_20) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11041]
+}
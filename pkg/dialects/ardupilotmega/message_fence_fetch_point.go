@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a current fence point from MAV.
 type MessageFenceFetchPoint struct {
 	// System ID.
@@ -16,3 +20,35 @@ type MessageFenceFetchPoint struct {
 func (*MessageFenceFetchPoint) GetID() uint32 {
 	return 161
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFenceFetchPoint) MAVLinkCRCExtra() byte {
+	return 68
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFenceFetchPoint) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFenceFetchPoint) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = m.Idx
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFenceFetchPoint) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.Idx = buf[2]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFenceFetchPoint) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[161]
+}
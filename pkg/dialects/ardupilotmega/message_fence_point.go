@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A fence point. Used to set a point when from GCS -> MAV. Also used to return a point from MAV -> GCS.
 type MessageFencePoint struct {
 	// System ID.
@@ -22,3 +29,41 @@ type MessageFencePoint struct {
 func (*MessageFencePoint) GetID() uint32 {
 	return 160
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFencePoint) MAVLinkCRCExtra() byte {
+	return 78
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFencePoint) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFencePoint) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Lat))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Lng))
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+	buf[10] = m.Idx
+	buf[11] = m.Count
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFencePoint) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Lat = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Lng = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+	m.Idx = buf[10]
+	m.Count = buf[11]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFencePoint) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[160]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control message for rate gimbal.
 type MessageGimbalControl struct {
 	// System ID.
@@ -20,3 +27,39 @@ type MessageGimbalControl struct {
 func (*MessageGimbalControl) GetID() uint32 {
 	return 201
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalControl) MAVLinkCRCExtra() byte {
+	return 205
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.DemandedRateX))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.DemandedRateY))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.DemandedRateZ))
+	buf[12] = m.TargetSystem
+	buf[13] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DemandedRateX = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.DemandedRateY = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.DemandedRateZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.TargetSystem = buf[12]
+	m.TargetComponent = buf[13]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[201]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // 3 axis gimbal measurements.
 type MessageGimbalReport struct {
 	// System ID.
@@ -34,3 +41,53 @@ type MessageGimbalReport struct {
 func (*MessageGimbalReport) GetID() uint32 {
 	return 200
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalReport) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalReport) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 42
+	}
+	return 42
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalReport) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.DeltaTime))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.DeltaAngleX))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.DeltaAngleY))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.DeltaAngleZ))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.DeltaVelocityX))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.DeltaVelocityY))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.DeltaVelocityZ))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.JointRoll))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.JointEl))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.JointAz))
+	buf[40] = m.TargetSystem
+	buf[41] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalReport) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DeltaTime = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.DeltaAngleX = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.DeltaAngleY = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.DeltaAngleZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.DeltaVelocityX = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.DeltaVelocityY = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.DeltaVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.JointRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.JointEl = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.JointAz = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.TargetSystem = buf[40]
+	m.TargetComponent = buf[41]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalReport) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[200]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // 100 Hz gimbal torque command telemetry.
 type MessageGimbalTorqueCmdReport struct {
 	// System ID.
@@ -20,3 +26,39 @@ type MessageGimbalTorqueCmdReport struct {
 func (*MessageGimbalTorqueCmdReport) GetID() uint32 {
 	return 214
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalTorqueCmdReport) MAVLinkCRCExtra() byte {
+	return 69
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalTorqueCmdReport) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalTorqueCmdReport) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.RlTorqueCmd))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.ElTorqueCmd))
+	binary.LittleEndian.PutUint16(buf[4:], uint16(m.AzTorqueCmd))
+	buf[6] = m.TargetSystem
+	buf[7] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalTorqueCmdReport) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RlTorqueCmd = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.ElTorqueCmd = int16(binary.LittleEndian.Uint16(buf[2:]))
+	m.AzTorqueCmd = int16(binary.LittleEndian.Uint16(buf[4:]))
+	m.TargetSystem = buf[6]
+	m.TargetComponent = buf[7]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalTorqueCmdReport) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[214]
+}
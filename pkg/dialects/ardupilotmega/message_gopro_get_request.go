@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a GOPRO_COMMAND response from the GoPro.
 type MessageGoproGetRequest struct {
 	// System ID.
@@ -16,3 +20,35 @@ type MessageGoproGetRequest struct {
 func (*MessageGoproGetRequest) GetID() uint32 {
 	return 216
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGoproGetRequest) MAVLinkCRCExtra() byte {
+	return 50
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGoproGetRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproGetRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = byte(m.CmdId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproGetRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.CmdId = GOPRO_COMMAND(buf[2])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGoproGetRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[216]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Response from a GOPRO_COMMAND get request.
 type MessageGoproGetResponse struct {
 	// Command ID.
@@ -16,3 +20,39 @@ type MessageGoproGetResponse struct {
 func (*MessageGoproGetResponse) GetID() uint32 {
 	return 217
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGoproGetResponse) MAVLinkCRCExtra() byte {
+	return 202
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGoproGetResponse) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproGetResponse) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.CmdId)
+	buf[1] = byte(m.Status)
+	for i, v := range m.Value {
+		buf[2+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproGetResponse) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CmdId = GOPRO_COMMAND(buf[0])
+	m.Status = GOPRO_REQUEST_STATUS(buf[1])
+	for i := range m.Value {
+		m.Value[i] = buf[2+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGoproGetResponse) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[217]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Heartbeat from a HeroBus attached GoPro.
 type MessageGoproHeartbeat struct {
 	// Status.
@@ -16,3 +20,35 @@ type MessageGoproHeartbeat struct {
 func (*MessageGoproHeartbeat) GetID() uint32 {
 	return 215
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGoproHeartbeat) MAVLinkCRCExtra() byte {
+	return 101
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGoproHeartbeat) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproHeartbeat) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Status)
+	buf[1] = byte(m.CaptureMode)
+	buf[2] = byte(m.Flags)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproHeartbeat) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Status = GOPRO_HEARTBEAT_STATUS(buf[0])
+	m.CaptureMode = GOPRO_CAPTURE_MODE(buf[1])
+	m.Flags = GOPRO_HEARTBEAT_FLAGS(buf[2])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGoproHeartbeat) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[215]
+}
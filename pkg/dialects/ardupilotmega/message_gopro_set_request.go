@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request to set a GOPRO_COMMAND with a desired.
 type MessageGoproSetRequest struct {
 	// System ID.
@@ -18,3 +22,41 @@ type MessageGoproSetRequest struct {
 func (*MessageGoproSetRequest) GetID() uint32 {
 	return 218
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGoproSetRequest) MAVLinkCRCExtra() byte {
+	return 17
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGoproSetRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 7
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproSetRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = byte(m.CmdId)
+	for i, v := range m.Value {
+		buf[3+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproSetRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.CmdId = GOPRO_COMMAND(buf[2])
+	for i := range m.Value {
+		m.Value[i] = buf[3+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGoproSetRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[218]
+}
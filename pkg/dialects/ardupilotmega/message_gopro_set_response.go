@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Response from a GOPRO_COMMAND set request.
 type MessageGoproSetResponse struct {
 	// Command ID.
@@ -14,3 +18,33 @@ type MessageGoproSetResponse struct {
 func (*MessageGoproSetResponse) GetID() uint32 {
 	return 219
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGoproSetResponse) MAVLinkCRCExtra() byte {
+	return 162
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGoproSetResponse) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproSetResponse) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.CmdId)
+	buf[1] = byte(m.Status)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGoproSetResponse) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CmdId = GOPRO_COMMAND(buf[0])
+	m.Status = GOPRO_REQUEST_STATUS(buf[1])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGoproSetResponse) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[219]
+}
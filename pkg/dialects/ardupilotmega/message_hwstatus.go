@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of key hardware.
 type MessageHwstatus struct {
 	// Board voltage.
@@ -14,3 +20,33 @@ type MessageHwstatus struct {
 func (*MessageHwstatus) GetID() uint32 {
 	return 165
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHwstatus) MAVLinkCRCExtra() byte {
+	return 21
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHwstatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHwstatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Vcc)
+	buf[2] = m.I2cerr
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHwstatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Vcc = binary.LittleEndian.Uint16(buf[0:])
+	m.I2cerr = buf[2]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHwstatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[165]
+}
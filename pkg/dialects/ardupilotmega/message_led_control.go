@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control vehicle LEDs.
 type MessageLedControl struct {
 	// System ID.
@@ -22,3 +26,45 @@ type MessageLedControl struct {
 func (*MessageLedControl) GetID() uint32 {
 	return 186
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLedControl) MAVLinkCRCExtra() byte {
+	return 72
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLedControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 29
+	}
+	return 29
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = m.Instance
+	buf[3] = m.Pattern
+	buf[4] = m.CustomLen
+	for i, v := range m.CustomBytes {
+		buf[5+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.Instance = buf[2]
+	m.Pattern = buf[3]
+	m.CustomLen = buf[4]
+	for i := range m.CustomBytes {
+		m.CustomBytes[i] = buf[5+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLedControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[186]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of AP_Limits. Sent in extended status stream when AP_Limits is enabled.
 type MessageLimitsStatus struct {
 	// State of AP_Limits.
@@ -28,3 +34,47 @@ type MessageLimitsStatus struct {
 func (*MessageLimitsStatus) GetID() uint32 {
 	return 167
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLimitsStatus) MAVLinkCRCExtra() byte {
+	return 144
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLimitsStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 22
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLimitsStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.LastTrigger)
+	binary.LittleEndian.PutUint32(buf[4:], m.LastAction)
+	binary.LittleEndian.PutUint32(buf[8:], m.LastRecovery)
+	binary.LittleEndian.PutUint32(buf[12:], m.LastClear)
+	binary.LittleEndian.PutUint16(buf[16:], m.BreachCount)
+	buf[18] = byte(m.LimitsState)
+	buf[19] = byte(m.ModsEnabled)
+	buf[20] = byte(m.ModsRequired)
+	buf[21] = byte(m.ModsTriggered)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLimitsStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.LastTrigger = binary.LittleEndian.Uint32(buf[0:])
+	m.LastAction = binary.LittleEndian.Uint32(buf[4:])
+	m.LastRecovery = binary.LittleEndian.Uint32(buf[8:])
+	m.LastClear = binary.LittleEndian.Uint32(buf[12:])
+	m.BreachCount = binary.LittleEndian.Uint16(buf[16:])
+	m.LimitsState = LIMITS_STATE(buf[18])
+	m.ModsEnabled = LIMIT_MODULE(buf[19])
+	m.ModsRequired = LIMIT_MODULE(buf[20])
+	m.ModsTriggered = LIMIT_MODULE(buf[21])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLimitsStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[167]
+}
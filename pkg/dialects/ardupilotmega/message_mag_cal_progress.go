@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reports progress of compass calibration.
 type MessageMagCalProgress struct {
 	// Compass being calibrated.
@@ -28,3 +35,51 @@ type MessageMagCalProgress struct {
 func (*MessageMagCalProgress) GetID() uint32 {
 	return 191
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMagCalProgress) MAVLinkCRCExtra() byte {
+	return 92
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMagCalProgress) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 27
+	}
+	return 27
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMagCalProgress) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.DirectionX))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.DirectionY))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.DirectionZ))
+	buf[12] = m.CompassId
+	buf[13] = m.CalMask
+	buf[14] = byte(m.CalStatus)
+	buf[15] = m.Attempt
+	buf[16] = m.CompletionPct
+	for i, v := range m.CompletionMask {
+		buf[17+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMagCalProgress) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DirectionX = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.DirectionY = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.DirectionZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.CompassId = buf[12]
+	m.CalMask = buf[13]
+	m.CalStatus = MAG_CAL_STATUS(buf[14])
+	m.Attempt = buf[15]
+	m.CompletionPct = buf[16]
+	for i := range m.CompletionMask {
+		m.CompletionMask[i] = buf[17+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMagCalProgress) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[191]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The MCU status, giving MCU temperature and voltage. The min and max voltages are to allow for detecting power supply instability.
 type MessageMcuStatus struct {
 	// MCU instance
@@ -20,3 +26,39 @@ type MessageMcuStatus struct {
 func (*MessageMcuStatus) GetID() uint32 {
 	return 11039
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMcuStatus) MAVLinkCRCExtra() byte {
+	return 142
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMcuStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMcuStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.McuTemperature))
+	binary.LittleEndian.PutUint16(buf[2:], m.McuVoltage)
+	binary.LittleEndian.PutUint16(buf[4:], m.McuVoltageMin)
+	binary.LittleEndian.PutUint16(buf[6:], m.McuVoltageMax)
+	buf[8] = m.Id
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMcuStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.McuTemperature = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.McuVoltage = binary.LittleEndian.Uint16(buf[2:])
+	m.McuVoltageMin = binary.LittleEndian.Uint16(buf[4:])
+	m.McuVoltageMax = binary.LittleEndian.Uint16(buf[6:])
+	m.Id = buf[8]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMcuStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11039]
+}
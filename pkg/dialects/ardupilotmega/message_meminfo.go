@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // State of autopilot RAM.
 type MessageMeminfo struct {
 	// Heap top.
@@ -16,3 +22,41 @@ type MessageMeminfo struct {
 func (*MessageMeminfo) GetID() uint32 {
 	return 152
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMeminfo) MAVLinkCRCExtra() byte {
+	return 208
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMeminfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMeminfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Brkval)
+	binary.LittleEndian.PutUint16(buf[2:], m.Freemem)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[4:], m.Freemem32)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMeminfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Brkval = binary.LittleEndian.Uint16(buf[0:])
+	m.Freemem = binary.LittleEndian.Uint16(buf[2:])
+	if !isV2 {
+		return
+	}
+	m.Freemem32 = binary.LittleEndian.Uint32(buf[4:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMeminfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[152]
+}
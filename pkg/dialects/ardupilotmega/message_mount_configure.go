@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message to configure a camera mount, directional antenna, etc.
 type MessageMountConfigure struct {
 	// System ID.
@@ -22,3 +26,41 @@ type MessageMountConfigure struct {
 func (*MessageMountConfigure) GetID() uint32 {
 	return 156
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMountConfigure) MAVLinkCRCExtra() byte {
+	return 19
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMountConfigure) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountConfigure) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = byte(m.MountMode)
+	buf[3] = m.StabRoll
+	buf[4] = m.StabPitch
+	buf[5] = m.StabYaw
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountConfigure) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.MountMode = MAV_MOUNT_MODE(buf[2])
+	m.StabRoll = buf[3]
+	m.StabPitch = buf[4]
+	m.StabYaw = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMountConfigure) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[156]
+}
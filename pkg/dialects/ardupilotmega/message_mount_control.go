@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message to control a camera mount, directional antenna, etc.
 type MessageMountControl struct {
 	// System ID.
@@ -22,3 +28,41 @@ type MessageMountControl struct {
 func (*MessageMountControl) GetID() uint32 {
 	return 157
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMountControl) MAVLinkCRCExtra() byte {
+	return 21
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMountControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 15
+	}
+	return 15
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.InputA))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.InputB))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.InputC))
+	buf[12] = m.TargetSystem
+	buf[13] = m.TargetComponent
+	buf[14] = m.SavePosition
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.InputA = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.InputB = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.InputC = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.TargetSystem = buf[12]
+	m.TargetComponent = buf[13]
+	m.SavePosition = buf[14]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMountControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[157]
+}
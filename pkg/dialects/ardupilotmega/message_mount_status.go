@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message with some status from autopilot to GCS about camera or antenna mount.
 type MessageMountStatus struct {
 	// System ID.
@@ -22,3 +28,47 @@ type MessageMountStatus struct {
 func (*MessageMountStatus) GetID() uint32 {
 	return 158
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMountStatus) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMountStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 15
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.PointingA))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.PointingB))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.PointingC))
+	buf[12] = m.TargetSystem
+	buf[13] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[14] = byte(m.MountMode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.PointingA = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.PointingB = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.PointingC = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.TargetSystem = buf[12]
+	m.TargetComponent = buf[13]
+	if !isV2 {
+		return
+	}
+	m.MountMode = MAV_MOUNT_MODE(buf[14])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMountStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[158]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a key-value pair as string. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type MessageNamedValueString struct {
 	// Timestamp (time since system boot).
@@ -16,3 +23,43 @@ type MessageNamedValueString struct {
 func (*MessageNamedValueString) GetID() uint32 {
 	return 11060
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageNamedValueString) MAVLinkCRCExtra() byte {
+	return 162
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageNamedValueString) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 78
+	}
+	return 78
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueString) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	copy(buf[4:14], m.Name)
+	copy(buf[14:78], m.Value)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueString) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	if n := bytes.IndexByte(buf[4:14], 0); n >= 0 {
+		m.Name = string(buf[4 : 4+n])
+	} else {
+		m.Name = string(buf[4:14])
+	}
+	if n := bytes.IndexByte(buf[14:78], 0); n >= 0 {
+		m.Value = string(buf[14 : 14+n])
+	} else {
+		m.Value = string(buf[14:78])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageNamedValueString) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11060]
+}
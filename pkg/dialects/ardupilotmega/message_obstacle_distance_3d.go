@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Obstacle located as a 3D vector.
 type MessageObstacleDistance_3d struct {
 	// Timestamp (time since system boot).
@@ -28,3 +35,47 @@ type MessageObstacleDistance_3d struct {
 func (*MessageObstacleDistance_3d) GetID() uint32 {
 	return 11037
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageObstacleDistance_3d) MAVLinkCRCExtra() byte {
+	return 130
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageObstacleDistance_3d) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageObstacleDistance_3d) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.MinDistance))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.MaxDistance))
+	binary.LittleEndian.PutUint16(buf[24:], m.ObstacleId)
+	buf[26] = byte(m.SensorType)
+	buf[27] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageObstacleDistance_3d) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.MinDistance = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.MaxDistance = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.ObstacleId = binary.LittleEndian.Uint16(buf[24:])
+	m.SensorType = MAV_DISTANCE_SENSOR(buf[26])
+	m.Frame = MAV_FRAME(buf[27])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageObstacleDistance_3d) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11037]
+}
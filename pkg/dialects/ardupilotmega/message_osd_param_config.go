@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Configure an OSD parameter slot.
 type MessageOsdParamConfig struct {
 	// System ID.
@@ -30,3 +38,53 @@ type MessageOsdParamConfig struct {
 func (*MessageOsdParamConfig) GetID() uint32 {
 	return 11033
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOsdParamConfig) MAVLinkCRCExtra() byte {
+	return 195
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOsdParamConfig) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 37
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamConfig) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.MinValue))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.MaxValue))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Increment))
+	buf[16] = m.TargetSystem
+	buf[17] = m.TargetComponent
+	buf[18] = m.OsdScreen
+	buf[19] = m.OsdIndex
+	copy(buf[20:36], m.ParamId)
+	buf[36] = byte(m.ConfigType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamConfig) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.MinValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.MaxValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Increment = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.TargetSystem = buf[16]
+	m.TargetComponent = buf[17]
+	m.OsdScreen = buf[18]
+	m.OsdIndex = buf[19]
+	if n := bytes.IndexByte(buf[20:36], 0); n >= 0 {
+		m.ParamId = string(buf[20 : 20+n])
+	} else {
+		m.ParamId = string(buf[20:36])
+	}
+	m.ConfigType = OSD_PARAM_CONFIG_TYPE(buf[36])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOsdParamConfig) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11033]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Configure OSD parameter reply.
 type MessageOsdParamConfigReply struct {
 	// Request ID - copied from request.
@@ -14,3 +20,33 @@ type MessageOsdParamConfigReply struct {
 func (*MessageOsdParamConfigReply) GetID() uint32 {
 	return 11034
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOsdParamConfigReply) MAVLinkCRCExtra() byte {
+	return 79
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOsdParamConfigReply) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 5
+	}
+	return 5
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamConfigReply) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	buf[4] = byte(m.Result)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamConfigReply) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.Result = OSD_PARAM_CONFIG_ERROR(buf[4])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOsdParamConfigReply) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11034]
+}
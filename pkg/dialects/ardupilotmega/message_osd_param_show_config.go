@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Read a configured an OSD parameter slot.
 type MessageOsdParamShowConfig struct {
 	// System ID.
@@ -20,3 +26,39 @@ type MessageOsdParamShowConfig struct {
 func (*MessageOsdParamShowConfig) GetID() uint32 {
 	return 11035
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOsdParamShowConfig) MAVLinkCRCExtra() byte {
+	return 128
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOsdParamShowConfig) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamShowConfig) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = m.OsdScreen
+	buf[7] = m.OsdIndex
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamShowConfig) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.OsdScreen = buf[6]
+	m.OsdIndex = buf[7]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOsdParamShowConfig) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11035]
+}
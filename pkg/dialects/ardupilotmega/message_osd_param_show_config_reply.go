@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Read configured OSD parameter reply.
 type MessageOsdParamShowConfigReply struct {
 	// Request ID - copied from request.
@@ -24,3 +32,47 @@ type MessageOsdParamShowConfigReply struct {
 func (*MessageOsdParamShowConfigReply) GetID() uint32 {
 	return 11036
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOsdParamShowConfigReply) MAVLinkCRCExtra() byte {
+	return 177
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOsdParamShowConfigReply) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 34
+	}
+	return 34
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamShowConfigReply) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RequestId)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.MinValue))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.MaxValue))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Increment))
+	buf[16] = byte(m.Result)
+	copy(buf[17:33], m.ParamId)
+	buf[33] = byte(m.ConfigType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOsdParamShowConfigReply) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = binary.LittleEndian.Uint32(buf[0:])
+	m.MinValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.MaxValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Increment = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Result = OSD_PARAM_CONFIG_ERROR(buf[16])
+	if n := bytes.IndexByte(buf[17:33], 0); n >= 0 {
+		m.ParamId = string(buf[17 : 17+n])
+	} else {
+		m.ParamId = string(buf[17:33])
+	}
+	m.ConfigType = OSD_PARAM_CONFIG_TYPE(buf[33])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOsdParamShowConfigReply) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11036]
+}
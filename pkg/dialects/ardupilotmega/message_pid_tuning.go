@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // PID tuning information.
 type MessagePidTuning struct {
 	// Axis.
@@ -28,3 +35,53 @@ type MessagePidTuning struct {
 func (*MessagePidTuning) GetID() uint32 {
 	return 194
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessagePidTuning) MAVLinkCRCExtra() byte {
+	return 98
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessagePidTuning) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 33
+	}
+	return 25
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePidTuning) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Desired))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Achieved))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Ff))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.P))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.I))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.D))
+	buf[24] = byte(m.Axis)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[25:], math.Float32bits(m.Srate))
+	binary.LittleEndian.PutUint32(buf[29:], math.Float32bits(m.Pdmod))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePidTuning) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Desired = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Achieved = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Ff = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.P = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.I = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.D = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Axis = PID_TUNING_AXIS(buf[24])
+	if !isV2 {
+		return
+	}
+	m.Srate = math.Float32frombits(binary.LittleEndian.Uint32(buf[25:]))
+	m.Pdmod = math.Float32frombits(binary.LittleEndian.Uint32(buf[29:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessagePidTuning) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[194]
+}
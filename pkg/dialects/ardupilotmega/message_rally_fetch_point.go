@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a current rally point from MAV. MAV should respond with a RALLY_POINT message. MAV should not respond if the request is invalid.
 type MessageRallyFetchPoint struct {
 	// System ID.
@@ -16,3 +20,35 @@ type MessageRallyFetchPoint struct {
 func (*MessageRallyFetchPoint) GetID() uint32 {
 	return 176
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRallyFetchPoint) MAVLinkCRCExtra() byte {
+	return 234
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRallyFetchPoint) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRallyFetchPoint) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = m.Idx
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRallyFetchPoint) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.Idx = buf[2]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRallyFetchPoint) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[176]
+}
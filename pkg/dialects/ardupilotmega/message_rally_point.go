@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A rally point. Used to set a point when from GCS -> MAV. Also used to return a point from MAV -> GCS.
 type MessageRallyPoint struct {
 	// System ID.
@@ -30,3 +36,49 @@ type MessageRallyPoint struct {
 func (*MessageRallyPoint) GetID() uint32 {
 	return 175
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRallyPoint) MAVLinkCRCExtra() byte {
+	return 138
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRallyPoint) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 19
+	}
+	return 19
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRallyPoint) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lng))
+	binary.LittleEndian.PutUint16(buf[8:], uint16(m.Alt))
+	binary.LittleEndian.PutUint16(buf[10:], uint16(m.BreakAlt))
+	binary.LittleEndian.PutUint16(buf[12:], m.LandDir)
+	buf[14] = m.TargetSystem
+	buf[15] = m.TargetComponent
+	buf[16] = m.Idx
+	buf[17] = m.Count
+	buf[18] = byte(m.Flags)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRallyPoint) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Alt = int16(binary.LittleEndian.Uint16(buf[8:]))
+	m.BreakAlt = int16(binary.LittleEndian.Uint16(buf[10:]))
+	m.LandDir = binary.LittleEndian.Uint16(buf[12:])
+	m.TargetSystem = buf[14]
+	m.TargetComponent = buf[15]
+	m.Idx = buf[16]
+	m.Count = buf[17]
+	m.Flags = RALLY_FLAGS(buf[18])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRallyPoint) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[175]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Rangefinder reporting.
 type MessageRangefinder struct {
 	// Distance.
@@ -14,3 +21,33 @@ type MessageRangefinder struct {
 func (*MessageRangefinder) GetID() uint32 {
 	return 173
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRangefinder) MAVLinkCRCExtra() byte {
+	return 83
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRangefinder) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRangefinder) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Distance))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Voltage))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRangefinder) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Distance = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Voltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRangefinder) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[173]
+}
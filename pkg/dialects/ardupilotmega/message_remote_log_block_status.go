@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send Status of each log block that autopilot board might have sent.
 type MessageRemoteLogBlockStatus struct {
 	// System ID.
@@ -18,3 +24,37 @@ type MessageRemoteLogBlockStatus struct {
 func (*MessageRemoteLogBlockStatus) GetID() uint32 {
 	return 185
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRemoteLogBlockStatus) MAVLinkCRCExtra() byte {
+	return 186
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRemoteLogBlockStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 7
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRemoteLogBlockStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Seqno)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = byte(m.Status)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRemoteLogBlockStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seqno = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.Status = MAV_REMOTE_LOG_DATA_BLOCK_STATUSES(buf[6])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRemoteLogBlockStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[185]
+}
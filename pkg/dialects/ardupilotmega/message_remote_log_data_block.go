@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a block of log data to remote location.
 type MessageRemoteLogDataBlock struct {
 	// System ID.
@@ -18,3 +24,41 @@ type MessageRemoteLogDataBlock struct {
 func (*MessageRemoteLogDataBlock) GetID() uint32 {
 	return 184
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRemoteLogDataBlock) MAVLinkCRCExtra() byte {
+	return 159
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRemoteLogDataBlock) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 206
+	}
+	return 206
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRemoteLogDataBlock) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Seqno))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	for i, v := range m.Data {
+		buf[6+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRemoteLogDataBlock) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seqno = MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	for i := range m.Data {
+		m.Data[i] = buf[6+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRemoteLogDataBlock) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[184]
+}
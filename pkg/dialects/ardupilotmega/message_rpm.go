@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // RPM sensor output.
 type MessageRpm struct {
 	// RPM Sensor1.
@@ -14,3 +21,33 @@ type MessageRpm struct {
 func (*MessageRpm) GetID() uint32 {
 	return 226
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRpm) MAVLinkCRCExtra() byte {
+	return 207
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRpm) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRpm) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Rpm1))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Rpm2))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRpm) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Rpm1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Rpm2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRpm) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[226]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a secure command. Data should be signed with a private key corresponding with a public key known to the recipient. Signature should be over the concatenation of the sequence number (little-endian format), the operation (little-endian format) the data and the session key. For SECURE_COMMAND_GET_SESSION_KEY the session key should be zero length. The data array consists of the data followed by the signature. The sum of the data_length and the sig_length cannot be more than 220. The format of the data is command specific.
 type MessageSecureCommand struct {
 	// System ID.
@@ -24,3 +30,47 @@ type MessageSecureCommand struct {
 func (*MessageSecureCommand) GetID() uint32 {
 	return 11004
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSecureCommand) MAVLinkCRCExtra() byte {
+	return 11
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSecureCommand) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 232
+	}
+	return 232
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSecureCommand) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Sequence)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Operation))
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+	buf[10] = m.DataLength
+	buf[11] = m.SigLength
+	for i, v := range m.Data {
+		buf[12+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSecureCommand) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint32(buf[0:])
+	m.Operation = SECURE_COMMAND_OP(binary.LittleEndian.Uint32(buf[4:]))
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+	m.DataLength = buf[10]
+	m.SigLength = buf[11]
+	for i := range m.Data {
+		m.Data[i] = buf[12+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSecureCommand) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11004]
+}
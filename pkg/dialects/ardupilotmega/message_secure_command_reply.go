@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reply from secure command.
 type MessageSecureCommandReply struct {
 	// Sequence ID from request.
@@ -20,3 +26,43 @@ type MessageSecureCommandReply struct {
 func (*MessageSecureCommandReply) GetID() uint32 {
 	return 11005
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSecureCommandReply) MAVLinkCRCExtra() byte {
+	return 93
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSecureCommandReply) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 230
+	}
+	return 230
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSecureCommandReply) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Sequence)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Operation))
+	buf[8] = byte(m.Result)
+	buf[9] = m.DataLength
+	for i, v := range m.Data {
+		buf[10+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSecureCommandReply) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint32(buf[0:])
+	m.Operation = SECURE_COMMAND_OP(binary.LittleEndian.Uint32(buf[4:]))
+	m.Result = MAV_RESULT(buf[8])
+	m.DataLength = buf[9]
+	for i := range m.Data {
+		m.Data[i] = buf[10+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSecureCommandReply) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11005]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Offsets and calibrations values for hardware sensors. This makes it easier to debug the calibration process.
 type MessageSensorOffsets struct {
 	// Magnetometer X offset.
@@ -34,3 +41,53 @@ type MessageSensorOffsets struct {
 func (*MessageSensorOffsets) GetID() uint32 {
 	return 150
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensorOffsets) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensorOffsets) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 42
+	}
+	return 42
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorOffsets) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.MagDeclination))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.RawPress))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.RawTemp))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.GyroCalX))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.GyroCalY))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.GyroCalZ))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.AccelCalX))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.AccelCalY))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.AccelCalZ))
+	binary.LittleEndian.PutUint16(buf[36:], uint16(m.MagOfsX))
+	binary.LittleEndian.PutUint16(buf[38:], uint16(m.MagOfsY))
+	binary.LittleEndian.PutUint16(buf[40:], uint16(m.MagOfsZ))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorOffsets) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MagDeclination = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.RawPress = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.RawTemp = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.GyroCalX = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.GyroCalY = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.GyroCalZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.AccelCalX = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.AccelCalY = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.AccelCalZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.MagOfsX = int16(binary.LittleEndian.Uint16(buf[36:]))
+	m.MagOfsY = int16(binary.LittleEndian.Uint16(buf[38:]))
+	m.MagOfsZ = int16(binary.LittleEndian.Uint16(buf[40:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensorOffsets) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[150]
+}
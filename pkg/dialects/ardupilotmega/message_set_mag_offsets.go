@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set the magnetometer offsets
 type MessageSetMagOffsets struct {
 	// System ID.
@@ -20,3 +26,39 @@ type MessageSetMagOffsets struct {
 func (*MessageSetMagOffsets) GetID() uint32 {
 	return 151
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSetMagOffsets) MAVLinkCRCExtra() byte {
+	return 219
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSetMagOffsets) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetMagOffsets) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.MagOfsX))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.MagOfsY))
+	binary.LittleEndian.PutUint16(buf[4:], uint16(m.MagOfsZ))
+	buf[6] = m.TargetSystem
+	buf[7] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetMagOffsets) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MagOfsX = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.MagOfsY = int16(binary.LittleEndian.Uint16(buf[2:]))
+	m.MagOfsZ = int16(binary.LittleEndian.Uint16(buf[4:]))
+	m.TargetSystem = buf[6]
+	m.TargetComponent = buf[7]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSetMagOffsets) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[151]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of simulation environment, if used.
 type MessageSimstate struct {
 	// Roll angle.
@@ -32,3 +39,51 @@ type MessageSimstate struct {
 func (*MessageSimstate) GetID() uint32 {
 	return 164
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSimstate) MAVLinkCRCExtra() byte {
+	return 154
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSimstate) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 44
+	}
+	return 44
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSimstate) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Xacc))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Yacc))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Zacc))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Xgyro))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Ygyro))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Zgyro))
+	binary.LittleEndian.PutUint32(buf[36:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[40:], uint32(m.Lng))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSimstate) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[36:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[40:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSimstate) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[164]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera vision based attitude and position deltas.
 type MessageVisionPositionDelta struct {
 	// Timestamp (synced to UNIX time or since system boot).
@@ -20,3 +27,47 @@ type MessageVisionPositionDelta struct {
 func (*MessageVisionPositionDelta) GetID() uint32 {
 	return 11011
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVisionPositionDelta) MAVLinkCRCExtra() byte {
+	return 106
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVisionPositionDelta) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 44
+	}
+	return 44
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionPositionDelta) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint64(buf[8:], m.TimeDeltaUsec)
+	for i, v := range m.AngleDelta {
+		binary.LittleEndian.PutUint32(buf[16+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.PositionDelta {
+		binary.LittleEndian.PutUint32(buf[28+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.Confidence))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionPositionDelta) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.TimeDeltaUsec = binary.LittleEndian.Uint64(buf[8:])
+	for i := range m.AngleDelta {
+		m.AngleDelta[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[16+i*4:]))
+	}
+	for i := range m.PositionDelta {
+		m.PositionDelta[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[28+i*4:]))
+	}
+	m.Confidence = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVisionPositionDelta) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11011]
+}
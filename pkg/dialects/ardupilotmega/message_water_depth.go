@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Water depth
 type MessageWaterDepth struct {
 	// Timestamp (time since system boot)
@@ -32,3 +39,51 @@ type MessageWaterDepth struct {
 func (*MessageWaterDepth) GetID() uint32 {
 	return 11038
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageWaterDepth) MAVLinkCRCExtra() byte {
+	return 47
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageWaterDepth) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 38
+	}
+	return 38
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWaterDepth) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lng))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Alt))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Distance))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Temperature))
+	buf[36] = m.Id
+	buf[37] = m.Healthy
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWaterDepth) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Lng = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Alt = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Distance = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Id = buf[36]
+	m.Healthy = buf[37]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageWaterDepth) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11038]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package ardupilotmega
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Wind estimation.
 type MessageWind struct {
 	// Wind direction (that wind is coming from).
@@ -16,3 +23,35 @@ type MessageWind struct {
 func (*MessageWind) GetID() uint32 {
 	return 168
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageWind) MAVLinkCRCExtra() byte {
+	return 1
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageWind) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWind) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Direction))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Speed))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.SpeedZ))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWind) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Direction = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Speed = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.SpeedZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageWind) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[168]
+}
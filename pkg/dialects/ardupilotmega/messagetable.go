@@ -0,0 +1,338 @@
+//autogenerated:yes
+//nolint:revive
+package ardupilotmega
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// MessageTable contains the CRC extra, the length and the target offsets
+// of all messages of the dialect. It can be used to validate and route frames
+// without decoding them.
+var MessageTable = dialect.MessageTable{
+	0:     {CRCExtra: 50, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	1:     {CRCExtra: 124, MinLength: 31, MaxLength: 43, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	2:     {CRCExtra: 137, MinLength: 12, MaxLength: 12, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	4:     {CRCExtra: 237, MinLength: 14, MaxLength: 14, TargetSystemOffset: 12, TargetComponentOffset: 13},
+	5:     {CRCExtra: 217, MinLength: 28, MaxLength: 28, TargetSystemOffset: 0, TargetComponentOffset: -1},
+	6:     {CRCExtra: 104, MinLength: 3, MaxLength: 3, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	7:     {CRCExtra: 119, MinLength: 32, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	8:     {CRCExtra: 117, MinLength: 36, MaxLength: 36, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11:    {CRCExtra: 89, MinLength: 6, MaxLength: 6, TargetSystemOffset: 4, TargetComponentOffset: -1},
+	20:    {CRCExtra: 214, MinLength: 20, MaxLength: 20, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	21:    {CRCExtra: 159, MinLength: 2, MaxLength: 2, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	22:    {CRCExtra: 220, MinLength: 25, MaxLength: 25, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	23:    {CRCExtra: 168, MinLength: 23, MaxLength: 23, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	24:    {CRCExtra: 24, MinLength: 30, MaxLength: 52, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	25:    {CRCExtra: 23, MinLength: 101, MaxLength: 101, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	26:    {CRCExtra: 170, MinLength: 22, MaxLength: 24, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	27:    {CRCExtra: 144, MinLength: 26, MaxLength: 29, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	28:    {CRCExtra: 67, MinLength: 16, MaxLength: 16, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	29:    {CRCExtra: 115, MinLength: 14, MaxLength: 16, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	30:    {CRCExtra: 39, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	31:    {CRCExtra: 246, MinLength: 32, MaxLength: 48, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	32:    {CRCExtra: 185, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	33:    {CRCExtra: 104, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	34:    {CRCExtra: 237, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	35:    {CRCExtra: 244, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	36:    {CRCExtra: 222, MinLength: 21, MaxLength: 37, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	37:    {CRCExtra: 212, MinLength: 6, MaxLength: 7, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	38:    {CRCExtra: 9, MinLength: 6, MaxLength: 7, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	39:    {CRCExtra: 254, MinLength: 37, MaxLength: 38, TargetSystemOffset: 32, TargetComponentOffset: 33},
+	40:    {CRCExtra: 230, MinLength: 4, MaxLength: 5, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	41:    {CRCExtra: 28, MinLength: 4, MaxLength: 4, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	42:    {CRCExtra: 28, MinLength: 2, MaxLength: 18, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	43:    {CRCExtra: 132, MinLength: 2, MaxLength: 3, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	44:    {CRCExtra: 221, MinLength: 4, MaxLength: 9, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	45:    {CRCExtra: 232, MinLength: 2, MaxLength: 3, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	46:    {CRCExtra: 11, MinLength: 2, MaxLength: 2, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	47:    {CRCExtra: 153, MinLength: 3, MaxLength: 8, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	48:    {CRCExtra: 41, MinLength: 13, MaxLength: 21, TargetSystemOffset: 12, TargetComponentOffset: -1},
+	49:    {CRCExtra: 39, MinLength: 12, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	50:    {CRCExtra: 78, MinLength: 37, MaxLength: 37, TargetSystemOffset: 18, TargetComponentOffset: 19},
+	51:    {CRCExtra: 196, MinLength: 4, MaxLength: 5, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	54:    {CRCExtra: 15, MinLength: 27, MaxLength: 27, TargetSystemOffset: 24, TargetComponentOffset: 25},
+	55:    {CRCExtra: 3, MinLength: 25, MaxLength: 25, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	61:    {CRCExtra: 167, MinLength: 72, MaxLength: 72, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	62:    {CRCExtra: 183, MinLength: 26, MaxLength: 26, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	63:    {CRCExtra: 119, MinLength: 181, MaxLength: 181, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	64:    {CRCExtra: 191, MinLength: 225, MaxLength: 225, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	65:    {CRCExtra: 118, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	66:    {CRCExtra: 148, MinLength: 6, MaxLength: 6, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	67:    {CRCExtra: 21, MinLength: 4, MaxLength: 4, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	69:    {CRCExtra: 243, MinLength: 11, MaxLength: 30, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	70:    {CRCExtra: 124, MinLength: 18, MaxLength: 38, TargetSystemOffset: 16, TargetComponentOffset: 17},
+	73:    {CRCExtra: 38, MinLength: 37, MaxLength: 38, TargetSystemOffset: 32, TargetComponentOffset: 33},
+	74:    {CRCExtra: 20, MinLength: 20, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	75:    {CRCExtra: 158, MinLength: 35, MaxLength: 35, TargetSystemOffset: 30, TargetComponentOffset: 31},
+	76:    {CRCExtra: 152, MinLength: 33, MaxLength: 33, TargetSystemOffset: 30, TargetComponentOffset: 31},
+	77:    {CRCExtra: 143, MinLength: 3, MaxLength: 10, TargetSystemOffset: 8, TargetComponentOffset: 9},
+	80:    {CRCExtra: 14, MinLength: 4, MaxLength: 4, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	81:    {CRCExtra: 106, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	82:    {CRCExtra: 49, MinLength: 39, MaxLength: 51, TargetSystemOffset: 36, TargetComponentOffset: 37},
+	83:    {CRCExtra: 22, MinLength: 37, MaxLength: 37, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	84:    {CRCExtra: 143, MinLength: 53, MaxLength: 53, TargetSystemOffset: 50, TargetComponentOffset: 51},
+	85:    {CRCExtra: 140, MinLength: 51, MaxLength: 51, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	86:    {CRCExtra: 5, MinLength: 53, MaxLength: 53, TargetSystemOffset: 50, TargetComponentOffset: 51},
+	87:    {CRCExtra: 150, MinLength: 51, MaxLength: 51, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	89:    {CRCExtra: 231, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	90:    {CRCExtra: 183, MinLength: 56, MaxLength: 56, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	91:    {CRCExtra: 63, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	92:    {CRCExtra: 54, MinLength: 33, MaxLength: 33, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	93:    {CRCExtra: 47, MinLength: 81, MaxLength: 81, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	100:   {CRCExtra: 175, MinLength: 26, MaxLength: 34, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	101:   {CRCExtra: 102, MinLength: 32, MaxLength: 117, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	102:   {CRCExtra: 158, MinLength: 32, MaxLength: 117, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	103:   {CRCExtra: 208, MinLength: 20, MaxLength: 57, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	104:   {CRCExtra: 56, MinLength: 32, MaxLength: 116, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	105:   {CRCExtra: 93, MinLength: 62, MaxLength: 63, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	106:   {CRCExtra: 138, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	107:   {CRCExtra: 108, MinLength: 64, MaxLength: 65, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	108:   {CRCExtra: 32, MinLength: 84, MaxLength: 92, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	109:   {CRCExtra: 185, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	110:   {CRCExtra: 84, MinLength: 254, MaxLength: 254, TargetSystemOffset: 1, TargetComponentOffset: 2},
+	111:   {CRCExtra: 34, MinLength: 16, MaxLength: 18, TargetSystemOffset: 16, TargetComponentOffset: 17},
+	112:   {CRCExtra: 174, MinLength: 12, MaxLength: 12, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	113:   {CRCExtra: 124, MinLength: 36, MaxLength: 39, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	114:   {CRCExtra: 237, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	115:   {CRCExtra: 4, MinLength: 64, MaxLength: 64, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	116:   {CRCExtra: 76, MinLength: 22, MaxLength: 24, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	117:   {CRCExtra: 128, MinLength: 6, MaxLength: 6, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	118:   {CRCExtra: 56, MinLength: 14, MaxLength: 14, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	119:   {CRCExtra: 116, MinLength: 12, MaxLength: 12, TargetSystemOffset: 10, TargetComponentOffset: 11},
+	120:   {CRCExtra: 134, MinLength: 97, MaxLength: 97, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	121:   {CRCExtra: 237, MinLength: 2, MaxLength: 2, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	122:   {CRCExtra: 203, MinLength: 2, MaxLength: 2, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	123:   {CRCExtra: 250, MinLength: 113, MaxLength: 113, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	124:   {CRCExtra: 87, MinLength: 35, MaxLength: 57, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	125:   {CRCExtra: 203, MinLength: 6, MaxLength: 6, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	126:   {CRCExtra: 220, MinLength: 79, MaxLength: 81, TargetSystemOffset: 79, TargetComponentOffset: 80},
+	127:   {CRCExtra: 25, MinLength: 35, MaxLength: 35, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	128:   {CRCExtra: 226, MinLength: 35, MaxLength: 35, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	129:   {CRCExtra: 46, MinLength: 22, MaxLength: 24, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	130:   {CRCExtra: 29, MinLength: 13, MaxLength: 13, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	131:   {CRCExtra: 223, MinLength: 255, MaxLength: 255, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	132:   {CRCExtra: 85, MinLength: 14, MaxLength: 39, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	133:   {CRCExtra: 6, MinLength: 18, MaxLength: 18, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	134:   {CRCExtra: 229, MinLength: 43, MaxLength: 43, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	135:   {CRCExtra: 203, MinLength: 8, MaxLength: 8, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	136:   {CRCExtra: 1, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	137:   {CRCExtra: 195, MinLength: 14, MaxLength: 16, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	138:   {CRCExtra: 109, MinLength: 36, MaxLength: 120, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	139:   {CRCExtra: 168, MinLength: 43, MaxLength: 43, TargetSystemOffset: 41, TargetComponentOffset: 42},
+	140:   {CRCExtra: 181, MinLength: 41, MaxLength: 41, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	141:   {CRCExtra: 47, MinLength: 32, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	142:   {CRCExtra: 72, MinLength: 243, MaxLength: 243, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	143:   {CRCExtra: 131, MinLength: 14, MaxLength: 16, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	144:   {CRCExtra: 127, MinLength: 93, MaxLength: 93, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	146:   {CRCExtra: 103, MinLength: 100, MaxLength: 100, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	147:   {CRCExtra: 154, MinLength: 36, MaxLength: 54, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	148:   {CRCExtra: 178, MinLength: 60, MaxLength: 78, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	149:   {CRCExtra: 200, MinLength: 30, MaxLength: 60, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	150:   {CRCExtra: 134, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	151:   {CRCExtra: 219, MinLength: 8, MaxLength: 8, TargetSystemOffset: 6, TargetComponentOffset: 7},
+	152:   {CRCExtra: 208, MinLength: 4, MaxLength: 8, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	153:   {CRCExtra: 188, MinLength: 12, MaxLength: 12, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	154:   {CRCExtra: 84, MinLength: 15, MaxLength: 15, TargetSystemOffset: 6, TargetComponentOffset: 7},
+	155:   {CRCExtra: 22, MinLength: 13, MaxLength: 13, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	156:   {CRCExtra: 19, MinLength: 6, MaxLength: 6, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	157:   {CRCExtra: 21, MinLength: 15, MaxLength: 15, TargetSystemOffset: 12, TargetComponentOffset: 13},
+	158:   {CRCExtra: 134, MinLength: 14, MaxLength: 15, TargetSystemOffset: 12, TargetComponentOffset: 13},
+	160:   {CRCExtra: 78, MinLength: 12, MaxLength: 12, TargetSystemOffset: 8, TargetComponentOffset: 9},
+	161:   {CRCExtra: 68, MinLength: 3, MaxLength: 3, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	162:   {CRCExtra: 189, MinLength: 8, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	163:   {CRCExtra: 127, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	164:   {CRCExtra: 154, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	165:   {CRCExtra: 21, MinLength: 3, MaxLength: 3, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	166:   {CRCExtra: 21, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	167:   {CRCExtra: 144, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	168:   {CRCExtra: 1, MinLength: 12, MaxLength: 12, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	169:   {CRCExtra: 234, MinLength: 18, MaxLength: 18, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	170:   {CRCExtra: 73, MinLength: 34, MaxLength: 34, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	171:   {CRCExtra: 181, MinLength: 66, MaxLength: 66, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	172:   {CRCExtra: 22, MinLength: 98, MaxLength: 98, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	173:   {CRCExtra: 83, MinLength: 8, MaxLength: 8, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	174:   {CRCExtra: 167, MinLength: 48, MaxLength: 48, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	175:   {CRCExtra: 138, MinLength: 19, MaxLength: 19, TargetSystemOffset: 14, TargetComponentOffset: 15},
+	176:   {CRCExtra: 234, MinLength: 3, MaxLength: 3, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	177:   {CRCExtra: 240, MinLength: 20, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	178:   {CRCExtra: 47, MinLength: 24, MaxLength: 24, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	179:   {CRCExtra: 189, MinLength: 29, MaxLength: 29, TargetSystemOffset: 26, TargetComponentOffset: -1},
+	180:   {CRCExtra: 52, MinLength: 45, MaxLength: 47, TargetSystemOffset: 42, TargetComponentOffset: -1},
+	181:   {CRCExtra: 174, MinLength: 4, MaxLength: 4, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	182:   {CRCExtra: 229, MinLength: 40, MaxLength: 40, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	183:   {CRCExtra: 85, MinLength: 2, MaxLength: 2, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	184:   {CRCExtra: 159, MinLength: 206, MaxLength: 206, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	185:   {CRCExtra: 186, MinLength: 7, MaxLength: 7, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	186:   {CRCExtra: 72, MinLength: 29, MaxLength: 29, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	191:   {CRCExtra: 92, MinLength: 27, MaxLength: 27, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	192:   {CRCExtra: 36, MinLength: 44, MaxLength: 54, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	193:   {CRCExtra: 71, MinLength: 22, MaxLength: 26, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	194:   {CRCExtra: 98, MinLength: 25, MaxLength: 33, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	195:   {CRCExtra: 120, MinLength: 37, MaxLength: 37, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	200:   {CRCExtra: 134, MinLength: 42, MaxLength: 42, TargetSystemOffset: 40, TargetComponentOffset: 41},
+	201:   {CRCExtra: 205, MinLength: 14, MaxLength: 14, TargetSystemOffset: 12, TargetComponentOffset: 13},
+	214:   {CRCExtra: 69, MinLength: 8, MaxLength: 8, TargetSystemOffset: 6, TargetComponentOffset: 7},
+	215:   {CRCExtra: 101, MinLength: 3, MaxLength: 3, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	216:   {CRCExtra: 50, MinLength: 3, MaxLength: 3, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	217:   {CRCExtra: 202, MinLength: 6, MaxLength: 6, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	218:   {CRCExtra: 17, MinLength: 7, MaxLength: 7, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	219:   {CRCExtra: 162, MinLength: 2, MaxLength: 2, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	225:   {CRCExtra: 208, MinLength: 65, MaxLength: 73, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	226:   {CRCExtra: 207, MinLength: 8, MaxLength: 8, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	230:   {CRCExtra: 163, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	231:   {CRCExtra: 105, MinLength: 40, MaxLength: 40, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	232:   {CRCExtra: 151, MinLength: 63, MaxLength: 65, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	233:   {CRCExtra: 35, MinLength: 182, MaxLength: 182, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	234:   {CRCExtra: 150, MinLength: 40, MaxLength: 40, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	235:   {CRCExtra: 179, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	241:   {CRCExtra: 90, MinLength: 32, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	242:   {CRCExtra: 104, MinLength: 52, MaxLength: 60, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	243:   {CRCExtra: 85, MinLength: 53, MaxLength: 61, TargetSystemOffset: 52, TargetComponentOffset: -1},
+	244:   {CRCExtra: 95, MinLength: 6, MaxLength: 6, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	245:   {CRCExtra: 130, MinLength: 2, MaxLength: 2, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	246:   {CRCExtra: 184, MinLength: 38, MaxLength: 38, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	247:   {CRCExtra: 81, MinLength: 19, MaxLength: 19, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	248:   {CRCExtra: 8, MinLength: 254, MaxLength: 254, TargetSystemOffset: 3, TargetComponentOffset: 4},
+	249:   {CRCExtra: 204, MinLength: 36, MaxLength: 36, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	250:   {CRCExtra: 49, MinLength: 30, MaxLength: 30, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	251:   {CRCExtra: 170, MinLength: 18, MaxLength: 18, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	252:   {CRCExtra: 44, MinLength: 18, MaxLength: 18, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	253:   {CRCExtra: 83, MinLength: 51, MaxLength: 54, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	254:   {CRCExtra: 46, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	256:   {CRCExtra: 71, MinLength: 42, MaxLength: 42, TargetSystemOffset: 8, TargetComponentOffset: 9},
+	257:   {CRCExtra: 131, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	258:   {CRCExtra: 187, MinLength: 32, MaxLength: 232, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	259:   {CRCExtra: 92, MinLength: 235, MaxLength: 237, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	260:   {CRCExtra: 146, MinLength: 5, MaxLength: 14, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	261:   {CRCExtra: 179, MinLength: 27, MaxLength: 61, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	262:   {CRCExtra: 12, MinLength: 18, MaxLength: 23, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	263:   {CRCExtra: 133, MinLength: 255, MaxLength: 255, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	264:   {CRCExtra: 49, MinLength: 28, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	265:   {CRCExtra: 26, MinLength: 16, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	266:   {CRCExtra: 193, MinLength: 255, MaxLength: 255, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	267:   {CRCExtra: 35, MinLength: 255, MaxLength: 255, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	268:   {CRCExtra: 14, MinLength: 4, MaxLength: 4, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	269:   {CRCExtra: 109, MinLength: 213, MaxLength: 215, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	270:   {CRCExtra: 59, MinLength: 19, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	271:   {CRCExtra: 22, MinLength: 52, MaxLength: 53, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	275:   {CRCExtra: 126, MinLength: 31, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	276:   {CRCExtra: 18, MinLength: 49, MaxLength: 50, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	277:   {CRCExtra: 62, MinLength: 30, MaxLength: 30, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	280:   {CRCExtra: 70, MinLength: 33, MaxLength: 33, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	281:   {CRCExtra: 48, MinLength: 13, MaxLength: 13, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	282:   {CRCExtra: 123, MinLength: 35, MaxLength: 35, TargetSystemOffset: 32, TargetComponentOffset: 33},
+	283:   {CRCExtra: 74, MinLength: 144, MaxLength: 149, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	284:   {CRCExtra: 99, MinLength: 32, MaxLength: 32, TargetSystemOffset: 30, TargetComponentOffset: 31},
+	285:   {CRCExtra: 137, MinLength: 40, MaxLength: 49, TargetSystemOffset: 38, TargetComponentOffset: 39},
+	286:   {CRCExtra: 210, MinLength: 53, MaxLength: 57, TargetSystemOffset: 50, TargetComponentOffset: 51},
+	287:   {CRCExtra: 1, MinLength: 23, MaxLength: 23, TargetSystemOffset: 20, TargetComponentOffset: 21},
+	288:   {CRCExtra: 20, MinLength: 23, MaxLength: 23, TargetSystemOffset: 20, TargetComponentOffset: 21},
+	290:   {CRCExtra: 251, MinLength: 46, MaxLength: 46, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	291:   {CRCExtra: 10, MinLength: 57, MaxLength: 57, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	295:   {CRCExtra: 234, MinLength: 12, MaxLength: 12, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	296:   {CRCExtra: 158, MinLength: 41, MaxLength: 41, TargetSystemOffset: 36, TargetComponentOffset: 37},
+	299:   {CRCExtra: 19, MinLength: 96, MaxLength: 98, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	300:   {CRCExtra: 217, MinLength: 22, MaxLength: 22, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	301:   {CRCExtra: 243, MinLength: 58, MaxLength: 58, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	310:   {CRCExtra: 28, MinLength: 17, MaxLength: 17, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	311:   {CRCExtra: 95, MinLength: 116, MaxLength: 116, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	320:   {CRCExtra: 243, MinLength: 20, MaxLength: 20, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	321:   {CRCExtra: 88, MinLength: 2, MaxLength: 2, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	322:   {CRCExtra: 243, MinLength: 149, MaxLength: 149, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	323:   {CRCExtra: 78, MinLength: 147, MaxLength: 147, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	324:   {CRCExtra: 132, MinLength: 146, MaxLength: 146, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	330:   {CRCExtra: 23, MinLength: 158, MaxLength: 167, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	331:   {CRCExtra: 91, MinLength: 230, MaxLength: 233, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	332:   {CRCExtra: 236, MinLength: 239, MaxLength: 239, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	333:   {CRCExtra: 231, MinLength: 109, MaxLength: 109, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	334:   {CRCExtra: 72, MinLength: 10, MaxLength: 53, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	335:   {CRCExtra: 225, MinLength: 24, MaxLength: 24, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	336:   {CRCExtra: 245, MinLength: 84, MaxLength: 84, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	339:   {CRCExtra: 199, MinLength: 5, MaxLength: 5, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	340:   {CRCExtra: 99, MinLength: 70, MaxLength: 70, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	345:   {CRCExtra: 209, MinLength: 21, MaxLength: 21, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	350:   {CRCExtra: 232, MinLength: 20, MaxLength: 252, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	360:   {CRCExtra: 11, MinLength: 25, MaxLength: 25, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	361:   {CRCExtra: 93, MinLength: 33, MaxLength: 33, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	370:   {CRCExtra: 75, MinLength: 87, MaxLength: 109, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	371:   {CRCExtra: 10, MinLength: 26, MaxLength: 26, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	372:   {CRCExtra: 26, MinLength: 140, MaxLength: 140, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	373:   {CRCExtra: 117, MinLength: 42, MaxLength: 42, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	375:   {CRCExtra: 251, MinLength: 140, MaxLength: 140, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	376:   {CRCExtra: 199, MinLength: 8, MaxLength: 8, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	380:   {CRCExtra: 232, MinLength: 20, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	385:   {CRCExtra: 147, MinLength: 133, MaxLength: 133, TargetSystemOffset: 2, TargetComponentOffset: 3},
+	386:   {CRCExtra: 132, MinLength: 16, MaxLength: 16, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	387:   {CRCExtra: 4, MinLength: 72, MaxLength: 72, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	388:   {CRCExtra: 8, MinLength: 37, MaxLength: 37, TargetSystemOffset: 32, TargetComponentOffset: 33},
+	390:   {CRCExtra: 156, MinLength: 238, MaxLength: 240, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	395:   {CRCExtra: 0, MinLength: 212, MaxLength: 212, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	396:   {CRCExtra: 50, MinLength: 160, MaxLength: 160, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	397:   {CRCExtra: 182, MinLength: 108, MaxLength: 108, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	400:   {CRCExtra: 110, MinLength: 254, MaxLength: 254, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	401:   {CRCExtra: 183, MinLength: 6, MaxLength: 6, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	410:   {CRCExtra: 160, MinLength: 53, MaxLength: 53, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	411:   {CRCExtra: 106, MinLength: 3, MaxLength: 3, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	412:   {CRCExtra: 33, MinLength: 6, MaxLength: 6, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	413:   {CRCExtra: 77, MinLength: 7, MaxLength: 7, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	435:   {CRCExtra: 134, MinLength: 46, MaxLength: 47, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	436:   {CRCExtra: 193, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	437:   {CRCExtra: 30, MinLength: 1, MaxLength: 1, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	440:   {CRCExtra: 66, MinLength: 35, MaxLength: 35, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	9000:  {CRCExtra: 113, MinLength: 137, MaxLength: 137, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	9005:  {CRCExtra: 117, MinLength: 34, MaxLength: 34, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10001: {CRCExtra: 209, MinLength: 20, MaxLength: 20, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10002: {CRCExtra: 186, MinLength: 41, MaxLength: 41, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10003: {CRCExtra: 4, MinLength: 1, MaxLength: 1, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10004: {CRCExtra: 133, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10005: {CRCExtra: 103, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10006: {CRCExtra: 193, MinLength: 4, MaxLength: 4, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10007: {CRCExtra: 71, MinLength: 17, MaxLength: 17, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10008: {CRCExtra: 240, MinLength: 14, MaxLength: 14, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10151: {CRCExtra: 195, MinLength: 85, MaxLength: 85, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11000: {CRCExtra: 134, MinLength: 51, MaxLength: 52, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	11001: {CRCExtra: 15, MinLength: 135, MaxLength: 136, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11002: {CRCExtra: 234, MinLength: 179, MaxLength: 180, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	11003: {CRCExtra: 64, MinLength: 5, MaxLength: 5, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11004: {CRCExtra: 11, MinLength: 232, MaxLength: 232, TargetSystemOffset: 8, TargetComponentOffset: 9},
+	11005: {CRCExtra: 93, MinLength: 230, MaxLength: 230, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11010: {CRCExtra: 46, MinLength: 49, MaxLength: 49, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11011: {CRCExtra: 106, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11020: {CRCExtra: 205, MinLength: 16, MaxLength: 16, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11030: {CRCExtra: 144, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11031: {CRCExtra: 133, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11032: {CRCExtra: 85, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11033: {CRCExtra: 195, MinLength: 37, MaxLength: 37, TargetSystemOffset: 16, TargetComponentOffset: 17},
+	11034: {CRCExtra: 79, MinLength: 5, MaxLength: 5, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11035: {CRCExtra: 128, MinLength: 8, MaxLength: 8, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	11036: {CRCExtra: 177, MinLength: 34, MaxLength: 34, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11037: {CRCExtra: 130, MinLength: 28, MaxLength: 28, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11038: {CRCExtra: 47, MinLength: 38, MaxLength: 38, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11039: {CRCExtra: 142, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11040: {CRCExtra: 132, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11041: {CRCExtra: 208, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11042: {CRCExtra: 201, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11043: {CRCExtra: 193, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11044: {CRCExtra: 189, MinLength: 44, MaxLength: 44, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	11060: {CRCExtra: 162, MinLength: 78, MaxLength: 78, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	12900: {CRCExtra: 114, MinLength: 44, MaxLength: 44, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	12901: {CRCExtra: 254, MinLength: 59, MaxLength: 59, TargetSystemOffset: 30, TargetComponentOffset: 31},
+	12902: {CRCExtra: 140, MinLength: 53, MaxLength: 53, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	12903: {CRCExtra: 249, MinLength: 46, MaxLength: 46, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	12904: {CRCExtra: 77, MinLength: 54, MaxLength: 54, TargetSystemOffset: 28, TargetComponentOffset: 29},
+	12905: {CRCExtra: 49, MinLength: 43, MaxLength: 43, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	12915: {CRCExtra: 94, MinLength: 249, MaxLength: 249, TargetSystemOffset: 0, TargetComponentOffset: 1},
+	12918: {CRCExtra: 139, MinLength: 51, MaxLength: 51, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	12919: {CRCExtra: 7, MinLength: 18, MaxLength: 18, TargetSystemOffset: 16, TargetComponentOffset: 17},
+	12920: {CRCExtra: 20, MinLength: 5, MaxLength: 5, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	42000: {CRCExtra: 227, MinLength: 1, MaxLength: 1, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	42001: {CRCExtra: 239, MinLength: 46, MaxLength: 46, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	50001: {CRCExtra: 246, MinLength: 32, MaxLength: 32, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	50002: {CRCExtra: 181, MinLength: 246, MaxLength: 246, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	50003: {CRCExtra: 62, MinLength: 19, MaxLength: 19, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	50004: {CRCExtra: 240, MinLength: 10, MaxLength: 10, TargetSystemOffset: 8, TargetComponentOffset: 9},
+	50005: {CRCExtra: 152, MinLength: 6, MaxLength: 6, TargetSystemOffset: 4, TargetComponentOffset: 5},
+	52000: {CRCExtra: 13, MinLength: 100, MaxLength: 100, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	52001: {CRCExtra: 239, MinLength: 1, MaxLength: 1, TargetSystemOffset: -1, TargetComponentOffset: -1},
+}
@@ -0,0 +1,973 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package ardupilotmega
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	150: {
+		ID:          150,
+		Name:        "SENSOR_OFFSETS",
+		Description: "Offsets and calibrations values for hardware sensors. This makes it easier to debug the calibration process.",
+		Fields: []*message.FieldMetadata{
+			{Name: "mag_ofs_x", Type: "int16_t", Description: "Magnetometer X offset."},
+			{Name: "mag_ofs_y", Type: "int16_t", Description: "Magnetometer Y offset."},
+			{Name: "mag_ofs_z", Type: "int16_t", Description: "Magnetometer Z offset."},
+			{Name: "mag_declination", Type: "float", Description: "Magnetic declination."},
+			{Name: "raw_press", Type: "int32_t", Description: "Raw pressure from barometer."},
+			{Name: "raw_temp", Type: "int32_t", Description: "Raw temperature from barometer."},
+			{Name: "gyro_cal_x", Type: "float", Description: "Gyro X calibration."},
+			{Name: "gyro_cal_y", Type: "float", Description: "Gyro Y calibration."},
+			{Name: "gyro_cal_z", Type: "float", Description: "Gyro Z calibration."},
+			{Name: "accel_cal_x", Type: "float", Description: "Accel X calibration."},
+			{Name: "accel_cal_y", Type: "float", Description: "Accel Y calibration."},
+			{Name: "accel_cal_z", Type: "float", Description: "Accel Z calibration."},
+		},
+	},
+	151: {
+		ID:          151,
+		Name:        "SET_MAG_OFFSETS",
+		Description: "Set the magnetometer offsets",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "mag_ofs_x", Type: "int16_t", Description: "Magnetometer X offset."},
+			{Name: "mag_ofs_y", Type: "int16_t", Description: "Magnetometer Y offset."},
+			{Name: "mag_ofs_z", Type: "int16_t", Description: "Magnetometer Z offset."},
+		},
+	},
+	152: {
+		ID:          152,
+		Name:        "MEMINFO",
+		Description: "State of autopilot RAM.",
+		Fields: []*message.FieldMetadata{
+			{Name: "brkval", Type: "uint16_t", Description: "Heap top."},
+			{Name: "freemem", Type: "uint16_t", Description: "Free memory."},
+			{Name: "freemem32", Type: "uint32_t", Extension: true, Description: "Free memory (32 bit)."},
+		},
+	},
+	153: {
+		ID:          153,
+		Name:        "AP_ADC",
+		Description: "Raw ADC output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "adc1", Type: "uint16_t", Description: "ADC output 1."},
+			{Name: "adc2", Type: "uint16_t", Description: "ADC output 2."},
+			{Name: "adc3", Type: "uint16_t", Description: "ADC output 3."},
+			{Name: "adc4", Type: "uint16_t", Description: "ADC output 4."},
+			{Name: "adc5", Type: "uint16_t", Description: "ADC output 5."},
+			{Name: "adc6", Type: "uint16_t", Description: "ADC output 6."},
+		},
+	},
+	154: {
+		ID:          154,
+		Name:        "DIGICAM_CONFIGURE",
+		Description: "Configure on-board Camera Control System.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "mode", Type: "uint8_t", Description: "Mode enumeration from 1 to N //P, TV, AV, M, etc. (0 means ignore)."},
+			{Name: "shutter_speed", Type: "uint16_t", Description: "Divisor number //e.g. 1000 means 1/1000 (0 means ignore)."},
+			{Name: "aperture", Type: "uint8_t", Description: "F stop number x 10 //e.g. 28 means 2.8 (0 means ignore)."},
+			{Name: "iso", Type: "uint8_t", Description: "ISO enumeration from 1 to N //e.g. 80, 100, 200, Etc (0 means ignore)."},
+			{Name: "exposure_type", Type: "uint8_t", Description: "Exposure type enumeration from 1 to N (0 means ignore)."},
+			{Name: "command_id", Type: "uint8_t", Description: "Command Identity (incremental loop: 0 to 255). //A command sent multiple times will be executed or pooled just once."},
+			{Name: "engine_cut_off", Type: "uint8_t", Description: "Main engine cut-off time before camera trigger (0 means no cut-off)."},
+			{Name: "extra_param", Type: "uint8_t", Description: "Extra parameters enumeration (0 means ignore)."},
+			{Name: "extra_value", Type: "float", Description: "Correspondent value to given extra_param."},
+		},
+	},
+	155: {
+		ID:          155,
+		Name:        "DIGICAM_CONTROL",
+		Description: "Control on-board Camera Control System to take shots.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "session", Type: "uint8_t", Description: "0: stop, 1: start or keep it up //Session control e.g. show/hide lens."},
+			{Name: "zoom_pos", Type: "uint8_t", Description: "1 to N //Zoom's absolute position (0 means ignore)."},
+			{Name: "zoom_step", Type: "int8_t", Description: "-100 to 100 //Zooming step value to offset zoom from the current position."},
+			{Name: "focus_lock", Type: "uint8_t", Description: "0: unlock focus or keep unlocked, 1: lock focus or keep locked, 3: re-lock focus."},
+			{Name: "shot", Type: "uint8_t", Description: "0: ignore, 1: shot or start filming."},
+			{Name: "command_id", Type: "uint8_t", Description: "Command Identity (incremental loop: 0 to 255)//A command sent multiple times will be executed or pooled just once."},
+			{Name: "extra_param", Type: "uint8_t", Description: "Extra parameters enumeration (0 means ignore)."},
+			{Name: "extra_value", Type: "float", Description: "Correspondent value to given extra_param."},
+		},
+	},
+	156: {
+		ID:          156,
+		Name:        "MOUNT_CONFIGURE",
+		Description: "Message to configure a camera mount, directional antenna, etc.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "mount_mode", Type: "uint8_t", Enum: "MAV_MOUNT_MODE", Description: "Mount operating mode."},
+			{Name: "stab_roll", Type: "uint8_t", Description: "(1 = yes, 0 = no)."},
+			{Name: "stab_pitch", Type: "uint8_t", Description: "(1 = yes, 0 = no)."},
+			{Name: "stab_yaw", Type: "uint8_t", Description: "(1 = yes, 0 = no)."},
+		},
+	},
+	157: {
+		ID:          157,
+		Name:        "MOUNT_CONTROL",
+		Description: "Message to control a camera mount, directional antenna, etc.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "input_a", Type: "int32_t", Description: "Pitch (centi-degrees) or lat (degE7), depending on mount mode."},
+			{Name: "input_b", Type: "int32_t", Description: "Roll (centi-degrees) or lon (degE7) depending on mount mode."},
+			{Name: "input_c", Type: "int32_t", Description: "Yaw (centi-degrees) or alt (cm) depending on mount mode."},
+			{Name: "save_position", Type: "uint8_t", Description: "If \"1\" it will save current trimmed position on EEPROM (just valid for NEUTRAL and LANDING)."},
+		},
+	},
+	158: {
+		ID:          158,
+		Name:        "MOUNT_STATUS",
+		Description: "Message with some status from autopilot to GCS about camera or antenna mount.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "pointing_a", Type: "int32_t", Description: "Pitch."},
+			{Name: "pointing_b", Type: "int32_t", Description: "Roll."},
+			{Name: "pointing_c", Type: "int32_t", Description: "Yaw."},
+			{Name: "mount_mode", Type: "uint8_t", Extension: true, Enum: "MAV_MOUNT_MODE", Description: "Mount operating mode."},
+		},
+	},
+	160: {
+		ID:          160,
+		Name:        "FENCE_POINT",
+		Description: "A fence point. Used to set a point when from GCS -> MAV. Also used to return a point from MAV -> GCS.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "idx", Type: "uint8_t", Description: "Point index (first point is 1, 0 is for return point)."},
+			{Name: "count", Type: "uint8_t", Description: "Total number of points (for sanity checking)."},
+			{Name: "lat", Type: "float", Description: "Latitude of point."},
+			{Name: "lng", Type: "float", Description: "Longitude of point."},
+		},
+	},
+	161: {
+		ID:          161,
+		Name:        "FENCE_FETCH_POINT",
+		Description: "Request a current fence point from MAV.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "idx", Type: "uint8_t", Description: "Point index (first point is 1, 0 is for return point)."},
+		},
+	},
+	163: {
+		ID:          163,
+		Name:        "AHRS",
+		Description: "Status of DCM attitude estimator.",
+		Fields: []*message.FieldMetadata{
+			{Name: "omegaIx", Type: "float", Description: "X gyro drift estimate."},
+			{Name: "omegaIy", Type: "float", Description: "Y gyro drift estimate."},
+			{Name: "omegaIz", Type: "float", Description: "Z gyro drift estimate."},
+			{Name: "accel_weight", Type: "float", Description: "Average accel_weight."},
+			{Name: "renorm_val", Type: "float", Description: "Average renormalisation value."},
+			{Name: "error_rp", Type: "float", Description: "Average error_roll_pitch value."},
+			{Name: "error_yaw", Type: "float", Description: "Average error_yaw value."},
+		},
+	},
+	164: {
+		ID:          164,
+		Name:        "SIMSTATE",
+		Description: "Status of simulation environment, if used.",
+		Fields: []*message.FieldMetadata{
+			{Name: "roll", Type: "float", Description: "Roll angle."},
+			{Name: "pitch", Type: "float", Description: "Pitch angle."},
+			{Name: "yaw", Type: "float", Description: "Yaw angle."},
+			{Name: "xacc", Type: "float", Description: "X acceleration."},
+			{Name: "yacc", Type: "float", Description: "Y acceleration."},
+			{Name: "zacc", Type: "float", Description: "Z acceleration."},
+			{Name: "xgyro", Type: "float", Description: "Angular speed around X axis."},
+			{Name: "ygyro", Type: "float", Description: "Angular speed around Y axis."},
+			{Name: "zgyro", Type: "float", Description: "Angular speed around Z axis."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude."},
+			{Name: "lng", Type: "int32_t", Description: "Longitude."},
+		},
+	},
+	165: {
+		ID:          165,
+		Name:        "HWSTATUS",
+		Description: "Status of key hardware.",
+		Fields: []*message.FieldMetadata{
+			{Name: "Vcc", Type: "uint16_t", Description: "Board voltage."},
+			{Name: "I2Cerr", Type: "uint8_t", Description: "I2C error count."},
+		},
+	},
+	166: {
+		ID:          166,
+		Name:        "RADIO",
+		Description: "Status generated by radio.",
+		Fields: []*message.FieldMetadata{
+			{Name: "rssi", Type: "uint8_t", Description: "Local signal strength."},
+			{Name: "remrssi", Type: "uint8_t", Description: "Remote signal strength."},
+			{Name: "txbuf", Type: "uint8_t", Description: "Remaining free transmitter buffer space."},
+			{Name: "noise", Type: "uint8_t", Description: "Background noise level."},
+			{Name: "remnoise", Type: "uint8_t", Description: "Remote background noise level."},
+			{Name: "rxerrors", Type: "uint16_t", Description: "Receive errors."},
+			{Name: "fixed", Type: "uint16_t", Description: "Count of error corrected packets."},
+		},
+	},
+	167: {
+		ID:          167,
+		Name:        "LIMITS_STATUS",
+		Description: "Status of AP_Limits. Sent in extended status stream when AP_Limits is enabled.",
+		Fields: []*message.FieldMetadata{
+			{Name: "limits_state", Type: "uint8_t", Enum: "LIMITS_STATE", Description: "State of AP_Limits."},
+			{Name: "last_trigger", Type: "uint32_t", Description: "Time (since boot) of last breach."},
+			{Name: "last_action", Type: "uint32_t", Description: "Time (since boot) of last recovery action."},
+			{Name: "last_recovery", Type: "uint32_t", Description: "Time (since boot) of last successful recovery."},
+			{Name: "last_clear", Type: "uint32_t", Description: "Time (since boot) of last all-clear."},
+			{Name: "breach_count", Type: "uint16_t", Description: "Number of fence breaches."},
+			{Name: "mods_enabled", Type: "uint8_t", Enum: "LIMIT_MODULE", Bitmask: true, Description: "AP_Limit_Module bitfield of enabled modules."},
+			{Name: "mods_required", Type: "uint8_t", Enum: "LIMIT_MODULE", Bitmask: true, Description: "AP_Limit_Module bitfield of required modules."},
+			{Name: "mods_triggered", Type: "uint8_t", Enum: "LIMIT_MODULE", Bitmask: true, Description: "AP_Limit_Module bitfield of triggered modules."},
+		},
+	},
+	168: {
+		ID:          168,
+		Name:        "WIND",
+		Description: "Wind estimation.",
+		Fields: []*message.FieldMetadata{
+			{Name: "direction", Type: "float", Description: "Wind direction (that wind is coming from)."},
+			{Name: "speed", Type: "float", Description: "Wind speed in ground plane."},
+			{Name: "speed_z", Type: "float", Description: "Vertical wind speed."},
+		},
+	},
+	169: {
+		ID:          169,
+		Name:        "DATA16",
+		Description: "Data packet, size 16.",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Description: "Data type."},
+			{Name: "len", Type: "uint8_t", Description: "Data length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 16, Description: "Raw data."},
+		},
+	},
+	170: {
+		ID:          170,
+		Name:        "DATA32",
+		Description: "Data packet, size 32.",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Description: "Data type."},
+			{Name: "len", Type: "uint8_t", Description: "Data length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 32, Description: "Raw data."},
+		},
+	},
+	171: {
+		ID:          171,
+		Name:        "DATA64",
+		Description: "Data packet, size 64.",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Description: "Data type."},
+			{Name: "len", Type: "uint8_t", Description: "Data length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 64, Description: "Raw data."},
+		},
+	},
+	172: {
+		ID:          172,
+		Name:        "DATA96",
+		Description: "Data packet, size 96.",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Description: "Data type."},
+			{Name: "len", Type: "uint8_t", Description: "Data length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 96, Description: "Raw data."},
+		},
+	},
+	173: {
+		ID:          173,
+		Name:        "RANGEFINDER",
+		Description: "Rangefinder reporting.",
+		Fields: []*message.FieldMetadata{
+			{Name: "distance", Type: "float", Description: "Distance."},
+			{Name: "voltage", Type: "float", Description: "Raw voltage if available, zero otherwise."},
+		},
+	},
+	174: {
+		ID:          174,
+		Name:        "AIRSPEED_AUTOCAL",
+		Description: "Airspeed auto-calibration.",
+		Fields: []*message.FieldMetadata{
+			{Name: "vx", Type: "float", Description: "GPS velocity north."},
+			{Name: "vy", Type: "float", Description: "GPS velocity east."},
+			{Name: "vz", Type: "float", Description: "GPS velocity down."},
+			{Name: "diff_pressure", Type: "float", Description: "Differential pressure."},
+			{Name: "EAS2TAS", Type: "float", Description: "Estimated to true airspeed ratio."},
+			{Name: "ratio", Type: "float", Description: "Airspeed ratio."},
+			{Name: "state_x", Type: "float", Description: "EKF state x."},
+			{Name: "state_y", Type: "float", Description: "EKF state y."},
+			{Name: "state_z", Type: "float", Description: "EKF state z."},
+			{Name: "Pax", Type: "float", Description: "EKF Pax."},
+			{Name: "Pby", Type: "float", Description: "EKF Pby."},
+			{Name: "Pcz", Type: "float", Description: "EKF Pcz."},
+		},
+	},
+	175: {
+		ID:          175,
+		Name:        "RALLY_POINT",
+		Description: "A rally point. Used to set a point when from GCS -> MAV. Also used to return a point from MAV -> GCS.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "idx", Type: "uint8_t", Description: "Point index (first point is 0)."},
+			{Name: "count", Type: "uint8_t", Description: "Total number of points (for sanity checking)."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude of point."},
+			{Name: "lng", Type: "int32_t", Description: "Longitude of point."},
+			{Name: "alt", Type: "int16_t", Description: "Transit / loiter altitude relative to home."},
+			{Name: "break_alt", Type: "int16_t", Description: "Break altitude relative to home."},
+			{Name: "land_dir", Type: "uint16_t", Description: "Heading to aim for when landing."},
+			{Name: "flags", Type: "uint8_t", Enum: "RALLY_FLAGS", Description: "Configuration flags."},
+		},
+	},
+	176: {
+		ID:          176,
+		Name:        "RALLY_FETCH_POINT",
+		Description: "Request a current rally point from MAV. MAV should respond with a RALLY_POINT message. MAV should not respond if the request is invalid.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "idx", Type: "uint8_t", Description: "Point index (first point is 0)."},
+		},
+	},
+	177: {
+		ID:          177,
+		Name:        "COMPASSMOT_STATUS",
+		Description: "Status of compassmot calibration.",
+		Fields: []*message.FieldMetadata{
+			{Name: "throttle", Type: "uint16_t", Description: "Throttle."},
+			{Name: "current", Type: "float", Description: "Current."},
+			{Name: "interference", Type: "uint16_t", Description: "Interference."},
+			{Name: "CompensationX", Type: "float", Description: "Motor Compensation X."},
+			{Name: "CompensationY", Type: "float", Description: "Motor Compensation Y."},
+			{Name: "CompensationZ", Type: "float", Description: "Motor Compensation Z."},
+		},
+	},
+	178: {
+		ID:          178,
+		Name:        "AHRS2",
+		Description: "Status of secondary AHRS filter if available.",
+		Fields: []*message.FieldMetadata{
+			{Name: "roll", Type: "float", Description: "Roll angle."},
+			{Name: "pitch", Type: "float", Description: "Pitch angle."},
+			{Name: "yaw", Type: "float", Description: "Yaw angle."},
+			{Name: "altitude", Type: "float", Description: "Altitude (MSL)."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude."},
+			{Name: "lng", Type: "int32_t", Description: "Longitude."},
+		},
+	},
+	179: {
+		ID:          179,
+		Name:        "CAMERA_STATUS",
+		Description: "Camera Event.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Image timestamp (since UNIX epoch, according to camera clock)."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "cam_idx", Type: "uint8_t", Description: "Camera ID."},
+			{Name: "img_idx", Type: "uint16_t", Description: "Image index."},
+			{Name: "event_id", Type: "uint8_t", Enum: "CAMERA_STATUS_TYPES", Description: "Event type."},
+			{Name: "p1", Type: "float", Description: "Parameter 1 (meaning depends on event_id, see CAMERA_STATUS_TYPES enum)."},
+			{Name: "p2", Type: "float", Description: "Parameter 2 (meaning depends on event_id, see CAMERA_STATUS_TYPES enum)."},
+			{Name: "p3", Type: "float", Description: "Parameter 3 (meaning depends on event_id, see CAMERA_STATUS_TYPES enum)."},
+			{Name: "p4", Type: "float", Description: "Parameter 4 (meaning depends on event_id, see CAMERA_STATUS_TYPES enum)."},
+		},
+	},
+	180: {
+		ID:          180,
+		Name:        "CAMERA_FEEDBACK",
+		Description: "Camera Capture Feedback.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Image timestamp (since UNIX epoch), as passed in by CAMERA_STATUS message (or autopilot if no CCB)."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "cam_idx", Type: "uint8_t", Description: "Camera ID."},
+			{Name: "img_idx", Type: "uint16_t", Description: "Image index."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude."},
+			{Name: "lng", Type: "int32_t", Description: "Longitude."},
+			{Name: "alt_msl", Type: "float", Description: "Altitude (MSL)."},
+			{Name: "alt_rel", Type: "float", Description: "Altitude (Relative to HOME location)."},
+			{Name: "roll", Type: "float", Description: "Camera Roll angle (earth frame, +-180)."},
+			{Name: "pitch", Type: "float", Description: "Camera Pitch angle (earth frame, +-180)."},
+			{Name: "yaw", Type: "float", Description: "Camera Yaw (earth frame, 0-360, true)."},
+			{Name: "foc_len", Type: "float", Description: "Focal Length."},
+			{Name: "flags", Type: "uint8_t", Enum: "CAMERA_FEEDBACK_FLAGS", Description: "Feedback flags."},
+			{Name: "completed_captures", Type: "uint16_t", Extension: true, Description: "Completed image captures."},
+		},
+	},
+	181: {
+		ID:          181,
+		Name:        "BATTERY2",
+		Description: "2nd Battery status",
+		Fields: []*message.FieldMetadata{
+			{Name: "voltage", Type: "uint16_t", Description: "Voltage."},
+			{Name: "current_battery", Type: "int16_t", Description: "Battery current, -1: autopilot does not measure the current."},
+		},
+	},
+	182: {
+		ID:          182,
+		Name:        "AHRS3",
+		Description: "Status of third AHRS filter if available. This is for ANU research group (Ali and Sean).",
+		Fields: []*message.FieldMetadata{
+			{Name: "roll", Type: "float", Description: "Roll angle."},
+			{Name: "pitch", Type: "float", Description: "Pitch angle."},
+			{Name: "yaw", Type: "float", Description: "Yaw angle."},
+			{Name: "altitude", Type: "float", Description: "Altitude (MSL)."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude."},
+			{Name: "lng", Type: "int32_t", Description: "Longitude."},
+			{Name: "v1", Type: "float", Description: "Test variable1."},
+			{Name: "v2", Type: "float", Description: "Test variable2."},
+			{Name: "v3", Type: "float", Description: "Test variable3."},
+			{Name: "v4", Type: "float", Description: "Test variable4."},
+		},
+	},
+	183: {
+		ID:          183,
+		Name:        "AUTOPILOT_VERSION_REQUEST",
+		Description: "Request the autopilot version from the system/component.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+		},
+	},
+	184: {
+		ID:          184,
+		Name:        "REMOTE_LOG_DATA_BLOCK",
+		Description: "Send a block of log data to remote location.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "seqno", Type: "uint32_t", Enum: "MAV_REMOTE_LOG_DATA_BLOCK_COMMANDS", Description: "Log data block sequence number."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 200, Description: "Log data block."},
+		},
+	},
+	185: {
+		ID:          185,
+		Name:        "REMOTE_LOG_BLOCK_STATUS",
+		Description: "Send Status of each log block that autopilot board might have sent.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "seqno", Type: "uint32_t", Description: "Log data block sequence number."},
+			{Name: "status", Type: "uint8_t", Enum: "MAV_REMOTE_LOG_DATA_BLOCK_STATUSES", Description: "Log data block status."},
+		},
+	},
+	186: {
+		ID:          186,
+		Name:        "LED_CONTROL",
+		Description: "Control vehicle LEDs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "instance", Type: "uint8_t", Description: "Instance (LED instance to control or 255 for all LEDs)."},
+			{Name: "pattern", Type: "uint8_t", Description: "Pattern (see LED_PATTERN_ENUM)."},
+			{Name: "custom_len", Type: "uint8_t", Description: "Custom Byte Length."},
+			{Name: "custom_bytes", Type: "uint8_t", ArrayLength: 24, Description: "Custom Bytes."},
+		},
+	},
+	191: {
+		ID:          191,
+		Name:        "MAG_CAL_PROGRESS",
+		Description: "Reports progress of compass calibration.",
+		Fields: []*message.FieldMetadata{
+			{Name: "compass_id", Type: "uint8_t", Description: "Compass being calibrated."},
+			{Name: "cal_mask", Type: "uint8_t", Description: "Bitmask of compasses being calibrated."},
+			{Name: "cal_status", Type: "uint8_t", Enum: "MAG_CAL_STATUS", Description: "Calibration Status."},
+			{Name: "attempt", Type: "uint8_t", Description: "Attempt number."},
+			{Name: "completion_pct", Type: "uint8_t", Description: "Completion percentage."},
+			{Name: "completion_mask", Type: "uint8_t", ArrayLength: 10, Description: "Bitmask of sphere sections (see http://en.wikipedia.org/wiki/Geodesic_grid)."},
+			{Name: "direction_x", Type: "float", Description: "Body frame direction vector for display."},
+			{Name: "direction_y", Type: "float", Description: "Body frame direction vector for display."},
+			{Name: "direction_z", Type: "float", Description: "Body frame direction vector for display."},
+		},
+	},
+	193: {
+		ID:          193,
+		Name:        "EKF_STATUS_REPORT",
+		Description: "EKF Status message including flags and variances.",
+		Fields: []*message.FieldMetadata{
+			{Name: "flags", Type: "uint16_t", Enum: "EKF_STATUS_FLAGS", Bitmask: true, Description: "Flags."},
+			{Name: "velocity_variance", Type: "float", Description: "Velocity variance."},
+			{Name: "pos_horiz_variance", Type: "float", Description: "Horizontal Position variance."},
+			{Name: "pos_vert_variance", Type: "float", Description: "Vertical Position variance."},
+			{Name: "compass_variance", Type: "float", Description: "Compass variance."},
+			{Name: "terrain_alt_variance", Type: "float", Description: "Terrain Altitude variance."},
+			{Name: "airspeed_variance", Type: "float", Extension: true, Description: "Airspeed variance."},
+		},
+	},
+	194: {
+		ID:          194,
+		Name:        "PID_TUNING",
+		Description: "PID tuning information.",
+		Fields: []*message.FieldMetadata{
+			{Name: "axis", Type: "uint8_t", Enum: "PID_TUNING_AXIS", Description: "Axis."},
+			{Name: "desired", Type: "float", Description: "Desired rate."},
+			{Name: "achieved", Type: "float", Description: "Achieved rate."},
+			{Name: "FF", Type: "float", Description: "FF component."},
+			{Name: "P", Type: "float", Description: "P component."},
+			{Name: "I", Type: "float", Description: "I component."},
+			{Name: "D", Type: "float", Description: "D component."},
+			{Name: "SRate", Type: "float", Extension: true, Description: "Slew rate."},
+			{Name: "PDmod", Type: "float", Extension: true, Description: "P/D oscillation modifier."},
+		},
+	},
+	195: {
+		ID:          195,
+		Name:        "DEEPSTALL",
+		Description: "Deepstall path planning.",
+		Fields: []*message.FieldMetadata{
+			{Name: "landing_lat", Type: "int32_t", Description: "Landing latitude."},
+			{Name: "landing_lon", Type: "int32_t", Description: "Landing longitude."},
+			{Name: "path_lat", Type: "int32_t", Description: "Final heading start point, latitude."},
+			{Name: "path_lon", Type: "int32_t", Description: "Final heading start point, longitude."},
+			{Name: "arc_entry_lat", Type: "int32_t", Description: "Arc entry point, latitude."},
+			{Name: "arc_entry_lon", Type: "int32_t", Description: "Arc entry point, longitude."},
+			{Name: "altitude", Type: "float", Description: "Altitude."},
+			{Name: "expected_travel_distance", Type: "float", Description: "Distance the aircraft expects to travel during the deepstall."},
+			{Name: "cross_track_error", Type: "float", Description: "Deepstall cross track error (only valid when in DEEPSTALL_STAGE_LAND)."},
+			{Name: "stage", Type: "uint8_t", Enum: "DEEPSTALL_STAGE", Description: "Deepstall stage."},
+		},
+	},
+	200: {
+		ID:          200,
+		Name:        "GIMBAL_REPORT",
+		Description: "3 axis gimbal measurements.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "delta_time", Type: "float", Description: "Time since last update."},
+			{Name: "delta_angle_x", Type: "float", Description: "Delta angle X."},
+			{Name: "delta_angle_y", Type: "float", Description: "Delta angle Y."},
+			{Name: "delta_angle_z", Type: "float", Description: "Delta angle X."},
+			{Name: "delta_velocity_x", Type: "float", Description: "Delta velocity X."},
+			{Name: "delta_velocity_y", Type: "float", Description: "Delta velocity Y."},
+			{Name: "delta_velocity_z", Type: "float", Description: "Delta velocity Z."},
+			{Name: "joint_roll", Type: "float", Description: "Joint ROLL."},
+			{Name: "joint_el", Type: "float", Description: "Joint EL."},
+			{Name: "joint_az", Type: "float", Description: "Joint AZ."},
+		},
+	},
+	201: {
+		ID:          201,
+		Name:        "GIMBAL_CONTROL",
+		Description: "Control message for rate gimbal.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "demanded_rate_x", Type: "float", Description: "Demanded angular rate X."},
+			{Name: "demanded_rate_y", Type: "float", Description: "Demanded angular rate Y."},
+			{Name: "demanded_rate_z", Type: "float", Description: "Demanded angular rate Z."},
+		},
+	},
+	214: {
+		ID:          214,
+		Name:        "GIMBAL_TORQUE_CMD_REPORT",
+		Description: "100 Hz gimbal torque command telemetry.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "rl_torque_cmd", Type: "int16_t", Description: "Roll Torque Command."},
+			{Name: "el_torque_cmd", Type: "int16_t", Description: "Elevation Torque Command."},
+			{Name: "az_torque_cmd", Type: "int16_t", Description: "Azimuth Torque Command."},
+		},
+	},
+	215: {
+		ID:          215,
+		Name:        "GOPRO_HEARTBEAT",
+		Description: "Heartbeat from a HeroBus attached GoPro.",
+		Fields: []*message.FieldMetadata{
+			{Name: "status", Type: "uint8_t", Enum: "GOPRO_HEARTBEAT_STATUS", Description: "Status."},
+			{Name: "capture_mode", Type: "uint8_t", Enum: "GOPRO_CAPTURE_MODE", Description: "Current capture mode."},
+			{Name: "flags", Type: "uint8_t", Enum: "GOPRO_HEARTBEAT_FLAGS", Bitmask: true, Description: "Additional status bits."},
+		},
+	},
+	216: {
+		ID:          216,
+		Name:        "GOPRO_GET_REQUEST",
+		Description: "Request a GOPRO_COMMAND response from the GoPro.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "cmd_id", Type: "uint8_t", Enum: "GOPRO_COMMAND", Description: "Command ID."},
+		},
+	},
+	217: {
+		ID:          217,
+		Name:        "GOPRO_GET_RESPONSE",
+		Description: "Response from a GOPRO_COMMAND get request.",
+		Fields: []*message.FieldMetadata{
+			{Name: "cmd_id", Type: "uint8_t", Enum: "GOPRO_COMMAND", Description: "Command ID."},
+			{Name: "status", Type: "uint8_t", Enum: "GOPRO_REQUEST_STATUS", Description: "Status."},
+			{Name: "value", Type: "uint8_t", ArrayLength: 4, Description: "Value."},
+		},
+	},
+	218: {
+		ID:          218,
+		Name:        "GOPRO_SET_REQUEST",
+		Description: "Request to set a GOPRO_COMMAND with a desired.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "cmd_id", Type: "uint8_t", Enum: "GOPRO_COMMAND", Description: "Command ID."},
+			{Name: "value", Type: "uint8_t", ArrayLength: 4, Description: "Value."},
+		},
+	},
+	219: {
+		ID:          219,
+		Name:        "GOPRO_SET_RESPONSE",
+		Description: "Response from a GOPRO_COMMAND set request.",
+		Fields: []*message.FieldMetadata{
+			{Name: "cmd_id", Type: "uint8_t", Enum: "GOPRO_COMMAND", Description: "Command ID."},
+			{Name: "status", Type: "uint8_t", Enum: "GOPRO_REQUEST_STATUS", Description: "Status."},
+		},
+	},
+	226: {
+		ID:          226,
+		Name:        "RPM",
+		Description: "RPM sensor output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "rpm1", Type: "float", Description: "RPM Sensor1."},
+			{Name: "rpm2", Type: "float", Description: "RPM Sensor2."},
+		},
+	},
+	11000: {
+		ID:          11000,
+		Name:        "DEVICE_OP_READ",
+		Description: "Read registers for a device.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied to reply."},
+			{Name: "bustype", Type: "uint8_t", Enum: "DEVICE_OP_BUSTYPE", Description: "The bus type."},
+			{Name: "bus", Type: "uint8_t", Description: "Bus number."},
+			{Name: "address", Type: "uint8_t", Description: "Bus address."},
+			{Name: "busname", Type: "char", ArrayLength: 40, Description: "Name of device on bus (for SPI)."},
+			{Name: "regstart", Type: "uint8_t", Description: "First register to read."},
+			{Name: "count", Type: "uint8_t", Description: "Count of registers to read."},
+			{Name: "bank", Type: "uint8_t", Extension: true, Description: "Bank number."},
+		},
+	},
+	11001: {
+		ID:          11001,
+		Name:        "DEVICE_OP_READ_REPLY",
+		Description: "Read registers reply.",
+		Fields: []*message.FieldMetadata{
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied from request."},
+			{Name: "result", Type: "uint8_t", Description: "0 for success, anything else is failure code."},
+			{Name: "regstart", Type: "uint8_t", Description: "Starting register."},
+			{Name: "count", Type: "uint8_t", Description: "Count of bytes read."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 128, Description: "Reply data."},
+			{Name: "bank", Type: "uint8_t", Extension: true, Description: "Bank number."},
+		},
+	},
+	11002: {
+		ID:          11002,
+		Name:        "DEVICE_OP_WRITE",
+		Description: "Write registers for a device.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied to reply."},
+			{Name: "bustype", Type: "uint8_t", Enum: "DEVICE_OP_BUSTYPE", Description: "The bus type."},
+			{Name: "bus", Type: "uint8_t", Description: "Bus number."},
+			{Name: "address", Type: "uint8_t", Description: "Bus address."},
+			{Name: "busname", Type: "char", ArrayLength: 40, Description: "Name of device on bus (for SPI)."},
+			{Name: "regstart", Type: "uint8_t", Description: "First register to write."},
+			{Name: "count", Type: "uint8_t", Description: "Count of registers to write."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 128, Description: "Write data."},
+			{Name: "bank", Type: "uint8_t", Extension: true, Description: "Bank number."},
+		},
+	},
+	11003: {
+		ID:          11003,
+		Name:        "DEVICE_OP_WRITE_REPLY",
+		Description: "Write registers reply.",
+		Fields: []*message.FieldMetadata{
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied from request."},
+			{Name: "result", Type: "uint8_t", Description: "0 for success, anything else is failure code."},
+		},
+	},
+	11004: {
+		ID:          11004,
+		Name:        "SECURE_COMMAND",
+		Description: "Send a secure command. Data should be signed with a private key corresponding with a public key known to the recipient. Signature should be over the concatenation of the sequence number (little-endian format), the operation (little-endian format) the data and the session key. For SECURE_COMMAND_GET_SESSION_KEY the session key should be zero length. The data array consists of the data followed by the signature. The sum of the data_length and the sig_length cannot be more than 220. The format of the data is command specific.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "sequence", Type: "uint32_t", Description: "Sequence ID for tagging reply."},
+			{Name: "operation", Type: "uint32_t", Enum: "SECURE_COMMAND_OP", Description: "Operation being requested."},
+			{Name: "data_length", Type: "uint8_t", Description: "Data length."},
+			{Name: "sig_length", Type: "uint8_t", Description: "Signature length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 220, Description: "Signed data."},
+		},
+	},
+	11005: {
+		ID:          11005,
+		Name:        "SECURE_COMMAND_REPLY",
+		Description: "Reply from secure command.",
+		Fields: []*message.FieldMetadata{
+			{Name: "sequence", Type: "uint32_t", Description: "Sequence ID from request."},
+			{Name: "operation", Type: "uint32_t", Enum: "SECURE_COMMAND_OP", Description: "Operation that was requested."},
+			{Name: "result", Type: "uint8_t", Enum: "MAV_RESULT", Description: "Result of command."},
+			{Name: "data_length", Type: "uint8_t", Description: "Data length."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 220, Description: "Reply data."},
+		},
+	},
+	11010: {
+		ID:          11010,
+		Name:        "ADAP_TUNING",
+		Description: "Adaptive Controller tuning information.",
+		Fields: []*message.FieldMetadata{
+			{Name: "axis", Type: "uint8_t", Enum: "PID_TUNING_AXIS", Description: "Axis."},
+			{Name: "desired", Type: "float", Description: "Desired rate."},
+			{Name: "achieved", Type: "float", Description: "Achieved rate."},
+			{Name: "error", Type: "float", Description: "Error between model and vehicle."},
+			{Name: "theta", Type: "float", Description: "Theta estimated state predictor."},
+			{Name: "omega", Type: "float", Description: "Omega estimated state predictor."},
+			{Name: "sigma", Type: "float", Description: "Sigma estimated state predictor."},
+			{Name: "theta_dot", Type: "float", Description: "Theta derivative."},
+			{Name: "omega_dot", Type: "float", Description: "Omega derivative."},
+			{Name: "sigma_dot", Type: "float", Description: "Sigma derivative."},
+			{Name: "f", Type: "float", Description: "Projection operator value."},
+			{Name: "f_dot", Type: "float", Description: "Projection operator derivative."},
+			{Name: "u", Type: "float", Description: "u adaptive controlled output command."},
+		},
+	},
+	11011: {
+		ID:          11011,
+		Name:        "VISION_POSITION_DELTA",
+		Description: "Camera vision based attitude and position deltas.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (synced to UNIX time or since system boot)."},
+			{Name: "time_delta_usec", Type: "uint64_t", Description: "Time since the last reported camera frame."},
+			{Name: "angle_delta", Type: "float", ArrayLength: 3, Description: "Defines a rotation vector [roll, pitch, yaw] to the current MAV_FRAME_BODY_FRD from the previous MAV_FRAME_BODY_FRD."},
+			{Name: "position_delta", Type: "float", ArrayLength: 3, Description: "Change in position to the current MAV_FRAME_BODY_FRD from the previous FRAME_BODY_FRD rotated to the current MAV_FRAME_BODY_FRD."},
+			{Name: "confidence", Type: "float", Description: "Normalised confidence value from 0 to 100."},
+		},
+	},
+	11020: {
+		ID:          11020,
+		Name:        "AOA_SSA",
+		Description: "Angle of Attack and Side Slip Angle.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (since boot or Unix epoch)."},
+			{Name: "AOA", Type: "float", Description: "Angle of Attack."},
+			{Name: "SSA", Type: "float", Description: "Side Slip Angle."},
+		},
+	},
+	11030: {
+		ID:          11030,
+		Name:        "ESC_TELEMETRY_1_TO_4",
+		Description: "ESC Telemetry Data for ESCs 1 to 4, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11031: {
+		ID:          11031,
+		Name:        "ESC_TELEMETRY_5_TO_8",
+		Description: "ESC Telemetry Data for ESCs 5 to 8, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11032: {
+		ID:          11032,
+		Name:        "ESC_TELEMETRY_9_TO_12",
+		Description: "ESC Telemetry Data for ESCs 9 to 12, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11033: {
+		ID:          11033,
+		Name:        "OSD_PARAM_CONFIG",
+		Description: "Configure an OSD parameter slot.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied to reply."},
+			{Name: "osd_screen", Type: "uint8_t", Description: "OSD parameter screen index."},
+			{Name: "osd_index", Type: "uint8_t", Description: "OSD parameter display index."},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "config_type", Type: "uint8_t", Enum: "OSD_PARAM_CONFIG_TYPE", Description: "Config type."},
+			{Name: "min_value", Type: "float", Description: "OSD parameter minimum value."},
+			{Name: "max_value", Type: "float", Description: "OSD parameter maximum value."},
+			{Name: "increment", Type: "float", Description: "OSD parameter increment."},
+		},
+	},
+	11034: {
+		ID:          11034,
+		Name:        "OSD_PARAM_CONFIG_REPLY",
+		Description: "Configure OSD parameter reply.",
+		Fields: []*message.FieldMetadata{
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied from request."},
+			{Name: "result", Type: "uint8_t", Enum: "OSD_PARAM_CONFIG_ERROR", Description: "Config error type."},
+		},
+	},
+	11035: {
+		ID:          11035,
+		Name:        "OSD_PARAM_SHOW_CONFIG",
+		Description: "Read a configured an OSD parameter slot.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied to reply."},
+			{Name: "osd_screen", Type: "uint8_t", Description: "OSD parameter screen index."},
+			{Name: "osd_index", Type: "uint8_t", Description: "OSD parameter display index."},
+		},
+	},
+	11036: {
+		ID:          11036,
+		Name:        "OSD_PARAM_SHOW_CONFIG_REPLY",
+		Description: "Read configured OSD parameter reply.",
+		Fields: []*message.FieldMetadata{
+			{Name: "request_id", Type: "uint32_t", Description: "Request ID - copied from request."},
+			{Name: "result", Type: "uint8_t", Enum: "OSD_PARAM_CONFIG_ERROR", Description: "Config error type."},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "config_type", Type: "uint8_t", Enum: "OSD_PARAM_CONFIG_TYPE", Description: "Config type."},
+			{Name: "min_value", Type: "float", Description: "OSD parameter minimum value."},
+			{Name: "max_value", Type: "float", Description: "OSD parameter maximum value."},
+			{Name: "increment", Type: "float", Description: "OSD parameter increment."},
+		},
+	},
+	11037: {
+		ID:          11037,
+		Name:        "OBSTACLE_DISTANCE_3D",
+		Description: "Obstacle located as a 3D vector.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "sensor_type", Type: "uint8_t", Enum: "MAV_DISTANCE_SENSOR", Description: "Class id of the distance sensor type."},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame of reference."},
+			{Name: "obstacle_id", Type: "uint16_t", Description: "Unique ID given to each obstacle so that its movement can be tracked. Use UINT16_MAX if object ID is unknown or cannot be determined."},
+			{Name: "x", Type: "float", Description: "X position of the obstacle."},
+			{Name: "y", Type: "float", Description: "Y position of the obstacle."},
+			{Name: "z", Type: "float", Description: "Z position of the obstacle."},
+			{Name: "min_distance", Type: "float", Description: "Minimum distance the sensor can measure."},
+			{Name: "max_distance", Type: "float", Description: "Maximum distance the sensor can measure."},
+		},
+	},
+	11038: {
+		ID:          11038,
+		Name:        "WATER_DEPTH",
+		Description: "Water depth",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)"},
+			{Name: "id", Type: "uint8_t", Description: "Onboard ID of the sensor"},
+			{Name: "healthy", Type: "uint8_t", Description: "Sensor data healthy (0=unhealthy, 1=healthy)"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lng", Type: "int32_t", Description: "Longitude"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL) of vehicle"},
+			{Name: "roll", Type: "float", Description: "Roll angle"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle"},
+			{Name: "distance", Type: "float", Description: "Distance (uncorrected)"},
+			{Name: "temperature", Type: "float", Description: "Water temperature"},
+		},
+	},
+	11039: {
+		ID:          11039,
+		Name:        "MCU_STATUS",
+		Description: "The MCU status, giving MCU temperature and voltage. The min and max voltages are to allow for detecting power supply instability.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "MCU instance"},
+			{Name: "MCU_temperature", Type: "int16_t", Description: "MCU Internal temperature"},
+			{Name: "MCU_voltage", Type: "uint16_t", Description: "MCU voltage"},
+			{Name: "MCU_voltage_min", Type: "uint16_t", Description: "MCU voltage minimum"},
+			{Name: "MCU_voltage_max", Type: "uint16_t", Description: "MCU voltage maximum"},
+		},
+	},
+	11040: {
+		ID:          11040,
+		Name:        "ESC_TELEMETRY_13_TO_16",
+		Description: "ESC Telemetry Data for ESCs 13 to 16, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11041: {
+		ID:          11041,
+		Name:        "ESC_TELEMETRY_17_TO_20",
+		Description: "ESC Telemetry Data for ESCs 17 to 20, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11042: {
+		ID:          11042,
+		Name:        "ESC_TELEMETRY_21_TO_24",
+		Description: "ESC Telemetry Data for ESCs 21 to 24, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11043: {
+		ID:          11043,
+		Name:        "ESC_TELEMETRY_25_TO_28",
+		Description: "ESC Telemetry Data for ESCs 25 to 28, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11044: {
+		ID:          11044,
+		Name:        "ESC_TELEMETRY_29_TO_32",
+		Description: "ESC Telemetry Data for ESCs 29 to 32, matching data sent by BLHeli ESCs.",
+		Fields: []*message.FieldMetadata{
+			{Name: "temperature", Type: "uint8_t", ArrayLength: 4, Description: "Temperature."},
+			{Name: "voltage", Type: "uint16_t", ArrayLength: 4, Description: "Voltage."},
+			{Name: "current", Type: "uint16_t", ArrayLength: 4, Description: "Current."},
+			{Name: "totalcurrent", Type: "uint16_t", ArrayLength: 4, Description: "Total current."},
+			{Name: "rpm", Type: "uint16_t", ArrayLength: 4, Description: "RPM (eRPM)."},
+			{Name: "count", Type: "uint16_t", ArrayLength: 4, Description: "count of telemetry packets received (wraps at 65535)."},
+		},
+	},
+	11060: {
+		ID:          11060,
+		Name:        "NAMED_VALUE_STRING",
+		Description: "Send a key-value pair as string. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "name", Type: "char", ArrayLength: 10, Description: "Name of the debug variable"},
+			{Name: "value", Type: "char", ArrayLength: 64, Description: "Value of the debug variable"},
+		},
+	},
+}
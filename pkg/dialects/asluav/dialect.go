@@ -1,5 +1,7 @@
 // Package asluav contains the asluav dialect.
 //
+// Definitions hash (SHA256): 3e83b663decf6388c83175849d80083fb907da5a0e14dc2ffc5decb5ecd9f20f
+//
 //autogenerated:yes
 package asluav
 
@@ -271,4 +273,5 @@ var dial = &dialect.Dialect{
 		&MessageSatcomLinkStatus{},
 		&MessageSensorAirflowAngles{},
 	},
+	Enums: enums,
 }
@@ -22,6 +22,14 @@ const (
 	GSM_LINK_TYPE_4G GSM_LINK_TYPE = 4
 )
 
+var values_GSM_LINK_TYPE = []GSM_LINK_TYPE{
+	GSM_LINK_TYPE_NONE,
+	GSM_LINK_TYPE_UNKNOWN,
+	GSM_LINK_TYPE_2G,
+	GSM_LINK_TYPE_3G,
+	GSM_LINK_TYPE_4G,
+}
+
 var value_to_label_GSM_LINK_TYPE = map[GSM_LINK_TYPE]string{
 	GSM_LINK_TYPE_NONE:    "GSM_LINK_TYPE_NONE",
 	GSM_LINK_TYPE_UNKNOWN: "GSM_LINK_TYPE_UNKNOWN",
@@ -63,3 +71,14 @@ func (e GSM_LINK_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GSM_LINK_TYPE) Values() []GSM_LINK_TYPE {
+	return append([]GSM_LINK_TYPE(nil), values_GSM_LINK_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GSM_LINK_TYPE) IsValid() bool {
+	_, ok := value_to_label_GSM_LINK_TYPE[e]
+	return ok
+}
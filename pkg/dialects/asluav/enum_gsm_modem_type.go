@@ -16,6 +16,11 @@ const (
 	GSM_MODEM_TYPE_HUAWEI_E3372 GSM_MODEM_TYPE = 1
 )
 
+var values_GSM_MODEM_TYPE = []GSM_MODEM_TYPE{
+	GSM_MODEM_TYPE_UNKNOWN,
+	GSM_MODEM_TYPE_HUAWEI_E3372,
+}
+
 var value_to_label_GSM_MODEM_TYPE = map[GSM_MODEM_TYPE]string{
 	GSM_MODEM_TYPE_UNKNOWN:      "GSM_MODEM_TYPE_UNKNOWN",
 	GSM_MODEM_TYPE_HUAWEI_E3372: "GSM_MODEM_TYPE_HUAWEI_E3372",
@@ -51,3 +56,14 @@ func (e GSM_MODEM_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GSM_MODEM_TYPE) Values() []GSM_MODEM_TYPE {
+	return append([]GSM_MODEM_TYPE(nil), values_GSM_MODEM_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GSM_MODEM_TYPE) IsValid() bool {
+	_, ok := value_to_label_GSM_MODEM_TYPE[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Off-board controls/commands for ASLUAVs
 type MessageAslObctrl struct {
 	// Time since system start
@@ -26,3 +33,45 @@ type MessageAslObctrl struct {
 func (*MessageAslObctrl) GetID() uint32 {
 	return 8008
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAslObctrl) MAVLinkCRCExtra() byte {
+	return 234
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAslObctrl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 33
+	}
+	return 33
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslObctrl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Uelev))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Uthrot))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Uthrot2))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Uaill))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Uailr))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Urud))
+	buf[32] = m.ObctrlStatus
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslObctrl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Uelev = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Uthrot = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Uthrot2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Uaill = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Uailr = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Urud = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.ObctrlStatus = buf[32]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAslObctrl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8008]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ASL-fixed-wing controller data
 type MessageAslctrlData struct {
 	// Timestamp
@@ -45,3 +52,79 @@ type MessageAslctrlData struct {
 func (*MessageAslctrlData) GetID() uint32 {
 	return 8004
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAslctrlData) MAVLinkCRCExtra() byte {
+	return 172
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAslctrlData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 98
+	}
+	return 98
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslctrlData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.H))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Href))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.HrefT))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Pitchangle))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Pitchangleref))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Q))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Qref))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Uelev))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.Uthrot))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.Uthrot2))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.Nz))
+	binary.LittleEndian.PutUint32(buf[52:], math.Float32bits(m.Airspeedref))
+	binary.LittleEndian.PutUint32(buf[56:], math.Float32bits(m.Yawangle))
+	binary.LittleEndian.PutUint32(buf[60:], math.Float32bits(m.Yawangleref))
+	binary.LittleEndian.PutUint32(buf[64:], math.Float32bits(m.Rollangle))
+	binary.LittleEndian.PutUint32(buf[68:], math.Float32bits(m.Rollangleref))
+	binary.LittleEndian.PutUint32(buf[72:], math.Float32bits(m.P))
+	binary.LittleEndian.PutUint32(buf[76:], math.Float32bits(m.Pref))
+	binary.LittleEndian.PutUint32(buf[80:], math.Float32bits(m.R))
+	binary.LittleEndian.PutUint32(buf[84:], math.Float32bits(m.Rref))
+	binary.LittleEndian.PutUint32(buf[88:], math.Float32bits(m.Uail))
+	binary.LittleEndian.PutUint32(buf[92:], math.Float32bits(m.Urud))
+	buf[96] = m.AslctrlMode
+	buf[97] = m.Spoilersengaged
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslctrlData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.H = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Href = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.HrefT = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Pitchangle = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Pitchangleref = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Q = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Qref = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Uelev = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Uthrot = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.Uthrot2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.Nz = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	m.Airspeedref = math.Float32frombits(binary.LittleEndian.Uint32(buf[52:]))
+	m.Yawangle = math.Float32frombits(binary.LittleEndian.Uint32(buf[56:]))
+	m.Yawangleref = math.Float32frombits(binary.LittleEndian.Uint32(buf[60:]))
+	m.Rollangle = math.Float32frombits(binary.LittleEndian.Uint32(buf[64:]))
+	m.Rollangleref = math.Float32frombits(binary.LittleEndian.Uint32(buf[68:]))
+	m.P = math.Float32frombits(binary.LittleEndian.Uint32(buf[72:]))
+	m.Pref = math.Float32frombits(binary.LittleEndian.Uint32(buf[76:]))
+	m.R = math.Float32frombits(binary.LittleEndian.Uint32(buf[80:]))
+	m.Rref = math.Float32frombits(binary.LittleEndian.Uint32(buf[84:]))
+	m.Uail = math.Float32frombits(binary.LittleEndian.Uint32(buf[88:]))
+	m.Urud = math.Float32frombits(binary.LittleEndian.Uint32(buf[92:]))
+	m.AslctrlMode = buf[96]
+	m.Spoilersengaged = buf[97]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAslctrlData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8004]
+}
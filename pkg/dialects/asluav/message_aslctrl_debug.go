@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ASL-fixed-wing controller debug data
 type MessageAslctrlDebug struct {
 	// Debug data
@@ -32,3 +39,51 @@ type MessageAslctrlDebug struct {
 func (*MessageAslctrlDebug) GetID() uint32 {
 	return 8005
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAslctrlDebug) MAVLinkCRCExtra() byte {
+	return 251
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAslctrlDebug) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 38
+	}
+	return 38
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslctrlDebug) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.I32_1)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.F_1))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.F_2))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.F_3))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.F_4))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.F_5))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.F_6))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.F_7))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.F_8))
+	buf[36] = m.I8_1
+	buf[37] = m.I8_2
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAslctrlDebug) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.I32_1 = binary.LittleEndian.Uint32(buf[0:])
+	m.F_1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.F_2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.F_3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.F_4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.F_5 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.F_6 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.F_7 = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.F_8 = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.I8_1 = buf[36]
+	m.I8_2 = buf[37]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAslctrlDebug) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8005]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Extended state information for ASLUAVs
 type MessageAsluavStatus struct {
 	// Status of the position-indicator LEDs
@@ -18,3 +25,41 @@ type MessageAsluavStatus struct {
 func (*MessageAsluavStatus) GetID() uint32 {
 	return 8006
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAsluavStatus) MAVLinkCRCExtra() byte {
+	return 97
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAsluavStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAsluavStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.MotorRpm))
+	buf[4] = m.LedStatus
+	buf[5] = m.SatcomStatus
+	for i, v := range m.ServoStatus {
+		buf[6+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAsluavStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MotorRpm = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.LedStatus = buf[4]
+	m.SatcomStatus = buf[5]
+	for i := range m.ServoStatus {
+		m.ServoStatus[i] = buf[6+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAsluavStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8006]
+}
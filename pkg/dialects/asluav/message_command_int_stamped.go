@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message encoding a command with parameters as scaled integers and additional metadata. Scaling depends on the actual command value.
 type MessageCommandIntStamped struct {
 	// UTC time, seconds elapsed since 01.01.1970
@@ -40,3 +47,59 @@ type MessageCommandIntStamped struct {
 func (*MessageCommandIntStamped) GetID() uint32 {
 	return 223
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCommandIntStamped) MAVLinkCRCExtra() byte {
+	return 119
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCommandIntStamped) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 47
+	}
+	return 47
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandIntStamped) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.VehicleTimestamp)
+	binary.LittleEndian.PutUint32(buf[8:], m.UtcTime)
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Param1))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Param2))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Param3))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Param4))
+	binary.LittleEndian.PutUint32(buf[28:], uint32(m.X))
+	binary.LittleEndian.PutUint32(buf[32:], uint32(m.Y))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint16(buf[40:], uint16(m.Command))
+	buf[42] = m.TargetSystem
+	buf[43] = m.TargetComponent
+	buf[44] = byte(m.Frame)
+	buf[45] = m.Current
+	buf[46] = m.Autocontinue
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandIntStamped) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.VehicleTimestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.UtcTime = binary.LittleEndian.Uint32(buf[8:])
+	m.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.X = int32(binary.LittleEndian.Uint32(buf[28:]))
+	m.Y = int32(binary.LittleEndian.Uint32(buf[32:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Command = MAV_CMD(binary.LittleEndian.Uint16(buf[40:]))
+	m.TargetSystem = buf[42]
+	m.TargetComponent = buf[43]
+	m.Frame = MAV_FRAME(buf[44])
+	m.Current = buf[45]
+	m.Autocontinue = buf[46]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCommandIntStamped) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[223]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a command with up to seven parameters to the MAV and additional metadata
 type MessageCommandLongStamped struct {
 	// UTC time, seconds elapsed since 01.01.1970
@@ -36,3 +43,55 @@ type MessageCommandLongStamped struct {
 func (*MessageCommandLongStamped) GetID() uint32 {
 	return 224
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCommandLongStamped) MAVLinkCRCExtra() byte {
+	return 102
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCommandLongStamped) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 45
+	}
+	return 45
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandLongStamped) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.VehicleTimestamp)
+	binary.LittleEndian.PutUint32(buf[8:], m.UtcTime)
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Param1))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Param2))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Param3))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Param4))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Param5))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Param6))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Param7))
+	binary.LittleEndian.PutUint16(buf[40:], uint16(m.Command))
+	buf[42] = m.TargetSystem
+	buf[43] = m.TargetComponent
+	buf[44] = m.Confirmation
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandLongStamped) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.VehicleTimestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.UtcTime = binary.LittleEndian.Uint32(buf[8:])
+	m.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Param5 = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Param6 = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Param7 = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Command = MAV_CMD(binary.LittleEndian.Uint16(buf[40:]))
+	m.TargetSystem = buf[42]
+	m.TargetComponent = buf[43]
+	m.Confirmation = buf[44]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCommandLongStamped) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[224]
+}
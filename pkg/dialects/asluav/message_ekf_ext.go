@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Extended EKF state estimates for ASLUAVs
 type MessageEkfExt struct {
 	// Time since system start
@@ -24,3 +31,43 @@ type MessageEkfExt struct {
 func (*MessageEkfExt) GetID() uint32 {
 	return 8007
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEkfExt) MAVLinkCRCExtra() byte {
+	return 64
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEkfExt) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEkfExt) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Windspeed))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Winddir))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Windz))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Airspeed))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Beta))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Alpha))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEkfExt) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Windspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Winddir = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Windz = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Beta = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Alpha = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEkfExt) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8007]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Fixed-wing soaring (i.e. thermal seeking) data
 type MessageFwSoaringData struct {
 	// Timestamp
@@ -60,3 +67,79 @@ type MessageFwSoaringData struct {
 func (*MessageFwSoaringData) GetID() uint32 {
 	return 8011
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFwSoaringData) MAVLinkCRCExtra() byte {
+	return 20
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFwSoaringData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 102
+	}
+	return 102
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFwSoaringData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint64(buf[8:], m.Timestampmodechanged)
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Xw))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Xr))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Xlat))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Xlon))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Varw))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Varr))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.Varlat))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.Varlon))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.Loiterradius))
+	binary.LittleEndian.PutUint32(buf[52:], math.Float32bits(m.Loiterdirection))
+	binary.LittleEndian.PutUint32(buf[56:], math.Float32bits(m.Disttosoarpoint))
+	binary.LittleEndian.PutUint32(buf[60:], math.Float32bits(m.Vsinkexp))
+	binary.LittleEndian.PutUint32(buf[64:], math.Float32bits(m.Z1Localupdraftspeed))
+	binary.LittleEndian.PutUint32(buf[68:], math.Float32bits(m.Z2Deltaroll))
+	binary.LittleEndian.PutUint32(buf[72:], math.Float32bits(m.Z1Exp))
+	binary.LittleEndian.PutUint32(buf[76:], math.Float32bits(m.Z2Exp))
+	binary.LittleEndian.PutUint32(buf[80:], math.Float32bits(m.Thermalgsnorth))
+	binary.LittleEndian.PutUint32(buf[84:], math.Float32bits(m.Thermalgseast))
+	binary.LittleEndian.PutUint32(buf[88:], math.Float32bits(m.TseDot))
+	binary.LittleEndian.PutUint32(buf[92:], math.Float32bits(m.Debugvar1))
+	binary.LittleEndian.PutUint32(buf[96:], math.Float32bits(m.Debugvar2))
+	buf[100] = m.Controlmode
+	buf[101] = m.Valid
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFwSoaringData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Timestampmodechanged = binary.LittleEndian.Uint64(buf[8:])
+	m.Xw = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Xr = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Xlat = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Xlon = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Varw = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Varr = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Varlat = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.Varlon = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.Loiterradius = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	m.Loiterdirection = math.Float32frombits(binary.LittleEndian.Uint32(buf[52:]))
+	m.Disttosoarpoint = math.Float32frombits(binary.LittleEndian.Uint32(buf[56:]))
+	m.Vsinkexp = math.Float32frombits(binary.LittleEndian.Uint32(buf[60:]))
+	m.Z1Localupdraftspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[64:]))
+	m.Z2Deltaroll = math.Float32frombits(binary.LittleEndian.Uint32(buf[68:]))
+	m.Z1Exp = math.Float32frombits(binary.LittleEndian.Uint32(buf[72:]))
+	m.Z2Exp = math.Float32frombits(binary.LittleEndian.Uint32(buf[76:]))
+	m.Thermalgsnorth = math.Float32frombits(binary.LittleEndian.Uint32(buf[80:]))
+	m.Thermalgseast = math.Float32frombits(binary.LittleEndian.Uint32(buf[84:]))
+	m.TseDot = math.Float32frombits(binary.LittleEndian.Uint32(buf[88:]))
+	m.Debugvar1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[92:]))
+	m.Debugvar2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[96:]))
+	m.Controlmode = buf[100]
+	m.Valid = buf[101]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFwSoaringData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8011]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of GSM modem (connected to onboard computer)
 type MessageGsmLinkStatus struct {
 	// Timestamp (of OBC)
@@ -24,3 +30,43 @@ type MessageGsmLinkStatus struct {
 func (*MessageGsmLinkStatus) GetID() uint32 {
 	return 8014
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGsmLinkStatus) MAVLinkCRCExtra() byte {
+	return 200
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGsmLinkStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGsmLinkStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	buf[8] = byte(m.GsmModemType)
+	buf[9] = byte(m.GsmLinkType)
+	buf[10] = m.Rssi
+	buf[11] = m.RsrpRscp
+	buf[12] = m.SinrEcio
+	buf[13] = m.Rsrq
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGsmLinkStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.GsmModemType = GSM_MODEM_TYPE(buf[8])
+	m.GsmLinkType = GSM_LINK_TYPE(buf[9])
+	m.Rssi = buf[10]
+	m.RsrpRscp = buf[11]
+	m.SinrEcio = buf[12]
+	m.Rsrq = buf[13]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGsmLinkStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8014]
+}
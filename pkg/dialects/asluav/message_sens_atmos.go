@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Atmospheric sensors (temperature, humidity, ...)
 type MessageSensAtmos struct {
 	// Time since system boot
@@ -16,3 +23,35 @@ type MessageSensAtmos struct {
 func (*MessageSensAtmos) GetID() uint32 {
 	return 8009
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensAtmos) MAVLinkCRCExtra() byte {
+	return 144
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensAtmos) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensAtmos) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Tempambient))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Humidity))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensAtmos) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Tempambient = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Humidity = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensAtmos) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8009]
+}
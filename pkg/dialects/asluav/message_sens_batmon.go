@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Battery pack monitoring data for Li-Ion batteries
 type MessageSensBatmon struct {
 	// Time since system start
@@ -40,3 +47,59 @@ type MessageSensBatmon struct {
 func (*MessageSensBatmon) GetID() uint32 {
 	return 8010
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensBatmon) MAVLinkCRCExtra() byte {
+	return 155
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensBatmon) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 41
+	}
+	return 41
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensBatmon) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.BatmonTimestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Temperature))
+	binary.LittleEndian.PutUint32(buf[12:], m.Safetystatus)
+	binary.LittleEndian.PutUint32(buf[16:], m.Operationstatus)
+	binary.LittleEndian.PutUint16(buf[20:], m.Voltage)
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.Current))
+	binary.LittleEndian.PutUint16(buf[24:], m.Batterystatus)
+	binary.LittleEndian.PutUint16(buf[26:], m.Serialnumber)
+	binary.LittleEndian.PutUint16(buf[28:], m.Cellvoltage1)
+	binary.LittleEndian.PutUint16(buf[30:], m.Cellvoltage2)
+	binary.LittleEndian.PutUint16(buf[32:], m.Cellvoltage3)
+	binary.LittleEndian.PutUint16(buf[34:], m.Cellvoltage4)
+	binary.LittleEndian.PutUint16(buf[36:], m.Cellvoltage5)
+	binary.LittleEndian.PutUint16(buf[38:], m.Cellvoltage6)
+	buf[40] = m.Soc
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensBatmon) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.BatmonTimestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Safetystatus = binary.LittleEndian.Uint32(buf[12:])
+	m.Operationstatus = binary.LittleEndian.Uint32(buf[16:])
+	m.Voltage = binary.LittleEndian.Uint16(buf[20:])
+	m.Current = int16(binary.LittleEndian.Uint16(buf[22:]))
+	m.Batterystatus = binary.LittleEndian.Uint16(buf[24:])
+	m.Serialnumber = binary.LittleEndian.Uint16(buf[26:])
+	m.Cellvoltage1 = binary.LittleEndian.Uint16(buf[28:])
+	m.Cellvoltage2 = binary.LittleEndian.Uint16(buf[30:])
+	m.Cellvoltage3 = binary.LittleEndian.Uint16(buf[32:])
+	m.Cellvoltage4 = binary.LittleEndian.Uint16(buf[34:])
+	m.Cellvoltage5 = binary.LittleEndian.Uint16(buf[36:])
+	m.Cellvoltage6 = binary.LittleEndian.Uint16(buf[38:])
+	m.Soc = buf[40]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensBatmon) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8010]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Maximum Power Point Tracker (MPPT) sensor data for solar module power performance tracking
 type MessageSensMppt struct {
 	// MPPT last timestamp
@@ -36,3 +43,55 @@ type MessageSensMppt struct {
 func (*MessageSensMppt) GetID() uint32 {
 	return 8003
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensMppt) MAVLinkCRCExtra() byte {
+	return 231
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensMppt) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 41
+	}
+	return 41
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensMppt) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.MpptTimestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Mppt1Volt))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Mppt1Amp))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Mppt2Volt))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Mppt2Amp))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Mppt3Volt))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Mppt3Amp))
+	binary.LittleEndian.PutUint16(buf[32:], m.Mppt1Pwm)
+	binary.LittleEndian.PutUint16(buf[34:], m.Mppt2Pwm)
+	binary.LittleEndian.PutUint16(buf[36:], m.Mppt3Pwm)
+	buf[38] = m.Mppt1Status
+	buf[39] = m.Mppt2Status
+	buf[40] = m.Mppt3Status
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensMppt) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MpptTimestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Mppt1Volt = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Mppt1Amp = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Mppt2Volt = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Mppt2Amp = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Mppt3Volt = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Mppt3Amp = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Mppt1Pwm = binary.LittleEndian.Uint16(buf[32:])
+	m.Mppt2Pwm = binary.LittleEndian.Uint16(buf[34:])
+	m.Mppt3Pwm = binary.LittleEndian.Uint16(buf[36:])
+	m.Mppt1Status = buf[38]
+	m.Mppt2Status = buf[39]
+	m.Mppt3Status = buf[40]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensMppt) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8003]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Voltage and current sensor data
 type MessageSensPower struct {
 	// Power board voltage sensor reading
@@ -18,3 +25,37 @@ type MessageSensPower struct {
 func (*MessageSensPower) GetID() uint32 {
 	return 8002
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensPower) MAVLinkCRCExtra() byte {
+	return 218
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensPower) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensPower) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Adc121VspbVolt))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Adc121CspbAmp))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Adc121Cs1Amp))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Adc121Cs2Amp))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensPower) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Adc121VspbVolt = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Adc121CspbAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Adc121Cs1Amp = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Adc121Cs2Amp = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensPower) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8002]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Monitoring of power board status
 type MessageSensPowerBoard struct {
 	// Timestamp
@@ -34,3 +41,53 @@ type MessageSensPowerBoard struct {
 func (*MessageSensPowerBoard) GetID() uint32 {
 	return 8013
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensPowerBoard) MAVLinkCRCExtra() byte {
+	return 222
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensPowerBoard) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 46
+	}
+	return 46
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensPowerBoard) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.PwrBrdSystemVolt))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.PwrBrdServoVolt))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.PwrBrdDigitalVolt))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.PwrBrdMotLAmp))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.PwrBrdMotRAmp))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.PwrBrdAnalogAmp))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.PwrBrdDigitalAmp))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.PwrBrdExtAmp))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.PwrBrdAuxAmp))
+	buf[44] = m.PwrBrdStatus
+	buf[45] = m.PwrBrdLedStatus
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensPowerBoard) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.PwrBrdSystemVolt = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.PwrBrdServoVolt = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.PwrBrdDigitalVolt = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.PwrBrdMotLAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.PwrBrdMotRAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.PwrBrdAnalogAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.PwrBrdDigitalAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.PwrBrdExtAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.PwrBrdAuxAmp = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.PwrBrdStatus = buf[44]
+	m.PwrBrdLedStatus = buf[45]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensPowerBoard) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8013]
+}
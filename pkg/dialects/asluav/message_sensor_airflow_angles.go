@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Calibrated airflow angle measurements
 type MessageSensorAirflowAngles struct {
 	// Timestamp
@@ -20,3 +27,39 @@ type MessageSensorAirflowAngles struct {
 func (*MessageSensorAirflowAngles) GetID() uint32 {
 	return 8016
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensorAirflowAngles) MAVLinkCRCExtra() byte {
+	return 149
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensorAirflowAngles) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorAirflowAngles) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Angleofattack))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Sideslip))
+	buf[16] = m.AngleofattackValid
+	buf[17] = m.SideslipValid
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorAirflowAngles) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Angleofattack = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Sideslip = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.AngleofattackValid = buf[16]
+	m.SideslipValid = buf[17]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensorAirflowAngles) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8016]
+}
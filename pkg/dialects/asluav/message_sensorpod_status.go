@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package asluav
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Monitoring of sensorpod status
 type MessageSensorpodStatus struct {
 	// Timestamp in linuxtime (since 1.1.1970)
@@ -26,3 +32,45 @@ type MessageSensorpodStatus struct {
 func (*MessageSensorpodStatus) GetID() uint32 {
 	return 8012
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSensorpodStatus) MAVLinkCRCExtra() byte {
+	return 54
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSensorpodStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorpodStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint16(buf[8:], m.FreeSpace)
+	buf[10] = m.VisensorRate_1
+	buf[11] = m.VisensorRate_2
+	buf[12] = m.VisensorRate_3
+	buf[13] = m.VisensorRate_4
+	buf[14] = m.RecordingNodesCount
+	buf[15] = m.CpuTemp
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSensorpodStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.FreeSpace = binary.LittleEndian.Uint16(buf[8:])
+	m.VisensorRate_1 = buf[10]
+	m.VisensorRate_2 = buf[11]
+	m.VisensorRate_3 = buf[12]
+	m.VisensorRate_4 = buf[13]
+	m.RecordingNodesCount = buf[14]
+	m.CpuTemp = buf[15]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSensorpodStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8012]
+}
@@ -0,0 +1,313 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package asluav
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	223: {
+		ID:          223,
+		Name:        "COMMAND_INT_STAMPED",
+		Description: "Message encoding a command with parameters as scaled integers and additional metadata. Scaling depends on the actual command value.",
+		Fields: []*message.FieldMetadata{
+			{Name: "utc_time", Type: "uint32_t", Description: "UTC time, seconds elapsed since 01.01.1970"},
+			{Name: "vehicle_timestamp", Type: "uint64_t", Description: "Microseconds elapsed since vehicle boot"},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the COMMAND, as defined by MAV_FRAME enum"},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "The scheduled action for the mission item, as defined by MAV_CMD enum"},
+			{Name: "current", Type: "uint8_t", Description: "false:0, true:1"},
+			{Name: "autocontinue", Type: "uint8_t", Description: "autocontinue to next wp"},
+			{Name: "param1", Type: "float", Description: "PARAM1, see MAV_CMD enum"},
+			{Name: "param2", Type: "float", Description: "PARAM2, see MAV_CMD enum"},
+			{Name: "param3", Type: "float", Description: "PARAM3, see MAV_CMD enum"},
+			{Name: "param4", Type: "float", Description: "PARAM4, see MAV_CMD enum"},
+			{Name: "x", Type: "int32_t", Description: "PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7"},
+			{Name: "y", Type: "int32_t", Description: "PARAM6 / local: y position in meters * 1e4, global: longitude in degrees * 10^7"},
+			{Name: "z", Type: "float", Description: "PARAM7 / z position: global: altitude in meters (MSL, WGS84, AGL or relative to home - depending on frame)."},
+		},
+	},
+	224: {
+		ID:          224,
+		Name:        "COMMAND_LONG_STAMPED",
+		Description: "Send a command with up to seven parameters to the MAV and additional metadata",
+		Fields: []*message.FieldMetadata{
+			{Name: "utc_time", Type: "uint32_t", Description: "UTC time, seconds elapsed since 01.01.1970"},
+			{Name: "vehicle_timestamp", Type: "uint64_t", Description: "Microseconds elapsed since vehicle boot"},
+			{Name: "target_system", Type: "uint8_t", Description: "System which should execute the command"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component which should execute the command, 0 for all components"},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "Command ID, as defined by MAV_CMD enum."},
+			{Name: "confirmation", Type: "uint8_t", Description: "0: First transmission of this command. 1-255: Confirmation transmissions (e.g. for kill command)"},
+			{Name: "param1", Type: "float", Description: "Parameter 1, as defined by MAV_CMD enum."},
+			{Name: "param2", Type: "float", Description: "Parameter 2, as defined by MAV_CMD enum."},
+			{Name: "param3", Type: "float", Description: "Parameter 3, as defined by MAV_CMD enum."},
+			{Name: "param4", Type: "float", Description: "Parameter 4, as defined by MAV_CMD enum."},
+			{Name: "param5", Type: "float", Description: "Parameter 5, as defined by MAV_CMD enum."},
+			{Name: "param6", Type: "float", Description: "Parameter 6, as defined by MAV_CMD enum."},
+			{Name: "param7", Type: "float", Description: "Parameter 7, as defined by MAV_CMD enum."},
+		},
+	},
+	8002: {
+		ID:          8002,
+		Name:        "SENS_POWER",
+		Description: "Voltage and current sensor data",
+		Fields: []*message.FieldMetadata{
+			{Name: "adc121_vspb_volt", Type: "float", Description: "Power board voltage sensor reading"},
+			{Name: "adc121_cspb_amp", Type: "float", Description: "Power board current sensor reading"},
+			{Name: "adc121_cs1_amp", Type: "float", Description: "Board current sensor 1 reading"},
+			{Name: "adc121_cs2_amp", Type: "float", Description: "Board current sensor 2 reading"},
+		},
+	},
+	8003: {
+		ID:          8003,
+		Name:        "SENS_MPPT",
+		Description: "Maximum Power Point Tracker (MPPT) sensor data for solar module power performance tracking",
+		Fields: []*message.FieldMetadata{
+			{Name: "mppt_timestamp", Type: "uint64_t", Description: "MPPT last timestamp"},
+			{Name: "mppt1_volt", Type: "float", Description: "MPPT1 voltage"},
+			{Name: "mppt1_amp", Type: "float", Description: "MPPT1 current"},
+			{Name: "mppt1_pwm", Type: "uint16_t", Description: "MPPT1 pwm"},
+			{Name: "mppt1_status", Type: "uint8_t", Description: "MPPT1 status"},
+			{Name: "mppt2_volt", Type: "float", Description: "MPPT2 voltage"},
+			{Name: "mppt2_amp", Type: "float", Description: "MPPT2 current"},
+			{Name: "mppt2_pwm", Type: "uint16_t", Description: "MPPT2 pwm"},
+			{Name: "mppt2_status", Type: "uint8_t", Description: "MPPT2 status"},
+			{Name: "mppt3_volt", Type: "float", Description: "MPPT3 voltage"},
+			{Name: "mppt3_amp", Type: "float", Description: "MPPT3 current"},
+			{Name: "mppt3_pwm", Type: "uint16_t", Description: "MPPT3 pwm"},
+			{Name: "mppt3_status", Type: "uint8_t", Description: "MPPT3 status"},
+		},
+	},
+	8004: {
+		ID:          8004,
+		Name:        "ASLCTRL_DATA",
+		Description: "ASL-fixed-wing controller data",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp"},
+			{Name: "aslctrl_mode", Type: "uint8_t", Description: "ASLCTRL control-mode (manual, stabilized, auto, etc...)"},
+			{Name: "h", Type: "float", Description: "See sourcecode for a description of these values..."},
+			{Name: "hRef", Type: "float"},
+			{Name: "hRef_t", Type: "float"},
+			{Name: "PitchAngle", Type: "float", Description: "Pitch angle"},
+			{Name: "PitchAngleRef", Type: "float", Description: "Pitch angle reference"},
+			{Name: "q", Type: "float"},
+			{Name: "qRef", Type: "float"},
+			{Name: "uElev", Type: "float"},
+			{Name: "uThrot", Type: "float"},
+			{Name: "uThrot2", Type: "float"},
+			{Name: "nZ", Type: "float"},
+			{Name: "AirspeedRef", Type: "float", Description: "Airspeed reference"},
+			{Name: "SpoilersEngaged", Type: "uint8_t"},
+			{Name: "YawAngle", Type: "float", Description: "Yaw angle"},
+			{Name: "YawAngleRef", Type: "float", Description: "Yaw angle reference"},
+			{Name: "RollAngle", Type: "float", Description: "Roll angle"},
+			{Name: "RollAngleRef", Type: "float", Description: "Roll angle reference"},
+			{Name: "p", Type: "float"},
+			{Name: "pRef", Type: "float"},
+			{Name: "r", Type: "float"},
+			{Name: "rRef", Type: "float"},
+			{Name: "uAil", Type: "float"},
+			{Name: "uRud", Type: "float"},
+		},
+	},
+	8005: {
+		ID:          8005,
+		Name:        "ASLCTRL_DEBUG",
+		Description: "ASL-fixed-wing controller debug data",
+		Fields: []*message.FieldMetadata{
+			{Name: "i32_1", Type: "uint32_t", Description: "Debug data"},
+			{Name: "i8_1", Type: "uint8_t", Description: "Debug data"},
+			{Name: "i8_2", Type: "uint8_t", Description: "Debug data"},
+			{Name: "f_1", Type: "float", Description: "Debug data"},
+			{Name: "f_2", Type: "float", Description: "Debug data"},
+			{Name: "f_3", Type: "float", Description: "Debug data"},
+			{Name: "f_4", Type: "float", Description: "Debug data"},
+			{Name: "f_5", Type: "float", Description: "Debug data"},
+			{Name: "f_6", Type: "float", Description: "Debug data"},
+			{Name: "f_7", Type: "float", Description: "Debug data"},
+			{Name: "f_8", Type: "float", Description: "Debug data"},
+		},
+	},
+	8006: {
+		ID:          8006,
+		Name:        "ASLUAV_STATUS",
+		Description: "Extended state information for ASLUAVs",
+		Fields: []*message.FieldMetadata{
+			{Name: "LED_status", Type: "uint8_t", Description: "Status of the position-indicator LEDs"},
+			{Name: "SATCOM_status", Type: "uint8_t", Description: "Status of the IRIDIUM satellite communication system"},
+			{Name: "Servo_status", Type: "uint8_t", ArrayLength: 8, Description: "Status vector for up to 8 servos"},
+			{Name: "Motor_rpm", Type: "float", Description: "Motor RPM"},
+		},
+	},
+	8007: {
+		ID:          8007,
+		Name:        "EKF_EXT",
+		Description: "Extended EKF state estimates for ASLUAVs",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Time since system start"},
+			{Name: "Windspeed", Type: "float", Description: "Magnitude of wind velocity (in lateral inertial plane)"},
+			{Name: "WindDir", Type: "float", Description: "Wind heading angle from North"},
+			{Name: "WindZ", Type: "float", Description: "Z (Down) component of inertial wind velocity"},
+			{Name: "Airspeed", Type: "float", Description: "Magnitude of air velocity"},
+			{Name: "beta", Type: "float", Description: "Sideslip angle"},
+			{Name: "alpha", Type: "float", Description: "Angle of attack"},
+		},
+	},
+	8008: {
+		ID:          8008,
+		Name:        "ASL_OBCTRL",
+		Description: "Off-board controls/commands for ASLUAVs",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Time since system start"},
+			{Name: "uElev", Type: "float", Description: "Elevator command [~]"},
+			{Name: "uThrot", Type: "float", Description: "Throttle command [~]"},
+			{Name: "uThrot2", Type: "float", Description: "Throttle 2 command [~]"},
+			{Name: "uAilL", Type: "float", Description: "Left aileron command [~]"},
+			{Name: "uAilR", Type: "float", Description: "Right aileron command [~]"},
+			{Name: "uRud", Type: "float", Description: "Rudder command [~]"},
+			{Name: "obctrl_status", Type: "uint8_t", Description: "Off-board computer status"},
+		},
+	},
+	8009: {
+		ID:          8009,
+		Name:        "SENS_ATMOS",
+		Description: "Atmospheric sensors (temperature, humidity, ...)",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Time since system boot"},
+			{Name: "TempAmbient", Type: "float", Description: "Ambient temperature"},
+			{Name: "Humidity", Type: "float", Description: "Relative humidity"},
+		},
+	},
+	8010: {
+		ID:          8010,
+		Name:        "SENS_BATMON",
+		Description: "Battery pack monitoring data for Li-Ion batteries",
+		Fields: []*message.FieldMetadata{
+			{Name: "batmon_timestamp", Type: "uint64_t", Description: "Time since system start"},
+			{Name: "temperature", Type: "float", Description: "Battery pack temperature"},
+			{Name: "voltage", Type: "uint16_t", Description: "Battery pack voltage"},
+			{Name: "current", Type: "int16_t", Description: "Battery pack current"},
+			{Name: "SoC", Type: "uint8_t", Description: "Battery pack state-of-charge"},
+			{Name: "batterystatus", Type: "uint16_t", Description: "Battery monitor status report bits in Hex"},
+			{Name: "serialnumber", Type: "uint16_t", Description: "Battery monitor serial number in Hex"},
+			{Name: "safetystatus", Type: "uint32_t", Description: "Battery monitor safetystatus report bits in Hex"},
+			{Name: "operationstatus", Type: "uint32_t", Description: "Battery monitor operation status report bits in Hex"},
+			{Name: "cellvoltage1", Type: "uint16_t", Description: "Battery pack cell 1 voltage"},
+			{Name: "cellvoltage2", Type: "uint16_t", Description: "Battery pack cell 2 voltage"},
+			{Name: "cellvoltage3", Type: "uint16_t", Description: "Battery pack cell 3 voltage"},
+			{Name: "cellvoltage4", Type: "uint16_t", Description: "Battery pack cell 4 voltage"},
+			{Name: "cellvoltage5", Type: "uint16_t", Description: "Battery pack cell 5 voltage"},
+			{Name: "cellvoltage6", Type: "uint16_t", Description: "Battery pack cell 6 voltage"},
+		},
+	},
+	8011: {
+		ID:          8011,
+		Name:        "FW_SOARING_DATA",
+		Description: "Fixed-wing soaring (i.e. thermal seeking) data",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp"},
+			{Name: "timestampModeChanged", Type: "uint64_t", Description: "Timestamp since last mode change"},
+			{Name: "xW", Type: "float", Description: "Thermal core updraft strength"},
+			{Name: "xR", Type: "float", Description: "Thermal radius"},
+			{Name: "xLat", Type: "float", Description: "Thermal center latitude"},
+			{Name: "xLon", Type: "float", Description: "Thermal center longitude"},
+			{Name: "VarW", Type: "float", Description: "Variance W"},
+			{Name: "VarR", Type: "float", Description: "Variance R"},
+			{Name: "VarLat", Type: "float", Description: "Variance Lat"},
+			{Name: "VarLon", Type: "float", Description: "Variance Lon"},
+			{Name: "LoiterRadius", Type: "float", Description: "Suggested loiter radius"},
+			{Name: "LoiterDirection", Type: "float", Description: "Suggested loiter direction"},
+			{Name: "DistToSoarPoint", Type: "float", Description: "Distance to soar point"},
+			{Name: "vSinkExp", Type: "float", Description: "Expected sink rate at current airspeed, roll and throttle"},
+			{Name: "z1_LocalUpdraftSpeed", Type: "float", Description: "Measurement / updraft speed at current/local airplane position"},
+			{Name: "z2_DeltaRoll", Type: "float", Description: "Measurement / roll angle tracking error"},
+			{Name: "z1_exp", Type: "float", Description: "Expected measurement 1"},
+			{Name: "z2_exp", Type: "float", Description: "Expected measurement 2"},
+			{Name: "ThermalGSNorth", Type: "float", Description: "Thermal drift (from estimator prediction step only)"},
+			{Name: "ThermalGSEast", Type: "float", Description: "Thermal drift (from estimator prediction step only)"},
+			{Name: "TSE_dot", Type: "float", Description: "Total specific energy change (filtered)"},
+			{Name: "DebugVar1", Type: "float", Description: "Debug variable 1"},
+			{Name: "DebugVar2", Type: "float", Description: "Debug variable 2"},
+			{Name: "ControlMode", Type: "uint8_t", Description: "Control Mode [-]"},
+			{Name: "valid", Type: "uint8_t", Description: "Data valid [-]"},
+		},
+	},
+	8012: {
+		ID:          8012,
+		Name:        "SENSORPOD_STATUS",
+		Description: "Monitoring of sensorpod status",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp in linuxtime (since 1.1.1970)"},
+			{Name: "visensor_rate_1", Type: "uint8_t", Description: "Rate of ROS topic 1"},
+			{Name: "visensor_rate_2", Type: "uint8_t", Description: "Rate of ROS topic 2"},
+			{Name: "visensor_rate_3", Type: "uint8_t", Description: "Rate of ROS topic 3"},
+			{Name: "visensor_rate_4", Type: "uint8_t", Description: "Rate of ROS topic 4"},
+			{Name: "recording_nodes_count", Type: "uint8_t", Description: "Number of recording nodes"},
+			{Name: "cpu_temp", Type: "uint8_t", Description: "Temperature of sensorpod CPU in"},
+			{Name: "free_space", Type: "uint16_t", Description: "Free space available in recordings directory in [Gb] * 1e2"},
+		},
+	},
+	8013: {
+		ID:          8013,
+		Name:        "SENS_POWER_BOARD",
+		Description: "Monitoring of power board status",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp"},
+			{Name: "pwr_brd_status", Type: "uint8_t", Description: "Power board status register"},
+			{Name: "pwr_brd_led_status", Type: "uint8_t", Description: "Power board leds status"},
+			{Name: "pwr_brd_system_volt", Type: "float", Description: "Power board system voltage"},
+			{Name: "pwr_brd_servo_volt", Type: "float", Description: "Power board servo voltage"},
+			{Name: "pwr_brd_digital_volt", Type: "float", Description: "Power board digital voltage"},
+			{Name: "pwr_brd_mot_l_amp", Type: "float", Description: "Power board left motor current sensor"},
+			{Name: "pwr_brd_mot_r_amp", Type: "float", Description: "Power board right motor current sensor"},
+			{Name: "pwr_brd_analog_amp", Type: "float", Description: "Power board analog current sensor"},
+			{Name: "pwr_brd_digital_amp", Type: "float", Description: "Power board digital current sensor"},
+			{Name: "pwr_brd_ext_amp", Type: "float", Description: "Power board extension current sensor"},
+			{Name: "pwr_brd_aux_amp", Type: "float", Description: "Power board aux current sensor"},
+		},
+	},
+	8014: {
+		ID:          8014,
+		Name:        "GSM_LINK_STATUS",
+		Description: "Status of GSM modem (connected to onboard computer)",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (of OBC)"},
+			{Name: "gsm_modem_type", Type: "uint8_t", Enum: "GSM_MODEM_TYPE", Description: "GSM modem used"},
+			{Name: "gsm_link_type", Type: "uint8_t", Enum: "GSM_LINK_TYPE", Description: "GSM link type"},
+			{Name: "rssi", Type: "uint8_t", Description: "RSSI as reported by modem (unconverted)"},
+			{Name: "rsrp_rscp", Type: "uint8_t", Description: "RSRP (LTE) or RSCP (WCDMA) as reported by modem (unconverted)"},
+			{Name: "sinr_ecio", Type: "uint8_t", Description: "SINR (LTE) or ECIO (WCDMA) as reported by modem (unconverted)"},
+			{Name: "rsrq", Type: "uint8_t", Description: "RSRQ (LTE only) as reported by modem (unconverted)"},
+		},
+	},
+	8015: {
+		ID:          8015,
+		Name:        "SATCOM_LINK_STATUS",
+		Description: "Status of the SatCom link",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp"},
+			{Name: "last_heartbeat", Type: "uint64_t", Description: "Timestamp of the last successful sbd session"},
+			{Name: "failed_sessions", Type: "uint16_t", Description: "Number of failed sessions"},
+			{Name: "successful_sessions", Type: "uint16_t", Description: "Number of successful sessions"},
+			{Name: "signal_quality", Type: "uint8_t", Description: "Signal quality"},
+			{Name: "ring_pending", Type: "uint8_t", Description: "Ring call pending"},
+			{Name: "tx_session_pending", Type: "uint8_t", Description: "Transmission session pending"},
+			{Name: "rx_session_pending", Type: "uint8_t", Description: "Receiving session pending"},
+		},
+	},
+	8016: {
+		ID:          8016,
+		Name:        "SENSOR_AIRFLOW_ANGLES",
+		Description: "Calibrated airflow angle measurements",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp"},
+			{Name: "angleofattack", Type: "float", Description: "Angle of attack"},
+			{Name: "angleofattack_valid", Type: "uint8_t", Description: "Angle of attack measurement valid"},
+			{Name: "sideslip", Type: "float", Description: "Sideslip angle"},
+			{Name: "sideslip_valid", Type: "uint8_t", Description: "Sideslip angle measurement valid"},
+		},
+	},
+}
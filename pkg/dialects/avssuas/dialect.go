@@ -1,5 +1,7 @@
 // Package avssuas contains the avssuas dialect.
 //
+// Definitions hash (SHA256): 99dc3b090263fd1331ffae889339b963264e90e69fb78000eee96ea22ac41313
+//
 //autogenerated:yes
 package avssuas
 
@@ -258,4 +260,5 @@ var dial = &dialect.Dialect{
 		&MessageAvssDroneImu{},
 		&MessageAvssDroneOperationMode{},
 	},
+	Enums: enums,
 }
@@ -16,3 +16,9 @@ func TestDialect(t *testing.T) {
 	err := d.Initialize()
 	require.NoError(t, err)
 }
+
+func TestMessageTable(t *testing.T) {
+	table, err := dialect.NewMessageTable(dialectpkg.Dialect)
+	require.NoError(t, err)
+	require.Equal(t, table, dialectpkg.MessageTable)
+}
@@ -22,6 +22,14 @@ const (
 	MODE_HORSEFLY_DROP AVSS_HORSEFLY_OPERATION_MODE = 4
 )
 
+var values_AVSS_HORSEFLY_OPERATION_MODE = []AVSS_HORSEFLY_OPERATION_MODE{
+	MODE_HORSEFLY_MANUAL_CTRL,
+	MODE_HORSEFLY_AUTO_TAKEOFF,
+	MODE_HORSEFLY_AUTO_LANDING,
+	MODE_HORSEFLY_NAVI_GO_HOME,
+	MODE_HORSEFLY_DROP,
+}
+
 var value_to_label_AVSS_HORSEFLY_OPERATION_MODE = map[AVSS_HORSEFLY_OPERATION_MODE]string{
 	MODE_HORSEFLY_MANUAL_CTRL:  "MODE_HORSEFLY_MANUAL_CTRL",
 	MODE_HORSEFLY_AUTO_TAKEOFF: "MODE_HORSEFLY_AUTO_TAKEOFF",
@@ -63,3 +71,14 @@ func (e AVSS_HORSEFLY_OPERATION_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AVSS_HORSEFLY_OPERATION_MODE) Values() []AVSS_HORSEFLY_OPERATION_MODE {
+	return append([]AVSS_HORSEFLY_OPERATION_MODE(nil), values_AVSS_HORSEFLY_OPERATION_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e AVSS_HORSEFLY_OPERATION_MODE) IsValid() bool {
+	_, ok := value_to_label_AVSS_HORSEFLY_OPERATION_MODE[e]
+	return ok
+}
@@ -40,6 +40,23 @@ const (
 	MODE_M300_ENGINE_START AVSS_M300_OPERATION_MODE = 41
 )
 
+var values_AVSS_M300_OPERATION_MODE = []AVSS_M300_OPERATION_MODE{
+	MODE_M300_MANUAL_CTRL,
+	MODE_M300_ATTITUDE,
+	MODE_M300_P_GPS,
+	MODE_M300_HOTPOINT_MODE,
+	MODE_M300_ASSISTED_TAKEOFF,
+	MODE_M300_AUTO_TAKEOFF,
+	MODE_M300_AUTO_LANDING,
+	MODE_M300_NAVI_GO_HOME,
+	MODE_M300_NAVI_SDK_CTRL,
+	MODE_M300_S_SPORT,
+	MODE_M300_FORCE_AUTO_LANDING,
+	MODE_M300_T_TRIPOD,
+	MODE_M300_SEARCH_MODE,
+	MODE_M300_ENGINE_START,
+}
+
 var value_to_label_AVSS_M300_OPERATION_MODE = map[AVSS_M300_OPERATION_MODE]string{
 	MODE_M300_MANUAL_CTRL:        "MODE_M300_MANUAL_CTRL",
 	MODE_M300_ATTITUDE:           "MODE_M300_ATTITUDE",
@@ -99,3 +116,14 @@ func (e AVSS_M300_OPERATION_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AVSS_M300_OPERATION_MODE) Values() []AVSS_M300_OPERATION_MODE {
+	return append([]AVSS_M300_OPERATION_MODE(nil), values_AVSS_M300_OPERATION_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e AVSS_M300_OPERATION_MODE) IsValid() bool {
+	_, ok := value_to_label_AVSS_M300_OPERATION_MODE[e]
+	return ok
+}
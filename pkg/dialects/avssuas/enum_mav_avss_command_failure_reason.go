@@ -18,6 +18,12 @@ const (
 	PRS_OTM_NOT_ARMED MAV_AVSS_COMMAND_FAILURE_REASON = 3
 )
 
+var values_MAV_AVSS_COMMAND_FAILURE_REASON = []MAV_AVSS_COMMAND_FAILURE_REASON{
+	PRS_NOT_STEADY,
+	PRS_DTM_NOT_ARMED,
+	PRS_OTM_NOT_ARMED,
+}
+
 var value_to_label_MAV_AVSS_COMMAND_FAILURE_REASON = map[MAV_AVSS_COMMAND_FAILURE_REASON]string{
 	PRS_NOT_STEADY:    "PRS_NOT_STEADY",
 	PRS_DTM_NOT_ARMED: "PRS_DTM_NOT_ARMED",
@@ -55,3 +61,14 @@ func (e MAV_AVSS_COMMAND_FAILURE_REASON) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_AVSS_COMMAND_FAILURE_REASON) Values() []MAV_AVSS_COMMAND_FAILURE_REASON {
+	return append([]MAV_AVSS_COMMAND_FAILURE_REASON(nil), values_MAV_AVSS_COMMAND_FAILURE_REASON...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_AVSS_COMMAND_FAILURE_REASON) IsValid() bool {
+	_, ok := value_to_label_MAV_AVSS_COMMAND_FAILURE_REASON[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package avssuas
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Drone IMU data. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).
 type MessageAvssDroneImu struct {
 	// Timestamp (time since FC boot).
@@ -32,3 +39,51 @@ type MessageAvssDroneImu struct {
 func (*MessageAvssDroneImu) GetID() uint32 {
 	return 60052
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvssDroneImu) MAVLinkCRCExtra() byte {
+	return 101
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvssDroneImu) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 44
+	}
+	return 44
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDroneImu) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Q1))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Q2))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Q3))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Q4))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Xacc))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Yacc))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Zacc))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Xgyro))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Ygyro))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.Zgyro))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDroneImu) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Q1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Q2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Q3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Q4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvssDroneImu) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60052]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package avssuas
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Drone operation mode.
 type MessageAvssDroneOperationMode struct {
 	// Timestamp (time since FC boot).
@@ -16,3 +22,35 @@ type MessageAvssDroneOperationMode struct {
 func (*MessageAvssDroneOperationMode) GetID() uint32 {
 	return 60053
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvssDroneOperationMode) MAVLinkCRCExtra() byte {
+	return 45
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvssDroneOperationMode) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDroneOperationMode) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	buf[4] = m.M300OperationMode
+	buf[5] = m.HorseflyOperationMode
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDroneOperationMode) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.M300OperationMode = buf[4]
+	m.HorseflyOperationMode = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvssDroneOperationMode) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60053]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package avssuas
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Drone position.
 type MessageAvssDronePosition struct {
 	// Timestamp (time since FC boot).
@@ -22,3 +29,41 @@ type MessageAvssDronePosition struct {
 func (*MessageAvssDronePosition) GetID() uint32 {
 	return 60051
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvssDronePosition) MAVLinkCRCExtra() byte {
+	return 245
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvssDronePosition) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 24
+	}
+	return 24
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDronePosition) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Alt))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.GroundAlt))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.BarometerAlt))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssDronePosition) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Alt = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.GroundAlt = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.BarometerAlt = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvssDronePosition) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60051]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package avssuas
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // AVSS PRS system status.
 type MessageAvssPrsSysStatus struct {
 	// Timestamp (time since PRS boot).
@@ -20,3 +26,39 @@ type MessageAvssPrsSysStatus struct {
 func (*MessageAvssPrsSysStatus) GetID() uint32 {
 	return 60050
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvssPrsSysStatus) MAVLinkCRCExtra() byte {
+	return 220
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvssPrsSysStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssPrsSysStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.ErrorStatus)
+	binary.LittleEndian.PutUint32(buf[8:], m.BatteryStatus)
+	buf[12] = m.ArmStatus
+	buf[13] = m.ChargeStatus
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvssPrsSysStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.ErrorStatus = binary.LittleEndian.Uint32(buf[4:])
+	m.BatteryStatus = binary.LittleEndian.Uint32(buf[8:])
+	m.ArmStatus = buf[12]
+	m.ChargeStatus = buf[13]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvssPrsSysStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60050]
+}
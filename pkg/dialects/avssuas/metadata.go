@@ -0,0 +1,64 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package avssuas
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	60050: {
+		ID:          60050,
+		Name:        "AVSS_PRS_SYS_STATUS",
+		Description: "AVSS PRS system status.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since PRS boot)."},
+			{Name: "error_status", Type: "uint32_t", Description: "PRS error statuses"},
+			{Name: "battery_status", Type: "uint32_t", Description: "Estimated battery run-time without a remote connection and PRS battery voltage"},
+			{Name: "arm_status", Type: "uint8_t", Description: "PRS arm statuses"},
+			{Name: "charge_status", Type: "uint8_t", Description: "PRS battery charge statuses"},
+		},
+	},
+	60051: {
+		ID:          60051,
+		Name:        "AVSS_DRONE_POSITION",
+		Description: "Drone position.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since FC boot)."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude, expressed"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude, expressed"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL). Note that virtually all GPS modules provide both WGS84 and MSL."},
+			{Name: "ground_alt", Type: "float", Description: "Altitude above ground, This altitude is measured by a ultrasound, Laser rangefinder or millimeter-wave radar"},
+			{Name: "barometer_alt", Type: "float", Description: "This altitude is measured by a barometer"},
+		},
+	},
+	60052: {
+		ID:          60052,
+		Name:        "AVSS_DRONE_IMU",
+		Description: "Drone IMU data. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since FC boot)."},
+			{Name: "q1", Type: "float", Description: "Quaternion component 1, w (1 in null-rotation)"},
+			{Name: "q2", Type: "float", Description: "Quaternion component 2, x (0 in null-rotation)"},
+			{Name: "q3", Type: "float", Description: "Quaternion component 3, y (0 in null-rotation)"},
+			{Name: "q4", Type: "float", Description: "Quaternion component 4, z (0 in null-rotation)"},
+			{Name: "xacc", Type: "float", Description: "X acceleration"},
+			{Name: "yacc", Type: "float", Description: "Y acceleration"},
+			{Name: "zacc", Type: "float", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "float", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "float", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "float", Description: "Angular speed around Z axis"},
+		},
+	},
+	60053: {
+		ID:          60053,
+		Name:        "AVSS_DRONE_OPERATION_MODE",
+		Description: "Drone operation mode.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since FC boot)."},
+			{Name: "M300_operation_mode", Type: "uint8_t", Description: "DJI M300 operation mode"},
+			{Name: "horsefly_operation_mode", Type: "uint8_t", Description: "horsefly operation mode"},
+		},
+	},
+}
@@ -1,5 +1,7 @@
 // Package common contains the common dialect.
 //
+// Definitions hash (SHA256): 94f5c6ed8e8950da4cce5873d44835c6539eecd392fd2bd67e056ca9f0fa3678
+//
 //autogenerated:yes
 package common
 
@@ -253,4 +255,5 @@ var dial = &dialect.Dialect{
 		&MessageOpenDroneIdSystemUpdate{},
 		&MessageHygrometerSensor{},
 	},
+	Enums: enums,
 }
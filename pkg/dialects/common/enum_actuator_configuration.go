@@ -25,6 +25,15 @@ const (
 	ACTUATOR_CONFIGURATION_SPIN_DIRECTION2 ACTUATOR_CONFIGURATION = 5
 )
 
+var values_ACTUATOR_CONFIGURATION = []ACTUATOR_CONFIGURATION{
+	ACTUATOR_CONFIGURATION_NONE,
+	ACTUATOR_CONFIGURATION_BEEP,
+	ACTUATOR_CONFIGURATION_3D_MODE_ON,
+	ACTUATOR_CONFIGURATION_3D_MODE_OFF,
+	ACTUATOR_CONFIGURATION_SPIN_DIRECTION1,
+	ACTUATOR_CONFIGURATION_SPIN_DIRECTION2,
+}
+
 var value_to_label_ACTUATOR_CONFIGURATION = map[ACTUATOR_CONFIGURATION]string{
 	ACTUATOR_CONFIGURATION_NONE:            "ACTUATOR_CONFIGURATION_NONE",
 	ACTUATOR_CONFIGURATION_BEEP:            "ACTUATOR_CONFIGURATION_BEEP",
@@ -68,3 +77,14 @@ func (e ACTUATOR_CONFIGURATION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ACTUATOR_CONFIGURATION) Values() []ACTUATOR_CONFIGURATION {
+	return append([]ACTUATOR_CONFIGURATION(nil), values_ACTUATOR_CONFIGURATION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ACTUATOR_CONFIGURATION) IsValid() bool {
+	_, ok := value_to_label_ACTUATOR_CONFIGURATION[e]
+	return ok
+}
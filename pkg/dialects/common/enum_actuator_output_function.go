@@ -79,6 +79,42 @@ const (
 	ACTUATOR_OUTPUT_FUNCTION_SERVO16 ACTUATOR_OUTPUT_FUNCTION = 48
 )
 
+var values_ACTUATOR_OUTPUT_FUNCTION = []ACTUATOR_OUTPUT_FUNCTION{
+	ACTUATOR_OUTPUT_FUNCTION_NONE,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR1,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR2,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR3,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR4,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR5,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR6,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR7,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR8,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR9,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR10,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR11,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR12,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR13,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR14,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR15,
+	ACTUATOR_OUTPUT_FUNCTION_MOTOR16,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO1,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO2,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO3,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO4,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO5,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO6,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO7,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO8,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO9,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO10,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO11,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO12,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO13,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO14,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO15,
+	ACTUATOR_OUTPUT_FUNCTION_SERVO16,
+}
+
 var value_to_label_ACTUATOR_OUTPUT_FUNCTION = map[ACTUATOR_OUTPUT_FUNCTION]string{
 	ACTUATOR_OUTPUT_FUNCTION_NONE:    "ACTUATOR_OUTPUT_FUNCTION_NONE",
 	ACTUATOR_OUTPUT_FUNCTION_MOTOR1:  "ACTUATOR_OUTPUT_FUNCTION_MOTOR1",
@@ -176,3 +212,14 @@ func (e ACTUATOR_OUTPUT_FUNCTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ACTUATOR_OUTPUT_FUNCTION) Values() []ACTUATOR_OUTPUT_FUNCTION {
+	return append([]ACTUATOR_OUTPUT_FUNCTION(nil), values_ACTUATOR_OUTPUT_FUNCTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ACTUATOR_OUTPUT_FUNCTION) IsValid() bool {
+	_, ok := value_to_label_ACTUATOR_OUTPUT_FUNCTION[e]
+	return ok
+}
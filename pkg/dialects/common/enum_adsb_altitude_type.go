@@ -17,6 +17,11 @@ const (
 	ADSB_ALTITUDE_TYPE_GEOMETRIC ADSB_ALTITUDE_TYPE = 1
 )
 
+var values_ADSB_ALTITUDE_TYPE = []ADSB_ALTITUDE_TYPE{
+	ADSB_ALTITUDE_TYPE_PRESSURE_QNH,
+	ADSB_ALTITUDE_TYPE_GEOMETRIC,
+}
+
 var value_to_label_ADSB_ALTITUDE_TYPE = map[ADSB_ALTITUDE_TYPE]string{
 	ADSB_ALTITUDE_TYPE_PRESSURE_QNH: "ADSB_ALTITUDE_TYPE_PRESSURE_QNH",
 	ADSB_ALTITUDE_TYPE_GEOMETRIC:    "ADSB_ALTITUDE_TYPE_GEOMETRIC",
@@ -52,3 +57,14 @@ func (e ADSB_ALTITUDE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ADSB_ALTITUDE_TYPE) Values() []ADSB_ALTITUDE_TYPE {
+	return append([]ADSB_ALTITUDE_TYPE(nil), values_ADSB_ALTITUDE_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ADSB_ALTITUDE_TYPE) IsValid() bool {
+	_, ok := value_to_label_ADSB_ALTITUDE_TYPE[e]
+	return ok
+}
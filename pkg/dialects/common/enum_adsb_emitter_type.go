@@ -33,6 +33,29 @@ const (
 	ADSB_EMITTER_TYPE_POINT_OBSTACLE    ADSB_EMITTER_TYPE = 19
 )
 
+var values_ADSB_EMITTER_TYPE = []ADSB_EMITTER_TYPE{
+	ADSB_EMITTER_TYPE_NO_INFO,
+	ADSB_EMITTER_TYPE_LIGHT,
+	ADSB_EMITTER_TYPE_SMALL,
+	ADSB_EMITTER_TYPE_LARGE,
+	ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE,
+	ADSB_EMITTER_TYPE_HEAVY,
+	ADSB_EMITTER_TYPE_HIGHLY_MANUV,
+	ADSB_EMITTER_TYPE_ROTOCRAFT,
+	ADSB_EMITTER_TYPE_UNASSIGNED,
+	ADSB_EMITTER_TYPE_GLIDER,
+	ADSB_EMITTER_TYPE_LIGHTER_AIR,
+	ADSB_EMITTER_TYPE_PARACHUTE,
+	ADSB_EMITTER_TYPE_ULTRA_LIGHT,
+	ADSB_EMITTER_TYPE_UNASSIGNED2,
+	ADSB_EMITTER_TYPE_UAV,
+	ADSB_EMITTER_TYPE_SPACE,
+	ADSB_EMITTER_TYPE_UNASSGINED3,
+	ADSB_EMITTER_TYPE_EMERGENCY_SURFACE,
+	ADSB_EMITTER_TYPE_SERVICE_SURFACE,
+	ADSB_EMITTER_TYPE_POINT_OBSTACLE,
+}
+
 var value_to_label_ADSB_EMITTER_TYPE = map[ADSB_EMITTER_TYPE]string{
 	ADSB_EMITTER_TYPE_NO_INFO:           "ADSB_EMITTER_TYPE_NO_INFO",
 	ADSB_EMITTER_TYPE_LIGHT:             "ADSB_EMITTER_TYPE_LIGHT",
@@ -104,3 +127,14 @@ func (e ADSB_EMITTER_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ADSB_EMITTER_TYPE) Values() []ADSB_EMITTER_TYPE {
+	return append([]ADSB_EMITTER_TYPE(nil), values_ADSB_EMITTER_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ADSB_EMITTER_TYPE) IsValid() bool {
+	_, ok := value_to_label_ADSB_EMITTER_TYPE[e]
+	return ok
+}
@@ -99,3 +99,33 @@ func (e ADSB_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ADSB_FLAGS) Values() []ADSB_FLAGS {
+	return append([]ADSB_FLAGS(nil), values_ADSB_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ADSB_FLAGS) IsValid() bool {
+	var mask ADSB_FLAGS
+	for _, val := range values_ADSB_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ADSB_FLAGS) Flags() []ADSB_FLAGS {
+	var flags []ADSB_FLAGS
+	for _, val := range values_ADSB_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ADSB_FLAGS) Has(flags ADSB_FLAGS) bool {
+	return e&flags == flags
+}
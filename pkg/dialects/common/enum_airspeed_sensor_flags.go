@@ -69,3 +69,33 @@ func (e AIRSPEED_SENSOR_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AIRSPEED_SENSOR_FLAGS) Values() []AIRSPEED_SENSOR_FLAGS {
+	return append([]AIRSPEED_SENSOR_FLAGS(nil), values_AIRSPEED_SENSOR_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e AIRSPEED_SENSOR_FLAGS) IsValid() bool {
+	var mask AIRSPEED_SENSOR_FLAGS
+	for _, val := range values_AIRSPEED_SENSOR_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e AIRSPEED_SENSOR_FLAGS) Flags() []AIRSPEED_SENSOR_FLAGS {
+	var flags []AIRSPEED_SENSOR_FLAGS
+	for _, val := range values_AIRSPEED_SENSOR_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e AIRSPEED_SENSOR_FLAGS) Has(flags AIRSPEED_SENSOR_FLAGS) bool {
+	return e&flags == flags
+}
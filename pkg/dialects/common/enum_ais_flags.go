@@ -123,3 +123,33 @@ func (e AIS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AIS_FLAGS) Values() []AIS_FLAGS {
+	return append([]AIS_FLAGS(nil), values_AIS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e AIS_FLAGS) IsValid() bool {
+	var mask AIS_FLAGS
+	for _, val := range values_AIS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e AIS_FLAGS) Flags() []AIS_FLAGS {
+	var flags []AIS_FLAGS
+	for _, val := range values_AIS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e AIS_FLAGS) Has(flags AIS_FLAGS) bool {
+	return e&flags == flags
+}
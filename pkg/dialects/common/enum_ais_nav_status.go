@@ -32,6 +32,25 @@ const (
 	AIS_NAV_STATUS_UNKNOWN AIS_NAV_STATUS = 15
 )
 
+var values_AIS_NAV_STATUS = []AIS_NAV_STATUS{
+	AIS_NAV_STATUS_UNDER_WAY,
+	AIS_NAV_STATUS_ANCHORED,
+	AIS_NAV_STATUS_UN_COMMANDED,
+	AIS_NAV_STATUS_RESTRICTED_MANOEUVERABILITY,
+	AIS_NAV_STATUS_DRAUGHT_CONSTRAINED,
+	AIS_NAV_STATUS_MOORED,
+	AIS_NAV_STATUS_AGROUND,
+	AIS_NAV_STATUS_FISHING,
+	AIS_NAV_STATUS_SAILING,
+	AIS_NAV_STATUS_RESERVED_HSC,
+	AIS_NAV_STATUS_RESERVED_WIG,
+	AIS_NAV_STATUS_RESERVED_1,
+	AIS_NAV_STATUS_RESERVED_2,
+	AIS_NAV_STATUS_RESERVED_3,
+	AIS_NAV_STATUS_AIS_SART,
+	AIS_NAV_STATUS_UNKNOWN,
+}
+
 var value_to_label_AIS_NAV_STATUS = map[AIS_NAV_STATUS]string{
 	AIS_NAV_STATUS_UNDER_WAY:                   "AIS_NAV_STATUS_UNDER_WAY",
 	AIS_NAV_STATUS_ANCHORED:                    "AIS_NAV_STATUS_ANCHORED",
@@ -95,3 +114,14 @@ func (e AIS_NAV_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AIS_NAV_STATUS) Values() []AIS_NAV_STATUS {
+	return append([]AIS_NAV_STATUS(nil), values_AIS_NAV_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e AIS_NAV_STATUS) IsValid() bool {
+	_, ok := value_to_label_AIS_NAV_STATUS[e]
+	return ok
+}
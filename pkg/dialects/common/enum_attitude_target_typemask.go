@@ -89,3 +89,33 @@ func (e ATTITUDE_TARGET_TYPEMASK) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ATTITUDE_TARGET_TYPEMASK) Values() []ATTITUDE_TARGET_TYPEMASK {
+	return append([]ATTITUDE_TARGET_TYPEMASK(nil), values_ATTITUDE_TARGET_TYPEMASK...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ATTITUDE_TARGET_TYPEMASK) IsValid() bool {
+	var mask ATTITUDE_TARGET_TYPEMASK
+	for _, val := range values_ATTITUDE_TARGET_TYPEMASK {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ATTITUDE_TARGET_TYPEMASK) Flags() []ATTITUDE_TARGET_TYPEMASK {
+	var flags []ATTITUDE_TARGET_TYPEMASK
+	for _, val := range values_ATTITUDE_TARGET_TYPEMASK {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ATTITUDE_TARGET_TYPEMASK) Has(flags ATTITUDE_TARGET_TYPEMASK) bool {
+	return e&flags == flags
+}
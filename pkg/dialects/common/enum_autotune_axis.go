@@ -75,3 +75,33 @@ func (e AUTOTUNE_AXIS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AUTOTUNE_AXIS) Values() []AUTOTUNE_AXIS {
+	return append([]AUTOTUNE_AXIS(nil), values_AUTOTUNE_AXIS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e AUTOTUNE_AXIS) IsValid() bool {
+	var mask AUTOTUNE_AXIS
+	for _, val := range values_AUTOTUNE_AXIS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e AUTOTUNE_AXIS) Flags() []AUTOTUNE_AXIS {
+	var flags []AUTOTUNE_AXIS
+	for _, val := range values_AUTOTUNE_AXIS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e AUTOTUNE_AXIS) Has(flags AUTOTUNE_AXIS) bool {
+	return e&flags == flags
+}
@@ -129,3 +129,33 @@ func (e CAMERA_CAP_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_CAP_FLAGS) Values() []CAMERA_CAP_FLAGS {
+	return append([]CAMERA_CAP_FLAGS(nil), values_CAMERA_CAP_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e CAMERA_CAP_FLAGS) IsValid() bool {
+	var mask CAMERA_CAP_FLAGS
+	for _, val := range values_CAMERA_CAP_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e CAMERA_CAP_FLAGS) Flags() []CAMERA_CAP_FLAGS {
+	var flags []CAMERA_CAP_FLAGS
+	for _, val := range values_CAMERA_CAP_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e CAMERA_CAP_FLAGS) Has(flags CAMERA_CAP_FLAGS) bool {
+	return e&flags == flags
+}
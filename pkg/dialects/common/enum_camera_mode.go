@@ -19,6 +19,12 @@ const (
 	CAMERA_MODE_IMAGE_SURVEY CAMERA_MODE = 2
 )
 
+var values_CAMERA_MODE = []CAMERA_MODE{
+	CAMERA_MODE_IMAGE,
+	CAMERA_MODE_VIDEO,
+	CAMERA_MODE_IMAGE_SURVEY,
+}
+
 var value_to_label_CAMERA_MODE = map[CAMERA_MODE]string{
 	CAMERA_MODE_IMAGE:        "CAMERA_MODE_IMAGE",
 	CAMERA_MODE_VIDEO:        "CAMERA_MODE_VIDEO",
@@ -56,3 +62,14 @@ func (e CAMERA_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_MODE) Values() []CAMERA_MODE {
+	return append([]CAMERA_MODE(nil), values_CAMERA_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_MODE) IsValid() bool {
+	_, ok := value_to_label_CAMERA_MODE[e]
+	return ok
+}
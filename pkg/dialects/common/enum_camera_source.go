@@ -21,6 +21,13 @@ const (
 	CAMERA_SOURCE_NDVI CAMERA_SOURCE = 3
 )
 
+var values_CAMERA_SOURCE = []CAMERA_SOURCE{
+	CAMERA_SOURCE_DEFAULT,
+	CAMERA_SOURCE_RGB,
+	CAMERA_SOURCE_IR,
+	CAMERA_SOURCE_NDVI,
+}
+
 var value_to_label_CAMERA_SOURCE = map[CAMERA_SOURCE]string{
 	CAMERA_SOURCE_DEFAULT: "CAMERA_SOURCE_DEFAULT",
 	CAMERA_SOURCE_RGB:     "CAMERA_SOURCE_RGB",
@@ -60,3 +67,14 @@ func (e CAMERA_SOURCE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_SOURCE) Values() []CAMERA_SOURCE {
+	return append([]CAMERA_SOURCE(nil), values_CAMERA_SOURCE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_SOURCE) IsValid() bool {
+	_, ok := value_to_label_CAMERA_SOURCE[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	CAMERA_TRACKING_MODE_RECTANGLE CAMERA_TRACKING_MODE = 2
 )
 
+var values_CAMERA_TRACKING_MODE = []CAMERA_TRACKING_MODE{
+	CAMERA_TRACKING_MODE_NONE,
+	CAMERA_TRACKING_MODE_POINT,
+	CAMERA_TRACKING_MODE_RECTANGLE,
+}
+
 var value_to_label_CAMERA_TRACKING_MODE = map[CAMERA_TRACKING_MODE]string{
 	CAMERA_TRACKING_MODE_NONE:      "CAMERA_TRACKING_MODE_NONE",
 	CAMERA_TRACKING_MODE_POINT:     "CAMERA_TRACKING_MODE_POINT",
@@ -56,3 +62,14 @@ func (e CAMERA_TRACKING_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_TRACKING_MODE) Values() []CAMERA_TRACKING_MODE {
+	return append([]CAMERA_TRACKING_MODE(nil), values_CAMERA_TRACKING_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_TRACKING_MODE) IsValid() bool {
+	_, ok := value_to_label_CAMERA_TRACKING_MODE[e]
+	return ok
+}
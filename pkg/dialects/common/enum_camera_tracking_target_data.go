@@ -74,3 +74,33 @@ func (e CAMERA_TRACKING_TARGET_DATA) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_TRACKING_TARGET_DATA) Values() []CAMERA_TRACKING_TARGET_DATA {
+	return append([]CAMERA_TRACKING_TARGET_DATA(nil), values_CAMERA_TRACKING_TARGET_DATA...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e CAMERA_TRACKING_TARGET_DATA) IsValid() bool {
+	var mask CAMERA_TRACKING_TARGET_DATA
+	for _, val := range values_CAMERA_TRACKING_TARGET_DATA {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e CAMERA_TRACKING_TARGET_DATA) Flags() []CAMERA_TRACKING_TARGET_DATA {
+	var flags []CAMERA_TRACKING_TARGET_DATA
+	for _, val := range values_CAMERA_TRACKING_TARGET_DATA {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e CAMERA_TRACKING_TARGET_DATA) Has(flags CAMERA_TRACKING_TARGET_DATA) bool {
+	return e&flags == flags
+}
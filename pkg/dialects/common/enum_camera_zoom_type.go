@@ -23,6 +23,14 @@ const (
 	ZOOM_TYPE_HORIZONTAL_FOV CAMERA_ZOOM_TYPE = 4
 )
 
+var values_CAMERA_ZOOM_TYPE = []CAMERA_ZOOM_TYPE{
+	ZOOM_TYPE_STEP,
+	ZOOM_TYPE_CONTINUOUS,
+	ZOOM_TYPE_RANGE,
+	ZOOM_TYPE_FOCAL_LENGTH,
+	ZOOM_TYPE_HORIZONTAL_FOV,
+}
+
 var value_to_label_CAMERA_ZOOM_TYPE = map[CAMERA_ZOOM_TYPE]string{
 	ZOOM_TYPE_STEP:           "ZOOM_TYPE_STEP",
 	ZOOM_TYPE_CONTINUOUS:     "ZOOM_TYPE_CONTINUOUS",
@@ -64,3 +72,14 @@ func (e CAMERA_ZOOM_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAMERA_ZOOM_TYPE) Values() []CAMERA_ZOOM_TYPE {
+	return append([]CAMERA_ZOOM_TYPE(nil), values_CAMERA_ZOOM_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAMERA_ZOOM_TYPE) IsValid() bool {
+	_, ok := value_to_label_CAMERA_ZOOM_TYPE[e]
+	return ok
+}
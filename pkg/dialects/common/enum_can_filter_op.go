@@ -15,6 +15,12 @@ const (
 	CAN_FILTER_REMOVE  CAN_FILTER_OP = 2
 )
 
+var values_CAN_FILTER_OP = []CAN_FILTER_OP{
+	CAN_FILTER_REPLACE,
+	CAN_FILTER_ADD,
+	CAN_FILTER_REMOVE,
+}
+
 var value_to_label_CAN_FILTER_OP = map[CAN_FILTER_OP]string{
 	CAN_FILTER_REPLACE: "CAN_FILTER_REPLACE",
 	CAN_FILTER_ADD:     "CAN_FILTER_ADD",
@@ -52,3 +58,14 @@ func (e CAN_FILTER_OP) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CAN_FILTER_OP) Values() []CAN_FILTER_OP {
+	return append([]CAN_FILTER_OP(nil), values_CAN_FILTER_OP...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CAN_FILTER_OP) IsValid() bool {
+	_, ok := value_to_label_CAN_FILTER_OP[e]
+	return ok
+}
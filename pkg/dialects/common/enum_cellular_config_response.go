@@ -23,6 +23,14 @@ const (
 	CELLULAR_CONFIG_BLOCKED_PUK_REQUIRED CELLULAR_CONFIG_RESPONSE = 4
 )
 
+var values_CELLULAR_CONFIG_RESPONSE = []CELLULAR_CONFIG_RESPONSE{
+	CELLULAR_CONFIG_RESPONSE_ACCEPTED,
+	CELLULAR_CONFIG_RESPONSE_APN_ERROR,
+	CELLULAR_CONFIG_RESPONSE_PIN_ERROR,
+	CELLULAR_CONFIG_RESPONSE_REJECTED,
+	CELLULAR_CONFIG_BLOCKED_PUK_REQUIRED,
+}
+
 var value_to_label_CELLULAR_CONFIG_RESPONSE = map[CELLULAR_CONFIG_RESPONSE]string{
 	CELLULAR_CONFIG_RESPONSE_ACCEPTED:    "CELLULAR_CONFIG_RESPONSE_ACCEPTED",
 	CELLULAR_CONFIG_RESPONSE_APN_ERROR:   "CELLULAR_CONFIG_RESPONSE_APN_ERROR",
@@ -64,3 +72,14 @@ func (e CELLULAR_CONFIG_RESPONSE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CELLULAR_CONFIG_RESPONSE) Values() []CELLULAR_CONFIG_RESPONSE {
+	return append([]CELLULAR_CONFIG_RESPONSE(nil), values_CELLULAR_CONFIG_RESPONSE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CELLULAR_CONFIG_RESPONSE) IsValid() bool {
+	_, ok := value_to_label_CELLULAR_CONFIG_RESPONSE[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	CELLULAR_NETWORK_FAILED_REASON_SIM_ERROR CELLULAR_NETWORK_FAILED_REASON = 3
 )
 
+var values_CELLULAR_NETWORK_FAILED_REASON = []CELLULAR_NETWORK_FAILED_REASON{
+	CELLULAR_NETWORK_FAILED_REASON_NONE,
+	CELLULAR_NETWORK_FAILED_REASON_UNKNOWN,
+	CELLULAR_NETWORK_FAILED_REASON_SIM_MISSING,
+	CELLULAR_NETWORK_FAILED_REASON_SIM_ERROR,
+}
+
 var value_to_label_CELLULAR_NETWORK_FAILED_REASON = map[CELLULAR_NETWORK_FAILED_REASON]string{
 	CELLULAR_NETWORK_FAILED_REASON_NONE:        "CELLULAR_NETWORK_FAILED_REASON_NONE",
 	CELLULAR_NETWORK_FAILED_REASON_UNKNOWN:     "CELLULAR_NETWORK_FAILED_REASON_UNKNOWN",
@@ -60,3 +67,14 @@ func (e CELLULAR_NETWORK_FAILED_REASON) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CELLULAR_NETWORK_FAILED_REASON) Values() []CELLULAR_NETWORK_FAILED_REASON {
+	return append([]CELLULAR_NETWORK_FAILED_REASON(nil), values_CELLULAR_NETWORK_FAILED_REASON...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CELLULAR_NETWORK_FAILED_REASON) IsValid() bool {
+	_, ok := value_to_label_CELLULAR_NETWORK_FAILED_REASON[e]
+	return ok
+}
@@ -18,6 +18,14 @@ const (
 	CELLULAR_NETWORK_RADIO_TYPE_LTE   CELLULAR_NETWORK_RADIO_TYPE = 4
 )
 
+var values_CELLULAR_NETWORK_RADIO_TYPE = []CELLULAR_NETWORK_RADIO_TYPE{
+	CELLULAR_NETWORK_RADIO_TYPE_NONE,
+	CELLULAR_NETWORK_RADIO_TYPE_GSM,
+	CELLULAR_NETWORK_RADIO_TYPE_CDMA,
+	CELLULAR_NETWORK_RADIO_TYPE_WCDMA,
+	CELLULAR_NETWORK_RADIO_TYPE_LTE,
+}
+
 var value_to_label_CELLULAR_NETWORK_RADIO_TYPE = map[CELLULAR_NETWORK_RADIO_TYPE]string{
 	CELLULAR_NETWORK_RADIO_TYPE_NONE:  "CELLULAR_NETWORK_RADIO_TYPE_NONE",
 	CELLULAR_NETWORK_RADIO_TYPE_GSM:   "CELLULAR_NETWORK_RADIO_TYPE_GSM",
@@ -59,3 +67,14 @@ func (e CELLULAR_NETWORK_RADIO_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CELLULAR_NETWORK_RADIO_TYPE) Values() []CELLULAR_NETWORK_RADIO_TYPE {
+	return append([]CELLULAR_NETWORK_RADIO_TYPE(nil), values_CELLULAR_NETWORK_RADIO_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CELLULAR_NETWORK_RADIO_TYPE) IsValid() bool {
+	_, ok := value_to_label_CELLULAR_NETWORK_RADIO_TYPE[e]
+	return ok
+}
@@ -39,6 +39,22 @@ const (
 	CELLULAR_STATUS_FLAG_CONNECTED CELLULAR_STATUS_FLAG = 12
 )
 
+var values_CELLULAR_STATUS_FLAG = []CELLULAR_STATUS_FLAG{
+	CELLULAR_STATUS_FLAG_UNKNOWN,
+	CELLULAR_STATUS_FLAG_FAILED,
+	CELLULAR_STATUS_FLAG_INITIALIZING,
+	CELLULAR_STATUS_FLAG_LOCKED,
+	CELLULAR_STATUS_FLAG_DISABLED,
+	CELLULAR_STATUS_FLAG_DISABLING,
+	CELLULAR_STATUS_FLAG_ENABLING,
+	CELLULAR_STATUS_FLAG_ENABLED,
+	CELLULAR_STATUS_FLAG_SEARCHING,
+	CELLULAR_STATUS_FLAG_REGISTERED,
+	CELLULAR_STATUS_FLAG_DISCONNECTING,
+	CELLULAR_STATUS_FLAG_CONNECTING,
+	CELLULAR_STATUS_FLAG_CONNECTED,
+}
+
 var value_to_label_CELLULAR_STATUS_FLAG = map[CELLULAR_STATUS_FLAG]string{
 	CELLULAR_STATUS_FLAG_UNKNOWN:       "CELLULAR_STATUS_FLAG_UNKNOWN",
 	CELLULAR_STATUS_FLAG_FAILED:        "CELLULAR_STATUS_FLAG_FAILED",
@@ -96,3 +112,14 @@ func (e CELLULAR_STATUS_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CELLULAR_STATUS_FLAG) Values() []CELLULAR_STATUS_FLAG {
+	return append([]CELLULAR_STATUS_FLAG(nil), values_CELLULAR_STATUS_FLAG...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CELLULAR_STATUS_FLAG) IsValid() bool {
+	_, ok := value_to_label_CELLULAR_STATUS_FLAG[e]
+	return ok
+}
@@ -25,6 +25,15 @@ const (
 	COMP_METADATA_TYPE_ACTUATORS COMP_METADATA_TYPE = 5
 )
 
+var values_COMP_METADATA_TYPE = []COMP_METADATA_TYPE{
+	COMP_METADATA_TYPE_GENERAL,
+	COMP_METADATA_TYPE_PARAMETER,
+	COMP_METADATA_TYPE_COMMANDS,
+	COMP_METADATA_TYPE_PERIPHERALS,
+	COMP_METADATA_TYPE_EVENTS,
+	COMP_METADATA_TYPE_ACTUATORS,
+}
+
 var value_to_label_COMP_METADATA_TYPE = map[COMP_METADATA_TYPE]string{
 	COMP_METADATA_TYPE_GENERAL:     "COMP_METADATA_TYPE_GENERAL",
 	COMP_METADATA_TYPE_PARAMETER:   "COMP_METADATA_TYPE_PARAMETER",
@@ -68,3 +77,14 @@ func (e COMP_METADATA_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (COMP_METADATA_TYPE) Values() []COMP_METADATA_TYPE {
+	return append([]COMP_METADATA_TYPE(nil), values_COMP_METADATA_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e COMP_METADATA_TYPE) IsValid() bool {
+	_, ok := value_to_label_COMP_METADATA_TYPE[e]
+	return ok
+}
@@ -79,3 +79,33 @@ func (e COMPUTER_STATUS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (COMPUTER_STATUS_FLAGS) Values() []COMPUTER_STATUS_FLAGS {
+	return append([]COMPUTER_STATUS_FLAGS(nil), values_COMPUTER_STATUS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e COMPUTER_STATUS_FLAGS) IsValid() bool {
+	var mask COMPUTER_STATUS_FLAGS
+	for _, val := range values_COMPUTER_STATUS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e COMPUTER_STATUS_FLAGS) Flags() []COMPUTER_STATUS_FLAGS {
+	var flags []COMPUTER_STATUS_FLAGS
+	for _, val := range values_COMPUTER_STATUS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e COMPUTER_STATUS_FLAGS) Has(flags COMPUTER_STATUS_FLAGS) bool {
+	return e&flags == flags
+}
@@ -64,3 +64,33 @@ func (e ENGINE_CONTROL_OPTIONS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ENGINE_CONTROL_OPTIONS) Values() []ENGINE_CONTROL_OPTIONS {
+	return append([]ENGINE_CONTROL_OPTIONS(nil), values_ENGINE_CONTROL_OPTIONS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ENGINE_CONTROL_OPTIONS) IsValid() bool {
+	var mask ENGINE_CONTROL_OPTIONS
+	for _, val := range values_ENGINE_CONTROL_OPTIONS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ENGINE_CONTROL_OPTIONS) Flags() []ENGINE_CONTROL_OPTIONS {
+	var flags []ENGINE_CONTROL_OPTIONS
+	for _, val := range values_ENGINE_CONTROL_OPTIONS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ENGINE_CONTROL_OPTIONS) Has(flags ENGINE_CONTROL_OPTIONS) bool {
+	return e&flags == flags
+}
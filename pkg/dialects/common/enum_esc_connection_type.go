@@ -25,6 +25,15 @@ const (
 	ESC_CONNECTION_TYPE_DSHOT ESC_CONNECTION_TYPE = 5
 )
 
+var values_ESC_CONNECTION_TYPE = []ESC_CONNECTION_TYPE{
+	ESC_CONNECTION_TYPE_PPM,
+	ESC_CONNECTION_TYPE_SERIAL,
+	ESC_CONNECTION_TYPE_ONESHOT,
+	ESC_CONNECTION_TYPE_I2C,
+	ESC_CONNECTION_TYPE_CAN,
+	ESC_CONNECTION_TYPE_DSHOT,
+}
+
 var value_to_label_ESC_CONNECTION_TYPE = map[ESC_CONNECTION_TYPE]string{
 	ESC_CONNECTION_TYPE_PPM:     "ESC_CONNECTION_TYPE_PPM",
 	ESC_CONNECTION_TYPE_SERIAL:  "ESC_CONNECTION_TYPE_SERIAL",
@@ -68,3 +77,14 @@ func (e ESC_CONNECTION_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ESC_CONNECTION_TYPE) Values() []ESC_CONNECTION_TYPE {
+	return append([]ESC_CONNECTION_TYPE(nil), values_ESC_CONNECTION_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ESC_CONNECTION_TYPE) IsValid() bool {
+	_, ok := value_to_label_ESC_CONNECTION_TYPE[e]
+	return ok
+}
@@ -94,3 +94,33 @@ func (e ESC_FAILURE_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ESC_FAILURE_FLAGS) Values() []ESC_FAILURE_FLAGS {
+	return append([]ESC_FAILURE_FLAGS(nil), values_ESC_FAILURE_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ESC_FAILURE_FLAGS) IsValid() bool {
+	var mask ESC_FAILURE_FLAGS
+	for _, val := range values_ESC_FAILURE_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ESC_FAILURE_FLAGS) Flags() []ESC_FAILURE_FLAGS {
+	var flags []ESC_FAILURE_FLAGS
+	for _, val := range values_ESC_FAILURE_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ESC_FAILURE_FLAGS) Has(flags ESC_FAILURE_FLAGS) bool {
+	return e&flags == flags
+}
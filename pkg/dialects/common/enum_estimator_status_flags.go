@@ -119,3 +119,33 @@ func (e ESTIMATOR_STATUS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ESTIMATOR_STATUS_FLAGS) Values() []ESTIMATOR_STATUS_FLAGS {
+	return append([]ESTIMATOR_STATUS_FLAGS(nil), values_ESTIMATOR_STATUS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ESTIMATOR_STATUS_FLAGS) IsValid() bool {
+	var mask ESTIMATOR_STATUS_FLAGS
+	for _, val := range values_ESTIMATOR_STATUS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ESTIMATOR_STATUS_FLAGS) Flags() []ESTIMATOR_STATUS_FLAGS {
+	var flags []ESTIMATOR_STATUS_FLAGS
+	for _, val := range values_ESTIMATOR_STATUS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ESTIMATOR_STATUS_FLAGS) Has(flags ESTIMATOR_STATUS_FLAGS) bool {
+	return e&flags == flags
+}
@@ -31,6 +31,18 @@ const (
 	FAILURE_TYPE_DRIFT FAILURE_TYPE = 8
 )
 
+var values_FAILURE_TYPE = []FAILURE_TYPE{
+	FAILURE_TYPE_OK,
+	FAILURE_TYPE_OFF,
+	FAILURE_TYPE_STUCK,
+	FAILURE_TYPE_GARBAGE,
+	FAILURE_TYPE_WRONG,
+	FAILURE_TYPE_SLOW,
+	FAILURE_TYPE_DELAYED,
+	FAILURE_TYPE_INTERMITTENT,
+	FAILURE_TYPE_DRIFT,
+}
+
 var value_to_label_FAILURE_TYPE = map[FAILURE_TYPE]string{
 	FAILURE_TYPE_OK:           "FAILURE_TYPE_OK",
 	FAILURE_TYPE_OFF:          "FAILURE_TYPE_OFF",
@@ -80,3 +92,14 @@ func (e FAILURE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FAILURE_TYPE) Values() []FAILURE_TYPE {
+	return append([]FAILURE_TYPE(nil), values_FAILURE_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e FAILURE_TYPE) IsValid() bool {
+	_, ok := value_to_label_FAILURE_TYPE[e]
+	return ok
+}
@@ -43,6 +43,31 @@ const (
 	FAILURE_UNIT_BUS_I2C FAILURE_UNIT = 201
 )
 
+var values_FAILURE_UNIT = []FAILURE_UNIT{
+	FAILURE_UNIT_SENSOR_GYRO,
+	FAILURE_UNIT_SENSOR_ACCEL,
+	FAILURE_UNIT_SENSOR_MAG,
+	FAILURE_UNIT_SENSOR_BARO,
+	FAILURE_UNIT_SENSOR_GPS,
+	FAILURE_UNIT_SENSOR_OPTICAL_FLOW,
+	FAILURE_UNIT_SENSOR_VIO,
+	FAILURE_UNIT_SENSOR_DISTANCE_SENSOR,
+	FAILURE_UNIT_SENSOR_AIRSPEED,
+	FAILURE_UNIT_SYSTEM_BATTERY,
+	FAILURE_UNIT_SYSTEM_MOTOR,
+	FAILURE_UNIT_SYSTEM_SERVO,
+	FAILURE_UNIT_SYSTEM_AVOIDANCE,
+	FAILURE_UNIT_SYSTEM_RC_SIGNAL,
+	FAILURE_UNIT_SYSTEM_MAVLINK_SIGNAL,
+	FAILURE_UNIT_SYSTEM_ESC,
+	FAILURE_UNIT_SYSTEM_TRAFFIC_AVOIDANCE,
+	FAILURE_UNIT_DATALINK_LTE,
+	FAILURE_UNIT_DATALINK_WIFI,
+	FAILURE_UNIT_DATALINK_TELEM_RADIO,
+	FAILURE_UNIT_BUS_CAN,
+	FAILURE_UNIT_BUS_I2C,
+}
+
 var value_to_label_FAILURE_UNIT = map[FAILURE_UNIT]string{
 	FAILURE_UNIT_SENSOR_GYRO:              "FAILURE_UNIT_SENSOR_GYRO",
 	FAILURE_UNIT_SENSOR_ACCEL:             "FAILURE_UNIT_SENSOR_ACCEL",
@@ -118,3 +143,14 @@ func (e FAILURE_UNIT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FAILURE_UNIT) Values() []FAILURE_UNIT {
+	return append([]FAILURE_UNIT(nil), values_FAILURE_UNIT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e FAILURE_UNIT) IsValid() bool {
+	_, ok := value_to_label_FAILURE_UNIT[e]
+	return ok
+}
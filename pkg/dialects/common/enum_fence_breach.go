@@ -20,6 +20,13 @@ const (
 	FENCE_BREACH_BOUNDARY FENCE_BREACH = 3
 )
 
+var values_FENCE_BREACH = []FENCE_BREACH{
+	FENCE_BREACH_NONE,
+	FENCE_BREACH_MINALT,
+	FENCE_BREACH_MAXALT,
+	FENCE_BREACH_BOUNDARY,
+}
+
 var value_to_label_FENCE_BREACH = map[FENCE_BREACH]string{
 	FENCE_BREACH_NONE:     "FENCE_BREACH_NONE",
 	FENCE_BREACH_MINALT:   "FENCE_BREACH_MINALT",
@@ -59,3 +66,14 @@ func (e FENCE_BREACH) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FENCE_BREACH) Values() []FENCE_BREACH {
+	return append([]FENCE_BREACH(nil), values_FENCE_BREACH...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e FENCE_BREACH) IsValid() bool {
+	_, ok := value_to_label_FENCE_BREACH[e]
+	return ok
+}
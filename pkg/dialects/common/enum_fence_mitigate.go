@@ -19,6 +19,12 @@ const (
 	FENCE_MITIGATE_VEL_LIMIT FENCE_MITIGATE = 2
 )
 
+var values_FENCE_MITIGATE = []FENCE_MITIGATE{
+	FENCE_MITIGATE_UNKNOWN,
+	FENCE_MITIGATE_NONE,
+	FENCE_MITIGATE_VEL_LIMIT,
+}
+
 var value_to_label_FENCE_MITIGATE = map[FENCE_MITIGATE]string{
 	FENCE_MITIGATE_UNKNOWN:   "FENCE_MITIGATE_UNKNOWN",
 	FENCE_MITIGATE_NONE:      "FENCE_MITIGATE_NONE",
@@ -56,3 +62,14 @@ func (e FENCE_MITIGATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FENCE_MITIGATE) Values() []FENCE_MITIGATE {
+	return append([]FENCE_MITIGATE(nil), values_FENCE_MITIGATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e FENCE_MITIGATE) IsValid() bool {
+	_, ok := value_to_label_FENCE_MITIGATE[e]
+	return ok
+}
@@ -81,3 +81,33 @@ func (e FENCE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FENCE_TYPE) Values() []FENCE_TYPE {
+	return append([]FENCE_TYPE(nil), values_FENCE_TYPE...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e FENCE_TYPE) IsValid() bool {
+	var mask FENCE_TYPE
+	for _, val := range values_FENCE_TYPE {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e FENCE_TYPE) Flags() []FENCE_TYPE {
+	var flags []FENCE_TYPE
+	for _, val := range values_FENCE_TYPE {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e FENCE_TYPE) Has(flags FENCE_TYPE) bool {
+	return e&flags == flags
+}
@@ -109,3 +109,33 @@ func (e GIMBAL_DEVICE_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GIMBAL_DEVICE_FLAGS) Values() []GIMBAL_DEVICE_FLAGS {
+	return append([]GIMBAL_DEVICE_FLAGS(nil), values_GIMBAL_DEVICE_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e GIMBAL_DEVICE_FLAGS) IsValid() bool {
+	var mask GIMBAL_DEVICE_FLAGS
+	for _, val := range values_GIMBAL_DEVICE_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e GIMBAL_DEVICE_FLAGS) Flags() []GIMBAL_DEVICE_FLAGS {
+	var flags []GIMBAL_DEVICE_FLAGS
+	for _, val := range values_GIMBAL_DEVICE_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e GIMBAL_DEVICE_FLAGS) Has(flags GIMBAL_DEVICE_FLAGS) bool {
+	return e&flags == flags
+}
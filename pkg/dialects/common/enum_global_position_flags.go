@@ -69,3 +69,33 @@ func (e GLOBAL_POSITION_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GLOBAL_POSITION_FLAGS) Values() []GLOBAL_POSITION_FLAGS {
+	return append([]GLOBAL_POSITION_FLAGS(nil), values_GLOBAL_POSITION_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e GLOBAL_POSITION_FLAGS) IsValid() bool {
+	var mask GLOBAL_POSITION_FLAGS
+	for _, val := range values_GLOBAL_POSITION_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e GLOBAL_POSITION_FLAGS) Flags() []GLOBAL_POSITION_FLAGS {
+	var flags []GLOBAL_POSITION_FLAGS
+	for _, val := range values_GLOBAL_POSITION_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e GLOBAL_POSITION_FLAGS) Has(flags GLOBAL_POSITION_FLAGS) bool {
+	return e&flags == flags
+}
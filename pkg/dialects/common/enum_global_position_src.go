@@ -29,6 +29,17 @@ const (
 	GLOBAL_POSITION_SRC_LEO GLOBAL_POSITION_SRC = 7
 )
 
+var values_GLOBAL_POSITION_SRC = []GLOBAL_POSITION_SRC{
+	GLOBAL_POSITION_SRC_UNKNOWN,
+	GLOBAL_POSITION_SRC_GNSS,
+	GLOBAL_POSITION_SRC_VISION,
+	GLOBAL_POSITION_SRC_PSEUDOLITES,
+	GLOBAL_POSITION_SRC_TERRAIN,
+	GLOBAL_POSITION_SRC_MAGNETIC,
+	GLOBAL_POSITION_SRC_ESTIMATOR,
+	GLOBAL_POSITION_SRC_LEO,
+}
+
 var value_to_label_GLOBAL_POSITION_SRC = map[GLOBAL_POSITION_SRC]string{
 	GLOBAL_POSITION_SRC_UNKNOWN:     "GLOBAL_POSITION_SRC_UNKNOWN",
 	GLOBAL_POSITION_SRC_GNSS:        "GLOBAL_POSITION_SRC_GNSS",
@@ -76,3 +87,14 @@ func (e GLOBAL_POSITION_SRC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GLOBAL_POSITION_SRC) Values() []GLOBAL_POSITION_SRC {
+	return append([]GLOBAL_POSITION_SRC(nil), values_GLOBAL_POSITION_SRC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GLOBAL_POSITION_SRC) IsValid() bool {
+	_, ok := value_to_label_GLOBAL_POSITION_SRC[e]
+	return ok
+}
@@ -31,6 +31,18 @@ const (
 	GPS_FIX_TYPE_PPP GPS_FIX_TYPE = 8
 )
 
+var values_GPS_FIX_TYPE = []GPS_FIX_TYPE{
+	GPS_FIX_TYPE_NO_GPS,
+	GPS_FIX_TYPE_NO_FIX,
+	GPS_FIX_TYPE_2D_FIX,
+	GPS_FIX_TYPE_3D_FIX,
+	GPS_FIX_TYPE_DGPS,
+	GPS_FIX_TYPE_RTK_FLOAT,
+	GPS_FIX_TYPE_RTK_FIXED,
+	GPS_FIX_TYPE_STATIC,
+	GPS_FIX_TYPE_PPP,
+}
+
 var value_to_label_GPS_FIX_TYPE = map[GPS_FIX_TYPE]string{
 	GPS_FIX_TYPE_NO_GPS:    "GPS_FIX_TYPE_NO_GPS",
 	GPS_FIX_TYPE_NO_FIX:    "GPS_FIX_TYPE_NO_FIX",
@@ -80,3 +92,14 @@ func (e GPS_FIX_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_FIX_TYPE) Values() []GPS_FIX_TYPE {
+	return append([]GPS_FIX_TYPE(nil), values_GPS_FIX_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GPS_FIX_TYPE) IsValid() bool {
+	_, ok := value_to_label_GPS_FIX_TYPE[e]
+	return ok
+}
@@ -98,3 +98,33 @@ func (e GPS_INPUT_IGNORE_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_INPUT_IGNORE_FLAGS) Values() []GPS_INPUT_IGNORE_FLAGS {
+	return append([]GPS_INPUT_IGNORE_FLAGS(nil), values_GPS_INPUT_IGNORE_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e GPS_INPUT_IGNORE_FLAGS) IsValid() bool {
+	var mask GPS_INPUT_IGNORE_FLAGS
+	for _, val := range values_GPS_INPUT_IGNORE_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e GPS_INPUT_IGNORE_FLAGS) Flags() []GPS_INPUT_IGNORE_FLAGS {
+	var flags []GPS_INPUT_IGNORE_FLAGS
+	for _, val := range values_GPS_INPUT_IGNORE_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e GPS_INPUT_IGNORE_FLAGS) Has(flags GPS_INPUT_IGNORE_FLAGS) bool {
+	return e&flags == flags
+}
@@ -19,6 +19,12 @@ const (
 	GRIPPER_ACTION_HOLD GRIPPER_ACTIONS = 2
 )
 
+var values_GRIPPER_ACTIONS = []GRIPPER_ACTIONS{
+	GRIPPER_ACTION_RELEASE,
+	GRIPPER_ACTION_GRAB,
+	GRIPPER_ACTION_HOLD,
+}
+
 var value_to_label_GRIPPER_ACTIONS = map[GRIPPER_ACTIONS]string{
 	GRIPPER_ACTION_RELEASE: "GRIPPER_ACTION_RELEASE",
 	GRIPPER_ACTION_GRAB:    "GRIPPER_ACTION_GRAB",
@@ -56,3 +62,14 @@ func (e GRIPPER_ACTIONS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GRIPPER_ACTIONS) Values() []GRIPPER_ACTIONS {
+	return append([]GRIPPER_ACTIONS(nil), values_GRIPPER_ACTIONS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GRIPPER_ACTIONS) IsValid() bool {
+	_, ok := value_to_label_GRIPPER_ACTIONS[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	HEADING_TYPE_DEFAULT HEADING_TYPE = 2
 )
 
+var values_HEADING_TYPE = []HEADING_TYPE{
+	HEADING_TYPE_COURSE_OVER_GROUND,
+	HEADING_TYPE_HEADING,
+	HEADING_TYPE_DEFAULT,
+}
+
 var value_to_label_HEADING_TYPE = map[HEADING_TYPE]string{
 	HEADING_TYPE_COURSE_OVER_GROUND: "HEADING_TYPE_COURSE_OVER_GROUND",
 	HEADING_TYPE_HEADING:            "HEADING_TYPE_HEADING",
@@ -56,3 +62,14 @@ func (e HEADING_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (HEADING_TYPE) Values() []HEADING_TYPE {
+	return append([]HEADING_TYPE(nil), values_HEADING_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e HEADING_TYPE) IsValid() bool {
+	_, ok := value_to_label_HEADING_TYPE[e]
+	return ok
+}
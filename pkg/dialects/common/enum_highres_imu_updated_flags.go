@@ -124,3 +124,33 @@ func (e HIGHRES_IMU_UPDATED_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (HIGHRES_IMU_UPDATED_FLAGS) Values() []HIGHRES_IMU_UPDATED_FLAGS {
+	return append([]HIGHRES_IMU_UPDATED_FLAGS(nil), values_HIGHRES_IMU_UPDATED_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e HIGHRES_IMU_UPDATED_FLAGS) IsValid() bool {
+	var mask HIGHRES_IMU_UPDATED_FLAGS
+	for _, val := range values_HIGHRES_IMU_UPDATED_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e HIGHRES_IMU_UPDATED_FLAGS) Flags() []HIGHRES_IMU_UPDATED_FLAGS {
+	var flags []HIGHRES_IMU_UPDATED_FLAGS
+	for _, val := range values_HIGHRES_IMU_UPDATED_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e HIGHRES_IMU_UPDATED_FLAGS) Has(flags HIGHRES_IMU_UPDATED_FLAGS) bool {
+	return e&flags == flags
+}
@@ -64,3 +64,33 @@ func (e HIL_ACTUATOR_CONTROLS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (HIL_ACTUATOR_CONTROLS_FLAGS) Values() []HIL_ACTUATOR_CONTROLS_FLAGS {
+	return append([]HIL_ACTUATOR_CONTROLS_FLAGS(nil), values_HIL_ACTUATOR_CONTROLS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e HIL_ACTUATOR_CONTROLS_FLAGS) IsValid() bool {
+	var mask HIL_ACTUATOR_CONTROLS_FLAGS
+	for _, val := range values_HIL_ACTUATOR_CONTROLS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e HIL_ACTUATOR_CONTROLS_FLAGS) Flags() []HIL_ACTUATOR_CONTROLS_FLAGS {
+	var flags []HIL_ACTUATOR_CONTROLS_FLAGS
+	for _, val := range values_HIL_ACTUATOR_CONTROLS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e HIL_ACTUATOR_CONTROLS_FLAGS) Has(flags HIL_ACTUATOR_CONTROLS_FLAGS) bool {
+	return e&flags == flags
+}
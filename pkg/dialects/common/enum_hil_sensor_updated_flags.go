@@ -129,3 +129,33 @@ func (e HIL_SENSOR_UPDATED_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (HIL_SENSOR_UPDATED_FLAGS) Values() []HIL_SENSOR_UPDATED_FLAGS {
+	return append([]HIL_SENSOR_UPDATED_FLAGS(nil), values_HIL_SENSOR_UPDATED_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e HIL_SENSOR_UPDATED_FLAGS) IsValid() bool {
+	var mask HIL_SENSOR_UPDATED_FLAGS
+	for _, val := range values_HIL_SENSOR_UPDATED_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e HIL_SENSOR_UPDATED_FLAGS) Flags() []HIL_SENSOR_UPDATED_FLAGS {
+	var flags []HIL_SENSOR_UPDATED_FLAGS
+	for _, val := range values_HIL_SENSOR_UPDATED_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e HIL_SENSOR_UPDATED_FLAGS) Has(flags HIL_SENSOR_UPDATED_FLAGS) bool {
+	return e&flags == flags
+}
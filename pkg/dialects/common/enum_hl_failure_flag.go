@@ -129,3 +129,33 @@ func (e HL_FAILURE_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (HL_FAILURE_FLAG) Values() []HL_FAILURE_FLAG {
+	return append([]HL_FAILURE_FLAG(nil), values_HL_FAILURE_FLAG...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e HL_FAILURE_FLAG) IsValid() bool {
+	var mask HL_FAILURE_FLAG
+	for _, val := range values_HL_FAILURE_FLAG {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e HL_FAILURE_FLAG) Flags() []HL_FAILURE_FLAG {
+	var flags []HL_FAILURE_FLAG
+	for _, val := range values_HL_FAILURE_FLAG {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e HL_FAILURE_FLAG) Has(flags HL_FAILURE_FLAG) bool {
+	return e&flags == flags
+}
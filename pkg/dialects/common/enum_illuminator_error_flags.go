@@ -74,3 +74,33 @@ func (e ILLUMINATOR_ERROR_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ILLUMINATOR_ERROR_FLAGS) Values() []ILLUMINATOR_ERROR_FLAGS {
+	return append([]ILLUMINATOR_ERROR_FLAGS(nil), values_ILLUMINATOR_ERROR_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e ILLUMINATOR_ERROR_FLAGS) IsValid() bool {
+	var mask ILLUMINATOR_ERROR_FLAGS
+	for _, val := range values_ILLUMINATOR_ERROR_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e ILLUMINATOR_ERROR_FLAGS) Flags() []ILLUMINATOR_ERROR_FLAGS {
+	var flags []ILLUMINATOR_ERROR_FLAGS
+	for _, val := range values_ILLUMINATOR_ERROR_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e ILLUMINATOR_ERROR_FLAGS) Has(flags ILLUMINATOR_ERROR_FLAGS) bool {
+	return e&flags == flags
+}
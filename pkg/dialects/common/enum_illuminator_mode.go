@@ -19,6 +19,12 @@ const (
 	ILLUMINATOR_MODE_EXTERNAL_SYNC ILLUMINATOR_MODE = 2
 )
 
+var values_ILLUMINATOR_MODE = []ILLUMINATOR_MODE{
+	ILLUMINATOR_MODE_UNKNOWN,
+	ILLUMINATOR_MODE_INTERNAL_CONTROL,
+	ILLUMINATOR_MODE_EXTERNAL_SYNC,
+}
+
 var value_to_label_ILLUMINATOR_MODE = map[ILLUMINATOR_MODE]string{
 	ILLUMINATOR_MODE_UNKNOWN:          "ILLUMINATOR_MODE_UNKNOWN",
 	ILLUMINATOR_MODE_INTERNAL_CONTROL: "ILLUMINATOR_MODE_INTERNAL_CONTROL",
@@ -56,3 +62,14 @@ func (e ILLUMINATOR_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ILLUMINATOR_MODE) Values() []ILLUMINATOR_MODE {
+	return append([]ILLUMINATOR_MODE(nil), values_ILLUMINATOR_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ILLUMINATOR_MODE) IsValid() bool {
+	_, ok := value_to_label_ILLUMINATOR_MODE[e]
+	return ok
+}
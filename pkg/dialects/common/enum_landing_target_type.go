@@ -21,6 +21,13 @@ const (
 	LANDING_TARGET_TYPE_VISION_OTHER LANDING_TARGET_TYPE = 3
 )
 
+var values_LANDING_TARGET_TYPE = []LANDING_TARGET_TYPE{
+	LANDING_TARGET_TYPE_LIGHT_BEACON,
+	LANDING_TARGET_TYPE_RADIO_BEACON,
+	LANDING_TARGET_TYPE_VISION_FIDUCIAL,
+	LANDING_TARGET_TYPE_VISION_OTHER,
+}
+
 var value_to_label_LANDING_TARGET_TYPE = map[LANDING_TARGET_TYPE]string{
 	LANDING_TARGET_TYPE_LIGHT_BEACON:    "LANDING_TARGET_TYPE_LIGHT_BEACON",
 	LANDING_TARGET_TYPE_RADIO_BEACON:    "LANDING_TARGET_TYPE_RADIO_BEACON",
@@ -60,3 +67,14 @@ func (e LANDING_TARGET_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (LANDING_TARGET_TYPE) Values() []LANDING_TARGET_TYPE {
+	return append([]LANDING_TARGET_TYPE(nil), values_LANDING_TARGET_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e LANDING_TARGET_TYPE) IsValid() bool {
+	_, ok := value_to_label_LANDING_TARGET_TYPE[e]
+	return ok
+}
@@ -28,6 +28,20 @@ const (
 	MAG_CAL_FAILED_RESIDUALS_HIGH MAG_CAL_STATUS = 10
 )
 
+var values_MAG_CAL_STATUS = []MAG_CAL_STATUS{
+	MAG_CAL_NOT_STARTED,
+	MAG_CAL_WAITING_TO_START,
+	MAG_CAL_RUNNING_STEP_ONE,
+	MAG_CAL_RUNNING_STEP_TWO,
+	MAG_CAL_SUCCESS,
+	MAG_CAL_FAILED,
+	MAG_CAL_FAILED_ORIENTATION,
+	MAG_CAL_FAILED_RADIUS,
+	MAG_CAL_FAILED_OFFSETS,
+	MAG_CAL_FAILED_DIAG_SCALING,
+	MAG_CAL_FAILED_RESIDUALS_HIGH,
+}
+
 var value_to_label_MAG_CAL_STATUS = map[MAG_CAL_STATUS]string{
 	MAG_CAL_NOT_STARTED:           "MAG_CAL_NOT_STARTED",
 	MAG_CAL_WAITING_TO_START:      "MAG_CAL_WAITING_TO_START",
@@ -81,3 +95,14 @@ func (e MAG_CAL_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAG_CAL_STATUS) Values() []MAG_CAL_STATUS {
+	return append([]MAG_CAL_STATUS(nil), values_MAG_CAL_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAG_CAL_STATUS) IsValid() bool {
+	_, ok := value_to_label_MAG_CAL_STATUS[e]
+	return ok
+}
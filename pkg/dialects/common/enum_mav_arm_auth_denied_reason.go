@@ -25,6 +25,15 @@ const (
 	MAV_ARM_AUTH_DENIED_REASON_BAD_WEATHER MAV_ARM_AUTH_DENIED_REASON = 5
 )
 
+var values_MAV_ARM_AUTH_DENIED_REASON = []MAV_ARM_AUTH_DENIED_REASON{
+	MAV_ARM_AUTH_DENIED_REASON_GENERIC,
+	MAV_ARM_AUTH_DENIED_REASON_NONE,
+	MAV_ARM_AUTH_DENIED_REASON_INVALID_WAYPOINT,
+	MAV_ARM_AUTH_DENIED_REASON_TIMEOUT,
+	MAV_ARM_AUTH_DENIED_REASON_AIRSPACE_IN_USE,
+	MAV_ARM_AUTH_DENIED_REASON_BAD_WEATHER,
+}
+
 var value_to_label_MAV_ARM_AUTH_DENIED_REASON = map[MAV_ARM_AUTH_DENIED_REASON]string{
 	MAV_ARM_AUTH_DENIED_REASON_GENERIC:          "MAV_ARM_AUTH_DENIED_REASON_GENERIC",
 	MAV_ARM_AUTH_DENIED_REASON_NONE:             "MAV_ARM_AUTH_DENIED_REASON_NONE",
@@ -68,3 +77,14 @@ func (e MAV_ARM_AUTH_DENIED_REASON) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ARM_AUTH_DENIED_REASON) Values() []MAV_ARM_AUTH_DENIED_REASON {
+	return append([]MAV_ARM_AUTH_DENIED_REASON(nil), values_MAV_ARM_AUTH_DENIED_REASON...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ARM_AUTH_DENIED_REASON) IsValid() bool {
+	_, ok := value_to_label_MAV_ARM_AUTH_DENIED_REASON[e]
+	return ok
+}
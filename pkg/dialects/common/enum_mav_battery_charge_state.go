@@ -29,6 +29,17 @@ const (
 	MAV_BATTERY_CHARGE_STATE_CHARGING MAV_BATTERY_CHARGE_STATE = 7
 )
 
+var values_MAV_BATTERY_CHARGE_STATE = []MAV_BATTERY_CHARGE_STATE{
+	MAV_BATTERY_CHARGE_STATE_UNDEFINED,
+	MAV_BATTERY_CHARGE_STATE_OK,
+	MAV_BATTERY_CHARGE_STATE_LOW,
+	MAV_BATTERY_CHARGE_STATE_CRITICAL,
+	MAV_BATTERY_CHARGE_STATE_EMERGENCY,
+	MAV_BATTERY_CHARGE_STATE_FAILED,
+	MAV_BATTERY_CHARGE_STATE_UNHEALTHY,
+	MAV_BATTERY_CHARGE_STATE_CHARGING,
+}
+
 var value_to_label_MAV_BATTERY_CHARGE_STATE = map[MAV_BATTERY_CHARGE_STATE]string{
 	MAV_BATTERY_CHARGE_STATE_UNDEFINED: "MAV_BATTERY_CHARGE_STATE_UNDEFINED",
 	MAV_BATTERY_CHARGE_STATE_OK:        "MAV_BATTERY_CHARGE_STATE_OK",
@@ -76,3 +87,14 @@ func (e MAV_BATTERY_CHARGE_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BATTERY_CHARGE_STATE) Values() []MAV_BATTERY_CHARGE_STATE {
+	return append([]MAV_BATTERY_CHARGE_STATE(nil), values_MAV_BATTERY_CHARGE_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_BATTERY_CHARGE_STATE) IsValid() bool {
+	_, ok := value_to_label_MAV_BATTERY_CHARGE_STATE[e]
+	return ok
+}
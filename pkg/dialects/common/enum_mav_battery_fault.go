@@ -104,3 +104,33 @@ func (e MAV_BATTERY_FAULT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BATTERY_FAULT) Values() []MAV_BATTERY_FAULT {
+	return append([]MAV_BATTERY_FAULT(nil), values_MAV_BATTERY_FAULT...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_BATTERY_FAULT) IsValid() bool {
+	var mask MAV_BATTERY_FAULT
+	for _, val := range values_MAV_BATTERY_FAULT {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_BATTERY_FAULT) Flags() []MAV_BATTERY_FAULT {
+	var flags []MAV_BATTERY_FAULT
+	for _, val := range values_MAV_BATTERY_FAULT {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_BATTERY_FAULT) Has(flags MAV_BATTERY_FAULT) bool {
+	return e&flags == flags
+}
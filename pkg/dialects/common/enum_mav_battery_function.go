@@ -23,6 +23,14 @@ const (
 	MAV_BATTERY_FUNCTION_PAYLOAD MAV_BATTERY_FUNCTION = 4
 )
 
+var values_MAV_BATTERY_FUNCTION = []MAV_BATTERY_FUNCTION{
+	MAV_BATTERY_FUNCTION_UNKNOWN,
+	MAV_BATTERY_FUNCTION_ALL,
+	MAV_BATTERY_FUNCTION_PROPULSION,
+	MAV_BATTERY_FUNCTION_AVIONICS,
+	MAV_BATTERY_FUNCTION_PAYLOAD,
+}
+
 var value_to_label_MAV_BATTERY_FUNCTION = map[MAV_BATTERY_FUNCTION]string{
 	MAV_BATTERY_FUNCTION_UNKNOWN:    "MAV_BATTERY_FUNCTION_UNKNOWN",
 	MAV_BATTERY_FUNCTION_ALL:        "MAV_BATTERY_FUNCTION_ALL",
@@ -64,3 +72,14 @@ func (e MAV_BATTERY_FUNCTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BATTERY_FUNCTION) Values() []MAV_BATTERY_FUNCTION {
+	return append([]MAV_BATTERY_FUNCTION(nil), values_MAV_BATTERY_FUNCTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_BATTERY_FUNCTION) IsValid() bool {
+	_, ok := value_to_label_MAV_BATTERY_FUNCTION[e]
+	return ok
+}
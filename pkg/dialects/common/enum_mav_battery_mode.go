@@ -19,6 +19,12 @@ const (
 	MAV_BATTERY_MODE_HOT_SWAP MAV_BATTERY_MODE = 2
 )
 
+var values_MAV_BATTERY_MODE = []MAV_BATTERY_MODE{
+	MAV_BATTERY_MODE_UNKNOWN,
+	MAV_BATTERY_MODE_AUTO_DISCHARGING,
+	MAV_BATTERY_MODE_HOT_SWAP,
+}
+
 var value_to_label_MAV_BATTERY_MODE = map[MAV_BATTERY_MODE]string{
 	MAV_BATTERY_MODE_UNKNOWN:          "MAV_BATTERY_MODE_UNKNOWN",
 	MAV_BATTERY_MODE_AUTO_DISCHARGING: "MAV_BATTERY_MODE_AUTO_DISCHARGING",
@@ -56,3 +62,14 @@ func (e MAV_BATTERY_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BATTERY_MODE) Values() []MAV_BATTERY_MODE {
+	return append([]MAV_BATTERY_MODE(nil), values_MAV_BATTERY_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_BATTERY_MODE) IsValid() bool {
+	_, ok := value_to_label_MAV_BATTERY_MODE[e]
+	return ok
+}
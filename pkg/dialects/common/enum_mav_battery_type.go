@@ -23,6 +23,14 @@ const (
 	MAV_BATTERY_TYPE_NIMH MAV_BATTERY_TYPE = 4
 )
 
+var values_MAV_BATTERY_TYPE = []MAV_BATTERY_TYPE{
+	MAV_BATTERY_TYPE_UNKNOWN,
+	MAV_BATTERY_TYPE_LIPO,
+	MAV_BATTERY_TYPE_LIFE,
+	MAV_BATTERY_TYPE_LION,
+	MAV_BATTERY_TYPE_NIMH,
+}
+
 var value_to_label_MAV_BATTERY_TYPE = map[MAV_BATTERY_TYPE]string{
 	MAV_BATTERY_TYPE_UNKNOWN: "MAV_BATTERY_TYPE_UNKNOWN",
 	MAV_BATTERY_TYPE_LIPO:    "MAV_BATTERY_TYPE_LIPO",
@@ -64,3 +72,14 @@ func (e MAV_BATTERY_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BATTERY_TYPE) Values() []MAV_BATTERY_TYPE {
+	return append([]MAV_BATTERY_TYPE(nil), values_MAV_BATTERY_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_BATTERY_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_BATTERY_TYPE[e]
+	return ok
+}
@@ -27,6 +27,16 @@ const (
 	MAV_COLLISION_ACTION_HOVER MAV_COLLISION_ACTION = 6
 )
 
+var values_MAV_COLLISION_ACTION = []MAV_COLLISION_ACTION{
+	MAV_COLLISION_ACTION_NONE,
+	MAV_COLLISION_ACTION_REPORT,
+	MAV_COLLISION_ACTION_ASCEND_OR_DESCEND,
+	MAV_COLLISION_ACTION_MOVE_HORIZONTALLY,
+	MAV_COLLISION_ACTION_MOVE_PERPENDICULAR,
+	MAV_COLLISION_ACTION_RTL,
+	MAV_COLLISION_ACTION_HOVER,
+}
+
 var value_to_label_MAV_COLLISION_ACTION = map[MAV_COLLISION_ACTION]string{
 	MAV_COLLISION_ACTION_NONE:               "MAV_COLLISION_ACTION_NONE",
 	MAV_COLLISION_ACTION_REPORT:             "MAV_COLLISION_ACTION_REPORT",
@@ -72,3 +82,14 @@ func (e MAV_COLLISION_ACTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_COLLISION_ACTION) Values() []MAV_COLLISION_ACTION {
+	return append([]MAV_COLLISION_ACTION(nil), values_MAV_COLLISION_ACTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_COLLISION_ACTION) IsValid() bool {
+	_, ok := value_to_label_MAV_COLLISION_ACTION[e]
+	return ok
+}
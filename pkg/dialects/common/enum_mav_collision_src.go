@@ -17,6 +17,11 @@ const (
 	MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT MAV_COLLISION_SRC = 1
 )
 
+var values_MAV_COLLISION_SRC = []MAV_COLLISION_SRC{
+	MAV_COLLISION_SRC_ADSB,
+	MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT,
+}
+
 var value_to_label_MAV_COLLISION_SRC = map[MAV_COLLISION_SRC]string{
 	MAV_COLLISION_SRC_ADSB:                   "MAV_COLLISION_SRC_ADSB",
 	MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT: "MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT",
@@ -52,3 +57,14 @@ func (e MAV_COLLISION_SRC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_COLLISION_SRC) Values() []MAV_COLLISION_SRC {
+	return append([]MAV_COLLISION_SRC(nil), values_MAV_COLLISION_SRC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_COLLISION_SRC) IsValid() bool {
+	_, ok := value_to_label_MAV_COLLISION_SRC[e]
+	return ok
+}
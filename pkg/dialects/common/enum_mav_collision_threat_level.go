@@ -19,6 +19,12 @@ const (
 	MAV_COLLISION_THREAT_LEVEL_HIGH MAV_COLLISION_THREAT_LEVEL = 2
 )
 
+var values_MAV_COLLISION_THREAT_LEVEL = []MAV_COLLISION_THREAT_LEVEL{
+	MAV_COLLISION_THREAT_LEVEL_NONE,
+	MAV_COLLISION_THREAT_LEVEL_LOW,
+	MAV_COLLISION_THREAT_LEVEL_HIGH,
+}
+
 var value_to_label_MAV_COLLISION_THREAT_LEVEL = map[MAV_COLLISION_THREAT_LEVEL]string{
 	MAV_COLLISION_THREAT_LEVEL_NONE: "MAV_COLLISION_THREAT_LEVEL_NONE",
 	MAV_COLLISION_THREAT_LEVEL_LOW:  "MAV_COLLISION_THREAT_LEVEL_LOW",
@@ -56,3 +62,14 @@ func (e MAV_COLLISION_THREAT_LEVEL) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_COLLISION_THREAT_LEVEL) Values() []MAV_COLLISION_THREAT_LEVEL {
+	return append([]MAV_COLLISION_THREAT_LEVEL(nil), values_MAV_COLLISION_THREAT_LEVEL...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_COLLISION_THREAT_LEVEL) IsValid() bool {
+	_, ok := value_to_label_MAV_COLLISION_THREAT_LEVEL[e]
+	return ok
+}
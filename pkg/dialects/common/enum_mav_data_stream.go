@@ -33,6 +33,18 @@ const (
 	MAV_DATA_STREAM_EXTRA3 MAV_DATA_STREAM = 12
 )
 
+var values_MAV_DATA_STREAM = []MAV_DATA_STREAM{
+	MAV_DATA_STREAM_ALL,
+	MAV_DATA_STREAM_RAW_SENSORS,
+	MAV_DATA_STREAM_EXTENDED_STATUS,
+	MAV_DATA_STREAM_RC_CHANNELS,
+	MAV_DATA_STREAM_RAW_CONTROLLER,
+	MAV_DATA_STREAM_POSITION,
+	MAV_DATA_STREAM_EXTRA1,
+	MAV_DATA_STREAM_EXTRA2,
+	MAV_DATA_STREAM_EXTRA3,
+}
+
 var value_to_label_MAV_DATA_STREAM = map[MAV_DATA_STREAM]string{
 	MAV_DATA_STREAM_ALL:             "MAV_DATA_STREAM_ALL",
 	MAV_DATA_STREAM_RAW_SENSORS:     "MAV_DATA_STREAM_RAW_SENSORS",
@@ -82,3 +94,14 @@ func (e MAV_DATA_STREAM) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_DATA_STREAM) Values() []MAV_DATA_STREAM {
+	return append([]MAV_DATA_STREAM(nil), values_MAV_DATA_STREAM...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_DATA_STREAM) IsValid() bool {
+	_, ok := value_to_label_MAV_DATA_STREAM[e]
+	return ok
+}
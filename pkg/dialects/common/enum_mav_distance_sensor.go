@@ -23,6 +23,14 @@ const (
 	MAV_DISTANCE_SENSOR_UNKNOWN MAV_DISTANCE_SENSOR = 4
 )
 
+var values_MAV_DISTANCE_SENSOR = []MAV_DISTANCE_SENSOR{
+	MAV_DISTANCE_SENSOR_LASER,
+	MAV_DISTANCE_SENSOR_ULTRASOUND,
+	MAV_DISTANCE_SENSOR_INFRARED,
+	MAV_DISTANCE_SENSOR_RADAR,
+	MAV_DISTANCE_SENSOR_UNKNOWN,
+}
+
 var value_to_label_MAV_DISTANCE_SENSOR = map[MAV_DISTANCE_SENSOR]string{
 	MAV_DISTANCE_SENSOR_LASER:      "MAV_DISTANCE_SENSOR_LASER",
 	MAV_DISTANCE_SENSOR_ULTRASOUND: "MAV_DISTANCE_SENSOR_ULTRASOUND",
@@ -64,3 +72,14 @@ func (e MAV_DISTANCE_SENSOR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_DISTANCE_SENSOR) Values() []MAV_DISTANCE_SENSOR {
+	return append([]MAV_DISTANCE_SENSOR(nil), values_MAV_DISTANCE_SENSOR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_DISTANCE_SENSOR) IsValid() bool {
+	_, ok := value_to_label_MAV_DISTANCE_SENSOR[e]
+	return ok
+}
@@ -69,3 +69,33 @@ func (e MAV_DO_REPOSITION_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_DO_REPOSITION_FLAGS) Values() []MAV_DO_REPOSITION_FLAGS {
+	return append([]MAV_DO_REPOSITION_FLAGS(nil), values_MAV_DO_REPOSITION_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_DO_REPOSITION_FLAGS) IsValid() bool {
+	var mask MAV_DO_REPOSITION_FLAGS
+	for _, val := range values_MAV_DO_REPOSITION_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_DO_REPOSITION_FLAGS) Flags() []MAV_DO_REPOSITION_FLAGS {
+	var flags []MAV_DO_REPOSITION_FLAGS
+	for _, val := range values_MAV_DO_REPOSITION_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_DO_REPOSITION_FLAGS) Has(flags MAV_DO_REPOSITION_FLAGS) bool {
+	return e&flags == flags
+}
@@ -31,6 +31,18 @@ const (
 	MAV_ESTIMATOR_TYPE_AUTOPILOT MAV_ESTIMATOR_TYPE = 8
 )
 
+var values_MAV_ESTIMATOR_TYPE = []MAV_ESTIMATOR_TYPE{
+	MAV_ESTIMATOR_TYPE_UNKNOWN,
+	MAV_ESTIMATOR_TYPE_NAIVE,
+	MAV_ESTIMATOR_TYPE_VISION,
+	MAV_ESTIMATOR_TYPE_VIO,
+	MAV_ESTIMATOR_TYPE_GPS,
+	MAV_ESTIMATOR_TYPE_GPS_INS,
+	MAV_ESTIMATOR_TYPE_MOCAP,
+	MAV_ESTIMATOR_TYPE_LIDAR,
+	MAV_ESTIMATOR_TYPE_AUTOPILOT,
+}
+
 var value_to_label_MAV_ESTIMATOR_TYPE = map[MAV_ESTIMATOR_TYPE]string{
 	MAV_ESTIMATOR_TYPE_UNKNOWN:   "MAV_ESTIMATOR_TYPE_UNKNOWN",
 	MAV_ESTIMATOR_TYPE_NAIVE:     "MAV_ESTIMATOR_TYPE_NAIVE",
@@ -80,3 +92,14 @@ func (e MAV_ESTIMATOR_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ESTIMATOR_TYPE) Values() []MAV_ESTIMATOR_TYPE {
+	return append([]MAV_ESTIMATOR_TYPE(nil), values_MAV_ESTIMATOR_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ESTIMATOR_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ESTIMATOR_TYPE[e]
+	return ok
+}
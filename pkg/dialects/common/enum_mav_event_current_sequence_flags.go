@@ -64,3 +64,33 @@ func (e MAV_EVENT_CURRENT_SEQUENCE_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_EVENT_CURRENT_SEQUENCE_FLAGS) Values() []MAV_EVENT_CURRENT_SEQUENCE_FLAGS {
+	return append([]MAV_EVENT_CURRENT_SEQUENCE_FLAGS(nil), values_MAV_EVENT_CURRENT_SEQUENCE_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_EVENT_CURRENT_SEQUENCE_FLAGS) IsValid() bool {
+	var mask MAV_EVENT_CURRENT_SEQUENCE_FLAGS
+	for _, val := range values_MAV_EVENT_CURRENT_SEQUENCE_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_EVENT_CURRENT_SEQUENCE_FLAGS) Flags() []MAV_EVENT_CURRENT_SEQUENCE_FLAGS {
+	var flags []MAV_EVENT_CURRENT_SEQUENCE_FLAGS
+	for _, val := range values_MAV_EVENT_CURRENT_SEQUENCE_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_EVENT_CURRENT_SEQUENCE_FLAGS) Has(flags MAV_EVENT_CURRENT_SEQUENCE_FLAGS) bool {
+	return e&flags == flags
+}
@@ -15,6 +15,10 @@ const (
 	MAV_EVENT_ERROR_REASON_UNAVAILABLE MAV_EVENT_ERROR_REASON = 0
 )
 
+var values_MAV_EVENT_ERROR_REASON = []MAV_EVENT_ERROR_REASON{
+	MAV_EVENT_ERROR_REASON_UNAVAILABLE,
+}
+
 var value_to_label_MAV_EVENT_ERROR_REASON = map[MAV_EVENT_ERROR_REASON]string{
 	MAV_EVENT_ERROR_REASON_UNAVAILABLE: "MAV_EVENT_ERROR_REASON_UNAVAILABLE",
 }
@@ -48,3 +52,14 @@ func (e MAV_EVENT_ERROR_REASON) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_EVENT_ERROR_REASON) Values() []MAV_EVENT_ERROR_REASON {
+	return append([]MAV_EVENT_ERROR_REASON(nil), values_MAV_EVENT_ERROR_REASON...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_EVENT_ERROR_REASON) IsValid() bool {
+	_, ok := value_to_label_MAV_EVENT_ERROR_REASON[e]
+	return ok
+}
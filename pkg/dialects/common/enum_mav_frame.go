@@ -68,6 +68,31 @@ const (
 	MAV_FRAME_LOCAL_FLU MAV_FRAME = 21
 )
 
+var values_MAV_FRAME = []MAV_FRAME{
+	MAV_FRAME_GLOBAL,
+	MAV_FRAME_LOCAL_NED,
+	MAV_FRAME_MISSION,
+	MAV_FRAME_GLOBAL_RELATIVE_ALT,
+	MAV_FRAME_LOCAL_ENU,
+	MAV_FRAME_GLOBAL_INT,
+	MAV_FRAME_GLOBAL_RELATIVE_ALT_INT,
+	MAV_FRAME_LOCAL_OFFSET_NED,
+	MAV_FRAME_BODY_NED,
+	MAV_FRAME_BODY_OFFSET_NED,
+	MAV_FRAME_GLOBAL_TERRAIN_ALT,
+	MAV_FRAME_GLOBAL_TERRAIN_ALT_INT,
+	MAV_FRAME_BODY_FRD,
+	MAV_FRAME_RESERVED_13,
+	MAV_FRAME_RESERVED_14,
+	MAV_FRAME_RESERVED_15,
+	MAV_FRAME_RESERVED_16,
+	MAV_FRAME_RESERVED_17,
+	MAV_FRAME_RESERVED_18,
+	MAV_FRAME_RESERVED_19,
+	MAV_FRAME_LOCAL_FRD,
+	MAV_FRAME_LOCAL_FLU,
+}
+
 var value_to_label_MAV_FRAME = map[MAV_FRAME]string{
 	MAV_FRAME_GLOBAL:                  "MAV_FRAME_GLOBAL",
 	MAV_FRAME_LOCAL_NED:               "MAV_FRAME_LOCAL_NED",
@@ -143,3 +168,14 @@ func (e MAV_FRAME) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_FRAME) Values() []MAV_FRAME {
+	return append([]MAV_FRAME(nil), values_MAV_FRAME...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_FRAME) IsValid() bool {
+	_, ok := value_to_label_MAV_FRAME[e]
+	return ok
+}
@@ -36,6 +36,20 @@ const (
 	MAV_FTP_ERR_FILENOTFOUND MAV_FTP_ERR = 10
 )
 
+var values_MAV_FTP_ERR = []MAV_FTP_ERR{
+	MAV_FTP_ERR_NONE,
+	MAV_FTP_ERR_FAIL,
+	MAV_FTP_ERR_FAILERRNO,
+	MAV_FTP_ERR_INVALIDDATASIZE,
+	MAV_FTP_ERR_INVALIDSESSION,
+	MAV_FTP_ERR_NOSESSIONSAVAILABLE,
+	MAV_FTP_ERR_EOF,
+	MAV_FTP_ERR_UNKNOWNCOMMAND,
+	MAV_FTP_ERR_FILEEXISTS,
+	MAV_FTP_ERR_FILEPROTECTED,
+	MAV_FTP_ERR_FILENOTFOUND,
+}
+
 var value_to_label_MAV_FTP_ERR = map[MAV_FTP_ERR]string{
 	MAV_FTP_ERR_NONE:                "MAV_FTP_ERR_NONE",
 	MAV_FTP_ERR_FAIL:                "MAV_FTP_ERR_FAIL",
@@ -89,3 +103,14 @@ func (e MAV_FTP_ERR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_FTP_ERR) Values() []MAV_FTP_ERR {
+	return append([]MAV_FTP_ERR(nil), values_MAV_FTP_ERR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_FTP_ERR) IsValid() bool {
+	_, ok := value_to_label_MAV_FTP_ERR[e]
+	return ok
+}
@@ -51,6 +51,28 @@ const (
 	MAV_FTP_OPCODE_NAK MAV_FTP_OPCODE = 129
 )
 
+var values_MAV_FTP_OPCODE = []MAV_FTP_OPCODE{
+	MAV_FTP_OPCODE_NONE,
+	MAV_FTP_OPCODE_TERMINATESESSION,
+	MAV_FTP_OPCODE_RESETSESSION,
+	MAV_FTP_OPCODE_LISTDIRECTORY,
+	MAV_FTP_OPCODE_OPENFILERO,
+	MAV_FTP_OPCODE_READFILE,
+	MAV_FTP_OPCODE_CREATEFILE,
+	MAV_FTP_OPCODE_WRITEFILE,
+	MAV_FTP_OPCODE_REMOVEFILE,
+	MAV_FTP_OPCODE_CREATEDIRECTORY,
+	MAV_FTP_OPCODE_REMOVEDIRECTORY,
+	MAV_FTP_OPCODE_OPENFILEWO,
+	MAV_FTP_OPCODE_TRUNCATEFILE,
+	MAV_FTP_OPCODE_RENAME,
+	MAV_FTP_OPCODE_CALCFILECRC,
+	MAV_FTP_OPCODE_BURSTREADFILE,
+	MAV_FTP_OPCODE_LISTDIRECTORYWITHTIME,
+	MAV_FTP_OPCODE_ACK,
+	MAV_FTP_OPCODE_NAK,
+}
+
 var value_to_label_MAV_FTP_OPCODE = map[MAV_FTP_OPCODE]string{
 	MAV_FTP_OPCODE_NONE:                  "MAV_FTP_OPCODE_NONE",
 	MAV_FTP_OPCODE_TERMINATESESSION:      "MAV_FTP_OPCODE_TERMINATESESSION",
@@ -120,3 +142,14 @@ func (e MAV_FTP_OPCODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_FTP_OPCODE) Values() []MAV_FTP_OPCODE {
+	return append([]MAV_FTP_OPCODE(nil), values_MAV_FTP_OPCODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_FTP_OPCODE) IsValid() bool {
+	_, ok := value_to_label_MAV_FTP_OPCODE[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	MAV_FUEL_TYPE_GAS MAV_FUEL_TYPE = 2
 )
 
+var values_MAV_FUEL_TYPE = []MAV_FUEL_TYPE{
+	MAV_FUEL_TYPE_UNKNOWN,
+	MAV_FUEL_TYPE_LIQUID,
+	MAV_FUEL_TYPE_GAS,
+}
+
 var value_to_label_MAV_FUEL_TYPE = map[MAV_FUEL_TYPE]string{
 	MAV_FUEL_TYPE_UNKNOWN: "MAV_FUEL_TYPE_UNKNOWN",
 	MAV_FUEL_TYPE_LIQUID:  "MAV_FUEL_TYPE_LIQUID",
@@ -56,3 +62,14 @@ func (e MAV_FUEL_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_FUEL_TYPE) Values() []MAV_FUEL_TYPE {
+	return append([]MAV_FUEL_TYPE(nil), values_MAV_FUEL_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_FUEL_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_FUEL_TYPE[e]
+	return ok
+}
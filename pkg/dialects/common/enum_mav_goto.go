@@ -21,6 +21,13 @@ const (
 	MAV_GOTO_HOLD_AT_SPECIFIED_POSITION MAV_GOTO = 3
 )
 
+var values_MAV_GOTO = []MAV_GOTO{
+	MAV_GOTO_DO_HOLD,
+	MAV_GOTO_DO_CONTINUE,
+	MAV_GOTO_HOLD_AT_CURRENT_POSITION,
+	MAV_GOTO_HOLD_AT_SPECIFIED_POSITION,
+}
+
 var value_to_label_MAV_GOTO = map[MAV_GOTO]string{
 	MAV_GOTO_DO_HOLD:                    "MAV_GOTO_DO_HOLD",
 	MAV_GOTO_DO_CONTINUE:                "MAV_GOTO_DO_CONTINUE",
@@ -60,3 +67,14 @@ func (e MAV_GOTO) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_GOTO) Values() []MAV_GOTO {
+	return append([]MAV_GOTO(nil), values_MAV_GOTO...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_GOTO) IsValid() bool {
+	_, ok := value_to_label_MAV_GOTO[e]
+	return ok
+}
@@ -23,6 +23,14 @@ const (
 	MAV_LANDED_STATE_LANDING MAV_LANDED_STATE = 4
 )
 
+var values_MAV_LANDED_STATE = []MAV_LANDED_STATE{
+	MAV_LANDED_STATE_UNDEFINED,
+	MAV_LANDED_STATE_ON_GROUND,
+	MAV_LANDED_STATE_IN_AIR,
+	MAV_LANDED_STATE_TAKEOFF,
+	MAV_LANDED_STATE_LANDING,
+}
+
 var value_to_label_MAV_LANDED_STATE = map[MAV_LANDED_STATE]string{
 	MAV_LANDED_STATE_UNDEFINED: "MAV_LANDED_STATE_UNDEFINED",
 	MAV_LANDED_STATE_ON_GROUND: "MAV_LANDED_STATE_ON_GROUND",
@@ -64,3 +72,14 @@ func (e MAV_LANDED_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_LANDED_STATE) Values() []MAV_LANDED_STATE {
+	return append([]MAV_LANDED_STATE(nil), values_MAV_LANDED_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_LANDED_STATE) IsValid() bool {
+	_, ok := value_to_label_MAV_LANDED_STATE[e]
+	return ok
+}
@@ -45,6 +45,25 @@ const (
 	MAV_MISSION_OPERATION_CANCELLED MAV_MISSION_RESULT = 15
 )
 
+var values_MAV_MISSION_RESULT = []MAV_MISSION_RESULT{
+	MAV_MISSION_ACCEPTED,
+	MAV_MISSION_ERROR,
+	MAV_MISSION_UNSUPPORTED_FRAME,
+	MAV_MISSION_UNSUPPORTED,
+	MAV_MISSION_NO_SPACE,
+	MAV_MISSION_INVALID,
+	MAV_MISSION_INVALID_PARAM1,
+	MAV_MISSION_INVALID_PARAM2,
+	MAV_MISSION_INVALID_PARAM3,
+	MAV_MISSION_INVALID_PARAM4,
+	MAV_MISSION_INVALID_PARAM5_X,
+	MAV_MISSION_INVALID_PARAM6_Y,
+	MAV_MISSION_INVALID_PARAM7,
+	MAV_MISSION_INVALID_SEQUENCE,
+	MAV_MISSION_DENIED,
+	MAV_MISSION_OPERATION_CANCELLED,
+}
+
 var value_to_label_MAV_MISSION_RESULT = map[MAV_MISSION_RESULT]string{
 	MAV_MISSION_ACCEPTED:            "MAV_MISSION_ACCEPTED",
 	MAV_MISSION_ERROR:               "MAV_MISSION_ERROR",
@@ -108,3 +127,14 @@ func (e MAV_MISSION_RESULT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MISSION_RESULT) Values() []MAV_MISSION_RESULT {
+	return append([]MAV_MISSION_RESULT(nil), values_MAV_MISSION_RESULT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MISSION_RESULT) IsValid() bool {
+	_, ok := value_to_label_MAV_MISSION_RESULT[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	MAV_MISSION_TYPE_ALL MAV_MISSION_TYPE = 255
 )
 
+var values_MAV_MISSION_TYPE = []MAV_MISSION_TYPE{
+	MAV_MISSION_TYPE_MISSION,
+	MAV_MISSION_TYPE_FENCE,
+	MAV_MISSION_TYPE_RALLY,
+	MAV_MISSION_TYPE_ALL,
+}
+
 var value_to_label_MAV_MISSION_TYPE = map[MAV_MISSION_TYPE]string{
 	MAV_MISSION_TYPE_MISSION: "MAV_MISSION_TYPE_MISSION",
 	MAV_MISSION_TYPE_FENCE:   "MAV_MISSION_TYPE_FENCE",
@@ -60,3 +67,14 @@ func (e MAV_MISSION_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MISSION_TYPE) Values() []MAV_MISSION_TYPE {
+	return append([]MAV_MISSION_TYPE(nil), values_MAV_MISSION_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MISSION_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_MISSION_TYPE[e]
+	return ok
+}
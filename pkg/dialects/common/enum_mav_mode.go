@@ -35,6 +35,20 @@ const (
 	MAV_MODE_TEST_ARMED MAV_MODE = 194
 )
 
+var values_MAV_MODE = []MAV_MODE{
+	MAV_MODE_PREFLIGHT,
+	MAV_MODE_STABILIZE_DISARMED,
+	MAV_MODE_STABILIZE_ARMED,
+	MAV_MODE_MANUAL_DISARMED,
+	MAV_MODE_MANUAL_ARMED,
+	MAV_MODE_GUIDED_DISARMED,
+	MAV_MODE_GUIDED_ARMED,
+	MAV_MODE_AUTO_DISARMED,
+	MAV_MODE_AUTO_ARMED,
+	MAV_MODE_TEST_DISARMED,
+	MAV_MODE_TEST_ARMED,
+}
+
 var value_to_label_MAV_MODE = map[MAV_MODE]string{
 	MAV_MODE_PREFLIGHT:          "MAV_MODE_PREFLIGHT",
 	MAV_MODE_STABILIZE_DISARMED: "MAV_MODE_STABILIZE_DISARMED",
@@ -88,3 +102,14 @@ func (e MAV_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MODE) Values() []MAV_MODE {
+	return append([]MAV_MODE(nil), values_MAV_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MODE) IsValid() bool {
+	_, ok := value_to_label_MAV_MODE[e]
+	return ok
+}
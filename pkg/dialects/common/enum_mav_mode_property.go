@@ -78,3 +78,33 @@ func (e MAV_MODE_PROPERTY) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MODE_PROPERTY) Values() []MAV_MODE_PROPERTY {
+	return append([]MAV_MODE_PROPERTY(nil), values_MAV_MODE_PROPERTY...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_MODE_PROPERTY) IsValid() bool {
+	var mask MAV_MODE_PROPERTY
+	for _, val := range values_MAV_MODE_PROPERTY {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_MODE_PROPERTY) Flags() []MAV_MODE_PROPERTY {
+	var flags []MAV_MODE_PROPERTY
+	for _, val := range values_MAV_MODE_PROPERTY {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_MODE_PROPERTY) Has(flags MAV_MODE_PROPERTY) bool {
+	return e&flags == flags
+}
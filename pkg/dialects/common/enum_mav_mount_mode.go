@@ -29,6 +29,17 @@ const (
 	MAV_MOUNT_MODE_WPNEXT_OFFSET MAV_MOUNT_MODE = 7
 )
 
+var values_MAV_MOUNT_MODE = []MAV_MOUNT_MODE{
+	MAV_MOUNT_MODE_RETRACT,
+	MAV_MOUNT_MODE_NEUTRAL,
+	MAV_MOUNT_MODE_MAVLINK_TARGETING,
+	MAV_MOUNT_MODE_RC_TARGETING,
+	MAV_MOUNT_MODE_GPS_POINT,
+	MAV_MOUNT_MODE_SYSID_TARGET,
+	MAV_MOUNT_MODE_HOME_LOCATION,
+	MAV_MOUNT_MODE_WPNEXT_OFFSET,
+}
+
 var value_to_label_MAV_MOUNT_MODE = map[MAV_MOUNT_MODE]string{
 	MAV_MOUNT_MODE_RETRACT:           "MAV_MOUNT_MODE_RETRACT",
 	MAV_MOUNT_MODE_NEUTRAL:           "MAV_MOUNT_MODE_NEUTRAL",
@@ -76,3 +87,14 @@ func (e MAV_MOUNT_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MOUNT_MODE) Values() []MAV_MOUNT_MODE {
+	return append([]MAV_MOUNT_MODE(nil), values_MAV_MOUNT_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MOUNT_MODE) IsValid() bool {
+	_, ok := value_to_label_MAV_MOUNT_MODE[e]
+	return ok
+}
@@ -16,6 +16,11 @@ const (
 	MAV_ODID_ARM_STATUS_PRE_ARM_FAIL_GENERIC MAV_ODID_ARM_STATUS = 1
 )
 
+var values_MAV_ODID_ARM_STATUS = []MAV_ODID_ARM_STATUS{
+	MAV_ODID_ARM_STATUS_GOOD_TO_ARM,
+	MAV_ODID_ARM_STATUS_PRE_ARM_FAIL_GENERIC,
+}
+
 var value_to_label_MAV_ODID_ARM_STATUS = map[MAV_ODID_ARM_STATUS]string{
 	MAV_ODID_ARM_STATUS_GOOD_TO_ARM:          "MAV_ODID_ARM_STATUS_GOOD_TO_ARM",
 	MAV_ODID_ARM_STATUS_PRE_ARM_FAIL_GENERIC: "MAV_ODID_ARM_STATUS_PRE_ARM_FAIL_GENERIC",
@@ -51,3 +56,14 @@ func (e MAV_ODID_ARM_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_ARM_STATUS) Values() []MAV_ODID_ARM_STATUS {
+	return append([]MAV_ODID_ARM_STATUS(nil), values_MAV_ODID_ARM_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_ARM_STATUS) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_ARM_STATUS[e]
+	return ok
+}
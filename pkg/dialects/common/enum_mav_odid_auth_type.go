@@ -24,6 +24,15 @@ const (
 	MAV_ODID_AUTH_TYPE_SPECIFIC_AUTHENTICATION MAV_ODID_AUTH_TYPE = 5
 )
 
+var values_MAV_ODID_AUTH_TYPE = []MAV_ODID_AUTH_TYPE{
+	MAV_ODID_AUTH_TYPE_NONE,
+	MAV_ODID_AUTH_TYPE_UAS_ID_SIGNATURE,
+	MAV_ODID_AUTH_TYPE_OPERATOR_ID_SIGNATURE,
+	MAV_ODID_AUTH_TYPE_MESSAGE_SET_SIGNATURE,
+	MAV_ODID_AUTH_TYPE_NETWORK_REMOTE_ID,
+	MAV_ODID_AUTH_TYPE_SPECIFIC_AUTHENTICATION,
+}
+
 var value_to_label_MAV_ODID_AUTH_TYPE = map[MAV_ODID_AUTH_TYPE]string{
 	MAV_ODID_AUTH_TYPE_NONE:                    "MAV_ODID_AUTH_TYPE_NONE",
 	MAV_ODID_AUTH_TYPE_UAS_ID_SIGNATURE:        "MAV_ODID_AUTH_TYPE_UAS_ID_SIGNATURE",
@@ -67,3 +76,14 @@ func (e MAV_ODID_AUTH_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_AUTH_TYPE) Values() []MAV_ODID_AUTH_TYPE {
+	return append([]MAV_ODID_AUTH_TYPE(nil), values_MAV_ODID_AUTH_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_AUTH_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_AUTH_TYPE[e]
+	return ok
+}
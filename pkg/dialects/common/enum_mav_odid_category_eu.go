@@ -20,6 +20,13 @@ const (
 	MAV_ODID_CATEGORY_EU_CERTIFIED MAV_ODID_CATEGORY_EU = 3
 )
 
+var values_MAV_ODID_CATEGORY_EU = []MAV_ODID_CATEGORY_EU{
+	MAV_ODID_CATEGORY_EU_UNDECLARED,
+	MAV_ODID_CATEGORY_EU_OPEN,
+	MAV_ODID_CATEGORY_EU_SPECIFIC,
+	MAV_ODID_CATEGORY_EU_CERTIFIED,
+}
+
 var value_to_label_MAV_ODID_CATEGORY_EU = map[MAV_ODID_CATEGORY_EU]string{
 	MAV_ODID_CATEGORY_EU_UNDECLARED: "MAV_ODID_CATEGORY_EU_UNDECLARED",
 	MAV_ODID_CATEGORY_EU_OPEN:       "MAV_ODID_CATEGORY_EU_OPEN",
@@ -59,3 +66,14 @@ func (e MAV_ODID_CATEGORY_EU) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_CATEGORY_EU) Values() []MAV_ODID_CATEGORY_EU {
+	return append([]MAV_ODID_CATEGORY_EU(nil), values_MAV_ODID_CATEGORY_EU...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_CATEGORY_EU) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_CATEGORY_EU[e]
+	return ok
+}
@@ -28,6 +28,17 @@ const (
 	MAV_ODID_CLASS_EU_CLASS_6 MAV_ODID_CLASS_EU = 7
 )
 
+var values_MAV_ODID_CLASS_EU = []MAV_ODID_CLASS_EU{
+	MAV_ODID_CLASS_EU_UNDECLARED,
+	MAV_ODID_CLASS_EU_CLASS_0,
+	MAV_ODID_CLASS_EU_CLASS_1,
+	MAV_ODID_CLASS_EU_CLASS_2,
+	MAV_ODID_CLASS_EU_CLASS_3,
+	MAV_ODID_CLASS_EU_CLASS_4,
+	MAV_ODID_CLASS_EU_CLASS_5,
+	MAV_ODID_CLASS_EU_CLASS_6,
+}
+
 var value_to_label_MAV_ODID_CLASS_EU = map[MAV_ODID_CLASS_EU]string{
 	MAV_ODID_CLASS_EU_UNDECLARED: "MAV_ODID_CLASS_EU_UNDECLARED",
 	MAV_ODID_CLASS_EU_CLASS_0:    "MAV_ODID_CLASS_EU_CLASS_0",
@@ -75,3 +86,14 @@ func (e MAV_ODID_CLASS_EU) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_CLASS_EU) Values() []MAV_ODID_CLASS_EU {
+	return append([]MAV_ODID_CLASS_EU(nil), values_MAV_ODID_CLASS_EU...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_CLASS_EU) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_CLASS_EU[e]
+	return ok
+}
@@ -16,6 +16,11 @@ const (
 	MAV_ODID_CLASSIFICATION_TYPE_EU MAV_ODID_CLASSIFICATION_TYPE = 1
 )
 
+var values_MAV_ODID_CLASSIFICATION_TYPE = []MAV_ODID_CLASSIFICATION_TYPE{
+	MAV_ODID_CLASSIFICATION_TYPE_UNDECLARED,
+	MAV_ODID_CLASSIFICATION_TYPE_EU,
+}
+
 var value_to_label_MAV_ODID_CLASSIFICATION_TYPE = map[MAV_ODID_CLASSIFICATION_TYPE]string{
 	MAV_ODID_CLASSIFICATION_TYPE_UNDECLARED: "MAV_ODID_CLASSIFICATION_TYPE_UNDECLARED",
 	MAV_ODID_CLASSIFICATION_TYPE_EU:         "MAV_ODID_CLASSIFICATION_TYPE_EU",
@@ -51,3 +56,14 @@ func (e MAV_ODID_CLASSIFICATION_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_CLASSIFICATION_TYPE) Values() []MAV_ODID_CLASSIFICATION_TYPE {
+	return append([]MAV_ODID_CLASSIFICATION_TYPE(nil), values_MAV_ODID_CLASSIFICATION_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_CLASSIFICATION_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_CLASSIFICATION_TYPE[e]
+	return ok
+}
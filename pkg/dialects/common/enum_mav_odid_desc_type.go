@@ -18,6 +18,12 @@ const (
 	MAV_ODID_DESC_TYPE_EXTENDED_STATUS MAV_ODID_DESC_TYPE = 2
 )
 
+var values_MAV_ODID_DESC_TYPE = []MAV_ODID_DESC_TYPE{
+	MAV_ODID_DESC_TYPE_TEXT,
+	MAV_ODID_DESC_TYPE_EMERGENCY,
+	MAV_ODID_DESC_TYPE_EXTENDED_STATUS,
+}
+
 var value_to_label_MAV_ODID_DESC_TYPE = map[MAV_ODID_DESC_TYPE]string{
 	MAV_ODID_DESC_TYPE_TEXT:            "MAV_ODID_DESC_TYPE_TEXT",
 	MAV_ODID_DESC_TYPE_EMERGENCY:       "MAV_ODID_DESC_TYPE_EMERGENCY",
@@ -55,3 +61,14 @@ func (e MAV_ODID_DESC_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_DESC_TYPE) Values() []MAV_ODID_DESC_TYPE {
+	return append([]MAV_ODID_DESC_TYPE(nil), values_MAV_ODID_DESC_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_DESC_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_DESC_TYPE[e]
+	return ok
+}
@@ -16,6 +16,11 @@ const (
 	MAV_ODID_HEIGHT_REF_OVER_GROUND MAV_ODID_HEIGHT_REF = 1
 )
 
+var values_MAV_ODID_HEIGHT_REF = []MAV_ODID_HEIGHT_REF{
+	MAV_ODID_HEIGHT_REF_OVER_TAKEOFF,
+	MAV_ODID_HEIGHT_REF_OVER_GROUND,
+}
+
 var value_to_label_MAV_ODID_HEIGHT_REF = map[MAV_ODID_HEIGHT_REF]string{
 	MAV_ODID_HEIGHT_REF_OVER_TAKEOFF: "MAV_ODID_HEIGHT_REF_OVER_TAKEOFF",
 	MAV_ODID_HEIGHT_REF_OVER_GROUND:  "MAV_ODID_HEIGHT_REF_OVER_GROUND",
@@ -51,3 +56,14 @@ func (e MAV_ODID_HEIGHT_REF) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_HEIGHT_REF) Values() []MAV_ODID_HEIGHT_REF {
+	return append([]MAV_ODID_HEIGHT_REF(nil), values_MAV_ODID_HEIGHT_REF...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_HEIGHT_REF) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_HEIGHT_REF[e]
+	return ok
+}
@@ -38,6 +38,22 @@ const (
 	MAV_ODID_HOR_ACC_1_METER MAV_ODID_HOR_ACC = 12
 )
 
+var values_MAV_ODID_HOR_ACC = []MAV_ODID_HOR_ACC{
+	MAV_ODID_HOR_ACC_UNKNOWN,
+	MAV_ODID_HOR_ACC_10NM,
+	MAV_ODID_HOR_ACC_4NM,
+	MAV_ODID_HOR_ACC_2NM,
+	MAV_ODID_HOR_ACC_1NM,
+	MAV_ODID_HOR_ACC_0_5NM,
+	MAV_ODID_HOR_ACC_0_3NM,
+	MAV_ODID_HOR_ACC_0_1NM,
+	MAV_ODID_HOR_ACC_0_05NM,
+	MAV_ODID_HOR_ACC_30_METER,
+	MAV_ODID_HOR_ACC_10_METER,
+	MAV_ODID_HOR_ACC_3_METER,
+	MAV_ODID_HOR_ACC_1_METER,
+}
+
 var value_to_label_MAV_ODID_HOR_ACC = map[MAV_ODID_HOR_ACC]string{
 	MAV_ODID_HOR_ACC_UNKNOWN:  "MAV_ODID_HOR_ACC_UNKNOWN",
 	MAV_ODID_HOR_ACC_10NM:     "MAV_ODID_HOR_ACC_10NM",
@@ -95,3 +111,14 @@ func (e MAV_ODID_HOR_ACC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_HOR_ACC) Values() []MAV_ODID_HOR_ACC {
+	return append([]MAV_ODID_HOR_ACC(nil), values_MAV_ODID_HOR_ACC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_HOR_ACC) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_HOR_ACC[e]
+	return ok
+}
@@ -22,6 +22,14 @@ const (
 	MAV_ODID_ID_TYPE_SPECIFIC_SESSION_ID MAV_ODID_ID_TYPE = 4
 )
 
+var values_MAV_ODID_ID_TYPE = []MAV_ODID_ID_TYPE{
+	MAV_ODID_ID_TYPE_NONE,
+	MAV_ODID_ID_TYPE_SERIAL_NUMBER,
+	MAV_ODID_ID_TYPE_CAA_REGISTRATION_ID,
+	MAV_ODID_ID_TYPE_UTM_ASSIGNED_UUID,
+	MAV_ODID_ID_TYPE_SPECIFIC_SESSION_ID,
+}
+
 var value_to_label_MAV_ODID_ID_TYPE = map[MAV_ODID_ID_TYPE]string{
 	MAV_ODID_ID_TYPE_NONE:                "MAV_ODID_ID_TYPE_NONE",
 	MAV_ODID_ID_TYPE_SERIAL_NUMBER:       "MAV_ODID_ID_TYPE_SERIAL_NUMBER",
@@ -63,3 +71,14 @@ func (e MAV_ODID_ID_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_ID_TYPE) Values() []MAV_ODID_ID_TYPE {
+	return append([]MAV_ODID_ID_TYPE(nil), values_MAV_ODID_ID_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_ID_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_ID_TYPE[e]
+	return ok
+}
@@ -14,6 +14,10 @@ const (
 	MAV_ODID_OPERATOR_ID_TYPE_CAA MAV_ODID_OPERATOR_ID_TYPE = 0
 )
 
+var values_MAV_ODID_OPERATOR_ID_TYPE = []MAV_ODID_OPERATOR_ID_TYPE{
+	MAV_ODID_OPERATOR_ID_TYPE_CAA,
+}
+
 var value_to_label_MAV_ODID_OPERATOR_ID_TYPE = map[MAV_ODID_OPERATOR_ID_TYPE]string{
 	MAV_ODID_OPERATOR_ID_TYPE_CAA: "MAV_ODID_OPERATOR_ID_TYPE_CAA",
 }
@@ -47,3 +51,14 @@ func (e MAV_ODID_OPERATOR_ID_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_OPERATOR_ID_TYPE) Values() []MAV_ODID_OPERATOR_ID_TYPE {
+	return append([]MAV_ODID_OPERATOR_ID_TYPE(nil), values_MAV_ODID_OPERATOR_ID_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_OPERATOR_ID_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_OPERATOR_ID_TYPE[e]
+	return ok
+}
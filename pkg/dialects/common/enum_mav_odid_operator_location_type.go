@@ -18,6 +18,12 @@ const (
 	MAV_ODID_OPERATOR_LOCATION_TYPE_FIXED MAV_ODID_OPERATOR_LOCATION_TYPE = 2
 )
 
+var values_MAV_ODID_OPERATOR_LOCATION_TYPE = []MAV_ODID_OPERATOR_LOCATION_TYPE{
+	MAV_ODID_OPERATOR_LOCATION_TYPE_TAKEOFF,
+	MAV_ODID_OPERATOR_LOCATION_TYPE_LIVE_GNSS,
+	MAV_ODID_OPERATOR_LOCATION_TYPE_FIXED,
+}
+
 var value_to_label_MAV_ODID_OPERATOR_LOCATION_TYPE = map[MAV_ODID_OPERATOR_LOCATION_TYPE]string{
 	MAV_ODID_OPERATOR_LOCATION_TYPE_TAKEOFF:   "MAV_ODID_OPERATOR_LOCATION_TYPE_TAKEOFF",
 	MAV_ODID_OPERATOR_LOCATION_TYPE_LIVE_GNSS: "MAV_ODID_OPERATOR_LOCATION_TYPE_LIVE_GNSS",
@@ -55,3 +61,14 @@ func (e MAV_ODID_OPERATOR_LOCATION_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_OPERATOR_LOCATION_TYPE) Values() []MAV_ODID_OPERATOR_LOCATION_TYPE {
+	return append([]MAV_ODID_OPERATOR_LOCATION_TYPE(nil), values_MAV_ODID_OPERATOR_LOCATION_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_OPERATOR_LOCATION_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_OPERATOR_LOCATION_TYPE[e]
+	return ok
+}
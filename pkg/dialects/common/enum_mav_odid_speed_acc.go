@@ -22,6 +22,14 @@ const (
 	MAV_ODID_SPEED_ACC_0_3_METERS_PER_SECOND MAV_ODID_SPEED_ACC = 4
 )
 
+var values_MAV_ODID_SPEED_ACC = []MAV_ODID_SPEED_ACC{
+	MAV_ODID_SPEED_ACC_UNKNOWN,
+	MAV_ODID_SPEED_ACC_10_METERS_PER_SECOND,
+	MAV_ODID_SPEED_ACC_3_METERS_PER_SECOND,
+	MAV_ODID_SPEED_ACC_1_METERS_PER_SECOND,
+	MAV_ODID_SPEED_ACC_0_3_METERS_PER_SECOND,
+}
+
 var value_to_label_MAV_ODID_SPEED_ACC = map[MAV_ODID_SPEED_ACC]string{
 	MAV_ODID_SPEED_ACC_UNKNOWN:               "MAV_ODID_SPEED_ACC_UNKNOWN",
 	MAV_ODID_SPEED_ACC_10_METERS_PER_SECOND:  "MAV_ODID_SPEED_ACC_10_METERS_PER_SECOND",
@@ -63,3 +71,14 @@ func (e MAV_ODID_SPEED_ACC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_SPEED_ACC) Values() []MAV_ODID_SPEED_ACC {
+	return append([]MAV_ODID_SPEED_ACC(nil), values_MAV_ODID_SPEED_ACC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_SPEED_ACC) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_SPEED_ACC[e]
+	return ok
+}
@@ -22,6 +22,14 @@ const (
 	MAV_ODID_STATUS_REMOTE_ID_SYSTEM_FAILURE MAV_ODID_STATUS = 4
 )
 
+var values_MAV_ODID_STATUS = []MAV_ODID_STATUS{
+	MAV_ODID_STATUS_UNDECLARED,
+	MAV_ODID_STATUS_GROUND,
+	MAV_ODID_STATUS_AIRBORNE,
+	MAV_ODID_STATUS_EMERGENCY,
+	MAV_ODID_STATUS_REMOTE_ID_SYSTEM_FAILURE,
+}
+
 var value_to_label_MAV_ODID_STATUS = map[MAV_ODID_STATUS]string{
 	MAV_ODID_STATUS_UNDECLARED:               "MAV_ODID_STATUS_UNDECLARED",
 	MAV_ODID_STATUS_GROUND:                   "MAV_ODID_STATUS_GROUND",
@@ -63,3 +71,14 @@ func (e MAV_ODID_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_STATUS) Values() []MAV_ODID_STATUS {
+	return append([]MAV_ODID_STATUS(nil), values_MAV_ODID_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_STATUS) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_STATUS[e]
+	return ok
+}
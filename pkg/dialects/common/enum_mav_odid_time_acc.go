@@ -44,6 +44,25 @@ const (
 	MAV_ODID_TIME_ACC_1_5_SECOND MAV_ODID_TIME_ACC = 15
 )
 
+var values_MAV_ODID_TIME_ACC = []MAV_ODID_TIME_ACC{
+	MAV_ODID_TIME_ACC_UNKNOWN,
+	MAV_ODID_TIME_ACC_0_1_SECOND,
+	MAV_ODID_TIME_ACC_0_2_SECOND,
+	MAV_ODID_TIME_ACC_0_3_SECOND,
+	MAV_ODID_TIME_ACC_0_4_SECOND,
+	MAV_ODID_TIME_ACC_0_5_SECOND,
+	MAV_ODID_TIME_ACC_0_6_SECOND,
+	MAV_ODID_TIME_ACC_0_7_SECOND,
+	MAV_ODID_TIME_ACC_0_8_SECOND,
+	MAV_ODID_TIME_ACC_0_9_SECOND,
+	MAV_ODID_TIME_ACC_1_0_SECOND,
+	MAV_ODID_TIME_ACC_1_1_SECOND,
+	MAV_ODID_TIME_ACC_1_2_SECOND,
+	MAV_ODID_TIME_ACC_1_3_SECOND,
+	MAV_ODID_TIME_ACC_1_4_SECOND,
+	MAV_ODID_TIME_ACC_1_5_SECOND,
+}
+
 var value_to_label_MAV_ODID_TIME_ACC = map[MAV_ODID_TIME_ACC]string{
 	MAV_ODID_TIME_ACC_UNKNOWN:    "MAV_ODID_TIME_ACC_UNKNOWN",
 	MAV_ODID_TIME_ACC_0_1_SECOND: "MAV_ODID_TIME_ACC_0_1_SECOND",
@@ -107,3 +126,14 @@ func (e MAV_ODID_TIME_ACC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_TIME_ACC) Values() []MAV_ODID_TIME_ACC {
+	return append([]MAV_ODID_TIME_ACC(nil), values_MAV_ODID_TIME_ACC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_TIME_ACC) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_TIME_ACC[e]
+	return ok
+}
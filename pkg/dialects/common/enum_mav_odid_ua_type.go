@@ -44,6 +44,25 @@ const (
 	MAV_ODID_UA_TYPE_OTHER MAV_ODID_UA_TYPE = 15
 )
 
+var values_MAV_ODID_UA_TYPE = []MAV_ODID_UA_TYPE{
+	MAV_ODID_UA_TYPE_NONE,
+	MAV_ODID_UA_TYPE_AEROPLANE,
+	MAV_ODID_UA_TYPE_HELICOPTER_OR_MULTIROTOR,
+	MAV_ODID_UA_TYPE_GYROPLANE,
+	MAV_ODID_UA_TYPE_HYBRID_LIFT,
+	MAV_ODID_UA_TYPE_ORNITHOPTER,
+	MAV_ODID_UA_TYPE_GLIDER,
+	MAV_ODID_UA_TYPE_KITE,
+	MAV_ODID_UA_TYPE_FREE_BALLOON,
+	MAV_ODID_UA_TYPE_CAPTIVE_BALLOON,
+	MAV_ODID_UA_TYPE_AIRSHIP,
+	MAV_ODID_UA_TYPE_FREE_FALL_PARACHUTE,
+	MAV_ODID_UA_TYPE_ROCKET,
+	MAV_ODID_UA_TYPE_TETHERED_POWERED_AIRCRAFT,
+	MAV_ODID_UA_TYPE_GROUND_OBSTACLE,
+	MAV_ODID_UA_TYPE_OTHER,
+}
+
 var value_to_label_MAV_ODID_UA_TYPE = map[MAV_ODID_UA_TYPE]string{
 	MAV_ODID_UA_TYPE_NONE:                      "MAV_ODID_UA_TYPE_NONE",
 	MAV_ODID_UA_TYPE_AEROPLANE:                 "MAV_ODID_UA_TYPE_AEROPLANE",
@@ -107,3 +126,14 @@ func (e MAV_ODID_UA_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_UA_TYPE) Values() []MAV_ODID_UA_TYPE {
+	return append([]MAV_ODID_UA_TYPE(nil), values_MAV_ODID_UA_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_UA_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_UA_TYPE[e]
+	return ok
+}
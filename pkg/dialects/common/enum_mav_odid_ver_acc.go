@@ -26,6 +26,16 @@ const (
 	MAV_ODID_VER_ACC_1_METER MAV_ODID_VER_ACC = 6
 )
 
+var values_MAV_ODID_VER_ACC = []MAV_ODID_VER_ACC{
+	MAV_ODID_VER_ACC_UNKNOWN,
+	MAV_ODID_VER_ACC_150_METER,
+	MAV_ODID_VER_ACC_45_METER,
+	MAV_ODID_VER_ACC_25_METER,
+	MAV_ODID_VER_ACC_10_METER,
+	MAV_ODID_VER_ACC_3_METER,
+	MAV_ODID_VER_ACC_1_METER,
+}
+
 var value_to_label_MAV_ODID_VER_ACC = map[MAV_ODID_VER_ACC]string{
 	MAV_ODID_VER_ACC_UNKNOWN:   "MAV_ODID_VER_ACC_UNKNOWN",
 	MAV_ODID_VER_ACC_150_METER: "MAV_ODID_VER_ACC_150_METER",
@@ -71,3 +81,14 @@ func (e MAV_ODID_VER_ACC) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ODID_VER_ACC) Values() []MAV_ODID_VER_ACC {
+	return append([]MAV_ODID_VER_ACC(nil), values_MAV_ODID_VER_ACC...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ODID_VER_ACC) IsValid() bool {
+	_, ok := value_to_label_MAV_ODID_VER_ACC[e]
+	return ok
+}
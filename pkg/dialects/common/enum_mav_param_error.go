@@ -31,6 +31,18 @@ const (
 	MAV_PARAM_ERROR_READ_FAIL MAV_PARAM_ERROR = 8
 )
 
+var values_MAV_PARAM_ERROR = []MAV_PARAM_ERROR{
+	MAV_PARAM_ERROR_NO_ERROR,
+	MAV_PARAM_ERROR_DOES_NOT_EXIST,
+	MAV_PARAM_ERROR_VALUE_OUT_OF_RANGE,
+	MAV_PARAM_ERROR_PERMISSION_DENIED,
+	MAV_PARAM_ERROR_COMPONENT_NOT_FOUND,
+	MAV_PARAM_ERROR_READ_ONLY,
+	MAV_PARAM_ERROR_TYPE_UNSUPPORTED,
+	MAV_PARAM_ERROR_TYPE_MISMATCH,
+	MAV_PARAM_ERROR_READ_FAIL,
+}
+
 var value_to_label_MAV_PARAM_ERROR = map[MAV_PARAM_ERROR]string{
 	MAV_PARAM_ERROR_NO_ERROR:            "MAV_PARAM_ERROR_NO_ERROR",
 	MAV_PARAM_ERROR_DOES_NOT_EXIST:      "MAV_PARAM_ERROR_DOES_NOT_EXIST",
@@ -80,3 +92,14 @@ func (e MAV_PARAM_ERROR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_PARAM_ERROR) Values() []MAV_PARAM_ERROR {
+	return append([]MAV_PARAM_ERROR(nil), values_MAV_PARAM_ERROR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_PARAM_ERROR) IsValid() bool {
+	_, ok := value_to_label_MAV_PARAM_ERROR[e]
+	return ok
+}
@@ -35,6 +35,20 @@ const (
 	MAV_PARAM_EXT_TYPE_CUSTOM MAV_PARAM_EXT_TYPE = 11
 )
 
+var values_MAV_PARAM_EXT_TYPE = []MAV_PARAM_EXT_TYPE{
+	MAV_PARAM_EXT_TYPE_UINT8,
+	MAV_PARAM_EXT_TYPE_INT8,
+	MAV_PARAM_EXT_TYPE_UINT16,
+	MAV_PARAM_EXT_TYPE_INT16,
+	MAV_PARAM_EXT_TYPE_UINT32,
+	MAV_PARAM_EXT_TYPE_INT32,
+	MAV_PARAM_EXT_TYPE_UINT64,
+	MAV_PARAM_EXT_TYPE_INT64,
+	MAV_PARAM_EXT_TYPE_REAL32,
+	MAV_PARAM_EXT_TYPE_REAL64,
+	MAV_PARAM_EXT_TYPE_CUSTOM,
+}
+
 var value_to_label_MAV_PARAM_EXT_TYPE = map[MAV_PARAM_EXT_TYPE]string{
 	MAV_PARAM_EXT_TYPE_UINT8:  "MAV_PARAM_EXT_TYPE_UINT8",
 	MAV_PARAM_EXT_TYPE_INT8:   "MAV_PARAM_EXT_TYPE_INT8",
@@ -88,3 +102,14 @@ func (e MAV_PARAM_EXT_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_PARAM_EXT_TYPE) Values() []MAV_PARAM_EXT_TYPE {
+	return append([]MAV_PARAM_EXT_TYPE(nil), values_MAV_PARAM_EXT_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_PARAM_EXT_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_PARAM_EXT_TYPE[e]
+	return ok
+}
@@ -33,6 +33,19 @@ const (
 	MAV_PARAM_TYPE_REAL64 MAV_PARAM_TYPE = 10
 )
 
+var values_MAV_PARAM_TYPE = []MAV_PARAM_TYPE{
+	MAV_PARAM_TYPE_UINT8,
+	MAV_PARAM_TYPE_INT8,
+	MAV_PARAM_TYPE_UINT16,
+	MAV_PARAM_TYPE_INT16,
+	MAV_PARAM_TYPE_UINT32,
+	MAV_PARAM_TYPE_INT32,
+	MAV_PARAM_TYPE_UINT64,
+	MAV_PARAM_TYPE_INT64,
+	MAV_PARAM_TYPE_REAL32,
+	MAV_PARAM_TYPE_REAL64,
+}
+
 var value_to_label_MAV_PARAM_TYPE = map[MAV_PARAM_TYPE]string{
 	MAV_PARAM_TYPE_UINT8:  "MAV_PARAM_TYPE_UINT8",
 	MAV_PARAM_TYPE_INT8:   "MAV_PARAM_TYPE_INT8",
@@ -84,3 +97,14 @@ func (e MAV_PARAM_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_PARAM_TYPE) Values() []MAV_PARAM_TYPE {
+	return append([]MAV_PARAM_TYPE(nil), values_MAV_PARAM_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_PARAM_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_PARAM_TYPE[e]
+	return ok
+}
@@ -89,3 +89,33 @@ func (e MAV_POWER_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_POWER_STATUS) Values() []MAV_POWER_STATUS {
+	return append([]MAV_POWER_STATUS(nil), values_MAV_POWER_STATUS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_POWER_STATUS) IsValid() bool {
+	var mask MAV_POWER_STATUS
+	for _, val := range values_MAV_POWER_STATUS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_POWER_STATUS) Flags() []MAV_POWER_STATUS {
+	var flags []MAV_POWER_STATUS
+	for _, val := range values_MAV_POWER_STATUS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_POWER_STATUS) Has(flags MAV_POWER_STATUS) bool {
+	return e&flags == flags
+}
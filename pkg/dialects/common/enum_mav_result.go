@@ -35,6 +35,20 @@ const (
 	MAV_RESULT_NOT_IN_CONTROL MAV_RESULT = 10
 )
 
+var values_MAV_RESULT = []MAV_RESULT{
+	MAV_RESULT_ACCEPTED,
+	MAV_RESULT_TEMPORARILY_REJECTED,
+	MAV_RESULT_DENIED,
+	MAV_RESULT_UNSUPPORTED,
+	MAV_RESULT_FAILED,
+	MAV_RESULT_IN_PROGRESS,
+	MAV_RESULT_CANCELLED,
+	MAV_RESULT_COMMAND_LONG_ONLY,
+	MAV_RESULT_COMMAND_INT_ONLY,
+	MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME,
+	MAV_RESULT_NOT_IN_CONTROL,
+}
+
 var value_to_label_MAV_RESULT = map[MAV_RESULT]string{
 	MAV_RESULT_ACCEPTED:                      "MAV_RESULT_ACCEPTED",
 	MAV_RESULT_TEMPORARILY_REJECTED:          "MAV_RESULT_TEMPORARILY_REJECTED",
@@ -88,3 +102,14 @@ func (e MAV_RESULT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_RESULT) Values() []MAV_RESULT {
+	return append([]MAV_RESULT(nil), values_MAV_RESULT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_RESULT) IsValid() bool {
+	_, ok := value_to_label_MAV_RESULT[e]
+	return ok
+}
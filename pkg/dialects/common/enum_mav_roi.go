@@ -25,6 +25,14 @@ const (
 	MAV_ROI_TARGET MAV_ROI = 4
 )
 
+var values_MAV_ROI = []MAV_ROI{
+	MAV_ROI_NONE,
+	MAV_ROI_WPNEXT,
+	MAV_ROI_WPINDEX,
+	MAV_ROI_LOCATION,
+	MAV_ROI_TARGET,
+}
+
 var value_to_label_MAV_ROI = map[MAV_ROI]string{
 	MAV_ROI_NONE:     "MAV_ROI_NONE",
 	MAV_ROI_WPNEXT:   "MAV_ROI_WPNEXT",
@@ -66,3 +74,14 @@ func (e MAV_ROI) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_ROI) Values() []MAV_ROI {
+	return append([]MAV_ROI(nil), values_MAV_ROI...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_ROI) IsValid() bool {
+	_, ok := value_to_label_MAV_ROI[e]
+	return ok
+}
@@ -97,6 +97,51 @@ const (
 	MAV_SENSOR_ROTATION_CUSTOM MAV_SENSOR_ORIENTATION = 100
 )
 
+var values_MAV_SENSOR_ORIENTATION = []MAV_SENSOR_ORIENTATION{
+	MAV_SENSOR_ROTATION_NONE,
+	MAV_SENSOR_ROTATION_YAW_45,
+	MAV_SENSOR_ROTATION_YAW_90,
+	MAV_SENSOR_ROTATION_YAW_135,
+	MAV_SENSOR_ROTATION_YAW_180,
+	MAV_SENSOR_ROTATION_YAW_225,
+	MAV_SENSOR_ROTATION_YAW_270,
+	MAV_SENSOR_ROTATION_YAW_315,
+	MAV_SENSOR_ROTATION_ROLL_180,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_45,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_90,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_135,
+	MAV_SENSOR_ROTATION_PITCH_180,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_225,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_270,
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_315,
+	MAV_SENSOR_ROTATION_ROLL_90,
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_45,
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_90,
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_135,
+	MAV_SENSOR_ROTATION_ROLL_270,
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_45,
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_90,
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_135,
+	MAV_SENSOR_ROTATION_PITCH_90,
+	MAV_SENSOR_ROTATION_PITCH_270,
+	MAV_SENSOR_ROTATION_PITCH_180_YAW_90,
+	MAV_SENSOR_ROTATION_PITCH_180_YAW_270,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_90,
+	MAV_SENSOR_ROTATION_ROLL_180_PITCH_90,
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_90,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_180,
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_180,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_270,
+	MAV_SENSOR_ROTATION_ROLL_180_PITCH_270,
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_270,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_180_YAW_90,
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_270,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_68_YAW_293,
+	MAV_SENSOR_ROTATION_PITCH_315,
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_315,
+	MAV_SENSOR_ROTATION_CUSTOM,
+}
+
 var value_to_label_MAV_SENSOR_ORIENTATION = map[MAV_SENSOR_ORIENTATION]string{
 	MAV_SENSOR_ROTATION_NONE:                     "MAV_SENSOR_ROTATION_NONE",
 	MAV_SENSOR_ROTATION_YAW_45:                   "MAV_SENSOR_ROTATION_YAW_45",
@@ -212,3 +257,14 @@ func (e MAV_SENSOR_ORIENTATION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_SENSOR_ORIENTATION) Values() []MAV_SENSOR_ORIENTATION {
+	return append([]MAV_SENSOR_ORIENTATION(nil), values_MAV_SENSOR_ORIENTATION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_SENSOR_ORIENTATION) IsValid() bool {
+	_, ok := value_to_label_MAV_SENSOR_ORIENTATION[e]
+	return ok
+}
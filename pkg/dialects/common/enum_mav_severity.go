@@ -29,6 +29,17 @@ const (
 	MAV_SEVERITY_DEBUG MAV_SEVERITY = 7
 )
 
+var values_MAV_SEVERITY = []MAV_SEVERITY{
+	MAV_SEVERITY_EMERGENCY,
+	MAV_SEVERITY_ALERT,
+	MAV_SEVERITY_CRITICAL,
+	MAV_SEVERITY_ERROR,
+	MAV_SEVERITY_WARNING,
+	MAV_SEVERITY_NOTICE,
+	MAV_SEVERITY_INFO,
+	MAV_SEVERITY_DEBUG,
+}
+
 var value_to_label_MAV_SEVERITY = map[MAV_SEVERITY]string{
 	MAV_SEVERITY_EMERGENCY: "MAV_SEVERITY_EMERGENCY",
 	MAV_SEVERITY_ALERT:     "MAV_SEVERITY_ALERT",
@@ -76,3 +87,14 @@ func (e MAV_SEVERITY) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_SEVERITY) Values() []MAV_SEVERITY {
+	return append([]MAV_SEVERITY(nil), values_MAV_SEVERITY...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_SEVERITY) IsValid() bool {
+	_, ok := value_to_label_MAV_SEVERITY[e]
+	return ok
+}
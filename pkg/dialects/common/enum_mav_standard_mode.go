@@ -72,6 +72,18 @@ const (
 	MAV_STANDARD_MODE_TAKEOFF MAV_STANDARD_MODE = 8
 )
 
+var values_MAV_STANDARD_MODE = []MAV_STANDARD_MODE{
+	MAV_STANDARD_MODE_NON_STANDARD,
+	MAV_STANDARD_MODE_POSITION_HOLD,
+	MAV_STANDARD_MODE_ORBIT,
+	MAV_STANDARD_MODE_CRUISE,
+	MAV_STANDARD_MODE_ALTITUDE_HOLD,
+	MAV_STANDARD_MODE_SAFE_RECOVERY,
+	MAV_STANDARD_MODE_MISSION,
+	MAV_STANDARD_MODE_LAND,
+	MAV_STANDARD_MODE_TAKEOFF,
+}
+
 var value_to_label_MAV_STANDARD_MODE = map[MAV_STANDARD_MODE]string{
 	MAV_STANDARD_MODE_NON_STANDARD:  "MAV_STANDARD_MODE_NON_STANDARD",
 	MAV_STANDARD_MODE_POSITION_HOLD: "MAV_STANDARD_MODE_POSITION_HOLD",
@@ -121,3 +133,14 @@ func (e MAV_STANDARD_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STANDARD_MODE) Values() []MAV_STANDARD_MODE {
+	return append([]MAV_STANDARD_MODE(nil), values_MAV_STANDARD_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_STANDARD_MODE) IsValid() bool {
+	_, ok := value_to_label_MAV_STANDARD_MODE[e]
+	return ok
+}
@@ -219,3 +219,33 @@ func (e MAV_SYS_STATUS_SENSOR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_SYS_STATUS_SENSOR) Values() []MAV_SYS_STATUS_SENSOR {
+	return append([]MAV_SYS_STATUS_SENSOR(nil), values_MAV_SYS_STATUS_SENSOR...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_SYS_STATUS_SENSOR) IsValid() bool {
+	var mask MAV_SYS_STATUS_SENSOR
+	for _, val := range values_MAV_SYS_STATUS_SENSOR {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_SYS_STATUS_SENSOR) Flags() []MAV_SYS_STATUS_SENSOR {
+	var flags []MAV_SYS_STATUS_SENSOR
+	for _, val := range values_MAV_SYS_STATUS_SENSOR {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_SYS_STATUS_SENSOR) Has(flags MAV_SYS_STATUS_SENSOR) bool {
+	return e&flags == flags
+}
@@ -99,3 +99,33 @@ func (e MAV_SYS_STATUS_SENSOR_EXTENDED) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_SYS_STATUS_SENSOR_EXTENDED) Values() []MAV_SYS_STATUS_SENSOR_EXTENDED {
+	return append([]MAV_SYS_STATUS_SENSOR_EXTENDED(nil), values_MAV_SYS_STATUS_SENSOR_EXTENDED...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_SYS_STATUS_SENSOR_EXTENDED) IsValid() bool {
+	var mask MAV_SYS_STATUS_SENSOR_EXTENDED
+	for _, val := range values_MAV_SYS_STATUS_SENSOR_EXTENDED {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_SYS_STATUS_SENSOR_EXTENDED) Flags() []MAV_SYS_STATUS_SENSOR_EXTENDED {
+	var flags []MAV_SYS_STATUS_SENSOR_EXTENDED
+	for _, val := range values_MAV_SYS_STATUS_SENSOR_EXTENDED {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_SYS_STATUS_SENSOR_EXTENDED) Has(flags MAV_SYS_STATUS_SENSOR_EXTENDED) bool {
+	return e&flags == flags
+}
@@ -40,6 +40,23 @@ const (
 	MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_IO_UART_PASSTHRU MAV_TUNNEL_PAYLOAD_TYPE = 212
 )
 
+var values_MAV_TUNNEL_PAYLOAD_TYPE = []MAV_TUNNEL_PAYLOAD_TYPE{
+	MAV_TUNNEL_PAYLOAD_TYPE_UNKNOWN,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED0,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED1,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED2,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED3,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED4,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED5,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED6,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED7,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED8,
+	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED9,
+	MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_REMOTE_OSD,
+	MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_ESC_UART_PASSTHRU,
+	MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_IO_UART_PASSTHRU,
+}
+
 var value_to_label_MAV_TUNNEL_PAYLOAD_TYPE = map[MAV_TUNNEL_PAYLOAD_TYPE]string{
 	MAV_TUNNEL_PAYLOAD_TYPE_UNKNOWN:                   "MAV_TUNNEL_PAYLOAD_TYPE_UNKNOWN",
 	MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED0:         "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED0",
@@ -99,3 +116,14 @@ func (e MAV_TUNNEL_PAYLOAD_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_TUNNEL_PAYLOAD_TYPE) Values() []MAV_TUNNEL_PAYLOAD_TYPE {
+	return append([]MAV_TUNNEL_PAYLOAD_TYPE(nil), values_MAV_TUNNEL_PAYLOAD_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_TUNNEL_PAYLOAD_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_TUNNEL_PAYLOAD_TYPE[e]
+	return ok
+}
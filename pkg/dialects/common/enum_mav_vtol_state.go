@@ -23,6 +23,14 @@ const (
 	MAV_VTOL_STATE_FW MAV_VTOL_STATE = 4
 )
 
+var values_MAV_VTOL_STATE = []MAV_VTOL_STATE{
+	MAV_VTOL_STATE_UNDEFINED,
+	MAV_VTOL_STATE_TRANSITION_TO_FW,
+	MAV_VTOL_STATE_TRANSITION_TO_MC,
+	MAV_VTOL_STATE_MC,
+	MAV_VTOL_STATE_FW,
+}
+
 var value_to_label_MAV_VTOL_STATE = map[MAV_VTOL_STATE]string{
 	MAV_VTOL_STATE_UNDEFINED:        "MAV_VTOL_STATE_UNDEFINED",
 	MAV_VTOL_STATE_TRANSITION_TO_FW: "MAV_VTOL_STATE_TRANSITION_TO_FW",
@@ -64,3 +72,14 @@ func (e MAV_VTOL_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_VTOL_STATE) Values() []MAV_VTOL_STATE {
+	return append([]MAV_VTOL_STATE(nil), values_MAV_VTOL_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_VTOL_STATE) IsValid() bool {
+	_, ok := value_to_label_MAV_VTOL_STATE[e]
+	return ok
+}
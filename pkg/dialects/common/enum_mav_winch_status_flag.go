@@ -129,3 +129,33 @@ func (e MAV_WINCH_STATUS_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_WINCH_STATUS_FLAG) Values() []MAV_WINCH_STATUS_FLAG {
+	return append([]MAV_WINCH_STATUS_FLAG(nil), values_MAV_WINCH_STATUS_FLAG...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_WINCH_STATUS_FLAG) IsValid() bool {
+	var mask MAV_WINCH_STATUS_FLAG
+	for _, val := range values_MAV_WINCH_STATUS_FLAG {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_WINCH_STATUS_FLAG) Flags() []MAV_WINCH_STATUS_FLAG {
+	var flags []MAV_WINCH_STATUS_FLAG
+	for _, val := range values_MAV_WINCH_STATUS_FLAG {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_WINCH_STATUS_FLAG) Has(flags MAV_WINCH_STATUS_FLAG) bool {
+	return e&flags == flags
+}
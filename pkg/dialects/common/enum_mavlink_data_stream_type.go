@@ -18,6 +18,15 @@ const (
 	MAVLINK_DATA_STREAM_IMG_PNG    MAVLINK_DATA_STREAM_TYPE = 5
 )
 
+var values_MAVLINK_DATA_STREAM_TYPE = []MAVLINK_DATA_STREAM_TYPE{
+	MAVLINK_DATA_STREAM_IMG_JPEG,
+	MAVLINK_DATA_STREAM_IMG_BMP,
+	MAVLINK_DATA_STREAM_IMG_RAW8U,
+	MAVLINK_DATA_STREAM_IMG_RAW32U,
+	MAVLINK_DATA_STREAM_IMG_PGM,
+	MAVLINK_DATA_STREAM_IMG_PNG,
+}
+
 var value_to_label_MAVLINK_DATA_STREAM_TYPE = map[MAVLINK_DATA_STREAM_TYPE]string{
 	MAVLINK_DATA_STREAM_IMG_JPEG:   "MAVLINK_DATA_STREAM_IMG_JPEG",
 	MAVLINK_DATA_STREAM_IMG_BMP:    "MAVLINK_DATA_STREAM_IMG_BMP",
@@ -61,3 +70,14 @@ func (e MAVLINK_DATA_STREAM_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAVLINK_DATA_STREAM_TYPE) Values() []MAVLINK_DATA_STREAM_TYPE {
+	return append([]MAVLINK_DATA_STREAM_TYPE(nil), values_MAVLINK_DATA_STREAM_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAVLINK_DATA_STREAM_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAVLINK_DATA_STREAM_TYPE[e]
+	return ok
+}
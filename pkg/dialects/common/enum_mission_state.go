@@ -27,6 +27,15 @@ const (
 	MISSION_STATE_COMPLETE MISSION_STATE = 5
 )
 
+var values_MISSION_STATE = []MISSION_STATE{
+	MISSION_STATE_UNKNOWN,
+	MISSION_STATE_NO_MISSION,
+	MISSION_STATE_NOT_STARTED,
+	MISSION_STATE_ACTIVE,
+	MISSION_STATE_PAUSED,
+	MISSION_STATE_COMPLETE,
+}
+
 var value_to_label_MISSION_STATE = map[MISSION_STATE]string{
 	MISSION_STATE_UNKNOWN:     "MISSION_STATE_UNKNOWN",
 	MISSION_STATE_NO_MISSION:  "MISSION_STATE_NO_MISSION",
@@ -70,3 +79,14 @@ func (e MISSION_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MISSION_STATE) Values() []MISSION_STATE {
+	return append([]MISSION_STATE(nil), values_MISSION_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MISSION_STATE) IsValid() bool {
+	_, ok := value_to_label_MISSION_STATE[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	MOTOR_TEST_ORDER_BOARD MOTOR_TEST_ORDER = 2
 )
 
+var values_MOTOR_TEST_ORDER = []MOTOR_TEST_ORDER{
+	MOTOR_TEST_ORDER_DEFAULT,
+	MOTOR_TEST_ORDER_SEQUENCE,
+	MOTOR_TEST_ORDER_BOARD,
+}
+
 var value_to_label_MOTOR_TEST_ORDER = map[MOTOR_TEST_ORDER]string{
 	MOTOR_TEST_ORDER_DEFAULT:  "MOTOR_TEST_ORDER_DEFAULT",
 	MOTOR_TEST_ORDER_SEQUENCE: "MOTOR_TEST_ORDER_SEQUENCE",
@@ -56,3 +62,14 @@ func (e MOTOR_TEST_ORDER) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MOTOR_TEST_ORDER) Values() []MOTOR_TEST_ORDER {
+	return append([]MOTOR_TEST_ORDER(nil), values_MOTOR_TEST_ORDER...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MOTOR_TEST_ORDER) IsValid() bool {
+	_, ok := value_to_label_MOTOR_TEST_ORDER[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	MOTOR_TEST_COMPASS_CAL MOTOR_TEST_THROTTLE_TYPE = 3
 )
 
+var values_MOTOR_TEST_THROTTLE_TYPE = []MOTOR_TEST_THROTTLE_TYPE{
+	MOTOR_TEST_THROTTLE_PERCENT,
+	MOTOR_TEST_THROTTLE_PWM,
+	MOTOR_TEST_THROTTLE_PILOT,
+	MOTOR_TEST_COMPASS_CAL,
+}
+
 var value_to_label_MOTOR_TEST_THROTTLE_TYPE = map[MOTOR_TEST_THROTTLE_TYPE]string{
 	MOTOR_TEST_THROTTLE_PERCENT: "MOTOR_TEST_THROTTLE_PERCENT",
 	MOTOR_TEST_THROTTLE_PWM:     "MOTOR_TEST_THROTTLE_PWM",
@@ -60,3 +67,14 @@ func (e MOTOR_TEST_THROTTLE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MOTOR_TEST_THROTTLE_TYPE) Values() []MOTOR_TEST_THROTTLE_TYPE {
+	return append([]MOTOR_TEST_THROTTLE_TYPE(nil), values_MOTOR_TEST_THROTTLE_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MOTOR_TEST_THROTTLE_TYPE) IsValid() bool {
+	_, ok := value_to_label_MOTOR_TEST_THROTTLE_TYPE[e]
+	return ok
+}
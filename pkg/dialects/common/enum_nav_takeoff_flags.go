@@ -63,3 +63,33 @@ func (e NAV_TAKEOFF_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (NAV_TAKEOFF_FLAGS) Values() []NAV_TAKEOFF_FLAGS {
+	return append([]NAV_TAKEOFF_FLAGS(nil), values_NAV_TAKEOFF_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e NAV_TAKEOFF_FLAGS) IsValid() bool {
+	var mask NAV_TAKEOFF_FLAGS
+	for _, val := range values_NAV_TAKEOFF_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e NAV_TAKEOFF_FLAGS) Flags() []NAV_TAKEOFF_FLAGS {
+	var flags []NAV_TAKEOFF_FLAGS
+	for _, val := range values_NAV_TAKEOFF_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e NAV_TAKEOFF_FLAGS) Has(flags NAV_TAKEOFF_FLAGS) bool {
+	return e&flags == flags
+}
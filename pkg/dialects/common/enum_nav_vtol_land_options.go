@@ -18,6 +18,12 @@ const (
 	NAV_VTOL_LAND_OPTIONS_FW_APPROACH NAV_VTOL_LAND_OPTIONS = 2
 )
 
+var values_NAV_VTOL_LAND_OPTIONS = []NAV_VTOL_LAND_OPTIONS{
+	NAV_VTOL_LAND_OPTIONS_DEFAULT,
+	NAV_VTOL_LAND_OPTIONS_FW_SPIRAL_APPROACH,
+	NAV_VTOL_LAND_OPTIONS_FW_APPROACH,
+}
+
 var value_to_label_NAV_VTOL_LAND_OPTIONS = map[NAV_VTOL_LAND_OPTIONS]string{
 	NAV_VTOL_LAND_OPTIONS_DEFAULT:            "NAV_VTOL_LAND_OPTIONS_DEFAULT",
 	NAV_VTOL_LAND_OPTIONS_FW_SPIRAL_APPROACH: "NAV_VTOL_LAND_OPTIONS_FW_SPIRAL_APPROACH",
@@ -55,3 +61,14 @@ func (e NAV_VTOL_LAND_OPTIONS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (NAV_VTOL_LAND_OPTIONS) Values() []NAV_VTOL_LAND_OPTIONS {
+	return append([]NAV_VTOL_LAND_OPTIONS(nil), values_NAV_VTOL_LAND_OPTIONS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e NAV_VTOL_LAND_OPTIONS) IsValid() bool {
+	_, ok := value_to_label_NAV_VTOL_LAND_OPTIONS[e]
+	return ok
+}
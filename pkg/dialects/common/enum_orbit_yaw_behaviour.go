@@ -25,6 +25,15 @@ const (
 	ORBIT_YAW_BEHAVIOUR_UNCHANGED ORBIT_YAW_BEHAVIOUR = 5
 )
 
+var values_ORBIT_YAW_BEHAVIOUR = []ORBIT_YAW_BEHAVIOUR{
+	ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER,
+	ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING,
+	ORBIT_YAW_BEHAVIOUR_UNCONTROLLED,
+	ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE,
+	ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED,
+	ORBIT_YAW_BEHAVIOUR_UNCHANGED,
+}
+
 var value_to_label_ORBIT_YAW_BEHAVIOUR = map[ORBIT_YAW_BEHAVIOUR]string{
 	ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER:  "ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER",
 	ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING:         "ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING",
@@ -68,3 +77,14 @@ func (e ORBIT_YAW_BEHAVIOUR) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ORBIT_YAW_BEHAVIOUR) Values() []ORBIT_YAW_BEHAVIOUR {
+	return append([]ORBIT_YAW_BEHAVIOUR(nil), values_ORBIT_YAW_BEHAVIOUR...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ORBIT_YAW_BEHAVIOUR) IsValid() bool {
+	_, ok := value_to_label_ORBIT_YAW_BEHAVIOUR[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	PARACHUTE_RELEASE PARACHUTE_ACTION = 2
 )
 
+var values_PARACHUTE_ACTION = []PARACHUTE_ACTION{
+	PARACHUTE_DISABLE,
+	PARACHUTE_ENABLE,
+	PARACHUTE_RELEASE,
+}
+
 var value_to_label_PARACHUTE_ACTION = map[PARACHUTE_ACTION]string{
 	PARACHUTE_DISABLE: "PARACHUTE_DISABLE",
 	PARACHUTE_ENABLE:  "PARACHUTE_ENABLE",
@@ -56,3 +62,14 @@ func (e PARACHUTE_ACTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PARACHUTE_ACTION) Values() []PARACHUTE_ACTION {
+	return append([]PARACHUTE_ACTION(nil), values_PARACHUTE_ACTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PARACHUTE_ACTION) IsValid() bool {
+	_, ok := value_to_label_PARACHUTE_ACTION[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	PARAM_ACK_IN_PROGRESS PARAM_ACK = 3
 )
 
+var values_PARAM_ACK = []PARAM_ACK{
+	PARAM_ACK_ACCEPTED,
+	PARAM_ACK_VALUE_UNSUPPORTED,
+	PARAM_ACK_FAILED,
+	PARAM_ACK_IN_PROGRESS,
+}
+
 var value_to_label_PARAM_ACK = map[PARAM_ACK]string{
 	PARAM_ACK_ACCEPTED:          "PARAM_ACK_ACCEPTED",
 	PARAM_ACK_VALUE_UNSUPPORTED: "PARAM_ACK_VALUE_UNSUPPORTED",
@@ -60,3 +67,14 @@ func (e PARAM_ACK) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PARAM_ACK) Values() []PARAM_ACK {
+	return append([]PARAM_ACK(nil), values_PARAM_ACK...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PARAM_ACK) IsValid() bool {
+	_, ok := value_to_label_PARAM_ACK[e]
+	return ok
+}
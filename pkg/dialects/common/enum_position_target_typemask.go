@@ -119,3 +119,33 @@ func (e POSITION_TARGET_TYPEMASK) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (POSITION_TARGET_TYPEMASK) Values() []POSITION_TARGET_TYPEMASK {
+	return append([]POSITION_TARGET_TYPEMASK(nil), values_POSITION_TARGET_TYPEMASK...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e POSITION_TARGET_TYPEMASK) IsValid() bool {
+	var mask POSITION_TARGET_TYPEMASK
+	for _, val := range values_POSITION_TARGET_TYPEMASK {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e POSITION_TARGET_TYPEMASK) Flags() []POSITION_TARGET_TYPEMASK {
+	var flags []POSITION_TARGET_TYPEMASK
+	for _, val := range values_POSITION_TARGET_TYPEMASK {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e POSITION_TARGET_TYPEMASK) Has(flags POSITION_TARGET_TYPEMASK) bool {
+	return e&flags == flags
+}
@@ -19,6 +19,12 @@ const (
 	PRECISION_LAND_MODE_REQUIRED PRECISION_LAND_MODE = 2
 )
 
+var values_PRECISION_LAND_MODE = []PRECISION_LAND_MODE{
+	PRECISION_LAND_MODE_DISABLED,
+	PRECISION_LAND_MODE_OPPORTUNISTIC,
+	PRECISION_LAND_MODE_REQUIRED,
+}
+
 var value_to_label_PRECISION_LAND_MODE = map[PRECISION_LAND_MODE]string{
 	PRECISION_LAND_MODE_DISABLED:      "PRECISION_LAND_MODE_DISABLED",
 	PRECISION_LAND_MODE_OPPORTUNISTIC: "PRECISION_LAND_MODE_OPPORTUNISTIC",
@@ -56,3 +62,14 @@ func (e PRECISION_LAND_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PRECISION_LAND_MODE) Values() []PRECISION_LAND_MODE {
+	return append([]PRECISION_LAND_MODE(nil), values_PRECISION_LAND_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PRECISION_LAND_MODE) IsValid() bool {
+	_, ok := value_to_label_PRECISION_LAND_MODE[e]
+	return ok
+}
@@ -25,6 +25,15 @@ const (
 	PREFLIGHT_CALIBRATION_ACCELEROMETER_FORCE_SAVE PREFLIGHT_CALIBRATION_ACCELEROMETER = 76
 )
 
+var values_PREFLIGHT_CALIBRATION_ACCELEROMETER = []PREFLIGHT_CALIBRATION_ACCELEROMETER{
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_NONE,
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_FULL,
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_TRIM,
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_TEMPERATURE,
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_SIMPLE,
+	PREFLIGHT_CALIBRATION_ACCELEROMETER_FORCE_SAVE,
+}
+
 var value_to_label_PREFLIGHT_CALIBRATION_ACCELEROMETER = map[PREFLIGHT_CALIBRATION_ACCELEROMETER]string{
 	PREFLIGHT_CALIBRATION_ACCELEROMETER_NONE:        "PREFLIGHT_CALIBRATION_ACCELEROMETER_NONE",
 	PREFLIGHT_CALIBRATION_ACCELEROMETER_FULL:        "PREFLIGHT_CALIBRATION_ACCELEROMETER_FULL",
@@ -68,3 +77,14 @@ func (e PREFLIGHT_CALIBRATION_ACCELEROMETER) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PREFLIGHT_CALIBRATION_ACCELEROMETER) Values() []PREFLIGHT_CALIBRATION_ACCELEROMETER {
+	return append([]PREFLIGHT_CALIBRATION_ACCELEROMETER(nil), values_PREFLIGHT_CALIBRATION_ACCELEROMETER...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PREFLIGHT_CALIBRATION_ACCELEROMETER) IsValid() bool {
+	_, ok := value_to_label_PREFLIGHT_CALIBRATION_ACCELEROMETER[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	PREFLIGHT_CALIBRATION_MAGNETOMETER_FORCE_SAVE PREFLIGHT_CALIBRATION_MAGNETOMETER = 76
 )
 
+var values_PREFLIGHT_CALIBRATION_MAGNETOMETER = []PREFLIGHT_CALIBRATION_MAGNETOMETER{
+	PREFLIGHT_CALIBRATION_MAGNETOMETER_NONE,
+	PREFLIGHT_CALIBRATION_MAGNETOMETER_START,
+	PREFLIGHT_CALIBRATION_MAGNETOMETER_FORCE_SAVE,
+}
+
 var value_to_label_PREFLIGHT_CALIBRATION_MAGNETOMETER = map[PREFLIGHT_CALIBRATION_MAGNETOMETER]string{
 	PREFLIGHT_CALIBRATION_MAGNETOMETER_NONE:       "PREFLIGHT_CALIBRATION_MAGNETOMETER_NONE",
 	PREFLIGHT_CALIBRATION_MAGNETOMETER_START:      "PREFLIGHT_CALIBRATION_MAGNETOMETER_START",
@@ -56,3 +62,14 @@ func (e PREFLIGHT_CALIBRATION_MAGNETOMETER) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PREFLIGHT_CALIBRATION_MAGNETOMETER) Values() []PREFLIGHT_CALIBRATION_MAGNETOMETER {
+	return append([]PREFLIGHT_CALIBRATION_MAGNETOMETER(nil), values_PREFLIGHT_CALIBRATION_MAGNETOMETER...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PREFLIGHT_CALIBRATION_MAGNETOMETER) IsValid() bool {
+	_, ok := value_to_label_PREFLIGHT_CALIBRATION_MAGNETOMETER[e]
+	return ok
+}
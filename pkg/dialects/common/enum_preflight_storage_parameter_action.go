@@ -24,6 +24,14 @@ const (
 	PARAM_RESET_ALL_DEFAULT PREFLIGHT_STORAGE_PARAMETER_ACTION = 4
 )
 
+var values_PREFLIGHT_STORAGE_PARAMETER_ACTION = []PREFLIGHT_STORAGE_PARAMETER_ACTION{
+	PARAM_READ_PERSISTENT,
+	PARAM_WRITE_PERSISTENT,
+	PARAM_RESET_FACTORY_DEFAULT,
+	PARAM_RESET_SENSOR_DEFAULT,
+	PARAM_RESET_ALL_DEFAULT,
+}
+
 var value_to_label_PREFLIGHT_STORAGE_PARAMETER_ACTION = map[PREFLIGHT_STORAGE_PARAMETER_ACTION]string{
 	PARAM_READ_PERSISTENT:       "PARAM_READ_PERSISTENT",
 	PARAM_WRITE_PERSISTENT:      "PARAM_WRITE_PERSISTENT",
@@ -65,3 +73,14 @@ func (e PREFLIGHT_STORAGE_PARAMETER_ACTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (PREFLIGHT_STORAGE_PARAMETER_ACTION) Values() []PREFLIGHT_STORAGE_PARAMETER_ACTION {
+	return append([]PREFLIGHT_STORAGE_PARAMETER_ACTION(nil), values_PREFLIGHT_STORAGE_PARAMETER_ACTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e PREFLIGHT_STORAGE_PARAMETER_ACTION) IsValid() bool {
+	_, ok := value_to_label_PREFLIGHT_STORAGE_PARAMETER_ACTION[e]
+	return ok
+}
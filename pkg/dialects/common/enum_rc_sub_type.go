@@ -19,6 +19,12 @@ const (
 	RC_SUB_TYPE_SPEKTRUM_DSMX8 RC_SUB_TYPE = 2
 )
 
+var values_RC_SUB_TYPE = []RC_SUB_TYPE{
+	RC_SUB_TYPE_SPEKTRUM_DSM2,
+	RC_SUB_TYPE_SPEKTRUM_DSMX,
+	RC_SUB_TYPE_SPEKTRUM_DSMX8,
+}
+
 var value_to_label_RC_SUB_TYPE = map[RC_SUB_TYPE]string{
 	RC_SUB_TYPE_SPEKTRUM_DSM2:  "RC_SUB_TYPE_SPEKTRUM_DSM2",
 	RC_SUB_TYPE_SPEKTRUM_DSMX:  "RC_SUB_TYPE_SPEKTRUM_DSMX",
@@ -56,3 +62,14 @@ func (e RC_SUB_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RC_SUB_TYPE) Values() []RC_SUB_TYPE {
+	return append([]RC_SUB_TYPE(nil), values_RC_SUB_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e RC_SUB_TYPE) IsValid() bool {
+	_, ok := value_to_label_RC_SUB_TYPE[e]
+	return ok
+}
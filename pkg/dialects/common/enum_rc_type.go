@@ -17,6 +17,11 @@ const (
 	RC_TYPE_CRSF RC_TYPE = 1
 )
 
+var values_RC_TYPE = []RC_TYPE{
+	RC_TYPE_SPEKTRUM,
+	RC_TYPE_CRSF,
+}
+
 var value_to_label_RC_TYPE = map[RC_TYPE]string{
 	RC_TYPE_SPEKTRUM: "RC_TYPE_SPEKTRUM",
 	RC_TYPE_CRSF:     "RC_TYPE_CRSF",
@@ -52,3 +57,14 @@ func (e RC_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RC_TYPE) Values() []RC_TYPE {
+	return append([]RC_TYPE(nil), values_RC_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e RC_TYPE) IsValid() bool {
+	_, ok := value_to_label_RC_TYPE[e]
+	return ok
+}
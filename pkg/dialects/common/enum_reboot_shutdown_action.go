@@ -25,6 +25,15 @@ const (
 	REBOOT_SHUTDOWN_ACTION_REBOOT_TO_MASS_STORAGE REBOOT_SHUTDOWN_ACTION = 5
 )
 
+var values_REBOOT_SHUTDOWN_ACTION = []REBOOT_SHUTDOWN_ACTION{
+	REBOOT_SHUTDOWN_ACTION_NONE,
+	REBOOT_SHUTDOWN_ACTION_REBOOT,
+	REBOOT_SHUTDOWN_ACTION_SHUTDOWN,
+	REBOOT_SHUTDOWN_ACTION_REBOOT_TO_BOOTLOADER,
+	REBOOT_SHUTDOWN_ACTION_POWER_ON,
+	REBOOT_SHUTDOWN_ACTION_REBOOT_TO_MASS_STORAGE,
+}
+
 var value_to_label_REBOOT_SHUTDOWN_ACTION = map[REBOOT_SHUTDOWN_ACTION]string{
 	REBOOT_SHUTDOWN_ACTION_NONE:                   "REBOOT_SHUTDOWN_ACTION_NONE",
 	REBOOT_SHUTDOWN_ACTION_REBOOT:                 "REBOOT_SHUTDOWN_ACTION_REBOOT",
@@ -68,3 +77,14 @@ func (e REBOOT_SHUTDOWN_ACTION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (REBOOT_SHUTDOWN_ACTION) Values() []REBOOT_SHUTDOWN_ACTION {
+	return append([]REBOOT_SHUTDOWN_ACTION(nil), values_REBOOT_SHUTDOWN_ACTION...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e REBOOT_SHUTDOWN_ACTION) IsValid() bool {
+	_, ok := value_to_label_REBOOT_SHUTDOWN_ACTION[e]
+	return ok
+}
@@ -17,6 +17,11 @@ const (
 	REBOOT_SHUTDOWN_CONDITIONS_FORCE REBOOT_SHUTDOWN_CONDITIONS = 20190226
 )
 
+var values_REBOOT_SHUTDOWN_CONDITIONS = []REBOOT_SHUTDOWN_CONDITIONS{
+	REBOOT_SHUTDOWN_CONDITIONS_SAFETY_INTERLOCKED,
+	REBOOT_SHUTDOWN_CONDITIONS_FORCE,
+}
+
 var value_to_label_REBOOT_SHUTDOWN_CONDITIONS = map[REBOOT_SHUTDOWN_CONDITIONS]string{
 	REBOOT_SHUTDOWN_CONDITIONS_SAFETY_INTERLOCKED: "REBOOT_SHUTDOWN_CONDITIONS_SAFETY_INTERLOCKED",
 	REBOOT_SHUTDOWN_CONDITIONS_FORCE:              "REBOOT_SHUTDOWN_CONDITIONS_FORCE",
@@ -52,3 +57,14 @@ func (e REBOOT_SHUTDOWN_CONDITIONS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (REBOOT_SHUTDOWN_CONDITIONS) Values() []REBOOT_SHUTDOWN_CONDITIONS {
+	return append([]REBOOT_SHUTDOWN_CONDITIONS(nil), values_REBOOT_SHUTDOWN_CONDITIONS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e REBOOT_SHUTDOWN_CONDITIONS) IsValid() bool {
+	_, ok := value_to_label_REBOOT_SHUTDOWN_CONDITIONS[e]
+	return ok
+}
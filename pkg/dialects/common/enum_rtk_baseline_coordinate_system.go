@@ -17,6 +17,11 @@ const (
 	RTK_BASELINE_COORDINATE_SYSTEM_NED RTK_BASELINE_COORDINATE_SYSTEM = 1
 )
 
+var values_RTK_BASELINE_COORDINATE_SYSTEM = []RTK_BASELINE_COORDINATE_SYSTEM{
+	RTK_BASELINE_COORDINATE_SYSTEM_ECEF,
+	RTK_BASELINE_COORDINATE_SYSTEM_NED,
+}
+
 var value_to_label_RTK_BASELINE_COORDINATE_SYSTEM = map[RTK_BASELINE_COORDINATE_SYSTEM]string{
 	RTK_BASELINE_COORDINATE_SYSTEM_ECEF: "RTK_BASELINE_COORDINATE_SYSTEM_ECEF",
 	RTK_BASELINE_COORDINATE_SYSTEM_NED:  "RTK_BASELINE_COORDINATE_SYSTEM_NED",
@@ -52,3 +57,14 @@ func (e RTK_BASELINE_COORDINATE_SYSTEM) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RTK_BASELINE_COORDINATE_SYSTEM) Values() []RTK_BASELINE_COORDINATE_SYSTEM {
+	return append([]RTK_BASELINE_COORDINATE_SYSTEM(nil), values_RTK_BASELINE_COORDINATE_SYSTEM...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e RTK_BASELINE_COORDINATE_SYSTEM) IsValid() bool {
+	_, ok := value_to_label_RTK_BASELINE_COORDINATE_SYSTEM[e]
+	return ok
+}
@@ -17,6 +17,11 @@ const (
 	SAFETY_SWITCH_STATE_DANGEROUS SAFETY_SWITCH_STATE = 1
 )
 
+var values_SAFETY_SWITCH_STATE = []SAFETY_SWITCH_STATE{
+	SAFETY_SWITCH_STATE_SAFE,
+	SAFETY_SWITCH_STATE_DANGEROUS,
+}
+
 var value_to_label_SAFETY_SWITCH_STATE = map[SAFETY_SWITCH_STATE]string{
 	SAFETY_SWITCH_STATE_SAFE:      "SAFETY_SWITCH_STATE_SAFE",
 	SAFETY_SWITCH_STATE_DANGEROUS: "SAFETY_SWITCH_STATE_DANGEROUS",
@@ -52,3 +57,14 @@ func (e SAFETY_SWITCH_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SAFETY_SWITCH_STATE) Values() []SAFETY_SWITCH_STATE {
+	return append([]SAFETY_SWITCH_STATE(nil), values_SAFETY_SWITCH_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SAFETY_SWITCH_STATE) IsValid() bool {
+	_, ok := value_to_label_SAFETY_SWITCH_STATE[e]
+	return ok
+}
@@ -63,6 +63,34 @@ const (
 	SERIAL_CONTROL_SERIAL9 SERIAL_CONTROL_DEV = 109
 )
 
+var values_SERIAL_CONTROL_DEV = []SERIAL_CONTROL_DEV{
+	SERIAL_CONTROL_DEV_TELEM1,
+	SERIAL_CONTROL_DEV_TELEM2,
+	SERIAL_CONTROL_DEV_GPS1,
+	SERIAL_CONTROL_DEV_GPS2,
+	SERIAL_CONTROL_DEV_TELEM3,
+	SERIAL_CONTROL_DEV_TELEM4,
+	SERIAL_CONTROL_DEV_SHELL,
+	SERIAL_CONTROL_DEV_ESC0,
+	SERIAL_CONTROL_DEV_ESC1,
+	SERIAL_CONTROL_DEV_ESC2,
+	SERIAL_CONTROL_DEV_ESC3,
+	SERIAL_CONTROL_DEV_ESC4,
+	SERIAL_CONTROL_DEV_ESC5,
+	SERIAL_CONTROL_DEV_ESC6,
+	SERIAL_CONTROL_DEV_ESC7,
+	SERIAL_CONTROL_SERIAL0,
+	SERIAL_CONTROL_SERIAL1,
+	SERIAL_CONTROL_SERIAL2,
+	SERIAL_CONTROL_SERIAL3,
+	SERIAL_CONTROL_SERIAL4,
+	SERIAL_CONTROL_SERIAL5,
+	SERIAL_CONTROL_SERIAL6,
+	SERIAL_CONTROL_SERIAL7,
+	SERIAL_CONTROL_SERIAL8,
+	SERIAL_CONTROL_SERIAL9,
+}
+
 var value_to_label_SERIAL_CONTROL_DEV = map[SERIAL_CONTROL_DEV]string{
 	SERIAL_CONTROL_DEV_TELEM1: "SERIAL_CONTROL_DEV_TELEM1",
 	SERIAL_CONTROL_DEV_TELEM2: "SERIAL_CONTROL_DEV_TELEM2",
@@ -144,3 +172,14 @@ func (e SERIAL_CONTROL_DEV) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SERIAL_CONTROL_DEV) Values() []SERIAL_CONTROL_DEV {
+	return append([]SERIAL_CONTROL_DEV(nil), values_SERIAL_CONTROL_DEV...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SERIAL_CONTROL_DEV) IsValid() bool {
+	_, ok := value_to_label_SERIAL_CONTROL_DEV[e]
+	return ok
+}
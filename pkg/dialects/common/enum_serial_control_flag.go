@@ -84,3 +84,33 @@ func (e SERIAL_CONTROL_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SERIAL_CONTROL_FLAG) Values() []SERIAL_CONTROL_FLAG {
+	return append([]SERIAL_CONTROL_FLAG(nil), values_SERIAL_CONTROL_FLAG...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e SERIAL_CONTROL_FLAG) IsValid() bool {
+	var mask SERIAL_CONTROL_FLAG
+	for _, val := range values_SERIAL_CONTROL_FLAG {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e SERIAL_CONTROL_FLAG) Flags() []SERIAL_CONTROL_FLAG {
+	var flags []SERIAL_CONTROL_FLAG
+	for _, val := range values_SERIAL_CONTROL_FLAG {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e SERIAL_CONTROL_FLAG) Has(flags SERIAL_CONTROL_FLAG) bool {
+	return e&flags == flags
+}
@@ -27,6 +27,16 @@ const (
 	FOCUS_TYPE_AUTO_CONTINUOUS SET_FOCUS_TYPE = 6
 )
 
+var values_SET_FOCUS_TYPE = []SET_FOCUS_TYPE{
+	FOCUS_TYPE_STEP,
+	FOCUS_TYPE_CONTINUOUS,
+	FOCUS_TYPE_RANGE,
+	FOCUS_TYPE_METERS,
+	FOCUS_TYPE_AUTO,
+	FOCUS_TYPE_AUTO_SINGLE,
+	FOCUS_TYPE_AUTO_CONTINUOUS,
+}
+
 var value_to_label_SET_FOCUS_TYPE = map[SET_FOCUS_TYPE]string{
 	FOCUS_TYPE_STEP:            "FOCUS_TYPE_STEP",
 	FOCUS_TYPE_CONTINUOUS:      "FOCUS_TYPE_CONTINUOUS",
@@ -72,3 +82,14 @@ func (e SET_FOCUS_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SET_FOCUS_TYPE) Values() []SET_FOCUS_TYPE {
+	return append([]SET_FOCUS_TYPE(nil), values_SET_FOCUS_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SET_FOCUS_TYPE) IsValid() bool {
+	_, ok := value_to_label_SET_FOCUS_TYPE[e]
+	return ok
+}
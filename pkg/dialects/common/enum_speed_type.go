@@ -21,6 +21,13 @@ const (
 	SPEED_TYPE_DESCENT_SPEED SPEED_TYPE = 3
 )
 
+var values_SPEED_TYPE = []SPEED_TYPE{
+	SPEED_TYPE_AIRSPEED,
+	SPEED_TYPE_GROUNDSPEED,
+	SPEED_TYPE_CLIMB_SPEED,
+	SPEED_TYPE_DESCENT_SPEED,
+}
+
 var value_to_label_SPEED_TYPE = map[SPEED_TYPE]string{
 	SPEED_TYPE_AIRSPEED:      "SPEED_TYPE_AIRSPEED",
 	SPEED_TYPE_GROUNDSPEED:   "SPEED_TYPE_GROUNDSPEED",
@@ -60,3 +67,14 @@ func (e SPEED_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (SPEED_TYPE) Values() []SPEED_TYPE {
+	return append([]SPEED_TYPE(nil), values_SPEED_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e SPEED_TYPE) IsValid() bool {
+	_, ok := value_to_label_SPEED_TYPE[e]
+	return ok
+}
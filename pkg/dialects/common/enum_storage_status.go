@@ -21,6 +21,13 @@ const (
 	STORAGE_STATUS_NOT_SUPPORTED STORAGE_STATUS = 3
 )
 
+var values_STORAGE_STATUS = []STORAGE_STATUS{
+	STORAGE_STATUS_EMPTY,
+	STORAGE_STATUS_UNFORMATTED,
+	STORAGE_STATUS_READY,
+	STORAGE_STATUS_NOT_SUPPORTED,
+}
+
 var value_to_label_STORAGE_STATUS = map[STORAGE_STATUS]string{
 	STORAGE_STATUS_EMPTY:         "STORAGE_STATUS_EMPTY",
 	STORAGE_STATUS_UNFORMATTED:   "STORAGE_STATUS_UNFORMATTED",
@@ -60,3 +67,14 @@ func (e STORAGE_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (STORAGE_STATUS) Values() []STORAGE_STATUS {
+	return append([]STORAGE_STATUS(nil), values_STORAGE_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e STORAGE_STATUS) IsValid() bool {
+	_, ok := value_to_label_STORAGE_STATUS[e]
+	return ok
+}
@@ -31,6 +31,18 @@ const (
 	STORAGE_TYPE_OTHER STORAGE_TYPE = 254
 )
 
+var values_STORAGE_TYPE = []STORAGE_TYPE{
+	STORAGE_TYPE_UNKNOWN,
+	STORAGE_TYPE_USB_STICK,
+	STORAGE_TYPE_SD,
+	STORAGE_TYPE_MICROSD,
+	STORAGE_TYPE_CF,
+	STORAGE_TYPE_CFE,
+	STORAGE_TYPE_XQD,
+	STORAGE_TYPE_HD,
+	STORAGE_TYPE_OTHER,
+}
+
 var value_to_label_STORAGE_TYPE = map[STORAGE_TYPE]string{
 	STORAGE_TYPE_UNKNOWN:   "STORAGE_TYPE_UNKNOWN",
 	STORAGE_TYPE_USB_STICK: "STORAGE_TYPE_USB_STICK",
@@ -80,3 +92,14 @@ func (e STORAGE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (STORAGE_TYPE) Values() []STORAGE_TYPE {
+	return append([]STORAGE_TYPE(nil), values_STORAGE_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e STORAGE_TYPE) IsValid() bool {
+	_, ok := value_to_label_STORAGE_TYPE[e]
+	return ok
+}
@@ -79,3 +79,33 @@ func (e STORAGE_USAGE_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (STORAGE_USAGE_FLAG) Values() []STORAGE_USAGE_FLAG {
+	return append([]STORAGE_USAGE_FLAG(nil), values_STORAGE_USAGE_FLAG...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e STORAGE_USAGE_FLAG) IsValid() bool {
+	var mask STORAGE_USAGE_FLAG
+	for _, val := range values_STORAGE_USAGE_FLAG {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e STORAGE_USAGE_FLAG) Flags() []STORAGE_USAGE_FLAG {
+	var flags []STORAGE_USAGE_FLAG
+	for _, val := range values_STORAGE_USAGE_FLAG {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e STORAGE_USAGE_FLAG) Has(flags STORAGE_USAGE_FLAG) bool {
+	return e&flags == flags
+}
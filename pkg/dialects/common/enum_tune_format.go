@@ -17,6 +17,11 @@ const (
 	TUNE_FORMAT_MML_MODERN TUNE_FORMAT = 2
 )
 
+var values_TUNE_FORMAT = []TUNE_FORMAT{
+	TUNE_FORMAT_QBASIC1_1,
+	TUNE_FORMAT_MML_MODERN,
+}
+
 var value_to_label_TUNE_FORMAT = map[TUNE_FORMAT]string{
 	TUNE_FORMAT_QBASIC1_1:  "TUNE_FORMAT_QBASIC1_1",
 	TUNE_FORMAT_MML_MODERN: "TUNE_FORMAT_MML_MODERN",
@@ -52,3 +57,14 @@ func (e TUNE_FORMAT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (TUNE_FORMAT) Values() []TUNE_FORMAT {
+	return append([]TUNE_FORMAT(nil), values_TUNE_FORMAT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e TUNE_FORMAT) IsValid() bool {
+	_, ok := value_to_label_TUNE_FORMAT[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	UAVCAN_NODE_HEALTH_CRITICAL UAVCAN_NODE_HEALTH = 3
 )
 
+var values_UAVCAN_NODE_HEALTH = []UAVCAN_NODE_HEALTH{
+	UAVCAN_NODE_HEALTH_OK,
+	UAVCAN_NODE_HEALTH_WARNING,
+	UAVCAN_NODE_HEALTH_ERROR,
+	UAVCAN_NODE_HEALTH_CRITICAL,
+}
+
 var value_to_label_UAVCAN_NODE_HEALTH = map[UAVCAN_NODE_HEALTH]string{
 	UAVCAN_NODE_HEALTH_OK:       "UAVCAN_NODE_HEALTH_OK",
 	UAVCAN_NODE_HEALTH_WARNING:  "UAVCAN_NODE_HEALTH_WARNING",
@@ -60,3 +67,14 @@ func (e UAVCAN_NODE_HEALTH) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVCAN_NODE_HEALTH) Values() []UAVCAN_NODE_HEALTH {
+	return append([]UAVCAN_NODE_HEALTH(nil), values_UAVCAN_NODE_HEALTH...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVCAN_NODE_HEALTH) IsValid() bool {
+	_, ok := value_to_label_UAVCAN_NODE_HEALTH[e]
+	return ok
+}
@@ -23,6 +23,14 @@ const (
 	UAVCAN_NODE_MODE_OFFLINE UAVCAN_NODE_MODE = 7
 )
 
+var values_UAVCAN_NODE_MODE = []UAVCAN_NODE_MODE{
+	UAVCAN_NODE_MODE_OPERATIONAL,
+	UAVCAN_NODE_MODE_INITIALIZATION,
+	UAVCAN_NODE_MODE_MAINTENANCE,
+	UAVCAN_NODE_MODE_SOFTWARE_UPDATE,
+	UAVCAN_NODE_MODE_OFFLINE,
+}
+
 var value_to_label_UAVCAN_NODE_MODE = map[UAVCAN_NODE_MODE]string{
 	UAVCAN_NODE_MODE_OPERATIONAL:     "UAVCAN_NODE_MODE_OPERATIONAL",
 	UAVCAN_NODE_MODE_INITIALIZATION:  "UAVCAN_NODE_MODE_INITIALIZATION",
@@ -64,3 +72,14 @@ func (e UAVCAN_NODE_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVCAN_NODE_MODE) Values() []UAVCAN_NODE_MODE {
+	return append([]UAVCAN_NODE_MODE(nil), values_UAVCAN_NODE_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVCAN_NODE_MODE) IsValid() bool {
+	_, ok := value_to_label_UAVCAN_NODE_MODE[e]
+	return ok
+}
@@ -99,3 +99,33 @@ func (e UTM_DATA_AVAIL_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UTM_DATA_AVAIL_FLAGS) Values() []UTM_DATA_AVAIL_FLAGS {
+	return append([]UTM_DATA_AVAIL_FLAGS(nil), values_UTM_DATA_AVAIL_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UTM_DATA_AVAIL_FLAGS) IsValid() bool {
+	var mask UTM_DATA_AVAIL_FLAGS
+	for _, val := range values_UTM_DATA_AVAIL_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UTM_DATA_AVAIL_FLAGS) Flags() []UTM_DATA_AVAIL_FLAGS {
+	var flags []UTM_DATA_AVAIL_FLAGS
+	for _, val := range values_UTM_DATA_AVAIL_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UTM_DATA_AVAIL_FLAGS) Has(flags UTM_DATA_AVAIL_FLAGS) bool {
+	return e&flags == flags
+}
@@ -23,6 +23,14 @@ const (
 	UTM_FLIGHT_STATE_NOCTRL UTM_FLIGHT_STATE = 32
 )
 
+var values_UTM_FLIGHT_STATE = []UTM_FLIGHT_STATE{
+	UTM_FLIGHT_STATE_UNKNOWN,
+	UTM_FLIGHT_STATE_GROUND,
+	UTM_FLIGHT_STATE_AIRBORNE,
+	UTM_FLIGHT_STATE_EMERGENCY,
+	UTM_FLIGHT_STATE_NOCTRL,
+}
+
 var value_to_label_UTM_FLIGHT_STATE = map[UTM_FLIGHT_STATE]string{
 	UTM_FLIGHT_STATE_UNKNOWN:   "UTM_FLIGHT_STATE_UNKNOWN",
 	UTM_FLIGHT_STATE_GROUND:    "UTM_FLIGHT_STATE_GROUND",
@@ -64,3 +72,14 @@ func (e UTM_FLIGHT_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UTM_FLIGHT_STATE) Values() []UTM_FLIGHT_STATE {
+	return append([]UTM_FLIGHT_STATE(nil), values_UTM_FLIGHT_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UTM_FLIGHT_STATE) IsValid() bool {
+	_, ok := value_to_label_UTM_FLIGHT_STATE[e]
+	return ok
+}
@@ -19,6 +19,12 @@ const (
 	VIDEO_STREAM_ENCODING_H265 VIDEO_STREAM_ENCODING = 2
 )
 
+var values_VIDEO_STREAM_ENCODING = []VIDEO_STREAM_ENCODING{
+	VIDEO_STREAM_ENCODING_UNKNOWN,
+	VIDEO_STREAM_ENCODING_H264,
+	VIDEO_STREAM_ENCODING_H265,
+}
+
 var value_to_label_VIDEO_STREAM_ENCODING = map[VIDEO_STREAM_ENCODING]string{
 	VIDEO_STREAM_ENCODING_UNKNOWN: "VIDEO_STREAM_ENCODING_UNKNOWN",
 	VIDEO_STREAM_ENCODING_H264:    "VIDEO_STREAM_ENCODING_H264",
@@ -56,3 +62,14 @@ func (e VIDEO_STREAM_ENCODING) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (VIDEO_STREAM_ENCODING) Values() []VIDEO_STREAM_ENCODING {
+	return append([]VIDEO_STREAM_ENCODING(nil), values_VIDEO_STREAM_ENCODING...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e VIDEO_STREAM_ENCODING) IsValid() bool {
+	_, ok := value_to_label_VIDEO_STREAM_ENCODING[e]
+	return ok
+}
@@ -74,3 +74,33 @@ func (e VIDEO_STREAM_STATUS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (VIDEO_STREAM_STATUS_FLAGS) Values() []VIDEO_STREAM_STATUS_FLAGS {
+	return append([]VIDEO_STREAM_STATUS_FLAGS(nil), values_VIDEO_STREAM_STATUS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e VIDEO_STREAM_STATUS_FLAGS) IsValid() bool {
+	var mask VIDEO_STREAM_STATUS_FLAGS
+	for _, val := range values_VIDEO_STREAM_STATUS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e VIDEO_STREAM_STATUS_FLAGS) Flags() []VIDEO_STREAM_STATUS_FLAGS {
+	var flags []VIDEO_STREAM_STATUS_FLAGS
+	for _, val := range values_VIDEO_STREAM_STATUS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e VIDEO_STREAM_STATUS_FLAGS) Has(flags VIDEO_STREAM_STATUS_FLAGS) bool {
+	return e&flags == flags
+}
@@ -23,6 +23,14 @@ const (
 	VIDEO_STREAM_TYPE_WHEP VIDEO_STREAM_TYPE = 4
 )
 
+var values_VIDEO_STREAM_TYPE = []VIDEO_STREAM_TYPE{
+	VIDEO_STREAM_TYPE_RTSP,
+	VIDEO_STREAM_TYPE_RTPUDP,
+	VIDEO_STREAM_TYPE_TCP_MPEG,
+	VIDEO_STREAM_TYPE_MPEG_TS,
+	VIDEO_STREAM_TYPE_WHEP,
+}
+
 var value_to_label_VIDEO_STREAM_TYPE = map[VIDEO_STREAM_TYPE]string{
 	VIDEO_STREAM_TYPE_RTSP:     "VIDEO_STREAM_TYPE_RTSP",
 	VIDEO_STREAM_TYPE_RTPUDP:   "VIDEO_STREAM_TYPE_RTPUDP",
@@ -64,3 +72,14 @@ func (e VIDEO_STREAM_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (VIDEO_STREAM_TYPE) Values() []VIDEO_STREAM_TYPE {
+	return append([]VIDEO_STREAM_TYPE(nil), values_VIDEO_STREAM_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e VIDEO_STREAM_TYPE) IsValid() bool {
+	_, ok := value_to_label_VIDEO_STREAM_TYPE[e]
+	return ok
+}
@@ -23,6 +23,14 @@ const (
 	VTOL_TRANSITION_HEADING_ANY VTOL_TRANSITION_HEADING = 4
 )
 
+var values_VTOL_TRANSITION_HEADING = []VTOL_TRANSITION_HEADING{
+	VTOL_TRANSITION_HEADING_VEHICLE_DEFAULT,
+	VTOL_TRANSITION_HEADING_NEXT_WAYPOINT,
+	VTOL_TRANSITION_HEADING_TAKEOFF,
+	VTOL_TRANSITION_HEADING_SPECIFIED,
+	VTOL_TRANSITION_HEADING_ANY,
+}
+
 var value_to_label_VTOL_TRANSITION_HEADING = map[VTOL_TRANSITION_HEADING]string{
 	VTOL_TRANSITION_HEADING_VEHICLE_DEFAULT: "VTOL_TRANSITION_HEADING_VEHICLE_DEFAULT",
 	VTOL_TRANSITION_HEADING_NEXT_WAYPOINT:   "VTOL_TRANSITION_HEADING_NEXT_WAYPOINT",
@@ -64,3 +72,14 @@ func (e VTOL_TRANSITION_HEADING) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (VTOL_TRANSITION_HEADING) Values() []VTOL_TRANSITION_HEADING {
+	return append([]VTOL_TRANSITION_HEADING(nil), values_VTOL_TRANSITION_HEADING...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e VTOL_TRANSITION_HEADING) IsValid() bool {
+	_, ok := value_to_label_VTOL_TRANSITION_HEADING[e]
+	return ok
+}
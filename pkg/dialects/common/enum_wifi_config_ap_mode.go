@@ -21,6 +21,13 @@ const (
 	WIFI_CONFIG_AP_MODE_DISABLED WIFI_CONFIG_AP_MODE = 3
 )
 
+var values_WIFI_CONFIG_AP_MODE = []WIFI_CONFIG_AP_MODE{
+	WIFI_CONFIG_AP_MODE_UNDEFINED,
+	WIFI_CONFIG_AP_MODE_AP,
+	WIFI_CONFIG_AP_MODE_STATION,
+	WIFI_CONFIG_AP_MODE_DISABLED,
+}
+
 var value_to_label_WIFI_CONFIG_AP_MODE = map[WIFI_CONFIG_AP_MODE]string{
 	WIFI_CONFIG_AP_MODE_UNDEFINED: "WIFI_CONFIG_AP_MODE_UNDEFINED",
 	WIFI_CONFIG_AP_MODE_AP:        "WIFI_CONFIG_AP_MODE_AP",
@@ -60,3 +67,14 @@ func (e WIFI_CONFIG_AP_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (WIFI_CONFIG_AP_MODE) Values() []WIFI_CONFIG_AP_MODE {
+	return append([]WIFI_CONFIG_AP_MODE(nil), values_WIFI_CONFIG_AP_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e WIFI_CONFIG_AP_MODE) IsValid() bool {
+	_, ok := value_to_label_WIFI_CONFIG_AP_MODE[e]
+	return ok
+}
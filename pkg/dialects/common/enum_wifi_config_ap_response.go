@@ -25,6 +25,15 @@ const (
 	WIFI_CONFIG_AP_RESPONSE_PASSWORD_ERROR WIFI_CONFIG_AP_RESPONSE = 5
 )
 
+var values_WIFI_CONFIG_AP_RESPONSE = []WIFI_CONFIG_AP_RESPONSE{
+	WIFI_CONFIG_AP_RESPONSE_UNDEFINED,
+	WIFI_CONFIG_AP_RESPONSE_ACCEPTED,
+	WIFI_CONFIG_AP_RESPONSE_REJECTED,
+	WIFI_CONFIG_AP_RESPONSE_MODE_ERROR,
+	WIFI_CONFIG_AP_RESPONSE_SSID_ERROR,
+	WIFI_CONFIG_AP_RESPONSE_PASSWORD_ERROR,
+}
+
 var value_to_label_WIFI_CONFIG_AP_RESPONSE = map[WIFI_CONFIG_AP_RESPONSE]string{
 	WIFI_CONFIG_AP_RESPONSE_UNDEFINED:      "WIFI_CONFIG_AP_RESPONSE_UNDEFINED",
 	WIFI_CONFIG_AP_RESPONSE_ACCEPTED:       "WIFI_CONFIG_AP_RESPONSE_ACCEPTED",
@@ -68,3 +77,14 @@ func (e WIFI_CONFIG_AP_RESPONSE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (WIFI_CONFIG_AP_RESPONSE) Values() []WIFI_CONFIG_AP_RESPONSE {
+	return append([]WIFI_CONFIG_AP_RESPONSE(nil), values_WIFI_CONFIG_AP_RESPONSE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e WIFI_CONFIG_AP_RESPONSE) IsValid() bool {
+	_, ok := value_to_label_WIFI_CONFIG_AP_RESPONSE[e]
+	return ok
+}
@@ -33,6 +33,19 @@ const (
 	WINCH_LOAD_PAYLOAD WINCH_ACTIONS = 9
 )
 
+var values_WINCH_ACTIONS = []WINCH_ACTIONS{
+	WINCH_RELAXED,
+	WINCH_RELATIVE_LENGTH_CONTROL,
+	WINCH_RATE_CONTROL,
+	WINCH_LOCK,
+	WINCH_DELIVER,
+	WINCH_HOLD,
+	WINCH_RETRACT,
+	WINCH_LOAD_LINE,
+	WINCH_ABANDON_LINE,
+	WINCH_LOAD_PAYLOAD,
+}
+
 var value_to_label_WINCH_ACTIONS = map[WINCH_ACTIONS]string{
 	WINCH_RELAXED:                 "WINCH_RELAXED",
 	WINCH_RELATIVE_LENGTH_CONTROL: "WINCH_RELATIVE_LENGTH_CONTROL",
@@ -84,3 +97,14 @@ func (e WINCH_ACTIONS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (WINCH_ACTIONS) Values() []WINCH_ACTIONS {
+	return append([]WINCH_ACTIONS(nil), values_WINCH_ACTIONS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e WINCH_ACTIONS) IsValid() bool {
+	_, ok := value_to_label_WINCH_ACTIONS[e]
+	return ok
+}
@@ -0,0 +1,2642 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package common
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{
+	"ACTUATOR_CONFIGURATION": {
+		Name:        "ACTUATOR_CONFIGURATION",
+		Description: "Actuator configuration, used to change a setting on an actuator. Component information metadata can be used to know which outputs support which commands.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ACTUATOR_CONFIGURATION_NONE", Value: 0, Description: "Do nothing."},
+			{Name: "ACTUATOR_CONFIGURATION_BEEP", Value: 1, Description: "Command the actuator to beep now."},
+			{Name: "ACTUATOR_CONFIGURATION_3D_MODE_ON", Value: 2, Description: "Permanently set the actuator (ESC) to 3D mode (reversible thrust)."},
+			{Name: "ACTUATOR_CONFIGURATION_3D_MODE_OFF", Value: 3, Description: "Permanently set the actuator (ESC) to non 3D mode (non-reversible thrust)."},
+			{Name: "ACTUATOR_CONFIGURATION_SPIN_DIRECTION1", Value: 4, Description: "Permanently set the actuator (ESC) to spin direction 1 (which can be clockwise or counter-clockwise)."},
+			{Name: "ACTUATOR_CONFIGURATION_SPIN_DIRECTION2", Value: 5, Description: "Permanently set the actuator (ESC) to spin direction 2 (opposite of direction 1)."},
+		},
+	},
+	"ACTUATOR_OUTPUT_FUNCTION": {
+		Name:        "ACTUATOR_OUTPUT_FUNCTION",
+		Description: "Actuator output function. Values greater or equal to 1000 are autopilot-specific.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_NONE", Value: 0, Description: "No function (disabled)."},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR1", Value: 1, Description: "Motor 1"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR2", Value: 2, Description: "Motor 2"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR3", Value: 3, Description: "Motor 3"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR4", Value: 4, Description: "Motor 4"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR5", Value: 5, Description: "Motor 5"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR6", Value: 6, Description: "Motor 6"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR7", Value: 7, Description: "Motor 7"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR8", Value: 8, Description: "Motor 8"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR9", Value: 9, Description: "Motor 9"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR10", Value: 10, Description: "Motor 10"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR11", Value: 11, Description: "Motor 11"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR12", Value: 12, Description: "Motor 12"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR13", Value: 13, Description: "Motor 13"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR14", Value: 14, Description: "Motor 14"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR15", Value: 15, Description: "Motor 15"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_MOTOR16", Value: 16, Description: "Motor 16"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO1", Value: 33, Description: "Servo 1"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO2", Value: 34, Description: "Servo 2"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO3", Value: 35, Description: "Servo 3"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO4", Value: 36, Description: "Servo 4"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO5", Value: 37, Description: "Servo 5"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO6", Value: 38, Description: "Servo 6"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO7", Value: 39, Description: "Servo 7"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO8", Value: 40, Description: "Servo 8"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO9", Value: 41, Description: "Servo 9"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO10", Value: 42, Description: "Servo 10"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO11", Value: 43, Description: "Servo 11"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO12", Value: 44, Description: "Servo 12"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO13", Value: 45, Description: "Servo 13"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO14", Value: 46, Description: "Servo 14"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO15", Value: 47, Description: "Servo 15"},
+			{Name: "ACTUATOR_OUTPUT_FUNCTION_SERVO16", Value: 48, Description: "Servo 16"},
+		},
+	},
+	"ADSB_ALTITUDE_TYPE": {
+		Name:        "ADSB_ALTITUDE_TYPE",
+		Description: "Enumeration of the ADSB altimeter types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ADSB_ALTITUDE_TYPE_PRESSURE_QNH", Value: 0, Description: "Altitude reported from a Baro source using QNH reference"},
+			{Name: "ADSB_ALTITUDE_TYPE_GEOMETRIC", Value: 1, Description: "Altitude reported from a GNSS source"},
+		},
+	},
+	"ADSB_EMITTER_TYPE": {
+		Name:        "ADSB_EMITTER_TYPE",
+		Description: "ADSB classification for the type of vehicle emitting the transponder signal",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ADSB_EMITTER_TYPE_NO_INFO", Value: 0},
+			{Name: "ADSB_EMITTER_TYPE_LIGHT", Value: 1},
+			{Name: "ADSB_EMITTER_TYPE_SMALL", Value: 2},
+			{Name: "ADSB_EMITTER_TYPE_LARGE", Value: 3},
+			{Name: "ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE", Value: 4},
+			{Name: "ADSB_EMITTER_TYPE_HEAVY", Value: 5},
+			{Name: "ADSB_EMITTER_TYPE_HIGHLY_MANUV", Value: 6},
+			{Name: "ADSB_EMITTER_TYPE_ROTOCRAFT", Value: 7},
+			{Name: "ADSB_EMITTER_TYPE_UNASSIGNED", Value: 8},
+			{Name: "ADSB_EMITTER_TYPE_GLIDER", Value: 9},
+			{Name: "ADSB_EMITTER_TYPE_LIGHTER_AIR", Value: 10},
+			{Name: "ADSB_EMITTER_TYPE_PARACHUTE", Value: 11},
+			{Name: "ADSB_EMITTER_TYPE_ULTRA_LIGHT", Value: 12},
+			{Name: "ADSB_EMITTER_TYPE_UNASSIGNED2", Value: 13},
+			{Name: "ADSB_EMITTER_TYPE_UAV", Value: 14},
+			{Name: "ADSB_EMITTER_TYPE_SPACE", Value: 15},
+			{Name: "ADSB_EMITTER_TYPE_UNASSGINED3", Value: 16},
+			{Name: "ADSB_EMITTER_TYPE_EMERGENCY_SURFACE", Value: 17},
+			{Name: "ADSB_EMITTER_TYPE_SERVICE_SURFACE", Value: 18},
+			{Name: "ADSB_EMITTER_TYPE_POINT_OBSTACLE", Value: 19},
+		},
+	},
+	"ADSB_FLAGS": {
+		Name:        "ADSB_FLAGS",
+		Description: "These flags indicate status such as data validity of each data source. Set = data valid",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ADSB_FLAGS_VALID_COORDS", Value: 1},
+			{Name: "ADSB_FLAGS_VALID_ALTITUDE", Value: 2},
+			{Name: "ADSB_FLAGS_VALID_HEADING", Value: 4},
+			{Name: "ADSB_FLAGS_VALID_VELOCITY", Value: 8},
+			{Name: "ADSB_FLAGS_VALID_CALLSIGN", Value: 16},
+			{Name: "ADSB_FLAGS_VALID_SQUAWK", Value: 32},
+			{Name: "ADSB_FLAGS_SIMULATED", Value: 64},
+			{Name: "ADSB_FLAGS_VERTICAL_VELOCITY_VALID", Value: 128},
+			{Name: "ADSB_FLAGS_BARO_VALID", Value: 256},
+			{Name: "ADSB_FLAGS_SOURCE_UAT", Value: 32768},
+		},
+	},
+	"AIRSPEED_SENSOR_FLAGS": {
+		Name:        "AIRSPEED_SENSOR_FLAGS",
+		Description: "Airspeed sensor flags",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AIRSPEED_SENSOR_UNHEALTHY", Value: 1, Description: "Airspeed sensor is unhealthy"},
+			{Name: "AIRSPEED_SENSOR_USING", Value: 2, Description: "True if the data from this sensor is being actively used by the flight controller for guidance, navigation or control."},
+		},
+	},
+	"AIS_FLAGS": {
+		Name:        "AIS_FLAGS",
+		Description: "These flags are used in the AIS_VESSEL.fields bitmask to indicate validity of data in the other message fields. When set, the data is valid.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AIS_FLAGS_POSITION_ACCURACY", Value: 1, Description: "1 = High (Position accuracy less than or equal to 10m), 0 = Low (position accuracy greater than 10m)."},
+			{Name: "AIS_FLAGS_VALID_COG", Value: 2, Description: "The COG field contains valid data"},
+			{Name: "AIS_FLAGS_VALID_VELOCITY", Value: 4, Description: "The velocity field contains valid data"},
+			{Name: "AIS_FLAGS_HIGH_VELOCITY", Value: 8, Description: "1 = Velocity over 52.5765m/s (102.2 knots)"},
+			{Name: "AIS_FLAGS_VALID_TURN_RATE", Value: 16, Description: "The turn_rate field contains valid data"},
+			{Name: "AIS_FLAGS_TURN_RATE_SIGN_ONLY", Value: 32, Description: "Only the sign of the returned turn_rate value is valid. The actual turn rate is either greater than 5deg/30s or less than -5deg/30s."},
+			{Name: "AIS_FLAGS_VALID_DIMENSIONS", Value: 64},
+			{Name: "AIS_FLAGS_LARGE_BOW_DIMENSION", Value: 128, Description: "Distance to bow is greater than or equal to 511m"},
+			{Name: "AIS_FLAGS_LARGE_STERN_DIMENSION", Value: 256, Description: "Distance to stern is greater than or equal to 511m"},
+			{Name: "AIS_FLAGS_LARGE_PORT_DIMENSION", Value: 512, Description: "Distance to port side is greater than or equal to 63m"},
+			{Name: "AIS_FLAGS_LARGE_STARBOARD_DIMENSION", Value: 1024, Description: "Distance to starboard side is greater than or equal to 63m"},
+			{Name: "AIS_FLAGS_VALID_CALLSIGN", Value: 2048, Description: "The callsign field contains valid data"},
+			{Name: "AIS_FLAGS_VALID_NAME", Value: 4096, Description: "The name field contains valid data"},
+		},
+	},
+	"AIS_NAV_STATUS": {
+		Name:        "AIS_NAV_STATUS",
+		Description: "Navigational status of AIS vessel, enum duplicated from AIS standard, https://gpsd.gitlab.io/gpsd/AIVDM.html",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AIS_NAV_STATUS_UNDER_WAY", Value: 0, Description: "Under way using engine."},
+			{Name: "AIS_NAV_STATUS_ANCHORED", Value: 1},
+			{Name: "AIS_NAV_STATUS_UN_COMMANDED", Value: 2},
+			{Name: "AIS_NAV_STATUS_RESTRICTED_MANOEUVERABILITY", Value: 3},
+			{Name: "AIS_NAV_STATUS_DRAUGHT_CONSTRAINED", Value: 4},
+			{Name: "AIS_NAV_STATUS_MOORED", Value: 5},
+			{Name: "AIS_NAV_STATUS_AGROUND", Value: 6},
+			{Name: "AIS_NAV_STATUS_FISHING", Value: 7},
+			{Name: "AIS_NAV_STATUS_SAILING", Value: 8},
+			{Name: "AIS_NAV_STATUS_RESERVED_HSC", Value: 9},
+			{Name: "AIS_NAV_STATUS_RESERVED_WIG", Value: 10},
+			{Name: "AIS_NAV_STATUS_RESERVED_1", Value: 11},
+			{Name: "AIS_NAV_STATUS_RESERVED_2", Value: 12},
+			{Name: "AIS_NAV_STATUS_RESERVED_3", Value: 13},
+			{Name: "AIS_NAV_STATUS_AIS_SART", Value: 14, Description: "Search And Rescue Transponder."},
+			{Name: "AIS_NAV_STATUS_UNKNOWN", Value: 15, Description: "Not available (default)."},
+		},
+	},
+	"AIS_TYPE": {
+		Name:        "AIS_TYPE",
+		Description: "Type of AIS vessel, enum duplicated from AIS standard, https://gpsd.gitlab.io/gpsd/AIVDM.html",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AIS_TYPE_UNKNOWN", Value: 0, Description: "Not available (default)."},
+			{Name: "AIS_TYPE_RESERVED_1", Value: 1},
+			{Name: "AIS_TYPE_RESERVED_2", Value: 2},
+			{Name: "AIS_TYPE_RESERVED_3", Value: 3},
+			{Name: "AIS_TYPE_RESERVED_4", Value: 4},
+			{Name: "AIS_TYPE_RESERVED_5", Value: 5},
+			{Name: "AIS_TYPE_RESERVED_6", Value: 6},
+			{Name: "AIS_TYPE_RESERVED_7", Value: 7},
+			{Name: "AIS_TYPE_RESERVED_8", Value: 8},
+			{Name: "AIS_TYPE_RESERVED_9", Value: 9},
+			{Name: "AIS_TYPE_RESERVED_10", Value: 10},
+			{Name: "AIS_TYPE_RESERVED_11", Value: 11},
+			{Name: "AIS_TYPE_RESERVED_12", Value: 12},
+			{Name: "AIS_TYPE_RESERVED_13", Value: 13},
+			{Name: "AIS_TYPE_RESERVED_14", Value: 14},
+			{Name: "AIS_TYPE_RESERVED_15", Value: 15},
+			{Name: "AIS_TYPE_RESERVED_16", Value: 16},
+			{Name: "AIS_TYPE_RESERVED_17", Value: 17},
+			{Name: "AIS_TYPE_RESERVED_18", Value: 18},
+			{Name: "AIS_TYPE_RESERVED_19", Value: 19},
+			{Name: "AIS_TYPE_WIG", Value: 20, Description: "Wing In Ground effect."},
+			{Name: "AIS_TYPE_WIG_HAZARDOUS_A", Value: 21},
+			{Name: "AIS_TYPE_WIG_HAZARDOUS_B", Value: 22},
+			{Name: "AIS_TYPE_WIG_HAZARDOUS_C", Value: 23},
+			{Name: "AIS_TYPE_WIG_HAZARDOUS_D", Value: 24},
+			{Name: "AIS_TYPE_WIG_RESERVED_1", Value: 25},
+			{Name: "AIS_TYPE_WIG_RESERVED_2", Value: 26},
+			{Name: "AIS_TYPE_WIG_RESERVED_3", Value: 27},
+			{Name: "AIS_TYPE_WIG_RESERVED_4", Value: 28},
+			{Name: "AIS_TYPE_WIG_RESERVED_5", Value: 29},
+			{Name: "AIS_TYPE_FISHING", Value: 30},
+			{Name: "AIS_TYPE_TOWING", Value: 31},
+			{Name: "AIS_TYPE_TOWING_LARGE", Value: 32, Description: "Towing: length exceeds 200m or breadth exceeds 25m."},
+			{Name: "AIS_TYPE_DREDGING", Value: 33, Description: "Dredging or other underwater ops."},
+			{Name: "AIS_TYPE_DIVING", Value: 34},
+			{Name: "AIS_TYPE_MILITARY", Value: 35},
+			{Name: "AIS_TYPE_SAILING", Value: 36},
+			{Name: "AIS_TYPE_PLEASURE", Value: 37},
+			{Name: "AIS_TYPE_RESERVED_20", Value: 38},
+			{Name: "AIS_TYPE_RESERVED_21", Value: 39},
+			{Name: "AIS_TYPE_HSC", Value: 40, Description: "High Speed Craft."},
+			{Name: "AIS_TYPE_HSC_HAZARDOUS_A", Value: 41},
+			{Name: "AIS_TYPE_HSC_HAZARDOUS_B", Value: 42},
+			{Name: "AIS_TYPE_HSC_HAZARDOUS_C", Value: 43},
+			{Name: "AIS_TYPE_HSC_HAZARDOUS_D", Value: 44},
+			{Name: "AIS_TYPE_HSC_RESERVED_1", Value: 45},
+			{Name: "AIS_TYPE_HSC_RESERVED_2", Value: 46},
+			{Name: "AIS_TYPE_HSC_RESERVED_3", Value: 47},
+			{Name: "AIS_TYPE_HSC_RESERVED_4", Value: 48},
+			{Name: "AIS_TYPE_HSC_UNKNOWN", Value: 49},
+			{Name: "AIS_TYPE_PILOT", Value: 50},
+			{Name: "AIS_TYPE_SAR", Value: 51, Description: "Search And Rescue vessel."},
+			{Name: "AIS_TYPE_TUG", Value: 52},
+			{Name: "AIS_TYPE_PORT_TENDER", Value: 53},
+			{Name: "AIS_TYPE_ANTI_POLLUTION", Value: 54, Description: "Anti-pollution equipment."},
+			{Name: "AIS_TYPE_LAW_ENFORCEMENT", Value: 55},
+			{Name: "AIS_TYPE_SPARE_LOCAL_1", Value: 56},
+			{Name: "AIS_TYPE_SPARE_LOCAL_2", Value: 57},
+			{Name: "AIS_TYPE_MEDICAL_TRANSPORT", Value: 58},
+			{Name: "AIS_TYPE_NONECOMBATANT", Value: 59, Description: "Noncombatant ship according to RR Resolution No. 18."},
+			{Name: "AIS_TYPE_PASSENGER", Value: 60},
+			{Name: "AIS_TYPE_PASSENGER_HAZARDOUS_A", Value: 61},
+			{Name: "AIS_TYPE_PASSENGER_HAZARDOUS_B", Value: 62},
+			{Name: "AIS_TYPE_PASSENGER_HAZARDOUS_C", Value: 63},
+			{Name: "AIS_TYPE_PASSENGER_HAZARDOUS_D", Value: 64},
+			{Name: "AIS_TYPE_PASSENGER_RESERVED_1", Value: 65},
+			{Name: "AIS_TYPE_PASSENGER_RESERVED_2", Value: 66},
+			{Name: "AIS_TYPE_PASSENGER_RESERVED_3", Value: 67},
+			{Name: "AIS_TYPE_PASSENGER_RESERVED_4", Value: 68},
+			{Name: "AIS_TYPE_PASSENGER_UNKNOWN", Value: 69},
+			{Name: "AIS_TYPE_CARGO", Value: 70},
+			{Name: "AIS_TYPE_CARGO_HAZARDOUS_A", Value: 71},
+			{Name: "AIS_TYPE_CARGO_HAZARDOUS_B", Value: 72},
+			{Name: "AIS_TYPE_CARGO_HAZARDOUS_C", Value: 73},
+			{Name: "AIS_TYPE_CARGO_HAZARDOUS_D", Value: 74},
+			{Name: "AIS_TYPE_CARGO_RESERVED_1", Value: 75},
+			{Name: "AIS_TYPE_CARGO_RESERVED_2", Value: 76},
+			{Name: "AIS_TYPE_CARGO_RESERVED_3", Value: 77},
+			{Name: "AIS_TYPE_CARGO_RESERVED_4", Value: 78},
+			{Name: "AIS_TYPE_CARGO_UNKNOWN", Value: 79},
+			{Name: "AIS_TYPE_TANKER", Value: 80},
+			{Name: "AIS_TYPE_TANKER_HAZARDOUS_A", Value: 81},
+			{Name: "AIS_TYPE_TANKER_HAZARDOUS_B", Value: 82},
+			{Name: "AIS_TYPE_TANKER_HAZARDOUS_C", Value: 83},
+			{Name: "AIS_TYPE_TANKER_HAZARDOUS_D", Value: 84},
+			{Name: "AIS_TYPE_TANKER_RESERVED_1", Value: 85},
+			{Name: "AIS_TYPE_TANKER_RESERVED_2", Value: 86},
+			{Name: "AIS_TYPE_TANKER_RESERVED_3", Value: 87},
+			{Name: "AIS_TYPE_TANKER_RESERVED_4", Value: 88},
+			{Name: "AIS_TYPE_TANKER_UNKNOWN", Value: 89},
+			{Name: "AIS_TYPE_OTHER", Value: 90},
+			{Name: "AIS_TYPE_OTHER_HAZARDOUS_A", Value: 91},
+			{Name: "AIS_TYPE_OTHER_HAZARDOUS_B", Value: 92},
+			{Name: "AIS_TYPE_OTHER_HAZARDOUS_C", Value: 93},
+			{Name: "AIS_TYPE_OTHER_HAZARDOUS_D", Value: 94},
+			{Name: "AIS_TYPE_OTHER_RESERVED_1", Value: 95},
+			{Name: "AIS_TYPE_OTHER_RESERVED_2", Value: 96},
+			{Name: "AIS_TYPE_OTHER_RESERVED_3", Value: 97},
+			{Name: "AIS_TYPE_OTHER_RESERVED_4", Value: 98},
+			{Name: "AIS_TYPE_OTHER_UNKNOWN", Value: 99},
+		},
+	},
+	"ATTITUDE_TARGET_TYPEMASK": {
+		Name:        "ATTITUDE_TARGET_TYPEMASK",
+		Description: "Bitmap to indicate which dimensions should be ignored by the vehicle: a value of 0b00000000 indicates that none of the setpoint dimensions should be ignored.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ATTITUDE_TARGET_TYPEMASK_BODY_ROLL_RATE_IGNORE", Value: 1, Description: "Ignore body roll rate"},
+			{Name: "ATTITUDE_TARGET_TYPEMASK_BODY_PITCH_RATE_IGNORE", Value: 2, Description: "Ignore body pitch rate"},
+			{Name: "ATTITUDE_TARGET_TYPEMASK_BODY_YAW_RATE_IGNORE", Value: 4, Description: "Ignore body yaw rate"},
+			{Name: "ATTITUDE_TARGET_TYPEMASK_THRUST_BODY_SET", Value: 32, Description: "Use 3D body thrust setpoint instead of throttle"},
+			{Name: "ATTITUDE_TARGET_TYPEMASK_THROTTLE_IGNORE", Value: 64, Description: "Ignore throttle"},
+			{Name: "ATTITUDE_TARGET_TYPEMASK_ATTITUDE_IGNORE", Value: 128, Description: "Ignore attitude"},
+		},
+	},
+	"AUTOTUNE_AXIS": {
+		Name:        "AUTOTUNE_AXIS",
+		Description: "Axes that will be autotuned by MAV_CMD_DO_AUTOTUNE_ENABLE. Note that at least one flag must be set in MAV_CMD_DO_AUTOTUNE_ENABLE.param2: if none are set, the flight stack will tune its default set of axes.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AUTOTUNE_AXIS_ROLL", Value: 1, Description: "Autotune roll axis."},
+			{Name: "AUTOTUNE_AXIS_PITCH", Value: 2, Description: "Autotune pitch axis."},
+			{Name: "AUTOTUNE_AXIS_YAW", Value: 4, Description: "Autotune yaw axis."},
+		},
+	},
+	"CAMERA_CAP_FLAGS": {
+		Name:        "CAMERA_CAP_FLAGS",
+		Description: "Camera capability flags (Bitmap)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_CAP_FLAGS_CAPTURE_VIDEO", Value: 1, Description: "Camera is able to record video"},
+			{Name: "CAMERA_CAP_FLAGS_CAPTURE_IMAGE", Value: 2, Description: "Camera is able to capture images"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_MODES", Value: 4, Description: "Camera has separate Video and Image/Photo modes (MAV_CMD_SET_CAMERA_MODE)"},
+			{Name: "CAMERA_CAP_FLAGS_CAN_CAPTURE_IMAGE_IN_VIDEO_MODE", Value: 8, Description: "Camera can capture images while in video mode"},
+			{Name: "CAMERA_CAP_FLAGS_CAN_CAPTURE_VIDEO_IN_IMAGE_MODE", Value: 16, Description: "Camera can capture videos while in Photo/Image mode"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_IMAGE_SURVEY_MODE", Value: 32, Description: "Camera has image survey mode (MAV_CMD_SET_CAMERA_MODE)"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_BASIC_ZOOM", Value: 64, Description: "Camera has basic zoom control (MAV_CMD_SET_CAMERA_ZOOM)"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_BASIC_FOCUS", Value: 128, Description: "Camera has basic focus control (MAV_CMD_SET_CAMERA_FOCUS)"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_VIDEO_STREAM", Value: 256, Description: "Camera has video streaming capabilities (request VIDEO_STREAM_INFORMATION with MAV_CMD_REQUEST_MESSAGE for video streaming info)"},
+			{Name: "CAMERA_CAP_FLAGS_HAS_TRACKING_POINT", Value: 512, Description: "Camera supports tracking of a point on the camera view."},
+			{Name: "CAMERA_CAP_FLAGS_HAS_TRACKING_RECTANGLE", Value: 1024, Description: "Camera supports tracking of a selection rectangle on the camera view."},
+			{Name: "CAMERA_CAP_FLAGS_HAS_TRACKING_GEO_STATUS", Value: 2048, Description: "Camera supports tracking geo status (CAMERA_TRACKING_GEO_STATUS)."},
+			{Name: "CAMERA_CAP_FLAGS_HAS_THERMAL_RANGE", Value: 4096, Description: "Camera supports absolute thermal range (request CAMERA_THERMAL_RANGE with MAV_CMD_REQUEST_MESSAGE)."},
+			{Name: "CAMERA_CAP_FLAGS_HAS_MTI", Value: 8192, Description: "Camera supports Moving Target Indicators (MTI) on the camera view (using MAV_CMD_CAMERA_START_MTI)."},
+		},
+	},
+	"CAMERA_MODE": {
+		Name:        "CAMERA_MODE",
+		Description: "Camera Modes.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_MODE_IMAGE", Value: 0, Description: "Camera is in image/photo capture mode."},
+			{Name: "CAMERA_MODE_VIDEO", Value: 1, Description: "Camera is in video capture mode."},
+			{Name: "CAMERA_MODE_IMAGE_SURVEY", Value: 2, Description: "Camera is in image survey capture mode. It allows for camera controller to do specific settings for surveys."},
+		},
+	},
+	"CAMERA_SOURCE": {
+		Name:        "CAMERA_SOURCE",
+		Description: "Camera sources for MAV_CMD_SET_CAMERA_SOURCE",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_SOURCE_DEFAULT", Value: 0, Description: "Default camera source."},
+			{Name: "CAMERA_SOURCE_RGB", Value: 1, Description: "RGB camera source."},
+			{Name: "CAMERA_SOURCE_IR", Value: 2, Description: "IR camera source."},
+			{Name: "CAMERA_SOURCE_NDVI", Value: 3, Description: "NDVI camera source."},
+		},
+	},
+	"CAMERA_TRACKING_MODE": {
+		Name:        "CAMERA_TRACKING_MODE",
+		Description: "Camera tracking modes",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_TRACKING_MODE_NONE", Value: 0, Description: "Not tracking"},
+			{Name: "CAMERA_TRACKING_MODE_POINT", Value: 1, Description: "Target is a point"},
+			{Name: "CAMERA_TRACKING_MODE_RECTANGLE", Value: 2, Description: "Target is a rectangle"},
+		},
+	},
+	"CAMERA_TRACKING_STATUS_FLAGS": {
+		Name:        "CAMERA_TRACKING_STATUS_FLAGS",
+		Description: "Camera tracking status flags",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_TRACKING_STATUS_FLAGS_IDLE", Value: 0, Description: "Camera is not tracking"},
+			{Name: "CAMERA_TRACKING_STATUS_FLAGS_ACTIVE", Value: 1, Description: "Camera is tracking"},
+			{Name: "CAMERA_TRACKING_STATUS_FLAGS_ERROR", Value: 2, Description: "Camera tracking in error state"},
+			{Name: "CAMERA_TRACKING_STATUS_FLAGS_MTI", Value: 4, Description: "Camera Moving Target Indicators (MTI) are active"},
+			{Name: "CAMERA_TRACKING_STATUS_FLAGS_COASTING", Value: 8, Description: "Camera tracking target is obscured and is being predicted"},
+		},
+	},
+	"CAMERA_TRACKING_TARGET_DATA": {
+		Name:        "CAMERA_TRACKING_TARGET_DATA",
+		Description: "Camera tracking target data (shows where tracked target is within image)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAMERA_TRACKING_TARGET_DATA_EMBEDDED", Value: 1, Description: "Target data embedded in image data (proprietary)"},
+			{Name: "CAMERA_TRACKING_TARGET_DATA_RENDERED", Value: 2, Description: "Target data rendered in image"},
+			{Name: "CAMERA_TRACKING_TARGET_DATA_IN_STATUS", Value: 4, Description: "Target data within status message (Point or Rectangle)"},
+		},
+	},
+	"CAMERA_ZOOM_TYPE": {
+		Name:        "CAMERA_ZOOM_TYPE",
+		Description: "Zoom types for MAV_CMD_SET_CAMERA_ZOOM",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ZOOM_TYPE_STEP", Value: 0, Description: "Zoom one step increment (-1 for wide, 1 for tele)"},
+			{Name: "ZOOM_TYPE_CONTINUOUS", Value: 1, Description: "Continuous normalized zoom in/out rate until stopped. Range -1..1, negative: wide, positive: narrow/tele, 0 to stop zooming. Other values should be clipped to the range."},
+			{Name: "ZOOM_TYPE_RANGE", Value: 2, Description: "Zoom value as proportion of full camera range (a percentage value between 0.0 and 100.0)"},
+			{Name: "ZOOM_TYPE_FOCAL_LENGTH", Value: 3, Description: "Zoom value/variable focal length in millimetres. Note that there is no message to get the valid zoom range of the camera, so this can type can only be used for cameras where the zoom range is known (implying that this cannot reliably be used in a GCS for an arbitrary camera)"},
+			{Name: "ZOOM_TYPE_HORIZONTAL_FOV", Value: 4, Description: "Zoom value as horizontal field of view in degrees."},
+		},
+	},
+	"CAN_FILTER_OP": {
+		Name:        "CAN_FILTER_OP",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CAN_FILTER_REPLACE", Value: 0},
+			{Name: "CAN_FILTER_ADD", Value: 1},
+			{Name: "CAN_FILTER_REMOVE", Value: 2},
+		},
+	},
+	"CELLULAR_CONFIG_RESPONSE": {
+		Name:        "CELLULAR_CONFIG_RESPONSE",
+		Description: "Possible responses from a CELLULAR_CONFIG message.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CELLULAR_CONFIG_RESPONSE_ACCEPTED", Value: 0, Description: "Changes accepted."},
+			{Name: "CELLULAR_CONFIG_RESPONSE_APN_ERROR", Value: 1, Description: "Invalid APN."},
+			{Name: "CELLULAR_CONFIG_RESPONSE_PIN_ERROR", Value: 2, Description: "Invalid PIN."},
+			{Name: "CELLULAR_CONFIG_RESPONSE_REJECTED", Value: 3, Description: "Changes rejected."},
+			{Name: "CELLULAR_CONFIG_BLOCKED_PUK_REQUIRED", Value: 4, Description: "PUK is required to unblock SIM card."},
+		},
+	},
+	"CELLULAR_NETWORK_FAILED_REASON": {
+		Name:        "CELLULAR_NETWORK_FAILED_REASON",
+		Description: "These flags are used to diagnose the failure state of CELLULAR_STATUS",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CELLULAR_NETWORK_FAILED_REASON_NONE", Value: 0, Description: "No error"},
+			{Name: "CELLULAR_NETWORK_FAILED_REASON_UNKNOWN", Value: 1, Description: "Error state is unknown"},
+			{Name: "CELLULAR_NETWORK_FAILED_REASON_SIM_MISSING", Value: 2, Description: "SIM is required for the modem but missing"},
+			{Name: "CELLULAR_NETWORK_FAILED_REASON_SIM_ERROR", Value: 3, Description: "SIM is available, but not usable for connection"},
+		},
+	},
+	"CELLULAR_NETWORK_RADIO_TYPE": {
+		Name:        "CELLULAR_NETWORK_RADIO_TYPE",
+		Description: "Cellular network radio type",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CELLULAR_NETWORK_RADIO_TYPE_NONE", Value: 0},
+			{Name: "CELLULAR_NETWORK_RADIO_TYPE_GSM", Value: 1},
+			{Name: "CELLULAR_NETWORK_RADIO_TYPE_CDMA", Value: 2},
+			{Name: "CELLULAR_NETWORK_RADIO_TYPE_WCDMA", Value: 3},
+			{Name: "CELLULAR_NETWORK_RADIO_TYPE_LTE", Value: 4},
+		},
+	},
+	"CELLULAR_STATUS_FLAG": {
+		Name:        "CELLULAR_STATUS_FLAG",
+		Description: "These flags encode the cellular network status",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "CELLULAR_STATUS_FLAG_UNKNOWN", Value: 0, Description: "State unknown or not reportable."},
+			{Name: "CELLULAR_STATUS_FLAG_FAILED", Value: 1, Description: "Modem is unusable"},
+			{Name: "CELLULAR_STATUS_FLAG_INITIALIZING", Value: 2, Description: "Modem is being initialized"},
+			{Name: "CELLULAR_STATUS_FLAG_LOCKED", Value: 3, Description: "Modem is locked"},
+			{Name: "CELLULAR_STATUS_FLAG_DISABLED", Value: 4, Description: "Modem is not enabled and is powered down"},
+			{Name: "CELLULAR_STATUS_FLAG_DISABLING", Value: 5, Description: "Modem is currently transitioning to the CELLULAR_STATUS_FLAG_DISABLED state"},
+			{Name: "CELLULAR_STATUS_FLAG_ENABLING", Value: 6, Description: "Modem is currently transitioning to the CELLULAR_STATUS_FLAG_ENABLED state"},
+			{Name: "CELLULAR_STATUS_FLAG_ENABLED", Value: 7, Description: "Modem is enabled and powered on but not registered with a network provider and not available for data connections"},
+			{Name: "CELLULAR_STATUS_FLAG_SEARCHING", Value: 8, Description: "Modem is searching for a network provider to register"},
+			{Name: "CELLULAR_STATUS_FLAG_REGISTERED", Value: 9, Description: "Modem is registered with a network provider, and data connections and messaging may be available for use"},
+			{Name: "CELLULAR_STATUS_FLAG_DISCONNECTING", Value: 10, Description: "Modem is disconnecting and deactivating the last active packet data bearer. This state will not be entered if more than one packet data bearer is active and one of the active bearers is deactivated"},
+			{Name: "CELLULAR_STATUS_FLAG_CONNECTING", Value: 11, Description: "Modem is activating and connecting the first packet data bearer. Subsequent bearer activations when another bearer is already active do not cause this state to be entered"},
+			{Name: "CELLULAR_STATUS_FLAG_CONNECTED", Value: 12, Description: "One or more packet data bearers is active and connected"},
+		},
+	},
+	"COMPUTER_STATUS_FLAGS": {
+		Name:        "COMPUTER_STATUS_FLAGS",
+		Description: "Flags used to report computer status.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "COMPUTER_STATUS_FLAGS_UNDER_VOLTAGE", Value: 1, Description: "Indicates if the system is experiencing voltage outside of acceptable range."},
+			{Name: "COMPUTER_STATUS_FLAGS_CPU_THROTTLE", Value: 2, Description: "Indicates if CPU throttling is active."},
+			{Name: "COMPUTER_STATUS_FLAGS_THERMAL_THROTTLE", Value: 4, Description: "Indicates if thermal throttling is active."},
+			{Name: "COMPUTER_STATUS_FLAGS_DISK_FULL", Value: 8, Description: "Indicates if main disk is full."},
+		},
+	},
+	"COMP_METADATA_TYPE": {
+		Name:        "COMP_METADATA_TYPE",
+		Description: "Supported component metadata types. These are used in the \"general\" metadata file returned by COMPONENT_METADATA to provide information about supported metadata types. The types are not used directly in MAVLink messages.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "COMP_METADATA_TYPE_GENERAL", Value: 0, Description: "General information about the component. General metadata includes information about other metadata types supported by the component. Files of this type must be supported, and must be downloadable from vehicle using a MAVLink FTP URI."},
+			{Name: "COMP_METADATA_TYPE_PARAMETER", Value: 1, Description: "Parameter meta data."},
+			{Name: "COMP_METADATA_TYPE_COMMANDS", Value: 2, Description: "Meta data that specifies which commands and command parameters the vehicle supports. (WIP)"},
+			{Name: "COMP_METADATA_TYPE_PERIPHERALS", Value: 3, Description: "Meta data that specifies external non-MAVLink peripherals."},
+			{Name: "COMP_METADATA_TYPE_EVENTS", Value: 4, Description: "Meta data for the events interface."},
+			{Name: "COMP_METADATA_TYPE_ACTUATORS", Value: 5, Description: "Meta data for actuator configuration (motors, servos and vehicle geometry) and testing."},
+		},
+	},
+	"ENGINE_CONTROL_OPTIONS": {
+		Name:        "ENGINE_CONTROL_OPTIONS",
+		Description: "Engine control options",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ENGINE_CONTROL_OPTIONS_ALLOW_START_WHILE_DISARMED", Value: 1, Description: "Allow starting the engine while disarmed (without changing the vehicle's armed state). This effectively arms just the ICE, without arming the vehicle to start other motors or propellers."},
+		},
+	},
+	"ESC_CONNECTION_TYPE": {
+		Name:        "ESC_CONNECTION_TYPE",
+		Description: "Indicates the ESC connection type.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ESC_CONNECTION_TYPE_PPM", Value: 0, Description: "Traditional PPM ESC."},
+			{Name: "ESC_CONNECTION_TYPE_SERIAL", Value: 1, Description: "Serial Bus connected ESC."},
+			{Name: "ESC_CONNECTION_TYPE_ONESHOT", Value: 2, Description: "One Shot PPM ESC."},
+			{Name: "ESC_CONNECTION_TYPE_I2C", Value: 3, Description: "I2C ESC."},
+			{Name: "ESC_CONNECTION_TYPE_CAN", Value: 4, Description: "CAN-Bus ESC."},
+			{Name: "ESC_CONNECTION_TYPE_DSHOT", Value: 5, Description: "DShot ESC."},
+		},
+	},
+	"ESC_FAILURE_FLAGS": {
+		Name:        "ESC_FAILURE_FLAGS",
+		Description: "Flags to report ESC failures.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ESC_FAILURE_OVER_CURRENT", Value: 1, Description: "Over current failure."},
+			{Name: "ESC_FAILURE_OVER_VOLTAGE", Value: 2, Description: "Over voltage failure."},
+			{Name: "ESC_FAILURE_OVER_TEMPERATURE", Value: 4, Description: "Over temperature failure."},
+			{Name: "ESC_FAILURE_OVER_RPM", Value: 8, Description: "Over RPM failure."},
+			{Name: "ESC_FAILURE_INCONSISTENT_CMD", Value: 16, Description: "Inconsistent command failure i.e. out of bounds."},
+			{Name: "ESC_FAILURE_MOTOR_STUCK", Value: 32, Description: "Motor stuck failure."},
+			{Name: "ESC_FAILURE_GENERIC", Value: 64, Description: "Generic ESC failure."},
+		},
+	},
+	"ESTIMATOR_STATUS_FLAGS": {
+		Name:        "ESTIMATOR_STATUS_FLAGS",
+		Description: "Flags in ESTIMATOR_STATUS message",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ESTIMATOR_ATTITUDE", Value: 1, Description: "True if the attitude estimate is good"},
+			{Name: "ESTIMATOR_VELOCITY_HORIZ", Value: 2, Description: "True if the horizontal velocity estimate is good"},
+			{Name: "ESTIMATOR_VELOCITY_VERT", Value: 4, Description: "True if the  vertical velocity estimate is good"},
+			{Name: "ESTIMATOR_POS_HORIZ_REL", Value: 8, Description: "True if the horizontal position (relative) estimate is good"},
+			{Name: "ESTIMATOR_POS_HORIZ_ABS", Value: 16, Description: "True if the horizontal position (absolute) estimate is good"},
+			{Name: "ESTIMATOR_POS_VERT_ABS", Value: 32, Description: "True if the vertical position (absolute) estimate is good"},
+			{Name: "ESTIMATOR_POS_VERT_AGL", Value: 64, Description: "True if the vertical position (above ground) estimate is good"},
+			{Name: "ESTIMATOR_CONST_POS_MODE", Value: 128, Description: "True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)"},
+			{Name: "ESTIMATOR_PRED_POS_HORIZ_REL", Value: 256, Description: "True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate"},
+			{Name: "ESTIMATOR_PRED_POS_HORIZ_ABS", Value: 512, Description: "True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate"},
+			{Name: "ESTIMATOR_GPS_GLITCH", Value: 1024, Description: "True if the EKF has detected a GPS glitch"},
+			{Name: "ESTIMATOR_ACCEL_ERROR", Value: 2048, Description: "True if the EKF has detected bad accelerometer data"},
+		},
+	},
+	"FAILURE_TYPE": {
+		Name:        "FAILURE_TYPE",
+		Description: "List of possible failure type to inject.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FAILURE_TYPE_OK", Value: 0, Description: "No failure injected, used to reset a previous failure."},
+			{Name: "FAILURE_TYPE_OFF", Value: 1, Description: "Sets unit off, so completely non-responsive."},
+			{Name: "FAILURE_TYPE_STUCK", Value: 2, Description: "Unit is stuck e.g. keeps reporting the same value."},
+			{Name: "FAILURE_TYPE_GARBAGE", Value: 3, Description: "Unit is reporting complete garbage."},
+			{Name: "FAILURE_TYPE_WRONG", Value: 4, Description: "Unit is consistently wrong."},
+			{Name: "FAILURE_TYPE_SLOW", Value: 5, Description: "Unit is slow, so e.g. reporting at slower than expected rate."},
+			{Name: "FAILURE_TYPE_DELAYED", Value: 6, Description: "Data of unit is delayed in time."},
+			{Name: "FAILURE_TYPE_INTERMITTENT", Value: 7, Description: "Unit is sometimes working, sometimes not."},
+			{Name: "FAILURE_TYPE_DRIFT", Value: 8, Description: "Unit is publishing plausible values but drifting away from true values."},
+		},
+	},
+	"FAILURE_UNIT": {
+		Name:        "FAILURE_UNIT",
+		Description: "List of possible units where failures can be injected.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FAILURE_UNIT_SENSOR_GYRO", Value: 0},
+			{Name: "FAILURE_UNIT_SENSOR_ACCEL", Value: 1},
+			{Name: "FAILURE_UNIT_SENSOR_MAG", Value: 2},
+			{Name: "FAILURE_UNIT_SENSOR_BARO", Value: 3},
+			{Name: "FAILURE_UNIT_SENSOR_GPS", Value: 4},
+			{Name: "FAILURE_UNIT_SENSOR_OPTICAL_FLOW", Value: 5},
+			{Name: "FAILURE_UNIT_SENSOR_VIO", Value: 6},
+			{Name: "FAILURE_UNIT_SENSOR_DISTANCE_SENSOR", Value: 7},
+			{Name: "FAILURE_UNIT_SENSOR_AIRSPEED", Value: 8},
+			{Name: "FAILURE_UNIT_SYSTEM_BATTERY", Value: 100},
+			{Name: "FAILURE_UNIT_SYSTEM_MOTOR", Value: 101, Description: "Interrupts the commanded output to the motor."},
+			{Name: "FAILURE_UNIT_SYSTEM_SERVO", Value: 102},
+			{Name: "FAILURE_UNIT_SYSTEM_AVOIDANCE", Value: 103},
+			{Name: "FAILURE_UNIT_SYSTEM_RC_SIGNAL", Value: 104},
+			{Name: "FAILURE_UNIT_SYSTEM_MAVLINK_SIGNAL", Value: 105},
+			{Name: "FAILURE_UNIT_SYSTEM_ESC", Value: 106, Description: "Interrupts the telemetry reported by the ESC."},
+			{Name: "FAILURE_UNIT_SYSTEM_TRAFFIC_AVOIDANCE", Value: 107, Description: "Traffic avoidance system like ADS-B or FLARM."},
+			{Name: "FAILURE_UNIT_DATALINK_LTE", Value: 150, Description: "Data link over a cellular (LTE) connection."},
+			{Name: "FAILURE_UNIT_DATALINK_WIFI", Value: 151, Description: "Data link over a Wi-Fi connection."},
+			{Name: "FAILURE_UNIT_DATALINK_TELEM_RADIO", Value: 152, Description: "Data link over a telemetry radio, e.g. a SiK radio."},
+			{Name: "FAILURE_UNIT_BUS_CAN", Value: 200, Description: "CAN bus. Instance is the bus number, e.g. 1 for CAN1."},
+			{Name: "FAILURE_UNIT_BUS_I2C", Value: 201, Description: "I2C bus. Instance is the bus number, e.g. 1 for I2C1."},
+		},
+	},
+	"FENCE_BREACH": {
+		Name:        "FENCE_BREACH",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FENCE_BREACH_NONE", Value: 0, Description: "No last fence breach"},
+			{Name: "FENCE_BREACH_MINALT", Value: 1, Description: "Breached minimum altitude"},
+			{Name: "FENCE_BREACH_MAXALT", Value: 2, Description: "Breached maximum altitude"},
+			{Name: "FENCE_BREACH_BOUNDARY", Value: 3, Description: "Breached fence boundary"},
+		},
+	},
+	"FENCE_MITIGATE": {
+		Name:        "FENCE_MITIGATE",
+		Description: "Actions being taken to mitigate/prevent fence breach",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FENCE_MITIGATE_UNKNOWN", Value: 0, Description: "Unknown"},
+			{Name: "FENCE_MITIGATE_NONE", Value: 1, Description: "No actions being taken"},
+			{Name: "FENCE_MITIGATE_VEL_LIMIT", Value: 2, Description: "Velocity limiting active to prevent breach"},
+		},
+	},
+	"FENCE_TYPE": {
+		Name:        "FENCE_TYPE",
+		Description: "Fence types to enable or disable when using MAV_CMD_DO_FENCE_ENABLE. Note that at least one of these flags must be set in MAV_CMD_DO_FENCE_ENABLE.param2. If none are set, the flight stack will ignore the field and enable/disable its default set of fences (usually all of them).",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FENCE_TYPE_ALT_MAX", Value: 1, Description: "Maximum altitude fence"},
+			{Name: "FENCE_TYPE_CIRCLE", Value: 2, Description: "Circle fence"},
+			{Name: "FENCE_TYPE_POLYGON", Value: 4, Description: "Polygon fence"},
+			{Name: "FENCE_TYPE_ALT_MIN", Value: 8, Description: "Minimum altitude fence"},
+		},
+	},
+	"FIRMWARE_VERSION_TYPE": {
+		Name:        "FIRMWARE_VERSION_TYPE",
+		Description: "These values define the type of firmware release.  These values indicate the first version or release of this type.  For example the first alpha release would be 64, the second would be 65.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FIRMWARE_VERSION_TYPE_DEV", Value: 0, Description: "development release"},
+			{Name: "FIRMWARE_VERSION_TYPE_ALPHA", Value: 64, Description: "alpha release"},
+			{Name: "FIRMWARE_VERSION_TYPE_BETA", Value: 128, Description: "beta release"},
+			{Name: "FIRMWARE_VERSION_TYPE_RC", Value: 192, Description: "release candidate"},
+			{Name: "FIRMWARE_VERSION_TYPE_OFFICIAL", Value: 255, Description: "official stable release"},
+		},
+	},
+	"GIMBAL_DEVICE_CAP_FLAGS": {
+		Name:        "GIMBAL_DEVICE_CAP_FLAGS",
+		Description: "Gimbal device (low level) capability flags (bitmap).",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_RETRACT", Value: 1, Description: "Gimbal device supports a retracted position."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_NEUTRAL", Value: 2, Description: "Gimbal device supports a horizontal, forward looking position, stabilized."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_AXIS", Value: 4, Description: "Gimbal device supports rotating around roll axis."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_FOLLOW", Value: 8, Description: "Gimbal device supports to follow a roll angle relative to the vehicle."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_LOCK", Value: 16, Description: "Gimbal device supports locking to a roll angle (generally that's the default with roll stabilized)."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_AXIS", Value: 32, Description: "Gimbal device supports rotating around pitch axis."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_FOLLOW", Value: 64, Description: "Gimbal device supports to follow a pitch angle relative to the vehicle."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_LOCK", Value: 128, Description: "Gimbal device supports locking to a pitch angle (generally that's the default with pitch stabilized)."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_AXIS", Value: 256, Description: "Gimbal device supports rotating around yaw axis."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_FOLLOW", Value: 512, Description: "Gimbal device supports to follow a yaw angle relative to the vehicle (generally that's the default)."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_LOCK", Value: 1024, Description: "Gimbal device supports locking to an absolute heading, i.e., yaw angle relative to North (earth frame, often this is an option available)."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_SUPPORTS_INFINITE_YAW", Value: 2048, Description: "Gimbal device supports yawing/panning infinitely (e.g. using slip disk)."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_SUPPORTS_YAW_IN_EARTH_FRAME", Value: 4096, Description: "Gimbal device supports yaw angles and angular velocities relative to North (earth frame). This usually requires support by an autopilot via AUTOPILOT_STATE_FOR_GIMBAL_DEVICE. Support can go on and off during runtime, which is reported by the flag GIMBAL_DEVICE_FLAGS_CAN_ACCEPT_YAW_IN_EARTH_FRAME."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_HAS_RC_INPUTS", Value: 8192, Description: "Gimbal device supports radio control inputs as an alternative input for controlling the gimbal orientation."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_CAN_POINT_LOCATION_LOCAL", Value: 65536, Description: "Gimbal device supports to point to a local position."},
+			{Name: "GIMBAL_DEVICE_CAP_FLAGS_CAN_POINT_LOCATION_GLOBAL", Value: 131072, Description: "Gimbal device supports to point to a global latitude, longitude, altitude position."},
+		},
+	},
+	"GIMBAL_DEVICE_ERROR_FLAGS": {
+		Name:        "GIMBAL_DEVICE_ERROR_FLAGS",
+		Description: "Gimbal device (low level) error flags (bitmap, 0 means no error)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_AT_ROLL_LIMIT", Value: 1, Description: "Gimbal device is limited by hardware roll limit."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_AT_PITCH_LIMIT", Value: 2, Description: "Gimbal device is limited by hardware pitch limit."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_AT_YAW_LIMIT", Value: 4, Description: "Gimbal device is limited by hardware yaw limit."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_ENCODER_ERROR", Value: 8, Description: "There is an error with the gimbal encoders."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_POWER_ERROR", Value: 16, Description: "There is an error with the gimbal power source."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_MOTOR_ERROR", Value: 32, Description: "There is an error with the gimbal motors."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_SOFTWARE_ERROR", Value: 64, Description: "There is an error with the gimbal's software."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_COMMS_ERROR", Value: 128, Description: "There is an error with the gimbal's communication."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_CALIBRATION_RUNNING", Value: 256, Description: "Gimbal device is currently calibrating."},
+			{Name: "GIMBAL_DEVICE_ERROR_FLAGS_NO_MANAGER", Value: 512, Description: "Gimbal device is not assigned to a gimbal manager."},
+		},
+	},
+	"GIMBAL_DEVICE_FLAGS": {
+		Name:        "GIMBAL_DEVICE_FLAGS",
+		Description: "Flags for gimbal device (lower level) operation.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GIMBAL_DEVICE_FLAGS_RETRACT", Value: 1, Description: "Set to retracted safe position (no stabilization), takes precedence over all other flags."},
+			{Name: "GIMBAL_DEVICE_FLAGS_NEUTRAL", Value: 2, Description: "Set to neutral/default position, taking precedence over all other flags except RETRACT. Neutral is commonly forward-facing and horizontal (roll=pitch=yaw=0) but may be any orientation."},
+			{Name: "GIMBAL_DEVICE_FLAGS_ROLL_LOCK", Value: 4, Description: "Lock roll angle to absolute angle relative to horizon (not relative to vehicle). This is generally the default with a stabilizing gimbal."},
+			{Name: "GIMBAL_DEVICE_FLAGS_PITCH_LOCK", Value: 8, Description: "Lock pitch angle to absolute angle relative to horizon (not relative to vehicle). This is generally the default with a stabilizing gimbal."},
+			{Name: "GIMBAL_DEVICE_FLAGS_YAW_LOCK", Value: 16, Description: "Lock yaw angle to absolute angle relative to North (not relative to vehicle). If this flag is set, the yaw angle and z component of angular velocity are relative to North (earth frame, x-axis pointing North), else they are relative to the vehicle heading (vehicle frame, earth frame rotated so that the x-axis is pointing forward)."},
+			{Name: "GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME", Value: 32, Description: "Yaw angle and z component of angular velocity are relative to the vehicle heading (vehicle frame, earth frame rotated such that the x-axis is pointing forward)."},
+			{Name: "GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME", Value: 64, Description: "Yaw angle and z component of angular velocity are relative to North (earth frame, x-axis is pointing North)."},
+			{Name: "GIMBAL_DEVICE_FLAGS_ACCEPTS_YAW_IN_EARTH_FRAME", Value: 128, Description: "Gimbal device can accept yaw angle inputs relative to North (earth frame). This flag is only for reporting (attempts to set this flag are ignored)."},
+			{Name: "GIMBAL_DEVICE_FLAGS_RC_EXCLUSIVE", Value: 256, Description: "The gimbal orientation is set exclusively by the RC signals feed to the gimbal's radio control inputs. MAVLink messages for setting the gimbal orientation (GIMBAL_DEVICE_SET_ATTITUDE) are ignored."},
+			{Name: "GIMBAL_DEVICE_FLAGS_RC_MIXED", Value: 512, Description: "The gimbal orientation is determined by combining/mixing the RC signals feed to the gimbal's radio control inputs and the MAVLink messages for setting the gimbal orientation (GIMBAL_DEVICE_SET_ATTITUDE). How these two controls are combined or mixed is not defined by the protocol but is up to the implementation."},
+		},
+	},
+	"GIMBAL_MANAGER_CAP_FLAGS": {
+		Name:        "GIMBAL_MANAGER_CAP_FLAGS",
+		Description: "Gimbal manager high level capability flags (bitmap). The flags are identical to the GIMBAL_DEVICE_CAP_FLAGS. However, the gimbal manager does not need to copy the flags from the gimbal but can also enhance the capabilities and thus add flags.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_RETRACT", Value: 1, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_RETRACT."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_NEUTRAL", Value: 2, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_NEUTRAL."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_ROLL_AXIS", Value: 4, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_AXIS."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_ROLL_FOLLOW", Value: 8, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_FOLLOW."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_ROLL_LOCK", Value: 16, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_ROLL_LOCK."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_PITCH_AXIS", Value: 32, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_AXIS."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_PITCH_FOLLOW", Value: 64, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_FOLLOW."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_PITCH_LOCK", Value: 128, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_PITCH_LOCK."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_YAW_AXIS", Value: 256, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_AXIS."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_YAW_FOLLOW", Value: 512, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_FOLLOW."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_YAW_LOCK", Value: 1024, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_YAW_LOCK."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_SUPPORTS_INFINITE_YAW", Value: 2048, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_SUPPORTS_INFINITE_YAW."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_SUPPORTS_YAW_IN_EARTH_FRAME", Value: 4096, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_SUPPORTS_YAW_IN_EARTH_FRAME."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_HAS_RC_INPUTS", Value: 8192, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_HAS_RC_INPUTS."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_CAN_POINT_LOCATION_LOCAL", Value: 65536, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_CAN_POINT_LOCATION_LOCAL."},
+			{Name: "GIMBAL_MANAGER_CAP_FLAGS_CAN_POINT_LOCATION_GLOBAL", Value: 131072, Description: "Based on GIMBAL_DEVICE_CAP_FLAGS_CAN_POINT_LOCATION_GLOBAL."},
+		},
+	},
+	"GIMBAL_MANAGER_FLAGS": {
+		Name:        "GIMBAL_MANAGER_FLAGS",
+		Description: "Flags for high level gimbal manager operation The first 16 bits are identical to the GIMBAL_DEVICE_FLAGS.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GIMBAL_MANAGER_FLAGS_RETRACT", Value: 1, Description: "Based on GIMBAL_DEVICE_FLAGS_RETRACT."},
+			{Name: "GIMBAL_MANAGER_FLAGS_NEUTRAL", Value: 2, Description: "Based on GIMBAL_DEVICE_FLAGS_NEUTRAL."},
+			{Name: "GIMBAL_MANAGER_FLAGS_ROLL_LOCK", Value: 4, Description: "Based on GIMBAL_DEVICE_FLAGS_ROLL_LOCK."},
+			{Name: "GIMBAL_MANAGER_FLAGS_PITCH_LOCK", Value: 8, Description: "Based on GIMBAL_DEVICE_FLAGS_PITCH_LOCK."},
+			{Name: "GIMBAL_MANAGER_FLAGS_YAW_LOCK", Value: 16, Description: "Based on GIMBAL_DEVICE_FLAGS_YAW_LOCK."},
+			{Name: "GIMBAL_MANAGER_FLAGS_YAW_IN_VEHICLE_FRAME", Value: 32, Description: "Based on GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME."},
+			{Name: "GIMBAL_MANAGER_FLAGS_YAW_IN_EARTH_FRAME", Value: 64, Description: "Based on GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME."},
+			{Name: "GIMBAL_MANAGER_FLAGS_ACCEPTS_YAW_IN_EARTH_FRAME", Value: 128, Description: "Based on GIMBAL_DEVICE_FLAGS_ACCEPTS_YAW_IN_EARTH_FRAME."},
+			{Name: "GIMBAL_MANAGER_FLAGS_RC_EXCLUSIVE", Value: 256, Description: "Based on GIMBAL_DEVICE_FLAGS_RC_EXCLUSIVE."},
+			{Name: "GIMBAL_MANAGER_FLAGS_RC_MIXED", Value: 512, Description: "Based on GIMBAL_DEVICE_FLAGS_RC_MIXED."},
+		},
+	},
+	"GLOBAL_POSITION_FLAGS": {
+		Name:        "GLOBAL_POSITION_FLAGS",
+		Description: "Status flags for GLOBAL_POSITION",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GLOBAL_POSITION_UNHEALTHY", Value: 1, Description: "Unhealthy sensor/estimator."},
+			{Name: "GLOBAL_POSITION_PRIMARY", Value: 2, Description: "True if the data originates from or is consumed by the primary estimator."},
+		},
+	},
+	"GLOBAL_POSITION_SRC": {
+		Name:        "GLOBAL_POSITION_SRC",
+		Description: "Source for GLOBAL_POSITION measurement or estimate.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GLOBAL_POSITION_SRC_UNKNOWN", Value: 0, Description: "Source is unknown or not one of the listed types."},
+			{Name: "GLOBAL_POSITION_SRC_GNSS", Value: 1, Description: "Global Navigation Satellite System (e.g.: GPS, Galileo, Glonass, BeiDou)."},
+			{Name: "GLOBAL_POSITION_SRC_VISION", Value: 2, Description: "Vision system (e.g.: map matching)."},
+			{Name: "GLOBAL_POSITION_SRC_PSEUDOLITES", Value: 3, Description: "A pseudo-satellite system using transceiver beacons to perform GNSS-like positioning."},
+			{Name: "GLOBAL_POSITION_SRC_TERRAIN", Value: 4, Description: "Terrain referenced navigation."},
+			{Name: "GLOBAL_POSITION_SRC_MAGNETIC", Value: 5, Description: "Magnetic positioning."},
+			{Name: "GLOBAL_POSITION_SRC_ESTIMATOR", Value: 6, Description: "Estimated position based on various sensors (eg. a Kalman Filter)."},
+			{Name: "GLOBAL_POSITION_SRC_LEO", Value: 7, Description: "Low Earth Orbit satellite-based positioning (e.g.: Starlink, Xona PULSAR)."},
+		},
+	},
+	"GPS_FIX_TYPE": {
+		Name:        "GPS_FIX_TYPE",
+		Description: "Type of GPS fix",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GPS_FIX_TYPE_NO_GPS", Value: 0, Description: "No GPS connected"},
+			{Name: "GPS_FIX_TYPE_NO_FIX", Value: 1, Description: "No position information, GPS is connected"},
+			{Name: "GPS_FIX_TYPE_2D_FIX", Value: 2, Description: "2D position"},
+			{Name: "GPS_FIX_TYPE_3D_FIX", Value: 3, Description: "3D position"},
+			{Name: "GPS_FIX_TYPE_DGPS", Value: 4, Description: "DGPS/SBAS aided 3D position"},
+			{Name: "GPS_FIX_TYPE_RTK_FLOAT", Value: 5, Description: "RTK float, 3D position"},
+			{Name: "GPS_FIX_TYPE_RTK_FIXED", Value: 6, Description: "RTK Fixed, 3D position"},
+			{Name: "GPS_FIX_TYPE_STATIC", Value: 7, Description: "Static fixed, typically used for base stations"},
+			{Name: "GPS_FIX_TYPE_PPP", Value: 8, Description: "PPP, 3D position."},
+		},
+	},
+	"GPS_INPUT_IGNORE_FLAGS": {
+		Name:        "GPS_INPUT_IGNORE_FLAGS",
+		Description: "",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GPS_INPUT_IGNORE_FLAG_ALT", Value: 1, Description: "ignore altitude field"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_HDOP", Value: 2, Description: "ignore hdop field"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_VDOP", Value: 4, Description: "ignore vdop field"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_VEL_HORIZ", Value: 8, Description: "ignore horizontal velocity field (vn and ve)"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_VEL_VERT", Value: 16, Description: "ignore vertical velocity field (vd)"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_SPEED_ACCURACY", Value: 32, Description: "ignore speed accuracy field"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_HORIZONTAL_ACCURACY", Value: 64, Description: "ignore horizontal accuracy field"},
+			{Name: "GPS_INPUT_IGNORE_FLAG_VERTICAL_ACCURACY", Value: 128, Description: "ignore vertical accuracy field"},
+		},
+	},
+	"GRIPPER_ACTIONS": {
+		Name:        "GRIPPER_ACTIONS",
+		Description: "Gripper actions.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "GRIPPER_ACTION_RELEASE", Value: 0, Description: "Gripper release cargo."},
+			{Name: "GRIPPER_ACTION_GRAB", Value: 1, Description: "Gripper grab onto cargo."},
+			{Name: "GRIPPER_ACTION_HOLD", Value: 2, Description: "Gripper hold current grip state/position."},
+		},
+	},
+	"HEADING_TYPE": {
+		Name:        "HEADING_TYPE",
+		Description: "Heading setpoint types used in MAV_CMD_GUIDED_CHANGE_HEADING",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "HEADING_TYPE_COURSE_OVER_GROUND", Value: 0, Description: "Course over ground."},
+			{Name: "HEADING_TYPE_HEADING", Value: 1, Description: "Raw vehicle heading."},
+			{Name: "HEADING_TYPE_DEFAULT", Value: 2, Description: "Default heading."},
+		},
+	},
+	"HIGHRES_IMU_UPDATED_FLAGS": {
+		Name:        "HIGHRES_IMU_UPDATED_FLAGS",
+		Description: "Flags in the HIGHRES_IMU message indicate which fields have updated since the last message",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "HIGHRES_IMU_UPDATED_XACC", Value: 1, Description: "The value in the xacc field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_YACC", Value: 2, Description: "The value in the yacc field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_ZACC", Value: 4, Description: "The value in the zacc field has been updated since"},
+			{Name: "HIGHRES_IMU_UPDATED_XGYRO", Value: 8, Description: "The value in the xgyro field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_YGYRO", Value: 16, Description: "The value in the ygyro field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_ZGYRO", Value: 32, Description: "The value in the zgyro field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_XMAG", Value: 64, Description: "The value in the xmag field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_YMAG", Value: 128, Description: "The value in the ymag field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_ZMAG", Value: 256, Description: "The value in the zmag field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_ABS_PRESSURE", Value: 512, Description: "The value in the abs_pressure field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_DIFF_PRESSURE", Value: 1024, Description: "The value in the diff_pressure field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_PRESSURE_ALT", Value: 2048, Description: "The value in the pressure_alt field has been updated"},
+			{Name: "HIGHRES_IMU_UPDATED_TEMPERATURE", Value: 4096, Description: "The value in the temperature field has been updated"},
+		},
+	},
+	"HIL_ACTUATOR_CONTROLS_FLAGS": {
+		Name:        "HIL_ACTUATOR_CONTROLS_FLAGS",
+		Description: "Flags used in HIL_ACTUATOR_CONTROLS message.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "HIL_ACTUATOR_CONTROLS_FLAGS_LOCKSTEP", Value: 1, Description: "Simulation is using lockstep"},
+		},
+	},
+	"HIL_SENSOR_UPDATED_FLAGS": {
+		Name:        "HIL_SENSOR_UPDATED_FLAGS",
+		Description: "Flags in the HIL_SENSOR message indicate which fields have updated since the last message",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "HIL_SENSOR_UPDATED_XACC", Value: 1, Description: "The value in the xacc field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_YACC", Value: 2, Description: "The value in the yacc field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_ZACC", Value: 4, Description: "The value in the zacc field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_XGYRO", Value: 8, Description: "The value in the xgyro field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_YGYRO", Value: 16, Description: "The value in the ygyro field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_ZGYRO", Value: 32, Description: "The value in the zgyro field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_XMAG", Value: 64, Description: "The value in the xmag field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_YMAG", Value: 128, Description: "The value in the ymag field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_ZMAG", Value: 256, Description: "The value in the zmag field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_ABS_PRESSURE", Value: 512, Description: "The value in the abs_pressure field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_DIFF_PRESSURE", Value: 1024, Description: "The value in the diff_pressure field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_PRESSURE_ALT", Value: 2048, Description: "The value in the pressure_alt field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_TEMPERATURE", Value: 4096, Description: "The value in the temperature field has been updated"},
+			{Name: "HIL_SENSOR_UPDATED_RESET", Value: 2147483648, Description: "Full reset of attitude/position/velocities/etc was performed in sim (Bit 31)."},
+		},
+	},
+	"HL_FAILURE_FLAG": {
+		Name:        "HL_FAILURE_FLAG",
+		Description: "Flags to report failure cases over the high latency telemetry.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "HL_FAILURE_FLAG_GPS", Value: 1, Description: "GPS failure."},
+			{Name: "HL_FAILURE_FLAG_DIFFERENTIAL_PRESSURE", Value: 2, Description: "Differential pressure sensor failure."},
+			{Name: "HL_FAILURE_FLAG_ABSOLUTE_PRESSURE", Value: 4, Description: "Absolute pressure sensor failure."},
+			{Name: "HL_FAILURE_FLAG_3D_ACCEL", Value: 8, Description: "Accelerometer sensor failure."},
+			{Name: "HL_FAILURE_FLAG_3D_GYRO", Value: 16, Description: "Gyroscope sensor failure."},
+			{Name: "HL_FAILURE_FLAG_3D_MAG", Value: 32, Description: "Magnetometer sensor failure."},
+			{Name: "HL_FAILURE_FLAG_TERRAIN", Value: 64, Description: "Terrain subsystem failure."},
+			{Name: "HL_FAILURE_FLAG_BATTERY", Value: 128, Description: "Battery failure/critical low battery."},
+			{Name: "HL_FAILURE_FLAG_RC_RECEIVER", Value: 256, Description: "RC receiver failure/no RC connection."},
+			{Name: "HL_FAILURE_FLAG_OFFBOARD_LINK", Value: 512, Description: "Offboard link failure."},
+			{Name: "HL_FAILURE_FLAG_ENGINE", Value: 1024, Description: "Engine failure."},
+			{Name: "HL_FAILURE_FLAG_GEOFENCE", Value: 2048, Description: "Geofence violation."},
+			{Name: "HL_FAILURE_FLAG_ESTIMATOR", Value: 4096, Description: "Estimator failure, for example measurement rejection or large variances."},
+			{Name: "HL_FAILURE_FLAG_MISSION", Value: 8192, Description: "Mission failure."},
+		},
+	},
+	"ILLUMINATOR_ERROR_FLAGS": {
+		Name:        "ILLUMINATOR_ERROR_FLAGS",
+		Description: "Illuminator module error flags (bitmap, 0 means no error)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ILLUMINATOR_ERROR_FLAGS_THERMAL_THROTTLING", Value: 1, Description: "Illuminator thermal throttling error."},
+			{Name: "ILLUMINATOR_ERROR_FLAGS_OVER_TEMPERATURE_SHUTDOWN", Value: 2, Description: "Illuminator over temperature shutdown error."},
+			{Name: "ILLUMINATOR_ERROR_FLAGS_THERMISTOR_FAILURE", Value: 4, Description: "Illuminator thermistor failure."},
+		},
+	},
+	"ILLUMINATOR_MODE": {
+		Name:        "ILLUMINATOR_MODE",
+		Description: "Modes of illuminator",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ILLUMINATOR_MODE_UNKNOWN", Value: 0, Description: "Illuminator mode is not specified/unknown"},
+			{Name: "ILLUMINATOR_MODE_INTERNAL_CONTROL", Value: 1, Description: "Illuminator behavior is controlled by MAV_CMD_DO_ILLUMINATOR_CONFIGURE settings"},
+			{Name: "ILLUMINATOR_MODE_EXTERNAL_SYNC", Value: 2, Description: "Illuminator behavior is controlled by external factors: e.g. an external hardware signal"},
+		},
+	},
+	"LANDING_TARGET_TYPE": {
+		Name:        "LANDING_TARGET_TYPE",
+		Description: "Type of landing target",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "LANDING_TARGET_TYPE_LIGHT_BEACON", Value: 0, Description: "Landing target signaled by light beacon (ex: IR-LOCK)"},
+			{Name: "LANDING_TARGET_TYPE_RADIO_BEACON", Value: 1, Description: "Landing target signaled by radio beacon (ex: ILS, NDB)"},
+			{Name: "LANDING_TARGET_TYPE_VISION_FIDUCIAL", Value: 2, Description: "Landing target represented by a fiducial marker (ex: ARTag)"},
+			{Name: "LANDING_TARGET_TYPE_VISION_OTHER", Value: 3, Description: "Landing target represented by a pre-defined visual shape/feature (ex: X-marker, H-marker, square)"},
+		},
+	},
+	"MAG_CAL_STATUS": {
+		Name:        "MAG_CAL_STATUS",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAG_CAL_NOT_STARTED", Value: 0},
+			{Name: "MAG_CAL_WAITING_TO_START", Value: 1},
+			{Name: "MAG_CAL_RUNNING_STEP_ONE", Value: 2},
+			{Name: "MAG_CAL_RUNNING_STEP_TWO", Value: 3},
+			{Name: "MAG_CAL_SUCCESS", Value: 4},
+			{Name: "MAG_CAL_FAILED", Value: 5},
+			{Name: "MAG_CAL_FAILED_ORIENTATION", Value: 6, Description: "Compass calibration failed: the vehicle orientation is outside the required tolerance."},
+			{Name: "MAG_CAL_FAILED_RADIUS", Value: 7, Description: "Compass calibration failed: the radius of the fitted sphere is unrealistically small or large."},
+			{Name: "MAG_CAL_FAILED_OFFSETS", Value: 8, Description: "Compass calibration failed: offset magnitude too large."},
+			{Name: "MAG_CAL_FAILED_DIAG_SCALING", Value: 9, Description: "Compass calibration failed: diagonal or off-diagonal scaling values out of valid range."},
+			{Name: "MAG_CAL_FAILED_RESIDUALS_HIGH", Value: 10, Description: "Compass calibration failed: fitness (RMS residual) exceeds tolerance."},
+		},
+	},
+	"MAVLINK_DATA_STREAM_TYPE": {
+		Name:        "MAVLINK_DATA_STREAM_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAVLINK_DATA_STREAM_IMG_JPEG", Value: 0},
+			{Name: "MAVLINK_DATA_STREAM_IMG_BMP", Value: 1},
+			{Name: "MAVLINK_DATA_STREAM_IMG_RAW8U", Value: 2},
+			{Name: "MAVLINK_DATA_STREAM_IMG_RAW32U", Value: 3},
+			{Name: "MAVLINK_DATA_STREAM_IMG_PGM", Value: 4},
+			{Name: "MAVLINK_DATA_STREAM_IMG_PNG", Value: 5},
+		},
+	},
+	"MAV_ARM_AUTH_DENIED_REASON": {
+		Name:        "MAV_ARM_AUTH_DENIED_REASON",
+		Description: "Reasons for denying an authorization request made with MAV_CMD_ARM_AUTHORIZATION_REQUEST. If the COMMAND_ACK result is MAV_RESULT_DENIED, this is used to set the reason in the result_param2 field.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_GENERIC", Value: 0, Description: "Not a specific reason"},
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_NONE", Value: 1, Description: "Authorizer will send the error as string to GCS"},
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_INVALID_WAYPOINT", Value: 2, Description: "At least one waypoint have a invalid value"},
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_TIMEOUT", Value: 3, Description: "Timeout in the authorizer process(in case it depends on network)"},
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_AIRSPACE_IN_USE", Value: 4, Description: "Airspace of the mission in use by another vehicle, second result parameter can have the waypoint id that caused it to be denied."},
+			{Name: "MAV_ARM_AUTH_DENIED_REASON_BAD_WEATHER", Value: 5, Description: "Weather is not good to fly"},
+		},
+	},
+	"MAV_AUTOPILOT": {
+		Name:        "MAV_AUTOPILOT",
+		Description: "Micro air vehicle / autopilot classes. This identifies the individual model.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_AUTOPILOT_GENERIC", Value: 0, Description: "Generic autopilot, full support for everything"},
+			{Name: "MAV_AUTOPILOT_RESERVED", Value: 1, Description: "Reserved for future use."},
+			{Name: "MAV_AUTOPILOT_SLUGS", Value: 2, Description: "SLUGS autopilot, http://slugsuav.soe.ucsc.edu"},
+			{Name: "MAV_AUTOPILOT_ARDUPILOTMEGA", Value: 3, Description: "ArduPilot - Plane/Copter/Rover/Sub/Tracker, https://ardupilot.org"},
+			{Name: "MAV_AUTOPILOT_OPENPILOT", Value: 4, Description: "OpenPilot, http://openpilot.org"},
+			{Name: "MAV_AUTOPILOT_GENERIC_WAYPOINTS_ONLY", Value: 5, Description: "Generic autopilot only supporting simple waypoints"},
+			{Name: "MAV_AUTOPILOT_GENERIC_WAYPOINTS_AND_SIMPLE_NAVIGATION_ONLY", Value: 6, Description: "Generic autopilot supporting waypoints and other simple navigation commands"},
+			{Name: "MAV_AUTOPILOT_GENERIC_MISSION_FULL", Value: 7, Description: "Generic autopilot supporting the full mission command set"},
+			{Name: "MAV_AUTOPILOT_INVALID", Value: 8, Description: "No valid autopilot, e.g. a GCS or other MAVLink component"},
+			{Name: "MAV_AUTOPILOT_PPZ", Value: 9, Description: "PPZ UAV - http://nongnu.org/paparazzi"},
+			{Name: "MAV_AUTOPILOT_UDB", Value: 10, Description: "UAV Dev Board"},
+			{Name: "MAV_AUTOPILOT_FP", Value: 11, Description: "FlexiPilot"},
+			{Name: "MAV_AUTOPILOT_PX4", Value: 12, Description: "PX4 Autopilot - http://px4.io/"},
+			{Name: "MAV_AUTOPILOT_SMACCMPILOT", Value: 13, Description: "SMACCMPilot - http://smaccmpilot.org"},
+			{Name: "MAV_AUTOPILOT_AUTOQUAD", Value: 14, Description: "AutoQuad -- http://autoquad.org"},
+			{Name: "MAV_AUTOPILOT_ARMAZILA", Value: 15, Description: "Armazila -- http://armazila.com"},
+			{Name: "MAV_AUTOPILOT_AEROB", Value: 16, Description: "Aerob -- http://aerob.ru"},
+			{Name: "MAV_AUTOPILOT_ASLUAV", Value: 17, Description: "ASLUAV autopilot -- http://www.asl.ethz.ch"},
+			{Name: "MAV_AUTOPILOT_SMARTAP", Value: 18, Description: "SmartAP Autopilot - http://sky-drones.com"},
+			{Name: "MAV_AUTOPILOT_AIRRAILS", Value: 19, Description: "AirRails - http://uaventure.com"},
+			{Name: "MAV_AUTOPILOT_REFLEX", Value: 20, Description: "Fusion Reflex - https://fusion.engineering"},
+		},
+	},
+	"MAV_BATTERY_CHARGE_STATE": {
+		Name:        "MAV_BATTERY_CHARGE_STATE",
+		Description: "Enumeration for battery charge states.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BATTERY_CHARGE_STATE_UNDEFINED", Value: 0, Description: "Low battery state is not provided"},
+			{Name: "MAV_BATTERY_CHARGE_STATE_OK", Value: 1, Description: "Battery is not in low state. Normal operation."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_LOW", Value: 2, Description: "Battery state is low, warn and monitor close."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_CRITICAL", Value: 3, Description: "Battery state is critical, return or abort immediately."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_EMERGENCY", Value: 4, Description: "Battery state is too low for ordinary abort sequence. Perform fastest possible emergency stop to prevent damage."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_FAILED", Value: 5, Description: "Battery failed, damage unavoidable. Possible causes (faults) are listed in MAV_BATTERY_FAULT."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_UNHEALTHY", Value: 6, Description: "Battery is diagnosed to be defective or an error occurred, usage is discouraged / prohibited. Possible causes (faults) are listed in MAV_BATTERY_FAULT."},
+			{Name: "MAV_BATTERY_CHARGE_STATE_CHARGING", Value: 7, Description: "Battery is charging."},
+		},
+	},
+	"MAV_BATTERY_FAULT": {
+		Name:        "MAV_BATTERY_FAULT",
+		Description: "Smart battery supply status/fault flags (bitmask) for health indication. The battery must also report either MAV_BATTERY_CHARGE_STATE_FAILED or MAV_BATTERY_CHARGE_STATE_UNHEALTHY if any of these are set.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BATTERY_FAULT_DEEP_DISCHARGE", Value: 1, Description: "Battery has deep discharged."},
+			{Name: "MAV_BATTERY_FAULT_SPIKES", Value: 2, Description: "Voltage spikes."},
+			{Name: "MAV_BATTERY_FAULT_CELL_FAIL", Value: 4, Description: "One or more cells have failed. Battery should also report MAV_BATTERY_CHARGE_STATE_FAILE (and should not be used)."},
+			{Name: "MAV_BATTERY_FAULT_OVER_CURRENT", Value: 8, Description: "Over-current fault."},
+			{Name: "MAV_BATTERY_FAULT_OVER_TEMPERATURE", Value: 16, Description: "Over-temperature fault."},
+			{Name: "MAV_BATTERY_FAULT_UNDER_TEMPERATURE", Value: 32, Description: "Under-temperature fault."},
+			{Name: "MAV_BATTERY_FAULT_INCOMPATIBLE_VOLTAGE", Value: 64, Description: "Vehicle voltage is not compatible with this battery (batteries on same power rail should have similar voltage)."},
+			{Name: "MAV_BATTERY_FAULT_INCOMPATIBLE_FIRMWARE", Value: 128, Description: "Battery firmware is not compatible with current autopilot firmware."},
+			{Name: "BATTERY_FAULT_INCOMPATIBLE_CELLS_CONFIGURATION", Value: 256, Description: "Battery is not compatible due to cell configuration (e.g. 5s1p when vehicle requires 6s)."},
+		},
+	},
+	"MAV_BATTERY_FUNCTION": {
+		Name:        "MAV_BATTERY_FUNCTION",
+		Description: "Enumeration of battery functions",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BATTERY_FUNCTION_UNKNOWN", Value: 0, Description: "Battery function is unknown"},
+			{Name: "MAV_BATTERY_FUNCTION_ALL", Value: 1, Description: "Battery supports all flight systems"},
+			{Name: "MAV_BATTERY_FUNCTION_PROPULSION", Value: 2, Description: "Battery for the propulsion system"},
+			{Name: "MAV_BATTERY_FUNCTION_AVIONICS", Value: 3, Description: "Avionics battery"},
+			{Name: "MAV_BATTERY_FUNCTION_PAYLOAD", Value: 4, Description: "Payload battery"},
+		},
+	},
+	"MAV_BATTERY_MODE": {
+		Name:        "MAV_BATTERY_MODE",
+		Description: "Battery mode. Note, the normal operation mode (i.e. when flying) should be reported as MAV_BATTERY_MODE_UNKNOWN to allow message trimming in normal flight.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BATTERY_MODE_UNKNOWN", Value: 0, Description: "Battery mode not supported/unknown battery mode/normal operation."},
+			{Name: "MAV_BATTERY_MODE_AUTO_DISCHARGING", Value: 1, Description: "Battery is auto discharging (towards storage level)."},
+			{Name: "MAV_BATTERY_MODE_HOT_SWAP", Value: 2, Description: "Battery in hot-swap mode (current limited to prevent spikes that might damage sensitive electrical circuits)."},
+		},
+	},
+	"MAV_BATTERY_TYPE": {
+		Name:        "MAV_BATTERY_TYPE",
+		Description: "Enumeration of battery types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BATTERY_TYPE_UNKNOWN", Value: 0, Description: "Not specified."},
+			{Name: "MAV_BATTERY_TYPE_LIPO", Value: 1, Description: "Lithium polymer battery"},
+			{Name: "MAV_BATTERY_TYPE_LIFE", Value: 2, Description: "Lithium-iron-phosphate battery"},
+			{Name: "MAV_BATTERY_TYPE_LION", Value: 3, Description: "Lithium-ION battery"},
+			{Name: "MAV_BATTERY_TYPE_NIMH", Value: 4, Description: "Nickel metal hydride battery"},
+		},
+	},
+	"MAV_BOOL": {
+		Name:        "MAV_BOOL",
+		Description: "Enum used to indicate true or false (also: success or failure, enabled or disabled, active or inactive).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_BOOL_FALSE", Value: 0, Description: "False."},
+			{Name: "MAV_BOOL_TRUE", Value: 1, Description: "True."},
+		},
+	},
+	"MAV_CMD": {
+		Name:        "MAV_CMD",
+		Description: "Commands to be executed by the MAV. They can be executed on user request, or as part of a mission script. If the action is used in a mission, the parameter mapping to the waypoint/mission message is as follows: Param 1, Param 2, Param 3, Param 4, X: Param 5, Y:Param 6, Z:Param 7. This command list is similar what ARINC 424 is for commercial aircraft: A data format how to interpret waypoint/mission data. NaN and INT32_MAX may be used in float/integer params (respectively) to indicate optional/default values (e.g. to use the component's current yaw or latitude rather than a specific value). See https://mavlink.io/en/guide/xml_schema.html#MAV_CMD for information about the structure of the MAV_CMD entries",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_CMD_NAV_WAYPOINT", Value: 16, Description: "Navigate to waypoint. This is intended for use in missions (for guided commands outside of missions use MAV_CMD_DO_REPOSITION)."},
+			{Name: "MAV_CMD_NAV_LOITER_UNLIM", Value: 17, Description: "Loiter around this waypoint an unlimited amount of time"},
+			{Name: "MAV_CMD_NAV_LOITER_TURNS", Value: 18, Description: "Loiter around this waypoint for X turns"},
+			{Name: "MAV_CMD_NAV_LOITER_TIME", Value: 19, Description: "Loiter at the specified latitude, longitude and altitude for a certain amount of time. Multicopter vehicles stop at the point (within a vehicle-specific acceptance radius). Forward-only moving vehicles (e.g. fixed-wing) circle the point with the specified radius/direction. If the Heading Required parameter (2) is non-zero forward moving aircraft will only leave the loiter circle once heading towards the next waypoint."},
+			{Name: "MAV_CMD_NAV_RETURN_TO_LAUNCH", Value: 20, Description: "Return to launch location"},
+			{Name: "MAV_CMD_NAV_LAND", Value: 21, Description: "Land at location."},
+			{Name: "MAV_CMD_NAV_TAKEOFF", Value: 22, Description: "Takeoff from ground / hand. Vehicles that support multiple takeoff modes (e.g. VTOL quadplane) should take off using the currently configured mode."},
+			{Name: "MAV_CMD_NAV_LAND_LOCAL", Value: 23, Description: "Land at local position (local frame only)"},
+			{Name: "MAV_CMD_NAV_TAKEOFF_LOCAL", Value: 24, Description: "Takeoff from local position (local frame only)"},
+			{Name: "MAV_CMD_NAV_FOLLOW", Value: 25, Description: "Vehicle following, i.e. this waypoint represents the position of a moving vehicle"},
+			{Name: "MAV_CMD_NAV_CONTINUE_AND_CHANGE_ALT", Value: 30, Description: "Continue on the current course and climb/descend to specified altitude.  When the altitude is reached continue to the next command (i.e., don't proceed to the next command until the desired altitude is reached."},
+			{Name: "MAV_CMD_NAV_LOITER_TO_ALT", Value: 31, Description: "Begin loiter at the specified Latitude and Longitude.  If Lat=Lon=0, then loiter at the current position.  Don't consider the navigation command complete (don't leave loiter) until the altitude has been reached. Additionally, if the Heading Required parameter is non-zero the aircraft will not leave the loiter until heading toward the next waypoint."},
+			{Name: "MAV_CMD_DO_FOLLOW", Value: 32, Description: "Begin following a target"},
+			{Name: "MAV_CMD_DO_FOLLOW_REPOSITION", Value: 33, Description: "Reposition the MAV after a follow target command has been sent"},
+			{Name: "MAV_CMD_DO_ORBIT", Value: 34, Description: "Start orbiting on the circumference of a circle defined by the parameters. Setting values to NaN/INT32_MAX (as appropriate) results in using defaults."},
+			{Name: "MAV_CMD_DO_FIGURE_EIGHT", Value: 35, Description: "Fly a figure eight path as defined by the parameters. Set parameters to NaN/INT32_MAX (as appropriate) to use system-default values. The command is intended for fixed wing vehicles (and VTOL hybrids flying in fixed-wing mode), allowing POI tracking for gimbals that don't support infinite rotation. This command only defines the flight path. Speed should be set independently (use e.g. MAV_CMD_DO_CHANGE_SPEED). Yaw and other degrees of freedom are not specified, and will be flight-stack specific (on vehicles where they can be controlled independent of the heading)."},
+			{Name: "MAV_CMD_NAV_ARC_WAYPOINT", Value: 36, Description: "Circular arc path waypoint. This defines the end/exit point and angle (param1) of an arc path from the previous waypoint. A position is required before this command to define the start of the arc (e.g. current position, a MAV_CMD_NAV_WAYPOINT, or a MAV_CMD_NAV_ARC_WAYPOINT). The resulting path is a circular arc in the NE frame, with the difference in height being defined by the difference in waypoint altitudes."},
+			{Name: "MAV_CMD_NAV_ROI", Value: 80, Description: "Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicle's control system to control the vehicle attitude and the attitude of various sensors such as cameras."},
+			{Name: "MAV_CMD_NAV_PATHPLANNING", Value: 81, Description: "Control autonomous path planning on the MAV."},
+			{Name: "MAV_CMD_NAV_SPLINE_WAYPOINT", Value: 82, Description: "Navigate to waypoint using a spline path."},
+			{Name: "MAV_CMD_NAV_VTOL_TAKEOFF", Value: 84, Description: "Takeoff from ground using VTOL mode, and transition to forward flight with specified heading. The command should be ignored by vehicles that dont support both VTOL and fixed-wing flight (multicopters, boats,etc.)."},
+			{Name: "MAV_CMD_NAV_VTOL_LAND", Value: 85, Description: "Land using VTOL mode"},
+			{Name: "MAV_CMD_NAV_GUIDED_ENABLE", Value: 92, Description: "Hand control over to an external controller"},
+			{Name: "MAV_CMD_NAV_DELAY", Value: 93, Description: "Delay the next navigation command a number of seconds or until a specified time"},
+			{Name: "MAV_CMD_NAV_PAYLOAD_PLACE", Value: 94, Description: "Descend and place payload. Vehicle moves to specified location, descends until it detects a hanging payload has reached the ground, and then releases the payload. If ground is not detected before the reaching the maximum descent value (param1), the command will complete without releasing the payload."},
+			{Name: "MAV_CMD_NAV_LAST", Value: 95, Description: "NOP - This command is only used to mark the upper limit of the NAV/ACTION commands in the enumeration"},
+			{Name: "MAV_CMD_CONDITION_DELAY", Value: 112, Description: "Delay mission state machine."},
+			{Name: "MAV_CMD_CONDITION_CHANGE_ALT", Value: 113, Description: "Ascend/descend to target altitude at specified rate. Delay mission state machine until desired altitude reached."},
+			{Name: "MAV_CMD_CONDITION_DISTANCE", Value: 114, Description: "Delay mission state machine until within desired distance of next NAV point."},
+			{Name: "MAV_CMD_CONDITION_YAW", Value: 115, Description: "Reach a certain target angle."},
+			{Name: "MAV_CMD_CONDITION_LAST", Value: 159, Description: "NOP - This command is only used to mark the upper limit of the CONDITION commands in the enumeration"},
+			{Name: "MAV_CMD_DO_SET_MODE", Value: 176, Description: "Set system mode."},
+			{Name: "MAV_CMD_DO_JUMP", Value: 177, Description: "Jump to the desired command in the mission list.  Repeat this action only the specified number of times"},
+			{Name: "MAV_CMD_DO_CHANGE_SPEED", Value: 178, Description: "Change speed and/or throttle set points. The value persists until it is overridden or there is a mode change"},
+			{Name: "MAV_CMD_DO_SET_HOME", Value: 179, Description: "Sets the home position to either to the current position or a specified position. The home position is the default position that the system will return to and land on. The position is set automatically by the system during the takeoff (and may also be set using this command). Note: the current home position may be emitted in a HOME_POSITION message on request (using MAV_CMD_REQUEST_MESSAGE with param1=242)."},
+			{Name: "MAV_CMD_DO_SET_PARAMETER", Value: 180, Description: "Set a system parameter.  Caution!  Use of this command requires knowledge of the numeric enumeration value of the parameter."},
+			{Name: "MAV_CMD_DO_SET_RELAY", Value: 181, Description: "Set a relay to a condition. The current value may optionally be reported using RELAY_STATUS."},
+			{Name: "MAV_CMD_DO_REPEAT_RELAY", Value: 182, Description: "Cycle a relay on and off for a desired number of cycles with a desired period."},
+			{Name: "MAV_CMD_DO_SET_SERVO", Value: 183, Description: "Set a servo to a desired PWM value."},
+			{Name: "MAV_CMD_DO_REPEAT_SERVO", Value: 184, Description: "Cycle a between its nominal setting and a desired PWM for a desired number of cycles with a desired period."},
+			{Name: "MAV_CMD_DO_FLIGHTTERMINATION", Value: 185, Description: "Terminate flight immediately. Flight termination immediately and irreversibly terminates the current flight, returning the vehicle to ground. The vehicle will ignore RC or other input until it has been power-cycled. Termination may trigger safety measures, including: disabling motors and deployment of parachute on multicopters, and setting flight surfaces to initiate a landing pattern on fixed-wing). On multicopters without a parachute it may trigger a crash landing. Support for this command can be tested using the protocol bit: MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION. Support for this command can also be tested by sending the command with param1=0 (< 0.5); the ACK should be either MAV_RESULT_FAILED or MAV_RESULT_UNSUPPORTED."},
+			{Name: "MAV_CMD_DO_CHANGE_ALTITUDE", Value: 186, Description: "Change altitude set point."},
+			{Name: "MAV_CMD_DO_SET_ACTUATOR", Value: 187, Description: "Sets actuators (e.g. servos) to a desired value. The actuator numbers are mapped to specific outputs (e.g. on any MAIN or AUX PWM or UAVCAN) using a flight-stack specific mechanism (i.e. a parameter)."},
+			{Name: "MAV_CMD_DO_RETURN_PATH_START", Value: 188, Description: "Mission item to specify the start of a failsafe/landing return-path segment (the end of the segment is the next MAV_CMD_DO_LAND_START item). A vehicle that is using missions for landing (e.g. in a return mode) will join the mission on the closest path of the return-path segment (instead of MAV_CMD_DO_LAND_START or the nearest waypoint). The main use case is to minimize the failsafe flight path in corridor missions, where the inbound/outbound paths are constrained (by geofences) to the same particular path. The MAV_CMD_NAV_RETURN_PATH_START would be placed at the start of the return path. If a failsafe occurs on the outbound path the vehicle will move to the nearest point on the return path (which is parallel for this kind of mission), effectively turning round and following the shortest path to landing. If a failsafe occurs on the inbound path the vehicle is already on the return segment and will continue to landing. The Latitude/Longitude/Altitude are optional, and may be set to 0 if not needed. If specified, the item defines the waypoint at which the return segment starts. If sent using as a command, the vehicle will perform a mission landing (using the land segment if defined) or reject the command if mission landings are not supported, or no mission landing is defined. When used as a command any position information in the command is ignored."},
+			{Name: "MAV_CMD_DO_LAND_START", Value: 189, Description: "Mission item to mark the start of a mission landing pattern, or a command to land with a mission landing pattern. When used in a mission, this is a marker for the start of a sequence of mission items that represent a landing pattern. It should be followed by a navigation item that defines the first waypoint of the landing sequence. The start marker positional params are used only for selecting what landing pattern to use if several are defined in the mission (the selected pattern will be the one with the marker position that is closest to the vehicle when a landing is commanded). If the marker item position has zero-values for latitude, longitude, and altitude, then landing pattern selection is instead based on the position of the first waypoint in the landing sequence. When sent as a command it triggers a landing using a mission landing pattern. The location parameters are not used in this case, and should be set to 0."},
+			{Name: "MAV_CMD_DO_RALLY_LAND", Value: 190, Description: "Mission command to perform a landing from a rally point."},
+			{Name: "MAV_CMD_DO_GO_AROUND", Value: 191, Description: "Mission command to safely abort an autonomous landing."},
+			{Name: "MAV_CMD_DO_REPOSITION", Value: 192, Description: "Reposition the vehicle to a specific WGS84 global position. This command is intended for guided commands (for missions use MAV_CMD_NAV_WAYPOINT instead)."},
+			{Name: "MAV_CMD_DO_PAUSE_CONTINUE", Value: 193, Description: "If in a GPS controlled position mode, hold the current position or continue."},
+			{Name: "MAV_CMD_DO_SET_REVERSE", Value: 194, Description: "Set moving direction to forward or reverse."},
+			{Name: "MAV_CMD_DO_SET_ROI_LOCATION", Value: 195, Description: "Sets the region of interest (ROI) to a location. This can then be used by the vehicle's control system to control the vehicle attitude and the attitude of various sensors such as cameras. This command can be sent to a gimbal manager but not to a gimbal device. A gimbal is not to react to this message."},
+			{Name: "MAV_CMD_DO_SET_ROI_WPNEXT_OFFSET", Value: 196, Description: "Sets the region of interest (ROI) to be toward next waypoint, with optional pitch/roll/yaw offset. This can then be used by the vehicle's control system to control the vehicle attitude and the attitude of various sensors such as cameras. This command can be sent to a gimbal manager but not to a gimbal device. A gimbal device is not to react to this message."},
+			{Name: "MAV_CMD_DO_SET_ROI_NONE", Value: 197, Description: "Cancels any previous ROI command returning the vehicle/sensors to default flight characteristics. This can then be used by the vehicle's control system to control the vehicle attitude and the attitude of various sensors such as cameras. This command can be sent to a gimbal manager but not to a gimbal device. A gimbal device is not to react to this message. After this command the gimbal manager should go back to manual input if available, and otherwise assume a neutral position."},
+			{Name: "MAV_CMD_DO_SET_ROI_SYSID", Value: 198, Description: "Mount tracks system with specified system ID. Determination of target vehicle position may be done with GLOBAL_POSITION_INT or any other means. This command can be sent to a gimbal manager but not to a gimbal device. A gimbal device is not to react to this message."},
+			{Name: "MAV_CMD_DO_CONTROL_VIDEO", Value: 200, Description: "Control onboard camera system."},
+			{Name: "MAV_CMD_DO_SET_ROI", Value: 201, Description: "Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicle's control system to control the vehicle attitude and the attitude of various sensors such as cameras."},
+			{Name: "MAV_CMD_DO_DIGICAM_CONFIGURE", Value: 202, Description: "Configure digital camera. This is a fallback message for systems that have not yet implemented PARAM_EXT_XXX messages and camera definition files (see https://mavlink.io/en/services/camera_def.html )."},
+			{Name: "MAV_CMD_DO_DIGICAM_CONTROL", Value: 203, Description: "Control digital camera. This is a fallback message for systems that have not yet implemented PARAM_EXT_XXX messages and camera definition files (see https://mavlink.io/en/services/camera_def.html )."},
+			{Name: "MAV_CMD_DO_MOUNT_CONFIGURE", Value: 204, Description: "Mission command to configure a camera or antenna mount"},
+			{Name: "MAV_CMD_DO_MOUNT_CONTROL", Value: 205, Description: "Mission command to control a camera or antenna mount"},
+			{Name: "MAV_CMD_DO_SET_CAM_TRIGG_DIST", Value: 206, Description: "Mission command to set camera trigger distance for this flight. The camera is triggered each time this distance is exceeded. This command can also be used to set the shutter integration time for the camera."},
+			{Name: "MAV_CMD_DO_FENCE_ENABLE", Value: 207, Description: "Enable the geofence. This can be used in a mission or via the command protocol. The persistence/lifetime of the setting is undefined. Depending on flight stack implementation it may persist until superseded, or it may revert to a system default at the end of a mission. Flight stacks typically reset the setting to system defaults on reboot."},
+			{Name: "MAV_CMD_DO_PARACHUTE", Value: 208, Description: "Mission item/command to release a parachute or enable/disable auto release."},
+			{Name: "MAV_CMD_DO_MOTOR_TEST", Value: 209, Description: "Command to perform motor test."},
+			{Name: "MAV_CMD_DO_INVERTED_FLIGHT", Value: 210, Description: "Change to/from inverted flight."},
+			{Name: "MAV_CMD_DO_GRIPPER", Value: 211, Description: "Mission command to operate a gripper."},
+			{Name: "MAV_CMD_DO_AUTOTUNE_ENABLE", Value: 212, Description: "Enable/disable autotune."},
+			{Name: "MAV_CMD_NAV_SET_YAW_SPEED", Value: 213, Description: "Sets a desired vehicle turn angle and speed change."},
+			{Name: "MAV_CMD_DO_SET_CAM_TRIGG_INTERVAL", Value: 214, Description: "Mission command to set camera trigger interval for this flight. If triggering is enabled, the camera is triggered each time this interval expires. This command can also be used to set the shutter integration time for the camera."},
+			{Name: "MAV_CMD_DO_MOUNT_CONTROL_QUAT", Value: 220, Description: "Mission command to control a camera or antenna mount, using a quaternion as reference."},
+			{Name: "MAV_CMD_DO_GUIDED_MASTER", Value: 221, Description: "set id of master controller"},
+			{Name: "MAV_CMD_DO_GUIDED_LIMITS", Value: 222, Description: "Set limits for external control"},
+			{Name: "MAV_CMD_DO_ENGINE_CONTROL", Value: 223, Description: "Control vehicle engine. This is interpreted by the vehicles engine controller to change the target engine state. It is intended for vehicles with internal combustion engines"},
+			{Name: "MAV_CMD_DO_SET_MISSION_CURRENT", Value: 224, Description: "Set the mission item with sequence number seq as the current item and emit MISSION_CURRENT (whether or not the mission number changed). If a mission is currently being executed, the system will continue to this new mission item on the shortest path, skipping any intermediate mission items. Note that mission jump repeat counters are not reset unless param2 is set (see MAV_CMD_DO_JUMP param2). This command may trigger a mission state-machine change on some systems: for example from MISSION_STATE_NOT_STARTED or MISSION_STATE_PAUSED to MISSION_STATE_ACTIVE. If the system is in mission mode, on those systems this command might therefore start, restart or resume the mission. If the system is not in mission mode this command must not trigger a switch to mission mode. The mission may be \"reset\" using param2. Resetting sets jump counters to initial values (to reset counters without changing the current mission item set the param1 to `-1`). Resetting also explicitly changes a mission state of MISSION_STATE_COMPLETE to MISSION_STATE_PAUSED or MISSION_STATE_ACTIVE, potentially allowing it to resume when it is (next) in a mission mode. The command will ACK with MAV_RESULT_FAILED if the sequence number is out of range (including if there is no mission item)."},
+			{Name: "MAV_CMD_DO_LAST", Value: 240, Description: "NOP - This command is only used to mark the upper limit of the DO commands in the enumeration"},
+			{Name: "MAV_CMD_PREFLIGHT_CALIBRATION", Value: 241, Description: "Trigger calibration. This command will be only accepted if in pre-flight mode. Except for Temperature Calibration, only one sensor should be set in a single message and all others should be zero."},
+			{Name: "MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS", Value: 242, Description: "Set sensor offsets. This command will be only accepted if in pre-flight mode."},
+			{Name: "MAV_CMD_PREFLIGHT_UAVCAN", Value: 243, Description: "Trigger UAVCAN configuration (actuator ID assignment and direction mapping). Note that this maps to the legacy UAVCAN v0 function UAVCAN_ENUMERATE, which is intended to be executed just once during initial vehicle configuration (it is not a normal pre-flight command and has been poorly named)."},
+			{Name: "MAV_CMD_PREFLIGHT_STORAGE", Value: 245, Description: "Request storage of different parameter values and logs. This command will be only accepted if in pre-flight mode."},
+			{Name: "MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN", Value: 246, Description: "Request the reboot or shutdown of system components."},
+			{Name: "MAV_CMD_OVERRIDE_GOTO", Value: 252, Description: "Override current mission with command to pause mission, pause mission and move to position, continue/resume mission. When param 1 indicates that the mission is paused (MAV_GOTO_DO_HOLD), param 2 defines whether it holds in place or moves to another position."},
+			{Name: "MAV_CMD_OBLIQUE_SURVEY", Value: 260, Description: "Mission command to set a Camera Auto Mount Pivoting Oblique Survey (Replaces CAM_TRIGG_DIST for this purpose). The camera is triggered each time this distance is exceeded, then the mount moves to the next position. Params 4~6 set-up the angle limits and number of positions for oblique survey, where mount-enabled vehicles automatically roll the camera between shots to emulate an oblique camera setup (providing an increased HFOV). This command can also be used to set the shutter integration time for the camera."},
+			{Name: "MAV_CMD_DO_SET_STANDARD_MODE", Value: 262, Description: "Enable the specified standard MAVLink mode. If the specified mode is not supported, the vehicle should ACK with MAV_RESULT_FAILED. See https://mavlink.io/en/services/standard_modes.html"},
+			{Name: "MAV_CMD_MISSION_START", Value: 300, Description: "start running a mission"},
+			{Name: "MAV_CMD_ACTUATOR_TEST", Value: 310, Description: "Actuator testing command. This is similar to MAV_CMD_DO_MOTOR_TEST but operates on the level of output functions, i.e. it is possible to test Motor1 independent from which output it is configured on. Autopilots must NACK this command with MAV_RESULT_TEMPORARILY_REJECTED while armed."},
+			{Name: "MAV_CMD_CONFIGURE_ACTUATOR", Value: 311, Description: "Actuator configuration command."},
+			{Name: "MAV_CMD_COMPONENT_ARM_DISARM", Value: 400, Description: "Arms / Disarms a component"},
+			{Name: "MAV_CMD_RUN_PREARM_CHECKS", Value: 401, Description: "Instructs a target system to run pre-arm checks. This allows preflight checks to be run on demand, which may be useful on systems that normally run them at low rate, or which do not trigger checks when the armable state might have changed. This command should return MAV_RESULT_ACCEPTED if it will run the checks. The results of the checks are usually then reported in SYS_STATUS messages (this is system-specific). The command should return MAV_RESULT_TEMPORARILY_REJECTED if the system is already armed."},
+			{Name: "MAV_CMD_ILLUMINATOR_ON_OFF", Value: 405, Description: "Turns illuminators ON/OFF. An illuminator is a light source that is used for lighting up dark areas external to the system: e.g. a torch or searchlight (as opposed to a light source for illuminating the system itself, e.g. an indicator light)."},
+			{Name: "MAV_CMD_DO_ILLUMINATOR_CONFIGURE", Value: 406, Description: "Configures illuminator settings. An illuminator is a light source that is used for lighting up dark areas external to the system: e.g. a torch or searchlight (as opposed to a light source for illuminating the system itself, e.g. an indicator light)."},
+			{Name: "MAV_CMD_GET_HOME_POSITION", Value: 410, Description: "Request the home position from the vehicle. The vehicle will ACK the command and emit the HOME_POSITION message."},
+			{Name: "MAV_CMD_INJECT_FAILURE", Value: 420, Description: "Inject artificial failure for testing purposes. Note that autopilots should implement an additional protection before accepting this command such as a specific param setting."},
+			{Name: "MAV_CMD_START_RX_PAIR", Value: 500, Description: "Starts receiver pairing."},
+			{Name: "MAV_CMD_GET_MESSAGE_INTERVAL", Value: 510, Description: "Request the interval between messages for a particular MAVLink message ID. The receiver should ACK the command and then emit its response in a MESSAGE_INTERVAL message."},
+			{Name: "MAV_CMD_SET_MESSAGE_INTERVAL", Value: 511, Description: "Set the interval between messages for a particular MAVLink message ID. This interface replaces REQUEST_DATA_STREAM."},
+			{Name: "MAV_CMD_REQUEST_MESSAGE", Value: 512, Description: "Request the target system(s) emit a single instance of a specified message (i.e. a \"one-shot\" version of MAV_CMD_SET_MESSAGE_INTERVAL)."},
+			{Name: "MAV_CMD_REQUEST_PROTOCOL_VERSION", Value: 519, Description: "Request MAVLink protocol version compatibility. All receivers should ACK the command and then emit their capabilities in an PROTOCOL_VERSION message"},
+			{Name: "MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES", Value: 520, Description: "Request autopilot capabilities. The receiver should ACK the command and then emit its capabilities in an AUTOPILOT_VERSION message"},
+			{Name: "MAV_CMD_REQUEST_CAMERA_INFORMATION", Value: 521, Description: "Request camera information (CAMERA_INFORMATION)."},
+			{Name: "MAV_CMD_REQUEST_CAMERA_SETTINGS", Value: 522, Description: "Request camera settings (CAMERA_SETTINGS)."},
+			{Name: "MAV_CMD_REQUEST_STORAGE_INFORMATION", Value: 525, Description: "Request storage information (STORAGE_INFORMATION). Use the command's target_component to target a specific component's storage."},
+			{Name: "MAV_CMD_STORAGE_FORMAT", Value: 526, Description: "Format a storage medium. Once format is complete, a STORAGE_INFORMATION message is sent. Use the command's target_component to target a specific component's storage."},
+			{Name: "MAV_CMD_REQUEST_CAMERA_CAPTURE_STATUS", Value: 527, Description: "Request camera capture status (CAMERA_CAPTURE_STATUS)"},
+			{Name: "MAV_CMD_REQUEST_FLIGHT_INFORMATION", Value: 528, Description: "Request flight information (FLIGHT_INFORMATION)"},
+			{Name: "MAV_CMD_RESET_CAMERA_SETTINGS", Value: 529, Description: "Reset all camera settings to Factory Default"},
+			{Name: "MAV_CMD_SET_CAMERA_MODE", Value: 530, Description: "Set camera running mode. Use NaN for reserved values. GCS will send a MAV_CMD_REQUEST_VIDEO_STREAM_STATUS command after a mode change if the camera supports video streaming."},
+			{Name: "MAV_CMD_SET_CAMERA_ZOOM", Value: 531, Description: "Set camera zoom. Camera must respond with a CAMERA_SETTINGS message (on success)."},
+			{Name: "MAV_CMD_SET_CAMERA_FOCUS", Value: 532, Description: "Set camera focus. Camera must respond with a CAMERA_SETTINGS message (on success)."},
+			{Name: "MAV_CMD_SET_STORAGE_USAGE", Value: 533, Description: "Set that a particular storage is the preferred location for saving photos, videos, and/or other media (e.g. to set that an SD card is used for storing videos). There can only be one preferred save location for each particular media type: setting a media usage flag will clear/reset that same flag if set on any other storage. If no flag is set the system should use its default storage. A target system can choose to always use default storage, in which case it should ACK the command with MAV_RESULT_UNSUPPORTED. A target system can choose to not allow a particular storage to be set as preferred storage, in which case it should ACK the command with MAV_RESULT_DENIED."},
+			{Name: "MAV_CMD_SET_CAMERA_SOURCE", Value: 534, Description: "Set camera source. Changes the camera's active sources on cameras with multiple image sensors."},
+			{Name: "MAV_CMD_JUMP_TAG", Value: 600, Description: "Tagged jump target. Can be jumped to with MAV_CMD_DO_JUMP_TAG."},
+			{Name: "MAV_CMD_DO_JUMP_TAG", Value: 601, Description: "Jump to the matching tag in the mission list. Repeat this action for the specified number of times. A mission should contain a single matching tag for each jump. If this is not the case then a jump to a missing tag should complete the mission, and a jump where there are multiple matching tags should always select the one with the lowest mission sequence number."},
+			{Name: "MAV_CMD_DO_SET_GLOBAL_ORIGIN", Value: 611, Description: "Sets the GNSS coordinates of the vehicle local origin (0,0,0) position. Vehicle should emit GPS_GLOBAL_ORIGIN irrespective of whether the origin is changed. This enables transform between the local coordinate frame and the global (GNSS) coordinate frame, which may be necessary when (for example) indoor and outdoor settings are connected and the MAV should move from in- to outdoor. This command supersedes SET_GPS_GLOBAL_ORIGIN. Should be sent in a COMMAND_INT (Expected frame is MAV_FRAME_GLOBAL, and this should be assumed when sent in COMMAND_LONG)."},
+			{Name: "MAV_CMD_DO_GIMBAL_MANAGER_PITCHYAW", Value: 1000, Description: "Set gimbal manager pitch/yaw setpoints (low rate command). It is possible to set combinations of the values below. E.g. an angle as well as a desired angular rate can be used to get to this angle at a certain angular rate, or an angular rate only will result in continuous turning. NaN is to be used to signal unset. Note: only the gimbal manager will react to this command - it will be ignored by a gimbal device. Use GIMBAL_MANAGER_SET_PITCHYAW if you need to stream pitch/yaw setpoints at higher rate."},
+			{Name: "MAV_CMD_DO_GIMBAL_MANAGER_CONFIGURE", Value: 1001, Description: "Gimbal configuration to set which sysid/compid is in primary and secondary control."},
+			{Name: "MAV_CMD_IMAGE_START_CAPTURE", Value: 2000, Description: "Start image capture sequence. CAMERA_IMAGE_CAPTURED must be emitted after each capture. Param1 (id) may be used to specify the target camera: 0: all cameras, 1 to 6: autopilot-connected cameras, 7-255: MAVLink camera component ID. It is needed in order to target specific cameras connected to the autopilot, or specific sensors in a multi-sensor camera (neither of which have a distinct MAVLink component ID). It is also needed to specify the target camera in missions. When used in a mission, an autopilot should execute the MAV_CMD for a specified local camera (param1 = 1-6), or resend it as a command if it is intended for a MAVLink camera (param1 = 7 - 255), setting the command's target_component as the param1 value (and setting param1 in the command to zero). If the param1 is 0 the autopilot should do both. When sent in a command the target MAVLink address is set using target_component. If addressed specifically to an autopilot: param1 should be used in the same way as it is for missions (though command should NACK with MAV_RESULT_DENIED if a specified local camera does not exist). If addressed to a MAVLink camera, param 1 can be used to address all cameras (0), or to separately address 1 to 7 individual sensors. Other values should be NACKed with MAV_RESULT_DENIED. If the command is broadcast (target_component is 0) then param 1 should be set to 0 (any other value should be NACKED with MAV_RESULT_DENIED). An autopilot would trigger any local cameras and forward the command to all channels."},
+			{Name: "MAV_CMD_IMAGE_STOP_CAPTURE", Value: 2001, Description: "Stop image capture sequence. Param1 (id) may be used to specify the target camera: 0: all cameras, 1 to 6: autopilot-connected cameras, 7-255: MAVLink camera component ID. It is needed in order to target specific cameras connected to the autopilot, or specific sensors in a multi-sensor camera (neither of which have a distinct MAVLink component ID). It is also needed to specify the target camera in missions. When used in a mission, an autopilot should execute the MAV_CMD for a specified local camera (param1 = 1-6), or resend it as a command if it is intended for a MAVLink camera (param1 = 7 - 255), setting the command's target_component as the param1 value (and setting param1 in the command to zero). If the param1 is 0 the autopilot should do both. When sent in a command the target MAVLink address is set using target_component. If addressed specifically to an autopilot: param1 should be used in the same way as it is for missions (though command should NACK with MAV_RESULT_DENIED if a specified local camera does not exist). If addressed to a MAVLink camera, param1 can be used to address all cameras (0), or to separately address 1 to 7 individual sensors. Other values should be NACKed with MAV_RESULT_DENIED. If the command is broadcast (target_component is 0) then param 1 should be set to 0 (any other value should be NACKED with MAV_RESULT_DENIED). An autopilot would trigger any local cameras and forward the command to all channels."},
+			{Name: "MAV_CMD_REQUEST_CAMERA_IMAGE_CAPTURE", Value: 2002, Description: "Re-request a CAMERA_IMAGE_CAPTURED message."},
+			{Name: "MAV_CMD_DO_TRIGGER_CONTROL", Value: 2003, Description: "Enable or disable on-board camera triggering system."},
+			{Name: "MAV_CMD_CAMERA_TRACK_POINT", Value: 2004, Description: "If the camera supports point visual tracking (CAMERA_CAP_FLAGS_HAS_TRACKING_POINT is set), this command allows to initiate the tracking."},
+			{Name: "MAV_CMD_CAMERA_TRACK_RECTANGLE", Value: 2005, Description: "If the camera supports rectangle visual tracking (CAMERA_CAP_FLAGS_HAS_TRACKING_RECTANGLE is set), this command allows to initiate the tracking."},
+			{Name: "MAV_CMD_CAMERA_STOP_TRACKING", Value: 2010, Description: "Stops ongoing tracking."},
+			{Name: "MAV_CMD_VIDEO_START_CAPTURE", Value: 2500, Description: "Starts video capture (recording)."},
+			{Name: "MAV_CMD_VIDEO_STOP_CAPTURE", Value: 2501, Description: "Stop the current video capture (recording)."},
+			{Name: "MAV_CMD_VIDEO_START_STREAMING", Value: 2502, Description: "Start video streaming"},
+			{Name: "MAV_CMD_VIDEO_STOP_STREAMING", Value: 2503, Description: "Stop the given video stream"},
+			{Name: "MAV_CMD_REQUEST_VIDEO_STREAM_INFORMATION", Value: 2504, Description: "Request video stream information (VIDEO_STREAM_INFORMATION)"},
+			{Name: "MAV_CMD_REQUEST_VIDEO_STREAM_STATUS", Value: 2505, Description: "Request video stream status (VIDEO_STREAM_STATUS)"},
+			{Name: "MAV_CMD_LOGGING_START", Value: 2510, Description: "Request to start streaming logging data over MAVLink (see also LOGGING_DATA message)"},
+			{Name: "MAV_CMD_LOGGING_STOP", Value: 2511, Description: "Request to stop streaming log data over MAVLink"},
+			{Name: "MAV_CMD_AIRFRAME_CONFIGURATION", Value: 2520},
+			{Name: "MAV_CMD_CONTROL_HIGH_LATENCY", Value: 2600, Description: "Request to start/stop transmitting over the high latency telemetry"},
+			{Name: "MAV_CMD_PANORAMA_CREATE", Value: 2800, Description: "Create a panorama at the current position"},
+			{Name: "MAV_CMD_DO_VTOL_TRANSITION", Value: 3000, Description: "Request VTOL transition"},
+			{Name: "MAV_CMD_ARM_AUTHORIZATION_REQUEST", Value: 3001, Description: "Request authorization to arm the vehicle to a external entity, the arm authorizer is responsible to request all data that is needs from the vehicle before authorize or deny the request. If approved the COMMAND_ACK message progress field should be set with period of time that this authorization is valid in seconds. If the authorization is denied COMMAND_ACK.result_param2 should be set with one of the reasons in MAV_ARM_AUTH_DENIED_REASON."},
+			{Name: "MAV_CMD_SET_GUIDED_SUBMODE_STANDARD", Value: 4000, Description: "This command sets the submode to standard guided when vehicle is in guided mode. The vehicle holds position and altitude and the user can input the desired velocities along all three axes."},
+			{Name: "MAV_CMD_SET_GUIDED_SUBMODE_CIRCLE", Value: 4001, Description: "This command sets submode circle when vehicle is in guided mode. Vehicle flies along a circle facing the center of the circle. The user can input the velocity along the circle and change the radius. If no input is given the vehicle will hold position."},
+			{Name: "MAV_CMD_CONDITION_GATE", Value: 4501, Description: "Delay mission state machine until gate has been reached."},
+			{Name: "MAV_CMD_NAV_FENCE_RETURN_POINT", Value: 5000, Description: "Fence return point (there can only be one such point in a geofence definition). If rally points are supported they should be used instead."},
+			{Name: "MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION", Value: 5001, Description: "Fence vertex for an inclusion polygon (the polygon must not be self-intersecting). The vehicle must stay within this area. Minimum of 3 vertices required. The vertices for a polygon must be sent sequentially, each with param1 set to the total number of vertices in the polygon."},
+			{Name: "MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION", Value: 5002, Description: "Fence vertex for an exclusion polygon (the polygon must not be self-intersecting). The vehicle must stay outside this area. Minimum of 3 vertices required. The vertices for a polygon must be sent sequentially, each with param1 set to the total number of vertices in the polygon."},
+			{Name: "MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION", Value: 5003, Description: "Circular fence area. The vehicle must stay inside this area."},
+			{Name: "MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION", Value: 5004, Description: "Circular fence area. The vehicle must stay outside this area."},
+			{Name: "MAV_CMD_NAV_RALLY_POINT", Value: 5100, Description: "Rally point. You can have multiple rally points defined."},
+			{Name: "MAV_CMD_UAVCAN_GET_NODE_INFO", Value: 5200, Description: "Commands the vehicle to respond with a sequence of messages UAVCAN_NODE_INFO, one message per every UAVCAN node that is online. Note that some of the response messages can be lost, which the receiver can detect easily by checking whether every received UAVCAN_NODE_STATUS has a matching message UAVCAN_NODE_INFO received earlier; if not, this command should be sent again in order to request re-transmission of the node information messages."},
+			{Name: "MAV_CMD_DO_SET_SAFETY_SWITCH_STATE", Value: 5300, Description: "Change state of safety switch."},
+			{Name: "MAV_CMD_DO_ADSB_OUT_IDENT", Value: 10001, Description: "Trigger the start of an ADSB-out IDENT. This should only be used when requested to do so by an Air Traffic Controller in controlled airspace. This starts the IDENT which is then typically held for 18 seconds by the hardware per the Mode A, C, and S transponder spec."},
+			{Name: "MAV_CMD_PAYLOAD_PREPARE_DEPLOY", Value: 30001, Description: "Deploy payload on a Lat / Lon / Alt position. This includes the navigation to reach the required release position and velocity."},
+			{Name: "MAV_CMD_PAYLOAD_CONTROL_DEPLOY", Value: 30002, Description: "Control the payload deployment."},
+			{Name: "MAV_CMD_FIXED_MAG_CAL_YAW", Value: 42006, Description: "Magnetometer calibration based on provided known yaw. This allows for fast calibration using WMM field tables in the vehicle, given only the known yaw of the vehicle. If Latitude and longitude are both zero then use the current vehicle location."},
+			{Name: "MAV_CMD_DO_WINCH", Value: 42600, Description: "Command to operate winch."},
+			{Name: "MAV_CMD_GUIDED_CHANGE_SPEED", Value: 43000, Description: "Change flight speed at a given rate. This slews the vehicle at a controllable rate between it's previous speed and the new one."},
+			{Name: "MAV_CMD_GUIDED_CHANGE_ALTITUDE", Value: 43001, Description: "Change target altitude at a given rate. This slews the vehicle at a controllable rate between it's previous altitude and the new one."},
+			{Name: "MAV_CMD_GUIDED_CHANGE_HEADING", Value: 43002, Description: "Change to target direction at a given rate, overriding previous heading/s. This slews the vehicle at a controllable rate between its previous heading and the new one."},
+			{Name: "MAV_CMD_EXTERNAL_POSITION_ESTIMATE", Value: 43003, Description: "Provide an external position estimate for use when dead-reckoning. This is meant to be used for occasional position resets that may be provided by a external system such as a remote pilot using landmarks over a video link."},
+			{Name: "MAV_CMD_WAYPOINT_USER_1", Value: 31000, Description: "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+			{Name: "MAV_CMD_WAYPOINT_USER_2", Value: 31001, Description: "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+			{Name: "MAV_CMD_WAYPOINT_USER_3", Value: 31002, Description: "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+			{Name: "MAV_CMD_WAYPOINT_USER_4", Value: 31003, Description: "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+			{Name: "MAV_CMD_WAYPOINT_USER_5", Value: 31004, Description: "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+			{Name: "MAV_CMD_SPATIAL_USER_1", Value: 31005, Description: "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+			{Name: "MAV_CMD_SPATIAL_USER_2", Value: 31006, Description: "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+			{Name: "MAV_CMD_SPATIAL_USER_3", Value: 31007, Description: "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+			{Name: "MAV_CMD_SPATIAL_USER_4", Value: 31008, Description: "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+			{Name: "MAV_CMD_SPATIAL_USER_5", Value: 31009, Description: "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+			{Name: "MAV_CMD_USER_1", Value: 31010, Description: "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+			{Name: "MAV_CMD_USER_2", Value: 31011, Description: "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+			{Name: "MAV_CMD_USER_3", Value: 31012, Description: "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+			{Name: "MAV_CMD_USER_4", Value: 31013, Description: "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+			{Name: "MAV_CMD_USER_5", Value: 31014, Description: "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+			{Name: "MAV_CMD_CAN_FORWARD", Value: 32000, Description: "Request forwarding of CAN packets from the given CAN bus to this component via this MAVLink channel. CAN Frames are sent using CAN_FRAME and CANFD_FRAME messages"},
+		},
+	},
+	"MAV_COLLISION_ACTION": {
+		Name:        "MAV_COLLISION_ACTION",
+		Description: "Possible actions an aircraft can take to avoid a collision.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_COLLISION_ACTION_NONE", Value: 0, Description: "Ignore any potential collisions"},
+			{Name: "MAV_COLLISION_ACTION_REPORT", Value: 1, Description: "Report potential collision"},
+			{Name: "MAV_COLLISION_ACTION_ASCEND_OR_DESCEND", Value: 2, Description: "Ascend or Descend to avoid threat"},
+			{Name: "MAV_COLLISION_ACTION_MOVE_HORIZONTALLY", Value: 3, Description: "Move horizontally to avoid threat"},
+			{Name: "MAV_COLLISION_ACTION_MOVE_PERPENDICULAR", Value: 4, Description: "Aircraft to move perpendicular to the collision's velocity vector"},
+			{Name: "MAV_COLLISION_ACTION_RTL", Value: 5, Description: "Aircraft to fly directly back to its launch point"},
+			{Name: "MAV_COLLISION_ACTION_HOVER", Value: 6, Description: "Aircraft to stop in place"},
+		},
+	},
+	"MAV_COLLISION_SRC": {
+		Name:        "MAV_COLLISION_SRC",
+		Description: "Source of information about this collision.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_COLLISION_SRC_ADSB", Value: 0, Description: "ID field references ADSB_VEHICLE packets"},
+			{Name: "MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT", Value: 1, Description: "ID field references MAVLink SRC ID"},
+		},
+	},
+	"MAV_COLLISION_THREAT_LEVEL": {
+		Name:        "MAV_COLLISION_THREAT_LEVEL",
+		Description: "Aircraft-rated danger from this threat.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_COLLISION_THREAT_LEVEL_NONE", Value: 0, Description: "Not a threat"},
+			{Name: "MAV_COLLISION_THREAT_LEVEL_LOW", Value: 1, Description: "Craft is mildly concerned about this threat"},
+			{Name: "MAV_COLLISION_THREAT_LEVEL_HIGH", Value: 2, Description: "Craft is panicking, and may take actions to avoid threat"},
+		},
+	},
+	"MAV_COMPONENT": {
+		Name:        "MAV_COMPONENT",
+		Description: "Legacy component ID values for particular types of hardware/software that might make up a MAVLink system (autopilot, cameras, servos, avoidance systems etc.). Components are not required or expected to use IDs with names that correspond to their type or function, but may choose to do so. Using an ID that matches the type may slightly reduce the chances of component id clashes, as, for historical reasons, it is less likely to be used by some other type of component. System integration will still need to ensure that all components have unique IDs. Component IDs are used for addressing messages to a particular component within a system. A component can use any unique ID between 1 and 255 (MAV_COMP_ID_ALL value is the broadcast address, used to send to all components). Historically component ID were also used for identifying the type of component. New code must not use component IDs to infer the component type, but instead check the MAV_TYPE in the HEARTBEAT message!",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_COMP_ID_ALL", Value: 0, Description: "Target id (target_component) used to broadcast messages to all components of the receiving system. Components should attempt to process messages with this component ID and forward to components on any other interfaces. Note: This is not a valid *source* component id for a message."},
+			{Name: "MAV_COMP_ID_AUTOPILOT1", Value: 1, Description: "System flight controller component (\"autopilot\"). Only one autopilot is expected in a particular system."},
+			{Name: "MAV_COMP_ID_USER1", Value: 25, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER2", Value: 26, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER3", Value: 27, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER4", Value: 28, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER5", Value: 29, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER6", Value: 30, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER7", Value: 31, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER8", Value: 32, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER9", Value: 33, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER10", Value: 34, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER11", Value: 35, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER12", Value: 36, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER13", Value: 37, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER14", Value: 38, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER15", Value: 39, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER16", Value: 40, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER17", Value: 41, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER18", Value: 42, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER19", Value: 43, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER20", Value: 44, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER21", Value: 45, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER22", Value: 46, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER23", Value: 47, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER24", Value: 48, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER25", Value: 49, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER26", Value: 50, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER27", Value: 51, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER28", Value: 52, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER29", Value: 53, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER30", Value: 54, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER31", Value: 55, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER32", Value: 56, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER33", Value: 57, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER34", Value: 58, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER35", Value: 59, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER36", Value: 60, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER37", Value: 61, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER38", Value: 62, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER39", Value: 63, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER40", Value: 64, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER41", Value: 65, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER42", Value: 66, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER43", Value: 67, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_TELEMETRY_RADIO", Value: 68, Description: "Telemetry radio (e.g. SiK radio, or other component that emits RADIO_STATUS messages)."},
+			{Name: "MAV_COMP_ID_USER45", Value: 69, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER46", Value: 70, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER47", Value: 71, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER48", Value: 72, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER49", Value: 73, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER50", Value: 74, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER51", Value: 75, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER52", Value: 76, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER53", Value: 77, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER54", Value: 78, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER55", Value: 79, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER56", Value: 80, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER57", Value: 81, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER58", Value: 82, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER59", Value: 83, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER60", Value: 84, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER61", Value: 85, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER62", Value: 86, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER63", Value: 87, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER64", Value: 88, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER65", Value: 89, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER66", Value: 90, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER67", Value: 91, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER68", Value: 92, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER69", Value: 93, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER70", Value: 94, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER71", Value: 95, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER72", Value: 96, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER73", Value: 97, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER74", Value: 98, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_USER75", Value: 99, Description: "Id for a component on privately managed MAVLink network. Can be used for any purpose but may not be published by components outside of the private network."},
+			{Name: "MAV_COMP_ID_CAMERA", Value: 100, Description: "Camera #1."},
+			{Name: "MAV_COMP_ID_CAMERA2", Value: 101, Description: "Camera #2."},
+			{Name: "MAV_COMP_ID_CAMERA3", Value: 102, Description: "Camera #3."},
+			{Name: "MAV_COMP_ID_CAMERA4", Value: 103, Description: "Camera #4."},
+			{Name: "MAV_COMP_ID_CAMERA5", Value: 104, Description: "Camera #5."},
+			{Name: "MAV_COMP_ID_CAMERA6", Value: 105, Description: "Camera #6."},
+			{Name: "MAV_COMP_ID_RADIO", Value: 110, Description: "Radio #1."},
+			{Name: "MAV_COMP_ID_RADIO2", Value: 111, Description: "Radio #2."},
+			{Name: "MAV_COMP_ID_RADIO3", Value: 112, Description: "Radio #3."},
+			{Name: "MAV_COMP_ID_SERVO1", Value: 140, Description: "Servo #1."},
+			{Name: "MAV_COMP_ID_SERVO2", Value: 141, Description: "Servo #2."},
+			{Name: "MAV_COMP_ID_SERVO3", Value: 142, Description: "Servo #3."},
+			{Name: "MAV_COMP_ID_SERVO4", Value: 143, Description: "Servo #4."},
+			{Name: "MAV_COMP_ID_SERVO5", Value: 144, Description: "Servo #5."},
+			{Name: "MAV_COMP_ID_SERVO6", Value: 145, Description: "Servo #6."},
+			{Name: "MAV_COMP_ID_SERVO7", Value: 146, Description: "Servo #7."},
+			{Name: "MAV_COMP_ID_SERVO8", Value: 147, Description: "Servo #8."},
+			{Name: "MAV_COMP_ID_SERVO9", Value: 148, Description: "Servo #9."},
+			{Name: "MAV_COMP_ID_SERVO10", Value: 149, Description: "Servo #10."},
+			{Name: "MAV_COMP_ID_SERVO11", Value: 150, Description: "Servo #11."},
+			{Name: "MAV_COMP_ID_SERVO12", Value: 151, Description: "Servo #12."},
+			{Name: "MAV_COMP_ID_SERVO13", Value: 152, Description: "Servo #13."},
+			{Name: "MAV_COMP_ID_SERVO14", Value: 153, Description: "Servo #14."},
+			{Name: "MAV_COMP_ID_GIMBAL", Value: 154, Description: "Gimbal #1."},
+			{Name: "MAV_COMP_ID_LOG", Value: 155, Description: "Logging component."},
+			{Name: "MAV_COMP_ID_ADSB", Value: 156, Description: "Automatic Dependent Surveillance-Broadcast (ADS-B) component."},
+			{Name: "MAV_COMP_ID_OSD", Value: 157, Description: "On Screen Display (OSD) devices for video links."},
+			{Name: "MAV_COMP_ID_PERIPHERAL", Value: 158, Description: "Generic autopilot peripheral component ID. Meant for devices that do not implement the parameter microservice."},
+			{Name: "MAV_COMP_ID_QX1_GIMBAL", Value: 159, Description: "Gimbal ID for QX1."},
+			{Name: "MAV_COMP_ID_FLARM", Value: 160, Description: "FLARM collision alert component."},
+			{Name: "MAV_COMP_ID_PARACHUTE", Value: 161, Description: "Parachute component."},
+			{Name: "MAV_COMP_ID_WINCH", Value: 169, Description: "Winch component."},
+			{Name: "MAV_COMP_ID_GIMBAL2", Value: 171, Description: "Gimbal #2."},
+			{Name: "MAV_COMP_ID_GIMBAL3", Value: 172, Description: "Gimbal #3."},
+			{Name: "MAV_COMP_ID_GIMBAL4", Value: 173, Description: "Gimbal #4"},
+			{Name: "MAV_COMP_ID_GIMBAL5", Value: 174, Description: "Gimbal #5."},
+			{Name: "MAV_COMP_ID_GIMBAL6", Value: 175, Description: "Gimbal #6."},
+			{Name: "MAV_COMP_ID_BATTERY", Value: 180, Description: "Battery #1."},
+			{Name: "MAV_COMP_ID_BATTERY2", Value: 181, Description: "Battery #2."},
+			{Name: "MAV_COMP_ID_MAVCAN", Value: 189, Description: "CAN over MAVLink client."},
+			{Name: "MAV_COMP_ID_MISSIONPLANNER", Value: 190, Description: "Component that can generate/supply a mission flight plan (e.g. GCS or developer API)."},
+			{Name: "MAV_COMP_ID_ONBOARD_COMPUTER", Value: 191, Description: "Component that lives on the onboard computer (companion computer) and has some generic functionalities, such as settings system parameters and monitoring the status of some processes that don't directly speak mavlink and so on."},
+			{Name: "MAV_COMP_ID_ONBOARD_COMPUTER2", Value: 192, Description: "Component that lives on the onboard computer (companion computer) and has some generic functionalities, such as settings system parameters and monitoring the status of some processes that don't directly speak mavlink and so on."},
+			{Name: "MAV_COMP_ID_ONBOARD_COMPUTER3", Value: 193, Description: "Component that lives on the onboard computer (companion computer) and has some generic functionalities, such as settings system parameters and monitoring the status of some processes that don't directly speak mavlink and so on."},
+			{Name: "MAV_COMP_ID_ONBOARD_COMPUTER4", Value: 194, Description: "Component that lives on the onboard computer (companion computer) and has some generic functionalities, such as settings system parameters and monitoring the status of some processes that don't directly speak mavlink and so on."},
+			{Name: "MAV_COMP_ID_PATHPLANNER", Value: 195, Description: "Component that finds an optimal path between points based on a certain constraint (e.g. minimum snap, shortest path, cost, etc.)."},
+			{Name: "MAV_COMP_ID_OBSTACLE_AVOIDANCE", Value: 196, Description: "Component that plans a collision free path between two points."},
+			{Name: "MAV_COMP_ID_VISUAL_INERTIAL_ODOMETRY", Value: 197, Description: "Component that provides position estimates using VIO techniques."},
+			{Name: "MAV_COMP_ID_PAIRING_MANAGER", Value: 198, Description: "Component that manages pairing of vehicle and GCS."},
+			{Name: "MAV_COMP_ID_IMU", Value: 200, Description: "Inertial Measurement Unit (IMU) #1."},
+			{Name: "MAV_COMP_ID_IMU_2", Value: 201, Description: "Inertial Measurement Unit (IMU) #2."},
+			{Name: "MAV_COMP_ID_IMU_3", Value: 202, Description: "Inertial Measurement Unit (IMU) #3."},
+			{Name: "MAV_COMP_ID_GPS", Value: 220, Description: "GPS #1."},
+			{Name: "MAV_COMP_ID_GPS2", Value: 221, Description: "GPS #2."},
+			{Name: "MAV_COMP_ID_ODID_TXRX_1", Value: 236, Description: "Open Drone ID transmitter/receiver (Bluetooth/WiFi/Internet)."},
+			{Name: "MAV_COMP_ID_ODID_TXRX_2", Value: 237, Description: "Open Drone ID transmitter/receiver (Bluetooth/WiFi/Internet)."},
+			{Name: "MAV_COMP_ID_ODID_TXRX_3", Value: 238, Description: "Open Drone ID transmitter/receiver (Bluetooth/WiFi/Internet)."},
+			{Name: "MAV_COMP_ID_UDP_BRIDGE", Value: 240, Description: "Component to bridge MAVLink to UDP (i.e. from a UART)."},
+			{Name: "MAV_COMP_ID_UART_BRIDGE", Value: 241, Description: "Component to bridge to UART (i.e. from UDP)."},
+			{Name: "MAV_COMP_ID_TUNNEL_NODE", Value: 242, Description: "Component handling TUNNEL messages (e.g. vendor specific GUI of a component)."},
+			{Name: "MAV_COMP_ID_ILLUMINATOR", Value: 243, Description: "Illuminator"},
+			{Name: "MAV_COMP_ID_SYSTEM_CONTROL", Value: 250, Description: "Deprecated, don't use. Component for handling system messages (e.g. to ARM, takeoff, etc.)."},
+		},
+	},
+	"MAV_DATA_STREAM": {
+		Name:        "MAV_DATA_STREAM",
+		Description: "A data stream is not a fixed set of messages, but rather a recommendation to the autopilot software. Individual autopilots may or may not obey the recommended messages.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_DATA_STREAM_ALL", Value: 0, Description: "Enable all data streams"},
+			{Name: "MAV_DATA_STREAM_RAW_SENSORS", Value: 1, Description: "Enable IMU_RAW, GPS_RAW, GPS_STATUS packets."},
+			{Name: "MAV_DATA_STREAM_EXTENDED_STATUS", Value: 2, Description: "Enable GPS_STATUS, CONTROL_STATUS, AUX_STATUS"},
+			{Name: "MAV_DATA_STREAM_RC_CHANNELS", Value: 3, Description: "Enable RC_CHANNELS_SCALED, RC_CHANNELS_RAW, SERVO_OUTPUT_RAW"},
+			{Name: "MAV_DATA_STREAM_RAW_CONTROLLER", Value: 4, Description: "Enable ATTITUDE_CONTROLLER_OUTPUT, POSITION_CONTROLLER_OUTPUT, NAV_CONTROLLER_OUTPUT."},
+			{Name: "MAV_DATA_STREAM_POSITION", Value: 6, Description: "Enable LOCAL_POSITION, GLOBAL_POSITION_INT messages."},
+			{Name: "MAV_DATA_STREAM_EXTRA1", Value: 10, Description: "Dependent on the autopilot"},
+			{Name: "MAV_DATA_STREAM_EXTRA2", Value: 11, Description: "Dependent on the autopilot"},
+			{Name: "MAV_DATA_STREAM_EXTRA3", Value: 12, Description: "Dependent on the autopilot"},
+		},
+	},
+	"MAV_DISTANCE_SENSOR": {
+		Name:        "MAV_DISTANCE_SENSOR",
+		Description: "Enumeration of distance sensor types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_DISTANCE_SENSOR_LASER", Value: 0, Description: "Laser rangefinder, e.g. LightWare SF02/F or PulsedLight units"},
+			{Name: "MAV_DISTANCE_SENSOR_ULTRASOUND", Value: 1, Description: "Ultrasound rangefinder, e.g. MaxBotix units"},
+			{Name: "MAV_DISTANCE_SENSOR_INFRARED", Value: 2, Description: "Infrared rangefinder, e.g. Sharp units"},
+			{Name: "MAV_DISTANCE_SENSOR_RADAR", Value: 3, Description: "Radar type, e.g. uLanding units"},
+			{Name: "MAV_DISTANCE_SENSOR_UNKNOWN", Value: 4, Description: "Broken or unknown type, e.g. analog units"},
+		},
+	},
+	"MAV_DO_REPOSITION_FLAGS": {
+		Name:        "MAV_DO_REPOSITION_FLAGS",
+		Description: "Bitmap of options for the MAV_CMD_DO_REPOSITION",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_DO_REPOSITION_FLAGS_CHANGE_MODE", Value: 1, Description: "The aircraft should immediately transition into guided. This should not be set for follow me applications"},
+			{Name: "MAV_DO_REPOSITION_FLAGS_RELATIVE_YAW", Value: 2, Description: "Yaw relative to the vehicle current heading (if not set, relative to North)."},
+		},
+	},
+	"MAV_ESTIMATOR_TYPE": {
+		Name:        "MAV_ESTIMATOR_TYPE",
+		Description: "Enumeration of estimator types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ESTIMATOR_TYPE_UNKNOWN", Value: 0, Description: "Unknown type of the estimator."},
+			{Name: "MAV_ESTIMATOR_TYPE_NAIVE", Value: 1, Description: "This is a naive estimator without any real covariance feedback."},
+			{Name: "MAV_ESTIMATOR_TYPE_VISION", Value: 2, Description: "Computer vision based estimate. Might be up to scale."},
+			{Name: "MAV_ESTIMATOR_TYPE_VIO", Value: 3, Description: "Visual-inertial estimate."},
+			{Name: "MAV_ESTIMATOR_TYPE_GPS", Value: 4, Description: "Plain GPS estimate."},
+			{Name: "MAV_ESTIMATOR_TYPE_GPS_INS", Value: 5, Description: "Estimator integrating GPS and inertial sensing."},
+			{Name: "MAV_ESTIMATOR_TYPE_MOCAP", Value: 6, Description: "Estimate from external motion capturing system."},
+			{Name: "MAV_ESTIMATOR_TYPE_LIDAR", Value: 7, Description: "Estimator based on lidar sensor input."},
+			{Name: "MAV_ESTIMATOR_TYPE_AUTOPILOT", Value: 8, Description: "Estimator on autopilot."},
+		},
+	},
+	"MAV_EVENT_CURRENT_SEQUENCE_FLAGS": {
+		Name:        "MAV_EVENT_CURRENT_SEQUENCE_FLAGS",
+		Description: "Flags for CURRENT_EVENT_SEQUENCE.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_EVENT_CURRENT_SEQUENCE_FLAGS_RESET", Value: 1, Description: "A sequence reset has happened (e.g. vehicle reboot)."},
+		},
+	},
+	"MAV_EVENT_ERROR_REASON": {
+		Name:        "MAV_EVENT_ERROR_REASON",
+		Description: "Reason for an event error response.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_EVENT_ERROR_REASON_UNAVAILABLE", Value: 0, Description: "The requested event is not available (anymore)."},
+		},
+	},
+	"MAV_FRAME": {
+		Name:        "MAV_FRAME",
+		Description: "Coordinate frames used by MAVLink. Not all frames are supported by all commands, messages, or vehicles. Global frames use the following naming conventions: - \"GLOBAL\": Global coordinate frame with WGS84 latitude/longitude and altitude positive over mean sea level (MSL) by default. The following modifiers may be used with \"GLOBAL\": - \"RELATIVE_ALT\": Altitude is relative to the vehicle home position rather than MSL. - \"TERRAIN_ALT\": Altitude is relative to ground level rather than MSL. - \"INT\": Latitude/longitude (in degrees) are scaled by multiplying by 1E7. Local frames use the following naming conventions: - \"LOCAL\": Origin of local frame is fixed relative to earth. Unless otherwise specified this origin is the origin of the vehicle position-estimator (\"EKF\"). - \"BODY\": Origin of local frame travels with the vehicle. NOTE, \"BODY\" does NOT indicate alignment of frame axis with vehicle attitude. - \"OFFSET\": Deprecated synonym for \"BODY\" (origin travels with the vehicle). Not to be used for new frames. Some deprecated frames do not follow these conventions (e.g. MAV_FRAME_BODY_NED and MAV_FRAME_BODY_OFFSET_NED).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_FRAME_GLOBAL", Value: 0, Description: "Global (WGS84) coordinate frame + altitude relative to mean sea level (MSL)."},
+			{Name: "MAV_FRAME_LOCAL_NED", Value: 1, Description: "NED local tangent frame (x: North, y: East, z: Down) with origin fixed relative to earth."},
+			{Name: "MAV_FRAME_MISSION", Value: 2, Description: "NOT a coordinate frame, indicates a mission command."},
+			{Name: "MAV_FRAME_GLOBAL_RELATIVE_ALT", Value: 3, Description: "Global (WGS84) coordinate frame + altitude relative to the home position."},
+			{Name: "MAV_FRAME_LOCAL_ENU", Value: 4, Description: "ENU local tangent frame (x: East, y: North, z: Up) with origin fixed relative to earth."},
+			{Name: "MAV_FRAME_GLOBAL_INT", Value: 5, Description: "Global (WGS84) coordinate frame (scaled) + altitude relative to mean sea level (MSL)."},
+			{Name: "MAV_FRAME_GLOBAL_RELATIVE_ALT_INT", Value: 6, Description: "Global (WGS84) coordinate frame (scaled) + altitude relative to the home position."},
+			{Name: "MAV_FRAME_LOCAL_OFFSET_NED", Value: 7, Description: "NED local tangent frame (x: North, y: East, z: Down) with origin that travels with the vehicle."},
+			{Name: "MAV_FRAME_BODY_NED", Value: 8, Description: "Same as MAV_FRAME_LOCAL_NED when used to represent position values. Same as MAV_FRAME_BODY_FRD when used with velocity/acceleration values."},
+			{Name: "MAV_FRAME_BODY_OFFSET_NED", Value: 9, Description: "This is the same as MAV_FRAME_BODY_FRD."},
+			{Name: "MAV_FRAME_GLOBAL_TERRAIN_ALT", Value: 10, Description: "Global (WGS84) coordinate frame with AGL altitude (altitude at ground level)."},
+			{Name: "MAV_FRAME_GLOBAL_TERRAIN_ALT_INT", Value: 11, Description: "Global (WGS84) coordinate frame (scaled) with AGL altitude (altitude at ground level)."},
+			{Name: "MAV_FRAME_BODY_FRD", Value: 12, Description: "FRD local frame aligned to the vehicle's attitude (x: Forward, y: Right, z: Down) with an origin that travels with vehicle."},
+			{Name: "MAV_FRAME_RESERVED_13", Value: 13, Description: "MAV_FRAME_BODY_FLU - Body fixed frame of reference, Z-up (x: Forward, y: Left, z: Up)."},
+			{Name: "MAV_FRAME_RESERVED_14", Value: 14, Description: "MAV_FRAME_MOCAP_NED - Odometry local coordinate frame of data given by a motion capture system, Z-down (x: North, y: East, z: Down)."},
+			{Name: "MAV_FRAME_RESERVED_15", Value: 15, Description: "MAV_FRAME_MOCAP_ENU - Odometry local coordinate frame of data given by a motion capture system, Z-up (x: East, y: North, z: Up)."},
+			{Name: "MAV_FRAME_RESERVED_16", Value: 16, Description: "MAV_FRAME_VISION_NED - Odometry local coordinate frame of data given by a vision estimation system, Z-down (x: North, y: East, z: Down)."},
+			{Name: "MAV_FRAME_RESERVED_17", Value: 17, Description: "MAV_FRAME_VISION_ENU - Odometry local coordinate frame of data given by a vision estimation system, Z-up (x: East, y: North, z: Up)."},
+			{Name: "MAV_FRAME_RESERVED_18", Value: 18, Description: "MAV_FRAME_ESTIM_NED - Odometry local coordinate frame of data given by an estimator running onboard the vehicle, Z-down (x: North, y: East, z: Down)."},
+			{Name: "MAV_FRAME_RESERVED_19", Value: 19, Description: "MAV_FRAME_ESTIM_ENU - Odometry local coordinate frame of data given by an estimator running onboard the vehicle, Z-up (x: East, y: North, z: Up)."},
+			{Name: "MAV_FRAME_LOCAL_FRD", Value: 20, Description: "FRD local tangent frame (x: Forward, y: Right, z: Down) with origin fixed relative to earth. The forward axis is aligned to the front of the vehicle in the horizontal plane."},
+			{Name: "MAV_FRAME_LOCAL_FLU", Value: 21, Description: "FLU local tangent frame (x: Forward, y: Left, z: Up) with origin fixed relative to earth. The forward axis is aligned to the front of the vehicle in the horizontal plane."},
+		},
+	},
+	"MAV_FTP_ERR": {
+		Name:        "MAV_FTP_ERR",
+		Description: "MAV FTP error codes (may be used in FILE_TRANSFER_PROTOCOL). See https://mavlink.io/en/services/ftp.html.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_FTP_ERR_NONE", Value: 0, Description: "None: No error"},
+			{Name: "MAV_FTP_ERR_FAIL", Value: 1, Description: "Fail: Unknown failure"},
+			{Name: "MAV_FTP_ERR_FAILERRNO", Value: 2, Description: "FailErrno: Command failed, Err number sent back in PayloadHeader.data[1]. This is a file-system error number understood by the server operating system."},
+			{Name: "MAV_FTP_ERR_INVALIDDATASIZE", Value: 3, Description: "InvalidDataSize: Payload size is invalid"},
+			{Name: "MAV_FTP_ERR_INVALIDSESSION", Value: 4, Description: "InvalidSession: Session is not currently open"},
+			{Name: "MAV_FTP_ERR_NOSESSIONSAVAILABLE", Value: 5, Description: "NoSessionsAvailable: All available sessions are already in use"},
+			{Name: "MAV_FTP_ERR_EOF", Value: 6, Description: "EOF: Offset past end of file for ListDirectory and ReadFile commands"},
+			{Name: "MAV_FTP_ERR_UNKNOWNCOMMAND", Value: 7, Description: "UnknownCommand: Unknown command / opcode"},
+			{Name: "MAV_FTP_ERR_FILEEXISTS", Value: 8, Description: "FileExists: File/directory already exists"},
+			{Name: "MAV_FTP_ERR_FILEPROTECTED", Value: 9, Description: "FileProtected: File/directory is write protected"},
+			{Name: "MAV_FTP_ERR_FILENOTFOUND", Value: 10, Description: "FileNotFound: File/directory not found"},
+		},
+	},
+	"MAV_FTP_OPCODE": {
+		Name:        "MAV_FTP_OPCODE",
+		Description: "MAV FTP opcodes (may be used in FILE_TRANSFER_PROTOCOL). See https://mavlink.io/en/services/ftp.html.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_FTP_OPCODE_NONE", Value: 0, Description: "None. Ignored, always ACKed"},
+			{Name: "MAV_FTP_OPCODE_TERMINATESESSION", Value: 1, Description: "TerminateSession: Terminates open Read session"},
+			{Name: "MAV_FTP_OPCODE_RESETSESSION", Value: 2, Description: "ResetSessions: Terminates all open read sessions"},
+			{Name: "MAV_FTP_OPCODE_LISTDIRECTORY", Value: 3, Description: "ListDirectory. List files and directories in path from offset"},
+			{Name: "MAV_FTP_OPCODE_OPENFILERO", Value: 4, Description: "OpenFileRO: Opens file at path for reading, returns session"},
+			{Name: "MAV_FTP_OPCODE_READFILE", Value: 5, Description: "ReadFile: Reads size bytes from offset in session"},
+			{Name: "MAV_FTP_OPCODE_CREATEFILE", Value: 6, Description: "CreateFile: Creates file at path for writing, returns session"},
+			{Name: "MAV_FTP_OPCODE_WRITEFILE", Value: 7, Description: "WriteFile: Writes size bytes to offset in session"},
+			{Name: "MAV_FTP_OPCODE_REMOVEFILE", Value: 8, Description: "RemoveFile: Remove file at path"},
+			{Name: "MAV_FTP_OPCODE_CREATEDIRECTORY", Value: 9, Description: "CreateDirectory: Creates directory at path"},
+			{Name: "MAV_FTP_OPCODE_REMOVEDIRECTORY", Value: 10, Description: "RemoveDirectory: Removes directory at path. The directory must be empty."},
+			{Name: "MAV_FTP_OPCODE_OPENFILEWO", Value: 11, Description: "OpenFileWO: Opens file at path for writing, returns session"},
+			{Name: "MAV_FTP_OPCODE_TRUNCATEFILE", Value: 12, Description: "TruncateFile: Truncate file at path to offset length"},
+			{Name: "MAV_FTP_OPCODE_RENAME", Value: 13, Description: "Rename: Rename path1 to path2"},
+			{Name: "MAV_FTP_OPCODE_CALCFILECRC", Value: 14, Description: "CalcFileCRC32: Calculate CRC32 for file at path"},
+			{Name: "MAV_FTP_OPCODE_BURSTREADFILE", Value: 15, Description: "BurstReadFile: Burst download session file"},
+			{Name: "MAV_FTP_OPCODE_LISTDIRECTORYWITHTIME", Value: 16, Description: "ListDirectoryWithTime: List files and directories, along with last-modification timestamps, in path from offset. This is the same as ListDirectory except for the addition of timestamps. Servers that do not support this opcode respond with a NAK (MAV_FTP_ERR_UNKNOWNCOMMAND)."},
+			{Name: "MAV_FTP_OPCODE_ACK", Value: 128, Description: "ACK: ACK response"},
+			{Name: "MAV_FTP_OPCODE_NAK", Value: 129, Description: "NAK: NAK response"},
+		},
+	},
+	"MAV_FUEL_TYPE": {
+		Name:        "MAV_FUEL_TYPE",
+		Description: "Fuel types for use in FUEL_TYPE. Fuel types specify the units for the maximum, available and consumed fuel, and for the flow rates.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_FUEL_TYPE_UNKNOWN", Value: 0, Description: "Not specified. Fuel levels are normalized (i.e. maximum is 1, and other levels are relative to 1)."},
+			{Name: "MAV_FUEL_TYPE_LIQUID", Value: 1, Description: "A generic liquid fuel. Fuel levels are in millilitres (ml). Fuel rates are in millilitres/second."},
+			{Name: "MAV_FUEL_TYPE_GAS", Value: 2, Description: "A gas tank. Fuel levels are in kilo-Pascal (kPa), and flow rates are in milliliters per second (ml/s)."},
+		},
+	},
+	"MAV_GENERATOR_STATUS_FLAG": {
+		Name:        "MAV_GENERATOR_STATUS_FLAG",
+		Description: "Flags to report status/failure cases for a power generator (used in GENERATOR_STATUS). Note that FAULTS are conditions that cause the generator to fail. Warnings are conditions that require attention before the next use (they indicate the system is not operating properly).",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_GENERATOR_STATUS_FLAG_OFF", Value: 1, Description: "Generator is off."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_READY", Value: 2, Description: "Generator is ready to start generating power."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_GENERATING", Value: 4, Description: "Generator is generating power."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_CHARGING", Value: 8, Description: "Generator is charging the batteries (generating enough power to charge and provide the load)."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_REDUCED_POWER", Value: 16, Description: "Generator is operating at a reduced maximum power."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_MAXPOWER", Value: 32, Description: "Generator is providing the maximum output."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_OVERTEMP_WARNING", Value: 64, Description: "Generator is near the maximum operating temperature, cooling is insufficient."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_OVERTEMP_FAULT", Value: 128, Description: "Generator hit the maximum operating temperature and shutdown."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_ELECTRONICS_OVERTEMP_WARNING", Value: 256, Description: "Power electronics are near the maximum operating temperature, cooling is insufficient."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_ELECTRONICS_OVERTEMP_FAULT", Value: 512, Description: "Power electronics hit the maximum operating temperature and shutdown."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_ELECTRONICS_FAULT", Value: 1024, Description: "Power electronics experienced a fault and shutdown."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_POWERSOURCE_FAULT", Value: 2048, Description: "The power source supplying the generator failed e.g. mechanical generator stopped, tether is no longer providing power, solar cell is in shade, hydrogen reaction no longer happening."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_COMMUNICATION_WARNING", Value: 4096, Description: "Generator controller having communication problems."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_COOLING_WARNING", Value: 8192, Description: "Power electronic or generator cooling system error."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_POWER_RAIL_FAULT", Value: 16384, Description: "Generator controller power rail experienced a fault."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_OVERCURRENT_FAULT", Value: 32768, Description: "Generator controller exceeded the overcurrent threshold and shutdown to prevent damage."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_BATTERY_OVERCHARGE_CURRENT_FAULT", Value: 65536, Description: "Generator controller detected a high current going into the batteries and shutdown to prevent battery damage."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_OVERVOLTAGE_FAULT", Value: 131072, Description: "Generator controller exceeded it's overvoltage threshold and shutdown to prevent it exceeding the voltage rating."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_BATTERY_UNDERVOLT_FAULT", Value: 262144, Description: "Batteries are under voltage (generator will not start)."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_START_INHIBITED", Value: 524288, Description: "Generator start is inhibited by e.g. a safety switch."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_MAINTENANCE_REQUIRED", Value: 1048576, Description: "Generator requires maintenance."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_WARMING_UP", Value: 2097152, Description: "Generator is not ready to generate yet."},
+			{Name: "MAV_GENERATOR_STATUS_FLAG_IDLE", Value: 4194304, Description: "Generator is idle."},
+		},
+	},
+	"MAV_GOTO": {
+		Name:        "MAV_GOTO",
+		Description: "Actions that may be specified in MAV_CMD_OVERRIDE_GOTO to override mission execution.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_GOTO_DO_HOLD", Value: 0, Description: "Hold at the current position."},
+			{Name: "MAV_GOTO_DO_CONTINUE", Value: 1, Description: "Continue with the next item in mission execution."},
+			{Name: "MAV_GOTO_HOLD_AT_CURRENT_POSITION", Value: 2, Description: "Hold at the current position of the system"},
+			{Name: "MAV_GOTO_HOLD_AT_SPECIFIED_POSITION", Value: 3, Description: "Hold at the position specified in the parameters of the DO_HOLD action"},
+		},
+	},
+	"MAV_LANDED_STATE": {
+		Name:        "MAV_LANDED_STATE",
+		Description: "Enumeration of landed detector states",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_LANDED_STATE_UNDEFINED", Value: 0, Description: "MAV landed state is unknown"},
+			{Name: "MAV_LANDED_STATE_ON_GROUND", Value: 1, Description: "MAV is landed (on ground)"},
+			{Name: "MAV_LANDED_STATE_IN_AIR", Value: 2, Description: "MAV is in air"},
+			{Name: "MAV_LANDED_STATE_TAKEOFF", Value: 3, Description: "MAV currently taking off"},
+			{Name: "MAV_LANDED_STATE_LANDING", Value: 4, Description: "MAV currently landing"},
+		},
+	},
+	"MAV_MISSION_RESULT": {
+		Name:        "MAV_MISSION_RESULT",
+		Description: "Result of mission operation (in a MISSION_ACK message).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MISSION_ACCEPTED", Value: 0, Description: "mission accepted OK"},
+			{Name: "MAV_MISSION_ERROR", Value: 1, Description: "Generic error / not accepting mission commands at all right now."},
+			{Name: "MAV_MISSION_UNSUPPORTED_FRAME", Value: 2, Description: "Coordinate frame is not supported."},
+			{Name: "MAV_MISSION_UNSUPPORTED", Value: 3, Description: "Command is not supported."},
+			{Name: "MAV_MISSION_NO_SPACE", Value: 4, Description: "Mission items exceed storage space."},
+			{Name: "MAV_MISSION_INVALID", Value: 5, Description: "One of the parameters has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM1", Value: 6, Description: "param1 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM2", Value: 7, Description: "param2 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM3", Value: 8, Description: "param3 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM4", Value: 9, Description: "param4 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM5_X", Value: 10, Description: "x / param5 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM6_Y", Value: 11, Description: "y / param6 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_PARAM7", Value: 12, Description: "z / param7 has an invalid value."},
+			{Name: "MAV_MISSION_INVALID_SEQUENCE", Value: 13, Description: "Mission item received out of sequence"},
+			{Name: "MAV_MISSION_DENIED", Value: 14, Description: "Not accepting any mission commands from this communication partner."},
+			{Name: "MAV_MISSION_OPERATION_CANCELLED", Value: 15, Description: "Current mission operation cancelled (e.g. mission upload, mission download)."},
+		},
+	},
+	"MAV_MISSION_TYPE": {
+		Name:        "MAV_MISSION_TYPE",
+		Description: "Type of mission items being requested/sent in mission protocol.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MISSION_TYPE_MISSION", Value: 0, Description: "Items are mission commands for main mission."},
+			{Name: "MAV_MISSION_TYPE_FENCE", Value: 1, Description: "Specifies GeoFence area(s). Items are MAV_CMD_NAV_FENCE_ GeoFence items."},
+			{Name: "MAV_MISSION_TYPE_RALLY", Value: 2, Description: "Specifies the rally points for the vehicle. Rally points are alternative RTL points. Items are MAV_CMD_NAV_RALLY_POINT rally point items."},
+			{Name: "MAV_MISSION_TYPE_ALL", Value: 255, Description: "Only used in MISSION_CLEAR_ALL to clear all mission types."},
+		},
+	},
+	"MAV_MODE": {
+		Name:        "MAV_MODE",
+		Description: "Predefined OR-combined MAV_MODE_FLAG values. These can simplify using the flags when setting modes. Note that manual input is enabled in all modes as a safety override.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MODE_PREFLIGHT", Value: 0, Description: "System is not ready to fly, booting, calibrating, etc. No flag is set."},
+			{Name: "MAV_MODE_STABILIZE_DISARMED", Value: 80, Description: "System is allowed to be active, under assisted RC control (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_STABILIZE_ENABLED)"},
+			{Name: "MAV_MODE_STABILIZE_ARMED", Value: 208, Description: "System is allowed to be active, under assisted RC control (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, MAV_MODE_FLAG_STABILIZE_ENABLED)"},
+			{Name: "MAV_MODE_MANUAL_DISARMED", Value: 64, Description: "System is allowed to be active, under manual (RC) control, no stabilization (MAV_MODE_FLAG_MANUAL_INPUT_ENABLED)"},
+			{Name: "MAV_MODE_MANUAL_ARMED", Value: 192, Description: "System is allowed to be active, under manual (RC) control, no stabilization (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_MANUAL_INPUT_ENABLED)"},
+			{Name: "MAV_MODE_GUIDED_DISARMED", Value: 88, Description: "System is allowed to be active, under autonomous control, manual setpoint (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_STABILIZE_ENABLED, MAV_MODE_FLAG_GUIDED_ENABLED)"},
+			{Name: "MAV_MODE_GUIDED_ARMED", Value: 216, Description: "System is allowed to be active, under autonomous control, manual setpoint (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, MAV_MODE_FLAG_STABILIZE_ENABLED, MAV_MODE_FLAG_GUIDED_ENABLED)"},
+			{Name: "MAV_MODE_AUTO_DISARMED", Value: 92, Description: "System is allowed to be active, under autonomous control and navigation (the trajectory is decided onboard and not pre-programmed by waypoints). (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_STABILIZE_ENABLED, MAV_MODE_FLAG_GUIDED_ENABLED, MAV_MODE_FLAG_AUTO_ENABLED)."},
+			{Name: "MAV_MODE_AUTO_ARMED", Value: 220, Description: "System is allowed to be active, under autonomous control and navigation (the trajectory is decided onboard and not pre-programmed by waypoints). (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, MAV_MODE_FLAG_STABILIZE_ENABLED, MAV_MODE_FLAG_GUIDED_ENABLED,MAV_MODE_FLAG_AUTO_ENABLED)."},
+			{Name: "MAV_MODE_TEST_DISARMED", Value: 66, Description: "UNDEFINED mode. This solely depends on the autopilot - use with caution, intended for developers only. (MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, MAV_MODE_FLAG_TEST_ENABLED)."},
+			{Name: "MAV_MODE_TEST_ARMED", Value: 194, Description: "UNDEFINED mode. This solely depends on the autopilot - use with caution, intended for developers only (MAV_MODE_FLAG_SAFETY_ARMED, MAV_MODE_FLAG_MANUAL_INPUT_ENABLED, MAV_MODE_FLAG_TEST_ENABLED)"},
+		},
+	},
+	"MAV_MODE_FLAG": {
+		Name:        "MAV_MODE_FLAG",
+		Description: "These flags encode the MAV mode, see MAV_MODE enum for useful combinations.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MODE_FLAG_SAFETY_ARMED", Value: 128, Description: "0b10000000 MAV safety set to armed. Motors are enabled / running / can start. Ready to fly. Additional note: this flag is to be ignored when sent in the command MAV_CMD_DO_SET_MODE and MAV_CMD_COMPONENT_ARM_DISARM shall be used instead. The flag can still be used to report the armed state."},
+			{Name: "MAV_MODE_FLAG_MANUAL_INPUT_ENABLED", Value: 64, Description: "0b01000000 remote control input is enabled."},
+			{Name: "MAV_MODE_FLAG_HIL_ENABLED", Value: 32, Description: "0b00100000 hardware in the loop simulation. All motors / actuators are blocked, but internal software is full operational."},
+			{Name: "MAV_MODE_FLAG_STABILIZE_ENABLED", Value: 16, Description: "0b00010000 system stabilizes electronically its attitude (and optionally position). It needs however further control inputs to move around."},
+			{Name: "MAV_MODE_FLAG_GUIDED_ENABLED", Value: 8, Description: "0b00001000 guided mode enabled, system flies waypoints / mission items."},
+			{Name: "MAV_MODE_FLAG_AUTO_ENABLED", Value: 4, Description: "0b00000100 autonomous mode enabled, system finds its own goal positions. Guided flag can be set or not, depends on the actual implementation."},
+			{Name: "MAV_MODE_FLAG_TEST_ENABLED", Value: 2, Description: "0b00000010 system has a test mode enabled. This flag is intended for temporary system tests and should not be used for stable implementations."},
+			{Name: "MAV_MODE_FLAG_CUSTOM_MODE_ENABLED", Value: 1, Description: "0b00000001 system-specific custom mode is enabled. When using this flag to enable a custom mode all other flags should be ignored."},
+		},
+	},
+	"MAV_MODE_FLAG_DECODE_POSITION": {
+		Name:        "MAV_MODE_FLAG_DECODE_POSITION",
+		Description: "These values encode the bit positions of the decode position. These values can be used to read the value of a flag bit by combining the base_mode variable with AND with the flag position value. The result will be either 0 or 1, depending on if the flag is set or not.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_SAFETY", Value: 128, Description: "First bit:  10000000"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_MANUAL", Value: 64, Description: "Second bit: 01000000"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_HIL", Value: 32, Description: "Third bit:  00100000"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_STABILIZE", Value: 16, Description: "Fourth bit: 00010000"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_GUIDED", Value: 8, Description: "Fifth bit:  00001000"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_AUTO", Value: 4, Description: "Sixth bit:   00000100"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_TEST", Value: 2, Description: "Seventh bit: 00000010"},
+			{Name: "MAV_MODE_FLAG_DECODE_POSITION_CUSTOM_MODE", Value: 1, Description: "Eighth bit: 00000001"},
+		},
+	},
+	"MAV_MODE_PROPERTY": {
+		Name:        "MAV_MODE_PROPERTY",
+		Description: "Mode properties.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MODE_PROPERTY_ADVANCED", Value: 1, Description: "If set, this mode is an advanced mode. For example a rate-controlled manual mode might be advanced, whereas a position-controlled manual mode is not. A GCS can optionally use this flag to configure the UI for its intended users."},
+			{Name: "MAV_MODE_PROPERTY_NOT_USER_SELECTABLE", Value: 2, Description: "If set, this mode should not be added to the list of selectable modes. The mode might still be selected by the FC directly (for example as part of a failsafe)."},
+			{Name: "MAV_MODE_PROPERTY_AUTO_MODE", Value: 4, Description: "If set, this mode is automatically controlled (it may use but does not require a manual controller). If unset the mode is a assumed to require user input (be a manual mode)."},
+		},
+	},
+	"MAV_MOUNT_MODE": {
+		Name:        "MAV_MOUNT_MODE",
+		Description: "Enumeration of possible mount operation modes. This message is used by obsolete/deprecated gimbal messages.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_MOUNT_MODE_RETRACT", Value: 0, Description: "Load and keep safe position (Roll,Pitch,Yaw) from permanent memory and stop stabilization"},
+			{Name: "MAV_MOUNT_MODE_NEUTRAL", Value: 1, Description: "Load and keep neutral position (Roll,Pitch,Yaw) from permanent memory."},
+			{Name: "MAV_MOUNT_MODE_MAVLINK_TARGETING", Value: 2, Description: "Load neutral position and start MAVLink Roll,Pitch,Yaw control with stabilization"},
+			{Name: "MAV_MOUNT_MODE_RC_TARGETING", Value: 3, Description: "Load neutral position and start RC Roll,Pitch,Yaw control with stabilization"},
+			{Name: "MAV_MOUNT_MODE_GPS_POINT", Value: 4, Description: "Load neutral position and start to point to Lat,Lon,Alt"},
+			{Name: "MAV_MOUNT_MODE_SYSID_TARGET", Value: 5, Description: "Gimbal tracks system with specified system ID"},
+			{Name: "MAV_MOUNT_MODE_HOME_LOCATION", Value: 6, Description: "Gimbal tracks home position"},
+			{Name: "MAV_MOUNT_MODE_WPNEXT_OFFSET", Value: 7, Description: "Gimbal tracks next waypoint location with offset"},
+		},
+	},
+	"MAV_ODID_ARM_STATUS": {
+		Name:        "MAV_ODID_ARM_STATUS",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_ARM_STATUS_GOOD_TO_ARM", Value: 0, Description: "Passing arming checks."},
+			{Name: "MAV_ODID_ARM_STATUS_PRE_ARM_FAIL_GENERIC", Value: 1, Description: "Generic arming failure, see error string for details."},
+		},
+	},
+	"MAV_ODID_AUTH_TYPE": {
+		Name:        "MAV_ODID_AUTH_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_AUTH_TYPE_NONE", Value: 0, Description: "No authentication type is specified."},
+			{Name: "MAV_ODID_AUTH_TYPE_UAS_ID_SIGNATURE", Value: 1, Description: "Signature for the UAS (Unmanned Aircraft System) ID."},
+			{Name: "MAV_ODID_AUTH_TYPE_OPERATOR_ID_SIGNATURE", Value: 2, Description: "Signature for the Operator ID."},
+			{Name: "MAV_ODID_AUTH_TYPE_MESSAGE_SET_SIGNATURE", Value: 3, Description: "Signature for the entire message set."},
+			{Name: "MAV_ODID_AUTH_TYPE_NETWORK_REMOTE_ID", Value: 4, Description: "Authentication is provided by Network Remote ID."},
+			{Name: "MAV_ODID_AUTH_TYPE_SPECIFIC_AUTHENTICATION", Value: 5, Description: "The exact authentication type is indicated by the first byte of authentication_data and these type values are managed by ICAO."},
+		},
+	},
+	"MAV_ODID_CATEGORY_EU": {
+		Name:        "MAV_ODID_CATEGORY_EU",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_CATEGORY_EU_UNDECLARED", Value: 0, Description: "The category for the UA, according to the EU specification, is undeclared."},
+			{Name: "MAV_ODID_CATEGORY_EU_OPEN", Value: 1, Description: "The category for the UA, according to the EU specification, is the Open category."},
+			{Name: "MAV_ODID_CATEGORY_EU_SPECIFIC", Value: 2, Description: "The category for the UA, according to the EU specification, is the Specific category."},
+			{Name: "MAV_ODID_CATEGORY_EU_CERTIFIED", Value: 3, Description: "The category for the UA, according to the EU specification, is the Certified category."},
+		},
+	},
+	"MAV_ODID_CLASSIFICATION_TYPE": {
+		Name:        "MAV_ODID_CLASSIFICATION_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_CLASSIFICATION_TYPE_UNDECLARED", Value: 0, Description: "The classification type for the UA is undeclared."},
+			{Name: "MAV_ODID_CLASSIFICATION_TYPE_EU", Value: 1, Description: "The classification type for the UA follows EU (European Union) specifications."},
+		},
+	},
+	"MAV_ODID_CLASS_EU": {
+		Name:        "MAV_ODID_CLASS_EU",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_CLASS_EU_UNDECLARED", Value: 0, Description: "The class for the UA, according to the EU specification, is undeclared."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_0", Value: 1, Description: "The class for the UA, according to the EU specification, is Class 0."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_1", Value: 2, Description: "The class for the UA, according to the EU specification, is Class 1."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_2", Value: 3, Description: "The class for the UA, according to the EU specification, is Class 2."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_3", Value: 4, Description: "The class for the UA, according to the EU specification, is Class 3."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_4", Value: 5, Description: "The class for the UA, according to the EU specification, is Class 4."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_5", Value: 6, Description: "The class for the UA, according to the EU specification, is Class 5."},
+			{Name: "MAV_ODID_CLASS_EU_CLASS_6", Value: 7, Description: "The class for the UA, according to the EU specification, is Class 6."},
+		},
+	},
+	"MAV_ODID_DESC_TYPE": {
+		Name:        "MAV_ODID_DESC_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_DESC_TYPE_TEXT", Value: 0, Description: "Optional free-form text description of the purpose of the flight."},
+			{Name: "MAV_ODID_DESC_TYPE_EMERGENCY", Value: 1, Description: "Optional additional clarification when status == MAV_ODID_STATUS_EMERGENCY."},
+			{Name: "MAV_ODID_DESC_TYPE_EXTENDED_STATUS", Value: 2, Description: "Optional additional clarification when status != MAV_ODID_STATUS_EMERGENCY."},
+		},
+	},
+	"MAV_ODID_HEIGHT_REF": {
+		Name:        "MAV_ODID_HEIGHT_REF",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_HEIGHT_REF_OVER_TAKEOFF", Value: 0, Description: "The height field is relative to the take-off location."},
+			{Name: "MAV_ODID_HEIGHT_REF_OVER_GROUND", Value: 1, Description: "The height field is relative to ground."},
+		},
+	},
+	"MAV_ODID_HOR_ACC": {
+		Name:        "MAV_ODID_HOR_ACC",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_HOR_ACC_UNKNOWN", Value: 0, Description: "The horizontal accuracy is unknown."},
+			{Name: "MAV_ODID_HOR_ACC_10NM", Value: 1, Description: "The horizontal accuracy is smaller than 10 Nautical Miles. 18.52 km."},
+			{Name: "MAV_ODID_HOR_ACC_4NM", Value: 2, Description: "The horizontal accuracy is smaller than 4 Nautical Miles. 7.408 km."},
+			{Name: "MAV_ODID_HOR_ACC_2NM", Value: 3, Description: "The horizontal accuracy is smaller than 2 Nautical Miles. 3.704 km."},
+			{Name: "MAV_ODID_HOR_ACC_1NM", Value: 4, Description: "The horizontal accuracy is smaller than 1 Nautical Miles. 1.852 km."},
+			{Name: "MAV_ODID_HOR_ACC_0_5NM", Value: 5, Description: "The horizontal accuracy is smaller than 0.5 Nautical Miles. 926 m."},
+			{Name: "MAV_ODID_HOR_ACC_0_3NM", Value: 6, Description: "The horizontal accuracy is smaller than 0.3 Nautical Miles. 555.6 m."},
+			{Name: "MAV_ODID_HOR_ACC_0_1NM", Value: 7, Description: "The horizontal accuracy is smaller than 0.1 Nautical Miles. 185.2 m."},
+			{Name: "MAV_ODID_HOR_ACC_0_05NM", Value: 8, Description: "The horizontal accuracy is smaller than 0.05 Nautical Miles. 92.6 m."},
+			{Name: "MAV_ODID_HOR_ACC_30_METER", Value: 9, Description: "The horizontal accuracy is smaller than 30 meter."},
+			{Name: "MAV_ODID_HOR_ACC_10_METER", Value: 10, Description: "The horizontal accuracy is smaller than 10 meter."},
+			{Name: "MAV_ODID_HOR_ACC_3_METER", Value: 11, Description: "The horizontal accuracy is smaller than 3 meter."},
+			{Name: "MAV_ODID_HOR_ACC_1_METER", Value: 12, Description: "The horizontal accuracy is smaller than 1 meter."},
+		},
+	},
+	"MAV_ODID_ID_TYPE": {
+		Name:        "MAV_ODID_ID_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_ID_TYPE_NONE", Value: 0, Description: "No type defined."},
+			{Name: "MAV_ODID_ID_TYPE_SERIAL_NUMBER", Value: 1, Description: "Manufacturer Serial Number (ANSI/CTA-2063 format)."},
+			{Name: "MAV_ODID_ID_TYPE_CAA_REGISTRATION_ID", Value: 2, Description: "CAA (Civil Aviation Authority) registered ID. Format: [ICAO Country Code].[CAA Assigned ID]."},
+			{Name: "MAV_ODID_ID_TYPE_UTM_ASSIGNED_UUID", Value: 3, Description: "UTM (Unmanned Traffic Management) assigned UUID (RFC4122)."},
+			{Name: "MAV_ODID_ID_TYPE_SPECIFIC_SESSION_ID", Value: 4, Description: "A 20 byte ID for a specific flight/session. The exact ID type is indicated by the first byte of uas_id and these type values are managed by ICAO."},
+		},
+	},
+	"MAV_ODID_OPERATOR_ID_TYPE": {
+		Name:        "MAV_ODID_OPERATOR_ID_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_OPERATOR_ID_TYPE_CAA", Value: 0, Description: "CAA (Civil Aviation Authority) registered operator ID."},
+		},
+	},
+	"MAV_ODID_OPERATOR_LOCATION_TYPE": {
+		Name:        "MAV_ODID_OPERATOR_LOCATION_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_OPERATOR_LOCATION_TYPE_TAKEOFF", Value: 0, Description: "The location/altitude of the operator is the same as the take-off location."},
+			{Name: "MAV_ODID_OPERATOR_LOCATION_TYPE_LIVE_GNSS", Value: 1, Description: "The location/altitude of the operator is dynamic. E.g. based on live GNSS data."},
+			{Name: "MAV_ODID_OPERATOR_LOCATION_TYPE_FIXED", Value: 2, Description: "The location/altitude of the operator are fixed values."},
+		},
+	},
+	"MAV_ODID_SPEED_ACC": {
+		Name:        "MAV_ODID_SPEED_ACC",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_SPEED_ACC_UNKNOWN", Value: 0, Description: "The speed accuracy is unknown."},
+			{Name: "MAV_ODID_SPEED_ACC_10_METERS_PER_SECOND", Value: 1, Description: "The speed accuracy is smaller than 10 meters per second."},
+			{Name: "MAV_ODID_SPEED_ACC_3_METERS_PER_SECOND", Value: 2, Description: "The speed accuracy is smaller than 3 meters per second."},
+			{Name: "MAV_ODID_SPEED_ACC_1_METERS_PER_SECOND", Value: 3, Description: "The speed accuracy is smaller than 1 meters per second."},
+			{Name: "MAV_ODID_SPEED_ACC_0_3_METERS_PER_SECOND", Value: 4, Description: "The speed accuracy is smaller than 0.3 meters per second."},
+		},
+	},
+	"MAV_ODID_STATUS": {
+		Name:        "MAV_ODID_STATUS",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_STATUS_UNDECLARED", Value: 0, Description: "The status of the (UA) Unmanned Aircraft is undefined."},
+			{Name: "MAV_ODID_STATUS_GROUND", Value: 1, Description: "The UA is on the ground."},
+			{Name: "MAV_ODID_STATUS_AIRBORNE", Value: 2, Description: "The UA is in the air."},
+			{Name: "MAV_ODID_STATUS_EMERGENCY", Value: 3, Description: "The UA is having an emergency."},
+			{Name: "MAV_ODID_STATUS_REMOTE_ID_SYSTEM_FAILURE", Value: 4, Description: "The remote ID system is failing or unreliable in some way."},
+		},
+	},
+	"MAV_ODID_TIME_ACC": {
+		Name:        "MAV_ODID_TIME_ACC",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_TIME_ACC_UNKNOWN", Value: 0, Description: "The timestamp accuracy is unknown."},
+			{Name: "MAV_ODID_TIME_ACC_0_1_SECOND", Value: 1, Description: "The timestamp accuracy is smaller than or equal to 0.1 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_2_SECOND", Value: 2, Description: "The timestamp accuracy is smaller than or equal to 0.2 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_3_SECOND", Value: 3, Description: "The timestamp accuracy is smaller than or equal to 0.3 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_4_SECOND", Value: 4, Description: "The timestamp accuracy is smaller than or equal to 0.4 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_5_SECOND", Value: 5, Description: "The timestamp accuracy is smaller than or equal to 0.5 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_6_SECOND", Value: 6, Description: "The timestamp accuracy is smaller than or equal to 0.6 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_7_SECOND", Value: 7, Description: "The timestamp accuracy is smaller than or equal to 0.7 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_8_SECOND", Value: 8, Description: "The timestamp accuracy is smaller than or equal to 0.8 second."},
+			{Name: "MAV_ODID_TIME_ACC_0_9_SECOND", Value: 9, Description: "The timestamp accuracy is smaller than or equal to 0.9 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_0_SECOND", Value: 10, Description: "The timestamp accuracy is smaller than or equal to 1.0 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_1_SECOND", Value: 11, Description: "The timestamp accuracy is smaller than or equal to 1.1 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_2_SECOND", Value: 12, Description: "The timestamp accuracy is smaller than or equal to 1.2 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_3_SECOND", Value: 13, Description: "The timestamp accuracy is smaller than or equal to 1.3 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_4_SECOND", Value: 14, Description: "The timestamp accuracy is smaller than or equal to 1.4 second."},
+			{Name: "MAV_ODID_TIME_ACC_1_5_SECOND", Value: 15, Description: "The timestamp accuracy is smaller than or equal to 1.5 second."},
+		},
+	},
+	"MAV_ODID_UA_TYPE": {
+		Name:        "MAV_ODID_UA_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_UA_TYPE_NONE", Value: 0, Description: "No UA (Unmanned Aircraft) type defined."},
+			{Name: "MAV_ODID_UA_TYPE_AEROPLANE", Value: 1, Description: "Aeroplane/Airplane. Fixed wing."},
+			{Name: "MAV_ODID_UA_TYPE_HELICOPTER_OR_MULTIROTOR", Value: 2, Description: "Helicopter or multirotor."},
+			{Name: "MAV_ODID_UA_TYPE_GYROPLANE", Value: 3, Description: "Gyroplane."},
+			{Name: "MAV_ODID_UA_TYPE_HYBRID_LIFT", Value: 4, Description: "VTOL (Vertical Take-Off and Landing). Fixed wing aircraft that can take off vertically."},
+			{Name: "MAV_ODID_UA_TYPE_ORNITHOPTER", Value: 5, Description: "Ornithopter."},
+			{Name: "MAV_ODID_UA_TYPE_GLIDER", Value: 6, Description: "Glider."},
+			{Name: "MAV_ODID_UA_TYPE_KITE", Value: 7, Description: "Kite."},
+			{Name: "MAV_ODID_UA_TYPE_FREE_BALLOON", Value: 8, Description: "Free Balloon."},
+			{Name: "MAV_ODID_UA_TYPE_CAPTIVE_BALLOON", Value: 9, Description: "Captive Balloon."},
+			{Name: "MAV_ODID_UA_TYPE_AIRSHIP", Value: 10, Description: "Airship. E.g. a blimp."},
+			{Name: "MAV_ODID_UA_TYPE_FREE_FALL_PARACHUTE", Value: 11, Description: "Free Fall/Parachute (unpowered)."},
+			{Name: "MAV_ODID_UA_TYPE_ROCKET", Value: 12, Description: "Rocket."},
+			{Name: "MAV_ODID_UA_TYPE_TETHERED_POWERED_AIRCRAFT", Value: 13, Description: "Tethered powered aircraft."},
+			{Name: "MAV_ODID_UA_TYPE_GROUND_OBSTACLE", Value: 14, Description: "Ground Obstacle."},
+			{Name: "MAV_ODID_UA_TYPE_OTHER", Value: 15, Description: "Other type of aircraft not listed earlier."},
+		},
+	},
+	"MAV_ODID_VER_ACC": {
+		Name:        "MAV_ODID_VER_ACC",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ODID_VER_ACC_UNKNOWN", Value: 0, Description: "The vertical accuracy is unknown."},
+			{Name: "MAV_ODID_VER_ACC_150_METER", Value: 1, Description: "The vertical accuracy is smaller than 150 meter."},
+			{Name: "MAV_ODID_VER_ACC_45_METER", Value: 2, Description: "The vertical accuracy is smaller than 45 meter."},
+			{Name: "MAV_ODID_VER_ACC_25_METER", Value: 3, Description: "The vertical accuracy is smaller than 25 meter."},
+			{Name: "MAV_ODID_VER_ACC_10_METER", Value: 4, Description: "The vertical accuracy is smaller than 10 meter."},
+			{Name: "MAV_ODID_VER_ACC_3_METER", Value: 5, Description: "The vertical accuracy is smaller than 3 meter."},
+			{Name: "MAV_ODID_VER_ACC_1_METER", Value: 6, Description: "The vertical accuracy is smaller than 1 meter."},
+		},
+	},
+	"MAV_PARAM_ERROR": {
+		Name:        "MAV_PARAM_ERROR",
+		Description: "Parameter protocol error types (see PARAM_ERROR).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_PARAM_ERROR_NO_ERROR", Value: 0, Description: "No error occurred (not expected in PARAM_ERROR but may be used in future implementations."},
+			{Name: "MAV_PARAM_ERROR_DOES_NOT_EXIST", Value: 1, Description: "Parameter does not exist"},
+			{Name: "MAV_PARAM_ERROR_VALUE_OUT_OF_RANGE", Value: 2, Description: "Parameter value does not fit within accepted range"},
+			{Name: "MAV_PARAM_ERROR_PERMISSION_DENIED", Value: 3, Description: "Caller is not permitted to set the value of this parameter"},
+			{Name: "MAV_PARAM_ERROR_COMPONENT_NOT_FOUND", Value: 4, Description: "Unknown component specified"},
+			{Name: "MAV_PARAM_ERROR_READ_ONLY", Value: 5, Description: "Parameter is read-only"},
+			{Name: "MAV_PARAM_ERROR_TYPE_UNSUPPORTED", Value: 6, Description: "Parameter data type (MAV_PARAM_TYPE) is not supported by flight stack (at all)"},
+			{Name: "MAV_PARAM_ERROR_TYPE_MISMATCH", Value: 7, Description: "Parameter type does not match expected type"},
+			{Name: "MAV_PARAM_ERROR_READ_FAIL", Value: 8, Description: "Parameter exists but reading failed"},
+		},
+	},
+	"MAV_PARAM_EXT_TYPE": {
+		Name:        "MAV_PARAM_EXT_TYPE",
+		Description: "Specifies the datatype of a MAVLink extended parameter.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_PARAM_EXT_TYPE_UINT8", Value: 1, Description: "8-bit unsigned integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_INT8", Value: 2, Description: "8-bit signed integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_UINT16", Value: 3, Description: "16-bit unsigned integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_INT16", Value: 4, Description: "16-bit signed integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_UINT32", Value: 5, Description: "32-bit unsigned integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_INT32", Value: 6, Description: "32-bit signed integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_UINT64", Value: 7, Description: "64-bit unsigned integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_INT64", Value: 8, Description: "64-bit signed integer"},
+			{Name: "MAV_PARAM_EXT_TYPE_REAL32", Value: 9, Description: "32-bit floating-point"},
+			{Name: "MAV_PARAM_EXT_TYPE_REAL64", Value: 10, Description: "64-bit floating-point"},
+			{Name: "MAV_PARAM_EXT_TYPE_CUSTOM", Value: 11, Description: "Custom Type"},
+		},
+	},
+	"MAV_PARAM_TYPE": {
+		Name:        "MAV_PARAM_TYPE",
+		Description: "Specifies the datatype of a MAVLink parameter.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_PARAM_TYPE_UINT8", Value: 1, Description: "8-bit unsigned integer"},
+			{Name: "MAV_PARAM_TYPE_INT8", Value: 2, Description: "8-bit signed integer"},
+			{Name: "MAV_PARAM_TYPE_UINT16", Value: 3, Description: "16-bit unsigned integer"},
+			{Name: "MAV_PARAM_TYPE_INT16", Value: 4, Description: "16-bit signed integer"},
+			{Name: "MAV_PARAM_TYPE_UINT32", Value: 5, Description: "32-bit unsigned integer"},
+			{Name: "MAV_PARAM_TYPE_INT32", Value: 6, Description: "32-bit signed integer"},
+			{Name: "MAV_PARAM_TYPE_UINT64", Value: 7, Description: "64-bit unsigned integer"},
+			{Name: "MAV_PARAM_TYPE_INT64", Value: 8, Description: "64-bit signed integer"},
+			{Name: "MAV_PARAM_TYPE_REAL32", Value: 9, Description: "32-bit floating-point"},
+			{Name: "MAV_PARAM_TYPE_REAL64", Value: 10, Description: "64-bit floating-point"},
+		},
+	},
+	"MAV_POWER_STATUS": {
+		Name:        "MAV_POWER_STATUS",
+		Description: "Power supply status flags (bitmask)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_POWER_STATUS_BRICK_VALID", Value: 1, Description: "main brick power supply valid"},
+			{Name: "MAV_POWER_STATUS_SERVO_VALID", Value: 2, Description: "main servo power supply valid for FMU"},
+			{Name: "MAV_POWER_STATUS_USB_CONNECTED", Value: 4, Description: "USB power is connected"},
+			{Name: "MAV_POWER_STATUS_PERIPH_OVERCURRENT", Value: 8, Description: "peripheral supply is in over-current state"},
+			{Name: "MAV_POWER_STATUS_PERIPH_HIPOWER_OVERCURRENT", Value: 16, Description: "hi-power peripheral supply is in over-current state"},
+			{Name: "MAV_POWER_STATUS_CHANGED", Value: 32, Description: "Power status has changed since boot"},
+		},
+	},
+	"MAV_PROTOCOL_CAPABILITY": {
+		Name:        "MAV_PROTOCOL_CAPABILITY",
+		Description: "Bitmask of (optional) autopilot capabilities (64 bit). If a bit is set, the autopilot supports this capability.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT", Value: 1, Description: "Autopilot supports the MISSION_ITEM float message type. Note that MISSION_ITEM is deprecated, and autopilots should use MISSION_ITEM_INT instead."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT", Value: 2, Description: "Autopilot supports the new param float message type."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_MISSION_INT", Value: 4, Description: "Autopilot supports MISSION_ITEM_INT scaled integer message type. Note that this flag must always be set if missions are supported, because missions must always use MISSION_ITEM_INT (rather than MISSION_ITEM, which is deprecated)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_COMMAND_INT", Value: 8, Description: "Autopilot supports COMMAND_INT scaled integer message type."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_PARAM_ENCODE_BYTEWISE", Value: 16, Description: "Parameter protocol uses byte-wise encoding of parameter values into param_value (float) fields: https://mavlink.io/en/services/parameter.html#parameter-encoding. Note that either this flag or MAV_PROTOCOL_CAPABILITY_PARAM_ENCODE_C_CAST should be set if the parameter protocol is supported."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_FTP", Value: 32, Description: "Autopilot supports the File Transfer Protocol v1: https://mavlink.io/en/services/ftp.html."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET", Value: 64, Description: "Autopilot supports the SET_ATTITUDE_TARGET message (for commanding attitude from an offboard controller)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED", Value: 128, Description: "Autopilot supports the SET_POSITION_TARGET_LOCAL_NED message (for commanding position and velocity targets in local NED frame)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT", Value: 256, Description: "Autopilot supports the SET_POSITION_TARGET_GLOBAL_INT message (for commanding position and velocity targets in global scaled integers)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_TERRAIN", Value: 512, Description: "Autopilot supports terrain protocol / data handling."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_RESERVED3", Value: 1024, Description: "Reserved for future use."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION", Value: 2048, Description: "Autopilot supports the MAV_CMD_DO_FLIGHTTERMINATION command (flight termination)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION", Value: 4096, Description: "Autopilot supports onboard compass calibration."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_MAVLINK2", Value: 8192, Description: "Autopilot supports MAVLink version 2."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_MISSION_FENCE", Value: 16384, Description: "Autopilot supports mission fence protocol."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_MISSION_RALLY", Value: 32768, Description: "Autopilot supports mission rally point protocol."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_RESERVED2", Value: 65536, Description: "Reserved for future use."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_PARAM_ENCODE_C_CAST", Value: 131072, Description: "Parameter protocol uses C-cast of parameter values to set the param_value (float) fields: https://mavlink.io/en/services/parameter.html#parameter-encoding. Note that either this flag or MAV_PROTOCOL_CAPABILITY_PARAM_ENCODE_BYTEWISE should be set if the parameter protocol is supported."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_COMPONENT_IMPLEMENTS_GIMBAL_MANAGER", Value: 262144, Description: "This component implements/is a gimbal manager. This means the GIMBAL_MANAGER_INFORMATION, and other messages can be requested."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_COMPONENT_ACCEPTS_GCS_CONTROL", Value: 524288, Description: "Component supports locking control to a particular GCS independent of its system (via MAV_CMD_REQUEST_OPERATOR_CONTROL)."},
+			{Name: "MAV_PROTOCOL_CAPABILITY_GRIPPER", Value: 1048576, Description: "Autopilot has a connected gripper. MAVLink Grippers would set MAV_TYPE_GRIPPER instead."},
+		},
+	},
+	"MAV_RESULT": {
+		Name:        "MAV_RESULT",
+		Description: "Result from a MAVLink command (MAV_CMD)",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_RESULT_ACCEPTED", Value: 0, Description: "Command is valid (is supported and has valid parameters), and was executed."},
+			{Name: "MAV_RESULT_TEMPORARILY_REJECTED", Value: 1, Description: "Command is valid, but cannot be executed at this time. This is used to indicate a problem that should be fixed just by waiting (e.g. a state machine is busy, can't arm because have not got GPS lock, etc.). Retrying later should work."},
+			{Name: "MAV_RESULT_DENIED", Value: 2, Description: "Command is invalid; it is supported but one or more parameter values are invalid (i.e. parameter reserved, value allowed by spec but not supported by flight stack, and so on). Retrying the same command and parameters will not work."},
+			{Name: "MAV_RESULT_UNSUPPORTED", Value: 3, Description: "Command is not supported (unknown)."},
+			{Name: "MAV_RESULT_FAILED", Value: 4, Description: "Command is valid, but execution has failed. This is used to indicate any non-temporary or unexpected problem, i.e. any problem that must be fixed before the command can succeed/be retried. For example, attempting to write a file when out of memory, attempting to arm when sensors are not calibrated, etc."},
+			{Name: "MAV_RESULT_IN_PROGRESS", Value: 5, Description: "Command is valid and is being executed. This will be followed by further progress updates, i.e. the component may send further COMMAND_ACK messages with result MAV_RESULT_IN_PROGRESS (at a rate decided by the implementation), and must terminate by sending a COMMAND_ACK message with final result of the operation. The COMMAND_ACK.progress field can be used to indicate the progress of the operation."},
+			{Name: "MAV_RESULT_CANCELLED", Value: 6, Description: "Command has been cancelled (as a result of receiving a COMMAND_CANCEL message)."},
+			{Name: "MAV_RESULT_COMMAND_LONG_ONLY", Value: 7, Description: "Command is only accepted when sent as a COMMAND_LONG."},
+			{Name: "MAV_RESULT_COMMAND_INT_ONLY", Value: 8, Description: "Command is only accepted when sent as a COMMAND_INT."},
+			{Name: "MAV_RESULT_COMMAND_UNSUPPORTED_MAV_FRAME", Value: 9, Description: "Command is invalid because a frame is required and the specified frame is not supported."},
+			{Name: "MAV_RESULT_NOT_IN_CONTROL", Value: 10, Description: "Command has been rejected because source system is not in control of the target system/component."},
+		},
+	},
+	"MAV_ROI": {
+		Name:        "MAV_ROI",
+		Description: "The ROI (region of interest) for the vehicle. This can be be used by the vehicle for camera/vehicle attitude alignment (see MAV_CMD_NAV_ROI).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_ROI_NONE", Value: 0, Description: "No region of interest."},
+			{Name: "MAV_ROI_WPNEXT", Value: 1, Description: "Point toward next waypoint, with optional pitch/roll/yaw offset."},
+			{Name: "MAV_ROI_WPINDEX", Value: 2, Description: "Point toward given waypoint."},
+			{Name: "MAV_ROI_LOCATION", Value: 3, Description: "Point toward fixed location."},
+			{Name: "MAV_ROI_TARGET", Value: 4, Description: "Point toward of given id."},
+		},
+	},
+	"MAV_SENSOR_ORIENTATION": {
+		Name:        "MAV_SENSOR_ORIENTATION",
+		Description: "Enumeration of sensor orientation, according to its rotations",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_SENSOR_ROTATION_NONE", Value: 0, Description: "Roll: 0, Pitch: 0, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_45", Value: 1, Description: "Roll: 0, Pitch: 0, Yaw: 45"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_90", Value: 2, Description: "Roll: 0, Pitch: 0, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_135", Value: 3, Description: "Roll: 0, Pitch: 0, Yaw: 135"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_180", Value: 4, Description: "Roll: 0, Pitch: 0, Yaw: 180"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_225", Value: 5, Description: "Roll: 0, Pitch: 0, Yaw: 225"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_270", Value: 6, Description: "Roll: 0, Pitch: 0, Yaw: 270"},
+			{Name: "MAV_SENSOR_ROTATION_YAW_315", Value: 7, Description: "Roll: 0, Pitch: 0, Yaw: 315"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180", Value: 8, Description: "Roll: 180, Pitch: 0, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_45", Value: 9, Description: "Roll: 180, Pitch: 0, Yaw: 45"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_90", Value: 10, Description: "Roll: 180, Pitch: 0, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_135", Value: 11, Description: "Roll: 180, Pitch: 0, Yaw: 135"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_180", Value: 12, Description: "Roll: 0, Pitch: 180, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_225", Value: 13, Description: "Roll: 180, Pitch: 0, Yaw: 225"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_270", Value: 14, Description: "Roll: 180, Pitch: 0, Yaw: 270"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_YAW_315", Value: 15, Description: "Roll: 180, Pitch: 0, Yaw: 315"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90", Value: 16, Description: "Roll: 90, Pitch: 0, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_YAW_45", Value: 17, Description: "Roll: 90, Pitch: 0, Yaw: 45"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_YAW_90", Value: 18, Description: "Roll: 90, Pitch: 0, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_YAW_135", Value: 19, Description: "Roll: 90, Pitch: 0, Yaw: 135"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270", Value: 20, Description: "Roll: 270, Pitch: 0, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_YAW_45", Value: 21, Description: "Roll: 270, Pitch: 0, Yaw: 45"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_YAW_90", Value: 22, Description: "Roll: 270, Pitch: 0, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_YAW_135", Value: 23, Description: "Roll: 270, Pitch: 0, Yaw: 135"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_90", Value: 24, Description: "Roll: 0, Pitch: 90, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_270", Value: 25, Description: "Roll: 0, Pitch: 270, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_180_YAW_90", Value: 26, Description: "Roll: 0, Pitch: 180, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_180_YAW_270", Value: 27, Description: "Roll: 0, Pitch: 180, Yaw: 270"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_90", Value: 28, Description: "Roll: 90, Pitch: 90, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_PITCH_90", Value: 29, Description: "Roll: 180, Pitch: 90, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_PITCH_90", Value: 30, Description: "Roll: 270, Pitch: 90, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_180", Value: 31, Description: "Roll: 90, Pitch: 180, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_PITCH_180", Value: 32, Description: "Roll: 270, Pitch: 180, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_270", Value: 33, Description: "Roll: 90, Pitch: 270, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_180_PITCH_270", Value: 34, Description: "Roll: 180, Pitch: 270, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_270_PITCH_270", Value: 35, Description: "Roll: 270, Pitch: 270, Yaw: 0"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_180_YAW_90", Value: 36, Description: "Roll: 90, Pitch: 180, Yaw: 90"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_YAW_270", Value: 37, Description: "Roll: 90, Pitch: 0, Yaw: 270"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_68_YAW_293", Value: 38, Description: "Roll: 90, Pitch: 68, Yaw: 293"},
+			{Name: "MAV_SENSOR_ROTATION_PITCH_315", Value: 39, Description: "Pitch: 315"},
+			{Name: "MAV_SENSOR_ROTATION_ROLL_90_PITCH_315", Value: 40, Description: "Roll: 90, Pitch: 315"},
+			{Name: "MAV_SENSOR_ROTATION_CUSTOM", Value: 100, Description: "Custom orientation"},
+		},
+	},
+	"MAV_SEVERITY": {
+		Name:        "MAV_SEVERITY",
+		Description: "Indicates the severity level, generally used for status messages to indicate their relative urgency. Based on RFC-5424 using expanded definitions at: http://www.kiwisyslog.com/kb/info:-syslog-message-levels/.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_SEVERITY_EMERGENCY", Value: 0, Description: "System is unusable. This is a \"panic\" condition."},
+			{Name: "MAV_SEVERITY_ALERT", Value: 1, Description: "Action should be taken immediately. Indicates error in non-critical systems."},
+			{Name: "MAV_SEVERITY_CRITICAL", Value: 2, Description: "Action must be taken immediately. Indicates failure in a primary system."},
+			{Name: "MAV_SEVERITY_ERROR", Value: 3, Description: "Indicates an error in secondary/redundant systems."},
+			{Name: "MAV_SEVERITY_WARNING", Value: 4, Description: "Indicates about a possible future error if this is not resolved within a given timeframe. Example would be a low battery warning."},
+			{Name: "MAV_SEVERITY_NOTICE", Value: 5, Description: "An unusual event has occurred, though not an error condition. This should be investigated for the root cause."},
+			{Name: "MAV_SEVERITY_INFO", Value: 6, Description: "Normal operational messages. Useful for logging. No action is required for these messages."},
+			{Name: "MAV_SEVERITY_DEBUG", Value: 7, Description: "Useful non-operational messages that can assist in debugging. These should not occur during normal operation."},
+		},
+	},
+	"MAV_STANDARD_MODE": {
+		Name:        "MAV_STANDARD_MODE",
+		Description: "Standard modes with a well understood meaning across flight stacks and vehicle types. For example, most flight stack have the concept of a \"return\" or \"RTL\" mode that takes a vehicle to safety, even though the precise mechanics of this mode may differ. The modes supported by a flight stack can be queried using AVAILABLE_MODES and set using MAV_CMD_DO_SET_STANDARD_MODE. The current mode is streamed in CURRENT_MODE. See https://mavlink.io/en/services/standard_modes.html",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_STANDARD_MODE_NON_STANDARD", Value: 0, Description: "Non standard mode. This may be used when reporting the mode if the current flight mode is not a standard mode."},
+			{Name: "MAV_STANDARD_MODE_POSITION_HOLD", Value: 1, Description: "Position mode (manual). Position-controlled and stabilized manual mode. When sticks are released vehicles return to their level-flight orientation and hold both position and altitude against wind and external forces. This mode can only be set by vehicles that can hold a fixed position. Multicopter (MC) vehicles actively brake and hold both position and altitude against wind and external forces. Hybrid MC/FW (\"VTOL\") vehicles first transition to multicopter mode (if needed) but otherwise behave in the same way as MC vehicles. Fixed-wing (FW) vehicles must not support this mode. Other vehicle types must not support this mode (this may be revisited through the PR process)."},
+			{Name: "MAV_STANDARD_MODE_ORBIT", Value: 2, Description: "Orbit (manual). Position-controlled and stabilized manual mode. The vehicle circles around a fixed setpoint in the horizontal plane at a particular radius, altitude, and direction. Flight stacks may further allow manual control over the setpoint position, radius, direction, speed, and/or altitude of the circle, but this is not mandated. Flight stacks may support the [MAV_CMD_DO_ORBIT](https://mavlink.io/en/messages/common.html#MAV_CMD_DO_ORBIT) for changing the orbit parameters. MC and FW vehicles may support this mode. Hybrid MC/FW (\"VTOL\") vehicles may support this mode in MC/FW or both modes; if the mode is not supported by the current configuration the vehicle should transition to the supported configuration. Other vehicle types must not support this mode (this may be revisited through the PR process)."},
+			{Name: "MAV_STANDARD_MODE_CRUISE", Value: 3, Description: "Cruise mode (manual). Position-controlled and stabilized manual mode. When sticks are released vehicles return to their level-flight orientation and hold their original track against wind and external forces. Fixed-wing (FW) vehicles level orientation and maintain current track and altitude against wind and external forces. Hybrid MC/FW (\"VTOL\") vehicles first transition to FW mode (if needed) but otherwise behave in the same way as MC vehicles. Multicopter (MC) vehicles must not support this mode. Other vehicle types must not support this mode (this may be revisited through the PR process)."},
+			{Name: "MAV_STANDARD_MODE_ALTITUDE_HOLD", Value: 4, Description: "Altitude hold (manual). Altitude-controlled and stabilized manual mode. When sticks are released vehicles return to their level-flight orientation and hold their altitude. MC vehicles continue with existing momentum and may move with wind (or other external forces). FW vehicles continue with current heading, but may be moved off-track by wind. Hybrid MC/FW (\"VTOL\") vehicles behave according to their current configuration/mode (FW or MC). Other vehicle types must not support this mode (this may be revisited through the PR process)."},
+			{Name: "MAV_STANDARD_MODE_SAFE_RECOVERY", Value: 5, Description: "Safe recovery mode (auto). Automatic mode that takes vehicle to a predefined safe location via a safe flight path, and may also automatically land the vehicle. This mode is more commonly referred to as RTL and/or or Smart RTL. The precise return location, flight path, and landing behaviour depend on vehicle configuration and type. For example, the vehicle might return to the home/launch location, a rally point, or the start of a mission landing, it might follow a direct path, mission path, or breadcrumb path, and land using a mission landing pattern or some other kind of descent."},
+			{Name: "MAV_STANDARD_MODE_MISSION", Value: 6, Description: "Mission mode (automatic). Automatic mode that executes MAVLink missions. Missions are executed from the current waypoint as soon as the mode is enabled."},
+			{Name: "MAV_STANDARD_MODE_LAND", Value: 7, Description: "Land mode (auto). Automatic mode that lands the vehicle at the current location. The precise landing behaviour depends on vehicle configuration and type."},
+			{Name: "MAV_STANDARD_MODE_TAKEOFF", Value: 8, Description: "Takeoff mode (auto). Automatic takeoff mode. The precise takeoff behaviour depends on vehicle configuration and type."},
+		},
+	},
+	"MAV_STATE": {
+		Name:        "MAV_STATE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_STATE_UNINIT", Value: 0, Description: "Uninitialized system, state is unknown."},
+			{Name: "MAV_STATE_BOOT", Value: 1, Description: "System is booting up."},
+			{Name: "MAV_STATE_CALIBRATING", Value: 2, Description: "System is calibrating and not flight-ready."},
+			{Name: "MAV_STATE_STANDBY", Value: 3, Description: "System is grounded and on standby. It can be launched any time."},
+			{Name: "MAV_STATE_ACTIVE", Value: 4, Description: "System is active and might be already airborne. Motors are engaged."},
+			{Name: "MAV_STATE_CRITICAL", Value: 5, Description: "System is in a non-normal flight mode (failsafe). It can however still navigate."},
+			{Name: "MAV_STATE_EMERGENCY", Value: 6, Description: "System is in a non-normal flight mode (failsafe). It lost control over parts or over the whole airframe. It is in mayday and going down."},
+			{Name: "MAV_STATE_POWEROFF", Value: 7, Description: "System just initialized its power-down sequence, will shut down now."},
+			{Name: "MAV_STATE_FLIGHT_TERMINATION", Value: 8, Description: "System is terminating itself (failsafe or commanded)."},
+		},
+	},
+	"MAV_SYS_STATUS_SENSOR": {
+		Name:        "MAV_SYS_STATUS_SENSOR",
+		Description: "These encode the sensors whose status is sent as part of the SYS_STATUS message.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_GYRO", Value: 1, Description: "0x01 3D gyro"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_ACCEL", Value: 2, Description: "0x02 3D accelerometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_MAG", Value: 4, Description: "0x04 3D magnetometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE", Value: 8, Description: "0x08 absolute pressure"},
+			{Name: "MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE", Value: 16, Description: "0x10 differential pressure"},
+			{Name: "MAV_SYS_STATUS_SENSOR_GPS", Value: 32, Description: "0x20 GPS"},
+			{Name: "MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW", Value: 64, Description: "0x40 optical flow"},
+			{Name: "MAV_SYS_STATUS_SENSOR_VISION_POSITION", Value: 128, Description: "0x80 computer vision position"},
+			{Name: "MAV_SYS_STATUS_SENSOR_LASER_POSITION", Value: 256, Description: "0x100 laser based position"},
+			{Name: "MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH", Value: 512, Description: "0x200 external ground truth (Vicon or Leica)"},
+			{Name: "MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL", Value: 1024, Description: "0x400 3D angular rate control"},
+			{Name: "MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION", Value: 2048, Description: "0x800 attitude stabilization"},
+			{Name: "MAV_SYS_STATUS_SENSOR_YAW_POSITION", Value: 4096, Description: "0x1000 yaw position"},
+			{Name: "MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL", Value: 8192, Description: "0x2000 z/altitude control"},
+			{Name: "MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL", Value: 16384, Description: "0x4000 x/y position control"},
+			{Name: "MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS", Value: 32768, Description: "0x8000 motor outputs / control"},
+			{Name: "MAV_SYS_STATUS_SENSOR_RC_RECEIVER", Value: 65536, Description: "0x10000 RC receiver"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_GYRO2", Value: 131072, Description: "0x20000 2nd 3D gyro"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_ACCEL2", Value: 262144, Description: "0x40000 2nd 3D accelerometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_MAG2", Value: 524288, Description: "0x80000 2nd 3D magnetometer"},
+			{Name: "MAV_SYS_STATUS_GEOFENCE", Value: 1048576, Description: "0x100000 geofence"},
+			{Name: "MAV_SYS_STATUS_AHRS", Value: 2097152, Description: "0x200000 AHRS subsystem health"},
+			{Name: "MAV_SYS_STATUS_TERRAIN", Value: 4194304, Description: "0x400000 Terrain subsystem health"},
+			{Name: "MAV_SYS_STATUS_REVERSE_MOTOR", Value: 8388608, Description: "0x800000 Motors are reversed"},
+			{Name: "MAV_SYS_STATUS_LOGGING", Value: 16777216, Description: "0x1000000 Logging"},
+			{Name: "MAV_SYS_STATUS_SENSOR_BATTERY", Value: 33554432, Description: "0x2000000 Battery"},
+			{Name: "MAV_SYS_STATUS_SENSOR_PROXIMITY", Value: 67108864, Description: "0x4000000 Proximity"},
+			{Name: "MAV_SYS_STATUS_SENSOR_SATCOM", Value: 134217728, Description: "0x8000000 Satellite Communication"},
+			{Name: "MAV_SYS_STATUS_PREARM_CHECK", Value: 268435456, Description: "0x10000000 pre-arm check status. Always healthy when armed"},
+			{Name: "MAV_SYS_STATUS_OBSTACLE_AVOIDANCE", Value: 536870912, Description: "0x20000000 Avoidance/collision prevention"},
+			{Name: "MAV_SYS_STATUS_SENSOR_PROPULSION", Value: 1073741824, Description: "0x40000000 propulsion (actuator, esc, motor or propellor)"},
+			{Name: "MAV_SYS_STATUS_EXTENSION_USED", Value: 2147483648, Description: "0x80000000 Extended bit-field are used for further sensor status bits (needs to be set in onboard_control_sensors_present only)"},
+		},
+	},
+	"MAV_SYS_STATUS_SENSOR_EXTENDED": {
+		Name:        "MAV_SYS_STATUS_SENSOR_EXTENDED",
+		Description: "These encode the sensors whose status is sent as part of the SYS_STATUS message in the extended fields.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_SYS_STATUS_RECOVERY_SYSTEM", Value: 1, Description: "0x01 Recovery system (parachute, balloon, retracts etc)"},
+			{Name: "MAV_SYS_STATUS_SENSOR_LEAK", Value: 2, Description: "0x02 Leak detection"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_GYRO3", Value: 4, Description: "0x04 3rd 3D gyro"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_ACCEL3", Value: 8, Description: "0x08 3rd 3D accelerometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_GYRO4", Value: 16, Description: "0x10 4th 3D gyro"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_ACCEL4", Value: 32, Description: "0x20 4th 3D accelerometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_MAG3", Value: 64, Description: "0x40 3rd 3D magnetometer"},
+			{Name: "MAV_SYS_STATUS_SENSOR_3D_MAG4", Value: 128, Description: "0x80 4th 3D magnetometer"},
+		},
+	},
+	"MAV_TUNNEL_PAYLOAD_TYPE": {
+		Name:        "MAV_TUNNEL_PAYLOAD_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_UNKNOWN", Value: 0, Description: "Encoding of payload unknown."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED0", Value: 200, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED1", Value: 201, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED2", Value: 202, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED3", Value: 203, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED4", Value: 204, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED5", Value: 205, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED6", Value: 206, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED7", Value: 207, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED8", Value: 208, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_STORM32_RESERVED9", Value: 209, Description: "Registered for STorM32 gimbal controller."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_REMOTE_OSD", Value: 210, Description: "Registered for ModalAI remote OSD protocol."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_ESC_UART_PASSTHRU", Value: 211, Description: "Registered for ModalAI ESC UART passthru protocol."},
+			{Name: "MAV_TUNNEL_PAYLOAD_TYPE_MODALAI_IO_UART_PASSTHRU", Value: 212, Description: "Registered for ModalAI vendor use."},
+		},
+	},
+	"MAV_TYPE": {
+		Name:        "MAV_TYPE",
+		Description: "MAVLINK component type reported in HEARTBEAT message. Flight controllers must report the type of the vehicle on which they are mounted (e.g. MAV_TYPE_OCTOROTOR). All other components must report a value appropriate for their type (e.g. a camera must use MAV_TYPE_CAMERA).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_TYPE_GENERIC", Value: 0, Description: "Generic micro air vehicle"},
+			{Name: "MAV_TYPE_FIXED_WING", Value: 1, Description: "Fixed wing aircraft."},
+			{Name: "MAV_TYPE_QUADROTOR", Value: 2, Description: "Quadrotor"},
+			{Name: "MAV_TYPE_COAXIAL", Value: 3, Description: "Coaxial helicopter"},
+			{Name: "MAV_TYPE_HELICOPTER", Value: 4, Description: "Normal helicopter with tail rotor."},
+			{Name: "MAV_TYPE_ANTENNA_TRACKER", Value: 5, Description: "Ground installation"},
+			{Name: "MAV_TYPE_GCS", Value: 6, Description: "Operator control unit / ground control station"},
+			{Name: "MAV_TYPE_AIRSHIP", Value: 7, Description: "Airship, controlled"},
+			{Name: "MAV_TYPE_FREE_BALLOON", Value: 8, Description: "Free balloon, uncontrolled"},
+			{Name: "MAV_TYPE_ROCKET", Value: 9, Description: "Rocket"},
+			{Name: "MAV_TYPE_GROUND_ROVER", Value: 10, Description: "Ground rover"},
+			{Name: "MAV_TYPE_SURFACE_BOAT", Value: 11, Description: "Surface vessel, boat, ship"},
+			{Name: "MAV_TYPE_SUBMARINE", Value: 12, Description: "Submarine"},
+			{Name: "MAV_TYPE_HEXAROTOR", Value: 13, Description: "Hexarotor"},
+			{Name: "MAV_TYPE_OCTOROTOR", Value: 14, Description: "Octorotor"},
+			{Name: "MAV_TYPE_TRICOPTER", Value: 15, Description: "Tricopter"},
+			{Name: "MAV_TYPE_FLAPPING_WING", Value: 16, Description: "Flapping wing"},
+			{Name: "MAV_TYPE_KITE", Value: 17, Description: "Kite"},
+			{Name: "MAV_TYPE_ONBOARD_CONTROLLER", Value: 18, Description: "Onboard companion controller"},
+			{Name: "MAV_TYPE_VTOL_TAILSITTER_DUOROTOR", Value: 19, Description: "Two-rotor Tailsitter VTOL that additionally uses control surfaces in vertical operation. Note, value previously named MAV_TYPE_VTOL_DUOROTOR."},
+			{Name: "MAV_TYPE_VTOL_TAILSITTER_QUADROTOR", Value: 20, Description: "Quad-rotor Tailsitter VTOL using a V-shaped quad config in vertical operation. Note: value previously named MAV_TYPE_VTOL_QUADROTOR."},
+			{Name: "MAV_TYPE_VTOL_TILTROTOR", Value: 21, Description: "Tiltrotor VTOL. Fuselage and wings stay (nominally) horizontal in all flight phases. It able to tilt (some) rotors to provide thrust in cruise flight."},
+			{Name: "MAV_TYPE_VTOL_FIXEDROTOR", Value: 22, Description: "VTOL with separate fixed rotors for hover and cruise flight. Fuselage and wings stay (nominally) horizontal in all flight phases."},
+			{Name: "MAV_TYPE_VTOL_TAILSITTER", Value: 23, Description: "Tailsitter VTOL. Fuselage and wings orientation changes depending on flight phase: vertical for hover, horizontal for cruise. Use more specific VTOL MAV_TYPE_VTOL_TAILSITTER_DUOROTOR or MAV_TYPE_VTOL_TAILSITTER_QUADROTOR if appropriate."},
+			{Name: "MAV_TYPE_VTOL_TILTWING", Value: 24, Description: "Tiltwing VTOL. Fuselage stays horizontal in all flight phases. The whole wing, along with any attached engine, can tilt between vertical and horizontal mode."},
+			{Name: "MAV_TYPE_VTOL_RESERVED5", Value: 25, Description: "VTOL reserved 5"},
+			{Name: "MAV_TYPE_GIMBAL", Value: 26, Description: "Gimbal"},
+			{Name: "MAV_TYPE_ADSB", Value: 27, Description: "ADSB system"},
+			{Name: "MAV_TYPE_PARAFOIL", Value: 28, Description: "Steerable, nonrigid airfoil"},
+			{Name: "MAV_TYPE_DODECAROTOR", Value: 29, Description: "Dodecarotor"},
+			{Name: "MAV_TYPE_CAMERA", Value: 30, Description: "Camera"},
+			{Name: "MAV_TYPE_CHARGING_STATION", Value: 31, Description: "Charging station"},
+			{Name: "MAV_TYPE_FLARM", Value: 32, Description: "FLARM collision avoidance system"},
+			{Name: "MAV_TYPE_SERVO", Value: 33, Description: "Servo"},
+			{Name: "MAV_TYPE_ODID", Value: 34, Description: "Open Drone ID. See https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "MAV_TYPE_DECAROTOR", Value: 35, Description: "Decarotor"},
+			{Name: "MAV_TYPE_BATTERY", Value: 36, Description: "Battery"},
+			{Name: "MAV_TYPE_PARACHUTE", Value: 37, Description: "Parachute"},
+			{Name: "MAV_TYPE_LOG", Value: 38, Description: "Log"},
+			{Name: "MAV_TYPE_OSD", Value: 39, Description: "OSD"},
+			{Name: "MAV_TYPE_IMU", Value: 40, Description: "IMU"},
+			{Name: "MAV_TYPE_GPS", Value: 41, Description: "GPS"},
+			{Name: "MAV_TYPE_WINCH", Value: 42, Description: "Winch"},
+			{Name: "MAV_TYPE_GENERIC_MULTIROTOR", Value: 43, Description: "Generic multirotor that does not fit into a specific type or whose type is unknown"},
+			{Name: "MAV_TYPE_ILLUMINATOR", Value: 44, Description: "Illuminator. An illuminator is a light source that is used for lighting up dark areas external to the system: e.g. a torch or searchlight (as opposed to a light source for illuminating the system itself, e.g. an indicator light)."},
+			{Name: "MAV_TYPE_SPACECRAFT_ORBITER", Value: 45, Description: "Orbiter spacecraft. Includes satellites orbiting terrestrial and extra-terrestrial bodies. Follows NASA Spacecraft Classification."},
+			{Name: "MAV_TYPE_GROUND_QUADRUPED", Value: 46, Description: "A generic four-legged ground vehicle (e.g., a robot dog)."},
+			{Name: "MAV_TYPE_VTOL_GYRODYNE", Value: 47, Description: "VTOL hybrid of helicopter and autogyro. It has a main rotor for lift and separate propellers for forward flight. The rotor must be powered for hover but can autorotate in cruise flight. See: https://en.wikipedia.org/wiki/Gyrodyne"},
+			{Name: "MAV_TYPE_GRIPPER", Value: 48, Description: "Gripper"},
+			{Name: "MAV_TYPE_RADIO", Value: 49, Description: "Radio"},
+		},
+	},
+	"MAV_VTOL_STATE": {
+		Name:        "MAV_VTOL_STATE",
+		Description: "Enumeration of VTOL states",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_VTOL_STATE_UNDEFINED", Value: 0, Description: "MAV is not configured as VTOL"},
+			{Name: "MAV_VTOL_STATE_TRANSITION_TO_FW", Value: 1, Description: "VTOL is in transition from multicopter to fixed-wing"},
+			{Name: "MAV_VTOL_STATE_TRANSITION_TO_MC", Value: 2, Description: "VTOL is in transition from fixed-wing to multicopter"},
+			{Name: "MAV_VTOL_STATE_MC", Value: 3, Description: "VTOL is in multicopter state"},
+			{Name: "MAV_VTOL_STATE_FW", Value: 4, Description: "VTOL is in fixed-wing state"},
+		},
+	},
+	"MAV_WINCH_STATUS_FLAG": {
+		Name:        "MAV_WINCH_STATUS_FLAG",
+		Description: "Winch status flags used in WINCH_STATUS",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MAV_WINCH_STATUS_HEALTHY", Value: 1, Description: "Winch is healthy"},
+			{Name: "MAV_WINCH_STATUS_FULLY_RETRACTED", Value: 2, Description: "Winch line is fully retracted"},
+			{Name: "MAV_WINCH_STATUS_MOVING", Value: 4, Description: "Winch motor is moving"},
+			{Name: "MAV_WINCH_STATUS_CLUTCH_ENGAGED", Value: 8, Description: "Winch clutch is engaged allowing motor to move freely."},
+			{Name: "MAV_WINCH_STATUS_LOCKED", Value: 16, Description: "Winch is locked by locking mechanism."},
+			{Name: "MAV_WINCH_STATUS_DROPPING", Value: 32, Description: "Winch is gravity dropping payload."},
+			{Name: "MAV_WINCH_STATUS_ARRESTING", Value: 64, Description: "Winch is arresting payload descent."},
+			{Name: "MAV_WINCH_STATUS_GROUND_SENSE", Value: 128, Description: "Winch is using torque measurements to sense the ground."},
+			{Name: "MAV_WINCH_STATUS_RETRACTING", Value: 256, Description: "Winch is returning to the fully retracted position."},
+			{Name: "MAV_WINCH_STATUS_REDELIVER", Value: 512, Description: "Winch is redelivering the payload. This is a failover state if the line tension goes above a threshold during RETRACTING."},
+			{Name: "MAV_WINCH_STATUS_ABANDON_LINE", Value: 1024, Description: "Winch is abandoning the line and possibly payload. Winch unspools the entire calculated line length. This is a failover state from REDELIVER if the number of attempts exceeds a threshold."},
+			{Name: "MAV_WINCH_STATUS_LOCKING", Value: 2048, Description: "Winch is engaging the locking mechanism."},
+			{Name: "MAV_WINCH_STATUS_LOAD_LINE", Value: 4096, Description: "Winch is spooling on line."},
+			{Name: "MAV_WINCH_STATUS_LOAD_PAYLOAD", Value: 8192, Description: "Winch is loading a payload."},
+		},
+	},
+	"MISSION_STATE": {
+		Name:        "MISSION_STATE",
+		Description: "States of the mission state machine. Note that these states are independent of whether the mission is in a mode that can execute mission items or not (is suspended). They may not all be relevant on all vehicles.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MISSION_STATE_UNKNOWN", Value: 0, Description: "The mission status reporting is not supported."},
+			{Name: "MISSION_STATE_NO_MISSION", Value: 1, Description: "No mission on the vehicle."},
+			{Name: "MISSION_STATE_NOT_STARTED", Value: 2, Description: "Mission has not started. This is the case after a mission has uploaded but not yet started executing."},
+			{Name: "MISSION_STATE_ACTIVE", Value: 3, Description: "Mission is active, and will execute mission items when in auto mode."},
+			{Name: "MISSION_STATE_PAUSED", Value: 4, Description: "Mission is paused when in auto mode."},
+			{Name: "MISSION_STATE_COMPLETE", Value: 5, Description: "Mission has executed all mission items."},
+		},
+	},
+	"MOTOR_TEST_ORDER": {
+		Name:        "MOTOR_TEST_ORDER",
+		Description: "Sequence that motors are tested when using MAV_CMD_DO_MOTOR_TEST.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MOTOR_TEST_ORDER_DEFAULT", Value: 0, Description: "Default autopilot motor test method."},
+			{Name: "MOTOR_TEST_ORDER_SEQUENCE", Value: 1, Description: "Motor numbers are specified as their index in a predefined vehicle-specific sequence."},
+			{Name: "MOTOR_TEST_ORDER_BOARD", Value: 2, Description: "Motor numbers are specified as the output as labeled on the board."},
+		},
+	},
+	"MOTOR_TEST_THROTTLE_TYPE": {
+		Name:        "MOTOR_TEST_THROTTLE_TYPE",
+		Description: "Defines how throttle value is represented in MAV_CMD_DO_MOTOR_TEST.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "MOTOR_TEST_THROTTLE_PERCENT", Value: 0, Description: "Throttle as a percentage (0 ~ 100)"},
+			{Name: "MOTOR_TEST_THROTTLE_PWM", Value: 1, Description: "Throttle as an absolute PWM value (normally in range of 1000~2000)."},
+			{Name: "MOTOR_TEST_THROTTLE_PILOT", Value: 2, Description: "Throttle pass-through from pilot's transmitter."},
+			{Name: "MOTOR_TEST_COMPASS_CAL", Value: 3, Description: "Per-motor compass calibration test."},
+		},
+	},
+	"NAV_TAKEOFF_FLAGS": {
+		Name:        "NAV_TAKEOFF_FLAGS",
+		Description: "",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "NAV_TAKEOFF_FLAGS_HORIZONTAL_POSITION_NOT_REQUIRED", Value: 1, Description: "Accept the command even if the autopilot does not have control over its horizontal position (note that it might not have altitude control either)."},
+		},
+	},
+	"NAV_VTOL_LAND_OPTIONS": {
+		Name:        "NAV_VTOL_LAND_OPTIONS",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "NAV_VTOL_LAND_OPTIONS_DEFAULT", Value: 0, Description: "Default autopilot landing behaviour."},
+			{Name: "NAV_VTOL_LAND_OPTIONS_FW_SPIRAL_APPROACH", Value: 1, Description: "Use a fixed wing spiral desent approach before landing."},
+			{Name: "NAV_VTOL_LAND_OPTIONS_FW_APPROACH", Value: 2, Description: "Use a fixed wing approach before detransitioning and landing vertically."},
+		},
+	},
+	"ORBIT_YAW_BEHAVIOUR": {
+		Name:        "ORBIT_YAW_BEHAVIOUR",
+		Description: "Yaw behaviour during orbit flight.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TO_CIRCLE_CENTER", Value: 0, Description: "Vehicle front points to the center (default)."},
+			{Name: "ORBIT_YAW_BEHAVIOUR_HOLD_INITIAL_HEADING", Value: 1, Description: "Vehicle front holds heading when message received."},
+			{Name: "ORBIT_YAW_BEHAVIOUR_UNCONTROLLED", Value: 2, Description: "Yaw uncontrolled."},
+			{Name: "ORBIT_YAW_BEHAVIOUR_HOLD_FRONT_TANGENT_TO_CIRCLE", Value: 3, Description: "Vehicle front follows flight path (tangential to circle)."},
+			{Name: "ORBIT_YAW_BEHAVIOUR_RC_CONTROLLED", Value: 4, Description: "Yaw controlled by RC input."},
+			{Name: "ORBIT_YAW_BEHAVIOUR_UNCHANGED", Value: 5, Description: "Vehicle uses current yaw behaviour (unchanged). The vehicle-default yaw behaviour is used if this value is specified when orbit is first commanded."},
+		},
+	},
+	"PARACHUTE_ACTION": {
+		Name:        "PARACHUTE_ACTION",
+		Description: "Parachute actions. Trigger release and enable/disable auto-release.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PARACHUTE_DISABLE", Value: 0, Description: "Disable auto-release of parachute (i.e. release triggered by crash detectors)."},
+			{Name: "PARACHUTE_ENABLE", Value: 1, Description: "Enable auto-release of parachute."},
+			{Name: "PARACHUTE_RELEASE", Value: 2, Description: "Release parachute and kill motors."},
+		},
+	},
+	"PARAM_ACK": {
+		Name:        "PARAM_ACK",
+		Description: "Result from PARAM_EXT_SET message.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PARAM_ACK_ACCEPTED", Value: 0, Description: "Parameter value ACCEPTED and SET"},
+			{Name: "PARAM_ACK_VALUE_UNSUPPORTED", Value: 1, Description: "Parameter value UNKNOWN/UNSUPPORTED"},
+			{Name: "PARAM_ACK_FAILED", Value: 2, Description: "Parameter failed to set"},
+			{Name: "PARAM_ACK_IN_PROGRESS", Value: 3, Description: "Parameter value received but not yet set/accepted. A subsequent PARAM_EXT_ACK with the final result will follow once operation is completed. This is returned immediately for parameters that take longer to set, indicating that the the parameter was received and does not need to be resent."},
+		},
+	},
+	"POSITION_TARGET_TYPEMASK": {
+		Name:        "POSITION_TARGET_TYPEMASK",
+		Description: "Bitmap to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 9 is set the floats afx afy afz should be interpreted as force instead of acceleration.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "POSITION_TARGET_TYPEMASK_X_IGNORE", Value: 1, Description: "Ignore position x"},
+			{Name: "POSITION_TARGET_TYPEMASK_Y_IGNORE", Value: 2, Description: "Ignore position y"},
+			{Name: "POSITION_TARGET_TYPEMASK_Z_IGNORE", Value: 4, Description: "Ignore position z"},
+			{Name: "POSITION_TARGET_TYPEMASK_VX_IGNORE", Value: 8, Description: "Ignore velocity x"},
+			{Name: "POSITION_TARGET_TYPEMASK_VY_IGNORE", Value: 16, Description: "Ignore velocity y"},
+			{Name: "POSITION_TARGET_TYPEMASK_VZ_IGNORE", Value: 32, Description: "Ignore velocity z"},
+			{Name: "POSITION_TARGET_TYPEMASK_AX_IGNORE", Value: 64, Description: "Ignore acceleration x"},
+			{Name: "POSITION_TARGET_TYPEMASK_AY_IGNORE", Value: 128, Description: "Ignore acceleration y"},
+			{Name: "POSITION_TARGET_TYPEMASK_AZ_IGNORE", Value: 256, Description: "Ignore acceleration z"},
+			{Name: "POSITION_TARGET_TYPEMASK_FORCE_SET", Value: 512, Description: "Use force instead of acceleration"},
+			{Name: "POSITION_TARGET_TYPEMASK_YAW_IGNORE", Value: 1024, Description: "Ignore yaw"},
+			{Name: "POSITION_TARGET_TYPEMASK_YAW_RATE_IGNORE", Value: 2048, Description: "Ignore yaw rate"},
+		},
+	},
+	"PRECISION_LAND_MODE": {
+		Name:        "PRECISION_LAND_MODE",
+		Description: "Precision land modes (used in MAV_CMD_NAV_LAND).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PRECISION_LAND_MODE_DISABLED", Value: 0, Description: "Normal (non-precision) landing."},
+			{Name: "PRECISION_LAND_MODE_OPPORTUNISTIC", Value: 1, Description: "Use precision landing if beacon detected when land command accepted, otherwise land normally."},
+			{Name: "PRECISION_LAND_MODE_REQUIRED", Value: 2, Description: "Use precision landing, searching for beacon if not found when land command accepted (land normally if beacon cannot be found)."},
+		},
+	},
+	"PREFLIGHT_CALIBRATION_ACCELEROMETER": {
+		Name:        "PREFLIGHT_CALIBRATION_ACCELEROMETER",
+		Description: "Action for the accelerometer (param5) of MAV_CMD_PREFLIGHT_CALIBRATION.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_NONE", Value: 0, Description: "No action."},
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_FULL", Value: 1, Description: "Full 6-position accelerometer calibration."},
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_TRIM", Value: 2, Description: "Board level (trim) calibration."},
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_TEMPERATURE", Value: 3, Description: "Accelerometer temperature calibration."},
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_SIMPLE", Value: 4, Description: "Simple accelerometer calibration."},
+			{Name: "PREFLIGHT_CALIBRATION_ACCELEROMETER_FORCE_SAVE", Value: 76, Description: "Force-accept the existing accelerometer calibration as valid without re-running it. Useful after a parameter reload that cleared calibration validity flags."},
+		},
+	},
+	"PREFLIGHT_CALIBRATION_MAGNETOMETER": {
+		Name:        "PREFLIGHT_CALIBRATION_MAGNETOMETER",
+		Description: "Action for the magnetometer (param2) of MAV_CMD_PREFLIGHT_CALIBRATION.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PREFLIGHT_CALIBRATION_MAGNETOMETER_NONE", Value: 0, Description: "No action."},
+			{Name: "PREFLIGHT_CALIBRATION_MAGNETOMETER_START", Value: 1, Description: "Start magnetometer calibration."},
+			{Name: "PREFLIGHT_CALIBRATION_MAGNETOMETER_FORCE_SAVE", Value: 76, Description: "Force-accept the existing compass calibration as valid without re-running it. Useful after a parameter reload that cleared calibration validity flags."},
+		},
+	},
+	"PREFLIGHT_STORAGE_PARAMETER_ACTION": {
+		Name:        "PREFLIGHT_STORAGE_PARAMETER_ACTION",
+		Description: "Actions for reading/writing parameters between persistent and volatile storage when using MAV_CMD_PREFLIGHT_STORAGE. (Commonly parameters are loaded from persistent storage (flash/EEPROM) into volatile storage (RAM) on startup and written back when they are changed.)",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "PARAM_READ_PERSISTENT", Value: 0, Description: "Read all parameters from persistent storage. Replaces values in volatile storage."},
+			{Name: "PARAM_WRITE_PERSISTENT", Value: 1, Description: "Write all parameter values to persistent storage (flash/EEPROM)"},
+			{Name: "PARAM_RESET_FACTORY_DEFAULT", Value: 2, Description: "Reset parameters to default values (such as sensor calibration, safety settings, and so on). Note that a flight stack may choose not to reset some parameters at their own discretion (such as those that are locked or expected to persist for the vehicle lifetime)."},
+			{Name: "PARAM_RESET_SENSOR_DEFAULT", Value: 3, Description: "Reset only sensor calibration parameters to factory defaults (or firmware default if not available)"},
+			{Name: "PARAM_RESET_ALL_DEFAULT", Value: 4, Description: "Reset all parameters to default values."},
+		},
+	},
+	"RC_SUB_TYPE": {
+		Name:        "RC_SUB_TYPE",
+		Description: "RC sub-type of types defined in RC_TYPE. Used in MAV_CMD_START_RX_PAIR. Ignored if value does not correspond to the set RC_TYPE.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "RC_SUB_TYPE_SPEKTRUM_DSM2", Value: 0, Description: "Spektrum DSM2"},
+			{Name: "RC_SUB_TYPE_SPEKTRUM_DSMX", Value: 1, Description: "Spektrum DSMX"},
+			{Name: "RC_SUB_TYPE_SPEKTRUM_DSMX8", Value: 2, Description: "Spektrum DSMX8"},
+		},
+	},
+	"RC_TYPE": {
+		Name:        "RC_TYPE",
+		Description: "RC type. Used in MAV_CMD_START_RX_PAIR.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "RC_TYPE_SPEKTRUM", Value: 0, Description: "Spektrum"},
+			{Name: "RC_TYPE_CRSF", Value: 1, Description: "CRSF"},
+		},
+	},
+	"REBOOT_SHUTDOWN_ACTION": {
+		Name:        "REBOOT_SHUTDOWN_ACTION",
+		Description: "Reboot/shutdown action for selected component in MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "REBOOT_SHUTDOWN_ACTION_NONE", Value: 0, Description: "Do nothing."},
+			{Name: "REBOOT_SHUTDOWN_ACTION_REBOOT", Value: 1, Description: "Reboot component."},
+			{Name: "REBOOT_SHUTDOWN_ACTION_SHUTDOWN", Value: 2, Description: "Shutdown component."},
+			{Name: "REBOOT_SHUTDOWN_ACTION_REBOOT_TO_BOOTLOADER", Value: 3, Description: "Reboot component and keep it in the bootloader until upgraded."},
+			{Name: "REBOOT_SHUTDOWN_ACTION_POWER_ON", Value: 4, Description: "Power on component. Do nothing if component is already powered (ACK command with MAV_RESULT_ACCEPTED)."},
+			{Name: "REBOOT_SHUTDOWN_ACTION_REBOOT_TO_MASS_STORAGE", Value: 5, Description: "Reboot component into a mass storage mode."},
+		},
+	},
+	"REBOOT_SHUTDOWN_CONDITIONS": {
+		Name:        "REBOOT_SHUTDOWN_CONDITIONS",
+		Description: "Specifies the conditions under which the MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN command should be accepted.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "REBOOT_SHUTDOWN_CONDITIONS_SAFETY_INTERLOCKED", Value: 0, Description: "Reboot/Shutdown only if allowed by safety checks, such as being landed."},
+			{Name: "REBOOT_SHUTDOWN_CONDITIONS_FORCE", Value: 20190226, Description: "Force reboot/shutdown of the autopilot/component regardless of system state."},
+		},
+	},
+	"RTK_BASELINE_COORDINATE_SYSTEM": {
+		Name:        "RTK_BASELINE_COORDINATE_SYSTEM",
+		Description: "RTK GPS baseline coordinate system, used for RTK corrections",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "RTK_BASELINE_COORDINATE_SYSTEM_ECEF", Value: 0, Description: "Earth-centered, Earth-fixed"},
+			{Name: "RTK_BASELINE_COORDINATE_SYSTEM_NED", Value: 1, Description: "RTK basestation centered, north, east, down"},
+		},
+	},
+	"SAFETY_SWITCH_STATE": {
+		Name:        "SAFETY_SWITCH_STATE",
+		Description: "Possible safety switch states.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "SAFETY_SWITCH_STATE_SAFE", Value: 0, Description: "Safety switch is engaged and vehicle should be safe to approach."},
+			{Name: "SAFETY_SWITCH_STATE_DANGEROUS", Value: 1, Description: "Safety switch is NOT engaged and motors, propellers and other actuators should be considered active."},
+		},
+	},
+	"SERIAL_CONTROL_DEV": {
+		Name:        "SERIAL_CONTROL_DEV",
+		Description: "SERIAL_CONTROL device types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "SERIAL_CONTROL_DEV_TELEM1", Value: 0, Description: "First telemetry port"},
+			{Name: "SERIAL_CONTROL_DEV_TELEM2", Value: 1, Description: "Second telemetry port"},
+			{Name: "SERIAL_CONTROL_DEV_GPS1", Value: 2, Description: "First GPS port"},
+			{Name: "SERIAL_CONTROL_DEV_GPS2", Value: 3, Description: "Second GPS port"},
+			{Name: "SERIAL_CONTROL_DEV_TELEM3", Value: 4, Description: "Third telemetry port"},
+			{Name: "SERIAL_CONTROL_DEV_TELEM4", Value: 5, Description: "Fourth telemetry port"},
+			{Name: "SERIAL_CONTROL_DEV_SHELL", Value: 10, Description: "system shell"},
+			{Name: "SERIAL_CONTROL_DEV_ESC0", Value: 20, Description: "Electronic Speed Controller 0"},
+			{Name: "SERIAL_CONTROL_DEV_ESC1", Value: 21, Description: "Electronic Speed Controller 1"},
+			{Name: "SERIAL_CONTROL_DEV_ESC2", Value: 22, Description: "Electronic Speed Controller 2"},
+			{Name: "SERIAL_CONTROL_DEV_ESC3", Value: 23, Description: "Electronic Speed Controller 3"},
+			{Name: "SERIAL_CONTROL_DEV_ESC4", Value: 24, Description: "Electronic Speed Controller 4"},
+			{Name: "SERIAL_CONTROL_DEV_ESC5", Value: 25, Description: "Electronic Speed Controller 5"},
+			{Name: "SERIAL_CONTROL_DEV_ESC6", Value: 26, Description: "Electronic Speed Controller 6"},
+			{Name: "SERIAL_CONTROL_DEV_ESC7", Value: 27, Description: "Electronic Speed Controller 7"},
+			{Name: "SERIAL_CONTROL_SERIAL0", Value: 100, Description: "SERIAL0"},
+			{Name: "SERIAL_CONTROL_SERIAL1", Value: 101, Description: "SERIAL1"},
+			{Name: "SERIAL_CONTROL_SERIAL2", Value: 102, Description: "SERIAL2"},
+			{Name: "SERIAL_CONTROL_SERIAL3", Value: 103, Description: "SERIAL3"},
+			{Name: "SERIAL_CONTROL_SERIAL4", Value: 104, Description: "SERIAL4"},
+			{Name: "SERIAL_CONTROL_SERIAL5", Value: 105, Description: "SERIAL5"},
+			{Name: "SERIAL_CONTROL_SERIAL6", Value: 106, Description: "SERIAL6"},
+			{Name: "SERIAL_CONTROL_SERIAL7", Value: 107, Description: "SERIAL7"},
+			{Name: "SERIAL_CONTROL_SERIAL8", Value: 108, Description: "SERIAL8"},
+			{Name: "SERIAL_CONTROL_SERIAL9", Value: 109, Description: "SERIAL9"},
+		},
+	},
+	"SERIAL_CONTROL_FLAG": {
+		Name:        "SERIAL_CONTROL_FLAG",
+		Description: "SERIAL_CONTROL flags (bitmask)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "SERIAL_CONTROL_FLAG_REPLY", Value: 1, Description: "Set if this is a reply"},
+			{Name: "SERIAL_CONTROL_FLAG_RESPOND", Value: 2, Description: "Set if the sender wants the receiver to send a response as another SERIAL_CONTROL message"},
+			{Name: "SERIAL_CONTROL_FLAG_EXCLUSIVE", Value: 4, Description: "Set if access to the serial port should be removed from whatever driver is currently using it, giving exclusive access to the SERIAL_CONTROL protocol. The port can be handed back by sending a request without this flag set"},
+			{Name: "SERIAL_CONTROL_FLAG_BLOCKING", Value: 8, Description: "Block on writes to the serial port"},
+			{Name: "SERIAL_CONTROL_FLAG_MULTI", Value: 16, Description: "Send multiple replies until port is drained"},
+		},
+	},
+	"SET_FOCUS_TYPE": {
+		Name:        "SET_FOCUS_TYPE",
+		Description: "Focus types for MAV_CMD_SET_CAMERA_FOCUS",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "FOCUS_TYPE_STEP", Value: 0, Description: "Focus one step increment (-1 for focusing in, 1 for focusing out towards infinity)."},
+			{Name: "FOCUS_TYPE_CONTINUOUS", Value: 1, Description: "Continuous normalized focus in/out rate until stopped. Range -1..1, negative: in, positive: out towards infinity, 0 to stop focusing. Other values should be clipped to the range."},
+			{Name: "FOCUS_TYPE_RANGE", Value: 2, Description: "Focus value as proportion of full camera focus range (a value between 0.0 and 100.0)"},
+			{Name: "FOCUS_TYPE_METERS", Value: 3, Description: "Focus value in metres. Note that there is no message to get the valid focus range of the camera, so this can type can only be used for cameras where the range is known (implying that this cannot reliably be used in a GCS for an arbitrary camera)."},
+			{Name: "FOCUS_TYPE_AUTO", Value: 4, Description: "Focus automatically."},
+			{Name: "FOCUS_TYPE_AUTO_SINGLE", Value: 5, Description: "Single auto focus. Mainly used for still pictures. Usually abbreviated as AF-S."},
+			{Name: "FOCUS_TYPE_AUTO_CONTINUOUS", Value: 6, Description: "Continuous auto focus. Mainly used for dynamic scenes. Abbreviated as AF-C."},
+		},
+	},
+	"SPEED_TYPE": {
+		Name:        "SPEED_TYPE",
+		Description: "Speed setpoint types used in MAV_CMD_DO_CHANGE_SPEED",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "SPEED_TYPE_AIRSPEED", Value: 0, Description: "Airspeed"},
+			{Name: "SPEED_TYPE_GROUNDSPEED", Value: 1, Description: "Groundspeed"},
+			{Name: "SPEED_TYPE_CLIMB_SPEED", Value: 2, Description: "Climb speed"},
+			{Name: "SPEED_TYPE_DESCENT_SPEED", Value: 3, Description: "Descent speed"},
+		},
+	},
+	"STORAGE_STATUS": {
+		Name:        "STORAGE_STATUS",
+		Description: "Flags to indicate the status of camera storage.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "STORAGE_STATUS_EMPTY", Value: 0, Description: "Storage is missing (no microSD card loaded for example.)"},
+			{Name: "STORAGE_STATUS_UNFORMATTED", Value: 1, Description: "Storage present but unformatted."},
+			{Name: "STORAGE_STATUS_READY", Value: 2, Description: "Storage present and ready."},
+			{Name: "STORAGE_STATUS_NOT_SUPPORTED", Value: 3, Description: "Camera does not supply storage status information. Capacity information in STORAGE_INFORMATION fields will be ignored."},
+		},
+	},
+	"STORAGE_TYPE": {
+		Name:        "STORAGE_TYPE",
+		Description: "Flags to indicate the type of storage.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "STORAGE_TYPE_UNKNOWN", Value: 0, Description: "Storage type is not known."},
+			{Name: "STORAGE_TYPE_USB_STICK", Value: 1, Description: "Storage type is USB device."},
+			{Name: "STORAGE_TYPE_SD", Value: 2, Description: "Storage type is SD card."},
+			{Name: "STORAGE_TYPE_MICROSD", Value: 3, Description: "Storage type is microSD card."},
+			{Name: "STORAGE_TYPE_CF", Value: 4, Description: "Storage type is CFast."},
+			{Name: "STORAGE_TYPE_CFE", Value: 5, Description: "Storage type is CFexpress."},
+			{Name: "STORAGE_TYPE_XQD", Value: 6, Description: "Storage type is XQD."},
+			{Name: "STORAGE_TYPE_HD", Value: 7, Description: "Storage type is HD mass storage type."},
+			{Name: "STORAGE_TYPE_OTHER", Value: 254, Description: "Storage type is other, not listed type."},
+		},
+	},
+	"STORAGE_USAGE_FLAG": {
+		Name:        "STORAGE_USAGE_FLAG",
+		Description: "Flags to indicate usage for a particular storage (see STORAGE_INFORMATION.storage_usage and MAV_CMD_SET_STORAGE_USAGE).",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "STORAGE_USAGE_FLAG_SET", Value: 1, Description: "Always set to 1 (indicates STORAGE_INFORMATION.storage_usage is supported)."},
+			{Name: "STORAGE_USAGE_FLAG_PHOTO", Value: 2, Description: "Storage for saving photos."},
+			{Name: "STORAGE_USAGE_FLAG_VIDEO", Value: 4, Description: "Storage for saving videos."},
+			{Name: "STORAGE_USAGE_FLAG_LOGS", Value: 8, Description: "Storage for saving logs."},
+		},
+	},
+	"TUNE_FORMAT": {
+		Name:        "TUNE_FORMAT",
+		Description: "Tune formats (used for vehicle buzzer/tone generation).",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "TUNE_FORMAT_QBASIC1_1", Value: 1, Description: "Format is QBasic 1.1 Play: https://www.qbasic.net/en/reference/qb11/Statement/PLAY-006.htm."},
+			{Name: "TUNE_FORMAT_MML_MODERN", Value: 2, Description: "Format is Modern Music Markup Language (MML): https://en.wikipedia.org/wiki/Music_Macro_Language#Modern_MML."},
+		},
+	},
+	"UAVCAN_NODE_HEALTH": {
+		Name:        "UAVCAN_NODE_HEALTH",
+		Description: "Generalized UAVCAN node health",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "UAVCAN_NODE_HEALTH_OK", Value: 0, Description: "The node is functioning properly."},
+			{Name: "UAVCAN_NODE_HEALTH_WARNING", Value: 1, Description: "A critical parameter went out of range or the node has encountered a minor failure."},
+			{Name: "UAVCAN_NODE_HEALTH_ERROR", Value: 2, Description: "The node has encountered a major failure."},
+			{Name: "UAVCAN_NODE_HEALTH_CRITICAL", Value: 3, Description: "The node has suffered a fatal malfunction."},
+		},
+	},
+	"UAVCAN_NODE_MODE": {
+		Name:        "UAVCAN_NODE_MODE",
+		Description: "Generalized UAVCAN node mode",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "UAVCAN_NODE_MODE_OPERATIONAL", Value: 0, Description: "The node is performing its primary functions."},
+			{Name: "UAVCAN_NODE_MODE_INITIALIZATION", Value: 1, Description: "The node is initializing; this mode is entered immediately after startup."},
+			{Name: "UAVCAN_NODE_MODE_MAINTENANCE", Value: 2, Description: "The node is under maintenance."},
+			{Name: "UAVCAN_NODE_MODE_SOFTWARE_UPDATE", Value: 3, Description: "The node is in the process of updating its software."},
+			{Name: "UAVCAN_NODE_MODE_OFFLINE", Value: 7, Description: "The node is no longer available online."},
+		},
+	},
+	"UTM_DATA_AVAIL_FLAGS": {
+		Name:        "UTM_DATA_AVAIL_FLAGS",
+		Description: "Flags for the global position report.",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "UTM_DATA_AVAIL_FLAGS_TIME_VALID", Value: 1, Description: "The field time contains valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_UAS_ID_AVAILABLE", Value: 2, Description: "The field uas_id contains valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_POSITION_AVAILABLE", Value: 4, Description: "The fields lat, lon and h_acc contain valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_ALTITUDE_AVAILABLE", Value: 8, Description: "The fields alt and v_acc contain valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_RELATIVE_ALTITUDE_AVAILABLE", Value: 16, Description: "The field relative_alt contains valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_HORIZONTAL_VELO_AVAILABLE", Value: 32, Description: "The fields vx and vy contain valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_VERTICAL_VELO_AVAILABLE", Value: 64, Description: "The field vz contains valid data."},
+			{Name: "UTM_DATA_AVAIL_FLAGS_NEXT_WAYPOINT_AVAILABLE", Value: 128, Description: "The fields next_lat, next_lon and next_alt contain valid data."},
+		},
+	},
+	"UTM_FLIGHT_STATE": {
+		Name:        "UTM_FLIGHT_STATE",
+		Description: "Airborne status of UAS.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "UTM_FLIGHT_STATE_UNKNOWN", Value: 1, Description: "The flight state can't be determined."},
+			{Name: "UTM_FLIGHT_STATE_GROUND", Value: 2, Description: "UAS on ground."},
+			{Name: "UTM_FLIGHT_STATE_AIRBORNE", Value: 3, Description: "UAS airborne."},
+			{Name: "UTM_FLIGHT_STATE_EMERGENCY", Value: 16, Description: "UAS is in an emergency flight state."},
+			{Name: "UTM_FLIGHT_STATE_NOCTRL", Value: 32, Description: "UAS has no active controls."},
+		},
+	},
+	"VIDEO_STREAM_ENCODING": {
+		Name:        "VIDEO_STREAM_ENCODING",
+		Description: "Video stream encodings",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VIDEO_STREAM_ENCODING_UNKNOWN", Value: 0, Description: "Stream encoding is unknown"},
+			{Name: "VIDEO_STREAM_ENCODING_H264", Value: 1, Description: "Stream encoding is H.264"},
+			{Name: "VIDEO_STREAM_ENCODING_H265", Value: 2, Description: "Stream encoding is H.265"},
+		},
+	},
+	"VIDEO_STREAM_STATUS_FLAGS": {
+		Name:        "VIDEO_STREAM_STATUS_FLAGS",
+		Description: "Stream status flags (Bitmap)",
+		Bitmask:     true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VIDEO_STREAM_STATUS_FLAGS_RUNNING", Value: 1, Description: "Stream is active (running)"},
+			{Name: "VIDEO_STREAM_STATUS_FLAGS_THERMAL", Value: 2, Description: "Stream is thermal imaging"},
+			{Name: "VIDEO_STREAM_STATUS_FLAGS_THERMAL_RANGE_ENABLED", Value: 4, Description: "Stream can report absolute thermal range (see CAMERA_THERMAL_RANGE)."},
+		},
+	},
+	"VIDEO_STREAM_TYPE": {
+		Name:        "VIDEO_STREAM_TYPE",
+		Description: "Video stream types",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VIDEO_STREAM_TYPE_RTSP", Value: 0, Description: "Stream is RTSP"},
+			{Name: "VIDEO_STREAM_TYPE_RTPUDP", Value: 1, Description: "Stream is RTP UDP (URI gives the port number)"},
+			{Name: "VIDEO_STREAM_TYPE_TCP_MPEG", Value: 2, Description: "Stream is MPEG on TCP"},
+			{Name: "VIDEO_STREAM_TYPE_MPEG_TS", Value: 3, Description: "Stream is MPEG TS (URI gives the port number)"},
+			{Name: "VIDEO_STREAM_TYPE_WHEP", Value: 4, Description: "Stream is WHEP (WebRTC-HTTP Egress Protocol)"},
+		},
+	},
+	"VTOL_TRANSITION_HEADING": {
+		Name:        "VTOL_TRANSITION_HEADING",
+		Description: "Direction of VTOL transition",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VTOL_TRANSITION_HEADING_VEHICLE_DEFAULT", Value: 0, Description: "Respect the heading configuration of the vehicle."},
+			{Name: "VTOL_TRANSITION_HEADING_NEXT_WAYPOINT", Value: 1, Description: "Use the heading pointing towards the next waypoint."},
+			{Name: "VTOL_TRANSITION_HEADING_TAKEOFF", Value: 2, Description: "Use the heading on takeoff (while sitting on the ground)."},
+			{Name: "VTOL_TRANSITION_HEADING_SPECIFIED", Value: 3, Description: "Use the specified heading in parameter 4."},
+			{Name: "VTOL_TRANSITION_HEADING_ANY", Value: 4, Description: "Use the current heading when reaching takeoff altitude (potentially facing the wind when weather-vaning is active)."},
+		},
+	},
+	"WIFI_CONFIG_AP_MODE": {
+		Name:        "WIFI_CONFIG_AP_MODE",
+		Description: "WiFi Mode.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "WIFI_CONFIG_AP_MODE_UNDEFINED", Value: 0, Description: "WiFi mode is undefined."},
+			{Name: "WIFI_CONFIG_AP_MODE_AP", Value: 1, Description: "WiFi configured as an access point."},
+			{Name: "WIFI_CONFIG_AP_MODE_STATION", Value: 2, Description: "WiFi configured as a station connected to an existing local WiFi network."},
+			{Name: "WIFI_CONFIG_AP_MODE_DISABLED", Value: 3, Description: "WiFi disabled."},
+		},
+	},
+	"WIFI_CONFIG_AP_RESPONSE": {
+		Name:        "WIFI_CONFIG_AP_RESPONSE",
+		Description: "Possible responses from a WIFI_CONFIG_AP message.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "WIFI_CONFIG_AP_RESPONSE_UNDEFINED", Value: 0, Description: "Undefined response. Likely an indicative of a system that doesn't support this request."},
+			{Name: "WIFI_CONFIG_AP_RESPONSE_ACCEPTED", Value: 1, Description: "Changes accepted."},
+			{Name: "WIFI_CONFIG_AP_RESPONSE_REJECTED", Value: 2, Description: "Changes rejected."},
+			{Name: "WIFI_CONFIG_AP_RESPONSE_MODE_ERROR", Value: 3, Description: "Invalid Mode."},
+			{Name: "WIFI_CONFIG_AP_RESPONSE_SSID_ERROR", Value: 4, Description: "Invalid SSID."},
+			{Name: "WIFI_CONFIG_AP_RESPONSE_PASSWORD_ERROR", Value: 5, Description: "Invalid Password."},
+		},
+	},
+	"WINCH_ACTIONS": {
+		Name:        "WINCH_ACTIONS",
+		Description: "Winch actions.",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "WINCH_RELAXED", Value: 0, Description: "Allow motor to freewheel."},
+			{Name: "WINCH_RELATIVE_LENGTH_CONTROL", Value: 1, Description: "Wind or unwind specified length of line, optionally using specified rate."},
+			{Name: "WINCH_RATE_CONTROL", Value: 2, Description: "Wind or unwind line at specified rate."},
+			{Name: "WINCH_LOCK", Value: 3, Description: "Perform the locking sequence to relieve motor while in the fully retracted position. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_DELIVER", Value: 4, Description: "Sequence of drop, slow down, touch down, reel up, lock. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_HOLD", Value: 5, Description: "Engage motor and hold current position. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_RETRACT", Value: 6, Description: "Return the reel to the fully retracted position. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_LOAD_LINE", Value: 7, Description: "Load the reel with line. The winch will calculate the total loaded length and stop when the tension exceeds a threshold. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_ABANDON_LINE", Value: 8, Description: "Spool out the entire length of the line. Only action and instance command parameters are used, others are ignored."},
+			{Name: "WINCH_LOAD_PAYLOAD", Value: 9, Description: "Spools out just enough to present the hook to the user to load the payload. Only action and instance command parameters are used, others are ignored"},
+		},
+	},
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set the vehicle attitude and body angular rates.
 type MessageActuatorControlTarget struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -16,3 +23,39 @@ type MessageActuatorControlTarget struct {
 func (*MessageActuatorControlTarget) GetID() uint32 {
 	return 140
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageActuatorControlTarget) MAVLinkCRCExtra() byte {
+	return 181
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageActuatorControlTarget) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 41
+	}
+	return 41
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageActuatorControlTarget) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.Controls {
+		binary.LittleEndian.PutUint32(buf[8+i*4:], math.Float32bits(v))
+	}
+	buf[40] = m.GroupMlx
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageActuatorControlTarget) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.Controls {
+		m.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[8+i*4:]))
+	}
+	m.GroupMlx = buf[40]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageActuatorControlTarget) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[140]
+}
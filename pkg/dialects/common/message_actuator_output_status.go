@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The raw values of the actuator outputs (e.g. on Pixhawk, from MAIN, AUX ports). This message supersedes SERVO_OUTPUT_RAW.
 type MessageActuatorOutputStatus struct {
 	// Timestamp (since system boot).
@@ -16,3 +23,39 @@ type MessageActuatorOutputStatus struct {
 func (*MessageActuatorOutputStatus) GetID() uint32 {
 	return 375
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageActuatorOutputStatus) MAVLinkCRCExtra() byte {
+	return 251
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageActuatorOutputStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 140
+	}
+	return 140
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageActuatorOutputStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.Active)
+	for i, v := range m.Actuator {
+		binary.LittleEndian.PutUint32(buf[12+i*4:], math.Float32bits(v))
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageActuatorOutputStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Active = binary.LittleEndian.Uint32(buf[8:])
+	for i := range m.Actuator {
+		m.Actuator[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[12+i*4:]))
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageActuatorOutputStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[375]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The location and information of an ADSB vehicle
 type MessageAdsbVehicle struct {
 	// ICAO address
@@ -36,3 +43,59 @@ type MessageAdsbVehicle struct {
 func (*MessageAdsbVehicle) GetID() uint32 {
 	return 246
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAdsbVehicle) MAVLinkCRCExtra() byte {
+	return 184
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAdsbVehicle) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 38
+	}
+	return 38
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAdsbVehicle) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.IcaoAddress)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Altitude))
+	binary.LittleEndian.PutUint16(buf[16:], m.Heading)
+	binary.LittleEndian.PutUint16(buf[18:], m.HorVelocity)
+	binary.LittleEndian.PutUint16(buf[20:], uint16(m.VerVelocity))
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.Flags))
+	binary.LittleEndian.PutUint16(buf[24:], m.Squawk)
+	buf[26] = byte(m.AltitudeType)
+	copy(buf[27:36], m.Callsign)
+	buf[36] = byte(m.EmitterType)
+	buf[37] = m.Tslc
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAdsbVehicle) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.IcaoAddress = binary.LittleEndian.Uint32(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Altitude = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Heading = binary.LittleEndian.Uint16(buf[16:])
+	m.HorVelocity = binary.LittleEndian.Uint16(buf[18:])
+	m.VerVelocity = int16(binary.LittleEndian.Uint16(buf[20:]))
+	m.Flags = ADSB_FLAGS(binary.LittleEndian.Uint16(buf[22:]))
+	m.Squawk = binary.LittleEndian.Uint16(buf[24:])
+	m.AltitudeType = ADSB_ALTITUDE_TYPE(buf[26])
+	if n := bytes.IndexByte(buf[27:36], 0); n >= 0 {
+		m.Callsign = string(buf[27 : 27+n])
+	} else {
+		m.Callsign = string(buf[27:36])
+	}
+	m.EmitterType = ADSB_EMITTER_TYPE(buf[36])
+	m.Tslc = buf[37]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAdsbVehicle) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[246]
+}
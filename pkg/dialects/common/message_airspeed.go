@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Airspeed information from a sensor.
 type MessageAirspeed struct {
 	// Sensor ID.
@@ -20,3 +27,39 @@ type MessageAirspeed struct {
 func (*MessageAirspeed) GetID() uint32 {
 	return 295
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAirspeed) MAVLinkCRCExtra() byte {
+	return 234
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAirspeed) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirspeed) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Airspeed))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.RawPress))
+	binary.LittleEndian.PutUint16(buf[8:], uint16(m.Temperature))
+	buf[10] = m.Id
+	buf[11] = byte(m.Flags)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirspeed) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.RawPress = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[8:]))
+	m.Id = buf[10]
+	m.Flags = AIRSPEED_SENSOR_FLAGS(buf[11])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAirspeed) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[295]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The location and information of an AIS vessel
 type MessageAisVessel struct {
 	// Mobile Marine Service Identifier, 9 decimal digits
@@ -44,3 +51,71 @@ type MessageAisVessel struct {
 func (*MessageAisVessel) GetID() uint32 {
 	return 301
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAisVessel) MAVLinkCRCExtra() byte {
+	return 243
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAisVessel) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 58
+	}
+	return 58
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAisVessel) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Mmsi)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lon))
+	binary.LittleEndian.PutUint16(buf[12:], m.Cog)
+	binary.LittleEndian.PutUint16(buf[14:], m.Heading)
+	binary.LittleEndian.PutUint16(buf[16:], m.Velocity)
+	binary.LittleEndian.PutUint16(buf[18:], m.DimensionBow)
+	binary.LittleEndian.PutUint16(buf[20:], m.DimensionStern)
+	binary.LittleEndian.PutUint16(buf[22:], m.Tslc)
+	binary.LittleEndian.PutUint16(buf[24:], uint16(m.Flags))
+	buf[26] = byte(m.TurnRate)
+	buf[27] = byte(m.NavigationalStatus)
+	buf[28] = byte(m.Type)
+	buf[29] = m.DimensionPort
+	buf[30] = m.DimensionStarboard
+	copy(buf[31:38], m.Callsign)
+	copy(buf[38:58], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAisVessel) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Mmsi = binary.LittleEndian.Uint32(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Cog = binary.LittleEndian.Uint16(buf[12:])
+	m.Heading = binary.LittleEndian.Uint16(buf[14:])
+	m.Velocity = binary.LittleEndian.Uint16(buf[16:])
+	m.DimensionBow = binary.LittleEndian.Uint16(buf[18:])
+	m.DimensionStern = binary.LittleEndian.Uint16(buf[20:])
+	m.Tslc = binary.LittleEndian.Uint16(buf[22:])
+	m.Flags = AIS_FLAGS(binary.LittleEndian.Uint16(buf[24:]))
+	m.TurnRate = int8(buf[26])
+	m.NavigationalStatus = AIS_NAV_STATUS(buf[27])
+	m.Type = AIS_TYPE(buf[28])
+	m.DimensionPort = buf[29]
+	m.DimensionStarboard = buf[30]
+	if n := bytes.IndexByte(buf[31:38], 0); n >= 0 {
+		m.Callsign = string(buf[31 : 31+n])
+	} else {
+		m.Callsign = string(buf[31:38])
+	}
+	if n := bytes.IndexByte(buf[38:58], 0); n >= 0 {
+		m.Name = string(buf[38 : 38+n])
+	} else {
+		m.Name = string(buf[38:58])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAisVessel) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[301]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reports the current commanded attitude of the vehicle as specified by the autopilot. This should match the commands sent in a SET_ATTITUDE_TARGET message if the vehicle is being controlled this way.
 type MessageAttitudeTarget struct {
 	// Timestamp (time since system boot).
@@ -24,3 +31,47 @@ type MessageAttitudeTarget struct {
 func (*MessageAttitudeTarget) GetID() uint32 {
 	return 83
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAttitudeTarget) MAVLinkCRCExtra() byte {
+	return 22
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAttitudeTarget) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 37
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAttitudeTarget) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[4+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.BodyRollRate))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.BodyPitchRate))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.BodyYawRate))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Thrust))
+	buf[36] = byte(m.TypeMask)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAttitudeTarget) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4+i*4:]))
+	}
+	m.BodyRollRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.BodyPitchRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.BodyYawRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.TypeMask = ATTITUDE_TARGET_TYPEMASK(buf[36])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAttitudeTarget) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[83]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Emit an encrypted signature / key identifying this system. PLEASE NOTE: This protocol has been kept simple, so transmitting the key requires an encrypted channel for true safety.
 type MessageAuthKey struct {
 	// key
@@ -12,3 +18,35 @@ type MessageAuthKey struct {
 func (*MessageAuthKey) GetID() uint32 {
 	return 7
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAuthKey) MAVLinkCRCExtra() byte {
+	return 119
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAuthKey) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAuthKey) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:32], m.Key)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAuthKey) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:32], 0); n >= 0 {
+		m.Key = string(buf[0 : 0+n])
+	} else {
+		m.Key = string(buf[0:32])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAuthKey) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[7]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Low level message containing autopilot state relevant for a gimbal device. This message is to be sent from the autopilot to the gimbal device component. The data of this message are for the gimbal device's estimator corrections, in particular horizon compensation, as well as indicates autopilot control intentions, e.g. feed forward angular control in the z-axis.
 type MessageAutopilotStateForGimbalDevice struct {
 	// System ID
@@ -36,3 +43,65 @@ type MessageAutopilotStateForGimbalDevice struct {
 func (*MessageAutopilotStateForGimbalDevice) GetID() uint32 {
 	return 286
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAutopilotStateForGimbalDevice) MAVLinkCRCExtra() byte {
+	return 210
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAutopilotStateForGimbalDevice) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 57
+	}
+	return 53
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotStateForGimbalDevice) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeBootUs)
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[8+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[24:], m.QEstimatedDelayUs)
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Vx))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Vy))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Vz))
+	binary.LittleEndian.PutUint32(buf[40:], m.VEstimatedDelayUs)
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.FeedForwardAngularVelocityZ))
+	binary.LittleEndian.PutUint16(buf[48:], uint16(m.EstimatorStatus))
+	buf[50] = m.TargetSystem
+	buf[51] = m.TargetComponent
+	buf[52] = byte(m.LandedState)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[53:], math.Float32bits(m.AngularVelocityZ))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotStateForGimbalDevice) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootUs = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[8+i*4:]))
+	}
+	m.QEstimatedDelayUs = binary.LittleEndian.Uint32(buf[24:])
+	m.Vx = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Vy = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Vz = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.VEstimatedDelayUs = binary.LittleEndian.Uint32(buf[40:])
+	m.FeedForwardAngularVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.EstimatorStatus = ESTIMATOR_STATUS_FLAGS(binary.LittleEndian.Uint16(buf[48:]))
+	m.TargetSystem = buf[50]
+	m.TargetComponent = buf[51]
+	m.LandedState = MAV_LANDED_STATE(buf[52])
+	if !isV2 {
+		return
+	}
+	m.AngularVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[53:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAutopilotStateForGimbalDevice) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[286]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a flight mode.
 // The message can be enumerated to get information for all modes, or requested for a particular mode, using MAV_CMD_REQUEST_MESSAGE.
 // Specify 0 in param2 to request that the message is emitted for all available modes or the specific index for just one mode.
@@ -30,3 +37,53 @@ type MessageAvailableModes struct {
 func (*MessageAvailableModes) GetID() uint32 {
 	return 435
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvailableModes) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvailableModes) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 47
+	}
+	return 46
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvailableModes) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Properties))
+	buf[8] = m.NumberModes
+	buf[9] = m.ModeIndex
+	buf[10] = byte(m.StandardMode)
+	copy(buf[11:46], m.ModeName)
+	if !isV2 {
+		return
+	}
+	buf[46] = m.Seq
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvailableModes) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.Properties = MAV_MODE_PROPERTY(binary.LittleEndian.Uint32(buf[4:]))
+	m.NumberModes = buf[8]
+	m.ModeIndex = buf[9]
+	m.StandardMode = MAV_STANDARD_MODE(buf[10])
+	if n := bytes.IndexByte(buf[11:46], 0); n >= 0 {
+		m.ModeName = string(buf[11 : 11+n])
+	} else {
+		m.ModeName = string(buf[11:46])
+	}
+	if !isV2 {
+		return
+	}
+	m.Seq = buf[46]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvailableModes) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[435]
+}
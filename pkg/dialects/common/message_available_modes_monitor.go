@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A change to the sequence number indicates that the set of AVAILABLE_MODES has changed, and that the receiver should re-request all available modes.
 // The message is optional, and is only needed when the set of modes can change dynamically after boot.
 // It should be emitted whenever the set of modes change.
@@ -16,3 +20,31 @@ type MessageAvailableModesMonitor struct {
 func (*MessageAvailableModesMonitor) GetID() uint32 {
 	return 437
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAvailableModesMonitor) MAVLinkCRCExtra() byte {
+	return 30
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAvailableModesMonitor) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 1
+	}
+	return 1
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvailableModesMonitor) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.Seq
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAvailableModesMonitor) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = buf[0]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAvailableModesMonitor) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[437]
+}
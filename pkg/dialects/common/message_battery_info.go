@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Battery information that is static, or requires infrequent update.
 // This message should requested using MAV_CMD_REQUEST_MESSAGE and/or streamed at very low rate.
 // BATTERY_STATUS_V2 is used for higher-rate battery status information.
@@ -52,3 +60,81 @@ type MessageBatteryInfo struct {
 func (*MessageBatteryInfo) GetID() uint32 {
 	return 372
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageBatteryInfo) MAVLinkCRCExtra() byte {
+	return 26
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageBatteryInfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 140
+	}
+	return 140
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryInfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.DischargeMinimumVoltage))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.ChargingMinimumVoltage))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.RestingMinimumVoltage))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.ChargingMaximumVoltage))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.ChargingMaximumCurrent))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.NominalVoltage))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.DischargeMaximumCurrent))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.DischargeMaximumBurstCurrent))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.DesignCapacity))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.FullChargeCapacity))
+	binary.LittleEndian.PutUint16(buf[40:], m.CycleCount)
+	binary.LittleEndian.PutUint16(buf[42:], m.Weight)
+	buf[44] = m.Id
+	buf[45] = byte(m.BatteryFunction)
+	buf[46] = byte(m.Type)
+	buf[47] = m.StateOfHealth
+	buf[48] = m.CellsInSeries
+	copy(buf[49:58], m.ManufactureDate)
+	copy(buf[58:90], m.SerialNumber)
+	copy(buf[90:140], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryInfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DischargeMinimumVoltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.ChargingMinimumVoltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.RestingMinimumVoltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.ChargingMaximumVoltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.ChargingMaximumCurrent = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.NominalVoltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.DischargeMaximumCurrent = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.DischargeMaximumBurstCurrent = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.DesignCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.FullChargeCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.CycleCount = binary.LittleEndian.Uint16(buf[40:])
+	m.Weight = binary.LittleEndian.Uint16(buf[42:])
+	m.Id = buf[44]
+	m.BatteryFunction = MAV_BATTERY_FUNCTION(buf[45])
+	m.Type = MAV_BATTERY_TYPE(buf[46])
+	m.StateOfHealth = buf[47]
+	m.CellsInSeries = buf[48]
+	if n := bytes.IndexByte(buf[49:58], 0); n >= 0 {
+		m.ManufactureDate = string(buf[49 : 49+n])
+	} else {
+		m.ManufactureDate = string(buf[49:58])
+	}
+	if n := bytes.IndexByte(buf[58:90], 0); n >= 0 {
+		m.SerialNumber = string(buf[58 : 58+n])
+	} else {
+		m.SerialNumber = string(buf[58:90])
+	}
+	if n := bytes.IndexByte(buf[90:140], 0); n >= 0 {
+		m.Name = string(buf[90 : 90+n])
+	} else {
+		m.Name = string(buf[90:140])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageBatteryInfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[372]
+}
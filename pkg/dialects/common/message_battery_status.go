@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Battery information. Updates GCS with flight controller battery status. Smart batteries also use this message, but may additionally send BATTERY_INFO.
 type MessageBatteryStatus struct {
 	// Battery ID
@@ -38,3 +44,71 @@ type MessageBatteryStatus struct {
 func (*MessageBatteryStatus) GetID() uint32 {
 	return 147
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageBatteryStatus) MAVLinkCRCExtra() byte {
+	return 154
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageBatteryStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 54
+	}
+	return 36
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.CurrentConsumed))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.EnergyConsumed))
+	binary.LittleEndian.PutUint16(buf[8:], uint16(m.Temperature))
+	for i, v := range m.Voltages {
+		binary.LittleEndian.PutUint16(buf[10+i*2:], v)
+	}
+	binary.LittleEndian.PutUint16(buf[30:], uint16(m.CurrentBattery))
+	buf[32] = m.Id
+	buf[33] = byte(m.BatteryFunction)
+	buf[34] = byte(m.Type)
+	buf[35] = byte(m.BatteryRemaining)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[36:], uint32(m.TimeRemaining))
+	buf[40] = byte(m.ChargeState)
+	for i, v := range m.VoltagesExt {
+		binary.LittleEndian.PutUint16(buf[41+i*2:], v)
+	}
+	buf[49] = byte(m.Mode)
+	binary.LittleEndian.PutUint32(buf[50:], uint32(m.FaultBitmask))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CurrentConsumed = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.EnergyConsumed = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[8:]))
+	for i := range m.Voltages {
+		m.Voltages[i] = binary.LittleEndian.Uint16(buf[10+i*2:])
+	}
+	m.CurrentBattery = int16(binary.LittleEndian.Uint16(buf[30:]))
+	m.Id = buf[32]
+	m.BatteryFunction = MAV_BATTERY_FUNCTION(buf[33])
+	m.Type = MAV_BATTERY_TYPE(buf[34])
+	m.BatteryRemaining = int8(buf[35])
+	if !isV2 {
+		return
+	}
+	m.TimeRemaining = int32(binary.LittleEndian.Uint32(buf[36:]))
+	m.ChargeState = MAV_BATTERY_CHARGE_STATE(buf[40])
+	for i := range m.VoltagesExt {
+		m.VoltagesExt[i] = binary.LittleEndian.Uint16(buf[41+i*2:])
+	}
+	m.Mode = MAV_BATTERY_MODE(buf[49])
+	m.FaultBitmask = MAV_BATTERY_FAULT(binary.LittleEndian.Uint32(buf[50:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageBatteryStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[147]
+}
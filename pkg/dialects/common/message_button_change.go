@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Report button state change.
 type MessageButtonChange struct {
 	// Timestamp (time since system boot).
@@ -16,3 +22,35 @@ type MessageButtonChange struct {
 func (*MessageButtonChange) GetID() uint32 {
 	return 257
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageButtonChange) MAVLinkCRCExtra() byte {
+	return 131
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageButtonChange) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageButtonChange) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.LastChangeMs)
+	buf[8] = m.State
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageButtonChange) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.LastChangeMs = binary.LittleEndian.Uint32(buf[4:])
+	m.State = buf[8]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageButtonChange) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[257]
+}
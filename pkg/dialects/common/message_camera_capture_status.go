@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about the status of a capture. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.
 type MessageCameraCaptureStatus struct {
 	// Timestamp (time since system boot).
@@ -26,3 +33,51 @@ type MessageCameraCaptureStatus struct {
 func (*MessageCameraCaptureStatus) GetID() uint32 {
 	return 262
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraCaptureStatus) MAVLinkCRCExtra() byte {
+	return 12
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraCaptureStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 23
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraCaptureStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.ImageInterval))
+	binary.LittleEndian.PutUint32(buf[8:], m.RecordingTimeMs)
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AvailableCapacity))
+	buf[16] = m.ImageStatus
+	buf[17] = m.VideoStatus
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[18:], uint32(m.ImageCount))
+	buf[22] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraCaptureStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.ImageInterval = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.RecordingTimeMs = binary.LittleEndian.Uint32(buf[8:])
+	m.AvailableCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.ImageStatus = buf[16]
+	m.VideoStatus = buf[17]
+	if !isV2 {
+		return
+	}
+	m.ImageCount = int32(binary.LittleEndian.Uint32(buf[18:]))
+	m.CameraDeviceId = buf[22]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraCaptureStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[262]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about the field of view of a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.
 type MessageCameraFovStatus struct {
 	// Timestamp (time since system boot).
@@ -32,3 +39,61 @@ type MessageCameraFovStatus struct {
 func (*MessageCameraFovStatus) GetID() uint32 {
 	return 271
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraFovStatus) MAVLinkCRCExtra() byte {
+	return 22
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraFovStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 53
+	}
+	return 52
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraFovStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.LatCamera))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.LonCamera))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.AltCamera))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.LatImage))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.LonImage))
+	binary.LittleEndian.PutUint32(buf[24:], uint32(m.AltImage))
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[28+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.Hfov))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.Vfov))
+	if !isV2 {
+		return
+	}
+	buf[52] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraFovStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.LatCamera = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.LonCamera = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.AltCamera = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.LatImage = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.LonImage = int32(binary.LittleEndian.Uint32(buf[20:]))
+	m.AltImage = int32(binary.LittleEndian.Uint32(buf[24:]))
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[28+i*4:]))
+	}
+	m.Hfov = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.Vfov = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	if !isV2 {
+		return
+	}
+	m.CameraDeviceId = buf[52]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraFovStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[271]
+}
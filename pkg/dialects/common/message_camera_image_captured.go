@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a captured image. This is emitted every time a message is captured.
 // MAV_CMD_REQUEST_MESSAGE can be used to (re)request this message for a specific sequence number or range of sequence numbers:
 // MAV_CMD_REQUEST_MESSAGE.param2 indicates the sequence number the first image to send, or set to -1 to send the message for all sequence numbers.
@@ -38,3 +46,59 @@ type MessageCameraImageCaptured struct {
 func (*MessageCameraImageCaptured) GetID() uint32 {
 	return 263
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraImageCaptured) MAVLinkCRCExtra() byte {
+	return 133
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraImageCaptured) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 255
+	}
+	return 255
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraImageCaptured) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUtc)
+	binary.LittleEndian.PutUint32(buf[8:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.Alt))
+	binary.LittleEndian.PutUint32(buf[24:], uint32(m.RelativeAlt))
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[28+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[44:], uint32(m.ImageIndex))
+	buf[48] = m.CameraId
+	buf[49] = byte(m.CaptureResult)
+	copy(buf[50:255], m.FileUrl)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraImageCaptured) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUtc = binary.LittleEndian.Uint64(buf[0:])
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[8:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Alt = int32(binary.LittleEndian.Uint32(buf[20:]))
+	m.RelativeAlt = int32(binary.LittleEndian.Uint32(buf[24:]))
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[28+i*4:]))
+	}
+	m.ImageIndex = int32(binary.LittleEndian.Uint32(buf[44:]))
+	m.CameraId = buf[48]
+	m.CaptureResult = MAV_BOOL(buf[49])
+	if n := bytes.IndexByte(buf[50:255], 0); n >= 0 {
+		m.FileUrl = string(buf[50 : 50+n])
+	} else {
+		m.FileUrl = string(buf[50:255])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraImageCaptured) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[263]
+}
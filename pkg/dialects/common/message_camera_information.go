@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.
 type MessageCameraInformation struct {
 	// Timestamp (time since system boot).
@@ -40,3 +48,77 @@ type MessageCameraInformation struct {
 func (*MessageCameraInformation) GetID() uint32 {
 	return 259
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraInformation) MAVLinkCRCExtra() byte {
+	return 92
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 237
+	}
+	return 235
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.FirmwareVersion)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.FocalLength))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.SensorSizeH))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.SensorSizeV))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.Flags))
+	binary.LittleEndian.PutUint16(buf[24:], m.ResolutionH)
+	binary.LittleEndian.PutUint16(buf[26:], m.ResolutionV)
+	binary.LittleEndian.PutUint16(buf[28:], m.CamDefinitionVersion)
+	for i, v := range m.VendorName {
+		buf[30+i] = v
+	}
+	for i, v := range m.ModelName {
+		buf[62+i] = v
+	}
+	buf[94] = m.LensId
+	copy(buf[95:235], m.CamDefinitionUri)
+	if !isV2 {
+		return
+	}
+	buf[235] = m.GimbalDeviceId
+	buf[236] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.FirmwareVersion = binary.LittleEndian.Uint32(buf[4:])
+	m.FocalLength = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.SensorSizeH = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.SensorSizeV = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Flags = CAMERA_CAP_FLAGS(binary.LittleEndian.Uint32(buf[20:]))
+	m.ResolutionH = binary.LittleEndian.Uint16(buf[24:])
+	m.ResolutionV = binary.LittleEndian.Uint16(buf[26:])
+	m.CamDefinitionVersion = binary.LittleEndian.Uint16(buf[28:])
+	for i := range m.VendorName {
+		m.VendorName[i] = buf[30+i]
+	}
+	for i := range m.ModelName {
+		m.ModelName[i] = buf[62+i]
+	}
+	m.LensId = buf[94]
+	if n := bytes.IndexByte(buf[95:235], 0); n >= 0 {
+		m.CamDefinitionUri = string(buf[95 : 95+n])
+	} else {
+		m.CamDefinitionUri = string(buf[95:235])
+	}
+	if !isV2 {
+		return
+	}
+	m.GimbalDeviceId = buf[235]
+	m.CameraDeviceId = buf[236]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[259]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Settings of a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.
 type MessageCameraSettings struct {
 	// Timestamp (time since system boot).
@@ -20,3 +27,45 @@ type MessageCameraSettings struct {
 func (*MessageCameraSettings) GetID() uint32 {
 	return 260
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraSettings) MAVLinkCRCExtra() byte {
+	return 146
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraSettings) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 5
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraSettings) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	buf[4] = byte(m.ModeId)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[5:], math.Float32bits(m.Zoomlevel))
+	binary.LittleEndian.PutUint32(buf[9:], math.Float32bits(m.Focuslevel))
+	buf[13] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraSettings) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.ModeId = CAMERA_MODE(buf[4])
+	if !isV2 {
+		return
+	}
+	m.Zoomlevel = math.Float32frombits(binary.LittleEndian.Uint32(buf[5:]))
+	m.Focuslevel = math.Float32frombits(binary.LittleEndian.Uint32(buf[9:]))
+	m.CameraDeviceId = buf[13]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraSettings) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[260]
+}
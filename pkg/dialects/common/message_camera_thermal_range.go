@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera absolute thermal range. This can be streamed when the associated VIDEO_STREAM_STATUS `flag` field bit VIDEO_STREAM_STATUS_FLAGS_THERMAL_RANGE_ENABLED is set, but a GCS may choose to only request it for the current active stream. Use MAV_CMD_SET_MESSAGE_INTERVAL to define message interval (param3 indicates the stream id of the current camera, or 0 for all streams, param4 indicates the target camera_device_id for autopilot-attached cameras or 0 for MAVLink cameras).
 type MessageCameraThermalRange struct {
 	// Timestamp (time since system boot).
@@ -28,3 +35,47 @@ type MessageCameraThermalRange struct {
 func (*MessageCameraThermalRange) GetID() uint32 {
 	return 277
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraThermalRange) MAVLinkCRCExtra() byte {
+	return 62
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraThermalRange) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 30
+	}
+	return 30
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraThermalRange) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Max))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.MaxPointX))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.MaxPointY))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Min))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.MinPointX))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.MinPointY))
+	buf[28] = m.StreamId
+	buf[29] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraThermalRange) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Max = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.MaxPointX = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.MaxPointY = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Min = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.MinPointX = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.MinPointY = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.StreamId = buf[28]
+	m.CameraDeviceId = buf[29]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraThermalRange) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[277]
+}
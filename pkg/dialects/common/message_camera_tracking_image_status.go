@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera tracking status, sent while in active tracking. Use MAV_CMD_SET_MESSAGE_INTERVAL to define message interval.
 type MessageCameraTrackingImageStatus struct {
 	// Current tracking status
@@ -32,3 +39,57 @@ type MessageCameraTrackingImageStatus struct {
 func (*MessageCameraTrackingImageStatus) GetID() uint32 {
 	return 275
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraTrackingImageStatus) MAVLinkCRCExtra() byte {
+	return 126
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraTrackingImageStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 31
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraTrackingImageStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.PointX))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.PointY))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Radius))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.RecTopX))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.RecTopY))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.RecBottomX))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.RecBottomY))
+	buf[28] = byte(m.TrackingStatus)
+	buf[29] = byte(m.TrackingMode)
+	buf[30] = byte(m.TargetData)
+	if !isV2 {
+		return
+	}
+	buf[31] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraTrackingImageStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.PointX = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.PointY = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Radius = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.RecTopX = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.RecTopY = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.RecBottomX = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.RecBottomY = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.TrackingStatus = CAMERA_TRACKING_STATUS_FLAGS(buf[28])
+	m.TrackingMode = CAMERA_TRACKING_MODE(buf[29])
+	m.TargetData = CAMERA_TRACKING_TARGET_DATA(buf[30])
+	if !isV2 {
+		return
+	}
+	m.CameraDeviceId = buf[31]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraTrackingImageStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[275]
+}
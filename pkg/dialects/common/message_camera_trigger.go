@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Camera-IMU triggering and synchronisation message.
 type MessageCameraTrigger struct {
 	// Timestamp for image frame (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -14,3 +20,33 @@ type MessageCameraTrigger struct {
 func (*MessageCameraTrigger) GetID() uint32 {
 	return 112
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCameraTrigger) MAVLinkCRCExtra() byte {
+	return 174
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCameraTrigger) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraTrigger) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.Seq)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCameraTrigger) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Seq = binary.LittleEndian.Uint32(buf[8:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCameraTrigger) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[112]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Modify the filter of what CAN messages to forward over the mavlink. This can be used to make CAN forwarding work well on low bandwidth links. The filtering is applied on bits 8 to 24 of the CAN id (2nd and 3rd bytes) which corresponds to the DroneCAN message ID for DroneCAN. Filters with more than 16 IDs can be constructed by sending multiple CAN_FILTER_MODIFY messages.
 type MessageCanFilterModify struct {
 	// System ID.
@@ -22,3 +28,45 @@ type MessageCanFilterModify struct {
 func (*MessageCanFilterModify) GetID() uint32 {
 	return 388
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCanFilterModify) MAVLinkCRCExtra() byte {
+	return 8
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCanFilterModify) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 37
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCanFilterModify) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Ids {
+		binary.LittleEndian.PutUint16(buf[i*2:], v)
+	}
+	buf[32] = m.TargetSystem
+	buf[33] = m.TargetComponent
+	buf[34] = m.Bus
+	buf[35] = byte(m.Operation)
+	buf[36] = m.NumIds
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCanFilterModify) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Ids {
+		m.Ids[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+	m.TargetSystem = buf[32]
+	m.TargetComponent = buf[33]
+	m.Bus = buf[34]
+	m.Operation = CAN_FILTER_OP(buf[35])
+	m.NumIds = buf[36]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCanFilterModify) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[388]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A forwarded CANFD frame as requested by MAV_CMD_CAN_FORWARD. These are separated from CAN_FRAME as they need different handling (eg. TAO handling)
 type MessageCanfdFrame struct {
 	// System ID.
@@ -22,3 +28,45 @@ type MessageCanfdFrame struct {
 func (*MessageCanfdFrame) GetID() uint32 {
 	return 387
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCanfdFrame) MAVLinkCRCExtra() byte {
+	return 4
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCanfdFrame) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 72
+	}
+	return 72
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCanfdFrame) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Id)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = m.Bus
+	buf[7] = m.Len
+	for i, v := range m.Data {
+		buf[8+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCanfdFrame) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Id = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.Bus = buf[6]
+	m.Len = buf[7]
+	for i := range m.Data {
+		m.Data[i] = buf[8+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCanfdFrame) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[387]
+}
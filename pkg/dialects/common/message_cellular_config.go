@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Configure cellular modems.
 // This message is re-emitted as an acknowledgement by the modem.
 // The message may also be explicitly requested using MAV_CMD_REQUEST_MESSAGE.
@@ -28,3 +34,61 @@ type MessageCellularConfig struct {
 func (*MessageCellularConfig) GetID() uint32 {
 	return 336
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCellularConfig) MAVLinkCRCExtra() byte {
+	return 245
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCellularConfig) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 84
+	}
+	return 84
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCellularConfig) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.EnableLte
+	buf[1] = m.EnablePin
+	copy(buf[2:18], m.Pin)
+	copy(buf[18:34], m.NewPin)
+	copy(buf[34:66], m.Apn)
+	copy(buf[66:82], m.Puk)
+	buf[82] = m.Roaming
+	buf[83] = byte(m.Response)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCellularConfig) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.EnableLte = buf[0]
+	m.EnablePin = buf[1]
+	if n := bytes.IndexByte(buf[2:18], 0); n >= 0 {
+		m.Pin = string(buf[2 : 2+n])
+	} else {
+		m.Pin = string(buf[2:18])
+	}
+	if n := bytes.IndexByte(buf[18:34], 0); n >= 0 {
+		m.NewPin = string(buf[18 : 18+n])
+	} else {
+		m.NewPin = string(buf[18:34])
+	}
+	if n := bytes.IndexByte(buf[34:66], 0); n >= 0 {
+		m.Apn = string(buf[34 : 34+n])
+	} else {
+		m.Apn = string(buf[34:66])
+	}
+	if n := bytes.IndexByte(buf[66:82], 0); n >= 0 {
+		m.Puk = string(buf[66 : 66+n])
+	} else {
+		m.Puk = string(buf[66:82])
+	}
+	m.Roaming = buf[82]
+	m.Response = CELLULAR_CONFIG_RESPONSE(buf[83])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCellularConfig) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[336]
+}
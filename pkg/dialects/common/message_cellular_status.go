@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Cellular network status as reported by a particular modem.
 // This is primarily intended for logging, but a GCS may choose to display link_tx_rate and link_rx_rate.
 // Note that a value of 0 in the id field indicates that the sender does not support reporting of multiple modems.
@@ -49,3 +57,75 @@ type MessageCellularStatus struct {
 func (*MessageCellularStatus) GetID() uint32 {
 	return 334
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCellularStatus) MAVLinkCRCExtra() byte {
+	return 72
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCellularStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 53
+	}
+	return 10
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCellularStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Mcc)
+	binary.LittleEndian.PutUint16(buf[2:], m.Mnc)
+	binary.LittleEndian.PutUint16(buf[4:], m.Lac)
+	buf[6] = byte(m.Status)
+	buf[7] = byte(m.FailureReason)
+	buf[8] = byte(m.Type)
+	buf[9] = m.Quality
+	if !isV2 {
+		return
+	}
+	buf[10] = m.Id
+	binary.LittleEndian.PutUint32(buf[11:], m.LinkTxRate)
+	binary.LittleEndian.PutUint32(buf[15:], m.LinkRxRate)
+	copy(buf[19:28], m.CellTowerId)
+	buf[28] = m.BandNumber
+	binary.LittleEndian.PutUint32(buf[29:], math.Float32bits(m.BandFrequency))
+	binary.LittleEndian.PutUint32(buf[33:], m.ChannelNumber)
+	binary.LittleEndian.PutUint32(buf[37:], math.Float32bits(m.RxLevel))
+	binary.LittleEndian.PutUint32(buf[41:], math.Float32bits(m.TxLevel))
+	binary.LittleEndian.PutUint32(buf[45:], math.Float32bits(m.RxQuality))
+	binary.LittleEndian.PutUint32(buf[49:], math.Float32bits(m.Sinr))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCellularStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Mcc = binary.LittleEndian.Uint16(buf[0:])
+	m.Mnc = binary.LittleEndian.Uint16(buf[2:])
+	m.Lac = binary.LittleEndian.Uint16(buf[4:])
+	m.Status = CELLULAR_STATUS_FLAG(buf[6])
+	m.FailureReason = CELLULAR_NETWORK_FAILED_REASON(buf[7])
+	m.Type = CELLULAR_NETWORK_RADIO_TYPE(buf[8])
+	m.Quality = buf[9]
+	if !isV2 {
+		return
+	}
+	m.Id = buf[10]
+	m.LinkTxRate = binary.LittleEndian.Uint32(buf[11:])
+	m.LinkRxRate = binary.LittleEndian.Uint32(buf[15:])
+	if n := bytes.IndexByte(buf[19:28], 0); n >= 0 {
+		m.CellTowerId = string(buf[19 : 19+n])
+	} else {
+		m.CellTowerId = string(buf[19:28])
+	}
+	m.BandNumber = buf[28]
+	m.BandFrequency = math.Float32frombits(binary.LittleEndian.Uint32(buf[29:]))
+	m.ChannelNumber = binary.LittleEndian.Uint32(buf[33:])
+	m.RxLevel = math.Float32frombits(binary.LittleEndian.Uint32(buf[37:]))
+	m.TxLevel = math.Float32frombits(binary.LittleEndian.Uint32(buf[41:]))
+	m.RxQuality = math.Float32frombits(binary.LittleEndian.Uint32(buf[45:]))
+	m.Sinr = math.Float32frombits(binary.LittleEndian.Uint32(buf[49:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCellularStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[334]
+}
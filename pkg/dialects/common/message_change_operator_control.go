@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request to control this MAV
 type MessageChangeOperatorControl struct {
 	// System the GCS requests control for
@@ -18,3 +24,41 @@ type MessageChangeOperatorControl struct {
 func (*MessageChangeOperatorControl) GetID() uint32 {
 	return 5
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageChangeOperatorControl) MAVLinkCRCExtra() byte {
+	return 217
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageChangeOperatorControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageChangeOperatorControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.ControlRequest
+	buf[2] = m.Version
+	copy(buf[3:28], m.Passkey)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageChangeOperatorControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.ControlRequest = buf[1]
+	m.Version = buf[2]
+	if n := bytes.IndexByte(buf[3:28], 0); n >= 0 {
+		m.Passkey = string(buf[3 : 3+n])
+	} else {
+		m.Passkey = string(buf[3:28])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageChangeOperatorControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[5]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Accept / deny control of this MAV
 type MessageChangeOperatorControlAck struct {
 	// ID of the GCS this message
@@ -16,3 +20,35 @@ type MessageChangeOperatorControlAck struct {
 func (*MessageChangeOperatorControlAck) GetID() uint32 {
 	return 6
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageChangeOperatorControlAck) MAVLinkCRCExtra() byte {
+	return 104
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageChangeOperatorControlAck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageChangeOperatorControlAck) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.GcsSystemId
+	buf[1] = m.ControlRequest
+	buf[2] = m.Ack
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageChangeOperatorControlAck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.GcsSystemId = buf[0]
+	m.ControlRequest = buf[1]
+	m.Ack = buf[2]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageChangeOperatorControlAck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[6]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a potential collision
 type MessageCollision struct {
 	// Collision data source
@@ -24,3 +31,43 @@ type MessageCollision struct {
 func (*MessageCollision) GetID() uint32 {
 	return 247
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCollision) MAVLinkCRCExtra() byte {
+	return 81
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCollision) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 19
+	}
+	return 19
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCollision) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Id)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.TimeToMinimumDelta))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.AltitudeMinimumDelta))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.HorizontalMinimumDelta))
+	buf[16] = byte(m.Src)
+	buf[17] = byte(m.Action)
+	buf[18] = byte(m.ThreatLevel)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCollision) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Id = binary.LittleEndian.Uint32(buf[0:])
+	m.TimeToMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.AltitudeMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.HorizontalMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Src = MAV_COLLISION_SRC(buf[16])
+	m.Action = MAV_COLLISION_ACTION(buf[17])
+	m.ThreatLevel = MAV_COLLISION_THREAT_LEVEL(buf[18])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCollision) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[247]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Report status of a command. Includes feedback whether the command was executed. The command microservice is documented at https://mavlink.io/en/services/command.html
 type MessageCommandAck struct {
 	// Command ID (of acknowledged command).
@@ -22,3 +28,47 @@ type MessageCommandAck struct {
 func (*MessageCommandAck) GetID() uint32 {
 	return 77
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCommandAck) MAVLinkCRCExtra() byte {
+	return 143
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCommandAck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 10
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandAck) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.Command))
+	buf[2] = byte(m.Result)
+	if !isV2 {
+		return
+	}
+	buf[3] = m.Progress
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.ResultParam2))
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandAck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Command = MAV_CMD(binary.LittleEndian.Uint16(buf[0:]))
+	m.Result = MAV_RESULT(buf[2])
+	if !isV2 {
+		return
+	}
+	m.Progress = buf[3]
+	m.ResultParam2 = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCommandAck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[77]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Cancel a long running command. The target system should respond with a COMMAND_ACK to the original command with result=MAV_RESULT_CANCELLED if the long running process was cancelled. If it has already completed, the cancel action can be ignored. The cancel action can be retried until some sort of acknowledgement to the original command has been received. The command microservice is documented at https://mavlink.io/en/services/command.html
 type MessageCommandCancel struct {
 	// System executing long running command. Should not be broadcast (0).
@@ -16,3 +22,35 @@ type MessageCommandCancel struct {
 func (*MessageCommandCancel) GetID() uint32 {
 	return 80
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCommandCancel) MAVLinkCRCExtra() byte {
+	return 14
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCommandCancel) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandCancel) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.Command))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCommandCancel) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Command = MAV_CMD(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCommandCancel) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[80]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Component information message, which may be requested using MAV_CMD_REQUEST_MESSAGE.
 type MessageComponentInformation struct {
 	// Timestamp (time since system boot).
@@ -20,3 +27,47 @@ type MessageComponentInformation struct {
 func (*MessageComponentInformation) GetID() uint32 {
 	return 395
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageComponentInformation) MAVLinkCRCExtra() byte {
+	return 0
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageComponentInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 212
+	}
+	return 212
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.GeneralMetadataFileCrc)
+	binary.LittleEndian.PutUint32(buf[8:], m.PeripheralsMetadataFileCrc)
+	copy(buf[12:112], m.GeneralMetadataUri)
+	copy(buf[112:212], m.PeripheralsMetadataUri)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.GeneralMetadataFileCrc = binary.LittleEndian.Uint32(buf[4:])
+	m.PeripheralsMetadataFileCrc = binary.LittleEndian.Uint32(buf[8:])
+	if n := bytes.IndexByte(buf[12:112], 0); n >= 0 {
+		m.GeneralMetadataUri = string(buf[12 : 12+n])
+	} else {
+		m.GeneralMetadataUri = string(buf[12:112])
+	}
+	if n := bytes.IndexByte(buf[112:212], 0); n >= 0 {
+		m.PeripheralsMetadataUri = string(buf[112 : 112+n])
+	} else {
+		m.PeripheralsMetadataUri = string(buf[112:212])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageComponentInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[395]
+}
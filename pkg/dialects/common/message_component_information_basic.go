@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Basic component information data. Should be requested using MAV_CMD_REQUEST_MESSAGE on startup, or when required.
 type MessageComponentInformationBasic struct {
 	// Timestamp (time since system boot).
@@ -26,3 +33,65 @@ type MessageComponentInformationBasic struct {
 func (*MessageComponentInformationBasic) GetID() uint32 {
 	return 396
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageComponentInformationBasic) MAVLinkCRCExtra() byte {
+	return 50
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageComponentInformationBasic) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 160
+	}
+	return 160
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentInformationBasic) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], uint64(m.Capabilities))
+	binary.LittleEndian.PutUint32(buf[8:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[12:], m.TimeManufactureS)
+	copy(buf[16:48], m.VendorName)
+	copy(buf[48:80], m.ModelName)
+	copy(buf[80:104], m.SoftwareVersion)
+	copy(buf[104:128], m.HardwareVersion)
+	copy(buf[128:160], m.SerialNumber)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentInformationBasic) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Capabilities = MAV_PROTOCOL_CAPABILITY(binary.LittleEndian.Uint64(buf[0:]))
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[8:])
+	m.TimeManufactureS = binary.LittleEndian.Uint32(buf[12:])
+	if n := bytes.IndexByte(buf[16:48], 0); n >= 0 {
+		m.VendorName = string(buf[16 : 16+n])
+	} else {
+		m.VendorName = string(buf[16:48])
+	}
+	if n := bytes.IndexByte(buf[48:80], 0); n >= 0 {
+		m.ModelName = string(buf[48 : 48+n])
+	} else {
+		m.ModelName = string(buf[48:80])
+	}
+	if n := bytes.IndexByte(buf[80:104], 0); n >= 0 {
+		m.SoftwareVersion = string(buf[80 : 80+n])
+	} else {
+		m.SoftwareVersion = string(buf[80:104])
+	}
+	if n := bytes.IndexByte(buf[104:128], 0); n >= 0 {
+		m.HardwareVersion = string(buf[104 : 104+n])
+	} else {
+		m.HardwareVersion = string(buf[104:128])
+	}
+	if n := bytes.IndexByte(buf[128:160], 0); n >= 0 {
+		m.SerialNumber = string(buf[128 : 128+n])
+	} else {
+		m.SerialNumber = string(buf[128:160])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageComponentInformationBasic) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[396]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Component metadata message, which may be requested using MAV_CMD_REQUEST_MESSAGE.
 // This contains the MAVLink FTP URI and CRC for the component's general metadata file.
 // The file must be hosted on the component, and may be xz compressed.
@@ -22,3 +29,39 @@ type MessageComponentMetadata struct {
 func (*MessageComponentMetadata) GetID() uint32 {
 	return 397
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageComponentMetadata) MAVLinkCRCExtra() byte {
+	return 182
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageComponentMetadata) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 108
+	}
+	return 108
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentMetadata) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.FileCrc)
+	copy(buf[8:108], m.Uri)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageComponentMetadata) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.FileCrc = binary.LittleEndian.Uint32(buf[4:])
+	if n := bytes.IndexByte(buf[8:108], 0); n >= 0 {
+		m.Uri = string(buf[8 : 8+n])
+	} else {
+		m.Uri = string(buf[8:108])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageComponentMetadata) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[397]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Regular broadcast for the current latest event sequence number for a component. This is used to check for dropped events.
 type MessageCurrentEventSequence struct {
 	// Sequence number.
@@ -14,3 +20,33 @@ type MessageCurrentEventSequence struct {
 func (*MessageCurrentEventSequence) GetID() uint32 {
 	return 411
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCurrentEventSequence) MAVLinkCRCExtra() byte {
+	return 106
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCurrentEventSequence) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCurrentEventSequence) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Sequence)
+	buf[2] = byte(m.Flags)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCurrentEventSequence) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint16(buf[0:])
+	m.Flags = MAV_EVENT_CURRENT_SEQUENCE_FLAGS(buf[2])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCurrentEventSequence) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[411]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Get the current mode.
 // This should be emitted on any mode change, and broadcast at low rate (nominally 0.5 Hz).
 // It may be requested using MAV_CMD_REQUEST_MESSAGE.
@@ -19,3 +25,35 @@ type MessageCurrentMode struct {
 func (*MessageCurrentMode) GetID() uint32 {
 	return 436
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCurrentMode) MAVLinkCRCExtra() byte {
+	return 193
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCurrentMode) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCurrentMode) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	binary.LittleEndian.PutUint32(buf[4:], m.IntendedCustomMode)
+	buf[8] = byte(m.StandardMode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCurrentMode) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.IntendedCustomMode = binary.LittleEndian.Uint32(buf[4:])
+	m.StandardMode = MAV_STANDARD_MODE(buf[8])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCurrentMode) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[436]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data stream status information.
 type MessageDataStream struct {
 	// The ID of the requested data stream.
@@ -16,3 +22,35 @@ type MessageDataStream struct {
 func (*MessageDataStream) GetID() uint32 {
 	return 67
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDataStream) MAVLinkCRCExtra() byte {
+	return 21
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDataStream) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDataStream) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.MessageRate)
+	buf[2] = byte(m.StreamId)
+	buf[3] = m.OnOff
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDataStream) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MessageRate = binary.LittleEndian.Uint16(buf[0:])
+	m.StreamId = MAV_DATA_STREAM(buf[2])
+	m.OnOff = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDataStream) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[67]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Handshake message to initiate, control and stop image streaming when using the Image Transmission Protocol: https://mavlink.io/en/services/image_transmission.html.
 type MessageDataTransmissionHandshake struct {
 	// Type of requested/acknowledged data.
@@ -24,3 +30,43 @@ type MessageDataTransmissionHandshake struct {
 func (*MessageDataTransmissionHandshake) GetID() uint32 {
 	return 130
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDataTransmissionHandshake) MAVLinkCRCExtra() byte {
+	return 29
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDataTransmissionHandshake) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 13
+	}
+	return 13
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDataTransmissionHandshake) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Size)
+	binary.LittleEndian.PutUint16(buf[4:], m.Width)
+	binary.LittleEndian.PutUint16(buf[6:], m.Height)
+	binary.LittleEndian.PutUint16(buf[8:], m.Packets)
+	buf[10] = byte(m.Type)
+	buf[11] = m.Payload
+	buf[12] = m.JpgQuality
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDataTransmissionHandshake) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Size = binary.LittleEndian.Uint32(buf[0:])
+	m.Width = binary.LittleEndian.Uint16(buf[4:])
+	m.Height = binary.LittleEndian.Uint16(buf[6:])
+	m.Packets = binary.LittleEndian.Uint16(buf[8:])
+	m.Type = MAVLINK_DATA_STREAM_TYPE(buf[10])
+	m.Payload = buf[11]
+	m.JpgQuality = buf[12]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDataTransmissionHandshake) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[130]
+}
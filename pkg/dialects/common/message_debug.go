@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a debug value. The index is used to discriminate between values. These values show up in the plot of QGroundControl as DEBUG N.
 type MessageDebug struct {
 	// Timestamp (time since system boot).
@@ -16,3 +23,35 @@ type MessageDebug struct {
 func (*MessageDebug) GetID() uint32 {
 	return 254
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDebug) MAVLinkCRCExtra() byte {
+	return 46
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDebug) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebug) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Value))
+	buf[8] = m.Ind
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebug) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Value = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Ind = buf[8]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDebug) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[254]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Large debug/prototyping array. The message uses the maximum available payload for data. The array_id and name fields are used to discriminate between messages in code and in user interfaces (respectively). Do not use in production code.
 type MessageDebugFloatArray struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -18,3 +26,51 @@ type MessageDebugFloatArray struct {
 func (*MessageDebugFloatArray) GetID() uint32 {
 	return 350
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDebugFloatArray) MAVLinkCRCExtra() byte {
+	return 232
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDebugFloatArray) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 252
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebugFloatArray) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint16(buf[8:], m.ArrayId)
+	copy(buf[10:20], m.Name)
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Data {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], math.Float32bits(v))
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebugFloatArray) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.ArrayId = binary.LittleEndian.Uint16(buf[8:])
+	if n := bytes.IndexByte(buf[10:20], 0); n >= 0 {
+		m.Name = string(buf[10 : 10+n])
+	} else {
+		m.Name = string(buf[10:20])
+	}
+	if !isV2 {
+		return
+	}
+	for i := range m.Data {
+		m.Data[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[20+i*4:]))
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDebugFloatArray) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[350]
+}
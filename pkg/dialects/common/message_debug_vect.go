@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // To debug something using a named 3D vector.
 type MessageDebugVect struct {
 	// Name
@@ -20,3 +28,43 @@ type MessageDebugVect struct {
 func (*MessageDebugVect) GetID() uint32 {
 	return 250
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDebugVect) MAVLinkCRCExtra() byte {
+	return 49
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDebugVect) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 30
+	}
+	return 30
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebugVect) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Z))
+	copy(buf[20:30], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDebugVect) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	if n := bytes.IndexByte(buf[20:30], 0); n >= 0 {
+		m.Name = string(buf[20 : 20+n])
+	} else {
+		m.Name = string(buf[20:30])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDebugVect) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[250]
+}
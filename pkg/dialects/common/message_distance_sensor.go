@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Distance sensor information for an onboard rangefinder.
 type MessageDistanceSensor struct {
 	// Timestamp (time since system boot).
@@ -34,3 +41,63 @@ type MessageDistanceSensor struct {
 func (*MessageDistanceSensor) GetID() uint32 {
 	return 132
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDistanceSensor) MAVLinkCRCExtra() byte {
+	return 85
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDistanceSensor) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 39
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensor) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint16(buf[4:], m.MinDistance)
+	binary.LittleEndian.PutUint16(buf[6:], m.MaxDistance)
+	binary.LittleEndian.PutUint16(buf[8:], m.CurrentDistance)
+	buf[10] = byte(m.Type)
+	buf[11] = m.Id
+	buf[12] = byte(m.Orientation)
+	buf[13] = m.Covariance
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[14:], math.Float32bits(m.HorizontalFov))
+	binary.LittleEndian.PutUint32(buf[18:], math.Float32bits(m.VerticalFov))
+	for i, v := range m.Quaternion {
+		binary.LittleEndian.PutUint32(buf[22+i*4:], math.Float32bits(v))
+	}
+	buf[38] = m.SignalQuality
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensor) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.MinDistance = binary.LittleEndian.Uint16(buf[4:])
+	m.MaxDistance = binary.LittleEndian.Uint16(buf[6:])
+	m.CurrentDistance = binary.LittleEndian.Uint16(buf[8:])
+	m.Type = MAV_DISTANCE_SENSOR(buf[10])
+	m.Id = buf[11]
+	m.Orientation = MAV_SENSOR_ORIENTATION(buf[12])
+	m.Covariance = buf[13]
+	if !isV2 {
+		return
+	}
+	m.HorizontalFov = math.Float32frombits(binary.LittleEndian.Uint32(buf[14:]))
+	m.VerticalFov = math.Float32frombits(binary.LittleEndian.Uint32(buf[18:]))
+	for i := range m.Quaternion {
+		m.Quaternion[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[22+i*4:]))
+	}
+	m.SignalQuality = buf[38]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDistanceSensor) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[132]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data packet for images sent using the Image Transmission Protocol: https://mavlink.io/en/services/image_transmission.html.
 type MessageEncapsulatedData struct {
 	// sequence number (starting with 0 on every transmission)
@@ -14,3 +20,37 @@ type MessageEncapsulatedData struct {
 func (*MessageEncapsulatedData) GetID() uint32 {
 	return 131
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEncapsulatedData) MAVLinkCRCExtra() byte {
+	return 223
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEncapsulatedData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 255
+	}
+	return 255
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEncapsulatedData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seqnr)
+	for i, v := range m.Data {
+		buf[2+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEncapsulatedData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seqnr = binary.LittleEndian.Uint16(buf[0:])
+	for i := range m.Data {
+		m.Data[i] = buf[2+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEncapsulatedData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[131]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ESC information for lower rate streaming. Recommended streaming rate 1Hz. See ESC_STATUS for higher-rate ESC data.
 type MessageEscInfo struct {
 	// Index of the first ESC in this message (ESC are indexed in motor order). minValue = 0, maxValue = 60, increment = 4.
@@ -28,3 +34,59 @@ type MessageEscInfo struct {
 func (*MessageEscInfo) GetID() uint32 {
 	return 290
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEscInfo) MAVLinkCRCExtra() byte {
+	return 251
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEscInfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 46
+	}
+	return 46
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscInfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.ErrorCount {
+		binary.LittleEndian.PutUint32(buf[8+i*4:], v)
+	}
+	binary.LittleEndian.PutUint16(buf[24:], m.Counter)
+	for i, v := range m.FailureFlags {
+		binary.LittleEndian.PutUint16(buf[26+i*2:], uint16(v))
+	}
+	for i, v := range m.Temperature {
+		binary.LittleEndian.PutUint16(buf[34+i*2:], uint16(v))
+	}
+	buf[42] = m.Index
+	buf[43] = m.Count
+	buf[44] = byte(m.ConnectionType)
+	buf[45] = m.Info
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscInfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.ErrorCount {
+		m.ErrorCount[i] = binary.LittleEndian.Uint32(buf[8+i*4:])
+	}
+	m.Counter = binary.LittleEndian.Uint16(buf[24:])
+	for i := range m.FailureFlags {
+		m.FailureFlags[i] = ESC_FAILURE_FLAGS(binary.LittleEndian.Uint16(buf[26+i*2:]))
+	}
+	for i := range m.Temperature {
+		m.Temperature[i] = int16(binary.LittleEndian.Uint16(buf[34+i*2:]))
+	}
+	m.Index = buf[42]
+	m.Count = buf[43]
+	m.ConnectionType = ESC_CONNECTION_TYPE(buf[44])
+	m.Info = buf[45]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEscInfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[290]
+}
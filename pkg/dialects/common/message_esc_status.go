@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ESC information for higher rate streaming. Recommended streaming rate is ~10 Hz. Information that changes more slowly is sent in ESC_INFO. It should typically only be streamed on high-bandwidth links (i.e. to a companion computer).
 type MessageEscStatus struct {
 	// Index of the first ESC in this message (ESC are indexed in motor order). minValue = 0, maxValue = 60, increment = 4.
@@ -20,3 +27,51 @@ type MessageEscStatus struct {
 func (*MessageEscStatus) GetID() uint32 {
 	return 291
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEscStatus) MAVLinkCRCExtra() byte {
+	return 10
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEscStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 57
+	}
+	return 57
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.Rpm {
+		binary.LittleEndian.PutUint32(buf[8+i*4:], uint32(v))
+	}
+	for i, v := range m.Voltage {
+		binary.LittleEndian.PutUint32(buf[24+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.Current {
+		binary.LittleEndian.PutUint32(buf[40+i*4:], math.Float32bits(v))
+	}
+	buf[56] = m.Index
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.Rpm {
+		m.Rpm[i] = int32(binary.LittleEndian.Uint32(buf[8+i*4:]))
+	}
+	for i := range m.Voltage {
+		m.Voltage[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[24+i*4:]))
+	}
+	for i := range m.Current {
+		m.Current[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[40+i*4:]))
+	}
+	m.Index = buf[56]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEscStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[291]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Event message. Each new event from a particular component gets a new sequence number. The same message might be sent multiple times if (re-)requested. Most events are broadcast, some can be specific to a target component (as receivers keep track of the sequence for missed events, all events need to be broadcast. Thus we use destination_component instead of target_component).
 type MessageEvent struct {
 	// Component ID
@@ -24,3 +30,47 @@ type MessageEvent struct {
 func (*MessageEvent) GetID() uint32 {
 	return 410
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEvent) MAVLinkCRCExtra() byte {
+	return 160
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEvent) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 53
+	}
+	return 53
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEvent) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Id)
+	binary.LittleEndian.PutUint32(buf[4:], m.EventTimeBootMs)
+	binary.LittleEndian.PutUint16(buf[8:], m.Sequence)
+	buf[10] = m.DestinationComponent
+	buf[11] = m.DestinationSystem
+	buf[12] = m.LogLevels
+	for i, v := range m.Arguments {
+		buf[13+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEvent) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Id = binary.LittleEndian.Uint32(buf[0:])
+	m.EventTimeBootMs = binary.LittleEndian.Uint32(buf[4:])
+	m.Sequence = binary.LittleEndian.Uint16(buf[8:])
+	m.DestinationComponent = buf[10]
+	m.DestinationSystem = buf[11]
+	m.LogLevels = buf[12]
+	for i := range m.Arguments {
+		m.Arguments[i] = buf[13+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEvent) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[410]
+}
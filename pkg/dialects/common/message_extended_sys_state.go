@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Provides state for additional features
 type MessageExtendedSysState struct {
 	// The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration.
@@ -14,3 +18,33 @@ type MessageExtendedSysState struct {
 func (*MessageExtendedSysState) GetID() uint32 {
 	return 245
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageExtendedSysState) MAVLinkCRCExtra() byte {
+	return 130
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageExtendedSysState) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageExtendedSysState) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.VtolState)
+	buf[1] = byte(m.LandedState)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageExtendedSysState) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.VtolState = MAV_VTOL_STATE(buf[0])
+	m.LandedState = MAV_LANDED_STATE(buf[1])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageExtendedSysState) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[245]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of geo-fencing. Sent in extended status stream when fencing enabled.
 type MessageFenceStatus struct {
 	// Breach status (0 if currently inside fence, 1 if outside).
@@ -20,3 +26,45 @@ type MessageFenceStatus struct {
 func (*MessageFenceStatus) GetID() uint32 {
 	return 162
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFenceStatus) MAVLinkCRCExtra() byte {
+	return 189
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFenceStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFenceStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.BreachTime)
+	binary.LittleEndian.PutUint16(buf[4:], m.BreachCount)
+	buf[6] = m.BreachStatus
+	buf[7] = byte(m.BreachType)
+	if !isV2 {
+		return
+	}
+	buf[8] = byte(m.BreachMitigation)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFenceStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.BreachTime = binary.LittleEndian.Uint32(buf[0:])
+	m.BreachCount = binary.LittleEndian.Uint16(buf[4:])
+	m.BreachStatus = buf[6]
+	m.BreachType = FENCE_BREACH(buf[7])
+	if !isV2 {
+		return
+	}
+	m.BreachMitigation = FENCE_MITIGATE(buf[8])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFenceStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[162]
+}
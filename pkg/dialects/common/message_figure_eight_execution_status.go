@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Vehicle status report that is sent out while figure eight execution is in progress (see MAV_CMD_DO_FIGURE_EIGHT).
 // This may typically send at low rates: of the order of 2Hz.
 type MessageFigureEightExecutionStatus struct {
@@ -27,3 +34,45 @@ type MessageFigureEightExecutionStatus struct {
 func (*MessageFigureEightExecutionStatus) GetID() uint32 {
 	return 361
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFigureEightExecutionStatus) MAVLinkCRCExtra() byte {
+	return 93
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFigureEightExecutionStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 33
+	}
+	return 33
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFigureEightExecutionStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.MajorRadius))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.MinorRadius))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Orientation))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.X))
+	binary.LittleEndian.PutUint32(buf[24:], uint32(m.Y))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Z))
+	buf[32] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFigureEightExecutionStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.MajorRadius = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.MinorRadius = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Orientation = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.X = int32(binary.LittleEndian.Uint32(buf[20:]))
+	m.Y = int32(binary.LittleEndian.Uint32(buf[24:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Frame = MAV_FRAME(buf[32])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFigureEightExecutionStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[361]
+}
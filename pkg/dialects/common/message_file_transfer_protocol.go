@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // File transfer protocol message: https://mavlink.io/en/services/ftp.html.
 type MessageFileTransferProtocol struct {
 	// Network ID (0 for broadcast)
@@ -18,3 +22,41 @@ type MessageFileTransferProtocol struct {
 func (*MessageFileTransferProtocol) GetID() uint32 {
 	return 110
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFileTransferProtocol) MAVLinkCRCExtra() byte {
+	return 84
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFileTransferProtocol) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 254
+	}
+	return 254
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFileTransferProtocol) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetNetwork
+	buf[1] = m.TargetSystem
+	buf[2] = m.TargetComponent
+	for i, v := range m.Payload {
+		buf[3+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFileTransferProtocol) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetNetwork = buf[0]
+	m.TargetSystem = buf[1]
+	m.TargetComponent = buf[2]
+	for i := range m.Payload {
+		m.Payload[i] = buf[3+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFileTransferProtocol) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[110]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Flight information.
 // This includes time since boot for arm, takeoff, and land, and a flight number.
 // Takeoff and landing values reset to zero on arm.
@@ -24,3 +30,45 @@ type MessageFlightInformation struct {
 func (*MessageFlightInformation) GetID() uint32 {
 	return 264
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFlightInformation) MAVLinkCRCExtra() byte {
+	return 49
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFlightInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFlightInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.ArmingTimeUtc)
+	binary.LittleEndian.PutUint64(buf[8:], m.TakeoffTimeUtc)
+	binary.LittleEndian.PutUint64(buf[16:], m.FlightUuid)
+	binary.LittleEndian.PutUint32(buf[24:], m.TimeBootMs)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[28:], m.LandingTime)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFlightInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ArmingTimeUtc = binary.LittleEndian.Uint64(buf[0:])
+	m.TakeoffTimeUtc = binary.LittleEndian.Uint64(buf[8:])
+	m.FlightUuid = binary.LittleEndian.Uint64(buf[16:])
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[24:])
+	if !isV2 {
+		return
+	}
+	m.LandingTime = binary.LittleEndian.Uint32(buf[28:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFlightInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[264]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Fuel status.
 // This message provides "generic" fuel level information for  in a GCS and for triggering failsafes in an autopilot.
 // The fuel type and associated units for fields in this message are defined in the enum MAV_FUEL_TYPE.
@@ -35,3 +42,45 @@ type MessageFuelStatus struct {
 func (*MessageFuelStatus) GetID() uint32 {
 	return 371
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFuelStatus) MAVLinkCRCExtra() byte {
+	return 10
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFuelStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 26
+	}
+	return 26
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFuelStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.MaximumFuel))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.ConsumedFuel))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.RemainingFuel))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.FlowRate))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Temperature))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.FuelType))
+	buf[24] = m.Id
+	buf[25] = m.PercentRemaining
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFuelStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MaximumFuel = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.ConsumedFuel = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.RemainingFuel = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.FlowRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.FuelType = MAV_FUEL_TYPE(binary.LittleEndian.Uint32(buf[20:]))
+	m.Id = buf[24]
+	m.PercentRemaining = buf[25]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFuelStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[371]
+}
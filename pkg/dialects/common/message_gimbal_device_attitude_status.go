@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message reporting the status of a gimbal device.
 // This message should be broadcast by a gimbal device component at a low regular rate (e.g. 5 Hz).
 // For the angles encoded in the quaternion and the angular velocities holds:
@@ -48,3 +55,63 @@ type MessageGimbalDeviceAttitudeStatus struct {
 func (*MessageGimbalDeviceAttitudeStatus) GetID() uint32 {
 	return 285
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalDeviceAttitudeStatus) MAVLinkCRCExtra() byte {
+	return 137
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalDeviceAttitudeStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 49
+	}
+	return 40
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceAttitudeStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[4+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AngularVelocityX))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.AngularVelocityY))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.AngularVelocityZ))
+	binary.LittleEndian.PutUint32(buf[32:], uint32(m.FailureFlags))
+	binary.LittleEndian.PutUint16(buf[36:], uint16(m.Flags))
+	buf[38] = m.TargetSystem
+	buf[39] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.DeltaYaw))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.DeltaYawVelocity))
+	buf[48] = m.GimbalDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceAttitudeStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4+i*4:]))
+	}
+	m.AngularVelocityX = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.AngularVelocityY = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.AngularVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.FailureFlags = GIMBAL_DEVICE_ERROR_FLAGS(binary.LittleEndian.Uint32(buf[32:]))
+	m.Flags = GIMBAL_DEVICE_FLAGS(binary.LittleEndian.Uint16(buf[36:]))
+	m.TargetSystem = buf[38]
+	m.TargetComponent = buf[39]
+	if !isV2 {
+		return
+	}
+	m.DeltaYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.DeltaYawVelocity = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.GimbalDeviceId = buf[48]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalDeviceAttitudeStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[285]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a low level gimbal. This message should be requested by the gimbal manager or a ground station using MAV_CMD_REQUEST_MESSAGE. The maximum angles and rates are the limits by hardware. However, the limits by software used are likely different/smaller and dependent on mode/settings/etc..
 type MessageGimbalDeviceInformation struct {
 	// Timestamp (time since system boot).
@@ -44,3 +52,81 @@ type MessageGimbalDeviceInformation struct {
 func (*MessageGimbalDeviceInformation) GetID() uint32 {
 	return 283
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalDeviceInformation) MAVLinkCRCExtra() byte {
+	return 74
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalDeviceInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 149
+	}
+	return 144
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Uid)
+	binary.LittleEndian.PutUint32(buf[8:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[12:], m.FirmwareVersion)
+	binary.LittleEndian.PutUint32(buf[16:], m.HardwareVersion)
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.RollMin))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.RollMax))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.PitchMin))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.PitchMax))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.YawMin))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.YawMax))
+	binary.LittleEndian.PutUint16(buf[44:], uint16(m.CapFlags))
+	binary.LittleEndian.PutUint16(buf[46:], m.CustomCapFlags)
+	copy(buf[48:80], m.VendorName)
+	copy(buf[80:112], m.ModelName)
+	copy(buf[112:144], m.CustomName)
+	if !isV2 {
+		return
+	}
+	buf[144] = m.GimbalDeviceId
+	binary.LittleEndian.PutUint32(buf[145:], uint32(m.CapFlags2))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Uid = binary.LittleEndian.Uint64(buf[0:])
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[8:])
+	m.FirmwareVersion = binary.LittleEndian.Uint32(buf[12:])
+	m.HardwareVersion = binary.LittleEndian.Uint32(buf[16:])
+	m.RollMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.RollMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.PitchMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.PitchMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.YawMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.YawMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.CapFlags = GIMBAL_DEVICE_CAP_FLAGS(binary.LittleEndian.Uint16(buf[44:]))
+	m.CustomCapFlags = binary.LittleEndian.Uint16(buf[46:])
+	if n := bytes.IndexByte(buf[48:80], 0); n >= 0 {
+		m.VendorName = string(buf[48 : 48+n])
+	} else {
+		m.VendorName = string(buf[48:80])
+	}
+	if n := bytes.IndexByte(buf[80:112], 0); n >= 0 {
+		m.ModelName = string(buf[80 : 80+n])
+	} else {
+		m.ModelName = string(buf[80:112])
+	}
+	if n := bytes.IndexByte(buf[112:144], 0); n >= 0 {
+		m.CustomName = string(buf[112 : 112+n])
+	} else {
+		m.CustomName = string(buf[112:144])
+	}
+	if !isV2 {
+		return
+	}
+	m.GimbalDeviceId = buf[144]
+	m.CapFlags2 = GIMBAL_DEVICE_CAP_FLAGS(binary.LittleEndian.Uint32(buf[145:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalDeviceInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[283]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Low level message to control a gimbal device's attitude.
 // This message is to be sent from the gimbal manager to the gimbal device component.
 // The quaternion and angular velocities can be set to NaN according to use case.
@@ -35,3 +42,47 @@ type MessageGimbalDeviceSetAttitude struct {
 func (*MessageGimbalDeviceSetAttitude) GetID() uint32 {
 	return 284
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalDeviceSetAttitude) MAVLinkCRCExtra() byte {
+	return 99
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalDeviceSetAttitude) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceSetAttitude) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.AngularVelocityX))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AngularVelocityY))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.AngularVelocityZ))
+	binary.LittleEndian.PutUint16(buf[28:], uint16(m.Flags))
+	buf[30] = m.TargetSystem
+	buf[31] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalDeviceSetAttitude) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[i*4:]))
+	}
+	m.AngularVelocityX = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.AngularVelocityY = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.AngularVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Flags = GIMBAL_DEVICE_FLAGS(binary.LittleEndian.Uint16(buf[28:]))
+	m.TargetSystem = buf[30]
+	m.TargetComponent = buf[31]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalDeviceSetAttitude) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[284]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Current status about a high level gimbal manager. This message should be broadcast at a low regular rate (e.g. 5Hz).
 type MessageGimbalManagerStatus struct {
 	// Timestamp (time since system boot).
@@ -24,3 +30,43 @@ type MessageGimbalManagerStatus struct {
 func (*MessageGimbalManagerStatus) GetID() uint32 {
 	return 281
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGimbalManagerStatus) MAVLinkCRCExtra() byte {
+	return 48
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGimbalManagerStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 13
+	}
+	return 13
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalManagerStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Flags))
+	buf[8] = m.GimbalDeviceId
+	buf[9] = m.PrimaryControlSysid
+	buf[10] = m.PrimaryControlCompid
+	buf[11] = m.SecondaryControlSysid
+	buf[12] = m.SecondaryControlCompid
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGimbalManagerStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Flags = GIMBAL_MANAGER_FLAGS(binary.LittleEndian.Uint32(buf[4:]))
+	m.GimbalDeviceId = buf[8]
+	m.PrimaryControlSysid = buf[9]
+	m.PrimaryControlCompid = buf[10]
+	m.SecondaryControlSysid = buf[11]
+	m.SecondaryControlCompid = buf[12]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGimbalManagerStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[281]
+}
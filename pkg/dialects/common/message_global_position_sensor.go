@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reports measurement/estimate from a global position sensor. Used as navigation fusion source and optionally displayed in the UI.
 type MessageGlobalPositionSensor struct {
 	// System ID (ID of target system, normally autopilot and ground station).
@@ -36,3 +43,55 @@ type MessageGlobalPositionSensor struct {
 func (*MessageGlobalPositionSensor) GetID() uint32 {
 	return 296
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGlobalPositionSensor) MAVLinkCRCExtra() byte {
+	return 158
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGlobalPositionSensor) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 41
+	}
+	return 41
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGlobalPositionSensor) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.ProcessingTime)
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AltEllipsoid))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Alt))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Eph))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Epv))
+	buf[36] = m.TargetSystem
+	buf[37] = m.TargetComponent
+	buf[38] = m.Id
+	buf[39] = byte(m.Source)
+	buf[40] = byte(m.Flags)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGlobalPositionSensor) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.ProcessingTime = binary.LittleEndian.Uint32(buf[8:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.AltEllipsoid = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Alt = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Eph = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Epv = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.TargetSystem = buf[36]
+	m.TargetComponent = buf[37]
+	m.Id = buf[38]
+	m.Source = GLOBAL_POSITION_SRC(buf[39])
+	m.Flags = GLOBAL_POSITION_FLAGS(buf[40])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGlobalPositionSensor) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[296]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting
 type MessageGps2Rtk struct {
 	// Time since boot of last baseline message received.
@@ -36,3 +42,55 @@ type MessageGps2Rtk struct {
 func (*MessageGps2Rtk) GetID() uint32 {
 	return 128
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGps2Rtk) MAVLinkCRCExtra() byte {
+	return 226
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGps2Rtk) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 35
+	}
+	return 35
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGps2Rtk) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeLastBaselineMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.Tow)
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.BaselineAMm))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.BaselineBMm))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.BaselineCMm))
+	binary.LittleEndian.PutUint32(buf[20:], m.Accuracy)
+	binary.LittleEndian.PutUint32(buf[24:], uint32(m.IarNumHypotheses))
+	binary.LittleEndian.PutUint16(buf[28:], m.Wn)
+	buf[30] = m.RtkReceiverId
+	buf[31] = m.RtkHealth
+	buf[32] = m.RtkRate
+	buf[33] = m.Nsats
+	buf[34] = byte(m.BaselineCoordsType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGps2Rtk) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeLastBaselineMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Tow = binary.LittleEndian.Uint32(buf[4:])
+	m.BaselineAMm = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.BaselineBMm = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.BaselineCMm = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Accuracy = binary.LittleEndian.Uint32(buf[20:])
+	m.IarNumHypotheses = int32(binary.LittleEndian.Uint32(buf[24:]))
+	m.Wn = binary.LittleEndian.Uint16(buf[28:])
+	m.RtkReceiverId = buf[30]
+	m.RtkHealth = buf[31]
+	m.RtkRate = buf[32]
+	m.Nsats = buf[33]
+	m.BaselineCoordsType = RTK_BASELINE_COORDINATE_SYSTEM(buf[34])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGps2Rtk) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[128]
+}
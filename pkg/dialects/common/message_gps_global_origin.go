@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Publishes the GPS coordinates of the vehicle local origin (0,0,0) position. Emitted whenever a new GPS-Local position mapping is requested or set - e.g. following SET_GPS_GLOBAL_ORIGIN message.
 type MessageGpsGlobalOrigin struct {
 	// Latitude (WGS84)
@@ -18,3 +24,43 @@ type MessageGpsGlobalOrigin struct {
 func (*MessageGpsGlobalOrigin) GetID() uint32 {
 	return 49
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGpsGlobalOrigin) MAVLinkCRCExtra() byte {
+	return 39
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGpsGlobalOrigin) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsGlobalOrigin) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Latitude))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Longitude))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Altitude))
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint64(buf[12:], m.TimeUsec)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsGlobalOrigin) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Latitude = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Longitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Altitude = int32(binary.LittleEndian.Uint32(buf[8:]))
+	if !isV2 {
+		return
+	}
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[12:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGpsGlobalOrigin) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[49]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for injecting into the onboard GPS (used for DGPS)
 type MessageGpsInjectData struct {
 	// System ID
@@ -18,3 +22,41 @@ type MessageGpsInjectData struct {
 func (*MessageGpsInjectData) GetID() uint32 {
 	return 123
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGpsInjectData) MAVLinkCRCExtra() byte {
+	return 250
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGpsInjectData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 113
+	}
+	return 113
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsInjectData) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = m.Len
+	for i, v := range m.Data {
+		buf[3+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsInjectData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.Len = buf[2]
+	for i := range m.Data {
+		m.Data[i] = buf[3+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGpsInjectData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[123]
+}
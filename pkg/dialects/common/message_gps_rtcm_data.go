@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // RTCM message for injecting into the onboard GPS (used for DGPS)
 type MessageGpsRtcmData struct {
 	// LSB: 1 means message is fragmented, next 2 bits are the fragment ID, the remaining 5 bits are used for the sequence ID. Messages are only to be flushed to the GPS when the entire message has been reconstructed on the autopilot. The fragment ID specifies which order the fragments should be assembled into a buffer, while the sequence ID is used to detect a mismatch between different buffers. The buffer is considered fully reconstructed when either all 4 fragments are present, or all the fragments before the first fragment with a non full payload is received. This management is used to ensure that normal GPS operation doesn't corrupt RTCM data, and to recover from a unreliable transport delivery order.
@@ -16,3 +20,39 @@ type MessageGpsRtcmData struct {
 func (*MessageGpsRtcmData) GetID() uint32 {
 	return 233
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGpsRtcmData) MAVLinkCRCExtra() byte {
+	return 35
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGpsRtcmData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 182
+	}
+	return 182
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsRtcmData) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.Flags
+	buf[1] = m.Len
+	for i, v := range m.Data {
+		buf[2+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsRtcmData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Flags = buf[0]
+	m.Len = buf[1]
+	for i := range m.Data {
+		m.Data[i] = buf[2+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGpsRtcmData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[233]
+}
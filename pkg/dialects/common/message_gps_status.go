@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The positioning status, as reported by GPS. This message is intended to display status information about each satellite visible to the receiver. See message GLOBAL_POSITION_INT for the global position estimate. This message can contain information for up to 20 satellites.
 type MessageGpsStatus struct {
 	// Number of satellites visible
@@ -22,3 +26,61 @@ type MessageGpsStatus struct {
 func (*MessageGpsStatus) GetID() uint32 {
 	return 25
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGpsStatus) MAVLinkCRCExtra() byte {
+	return 23
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGpsStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 101
+	}
+	return 101
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.SatellitesVisible
+	for i, v := range m.SatellitePrn {
+		buf[1+i] = v
+	}
+	for i, v := range m.SatelliteUsed {
+		buf[21+i] = v
+	}
+	for i, v := range m.SatelliteElevation {
+		buf[41+i] = v
+	}
+	for i, v := range m.SatelliteAzimuth {
+		buf[61+i] = v
+	}
+	for i, v := range m.SatelliteSnr {
+		buf[81+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGpsStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.SatellitesVisible = buf[0]
+	for i := range m.SatellitePrn {
+		m.SatellitePrn[i] = buf[1+i]
+	}
+	for i := range m.SatelliteUsed {
+		m.SatelliteUsed[i] = buf[21+i]
+	}
+	for i := range m.SatelliteElevation {
+		m.SatelliteElevation[i] = buf[41+i]
+	}
+	for i := range m.SatelliteAzimuth {
+		m.SatelliteAzimuth[i] = buf[61+i]
+	}
+	for i := range m.SatelliteSnr {
+		m.SatelliteSnr[i] = buf[81+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGpsStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[25]
+}
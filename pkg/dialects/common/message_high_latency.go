@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message appropriate for high latency connections like Iridium
 type MessageHighLatency struct {
 	// Bitmap of enabled system modes.
@@ -58,3 +64,77 @@ type MessageHighLatency struct {
 func (*MessageHighLatency) GetID() uint32 {
 	return 234
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHighLatency) MAVLinkCRCExtra() byte {
+	return 150
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHighLatency) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 40
+	}
+	return 40
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHighLatency) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Latitude))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Longitude))
+	binary.LittleEndian.PutUint16(buf[12:], uint16(m.Roll))
+	binary.LittleEndian.PutUint16(buf[14:], uint16(m.Pitch))
+	binary.LittleEndian.PutUint16(buf[16:], m.Heading)
+	binary.LittleEndian.PutUint16(buf[18:], uint16(m.HeadingSp))
+	binary.LittleEndian.PutUint16(buf[20:], uint16(m.AltitudeAmsl))
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.AltitudeSp))
+	binary.LittleEndian.PutUint16(buf[24:], m.WpDistance)
+	buf[26] = byte(m.BaseMode)
+	buf[27] = byte(m.LandedState)
+	buf[28] = byte(m.Throttle)
+	buf[29] = m.Airspeed
+	buf[30] = m.AirspeedSp
+	buf[31] = m.Groundspeed
+	buf[32] = byte(m.ClimbRate)
+	buf[33] = m.GpsNsat
+	buf[34] = byte(m.GpsFixType)
+	buf[35] = m.BatteryRemaining
+	buf[36] = byte(m.Temperature)
+	buf[37] = byte(m.TemperatureAir)
+	buf[38] = m.Failsafe
+	buf[39] = m.WpNum
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHighLatency) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.Latitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Longitude = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Roll = int16(binary.LittleEndian.Uint16(buf[12:]))
+	m.Pitch = int16(binary.LittleEndian.Uint16(buf[14:]))
+	m.Heading = binary.LittleEndian.Uint16(buf[16:])
+	m.HeadingSp = int16(binary.LittleEndian.Uint16(buf[18:]))
+	m.AltitudeAmsl = int16(binary.LittleEndian.Uint16(buf[20:]))
+	m.AltitudeSp = int16(binary.LittleEndian.Uint16(buf[22:]))
+	m.WpDistance = binary.LittleEndian.Uint16(buf[24:])
+	m.BaseMode = MAV_MODE_FLAG(buf[26])
+	m.LandedState = MAV_LANDED_STATE(buf[27])
+	m.Throttle = int8(buf[28])
+	m.Airspeed = buf[29]
+	m.AirspeedSp = buf[30]
+	m.Groundspeed = buf[31]
+	m.ClimbRate = int8(buf[32])
+	m.GpsNsat = buf[33]
+	m.GpsFixType = GPS_FIX_TYPE(buf[34])
+	m.BatteryRemaining = buf[35]
+	m.Temperature = int8(buf[36])
+	m.TemperatureAir = int8(buf[37])
+	m.Failsafe = buf[38]
+	m.WpNum = buf[39]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHighLatency) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[234]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message appropriate for high latency connections like Iridium (version 2)
 type MessageHighLatency2 struct {
 	// Timestamp (milliseconds since boot or Unix epoch)
@@ -64,3 +70,83 @@ type MessageHighLatency2 struct {
 func (*MessageHighLatency2) GetID() uint32 {
 	return 235
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHighLatency2) MAVLinkCRCExtra() byte {
+	return 179
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHighLatency2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 42
+	}
+	return 42
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHighLatency2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Latitude))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Longitude))
+	binary.LittleEndian.PutUint16(buf[12:], m.CustomMode)
+	binary.LittleEndian.PutUint16(buf[14:], uint16(m.Altitude))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.TargetAltitude))
+	binary.LittleEndian.PutUint16(buf[18:], m.TargetDistance)
+	binary.LittleEndian.PutUint16(buf[20:], m.WpNum)
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.FailureFlags))
+	buf[24] = byte(m.Type)
+	buf[25] = byte(m.Autopilot)
+	buf[26] = m.Heading
+	buf[27] = m.TargetHeading
+	buf[28] = m.Throttle
+	buf[29] = m.Airspeed
+	buf[30] = m.AirspeedSp
+	buf[31] = m.Groundspeed
+	buf[32] = m.Windspeed
+	buf[33] = m.WindHeading
+	buf[34] = m.Eph
+	buf[35] = m.Epv
+	buf[36] = byte(m.TemperatureAir)
+	buf[37] = byte(m.ClimbRate)
+	buf[38] = byte(m.Battery)
+	buf[39] = byte(m.Custom0)
+	buf[40] = byte(m.Custom1)
+	buf[41] = byte(m.Custom2)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHighLatency2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint32(buf[0:])
+	m.Latitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Longitude = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.CustomMode = binary.LittleEndian.Uint16(buf[12:])
+	m.Altitude = int16(binary.LittleEndian.Uint16(buf[14:]))
+	m.TargetAltitude = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.TargetDistance = binary.LittleEndian.Uint16(buf[18:])
+	m.WpNum = binary.LittleEndian.Uint16(buf[20:])
+	m.FailureFlags = HL_FAILURE_FLAG(binary.LittleEndian.Uint16(buf[22:]))
+	m.Type = MAV_TYPE(buf[24])
+	m.Autopilot = MAV_AUTOPILOT(buf[25])
+	m.Heading = buf[26]
+	m.TargetHeading = buf[27]
+	m.Throttle = buf[28]
+	m.Airspeed = buf[29]
+	m.AirspeedSp = buf[30]
+	m.Groundspeed = buf[31]
+	m.Windspeed = buf[32]
+	m.WindHeading = buf[33]
+	m.Eph = buf[34]
+	m.Epv = buf[35]
+	m.TemperatureAir = int8(buf[36])
+	m.ClimbRate = int8(buf[37])
+	m.Battery = int8(buf[38])
+	m.Custom0 = int8(buf[39])
+	m.Custom1 = int8(buf[40])
+	m.Custom2 = int8(buf[41])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHighLatency2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[235]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Sent from autopilot to simulation. Hardware in the loop control outputs. Alternative to HIL_CONTROLS.
 type MessageHilActuatorControls struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -18,3 +25,41 @@ type MessageHilActuatorControls struct {
 func (*MessageHilActuatorControls) GetID() uint32 {
 	return 93
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHilActuatorControls) MAVLinkCRCExtra() byte {
+	return 47
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHilActuatorControls) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 81
+	}
+	return 81
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHilActuatorControls) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint64(buf[8:], uint64(m.Flags))
+	for i, v := range m.Controls {
+		binary.LittleEndian.PutUint32(buf[16+i*4:], math.Float32bits(v))
+	}
+	buf[80] = byte(m.Mode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHilActuatorControls) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Flags = HIL_ACTUATOR_CONTROLS_FLAGS(binary.LittleEndian.Uint64(buf[8:]))
+	for i := range m.Controls {
+		m.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[16+i*4:]))
+	}
+	m.Mode = MAV_MODE_FLAG(buf[80])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHilActuatorControls) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[93]
+}
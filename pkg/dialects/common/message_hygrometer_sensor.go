@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Temperature and humidity from hygrometer.
 type MessageHygrometerSensor struct {
 	// Hygrometer ID
@@ -16,3 +22,35 @@ type MessageHygrometerSensor struct {
 func (*MessageHygrometerSensor) GetID() uint32 {
 	return 12920
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHygrometerSensor) MAVLinkCRCExtra() byte {
+	return 20
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHygrometerSensor) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 5
+	}
+	return 5
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHygrometerSensor) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.Temperature))
+	binary.LittleEndian.PutUint16(buf[2:], m.Humidity)
+	buf[4] = m.Id
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHygrometerSensor) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.Humidity = binary.LittleEndian.Uint16(buf[2:])
+	m.Id = buf[4]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHygrometerSensor) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12920]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Illuminator status
 type MessageIlluminatorStatus struct {
 	// Time since the start-up of the illuminator in ms
@@ -32,3 +39,51 @@ type MessageIlluminatorStatus struct {
 func (*MessageIlluminatorStatus) GetID() uint32 {
 	return 440
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageIlluminatorStatus) MAVLinkCRCExtra() byte {
+	return 66
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageIlluminatorStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 35
+	}
+	return 35
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIlluminatorStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.UptimeMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.ErrorStatus))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Brightness))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.StrobePeriod))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.StrobeDutyCycle))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.TempC))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.MinStrobePeriod))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.MaxStrobePeriod))
+	buf[32] = m.Enable
+	buf[33] = byte(m.ModeBitmask)
+	buf[34] = byte(m.Mode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIlluminatorStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.UptimeMs = binary.LittleEndian.Uint32(buf[0:])
+	m.ErrorStatus = ILLUMINATOR_ERROR_FLAGS(binary.LittleEndian.Uint32(buf[4:]))
+	m.Brightness = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.StrobePeriod = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.StrobeDutyCycle = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.TempC = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.MinStrobePeriod = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.MaxStrobePeriod = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Enable = buf[32]
+	m.ModeBitmask = ILLUMINATOR_MODE(buf[33])
+	m.Mode = ILLUMINATOR_MODE(buf[34])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageIlluminatorStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[440]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of the Iridium SBD link.
 type MessageIsbdLinkStatus struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -26,3 +32,45 @@ type MessageIsbdLinkStatus struct {
 func (*MessageIsbdLinkStatus) GetID() uint32 {
 	return 335
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageIsbdLinkStatus) MAVLinkCRCExtra() byte {
+	return 225
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageIsbdLinkStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 24
+	}
+	return 24
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIsbdLinkStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint64(buf[8:], m.LastHeartbeat)
+	binary.LittleEndian.PutUint16(buf[16:], m.FailedSessions)
+	binary.LittleEndian.PutUint16(buf[18:], m.SuccessfulSessions)
+	buf[20] = m.SignalQuality
+	buf[21] = m.RingPending
+	buf[22] = m.TxSessionPending
+	buf[23] = m.RxSessionPending
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIsbdLinkStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.LastHeartbeat = binary.LittleEndian.Uint64(buf[8:])
+	m.FailedSessions = binary.LittleEndian.Uint16(buf[16:])
+	m.SuccessfulSessions = binary.LittleEndian.Uint16(buf[18:])
+	m.SignalQuality = buf[20]
+	m.RingPending = buf[21]
+	m.TxSessionPending = buf[22]
+	m.RxSessionPending = buf[23]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageIsbdLinkStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[335]
+}
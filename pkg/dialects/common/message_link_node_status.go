@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status generated in each node in the communication chain and injected into MAVLink stream.
 type MessageLinkNodeStatus struct {
 	// Timestamp (time since system boot).
@@ -32,3 +38,51 @@ type MessageLinkNodeStatus struct {
 func (*MessageLinkNodeStatus) GetID() uint32 {
 	return 8
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLinkNodeStatus) MAVLinkCRCExtra() byte {
+	return 117
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLinkNodeStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 36
+	}
+	return 36
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLinkNodeStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], m.TxRate)
+	binary.LittleEndian.PutUint32(buf[12:], m.RxRate)
+	binary.LittleEndian.PutUint32(buf[16:], m.MessagesSent)
+	binary.LittleEndian.PutUint32(buf[20:], m.MessagesReceived)
+	binary.LittleEndian.PutUint32(buf[24:], m.MessagesLost)
+	binary.LittleEndian.PutUint16(buf[28:], m.RxParseErr)
+	binary.LittleEndian.PutUint16(buf[30:], m.TxOverflows)
+	binary.LittleEndian.PutUint16(buf[32:], m.RxOverflows)
+	buf[34] = m.TxBuf
+	buf[35] = m.RxBuf
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLinkNodeStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.TxRate = binary.LittleEndian.Uint32(buf[8:])
+	m.RxRate = binary.LittleEndian.Uint32(buf[12:])
+	m.MessagesSent = binary.LittleEndian.Uint32(buf[16:])
+	m.MessagesReceived = binary.LittleEndian.Uint32(buf[20:])
+	m.MessagesLost = binary.LittleEndian.Uint32(buf[24:])
+	m.RxParseErr = binary.LittleEndian.Uint16(buf[28:])
+	m.TxOverflows = binary.LittleEndian.Uint16(buf[30:])
+	m.RxOverflows = binary.LittleEndian.Uint16(buf[32:])
+	m.TxBuf = buf[34]
+	m.RxBuf = buf[35]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLinkNodeStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[8]
+}
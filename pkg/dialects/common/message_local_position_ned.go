@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The filtered local position (e.g. fused computer vision and accelerometers). Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)
 type MessageLocalPositionNed struct {
 	// Timestamp (time since system boot).
@@ -24,3 +31,43 @@ type MessageLocalPositionNed struct {
 func (*MessageLocalPositionNed) GetID() uint32 {
 	return 32
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLocalPositionNed) MAVLinkCRCExtra() byte {
+	return 185
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLocalPositionNed) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLocalPositionNed) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Vx))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Vy))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Vz))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLocalPositionNed) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Vx = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Vy = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Vz = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLocalPositionNed) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[32]
+}
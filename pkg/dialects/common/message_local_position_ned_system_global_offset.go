@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The offset in X, Y, Z and yaw between the LOCAL_POSITION_NED messages of MAV X and the global coordinate frame in NED coordinates. Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)
 type MessageLocalPositionNedSystemGlobalOffset struct {
 	// Timestamp (time since system boot).
@@ -24,3 +31,43 @@ type MessageLocalPositionNedSystemGlobalOffset struct {
 func (*MessageLocalPositionNedSystemGlobalOffset) GetID() uint32 {
 	return 89
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLocalPositionNedSystemGlobalOffset) MAVLinkCRCExtra() byte {
+	return 231
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLocalPositionNedSystemGlobalOffset) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLocalPositionNedSystemGlobalOffset) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Yaw))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLocalPositionNedSystemGlobalOffset) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLocalPositionNedSystemGlobalOffset) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[89]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reply to LOG_REQUEST_DATA
 type MessageLogData struct {
 	// Log id (from LOG_ENTRY reply)
@@ -18,3 +24,41 @@ type MessageLogData struct {
 func (*MessageLogData) GetID() uint32 {
 	return 120
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogData) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 97
+	}
+	return 97
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Ofs)
+	binary.LittleEndian.PutUint16(buf[4:], m.Id)
+	buf[6] = m.Count
+	for i, v := range m.Data {
+		buf[7+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Ofs = binary.LittleEndian.Uint32(buf[0:])
+	m.Id = binary.LittleEndian.Uint16(buf[4:])
+	m.Count = buf[6]
+	for i := range m.Data {
+		m.Data[i] = buf[7+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[120]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reply to LOG_REQUEST_LIST
 type MessageLogEntry struct {
 	// Log id
@@ -20,3 +26,39 @@ type MessageLogEntry struct {
 func (*MessageLogEntry) GetID() uint32 {
 	return 118
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogEntry) MAVLinkCRCExtra() byte {
+	return 56
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogEntry) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogEntry) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeUtc)
+	binary.LittleEndian.PutUint32(buf[4:], m.Size)
+	binary.LittleEndian.PutUint16(buf[8:], m.Id)
+	binary.LittleEndian.PutUint16(buf[10:], m.NumLogs)
+	binary.LittleEndian.PutUint16(buf[12:], m.LastLogNum)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogEntry) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUtc = binary.LittleEndian.Uint32(buf[0:])
+	m.Size = binary.LittleEndian.Uint32(buf[4:])
+	m.Id = binary.LittleEndian.Uint16(buf[8:])
+	m.NumLogs = binary.LittleEndian.Uint16(buf[10:])
+	m.LastLogNum = binary.LittleEndian.Uint16(buf[12:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogEntry) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[118]
+}
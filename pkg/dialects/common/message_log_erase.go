@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Erase all logs
 type MessageLogErase struct {
 	// System ID
@@ -14,3 +18,33 @@ type MessageLogErase struct {
 func (*MessageLogErase) GetID() uint32 {
 	return 121
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogErase) MAVLinkCRCExtra() byte {
+	return 237
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogErase) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogErase) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogErase) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogErase) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[121]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a chunk of a log
 type MessageLogRequestData struct {
 	// System ID
@@ -20,3 +26,39 @@ type MessageLogRequestData struct {
 func (*MessageLogRequestData) GetID() uint32 {
 	return 119
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogRequestData) MAVLinkCRCExtra() byte {
+	return 116
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogRequestData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Ofs)
+	binary.LittleEndian.PutUint32(buf[4:], m.Count)
+	binary.LittleEndian.PutUint16(buf[8:], m.Id)
+	buf[10] = m.TargetSystem
+	buf[11] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Ofs = binary.LittleEndian.Uint32(buf[0:])
+	m.Count = binary.LittleEndian.Uint32(buf[4:])
+	m.Id = binary.LittleEndian.Uint16(buf[8:])
+	m.TargetSystem = buf[10]
+	m.TargetComponent = buf[11]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogRequestData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[119]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Stop log transfer and resume normal logging
 type MessageLogRequestEnd struct {
 	// System ID
@@ -14,3 +18,33 @@ type MessageLogRequestEnd struct {
 func (*MessageLogRequestEnd) GetID() uint32 {
 	return 122
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogRequestEnd) MAVLinkCRCExtra() byte {
+	return 203
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogRequestEnd) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestEnd) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestEnd) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogRequestEnd) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[122]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a list of available logs.
 // On some systems calling this may stop on-board logging until LOG_REQUEST_END is called.
 // If there are no log files available this request shall be answered with one LOG_ENTRY message with id = 0 and num_logs = 0.
@@ -22,3 +28,37 @@ type MessageLogRequestList struct {
 func (*MessageLogRequestList) GetID() uint32 {
 	return 117
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLogRequestList) MAVLinkCRCExtra() byte {
+	return 128
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLogRequestList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestList) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Start)
+	binary.LittleEndian.PutUint16(buf[2:], m.End)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLogRequestList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Start = binary.LittleEndian.Uint16(buf[0:])
+	m.End = binary.LittleEndian.Uint16(buf[2:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLogRequestList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[117]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // An ack for a LOGGING_DATA_ACKED message
 type MessageLoggingAck struct {
 	// system ID of the target
@@ -16,3 +22,35 @@ type MessageLoggingAck struct {
 func (*MessageLoggingAck) GetID() uint32 {
 	return 268
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLoggingAck) MAVLinkCRCExtra() byte {
+	return 14
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLoggingAck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingAck) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Sequence)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingAck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLoggingAck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[268]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A message containing logged data (see also MAV_CMD_LOGGING_START)
 type MessageLoggingData struct {
 	// system ID of the target
@@ -22,3 +28,45 @@ type MessageLoggingData struct {
 func (*MessageLoggingData) GetID() uint32 {
 	return 266
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLoggingData) MAVLinkCRCExtra() byte {
+	return 193
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLoggingData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 255
+	}
+	return 255
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Sequence)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	buf[4] = m.Length
+	buf[5] = m.FirstMessageOffset
+	for i, v := range m.Data {
+		buf[6+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	m.Length = buf[4]
+	m.FirstMessageOffset = buf[5]
+	for i := range m.Data {
+		m.Data[i] = buf[6+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLoggingData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[266]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A message containing logged data which requires a LOGGING_ACK to be sent back
 type MessageLoggingDataAcked struct {
 	// system ID of the target
@@ -22,3 +28,45 @@ type MessageLoggingDataAcked struct {
 func (*MessageLoggingDataAcked) GetID() uint32 {
 	return 267
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLoggingDataAcked) MAVLinkCRCExtra() byte {
+	return 35
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLoggingDataAcked) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 255
+	}
+	return 255
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingDataAcked) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Sequence)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	buf[4] = m.Length
+	buf[5] = m.FirstMessageOffset
+	for i, v := range m.Data {
+		buf[6+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoggingDataAcked) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	m.Length = buf[4]
+	m.FirstMessageOffset = buf[5]
+	for i := range m.Data {
+		m.Data[i] = buf[6+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLoggingDataAcked) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[267]
+}
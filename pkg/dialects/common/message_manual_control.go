@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Manual (joystick) control message.
 // This message represents movement axes and button using standard joystick axes nomenclature. Unused axes can be disabled and buttons states are transmitted as individual on/off bits of a bitmask. For more information see https://mavlink.io/en/services/manual_control.html
 type MessageManualControl struct {
@@ -43,3 +49,67 @@ type MessageManualControl struct {
 func (*MessageManualControl) GetID() uint32 {
 	return 69
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageManualControl) MAVLinkCRCExtra() byte {
+	return 243
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageManualControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 30
+	}
+	return 11
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.X))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.Y))
+	binary.LittleEndian.PutUint16(buf[4:], uint16(m.Z))
+	binary.LittleEndian.PutUint16(buf[6:], uint16(m.R))
+	binary.LittleEndian.PutUint16(buf[8:], m.Buttons)
+	buf[10] = m.Target
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[11:], m.Buttons2)
+	buf[13] = m.EnabledExtensions
+	binary.LittleEndian.PutUint16(buf[14:], uint16(m.S))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.T))
+	binary.LittleEndian.PutUint16(buf[18:], uint16(m.Aux1))
+	binary.LittleEndian.PutUint16(buf[20:], uint16(m.Aux2))
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.Aux3))
+	binary.LittleEndian.PutUint16(buf[24:], uint16(m.Aux4))
+	binary.LittleEndian.PutUint16(buf[26:], uint16(m.Aux5))
+	binary.LittleEndian.PutUint16(buf[28:], uint16(m.Aux6))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.X = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.Y = int16(binary.LittleEndian.Uint16(buf[2:]))
+	m.Z = int16(binary.LittleEndian.Uint16(buf[4:]))
+	m.R = int16(binary.LittleEndian.Uint16(buf[6:]))
+	m.Buttons = binary.LittleEndian.Uint16(buf[8:])
+	m.Target = buf[10]
+	if !isV2 {
+		return
+	}
+	m.Buttons2 = binary.LittleEndian.Uint16(buf[11:])
+	m.EnabledExtensions = buf[13]
+	m.S = int16(binary.LittleEndian.Uint16(buf[14:]))
+	m.T = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.Aux1 = int16(binary.LittleEndian.Uint16(buf[18:]))
+	m.Aux2 = int16(binary.LittleEndian.Uint16(buf[20:]))
+	m.Aux3 = int16(binary.LittleEndian.Uint16(buf[22:]))
+	m.Aux4 = int16(binary.LittleEndian.Uint16(buf[24:]))
+	m.Aux5 = int16(binary.LittleEndian.Uint16(buf[26:]))
+	m.Aux6 = int16(binary.LittleEndian.Uint16(buf[28:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageManualControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[69]
+}
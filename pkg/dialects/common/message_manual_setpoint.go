@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Setpoint in roll, pitch, yaw and thrust from the operator
 type MessageManualSetpoint struct {
 	// Timestamp (time since system boot).
@@ -24,3 +31,43 @@ type MessageManualSetpoint struct {
 func (*MessageManualSetpoint) GetID() uint32 {
 	return 81
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageManualSetpoint) MAVLinkCRCExtra() byte {
+	return 106
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageManualSetpoint) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 22
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualSetpoint) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Thrust))
+	buf[20] = m.ModeSwitch
+	buf[21] = m.ManualOverrideSwitch
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualSetpoint) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.ModeSwitch = buf[20]
+	m.ManualOverrideSwitch = buf[21]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageManualSetpoint) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[81]
+}
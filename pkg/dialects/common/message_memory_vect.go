@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send raw controller memory. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type MessageMemoryVect struct {
 	// Starting address of the debug variables
@@ -18,3 +24,41 @@ type MessageMemoryVect struct {
 func (*MessageMemoryVect) GetID() uint32 {
 	return 249
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMemoryVect) MAVLinkCRCExtra() byte {
+	return 204
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMemoryVect) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 36
+	}
+	return 36
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMemoryVect) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Address)
+	buf[2] = m.Ver
+	buf[3] = m.Type
+	for i, v := range m.Value {
+		buf[4+i] = byte(v)
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMemoryVect) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Address = binary.LittleEndian.Uint16(buf[0:])
+	m.Ver = buf[2]
+	m.Type = buf[3]
+	for i := range m.Value {
+		m.Value[i] = int8(buf[4+i])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMemoryVect) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[249]
+}
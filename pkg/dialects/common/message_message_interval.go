@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The interval between messages for a particular MAVLink message ID.
 // This message is sent in response to the MAV_CMD_REQUEST_MESSAGE command with param1=244 (this message) and param2=message_id (the id of the message for which the interval is required).
 // This interface replaces DATA_STREAM.
@@ -16,3 +22,33 @@ type MessageMessageInterval struct {
 func (*MessageMessageInterval) GetID() uint32 {
 	return 244
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMessageInterval) MAVLinkCRCExtra() byte {
+	return 95
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMessageInterval) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMessageInterval) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.IntervalUs))
+	binary.LittleEndian.PutUint16(buf[4:], m.MessageId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMessageInterval) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.IntervalUs = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.MessageId = binary.LittleEndian.Uint16(buf[4:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMessageInterval) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[244]
+}
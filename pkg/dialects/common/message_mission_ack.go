@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Acknowledgment message during waypoint handling. The type field states if this message is a positive ack (type=0) or if an error happened (type=non-zero).
 type MessageMissionAck struct {
 	// System ID
@@ -25,3 +31,45 @@ type MessageMissionAck struct {
 func (*MessageMissionAck) GetID() uint32 {
 	return 47
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionAck) MAVLinkCRCExtra() byte {
+	return 153
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionAck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionAck) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	buf[2] = byte(m.Type)
+	if !isV2 {
+		return
+	}
+	buf[3] = byte(m.MissionType)
+	binary.LittleEndian.PutUint32(buf[4:], m.OpaqueId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionAck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	m.Type = MAV_MISSION_RESULT(buf[2])
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[3])
+	m.OpaqueId = binary.LittleEndian.Uint32(buf[4:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionAck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[47]
+}
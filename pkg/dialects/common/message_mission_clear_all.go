@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Delete all mission items at once.
 type MessageMissionClearAll struct {
 	// System ID
@@ -16,3 +20,41 @@ type MessageMissionClearAll struct {
 func (*MessageMissionClearAll) GetID() uint32 {
 	return 45
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionClearAll) MAVLinkCRCExtra() byte {
+	return 232
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionClearAll) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionClearAll) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[2] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionClearAll) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[2])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionClearAll) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[45]
+}
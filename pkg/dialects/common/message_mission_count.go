@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // This message is emitted as response to MISSION_REQUEST_LIST by the MAV and to initiate a write transaction. The GCS can then request the individual mission item based on the knowledge of the total number of waypoints.
 type MessageMissionCount struct {
 	// System ID
@@ -25,3 +31,45 @@ type MessageMissionCount struct {
 func (*MessageMissionCount) GetID() uint32 {
 	return 44
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionCount) MAVLinkCRCExtra() byte {
+	return 221
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionCount) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionCount) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Count)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[4] = byte(m.MissionType)
+	binary.LittleEndian.PutUint32(buf[5:], m.OpaqueId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionCount) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Count = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[4])
+	m.OpaqueId = binary.LittleEndian.Uint32(buf[5:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionCount) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[44]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message that announces the sequence number of the current target mission item (that the system will fly towards/execute when the mission is running).
 // This message should be streamed all the time (nominally at 1Hz).
 // This message should be emitted following a call to MAV_CMD_DO_SET_MISSION_CURRENT or MISSION_SET_CURRENT.
@@ -26,3 +32,49 @@ type MessageMissionCurrent struct {
 func (*MessageMissionCurrent) GetID() uint32 {
 	return 42
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionCurrent) MAVLinkCRCExtra() byte {
+	return 28
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionCurrent) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionCurrent) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[2:], m.Total)
+	buf[4] = byte(m.MissionState)
+	buf[5] = m.MissionMode
+	binary.LittleEndian.PutUint32(buf[6:], m.MissionId)
+	binary.LittleEndian.PutUint32(buf[10:], m.FenceId)
+	binary.LittleEndian.PutUint32(buf[14:], m.RallyPointsId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionCurrent) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+	if !isV2 {
+		return
+	}
+	m.Total = binary.LittleEndian.Uint16(buf[2:])
+	m.MissionState = MISSION_STATE(buf[4])
+	m.MissionMode = buf[5]
+	m.MissionId = binary.LittleEndian.Uint32(buf[6:])
+	m.FenceId = binary.LittleEndian.Uint32(buf[10:])
+	m.RallyPointsId = binary.LittleEndian.Uint32(buf[14:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionCurrent) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[42]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message encoding a mission item.
 // This message is emitted to announce the presence of a mission item and to set a mission item on the system.
 // The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). NaN may be used to indicate an optional/default value (e.g. to use the system's current latitude or yaw rather than a specific value). See also https://mavlink.io/en/services/mission.html.
@@ -42,3 +49,65 @@ type MessageMissionItem struct {
 func (*MessageMissionItem) GetID() uint32 {
 	return 39
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionItem) MAVLinkCRCExtra() byte {
+	return 254
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionItem) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 38
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionItem) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Param1))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Param2))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Param3))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Param4))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint16(buf[28:], m.Seq)
+	binary.LittleEndian.PutUint16(buf[30:], uint16(m.Command))
+	buf[32] = m.TargetSystem
+	buf[33] = m.TargetComponent
+	buf[34] = byte(m.Frame)
+	buf[35] = m.Current
+	buf[36] = m.Autocontinue
+	if !isV2 {
+		return
+	}
+	buf[37] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionItem) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Seq = binary.LittleEndian.Uint16(buf[28:])
+	m.Command = MAV_CMD(binary.LittleEndian.Uint16(buf[30:]))
+	m.TargetSystem = buf[32]
+	m.TargetComponent = buf[33]
+	m.Frame = MAV_FRAME(buf[34])
+	m.Current = buf[35]
+	m.Autocontinue = buf[36]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[37])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionItem) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[39]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // A certain mission item has been reached. The system will either hold this position (or circle on the orbit) or (if the autocontinue on the WP was set) continue to the next waypoint.
 type MessageMissionItemReached struct {
 	// Sequence
@@ -12,3 +18,31 @@ type MessageMissionItemReached struct {
 func (*MessageMissionItemReached) GetID() uint32 {
 	return 46
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionItemReached) MAVLinkCRCExtra() byte {
+	return 11
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionItemReached) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionItemReached) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionItemReached) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionItemReached) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[46]
+}
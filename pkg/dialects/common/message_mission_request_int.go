@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request the information of the mission item with the sequence number seq. The response of the system to this message should be a MISSION_ITEM_INT message. https://mavlink.io/en/services/mission.html
 type MessageMissionRequestInt struct {
 	// System ID
@@ -18,3 +24,43 @@ type MessageMissionRequestInt struct {
 func (*MessageMissionRequestInt) GetID() uint32 {
 	return 51
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionRequestInt) MAVLinkCRCExtra() byte {
+	return 196
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionRequestInt) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 5
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestInt) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[4] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestInt) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[4])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionRequestInt) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[51]
+}
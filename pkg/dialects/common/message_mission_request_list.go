@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request the overall list of mission items from the system/component.
 type MessageMissionRequestList struct {
 	// System ID
@@ -16,3 +20,41 @@ type MessageMissionRequestList struct {
 func (*MessageMissionRequestList) GetID() uint32 {
 	return 43
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionRequestList) MAVLinkCRCExtra() byte {
+	return 132
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionRequestList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestList) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[2] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[2])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionRequestList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[43]
+}
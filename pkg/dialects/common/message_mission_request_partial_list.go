@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a partial list of mission items from the system/component. https://mavlink.io/en/services/mission.html. If start and end index are the same, just send one waypoint.
 type MessageMissionRequestPartialList struct {
 	// System ID
@@ -20,3 +26,45 @@ type MessageMissionRequestPartialList struct {
 func (*MessageMissionRequestPartialList) GetID() uint32 {
 	return 37
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionRequestPartialList) MAVLinkCRCExtra() byte {
+	return 212
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionRequestPartialList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestPartialList) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.StartIndex))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.EndIndex))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[6] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionRequestPartialList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.StartIndex = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.EndIndex = int16(binary.LittleEndian.Uint16(buf[2:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[6])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionRequestPartialList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[37]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set the mission item with sequence number seq as the current item and emit MISSION_CURRENT (whether or not the mission number changed).
 // If a mission is currently being executed, the system will continue to this new mission item on the shortest path, skipping any intermediate mission items.
 // Note that mission jump repeat counters are not reset (see MAV_CMD_DO_JUMP param2).
@@ -21,3 +27,35 @@ type MessageMissionSetCurrent struct {
 func (*MessageMissionSetCurrent) GetID() uint32 {
 	return 41
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionSetCurrent) MAVLinkCRCExtra() byte {
+	return 28
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionSetCurrent) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionSetCurrent) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionSetCurrent) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionSetCurrent) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[41]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // This message is sent to the MAV to write a partial list. If start index == end index, only one item will be transmitted / updated. If the start index is NOT 0 and above the current list size, this request should be REJECTED!
 type MessageMissionWritePartialList struct {
 	// System ID
@@ -20,3 +26,45 @@ type MessageMissionWritePartialList struct {
 func (*MessageMissionWritePartialList) GetID() uint32 {
 	return 38
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMissionWritePartialList) MAVLinkCRCExtra() byte {
+	return 9
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMissionWritePartialList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionWritePartialList) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.StartIndex))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.EndIndex))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	buf[6] = byte(m.MissionType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMissionWritePartialList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.StartIndex = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.EndIndex = int16(binary.LittleEndian.Uint16(buf[2:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	if !isV2 {
+		return
+	}
+	m.MissionType = MAV_MISSION_TYPE(buf[6])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMissionWritePartialList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[38]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Orientation of a mount
 type MessageMountOrientation struct {
 	// Timestamp (time since system boot).
@@ -20,3 +27,45 @@ type MessageMountOrientation struct {
 func (*MessageMountOrientation) GetID() uint32 {
 	return 265
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMountOrientation) MAVLinkCRCExtra() byte {
+	return 26
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMountOrientation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountOrientation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Yaw))
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.YawAbsolute))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMountOrientation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	if !isV2 {
+		return
+	}
+	m.YawAbsolute = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMountOrientation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[265]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a key-value pair as float. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type MessageNamedValueFloat struct {
 	// Timestamp (time since system boot).
@@ -16,3 +24,39 @@ type MessageNamedValueFloat struct {
 func (*MessageNamedValueFloat) GetID() uint32 {
 	return 251
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageNamedValueFloat) MAVLinkCRCExtra() byte {
+	return 170
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageNamedValueFloat) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueFloat) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Value))
+	copy(buf[8:18], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueFloat) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Value = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	if n := bytes.IndexByte(buf[8:18], 0); n >= 0 {
+		m.Name = string(buf[8 : 8+n])
+	} else {
+		m.Name = string(buf[8:18])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageNamedValueFloat) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[251]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send a key-value pair as integer. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type MessageNamedValueInt struct {
 	// Timestamp (time since system boot).
@@ -16,3 +23,39 @@ type MessageNamedValueInt struct {
 func (*MessageNamedValueInt) GetID() uint32 {
 	return 252
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageNamedValueInt) MAVLinkCRCExtra() byte {
+	return 44
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageNamedValueInt) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueInt) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Value))
+	copy(buf[8:18], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNamedValueInt) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Value = int32(binary.LittleEndian.Uint32(buf[4:]))
+	if n := bytes.IndexByte(buf[8:18], 0); n >= 0 {
+		m.Name = string(buf[8 : 8+n])
+	} else {
+		m.Name = string(buf[8:18])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageNamedValueInt) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[252]
+}
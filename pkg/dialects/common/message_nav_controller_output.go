@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The state of the navigation and position controller.
 type MessageNavControllerOutput struct {
 	// Current desired roll
@@ -26,3 +33,45 @@ type MessageNavControllerOutput struct {
 func (*MessageNavControllerOutput) GetID() uint32 {
 	return 62
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageNavControllerOutput) MAVLinkCRCExtra() byte {
+	return 183
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageNavControllerOutput) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 26
+	}
+	return 26
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNavControllerOutput) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.NavRoll))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.NavPitch))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.AltError))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AspdError))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.XtrackError))
+	binary.LittleEndian.PutUint16(buf[20:], uint16(m.NavBearing))
+	binary.LittleEndian.PutUint16(buf[22:], uint16(m.TargetBearing))
+	binary.LittleEndian.PutUint16(buf[24:], m.WpDist)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageNavControllerOutput) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.NavRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.NavPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.AltError = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.AspdError = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.XtrackError = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.NavBearing = int16(binary.LittleEndian.Uint16(buf[20:]))
+	m.TargetBearing = int16(binary.LittleEndian.Uint16(buf[22:]))
+	m.WpDist = binary.LittleEndian.Uint16(buf[24:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageNavControllerOutput) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[62]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Obstacle distances in front of the sensor, starting from the left in increment degrees to the right
 type MessageObstacleDistance struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -28,3 +35,57 @@ type MessageObstacleDistance struct {
 func (*MessageObstacleDistance) GetID() uint32 {
 	return 330
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageObstacleDistance) MAVLinkCRCExtra() byte {
+	return 23
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageObstacleDistance) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 167
+	}
+	return 158
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageObstacleDistance) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.Distances {
+		binary.LittleEndian.PutUint16(buf[8+i*2:], v)
+	}
+	binary.LittleEndian.PutUint16(buf[152:], m.MinDistance)
+	binary.LittleEndian.PutUint16(buf[154:], m.MaxDistance)
+	buf[156] = byte(m.SensorType)
+	buf[157] = m.Increment
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[158:], math.Float32bits(m.IncrementF))
+	binary.LittleEndian.PutUint32(buf[162:], math.Float32bits(m.AngleOffset))
+	buf[166] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageObstacleDistance) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.Distances {
+		m.Distances[i] = binary.LittleEndian.Uint16(buf[8+i*2:])
+	}
+	m.MinDistance = binary.LittleEndian.Uint16(buf[152:])
+	m.MaxDistance = binary.LittleEndian.Uint16(buf[154:])
+	m.SensorType = MAV_DISTANCE_SENSOR(buf[156])
+	m.Increment = buf[157]
+	if !isV2 {
+		return
+	}
+	m.IncrementF = math.Float32frombits(binary.LittleEndian.Uint32(buf[158:]))
+	m.AngleOffset = math.Float32frombits(binary.LittleEndian.Uint32(buf[162:]))
+	m.Frame = MAV_FRAME(buf[166])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageObstacleDistance) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[330]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Hardware status sent by an onboard computer.
 type MessageOnboardComputerStatus struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -52,3 +58,133 @@ type MessageOnboardComputerStatus struct {
 func (*MessageOnboardComputerStatus) GetID() uint32 {
 	return 390
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOnboardComputerStatus) MAVLinkCRCExtra() byte {
+	return 156
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOnboardComputerStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 240
+	}
+	return 238
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOnboardComputerStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.Uptime)
+	binary.LittleEndian.PutUint32(buf[12:], m.RamUsage)
+	binary.LittleEndian.PutUint32(buf[16:], m.RamTotal)
+	for i, v := range m.StorageType {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], v)
+	}
+	for i, v := range m.StorageUsage {
+		binary.LittleEndian.PutUint32(buf[36+i*4:], v)
+	}
+	for i, v := range m.StorageTotal {
+		binary.LittleEndian.PutUint32(buf[52+i*4:], v)
+	}
+	for i, v := range m.LinkType {
+		binary.LittleEndian.PutUint32(buf[68+i*4:], v)
+	}
+	for i, v := range m.LinkTxRate {
+		binary.LittleEndian.PutUint32(buf[92+i*4:], v)
+	}
+	for i, v := range m.LinkRxRate {
+		binary.LittleEndian.PutUint32(buf[116+i*4:], v)
+	}
+	for i, v := range m.LinkTxMax {
+		binary.LittleEndian.PutUint32(buf[140+i*4:], v)
+	}
+	for i, v := range m.LinkRxMax {
+		binary.LittleEndian.PutUint32(buf[164+i*4:], v)
+	}
+	for i, v := range m.FanSpeed {
+		binary.LittleEndian.PutUint16(buf[188+i*2:], uint16(v))
+	}
+	buf[196] = m.Type
+	for i, v := range m.CpuCores {
+		buf[197+i] = v
+	}
+	for i, v := range m.CpuCombined {
+		buf[205+i] = v
+	}
+	for i, v := range m.GpuCores {
+		buf[215+i] = v
+	}
+	for i, v := range m.GpuCombined {
+		buf[219+i] = v
+	}
+	buf[229] = byte(m.TemperatureBoard)
+	for i, v := range m.TemperatureCore {
+		buf[230+i] = byte(v)
+	}
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[238:], uint16(m.StatusFlags))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOnboardComputerStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Uptime = binary.LittleEndian.Uint32(buf[8:])
+	m.RamUsage = binary.LittleEndian.Uint32(buf[12:])
+	m.RamTotal = binary.LittleEndian.Uint32(buf[16:])
+	for i := range m.StorageType {
+		m.StorageType[i] = binary.LittleEndian.Uint32(buf[20+i*4:])
+	}
+	for i := range m.StorageUsage {
+		m.StorageUsage[i] = binary.LittleEndian.Uint32(buf[36+i*4:])
+	}
+	for i := range m.StorageTotal {
+		m.StorageTotal[i] = binary.LittleEndian.Uint32(buf[52+i*4:])
+	}
+	for i := range m.LinkType {
+		m.LinkType[i] = binary.LittleEndian.Uint32(buf[68+i*4:])
+	}
+	for i := range m.LinkTxRate {
+		m.LinkTxRate[i] = binary.LittleEndian.Uint32(buf[92+i*4:])
+	}
+	for i := range m.LinkRxRate {
+		m.LinkRxRate[i] = binary.LittleEndian.Uint32(buf[116+i*4:])
+	}
+	for i := range m.LinkTxMax {
+		m.LinkTxMax[i] = binary.LittleEndian.Uint32(buf[140+i*4:])
+	}
+	for i := range m.LinkRxMax {
+		m.LinkRxMax[i] = binary.LittleEndian.Uint32(buf[164+i*4:])
+	}
+	for i := range m.FanSpeed {
+		m.FanSpeed[i] = int16(binary.LittleEndian.Uint16(buf[188+i*2:]))
+	}
+	m.Type = buf[196]
+	for i := range m.CpuCores {
+		m.CpuCores[i] = buf[197+i]
+	}
+	for i := range m.CpuCombined {
+		m.CpuCombined[i] = buf[205+i]
+	}
+	for i := range m.GpuCores {
+		m.GpuCores[i] = buf[215+i]
+	}
+	for i := range m.GpuCombined {
+		m.GpuCombined[i] = buf[219+i]
+	}
+	m.TemperatureBoard = int8(buf[229])
+	for i := range m.TemperatureCore {
+		m.TemperatureCore[i] = int8(buf[230+i])
+	}
+	if !isV2 {
+		return
+	}
+	m.StatusFlags = COMPUTER_STATUS_FLAGS(binary.LittleEndian.Uint16(buf[238:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOnboardComputerStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[390]
+}
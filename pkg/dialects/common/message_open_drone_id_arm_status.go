@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Transmitter (remote ID system) is enabled and ready to start sending location and other required information. This is streamed by transmitter. A flight controller uses it as a condition to arm.
 type MessageOpenDroneIdArmStatus struct {
 	// Status level indicating if arming is allowed.
@@ -14,3 +20,37 @@ type MessageOpenDroneIdArmStatus struct {
 func (*MessageOpenDroneIdArmStatus) GetID() uint32 {
 	return 12918
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdArmStatus) MAVLinkCRCExtra() byte {
+	return 139
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdArmStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 51
+	}
+	return 51
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdArmStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Status)
+	copy(buf[1:51], m.Error)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdArmStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Status = MAV_ODID_ARM_STATUS(buf[0])
+	if n := bytes.IndexByte(buf[1:51], 0); n >= 0 {
+		m.Error = string(buf[1 : 1+n])
+	} else {
+		m.Error = string(buf[1:51])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdArmStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12918]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID Authentication message. The Authentication Message defines a field that can provide a means of authenticity for the identity of the UAS (Unmanned Aircraft System). The Authentication message can have two different formats. For data page 0, the fields PageCount, Length and TimeStamp are present and AuthData is only 17 bytes. For data page 1 through 15, PageCount, Length and TimeStamp are not present and the size of AuthData is 23 bytes.
 type MessageOpenDroneIdAuthentication struct {
 	// System ID (0 for broadcast).
@@ -28,3 +34,55 @@ type MessageOpenDroneIdAuthentication struct {
 func (*MessageOpenDroneIdAuthentication) GetID() uint32 {
 	return 12902
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdAuthentication) MAVLinkCRCExtra() byte {
+	return 140
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdAuthentication) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 53
+	}
+	return 53
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdAuthentication) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Timestamp)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[6+i] = v
+	}
+	buf[26] = byte(m.AuthenticationType)
+	buf[27] = m.DataPage
+	buf[28] = m.LastPageIndex
+	buf[29] = m.Length
+	for i, v := range m.AuthenticationData {
+		buf[30+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdAuthentication) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[6+i]
+	}
+	m.AuthenticationType = MAV_ODID_AUTH_TYPE(buf[26])
+	m.DataPage = buf[27]
+	m.LastPageIndex = buf[28]
+	m.Length = buf[29]
+	for i := range m.AuthenticationData {
+		m.AuthenticationData[i] = buf[30+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdAuthentication) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12902]
+}
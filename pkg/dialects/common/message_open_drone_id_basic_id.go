@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID Basic ID message. This and the below messages are primarily meant for feeding data to/from an OpenDroneID implementation. E.g. https://github.com/opendroneid/opendroneid-core-c. These messages are compatible with the ASTM F3411 Remote ID standard and the ASD-STAN prEN 4709-002 Direct Remote ID standard. Additional information and usage of these messages is documented at https://mavlink.io/en/services/opendroneid.html.
 type MessageOpenDroneIdBasicId struct {
 	// System ID (0 for broadcast).
@@ -22,3 +26,49 @@ type MessageOpenDroneIdBasicId struct {
 func (*MessageOpenDroneIdBasicId) GetID() uint32 {
 	return 12900
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdBasicId) MAVLinkCRCExtra() byte {
+	return 114
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdBasicId) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 44
+	}
+	return 44
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdBasicId) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[2+i] = v
+	}
+	buf[22] = byte(m.IdType)
+	buf[23] = byte(m.UaType)
+	for i, v := range m.UasId {
+		buf[24+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdBasicId) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[2+i]
+	}
+	m.IdType = MAV_ODID_ID_TYPE(buf[22])
+	m.UaType = MAV_ODID_UA_TYPE(buf[23])
+	for i := range m.UasId {
+		m.UasId[i] = buf[24+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdBasicId) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12900]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID Location message. The float data types are 32-bit IEEE 754. The Location message provides the location, altitude, direction and speed of the aircraft.
 type MessageOpenDroneIdLocation struct {
 	// System ID (0 for broadcast).
@@ -48,3 +55,71 @@ type MessageOpenDroneIdLocation struct {
 func (*MessageOpenDroneIdLocation) GetID() uint32 {
 	return 12901
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdLocation) MAVLinkCRCExtra() byte {
+	return 254
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdLocation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 59
+	}
+	return 59
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdLocation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Latitude))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Longitude))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.AltitudeBarometric))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AltitudeGeodetic))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Height))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Timestamp))
+	binary.LittleEndian.PutUint16(buf[24:], m.Direction)
+	binary.LittleEndian.PutUint16(buf[26:], m.SpeedHorizontal)
+	binary.LittleEndian.PutUint16(buf[28:], uint16(m.SpeedVertical))
+	buf[30] = m.TargetSystem
+	buf[31] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[32+i] = v
+	}
+	buf[52] = byte(m.Status)
+	buf[53] = byte(m.HeightReference)
+	buf[54] = byte(m.HorizontalAccuracy)
+	buf[55] = byte(m.VerticalAccuracy)
+	buf[56] = byte(m.BarometerAccuracy)
+	buf[57] = byte(m.SpeedAccuracy)
+	buf[58] = byte(m.TimestampAccuracy)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdLocation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Latitude = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Longitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.AltitudeBarometric = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.AltitudeGeodetic = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Height = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Timestamp = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Direction = binary.LittleEndian.Uint16(buf[24:])
+	m.SpeedHorizontal = binary.LittleEndian.Uint16(buf[26:])
+	m.SpeedVertical = int16(binary.LittleEndian.Uint16(buf[28:]))
+	m.TargetSystem = buf[30]
+	m.TargetComponent = buf[31]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[32+i]
+	}
+	m.Status = MAV_ODID_STATUS(buf[52])
+	m.HeightReference = MAV_ODID_HEIGHT_REF(buf[53])
+	m.HorizontalAccuracy = MAV_ODID_HOR_ACC(buf[54])
+	m.VerticalAccuracy = MAV_ODID_VER_ACC(buf[55])
+	m.BarometerAccuracy = MAV_ODID_VER_ACC(buf[56])
+	m.SpeedAccuracy = MAV_ODID_SPEED_ACC(buf[57])
+	m.TimestampAccuracy = MAV_ODID_TIME_ACC(buf[58])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdLocation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12901]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // An OpenDroneID message pack is a container for multiple encoded OpenDroneID messages (i.e. not in the format given for the above message descriptions but after encoding into the compressed OpenDroneID byte format). Used e.g. when transmitting on Bluetooth 5.0 Long Range/Extended Advertising or on WiFi Neighbor Aware Networking or on WiFi Beacon.
 type MessageOpenDroneIdMessagePack struct {
 	// System ID (0 for broadcast).
@@ -22,3 +26,49 @@ type MessageOpenDroneIdMessagePack struct {
 func (*MessageOpenDroneIdMessagePack) GetID() uint32 {
 	return 12915
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdMessagePack) MAVLinkCRCExtra() byte {
+	return 94
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdMessagePack) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 249
+	}
+	return 249
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdMessagePack) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[2+i] = v
+	}
+	buf[22] = m.SingleMessageSize
+	buf[23] = m.MsgPackSize
+	for i, v := range m.Messages {
+		buf[24+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdMessagePack) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[2+i]
+	}
+	m.SingleMessageSize = buf[22]
+	m.MsgPackSize = buf[23]
+	for i := range m.Messages {
+		m.Messages[i] = buf[24+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdMessagePack) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12915]
+}
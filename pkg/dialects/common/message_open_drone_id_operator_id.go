@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID Operator ID message, which contains the CAA (Civil Aviation Authority) issued operator ID.
 type MessageOpenDroneIdOperatorId struct {
 	// System ID (0 for broadcast).
@@ -20,3 +26,47 @@ type MessageOpenDroneIdOperatorId struct {
 func (*MessageOpenDroneIdOperatorId) GetID() uint32 {
 	return 12905
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdOperatorId) MAVLinkCRCExtra() byte {
+	return 49
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdOperatorId) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 43
+	}
+	return 43
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdOperatorId) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[2+i] = v
+	}
+	buf[22] = byte(m.OperatorIdType)
+	copy(buf[23:43], m.OperatorId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdOperatorId) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[2+i]
+	}
+	m.OperatorIdType = MAV_ODID_OPERATOR_ID_TYPE(buf[22])
+	if n := bytes.IndexByte(buf[23:43], 0); n >= 0 {
+		m.OperatorId = string(buf[23 : 23+n])
+	} else {
+		m.OperatorId = string(buf[23:43])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdOperatorId) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12905]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID Self ID message. The Self ID Message is an opportunity for the operator to (optionally) declare their identity and purpose of the flight. This message can provide additional information that could reduce the threat profile of a UA (Unmanned Aircraft) flying in a particular area or manner. This message can also be used to provide optional additional clarification in an emergency/remote ID system failure situation.
 type MessageOpenDroneIdSelfId struct {
 	// System ID (0 for broadcast).
@@ -20,3 +26,47 @@ type MessageOpenDroneIdSelfId struct {
 func (*MessageOpenDroneIdSelfId) GetID() uint32 {
 	return 12903
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSelfId) MAVLinkCRCExtra() byte {
+	return 249
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSelfId) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 46
+	}
+	return 46
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSelfId) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[2+i] = v
+	}
+	buf[22] = byte(m.DescriptionType)
+	copy(buf[23:46], m.Description)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSelfId) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[2+i]
+	}
+	m.DescriptionType = MAV_ODID_DESC_TYPE(buf[22])
+	if n := bytes.IndexByte(buf[23:46], 0); n >= 0 {
+		m.Description = string(buf[23 : 23+n])
+	} else {
+		m.Description = string(buf[23:46])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdSelfId) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12903]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for filling the OpenDroneID System message. The System Message contains general system information including the operator location/altitude and possible aircraft group and/or category/class information.
 type MessageOpenDroneIdSystem struct {
 	// System ID (0 for broadcast).
@@ -40,3 +47,63 @@ type MessageOpenDroneIdSystem struct {
 func (*MessageOpenDroneIdSystem) GetID() uint32 {
 	return 12904
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSystem) MAVLinkCRCExtra() byte {
+	return 77
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSystem) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 54
+	}
+	return 54
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSystem) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.OperatorLatitude))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.OperatorLongitude))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.AreaCeiling))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AreaFloor))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.OperatorAltitudeGeo))
+	binary.LittleEndian.PutUint32(buf[20:], m.Timestamp)
+	binary.LittleEndian.PutUint16(buf[24:], m.AreaCount)
+	binary.LittleEndian.PutUint16(buf[26:], m.AreaRadius)
+	buf[28] = m.TargetSystem
+	buf[29] = m.TargetComponent
+	for i, v := range m.IdOrMac {
+		buf[30+i] = v
+	}
+	buf[50] = byte(m.OperatorLocationType)
+	buf[51] = byte(m.ClassificationType)
+	buf[52] = byte(m.CategoryEu)
+	buf[53] = byte(m.ClassEu)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSystem) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.OperatorLatitude = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.OperatorLongitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.AreaCeiling = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.AreaFloor = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.OperatorAltitudeGeo = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Timestamp = binary.LittleEndian.Uint32(buf[20:])
+	m.AreaCount = binary.LittleEndian.Uint16(buf[24:])
+	m.AreaRadius = binary.LittleEndian.Uint16(buf[26:])
+	m.TargetSystem = buf[28]
+	m.TargetComponent = buf[29]
+	for i := range m.IdOrMac {
+		m.IdOrMac[i] = buf[30+i]
+	}
+	m.OperatorLocationType = MAV_ODID_OPERATOR_LOCATION_TYPE(buf[50])
+	m.ClassificationType = MAV_ODID_CLASSIFICATION_TYPE(buf[51])
+	m.CategoryEu = MAV_ODID_CATEGORY_EU(buf[52])
+	m.ClassEu = MAV_ODID_CLASS_EU(buf[53])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdSystem) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12904]
+}
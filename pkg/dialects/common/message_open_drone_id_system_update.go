@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Update the data in the OPEN_DRONE_ID_SYSTEM message with new location information. This can be sent to update the location information for the operator when no other information in the SYSTEM message has changed. This message allows for efficient operation on radio links which have limited uplink bandwidth while meeting requirements for update frequency of the operator location.
 type MessageOpenDroneIdSystemUpdate struct {
 	// System ID (0 for broadcast).
@@ -22,3 +29,41 @@ type MessageOpenDroneIdSystemUpdate struct {
 func (*MessageOpenDroneIdSystemUpdate) GetID() uint32 {
 	return 12919
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSystemUpdate) MAVLinkCRCExtra() byte {
+	return 7
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOpenDroneIdSystemUpdate) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSystemUpdate) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.OperatorLatitude))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.OperatorLongitude))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.OperatorAltitudeGeo))
+	binary.LittleEndian.PutUint32(buf[12:], m.Timestamp)
+	buf[16] = m.TargetSystem
+	buf[17] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOpenDroneIdSystemUpdate) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.OperatorLatitude = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.OperatorLongitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.OperatorAltitudeGeo = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Timestamp = binary.LittleEndian.Uint32(buf[12:])
+	m.TargetSystem = buf[16]
+	m.TargetComponent = buf[17]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOpenDroneIdSystemUpdate) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[12919]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Vehicle status report that is sent out while orbit execution is in progress (see MAV_CMD_DO_ORBIT).
 type MessageOrbitExecutionStatus struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -22,3 +29,41 @@ type MessageOrbitExecutionStatus struct {
 func (*MessageOrbitExecutionStatus) GetID() uint32 {
 	return 360
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageOrbitExecutionStatus) MAVLinkCRCExtra() byte {
+	return 11
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageOrbitExecutionStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 25
+	}
+	return 25
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOrbitExecutionStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Radius))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.X))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Y))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Z))
+	buf[24] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageOrbitExecutionStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Radius = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.X = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Y = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Frame = MAV_FRAME(buf[24])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageOrbitExecutionStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[360]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Parameter set/get error. Returned from a MAVLink node in response to an error in the parameter protocol, for example failing to set a parameter because it does not exist.
 type MessageParamError struct {
 	// System ID
@@ -20,3 +27,43 @@ type MessageParamError struct {
 func (*MessageParamError) GetID() uint32 {
 	return 345
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamError) MAVLinkCRCExtra() byte {
+	return 209
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamError) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 21
+	}
+	return 21
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamError) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.ParamIndex))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	copy(buf[4:20], m.ParamId)
+	buf[20] = byte(m.Error)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamError) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamIndex = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if n := bytes.IndexByte(buf[4:20], 0); n >= 0 {
+		m.ParamId = string(buf[4 : 4+n])
+	} else {
+		m.ParamId = string(buf[4:20])
+	}
+	m.Error = MAV_PARAM_ERROR(buf[20])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamError) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[345]
+}
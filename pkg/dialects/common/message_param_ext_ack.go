@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Response from a PARAM_EXT_SET message.
 type MessageParamExtAck struct {
 	// Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
@@ -18,3 +24,45 @@ type MessageParamExtAck struct {
 func (*MessageParamExtAck) GetID() uint32 {
 	return 324
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamExtAck) MAVLinkCRCExtra() byte {
+	return 132
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamExtAck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 146
+	}
+	return 146
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtAck) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:16], m.ParamId)
+	copy(buf[16:144], m.ParamValue)
+	buf[144] = byte(m.ParamType)
+	buf[145] = byte(m.ParamResult)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtAck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:16], 0); n >= 0 {
+		m.ParamId = string(buf[0 : 0+n])
+	} else {
+		m.ParamId = string(buf[0:16])
+	}
+	if n := bytes.IndexByte(buf[16:144], 0); n >= 0 {
+		m.ParamValue = string(buf[16 : 16+n])
+	} else {
+		m.ParamValue = string(buf[16:144])
+	}
+	m.ParamType = MAV_PARAM_EXT_TYPE(buf[144])
+	m.ParamResult = PARAM_ACK(buf[145])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamExtAck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[324]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request all parameters of this component. All parameters should be emitted in response as PARAM_EXT_VALUE.
 type MessageParamExtRequestList struct {
 	// System ID
@@ -14,3 +18,33 @@ type MessageParamExtRequestList struct {
 func (*MessageParamExtRequestList) GetID() uint32 {
 	return 321
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamExtRequestList) MAVLinkCRCExtra() byte {
+	return 88
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamExtRequestList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtRequestList) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtRequestList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamExtRequestList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[321]
+}
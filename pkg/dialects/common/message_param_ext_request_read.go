@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request to read the value of a parameter with either the param_id string id or param_index. PARAM_EXT_VALUE should be emitted in response.
 type MessageParamExtRequestRead struct {
 	// System ID
@@ -18,3 +25,41 @@ type MessageParamExtRequestRead struct {
 func (*MessageParamExtRequestRead) GetID() uint32 {
 	return 320
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamExtRequestRead) MAVLinkCRCExtra() byte {
+	return 243
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamExtRequestRead) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtRequestRead) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.ParamIndex))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	copy(buf[4:20], m.ParamId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtRequestRead) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamIndex = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if n := bytes.IndexByte(buf[4:20], 0); n >= 0 {
+		m.ParamId = string(buf[4 : 4+n])
+	} else {
+		m.ParamId = string(buf[4:20])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamExtRequestRead) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[320]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set a parameter value. In order to deal with message loss (and retransmission of PARAM_EXT_SET), when setting a parameter value and the new value is the same as the current value, you will immediately get a PARAM_ACK_ACCEPTED response. If the current state is PARAM_ACK_IN_PROGRESS, you will accordingly receive a PARAM_ACK_IN_PROGRESS in response.
 type MessageParamExtSet struct {
 	// System ID
@@ -20,3 +26,47 @@ type MessageParamExtSet struct {
 func (*MessageParamExtSet) GetID() uint32 {
 	return 323
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamExtSet) MAVLinkCRCExtra() byte {
+	return 78
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamExtSet) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 147
+	}
+	return 147
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtSet) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	copy(buf[2:18], m.ParamId)
+	copy(buf[18:146], m.ParamValue)
+	buf[146] = byte(m.ParamType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtSet) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	if n := bytes.IndexByte(buf[2:18], 0); n >= 0 {
+		m.ParamId = string(buf[2 : 2+n])
+	} else {
+		m.ParamId = string(buf[2:18])
+	}
+	if n := bytes.IndexByte(buf[18:146], 0); n >= 0 {
+		m.ParamValue = string(buf[18 : 18+n])
+	} else {
+		m.ParamValue = string(buf[18:146])
+	}
+	m.ParamType = MAV_PARAM_EXT_TYPE(buf[146])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamExtSet) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[323]
+}
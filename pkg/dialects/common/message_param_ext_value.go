@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Emit the value of a parameter. The inclusion of param_count and param_index in the message allows the recipient to keep track of received parameters and allows them to re-request missing parameters after a loss or timeout.
 type MessageParamExtValue struct {
 	// Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
@@ -20,3 +27,47 @@ type MessageParamExtValue struct {
 func (*MessageParamExtValue) GetID() uint32 {
 	return 322
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamExtValue) MAVLinkCRCExtra() byte {
+	return 243
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamExtValue) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 149
+	}
+	return 149
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtValue) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.ParamCount)
+	binary.LittleEndian.PutUint16(buf[2:], m.ParamIndex)
+	copy(buf[4:20], m.ParamId)
+	copy(buf[20:148], m.ParamValue)
+	buf[148] = byte(m.ParamType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamExtValue) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamCount = binary.LittleEndian.Uint16(buf[0:])
+	m.ParamIndex = binary.LittleEndian.Uint16(buf[2:])
+	if n := bytes.IndexByte(buf[4:20], 0); n >= 0 {
+		m.ParamId = string(buf[4 : 4+n])
+	} else {
+		m.ParamId = string(buf[4:20])
+	}
+	if n := bytes.IndexByte(buf[20:148], 0); n >= 0 {
+		m.ParamValue = string(buf[20 : 20+n])
+	} else {
+		m.ParamValue = string(buf[20:148])
+	}
+	m.ParamType = MAV_PARAM_EXT_TYPE(buf[148])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamExtValue) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[322]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Bind a RC channel to a parameter. The parameter should change according to the RC channel value.
 type MessageParamMapRc struct {
 	// System ID
@@ -28,3 +36,51 @@ type MessageParamMapRc struct {
 func (*MessageParamMapRc) GetID() uint32 {
 	return 50
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamMapRc) MAVLinkCRCExtra() byte {
+	return 78
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamMapRc) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 37
+	}
+	return 37
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamMapRc) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.ParamValue0))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Scale))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.ParamValueMin))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.ParamValueMax))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.ParamIndex))
+	buf[18] = m.TargetSystem
+	buf[19] = m.TargetComponent
+	copy(buf[20:36], m.ParamId)
+	buf[36] = m.ParameterRcChannelIndex
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamMapRc) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamValue0 = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Scale = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.ParamValueMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.ParamValueMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.ParamIndex = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.TargetSystem = buf[18]
+	m.TargetComponent = buf[19]
+	if n := bytes.IndexByte(buf[20:36], 0); n >= 0 {
+		m.ParamId = string(buf[20 : 20+n])
+	} else {
+		m.ParamId = string(buf[20:36])
+	}
+	m.ParameterRcChannelIndex = buf[36]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamMapRc) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50]
+}
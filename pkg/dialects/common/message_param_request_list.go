@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request all parameters of this component. After this request, all parameters are emitted. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html
 type MessageParamRequestList struct {
 	// System ID
@@ -14,3 +18,33 @@ type MessageParamRequestList struct {
 func (*MessageParamRequestList) GetID() uint32 {
 	return 21
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamRequestList) MAVLinkCRCExtra() byte {
+	return 159
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamRequestList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamRequestList) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamRequestList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamRequestList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[21]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request to read the onboard parameter with the param_id string id. Onboard parameters are stored as key[const char*] -> value[float]. This allows to send a parameter to any other component (such as the GCS) without the need of previous knowledge of possible parameter names. Thus the same GCS can store different parameters for different autopilots. See also https://mavlink.io/en/services/parameter.html for a full documentation of QGroundControl and IMU code.
 type MessageParamRequestRead struct {
 	// System ID
@@ -18,3 +25,41 @@ type MessageParamRequestRead struct {
 func (*MessageParamRequestRead) GetID() uint32 {
 	return 20
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamRequestRead) MAVLinkCRCExtra() byte {
+	return 214
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamRequestRead) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamRequestRead) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.ParamIndex))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	copy(buf[4:20], m.ParamId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamRequestRead) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamIndex = int16(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if n := bytes.IndexByte(buf[4:20], 0); n >= 0 {
+		m.ParamId = string(buf[4 : 4+n])
+	} else {
+		m.ParamId = string(buf[4:20])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamRequestRead) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[20]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set a parameter value (write new value to permanent storage).
 // The receiving component should acknowledge the new parameter value by broadcasting a PARAM_VALUE message (broadcasting ensures that multiple GCS all have an up-to-date list of all parameters). If the sending GCS did not receive a PARAM_VALUE within its timeout time, it should re-send the PARAM_SET message. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html.
 type MessageParamSet struct {
@@ -21,3 +29,43 @@ type MessageParamSet struct {
 func (*MessageParamSet) GetID() uint32 {
 	return 23
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamSet) MAVLinkCRCExtra() byte {
+	return 168
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamSet) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 23
+	}
+	return 23
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamSet) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.ParamValue))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	copy(buf[6:22], m.ParamId)
+	buf[22] = byte(m.ParamType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamSet) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	if n := bytes.IndexByte(buf[6:22], 0); n >= 0 {
+		m.ParamId = string(buf[6 : 6+n])
+	} else {
+		m.ParamId = string(buf[6:22])
+	}
+	m.ParamType = MAV_PARAM_TYPE(buf[22])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamSet) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[23]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Emit the value of a onboard parameter. The inclusion of param_count and param_index in the message allows the recipient to keep track of received parameters and allows him to re-request missing parameters after a loss or timeout. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html
 type MessageParamValue struct {
 	// Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
@@ -20,3 +28,43 @@ type MessageParamValue struct {
 func (*MessageParamValue) GetID() uint32 {
 	return 22
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamValue) MAVLinkCRCExtra() byte {
+	return 220
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamValue) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 25
+	}
+	return 25
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamValue) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.ParamValue))
+	binary.LittleEndian.PutUint16(buf[4:], m.ParamCount)
+	binary.LittleEndian.PutUint16(buf[6:], m.ParamIndex)
+	copy(buf[8:24], m.ParamId)
+	buf[24] = byte(m.ParamType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamValue) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.ParamCount = binary.LittleEndian.Uint16(buf[4:])
+	m.ParamIndex = binary.LittleEndian.Uint16(buf[6:])
+	if n := bytes.IndexByte(buf[8:24], 0); n >= 0 {
+		m.ParamId = string(buf[8 : 8+n])
+	} else {
+		m.ParamId = string(buf[8:24])
+	}
+	m.ParamType = MAV_PARAM_TYPE(buf[24])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamValue) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[22]
+}
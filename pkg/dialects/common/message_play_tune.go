@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control vehicle tone generation (buzzer).
 type MessagePlayTune struct {
 	// System ID
@@ -18,3 +24,51 @@ type MessagePlayTune struct {
 func (*MessagePlayTune) GetID() uint32 {
 	return 258
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessagePlayTune) MAVLinkCRCExtra() byte {
+	return 187
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessagePlayTune) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 232
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePlayTune) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+	copy(buf[2:32], m.Tune)
+	if !isV2 {
+		return
+	}
+	copy(buf[32:232], m.Tune2)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePlayTune) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+	if n := bytes.IndexByte(buf[2:32], 0); n >= 0 {
+		m.Tune = string(buf[2 : 2+n])
+	} else {
+		m.Tune = string(buf[2:32])
+	}
+	if !isV2 {
+		return
+	}
+	if n := bytes.IndexByte(buf[32:232], 0); n >= 0 {
+		m.Tune2 = string(buf[32 : 32+n])
+	} else {
+		m.Tune2 = string(buf[32:232])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessagePlayTune) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[258]
+}
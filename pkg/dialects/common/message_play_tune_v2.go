@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Play vehicle tone/tune (buzzer). Supported tunes can be determined using SUPPORTED_TUNES. Supersedes message PLAY_TUNE.
 type MessagePlayTuneV2 struct {
 	// System ID
@@ -18,3 +25,41 @@ type MessagePlayTuneV2 struct {
 func (*MessagePlayTuneV2) GetID() uint32 {
 	return 400
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessagePlayTuneV2) MAVLinkCRCExtra() byte {
+	return 110
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessagePlayTuneV2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 254
+	}
+	return 254
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePlayTuneV2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Format))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	copy(buf[6:254], m.Tune)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePlayTuneV2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Format = TUNE_FORMAT(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	if n := bytes.IndexByte(buf[6:254], 0); n >= 0 {
+		m.Tune = string(buf[6 : 6+n])
+	} else {
+		m.Tune = string(buf[6:254])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessagePlayTuneV2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[400]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Power supply status
 type MessagePowerStatus struct {
 	// 5V rail voltage.
@@ -16,3 +22,35 @@ type MessagePowerStatus struct {
 func (*MessagePowerStatus) GetID() uint32 {
 	return 125
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessagePowerStatus) MAVLinkCRCExtra() byte {
+	return 203
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessagePowerStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePowerStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Vcc)
+	binary.LittleEndian.PutUint16(buf[2:], m.Vservo)
+	binary.LittleEndian.PutUint16(buf[4:], uint16(m.Flags))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessagePowerStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Vcc = binary.LittleEndian.Uint16(buf[0:])
+	m.Vservo = binary.LittleEndian.Uint16(buf[2:])
+	m.Flags = MAV_POWER_STATUS(binary.LittleEndian.Uint16(buf[4:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessagePowerStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[125]
+}
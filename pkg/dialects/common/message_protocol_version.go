@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Version and capability of protocol version. This message can be requested with MAV_CMD_REQUEST_MESSAGE and is used as part of the handshaking to establish which MAVLink version should be used on the network. Every node should respond to a request for PROTOCOL_VERSION to enable the handshaking. Library implementers should consider adding this into the default decoding state machine to allow the protocol core to respond directly.
 type MessageProtocolVersion struct {
 	// Currently active MAVLink version number * 100: v1.0 is 100, v2.0 is 200, etc.
@@ -20,3 +26,47 @@ type MessageProtocolVersion struct {
 func (*MessageProtocolVersion) GetID() uint32 {
 	return 300
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageProtocolVersion) MAVLinkCRCExtra() byte {
+	return 217
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageProtocolVersion) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 22
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageProtocolVersion) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Version)
+	binary.LittleEndian.PutUint16(buf[2:], m.MinVersion)
+	binary.LittleEndian.PutUint16(buf[4:], m.MaxVersion)
+	for i, v := range m.SpecVersionHash {
+		buf[6+i] = v
+	}
+	for i, v := range m.LibraryVersionHash {
+		buf[14+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageProtocolVersion) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Version = binary.LittleEndian.Uint16(buf[0:])
+	m.MinVersion = binary.LittleEndian.Uint16(buf[2:])
+	m.MaxVersion = binary.LittleEndian.Uint16(buf[4:])
+	for i := range m.SpecVersionHash {
+		m.SpecVersionHash[i] = buf[6+i]
+	}
+	for i := range m.LibraryVersionHash {
+		m.LibraryVersionHash[i] = buf[14+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageProtocolVersion) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[300]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status generated by radio and injected into MAVLink stream.
 type MessageRadioStatus struct {
 	// Local (message sender) received signal strength indication in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown.
@@ -24,3 +30,43 @@ type MessageRadioStatus struct {
 func (*MessageRadioStatus) GetID() uint32 {
 	return 109
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRadioStatus) MAVLinkCRCExtra() byte {
+	return 185
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRadioStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRadioStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Rxerrors)
+	binary.LittleEndian.PutUint16(buf[2:], m.Fixed)
+	buf[4] = m.Rssi
+	buf[5] = m.Remrssi
+	buf[6] = m.Txbuf
+	buf[7] = m.Noise
+	buf[8] = m.Remnoise
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRadioStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Rxerrors = binary.LittleEndian.Uint16(buf[0:])
+	m.Fixed = binary.LittleEndian.Uint16(buf[2:])
+	m.Rssi = buf[4]
+	m.Remrssi = buf[5]
+	m.Txbuf = buf[6]
+	m.Noise = buf[7]
+	m.Remnoise = buf[8]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRadioStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[109]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // RPM sensor data message.
 type MessageRawRpm struct {
 	// Index of this RPM sensor (0-indexed)
@@ -14,3 +21,33 @@ type MessageRawRpm struct {
 func (*MessageRawRpm) GetID() uint32 {
 	return 339
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRawRpm) MAVLinkCRCExtra() byte {
+	return 199
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRawRpm) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 5
+	}
+	return 5
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRawRpm) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Frequency))
+	buf[4] = m.Index
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRawRpm) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Frequency = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Index = buf[4]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRawRpm) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[339]
+}
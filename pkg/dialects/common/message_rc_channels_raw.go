@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The RAW values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. A value of UINT16_MAX implies the channel is unused. Individual receivers/transmitters might violate this specification.
 type MessageRcChannelsRaw struct {
 	// Timestamp (time since system boot).
@@ -32,3 +38,51 @@ type MessageRcChannelsRaw struct {
 func (*MessageRcChannelsRaw) GetID() uint32 {
 	return 35
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRcChannelsRaw) MAVLinkCRCExtra() byte {
+	return 244
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRcChannelsRaw) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 22
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRcChannelsRaw) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint16(buf[4:], m.Chan1Raw)
+	binary.LittleEndian.PutUint16(buf[6:], m.Chan2Raw)
+	binary.LittleEndian.PutUint16(buf[8:], m.Chan3Raw)
+	binary.LittleEndian.PutUint16(buf[10:], m.Chan4Raw)
+	binary.LittleEndian.PutUint16(buf[12:], m.Chan5Raw)
+	binary.LittleEndian.PutUint16(buf[14:], m.Chan6Raw)
+	binary.LittleEndian.PutUint16(buf[16:], m.Chan7Raw)
+	binary.LittleEndian.PutUint16(buf[18:], m.Chan8Raw)
+	buf[20] = m.Port
+	buf[21] = m.Rssi
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRcChannelsRaw) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Chan1Raw = binary.LittleEndian.Uint16(buf[4:])
+	m.Chan2Raw = binary.LittleEndian.Uint16(buf[6:])
+	m.Chan3Raw = binary.LittleEndian.Uint16(buf[8:])
+	m.Chan4Raw = binary.LittleEndian.Uint16(buf[10:])
+	m.Chan5Raw = binary.LittleEndian.Uint16(buf[12:])
+	m.Chan6Raw = binary.LittleEndian.Uint16(buf[14:])
+	m.Chan7Raw = binary.LittleEndian.Uint16(buf[16:])
+	m.Chan8Raw = binary.LittleEndian.Uint16(buf[18:])
+	m.Port = buf[20]
+	m.Rssi = buf[21]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRcChannelsRaw) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[35]
+}
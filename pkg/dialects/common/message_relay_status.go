@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Reports the on/off state of relays, as controlled by MAV_CMD_DO_SET_RELAY.
 // Message streaming should be requested using MAV_CMD_SET_MESSAGE_INTERVAL.
 // Note that it should not be sent on every relay state change to avoid flooding the link.
@@ -18,3 +24,35 @@ type MessageRelayStatus struct {
 func (*MessageRelayStatus) GetID() uint32 {
 	return 376
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRelayStatus) MAVLinkCRCExtra() byte {
+	return 199
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRelayStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRelayStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint16(buf[4:], m.On)
+	binary.LittleEndian.PutUint16(buf[6:], m.Present)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRelayStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.On = binary.LittleEndian.Uint16(buf[4:])
+	m.Present = binary.LittleEndian.Uint16(buf[6:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRelayStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[376]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request a data stream.
 type MessageRequestDataStream struct {
 	// The target requested to send the message stream.
@@ -20,3 +26,39 @@ type MessageRequestDataStream struct {
 func (*MessageRequestDataStream) GetID() uint32 {
 	return 66
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRequestDataStream) MAVLinkCRCExtra() byte {
+	return 148
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRequestDataStream) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRequestDataStream) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.ReqMessageRate)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	buf[4] = byte(m.ReqStreamId)
+	buf[5] = m.StartStop
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRequestDataStream) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ReqMessageRate = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	m.ReqStreamId = MAV_DATA_STREAM(buf[4])
+	m.StartStop = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRequestDataStream) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[66]
+}
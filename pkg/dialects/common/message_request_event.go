@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request one or more events to be (re-)sent. If first_sequence==last_sequence, only a single event is requested. Note that first_sequence can be larger than last_sequence (because the sequence number can wrap). Each sequence will trigger an EVENT or EVENT_ERROR response.
 type MessageRequestEvent struct {
 	// System ID
@@ -18,3 +24,37 @@ type MessageRequestEvent struct {
 func (*MessageRequestEvent) GetID() uint32 {
 	return 412
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRequestEvent) MAVLinkCRCExtra() byte {
+	return 33
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRequestEvent) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRequestEvent) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.FirstSequence)
+	binary.LittleEndian.PutUint16(buf[2:], m.LastSequence)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRequestEvent) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.FirstSequence = binary.LittleEndian.Uint16(buf[0:])
+	m.LastSequence = binary.LittleEndian.Uint16(buf[2:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRequestEvent) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[412]
+}
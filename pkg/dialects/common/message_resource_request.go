@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The autopilot is requesting a resource (file, binary, other type of data)
 type MessageResourceRequest struct {
 	// Request ID. This ID should be reused when sending back URI contents
@@ -20,3 +24,47 @@ type MessageResourceRequest struct {
 func (*MessageResourceRequest) GetID() uint32 {
 	return 142
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageResourceRequest) MAVLinkCRCExtra() byte {
+	return 72
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageResourceRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 243
+	}
+	return 243
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageResourceRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.RequestId
+	buf[1] = m.UriType
+	for i, v := range m.Uri {
+		buf[2+i] = v
+	}
+	buf[122] = m.TransferType
+	for i, v := range m.Storage {
+		buf[123+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageResourceRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RequestId = buf[0]
+	m.UriType = buf[1]
+	for i := range m.Uri {
+		m.Uri[i] = buf[2+i]
+	}
+	m.TransferType = buf[122]
+	for i := range m.Storage {
+		m.Storage[i] = buf[123+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageResourceRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[142]
+}
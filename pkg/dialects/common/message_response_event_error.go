@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Response to a REQUEST_EVENT in case of an error (e.g. the event is not available anymore).
 type MessageResponseEventError struct {
 	// System ID
@@ -20,3 +26,39 @@ type MessageResponseEventError struct {
 func (*MessageResponseEventError) GetID() uint32 {
 	return 413
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageResponseEventError) MAVLinkCRCExtra() byte {
+	return 77
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageResponseEventError) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 7
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageResponseEventError) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Sequence)
+	binary.LittleEndian.PutUint16(buf[2:], m.SequenceOldestAvailable)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = byte(m.Reason)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageResponseEventError) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Sequence = binary.LittleEndian.Uint16(buf[0:])
+	m.SequenceOldestAvailable = binary.LittleEndian.Uint16(buf[2:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.Reason = MAV_EVENT_ERROR_REASON(buf[6])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageResponseEventError) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[413]
+}
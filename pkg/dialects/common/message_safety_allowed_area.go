@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Read out the safety zone the MAV currently assumes.
 type MessageSafetyAllowedArea struct {
 	// Coordinate frame. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down.
@@ -24,3 +31,43 @@ type MessageSafetyAllowedArea struct {
 func (*MessageSafetyAllowedArea) GetID() uint32 {
 	return 55
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSafetyAllowedArea) MAVLinkCRCExtra() byte {
+	return 3
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSafetyAllowedArea) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 25
+	}
+	return 25
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSafetyAllowedArea) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.P1x))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.P1y))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.P1z))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.P2x))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.P2y))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.P2z))
+	buf[24] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSafetyAllowedArea) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.P1x = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.P1y = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.P1z = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.P2x = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.P2y = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.P2z = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Frame = MAV_FRAME(buf[24])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSafetyAllowedArea) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[55]
+}
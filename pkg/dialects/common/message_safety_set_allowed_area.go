@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set a safety zone (volume), which is defined by two corners of a cube. This message can be used to tell the MAV which setpoints/waypoints to accept and which to reject. Safety areas are often enforced by national or competition regulations.
 type MessageSafetySetAllowedArea struct {
 	// System ID
@@ -28,3 +35,47 @@ type MessageSafetySetAllowedArea struct {
 func (*MessageSafetySetAllowedArea) GetID() uint32 {
 	return 54
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSafetySetAllowedArea) MAVLinkCRCExtra() byte {
+	return 15
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSafetySetAllowedArea) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 27
+	}
+	return 27
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSafetySetAllowedArea) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.P1x))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.P1y))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.P1z))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.P2x))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.P2y))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.P2z))
+	buf[24] = m.TargetSystem
+	buf[25] = m.TargetComponent
+	buf[26] = byte(m.Frame)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSafetySetAllowedArea) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.P1x = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.P1y = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.P1z = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.P2x = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.P2y = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.P2z = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.TargetSystem = buf[24]
+	m.TargetComponent = buf[25]
+	m.Frame = MAV_FRAME(buf[26])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSafetySetAllowedArea) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[54]
+}
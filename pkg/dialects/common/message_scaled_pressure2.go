@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Barometer readings for 2nd barometer
 type MessageScaledPressure2 struct {
 	// Timestamp (time since system boot).
@@ -20,3 +27,45 @@ type MessageScaledPressure2 struct {
 func (*MessageScaledPressure2) GetID() uint32 {
 	return 137
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScaledPressure2) MAVLinkCRCExtra() byte {
+	return 195
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScaledPressure2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScaledPressure2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.PressAbs))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.PressDiff))
+	binary.LittleEndian.PutUint16(buf[12:], uint16(m.Temperature))
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[14:], uint16(m.TemperaturePressDiff))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScaledPressure2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[12:]))
+	if !isV2 {
+		return
+	}
+	m.TemperaturePressDiff = int16(binary.LittleEndian.Uint16(buf[14:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScaledPressure2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[137]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control a serial port. This can be used for raw access to an onboard serial peripheral such as a GPS or telemetry radio. It is designed to make it possible to update the devices firmware via MAVLink messages or change the devices settings. A message with zero bytes can be used to change just the baudrate.
 type MessageSerialControl struct {
 	// Serial control device type.
@@ -26,3 +32,55 @@ type MessageSerialControl struct {
 func (*MessageSerialControl) GetID() uint32 {
 	return 126
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSerialControl) MAVLinkCRCExtra() byte {
+	return 220
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSerialControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 81
+	}
+	return 79
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSerialControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Baudrate)
+	binary.LittleEndian.PutUint16(buf[4:], m.Timeout)
+	buf[6] = byte(m.Device)
+	buf[7] = byte(m.Flags)
+	buf[8] = m.Count
+	for i, v := range m.Data {
+		buf[9+i] = v
+	}
+	if !isV2 {
+		return
+	}
+	buf[79] = m.TargetSystem
+	buf[80] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSerialControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Baudrate = binary.LittleEndian.Uint32(buf[0:])
+	m.Timeout = binary.LittleEndian.Uint16(buf[4:])
+	m.Device = SERIAL_CONTROL_DEV(buf[6])
+	m.Flags = SERIAL_CONTROL_FLAG(buf[7])
+	m.Count = buf[8]
+	for i := range m.Data {
+		m.Data[i] = buf[9+i]
+	}
+	if !isV2 {
+		return
+	}
+	m.TargetSystem = buf[79]
+	m.TargetComponent = buf[80]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSerialControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[126]
+}
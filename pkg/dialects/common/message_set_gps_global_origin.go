@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Sets the GPS coordinates of the vehicle local origin (0,0,0) position. Vehicle should emit GPS_GLOBAL_ORIGIN irrespective of whether the origin is changed. This enables transform between the local coordinate frame and the global (GPS) coordinate frame, which may be necessary when (for example) indoor and outdoor settings are connected and the MAV should move from in- to outdoor.
 type MessageSetGpsGlobalOrigin struct {
 	// System ID
@@ -20,3 +26,45 @@ type MessageSetGpsGlobalOrigin struct {
 func (*MessageSetGpsGlobalOrigin) GetID() uint32 {
 	return 48
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSetGpsGlobalOrigin) MAVLinkCRCExtra() byte {
+	return 41
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSetGpsGlobalOrigin) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 21
+	}
+	return 13
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetGpsGlobalOrigin) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Latitude))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Longitude))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Altitude))
+	buf[12] = m.TargetSystem
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint64(buf[13:], m.TimeUsec)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetGpsGlobalOrigin) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Latitude = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Longitude = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Altitude = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.TargetSystem = buf[12]
+	if !isV2 {
+		return
+	}
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[13:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSetGpsGlobalOrigin) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[48]
+}
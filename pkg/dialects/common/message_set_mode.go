@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set the system mode, as defined by enum MAV_MODE_FLAG. There is no target component id as the mode is by definition for the overall aircraft, not only for one component.
 type MessageSetMode struct {
 	// The system setting the mode
@@ -16,3 +22,35 @@ type MessageSetMode struct {
 func (*MessageSetMode) GetID() uint32 {
 	return 11
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSetMode) MAVLinkCRCExtra() byte {
+	return 89
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSetMode) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetMode) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	buf[4] = m.TargetSystem
+	buf[5] = byte(m.BaseMode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetMode) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.BaseMode = MAV_MODE_FLAG(buf[5])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSetMode) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[11]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Setup a MAVLink2 signing key. If called with secret_key of all zero and zero initial_timestamp will disable signing
 type MessageSetupSigning struct {
 	// system id of the target
@@ -18,3 +24,41 @@ type MessageSetupSigning struct {
 func (*MessageSetupSigning) GetID() uint32 {
 	return 256
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSetupSigning) MAVLinkCRCExtra() byte {
+	return 71
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSetupSigning) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 42
+	}
+	return 42
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetupSigning) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.InitialTimestamp)
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+	for i, v := range m.SecretKey {
+		buf[10+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetupSigning) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.InitialTimestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+	for i := range m.SecretKey {
+		m.SecretKey[i] = buf[10+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSetupSigning) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[256]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Smart Battery information (static/infrequent update). Use for updates from: smart battery to flight stack, flight stack to GCS. Use BATTERY_STATUS for the frequent battery updates.
 type MessageSmartBatteryInfo struct {
 	// Battery ID
@@ -44,3 +51,81 @@ type MessageSmartBatteryInfo struct {
 func (*MessageSmartBatteryInfo) GetID() uint32 {
 	return 370
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSmartBatteryInfo) MAVLinkCRCExtra() byte {
+	return 75
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSmartBatteryInfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 109
+	}
+	return 87
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSmartBatteryInfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.CapacityFullSpecification))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.CapacityFull))
+	binary.LittleEndian.PutUint16(buf[8:], m.CycleCount)
+	binary.LittleEndian.PutUint16(buf[10:], m.Weight)
+	binary.LittleEndian.PutUint16(buf[12:], m.DischargeMinimumVoltage)
+	binary.LittleEndian.PutUint16(buf[14:], m.ChargingMinimumVoltage)
+	binary.LittleEndian.PutUint16(buf[16:], m.RestingMinimumVoltage)
+	buf[18] = m.Id
+	buf[19] = byte(m.BatteryFunction)
+	buf[20] = byte(m.Type)
+	copy(buf[21:37], m.SerialNumber)
+	copy(buf[37:87], m.DeviceName)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[87:], m.ChargingMaximumVoltage)
+	buf[89] = m.CellsInSeries
+	binary.LittleEndian.PutUint32(buf[90:], m.DischargeMaximumCurrent)
+	binary.LittleEndian.PutUint32(buf[94:], m.DischargeMaximumBurstCurrent)
+	copy(buf[98:109], m.ManufactureDate)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSmartBatteryInfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CapacityFullSpecification = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.CapacityFull = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.CycleCount = binary.LittleEndian.Uint16(buf[8:])
+	m.Weight = binary.LittleEndian.Uint16(buf[10:])
+	m.DischargeMinimumVoltage = binary.LittleEndian.Uint16(buf[12:])
+	m.ChargingMinimumVoltage = binary.LittleEndian.Uint16(buf[14:])
+	m.RestingMinimumVoltage = binary.LittleEndian.Uint16(buf[16:])
+	m.Id = buf[18]
+	m.BatteryFunction = MAV_BATTERY_FUNCTION(buf[19])
+	m.Type = MAV_BATTERY_TYPE(buf[20])
+	if n := bytes.IndexByte(buf[21:37], 0); n >= 0 {
+		m.SerialNumber = string(buf[21 : 21+n])
+	} else {
+		m.SerialNumber = string(buf[21:37])
+	}
+	if n := bytes.IndexByte(buf[37:87], 0); n >= 0 {
+		m.DeviceName = string(buf[37 : 37+n])
+	} else {
+		m.DeviceName = string(buf[37:87])
+	}
+	if !isV2 {
+		return
+	}
+	m.ChargingMaximumVoltage = binary.LittleEndian.Uint16(buf[87:])
+	m.CellsInSeries = buf[89]
+	m.DischargeMaximumCurrent = binary.LittleEndian.Uint32(buf[90:])
+	m.DischargeMaximumBurstCurrent = binary.LittleEndian.Uint32(buf[94:])
+	if n := bytes.IndexByte(buf[98:109], 0); n >= 0 {
+		m.ManufactureDate = string(buf[98 : 98+n])
+	} else {
+		m.ManufactureDate = string(buf[98:109])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSmartBatteryInfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[370]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status text message. These messages are printed in yellow in the COMM console of QGroundControl. WARNING: They consume quite some bandwidth, so use only for important status and error messages. If implemented wisely, these messages are buffered on the MCU and sent only at a limited rate (e.g. 10 Hz).
 type MessageStatustext struct {
 	// Severity of status. Relies on the definitions within RFC-5424.
@@ -18,3 +25,47 @@ type MessageStatustext struct {
 func (*MessageStatustext) GetID() uint32 {
 	return 253
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStatustext) MAVLinkCRCExtra() byte {
+	return 83
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStatustext) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 54
+	}
+	return 51
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStatustext) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Severity)
+	copy(buf[1:51], m.Text)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint16(buf[51:], m.Id)
+	buf[53] = m.ChunkSeq
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStatustext) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Severity = MAV_SEVERITY(buf[0])
+	if n := bytes.IndexByte(buf[1:51], 0); n >= 0 {
+		m.Text = string(buf[1 : 1+n])
+	} else {
+		m.Text = string(buf[1:51])
+	}
+	if !isV2 {
+		return
+	}
+	m.Id = binary.LittleEndian.Uint16(buf[51:])
+	m.ChunkSeq = buf[53]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStatustext) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[253]
+}
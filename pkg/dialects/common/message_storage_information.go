@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a storage medium. This message is sent in response to a request with MAV_CMD_REQUEST_MESSAGE and whenever the status of the storage changes (STORAGE_STATUS). Use MAV_CMD_REQUEST_MESSAGE.param2 to indicate the index/id of requested storage: 0 for all, 1 for first, 2 for second, etc.
 type MessageStorageInformation struct {
 	// Timestamp (time since system boot).
@@ -37,3 +45,63 @@ type MessageStorageInformation struct {
 func (*MessageStorageInformation) GetID() uint32 {
 	return 261
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorageInformation) MAVLinkCRCExtra() byte {
+	return 179
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorageInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 61
+	}
+	return 27
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorageInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.TotalCapacity))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.UsedCapacity))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.AvailableCapacity))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.ReadSpeed))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.WriteSpeed))
+	buf[24] = m.StorageId
+	buf[25] = m.StorageCount
+	buf[26] = byte(m.Status)
+	if !isV2 {
+		return
+	}
+	buf[27] = byte(m.Type)
+	copy(buf[28:60], m.Name)
+	buf[60] = byte(m.StorageUsage)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorageInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.TotalCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.UsedCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.AvailableCapacity = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.ReadSpeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.WriteSpeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.StorageId = buf[24]
+	m.StorageCount = buf[25]
+	m.Status = STORAGE_STATUS(buf[26])
+	if !isV2 {
+		return
+	}
+	m.Type = STORAGE_TYPE(buf[27])
+	if n := bytes.IndexByte(buf[28:60], 0); n >= 0 {
+		m.Name = string(buf[28 : 28+n])
+	} else {
+		m.Name = string(buf[28:60])
+	}
+	m.StorageUsage = STORAGE_USAGE_FLAG(buf[60])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorageInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[261]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Tune formats supported by vehicle, i.e. via PLAY_TUNE_V2. This should be emitted as response to MAV_CMD_REQUEST_MESSAGE.
 type MessageSupportedTunes struct {
 	// System ID
@@ -16,3 +22,35 @@ type MessageSupportedTunes struct {
 func (*MessageSupportedTunes) GetID() uint32 {
 	return 401
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSupportedTunes) MAVLinkCRCExtra() byte {
+	return 183
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSupportedTunes) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSupportedTunes) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Format))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSupportedTunes) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Format = TUNE_FORMAT(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSupportedTunes) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[401]
+}
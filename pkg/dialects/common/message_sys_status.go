@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Sensor and subsystem status information. Provides a compact representation of sensor/subsystem status and a few other basic statistics.
 type MessageSysStatus struct {
 	// Bitmap showing which onboard controllers and sensors are present. Value of 0: not present. Value of 1: present.
@@ -42,3 +48,67 @@ type MessageSysStatus struct {
 func (*MessageSysStatus) GetID() uint32 {
 	return 1
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSysStatus) MAVLinkCRCExtra() byte {
+	return 124
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSysStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 43
+	}
+	return 31
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSysStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.OnboardControlSensorsPresent))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.OnboardControlSensorsEnabled))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.OnboardControlSensorsHealth))
+	binary.LittleEndian.PutUint16(buf[12:], m.Load)
+	binary.LittleEndian.PutUint16(buf[14:], m.VoltageBattery)
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.CurrentBattery))
+	binary.LittleEndian.PutUint16(buf[18:], m.DropRateComm)
+	binary.LittleEndian.PutUint16(buf[20:], m.ErrorsComm)
+	binary.LittleEndian.PutUint16(buf[22:], m.ErrorsCount1)
+	binary.LittleEndian.PutUint16(buf[24:], m.ErrorsCount2)
+	binary.LittleEndian.PutUint16(buf[26:], m.ErrorsCount3)
+	binary.LittleEndian.PutUint16(buf[28:], m.ErrorsCount4)
+	buf[30] = byte(m.BatteryRemaining)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[31:], uint32(m.OnboardControlSensorsPresentExtended))
+	binary.LittleEndian.PutUint32(buf[35:], uint32(m.OnboardControlSensorsEnabledExtended))
+	binary.LittleEndian.PutUint32(buf[39:], uint32(m.OnboardControlSensorsHealthExtended))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSysStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.OnboardControlSensorsPresent = MAV_SYS_STATUS_SENSOR(binary.LittleEndian.Uint32(buf[0:]))
+	m.OnboardControlSensorsEnabled = MAV_SYS_STATUS_SENSOR(binary.LittleEndian.Uint32(buf[4:]))
+	m.OnboardControlSensorsHealth = MAV_SYS_STATUS_SENSOR(binary.LittleEndian.Uint32(buf[8:]))
+	m.Load = binary.LittleEndian.Uint16(buf[12:])
+	m.VoltageBattery = binary.LittleEndian.Uint16(buf[14:])
+	m.CurrentBattery = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.DropRateComm = binary.LittleEndian.Uint16(buf[18:])
+	m.ErrorsComm = binary.LittleEndian.Uint16(buf[20:])
+	m.ErrorsCount1 = binary.LittleEndian.Uint16(buf[22:])
+	m.ErrorsCount2 = binary.LittleEndian.Uint16(buf[24:])
+	m.ErrorsCount3 = binary.LittleEndian.Uint16(buf[26:])
+	m.ErrorsCount4 = binary.LittleEndian.Uint16(buf[28:])
+	m.BatteryRemaining = int8(buf[30])
+	if !isV2 {
+		return
+	}
+	m.OnboardControlSensorsPresentExtended = MAV_SYS_STATUS_SENSOR_EXTENDED(binary.LittleEndian.Uint32(buf[31:]))
+	m.OnboardControlSensorsEnabledExtended = MAV_SYS_STATUS_SENSOR_EXTENDED(binary.LittleEndian.Uint32(buf[35:]))
+	m.OnboardControlSensorsHealthExtended = MAV_SYS_STATUS_SENSOR_EXTENDED(binary.LittleEndian.Uint32(buf[39:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSysStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[1]
+}
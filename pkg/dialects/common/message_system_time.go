@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The system time is the time of the sender's master clock.
 // This can be emitted by flight controllers, onboard computers, or other components in the MAVLink network.
 // Components that are using a less reliable time source, such as a battery-backed real time clock, can choose to match their system clock to that of a system that indicates a more recent time.
@@ -18,3 +24,33 @@ type MessageSystemTime struct {
 func (*MessageSystemTime) GetID() uint32 {
 	return 2
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSystemTime) MAVLinkCRCExtra() byte {
+	return 137
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSystemTime) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSystemTime) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUnixUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.TimeBootMs)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSystemTime) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUnixUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[8:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSystemTime) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[2]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request that the vehicle report terrain height at the given location (expected response is a TERRAIN_REPORT). Used by GCS to check if vehicle has all terrain data needed for a mission.
 type MessageTerrainCheck struct {
 	// Latitude
@@ -14,3 +20,33 @@ type MessageTerrainCheck struct {
 func (*MessageTerrainCheck) GetID() uint32 {
 	return 135
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTerrainCheck) MAVLinkCRCExtra() byte {
+	return 203
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTerrainCheck) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainCheck) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lon))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainCheck) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[4:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTerrainCheck) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[135]
+}
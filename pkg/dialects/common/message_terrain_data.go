@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Terrain data sent from GCS. The lat/lon and grid_spacing must be the same as a lat/lon from a TERRAIN_REQUEST. See terrain protocol docs: https://mavlink.io/en/services/terrain.html
 type MessageTerrainData struct {
 	// Latitude of SW corner of first grid
@@ -20,3 +26,43 @@ type MessageTerrainData struct {
 func (*MessageTerrainData) GetID() uint32 {
 	return 134
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTerrainData) MAVLinkCRCExtra() byte {
+	return 229
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTerrainData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 43
+	}
+	return 43
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Lon))
+	binary.LittleEndian.PutUint16(buf[8:], m.GridSpacing)
+	for i, v := range m.Data {
+		binary.LittleEndian.PutUint16(buf[10+i*2:], uint16(v))
+	}
+	buf[42] = m.Gridbit
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.GridSpacing = binary.LittleEndian.Uint16(buf[8:])
+	for i := range m.Data {
+		m.Data[i] = int16(binary.LittleEndian.Uint16(buf[10+i*2:]))
+	}
+	m.Gridbit = buf[42]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTerrainData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[134]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request for terrain data and terrain status. See terrain protocol docs: https://mavlink.io/en/services/terrain.html
 type MessageTerrainRequest struct {
 	// Latitude of SW corner of first grid
@@ -18,3 +24,37 @@ type MessageTerrainRequest struct {
 func (*MessageTerrainRequest) GetID() uint32 {
 	return 133
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTerrainRequest) MAVLinkCRCExtra() byte {
+	return 6
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTerrainRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 18
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Mask)
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lon))
+	binary.LittleEndian.PutUint16(buf[16:], m.GridSpacing)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTerrainRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Mask = binary.LittleEndian.Uint64(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.GridSpacing = binary.LittleEndian.Uint16(buf[16:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTerrainRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[133]
+}
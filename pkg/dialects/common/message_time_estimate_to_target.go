@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Time/duration estimates for various events and actions given the current vehicle state and position.
 type MessageTimeEstimateToTarget struct {
 	// Estimated time to complete the vehicle's configured "safe return" action from its current position (e.g. RTL, Smart RTL, etc.). -1 indicates that the vehicle is landed, or that no time estimate available.
@@ -20,3 +26,39 @@ type MessageTimeEstimateToTarget struct {
 func (*MessageTimeEstimateToTarget) GetID() uint32 {
 	return 380
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTimeEstimateToTarget) MAVLinkCRCExtra() byte {
+	return 232
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTimeEstimateToTarget) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTimeEstimateToTarget) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.SafeReturn))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Land))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.MissionNextItem))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.MissionEnd))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.CommandedAction))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTimeEstimateToTarget) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.SafeReturn = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Land = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.MissionNextItem = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.MissionEnd = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.CommandedAction = int32(binary.LittleEndian.Uint32(buf[16:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTimeEstimateToTarget) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[380]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Time synchronization message.
 // The message is used for both timesync requests and responses.
 // The request is sent with `ts1=syncing component timestamp` and `tc1=0`, and may be broadcast or targeted to a specific system/component.
@@ -26,3 +32,43 @@ type MessageTimesync struct {
 func (*MessageTimesync) GetID() uint32 {
 	return 111
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTimesync) MAVLinkCRCExtra() byte {
+	return 34
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTimesync) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 18
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTimesync) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], uint64(m.Tc1))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(m.Ts1))
+	if !isV2 {
+		return
+	}
+	buf[16] = m.TargetSystem
+	buf[17] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTimesync) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Tc1 = int64(binary.LittleEndian.Uint64(buf[0:]))
+	m.Ts1 = int64(binary.LittleEndian.Uint64(buf[8:]))
+	if !isV2 {
+		return
+	}
+	m.TargetSystem = buf[16]
+	m.TargetComponent = buf[17]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTimesync) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[111]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Describe a trajectory using an array of up-to 5 bezier control points in the local frame (MAV_FRAME_LOCAL_NED).
 type MessageTrajectoryRepresentationBezier struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -24,3 +31,63 @@ type MessageTrajectoryRepresentationBezier struct {
 func (*MessageTrajectoryRepresentationBezier) GetID() uint32 {
 	return 333
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTrajectoryRepresentationBezier) MAVLinkCRCExtra() byte {
+	return 231
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTrajectoryRepresentationBezier) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 109
+	}
+	return 109
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTrajectoryRepresentationBezier) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.PosX {
+		binary.LittleEndian.PutUint32(buf[8+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.PosY {
+		binary.LittleEndian.PutUint32(buf[28+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.PosZ {
+		binary.LittleEndian.PutUint32(buf[48+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.Delta {
+		binary.LittleEndian.PutUint32(buf[68+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.PosYaw {
+		binary.LittleEndian.PutUint32(buf[88+i*4:], math.Float32bits(v))
+	}
+	buf[108] = m.ValidPoints
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTrajectoryRepresentationBezier) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.PosX {
+		m.PosX[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[8+i*4:]))
+	}
+	for i := range m.PosY {
+		m.PosY[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[28+i*4:]))
+	}
+	for i := range m.PosZ {
+		m.PosZ[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[48+i*4:]))
+	}
+	for i := range m.Delta {
+		m.Delta[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[68+i*4:]))
+	}
+	for i := range m.PosYaw {
+		m.PosYaw[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[88+i*4:]))
+	}
+	m.ValidPoints = buf[108]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTrajectoryRepresentationBezier) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[333]
+}
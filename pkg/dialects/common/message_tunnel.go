@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message for transporting "arbitrary" variable-length data from one component to another (broadcast is not forbidden, but discouraged). The encoding of the data is usually extension specific, i.e. determined by the source, and is usually not documented as part of the MAVLink specification.
 type MessageTunnel struct {
 	// System ID (can be 0 for broadcast, but this is discouraged)
@@ -20,3 +26,43 @@ type MessageTunnel struct {
 func (*MessageTunnel) GetID() uint32 {
 	return 385
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTunnel) MAVLinkCRCExtra() byte {
+	return 147
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTunnel) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 133
+	}
+	return 133
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTunnel) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.PayloadType))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	buf[4] = m.PayloadLength
+	for i, v := range m.Payload {
+		buf[5+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTunnel) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.PayloadType = MAV_TUNNEL_PAYLOAD_TYPE(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	m.PayloadLength = buf[4]
+	for i := range m.Payload {
+		m.Payload[i] = buf[5+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTunnel) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[385]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // General information describing a particular UAVCAN node.
 // Please refer to the definition of the UAVCAN service "uavcan.protocol.GetNodeInfo" for the background information.
 // This message should be emitted by the system whenever a new node appears online, or an existing node reboots.
@@ -33,3 +40,55 @@ type MessageUavcanNodeInfo struct {
 func (*MessageUavcanNodeInfo) GetID() uint32 {
 	return 311
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavcanNodeInfo) MAVLinkCRCExtra() byte {
+	return 95
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavcanNodeInfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 116
+	}
+	return 116
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavcanNodeInfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.UptimeSec)
+	binary.LittleEndian.PutUint32(buf[12:], m.SwVcsCommit)
+	copy(buf[16:96], m.Name)
+	buf[96] = m.HwVersionMajor
+	buf[97] = m.HwVersionMinor
+	for i, v := range m.HwUniqueId {
+		buf[98+i] = v
+	}
+	buf[114] = m.SwVersionMajor
+	buf[115] = m.SwVersionMinor
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavcanNodeInfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.UptimeSec = binary.LittleEndian.Uint32(buf[8:])
+	m.SwVcsCommit = binary.LittleEndian.Uint32(buf[12:])
+	if n := bytes.IndexByte(buf[16:96], 0); n >= 0 {
+		m.Name = string(buf[16 : 16+n])
+	} else {
+		m.Name = string(buf[16:96])
+	}
+	m.HwVersionMajor = buf[96]
+	m.HwVersionMinor = buf[97]
+	for i := range m.HwUniqueId {
+		m.HwUniqueId[i] = buf[98+i]
+	}
+	m.SwVersionMajor = buf[114]
+	m.SwVersionMinor = buf[115]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavcanNodeInfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[311]
+}
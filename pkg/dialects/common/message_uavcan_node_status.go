@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // General status information of an UAVCAN node. Please refer to the definition of the UAVCAN message "uavcan.protocol.NodeStatus" for the background information. The UAVCAN specification is available at http://uavcan.org.
 type MessageUavcanNodeStatus struct {
 	// Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number.
@@ -22,3 +28,41 @@ type MessageUavcanNodeStatus struct {
 func (*MessageUavcanNodeStatus) GetID() uint32 {
 	return 310
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavcanNodeStatus) MAVLinkCRCExtra() byte {
+	return 28
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavcanNodeStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavcanNodeStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.UptimeSec)
+	binary.LittleEndian.PutUint16(buf[12:], m.VendorSpecificStatusCode)
+	buf[14] = byte(m.Health)
+	buf[15] = byte(m.Mode)
+	buf[16] = m.SubMode
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavcanNodeStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.UptimeSec = binary.LittleEndian.Uint32(buf[8:])
+	m.VendorSpecificStatusCode = binary.LittleEndian.Uint16(buf[12:])
+	m.Health = UAVCAN_NODE_HEALTH(buf[14])
+	m.Mode = UAVCAN_NODE_MODE(buf[15])
+	m.SubMode = buf[16]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavcanNodeStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[310]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message implementing parts of the V2 payload specs in V1 frames for transitional support.
 type MessageV2Extension struct {
 	// Network ID (0 for broadcast)
@@ -20,3 +26,43 @@ type MessageV2Extension struct {
 func (*MessageV2Extension) GetID() uint32 {
 	return 248
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageV2Extension) MAVLinkCRCExtra() byte {
+	return 8
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageV2Extension) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 254
+	}
+	return 254
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageV2Extension) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.MessageType)
+	buf[2] = m.TargetNetwork
+	buf[3] = m.TargetSystem
+	buf[4] = m.TargetComponent
+	for i, v := range m.Payload {
+		buf[5+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageV2Extension) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MessageType = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetNetwork = buf[2]
+	m.TargetSystem = buf[3]
+	m.TargetComponent = buf[4]
+	for i := range m.Payload {
+		m.Payload[i] = buf[5+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageV2Extension) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[248]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Metrics typically displayed on a HUD for fixed wing aircraft.
 type MessageVfrHud struct {
 	// Vehicle speed in form appropriate for vehicle type. For standard aircraft this is typically calibrated airspeed (CAS) or indicated airspeed (IAS) - either of which can be used by a pilot to estimate stall speed.
@@ -22,3 +29,41 @@ type MessageVfrHud struct {
 func (*MessageVfrHud) GetID() uint32 {
 	return 74
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVfrHud) MAVLinkCRCExtra() byte {
+	return 20
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVfrHud) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVfrHud) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Airspeed))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Groundspeed))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Alt))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Climb))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.Heading))
+	binary.LittleEndian.PutUint16(buf[18:], m.Throttle)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVfrHud) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Groundspeed = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Alt = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Climb = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Heading = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.Throttle = binary.LittleEndian.Uint16(buf[18:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVfrHud) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[74]
+}
@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about video stream. It may be requested using MAV_CMD_REQUEST_MESSAGE, where param2 indicates the video stream id: 0 for all streams, 1 for first, 2 for second, etc.
 type MessageVideoStreamInformation struct {
 	// Video Stream ID (1 for first, 2 for second, etc.)
@@ -38,3 +46,71 @@ type MessageVideoStreamInformation struct {
 func (*MessageVideoStreamInformation) GetID() uint32 {
 	return 269
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVideoStreamInformation) MAVLinkCRCExtra() byte {
+	return 109
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVideoStreamInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 215
+	}
+	return 213
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVideoStreamInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Framerate))
+	binary.LittleEndian.PutUint32(buf[4:], m.Bitrate)
+	binary.LittleEndian.PutUint16(buf[8:], uint16(m.Flags))
+	binary.LittleEndian.PutUint16(buf[10:], m.ResolutionH)
+	binary.LittleEndian.PutUint16(buf[12:], m.ResolutionV)
+	binary.LittleEndian.PutUint16(buf[14:], m.Rotation)
+	binary.LittleEndian.PutUint16(buf[16:], m.Hfov)
+	buf[18] = m.StreamId
+	buf[19] = m.Count
+	buf[20] = byte(m.Type)
+	copy(buf[21:53], m.Name)
+	copy(buf[53:213], m.Uri)
+	if !isV2 {
+		return
+	}
+	buf[213] = byte(m.Encoding)
+	buf[214] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVideoStreamInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Framerate = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Bitrate = binary.LittleEndian.Uint32(buf[4:])
+	m.Flags = VIDEO_STREAM_STATUS_FLAGS(binary.LittleEndian.Uint16(buf[8:]))
+	m.ResolutionH = binary.LittleEndian.Uint16(buf[10:])
+	m.ResolutionV = binary.LittleEndian.Uint16(buf[12:])
+	m.Rotation = binary.LittleEndian.Uint16(buf[14:])
+	m.Hfov = binary.LittleEndian.Uint16(buf[16:])
+	m.StreamId = buf[18]
+	m.Count = buf[19]
+	m.Type = VIDEO_STREAM_TYPE(buf[20])
+	if n := bytes.IndexByte(buf[21:53], 0); n >= 0 {
+		m.Name = string(buf[21 : 21+n])
+	} else {
+		m.Name = string(buf[21:53])
+	}
+	if n := bytes.IndexByte(buf[53:213], 0); n >= 0 {
+		m.Uri = string(buf[53 : 53+n])
+	} else {
+		m.Uri = string(buf[53:213])
+	}
+	if !isV2 {
+		return
+	}
+	m.Encoding = VIDEO_STREAM_ENCODING(buf[213])
+	m.CameraDeviceId = buf[214]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVideoStreamInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[269]
+}
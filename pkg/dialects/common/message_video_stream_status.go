@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about the status of a video stream. It may be requested using MAV_CMD_REQUEST_MESSAGE.
 type MessageVideoStreamStatus struct {
 	// Video Stream ID (1 for first, 2 for second, etc.)
@@ -28,3 +35,53 @@ type MessageVideoStreamStatus struct {
 func (*MessageVideoStreamStatus) GetID() uint32 {
 	return 270
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVideoStreamStatus) MAVLinkCRCExtra() byte {
+	return 59
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVideoStreamStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 19
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVideoStreamStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Framerate))
+	binary.LittleEndian.PutUint32(buf[4:], m.Bitrate)
+	binary.LittleEndian.PutUint16(buf[8:], uint16(m.Flags))
+	binary.LittleEndian.PutUint16(buf[10:], m.ResolutionH)
+	binary.LittleEndian.PutUint16(buf[12:], m.ResolutionV)
+	binary.LittleEndian.PutUint16(buf[14:], m.Rotation)
+	binary.LittleEndian.PutUint16(buf[16:], m.Hfov)
+	buf[18] = m.StreamId
+	if !isV2 {
+		return
+	}
+	buf[19] = m.CameraDeviceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVideoStreamStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Framerate = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Bitrate = binary.LittleEndian.Uint32(buf[4:])
+	m.Flags = VIDEO_STREAM_STATUS_FLAGS(binary.LittleEndian.Uint16(buf[8:]))
+	m.ResolutionH = binary.LittleEndian.Uint16(buf[10:])
+	m.ResolutionV = binary.LittleEndian.Uint16(buf[12:])
+	m.Rotation = binary.LittleEndian.Uint16(buf[14:])
+	m.Hfov = binary.LittleEndian.Uint16(buf[16:])
+	m.StreamId = buf[18]
+	if !isV2 {
+		return
+	}
+	m.CameraDeviceId = buf[19]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVideoStreamStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[270]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Local position/attitude estimate from a vision source.
 type MessageVisionPositionEstimate struct {
 	// Timestamp (UNIX time or time since system boot)
@@ -28,3 +35,57 @@ type MessageVisionPositionEstimate struct {
 func (*MessageVisionPositionEstimate) GetID() uint32 {
 	return 102
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVisionPositionEstimate) MAVLinkCRCExtra() byte {
+	return 158
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVisionPositionEstimate) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 117
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionPositionEstimate) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Usec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Yaw))
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Covariance {
+		binary.LittleEndian.PutUint32(buf[32+i*4:], math.Float32bits(v))
+	}
+	buf[116] = m.ResetCounter
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionPositionEstimate) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Usec = binary.LittleEndian.Uint64(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	if !isV2 {
+		return
+	}
+	for i := range m.Covariance {
+		m.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[32+i*4:]))
+	}
+	m.ResetCounter = buf[116]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVisionPositionEstimate) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[102]
+}
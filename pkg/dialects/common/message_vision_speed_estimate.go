@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Speed estimate from a vision source.
 type MessageVisionSpeedEstimate struct {
 	// Timestamp (UNIX time or time since system boot)
@@ -22,3 +29,51 @@ type MessageVisionSpeedEstimate struct {
 func (*MessageVisionSpeedEstimate) GetID() uint32 {
 	return 103
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVisionSpeedEstimate) MAVLinkCRCExtra() byte {
+	return 208
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVisionSpeedEstimate) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 57
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionSpeedEstimate) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Usec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Z))
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Covariance {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], math.Float32bits(v))
+	}
+	buf[56] = m.ResetCounter
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVisionSpeedEstimate) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Usec = binary.LittleEndian.Uint64(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	if !isV2 {
+		return
+	}
+	for i := range m.Covariance {
+		m.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[20+i*4:]))
+	}
+	m.ResetCounter = buf[56]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVisionSpeedEstimate) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[103]
+}
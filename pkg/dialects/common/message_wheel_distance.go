@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Cumulative distance traveled for each reported wheel.
 type MessageWheelDistance struct {
 	// Timestamp (synced to UNIX time or since system boot).
@@ -16,3 +23,39 @@ type MessageWheelDistance struct {
 func (*MessageWheelDistance) GetID() uint32 {
 	return 9000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageWheelDistance) MAVLinkCRCExtra() byte {
+	return 113
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageWheelDistance) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 137
+	}
+	return 137
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWheelDistance) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	for i, v := range m.Distance {
+		binary.LittleEndian.PutUint64(buf[8+i*8:], math.Float64bits(v))
+	}
+	buf[136] = m.Count
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWheelDistance) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	for i := range m.Distance {
+		m.Distance[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[8+i*8:]))
+	}
+	m.Count = buf[136]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageWheelDistance) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[9000]
+}
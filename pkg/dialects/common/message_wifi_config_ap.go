@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Configure WiFi AP SSID, password, and mode. This message is re-emitted as an acknowledgement by the AP. The message may also be explicitly requested using MAV_CMD_REQUEST_MESSAGE
 type MessageWifiConfigAp struct {
 	// Name of Wi-Fi network (SSID). Blank to leave it unchanged when setting. Current SSID when sent back as a response.
@@ -18,3 +24,51 @@ type MessageWifiConfigAp struct {
 func (*MessageWifiConfigAp) GetID() uint32 {
 	return 299
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageWifiConfigAp) MAVLinkCRCExtra() byte {
+	return 19
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageWifiConfigAp) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 98
+	}
+	return 96
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWifiConfigAp) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:32], m.Ssid)
+	copy(buf[32:96], m.Password)
+	if !isV2 {
+		return
+	}
+	buf[96] = byte(m.Mode)
+	buf[97] = byte(m.Response)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWifiConfigAp) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:32], 0); n >= 0 {
+		m.Ssid = string(buf[0 : 0+n])
+	} else {
+		m.Ssid = string(buf[0:32])
+	}
+	if n := bytes.IndexByte(buf[32:96], 0); n >= 0 {
+		m.Password = string(buf[32 : 32+n])
+	} else {
+		m.Password = string(buf[32:96])
+	}
+	if !isV2 {
+		return
+	}
+	m.Mode = WIFI_CONFIG_AP_MODE(buf[96])
+	m.Response = WIFI_CONFIG_AP_RESPONSE(buf[97])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageWifiConfigAp) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[299]
+}
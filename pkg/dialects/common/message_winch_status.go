@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package common
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Winch status.
 type MessageWinchStatus struct {
 	// Timestamp (synced to UNIX time or since system boot).
@@ -26,3 +33,45 @@ type MessageWinchStatus struct {
 func (*MessageWinchStatus) GetID() uint32 {
 	return 9005
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageWinchStatus) MAVLinkCRCExtra() byte {
+	return 117
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageWinchStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 34
+	}
+	return 34
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWinchStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.LineLength))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Speed))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Tension))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Voltage))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Current))
+	binary.LittleEndian.PutUint32(buf[28:], uint32(m.Status))
+	binary.LittleEndian.PutUint16(buf[32:], uint16(m.Temperature))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageWinchStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.LineLength = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Speed = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Tension = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Voltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Current = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Status = MAV_WINCH_STATUS_FLAG(binary.LittleEndian.Uint32(buf[28:]))
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[32:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageWinchStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[9005]
+}
@@ -0,0 +1,3532 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package common
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	1: {
+		ID:          1,
+		Name:        "SYS_STATUS",
+		Description: "Sensor and subsystem status information. Provides a compact representation of sensor/subsystem status and a few other basic statistics.",
+		Fields: []*message.FieldMetadata{
+			{Name: "onboard_control_sensors_present", Type: "uint32_t", Enum: "MAV_SYS_STATUS_SENSOR", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors are present. Value of 0: not present. Value of 1: present."},
+			{Name: "onboard_control_sensors_enabled", Type: "uint32_t", Enum: "MAV_SYS_STATUS_SENSOR", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors are enabled:  Value of 0: not enabled. Value of 1: enabled."},
+			{Name: "onboard_control_sensors_health", Type: "uint32_t", Enum: "MAV_SYS_STATUS_SENSOR", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors have an error (or are operational). Value of 0: error. Value of 1: healthy."},
+			{Name: "load", Type: "uint16_t", Description: "Maximum usage in percent of the mainloop time. Values: [0-1000] - should always be below 1000"},
+			{Name: "voltage_battery", Type: "uint16_t", Description: "Battery voltage, UINT16_MAX: Voltage not sent by autopilot. Value is ambiguous on multi-battery systems. BATTERY_STATUS is a recommended alternative."},
+			{Name: "current_battery", Type: "int16_t", Description: "Battery current, -1: Current not sent by autopilot. Value may overflow/rollover for very high currents (> 327.67A). Value is ambiguous on multi-battery systems. BATTERY_STATUS is a recommended alternative."},
+			{Name: "battery_remaining", Type: "int8_t", Description: "Battery energy remaining, -1: Battery remaining energy not sent by autopilot. Value is ambiguous on multi-battery systems. BATTERY_STATUS is a recommended alternative."},
+			{Name: "drop_rate_comm", Type: "uint16_t", Description: "Communication drop rate, (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)"},
+			{Name: "errors_comm", Type: "uint16_t", Description: "Communication errors (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)"},
+			{Name: "errors_count1", Type: "uint16_t", Description: "Autopilot-specific errors"},
+			{Name: "errors_count2", Type: "uint16_t", Description: "Autopilot-specific errors"},
+			{Name: "errors_count3", Type: "uint16_t", Description: "Autopilot-specific errors"},
+			{Name: "errors_count4", Type: "uint16_t", Description: "Autopilot-specific errors"},
+			{Name: "onboard_control_sensors_present_extended", Type: "uint32_t", Extension: true, Enum: "MAV_SYS_STATUS_SENSOR_EXTENDED", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors are present. Value of 0: not present. Value of 1: present."},
+			{Name: "onboard_control_sensors_enabled_extended", Type: "uint32_t", Extension: true, Enum: "MAV_SYS_STATUS_SENSOR_EXTENDED", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors are enabled:  Value of 0: not enabled. Value of 1: enabled."},
+			{Name: "onboard_control_sensors_health_extended", Type: "uint32_t", Extension: true, Enum: "MAV_SYS_STATUS_SENSOR_EXTENDED", Bitmask: true, Description: "Bitmap showing which onboard controllers and sensors have an error (or are operational). Value of 0: error. Value of 1: healthy."},
+		},
+	},
+	2: {
+		ID:          2,
+		Name:        "SYSTEM_TIME",
+		Description: "The system time is the time of the sender's master clock. This can be emitted by flight controllers, onboard computers, or other components in the MAVLink network. Components that are using a less reliable time source, such as a battery-backed real time clock, can choose to match their system clock to that of a system that indicates a more recent time. This allows more broadly accurate date stamping of logs, and so on. If precise time synchronization is needed then use TIMESYNC instead.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_unix_usec", Type: "uint64_t", Description: "Timestamp (UNIX epoch time)."},
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+		},
+	},
+	4: {
+		ID:          4,
+		Name:        "PING",
+		Description: "A ping message either requesting or responding to a ping. This allows to measure the system latencies, including serial port, radio modem and UDP connections. The ping microservice is documented at https://mavlink.io/en/services/ping.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "seq", Type: "uint32_t", Description: "PING sequence"},
+			{Name: "target_system", Type: "uint8_t", Description: "0: request ping from all receiving systems. If greater than 0: message is a ping response and number is the system id of the requesting system"},
+			{Name: "target_component", Type: "uint8_t", Description: "0: request ping from all receiving components. If greater than 0: message is a ping response and number is the component id of the requesting component."},
+		},
+	},
+	5: {
+		ID:          5,
+		Name:        "CHANGE_OPERATOR_CONTROL",
+		Description: "Request to control this MAV",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System the GCS requests control for"},
+			{Name: "control_request", Type: "uint8_t", Description: "0: request control of this MAV, 1: Release control of this MAV"},
+			{Name: "version", Type: "uint8_t", Description: "0: key as plaintext, 1-255: future, different hashing/encryption variants. The GCS should in general use the safest mode possible initially and then gradually move down the encryption level if it gets a NACK message indicating an encryption mismatch."},
+			{Name: "passkey", Type: "char", ArrayLength: 25, Description: "Password / Key, depending on version plaintext or encrypted. 25 or less characters, NULL terminated. The characters may involve A-Z, a-z, 0-9, and \"!?,.-\""},
+		},
+	},
+	6: {
+		ID:          6,
+		Name:        "CHANGE_OPERATOR_CONTROL_ACK",
+		Description: "Accept / deny control of this MAV",
+		Fields: []*message.FieldMetadata{
+			{Name: "gcs_system_id", Type: "uint8_t", Description: "ID of the GCS this message"},
+			{Name: "control_request", Type: "uint8_t", Description: "0: request control of this MAV, 1: Release control of this MAV"},
+			{Name: "ack", Type: "uint8_t", Description: "0: ACK, 1: NACK: Wrong passkey, 2: NACK: Unsupported passkey encryption method, 3: NACK: Already under control"},
+		},
+	},
+	7: {
+		ID:          7,
+		Name:        "AUTH_KEY",
+		Description: "Emit an encrypted signature / key identifying this system. PLEASE NOTE: This protocol has been kept simple, so transmitting the key requires an encrypted channel for true safety.",
+		Fields: []*message.FieldMetadata{
+			{Name: "key", Type: "char", ArrayLength: 32, Description: "key"},
+		},
+	},
+	8: {
+		ID:          8,
+		Name:        "LINK_NODE_STATUS",
+		Description: "Status generated in each node in the communication chain and injected into MAVLink stream.",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (time since system boot)."},
+			{Name: "tx_buf", Type: "uint8_t", Description: "Remaining free transmit buffer space"},
+			{Name: "rx_buf", Type: "uint8_t", Description: "Remaining free receive buffer space"},
+			{Name: "tx_rate", Type: "uint32_t", Description: "Transmit rate"},
+			{Name: "rx_rate", Type: "uint32_t", Description: "Receive rate"},
+			{Name: "rx_parse_err", Type: "uint16_t", Description: "Number of bytes that could not be parsed correctly."},
+			{Name: "tx_overflows", Type: "uint16_t", Description: "Transmit buffer overflows. This number wraps around as it reaches UINT16_MAX"},
+			{Name: "rx_overflows", Type: "uint16_t", Description: "Receive buffer overflows. This number wraps around as it reaches UINT16_MAX"},
+			{Name: "messages_sent", Type: "uint32_t", Description: "Messages sent"},
+			{Name: "messages_received", Type: "uint32_t", Description: "Messages received (estimated from counting seq)"},
+			{Name: "messages_lost", Type: "uint32_t", Description: "Messages lost (estimated from counting seq)"},
+		},
+	},
+	11: {
+		ID:          11,
+		Name:        "SET_MODE",
+		Description: "Set the system mode, as defined by enum MAV_MODE_FLAG. There is no target component id as the mode is by definition for the overall aircraft, not only for one component.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "The system setting the mode"},
+			{Name: "base_mode", Type: "uint8_t", Enum: "MAV_MODE_FLAG", Bitmask: true, Description: "The new base mode."},
+			{Name: "custom_mode", Type: "uint32_t", Description: "The new autopilot-specific mode. This field can be ignored by an autopilot."},
+		},
+	},
+	20: {
+		ID:          20,
+		Name:        "PARAM_REQUEST_READ",
+		Description: "Request to read the onboard parameter with the param_id string id. Onboard parameters are stored as key[const char*] -> value[float]. This allows to send a parameter to any other component (such as the GCS) without the need of previous knowledge of possible parameter names. Thus the same GCS can store different parameters for different autopilots. See also https://mavlink.io/en/services/parameter.html for a full documentation of QGroundControl and IMU code.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_index", Type: "int16_t", Description: "Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored)"},
+		},
+	},
+	21: {
+		ID:          21,
+		Name:        "PARAM_REQUEST_LIST",
+		Description: "Request all parameters of this component. After this request, all parameters are emitted. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+		},
+	},
+	22: {
+		ID:          22,
+		Name:        "PARAM_VALUE",
+		Description: "Emit the value of a onboard parameter. The inclusion of param_count and param_index in the message allows the recipient to keep track of received parameters and allows him to re-request missing parameters after a loss or timeout. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_value", Type: "float", Description: "Onboard parameter value"},
+			{Name: "param_type", Type: "uint8_t", Enum: "MAV_PARAM_TYPE", Description: "Onboard parameter type."},
+			{Name: "param_count", Type: "uint16_t", Description: "Total number of onboard parameters"},
+			{Name: "param_index", Type: "uint16_t", Description: "Index of this onboard parameter"},
+		},
+	},
+	23: {
+		ID:          23,
+		Name:        "PARAM_SET",
+		Description: "Set a parameter value (write new value to permanent storage). The receiving component should acknowledge the new parameter value by broadcasting a PARAM_VALUE message (broadcasting ensures that multiple GCS all have an up-to-date list of all parameters). If the sending GCS did not receive a PARAM_VALUE within its timeout time, it should re-send the PARAM_SET message. The parameter microservice is documented at https://mavlink.io/en/services/parameter.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_value", Type: "float", Description: "Onboard parameter value"},
+			{Name: "param_type", Type: "uint8_t", Enum: "MAV_PARAM_TYPE", Description: "Onboard parameter type."},
+		},
+	},
+	24: {
+		ID:          24,
+		Name:        "GPS_RAW_INT",
+		Description: "The global position, as returned by the Global Positioning System (GPS). This is NOT the global position estimate of the system, but rather a RAW sensor value. See message GLOBAL_POSITION_INT for the global position estimate.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "fix_type", Type: "uint8_t", Enum: "GPS_FIX_TYPE", Description: "GPS fix type."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84, EGM96 ellipsoid)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84, EGM96 ellipsoid)"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL). Positive for up. Note that virtually all GPS modules provide the MSL altitude in addition to the WGS84 altitude."},
+			{Name: "eph", Type: "uint16_t", Description: "GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "epv", Type: "uint16_t", Description: "GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "vel", Type: "uint16_t", Description: "GPS ground speed. If unknown, set to: UINT16_MAX"},
+			{Name: "cog", Type: "uint16_t", Description: "Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX"},
+			{Name: "satellites_visible", Type: "uint8_t", Description: "Number of satellites visible. If unknown, set to UINT8_MAX"},
+			{Name: "alt_ellipsoid", Type: "int32_t", Extension: true, Description: "Altitude (above WGS84, EGM96 ellipsoid). Positive for up."},
+			{Name: "h_acc", Type: "uint32_t", Extension: true, Description: "Position uncertainty."},
+			{Name: "v_acc", Type: "uint32_t", Extension: true, Description: "Altitude uncertainty."},
+			{Name: "vel_acc", Type: "uint32_t", Extension: true, Description: "Speed uncertainty."},
+			{Name: "hdg_acc", Type: "uint32_t", Extension: true, Description: "Heading / track uncertainty"},
+			{Name: "yaw", Type: "uint16_t", Extension: true, Description: "Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north."},
+		},
+	},
+	25: {
+		ID:          25,
+		Name:        "GPS_STATUS",
+		Description: "The positioning status, as reported by GPS. This message is intended to display status information about each satellite visible to the receiver. See message GLOBAL_POSITION_INT for the global position estimate. This message can contain information for up to 20 satellites.",
+		Fields: []*message.FieldMetadata{
+			{Name: "satellites_visible", Type: "uint8_t", Description: "Number of satellites visible"},
+			{Name: "satellite_prn", Type: "uint8_t", ArrayLength: 20, Description: "Global satellite ID"},
+			{Name: "satellite_used", Type: "uint8_t", ArrayLength: 20, Description: "0: Satellite not used, 1: used for localization"},
+			{Name: "satellite_elevation", Type: "uint8_t", ArrayLength: 20, Description: "Elevation (0: right on top of receiver, 90: on the horizon) of satellite"},
+			{Name: "satellite_azimuth", Type: "uint8_t", ArrayLength: 20, Description: "Direction of satellite, 0: 0 deg, 255: 360 deg."},
+			{Name: "satellite_snr", Type: "uint8_t", ArrayLength: 20, Description: "Signal to noise ratio of satellite"},
+		},
+	},
+	26: {
+		ID:          26,
+		Name:        "SCALED_IMU",
+		Description: "The RAW IMU readings for the usual 9DOF sensor setup. This message should contain the scaled values to the described units",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "int16_t", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "int16_t", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "int16_t", Description: "Angular speed around Z axis"},
+			{Name: "xmag", Type: "int16_t", Description: "X Magnetic field"},
+			{Name: "ymag", Type: "int16_t", Description: "Y Magnetic field"},
+			{Name: "zmag", Type: "int16_t", Description: "Z Magnetic field"},
+			{Name: "temperature", Type: "int16_t", Extension: true, Description: "Temperature, 0: IMU does not provide temperature values. If the IMU is at 0C it must send 1 (0.01C)."},
+		},
+	},
+	27: {
+		ID:          27,
+		Name:        "RAW_IMU",
+		Description: "The RAW IMU readings for a 9DOF sensor, which is identified by the id (default IMU1). This message should always contain the true raw values without any scaling to allow data capture and system debugging.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration (raw)"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration (raw)"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration (raw)"},
+			{Name: "xgyro", Type: "int16_t", Description: "Angular speed around X axis (raw)"},
+			{Name: "ygyro", Type: "int16_t", Description: "Angular speed around Y axis (raw)"},
+			{Name: "zgyro", Type: "int16_t", Description: "Angular speed around Z axis (raw)"},
+			{Name: "xmag", Type: "int16_t", Description: "X Magnetic field (raw)"},
+			{Name: "ymag", Type: "int16_t", Description: "Y Magnetic field (raw)"},
+			{Name: "zmag", Type: "int16_t", Description: "Z Magnetic field (raw)"},
+			{Name: "id", Type: "uint8_t", Extension: true, Description: "Id. Ids are numbered from 0 and map to IMUs numbered from 1 (e.g. IMU1 will have a message with id=0)"},
+			{Name: "temperature", Type: "int16_t", Extension: true, Description: "Temperature, 0: IMU does not provide temperature values. If the IMU is at 0C it must send 1 (0.01C)."},
+		},
+	},
+	28: {
+		ID:          28,
+		Name:        "RAW_PRESSURE",
+		Description: "The RAW pressure readings for the typical setup of one absolute pressure and one differential pressure sensor. The sensor values should be the raw, UNSCALED ADC values.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "press_abs", Type: "int16_t", Description: "Absolute pressure (raw)"},
+			{Name: "press_diff1", Type: "int16_t", Description: "Differential pressure 1 (raw, 0 if nonexistent)"},
+			{Name: "press_diff2", Type: "int16_t", Description: "Differential pressure 2 (raw, 0 if nonexistent)"},
+			{Name: "temperature", Type: "int16_t", Description: "Raw Temperature measurement (raw)"},
+		},
+	},
+	29: {
+		ID:          29,
+		Name:        "SCALED_PRESSURE",
+		Description: "The pressure readings for the typical setup of one absolute and differential pressure sensor. The units are as specified in each field.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "press_abs", Type: "float", Description: "Absolute pressure"},
+			{Name: "press_diff", Type: "float", Description: "Differential pressure 1"},
+			{Name: "temperature", Type: "int16_t", Description: "Absolute pressure temperature"},
+			{Name: "temperature_press_diff", Type: "int16_t", Extension: true, Description: "Differential pressure temperature (0, if not available). Report values of 0 (or 1) as 1 cdegC."},
+		},
+	},
+	30: {
+		ID:          30,
+		Name:        "ATTITUDE",
+		Description: "The attitude in the aeronautical frame (right-handed, Z-down, Y-right, X-front, ZYX, intrinsic).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "roll", Type: "float", Description: "Roll angle (-pi..+pi)"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle (-pi..+pi)"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle (-pi..+pi)"},
+			{Name: "rollspeed", Type: "float", Description: "Roll angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Pitch angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Yaw angular speed"},
+		},
+	},
+	31: {
+		ID:          31,
+		Name:        "ATTITUDE_QUATERNION",
+		Description: "The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "q1", Type: "float", Description: "Quaternion component 1, w (1 in null-rotation)"},
+			{Name: "q2", Type: "float", Description: "Quaternion component 2, x (0 in null-rotation)"},
+			{Name: "q3", Type: "float", Description: "Quaternion component 3, y (0 in null-rotation)"},
+			{Name: "q4", Type: "float", Description: "Quaternion component 4, z (0 in null-rotation)"},
+			{Name: "rollspeed", Type: "float", Description: "Roll angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Pitch angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Yaw angular speed"},
+			{Name: "repr_offset_q", Type: "float", ArrayLength: 4, Extension: true, Description: "Rotation offset by which the attitude quaternion and angular speed vector should be rotated for user display (quaternion with [w, x, y, z] order, zero-rotation is [1, 0, 0, 0], send [0, 0, 0, 0] if field not supported). This field is intended for systems in which the reference attitude may change during flight. For example, tailsitters VTOLs rotate their reference attitude by 90 degrees between hover mode and fixed wing mode, thus repr_offset_q is equal to [1, 0, 0, 0] in hover mode and equal to [0.7071, 0, 0.7071, 0] in fixed wing mode."},
+		},
+	},
+	32: {
+		ID:          32,
+		Name:        "LOCAL_POSITION_NED",
+		Description: "The filtered local position (e.g. fused computer vision and accelerometers). Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "x", Type: "float", Description: "X Position"},
+			{Name: "y", Type: "float", Description: "Y Position"},
+			{Name: "z", Type: "float", Description: "Z Position"},
+			{Name: "vx", Type: "float", Description: "X Speed"},
+			{Name: "vy", Type: "float", Description: "Y Speed"},
+			{Name: "vz", Type: "float", Description: "Z Speed"},
+		},
+	},
+	34: {
+		ID:          34,
+		Name:        "RC_CHANNELS_SCALED",
+		Description: "The scaled values of the RC channels received: (-100%) -10000, (0%) 0, (100%) 10000. Channels that are inactive should be set to INT16_MAX.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "port", Type: "uint8_t", Description: "Servo output port (set of 8 outputs = 1 port). Flight stacks running on Pixhawk should use: 0 = MAIN, 1 = AUX."},
+			{Name: "chan1_scaled", Type: "int16_t", Description: "RC channel 1 value scaled."},
+			{Name: "chan2_scaled", Type: "int16_t", Description: "RC channel 2 value scaled."},
+			{Name: "chan3_scaled", Type: "int16_t", Description: "RC channel 3 value scaled."},
+			{Name: "chan4_scaled", Type: "int16_t", Description: "RC channel 4 value scaled."},
+			{Name: "chan5_scaled", Type: "int16_t", Description: "RC channel 5 value scaled."},
+			{Name: "chan6_scaled", Type: "int16_t", Description: "RC channel 6 value scaled."},
+			{Name: "chan7_scaled", Type: "int16_t", Description: "RC channel 7 value scaled."},
+			{Name: "chan8_scaled", Type: "int16_t", Description: "RC channel 8 value scaled."},
+			{Name: "rssi", Type: "uint8_t", Description: "Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+		},
+	},
+	35: {
+		ID:          35,
+		Name:        "RC_CHANNELS_RAW",
+		Description: "The RAW values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. A value of UINT16_MAX implies the channel is unused. Individual receivers/transmitters might violate this specification.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "port", Type: "uint8_t", Description: "Servo output port (set of 8 outputs = 1 port). Flight stacks running on Pixhawk should use: 0 = MAIN, 1 = AUX."},
+			{Name: "chan1_raw", Type: "uint16_t", Description: "RC channel 1 value."},
+			{Name: "chan2_raw", Type: "uint16_t", Description: "RC channel 2 value."},
+			{Name: "chan3_raw", Type: "uint16_t", Description: "RC channel 3 value."},
+			{Name: "chan4_raw", Type: "uint16_t", Description: "RC channel 4 value."},
+			{Name: "chan5_raw", Type: "uint16_t", Description: "RC channel 5 value."},
+			{Name: "chan6_raw", Type: "uint16_t", Description: "RC channel 6 value."},
+			{Name: "chan7_raw", Type: "uint16_t", Description: "RC channel 7 value."},
+			{Name: "chan8_raw", Type: "uint16_t", Description: "RC channel 8 value."},
+			{Name: "rssi", Type: "uint8_t", Description: "Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+		},
+	},
+	36: {
+		ID:          36,
+		Name:        "SERVO_OUTPUT_RAW",
+		Description: "Superseded by ACTUATOR_OUTPUT_STATUS. The RAW values of the servo outputs (for RC input from the remote, use the RC_CHANNELS messages). The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint32_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "port", Type: "uint8_t", Description: "Servo output port (set of 8 outputs = 1 port). Flight stacks running on Pixhawk should use: 0 = MAIN, 1 = AUX."},
+			{Name: "servo1_raw", Type: "uint16_t", Description: "Servo output 1 value"},
+			{Name: "servo2_raw", Type: "uint16_t", Description: "Servo output 2 value"},
+			{Name: "servo3_raw", Type: "uint16_t", Description: "Servo output 3 value"},
+			{Name: "servo4_raw", Type: "uint16_t", Description: "Servo output 4 value"},
+			{Name: "servo5_raw", Type: "uint16_t", Description: "Servo output 5 value"},
+			{Name: "servo6_raw", Type: "uint16_t", Description: "Servo output 6 value"},
+			{Name: "servo7_raw", Type: "uint16_t", Description: "Servo output 7 value"},
+			{Name: "servo8_raw", Type: "uint16_t", Description: "Servo output 8 value"},
+			{Name: "servo9_raw", Type: "uint16_t", Extension: true, Description: "Servo output 9 value"},
+			{Name: "servo10_raw", Type: "uint16_t", Extension: true, Description: "Servo output 10 value"},
+			{Name: "servo11_raw", Type: "uint16_t", Extension: true, Description: "Servo output 11 value"},
+			{Name: "servo12_raw", Type: "uint16_t", Extension: true, Description: "Servo output 12 value"},
+			{Name: "servo13_raw", Type: "uint16_t", Extension: true, Description: "Servo output 13 value"},
+			{Name: "servo14_raw", Type: "uint16_t", Extension: true, Description: "Servo output 14 value"},
+			{Name: "servo15_raw", Type: "uint16_t", Extension: true, Description: "Servo output 15 value"},
+			{Name: "servo16_raw", Type: "uint16_t", Extension: true, Description: "Servo output 16 value"},
+		},
+	},
+	37: {
+		ID:          37,
+		Name:        "MISSION_REQUEST_PARTIAL_LIST",
+		Description: "Request a partial list of mission items from the system/component. https://mavlink.io/en/services/mission.html. If start and end index are the same, just send one waypoint.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "start_index", Type: "int16_t", Description: "Start index"},
+			{Name: "end_index", Type: "int16_t", Description: "End index, -1 by default (-1: send list to end). Else a valid index of the list"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	38: {
+		ID:          38,
+		Name:        "MISSION_WRITE_PARTIAL_LIST",
+		Description: "This message is sent to the MAV to write a partial list. If start index == end index, only one item will be transmitted / updated. If the start index is NOT 0 and above the current list size, this request should be REJECTED!",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "start_index", Type: "int16_t", Description: "Start index. Must be smaller / equal to the largest index of the current onboard list."},
+			{Name: "end_index", Type: "int16_t", Description: "End index, equal or greater than start index."},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	39: {
+		ID:          39,
+		Name:        "MISSION_ITEM",
+		Description: "Message encoding a mission item. This message is emitted to announce the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). NaN may be used to indicate an optional/default value (e.g. to use the system's current latitude or yaw rather than a specific value). See also https://mavlink.io/en/services/mission.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the waypoint."},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "The scheduled action for the waypoint."},
+			{Name: "current", Type: "uint8_t", Description: "false:0, true:1"},
+			{Name: "autocontinue", Type: "uint8_t", Description: "Autocontinue to next waypoint. 0: false, 1: true. Set false to pause mission after the item completes."},
+			{Name: "param1", Type: "float", Description: "PARAM1, see MAV_CMD enum"},
+			{Name: "param2", Type: "float", Description: "PARAM2, see MAV_CMD enum"},
+			{Name: "param3", Type: "float", Description: "PARAM3, see MAV_CMD enum"},
+			{Name: "param4", Type: "float", Description: "PARAM4, see MAV_CMD enum"},
+			{Name: "x", Type: "float", Description: "PARAM5 / local: X coordinate, global: latitude"},
+			{Name: "y", Type: "float", Description: "PARAM6 / local: Y coordinate, global: longitude"},
+			{Name: "z", Type: "float", Description: "PARAM7 / local: Z coordinate, global: altitude (relative or absolute, depending on frame)."},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	40: {
+		ID:          40,
+		Name:        "MISSION_REQUEST",
+		Description: "Request the information of the mission item with the sequence number seq. The response of the system to this message should be a MISSION_ITEM message. https://mavlink.io/en/services/mission.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	41: {
+		ID:          41,
+		Name:        "MISSION_SET_CURRENT",
+		Description: "Set the mission item with sequence number seq as the current item and emit MISSION_CURRENT (whether or not the mission number changed). If a mission is currently being executed, the system will continue to this new mission item on the shortest path, skipping any intermediate mission items. Note that mission jump repeat counters are not reset (see MAV_CMD_DO_JUMP param2). This message may trigger a mission state-machine change on some systems: for example from MISSION_STATE_NOT_STARTED or MISSION_STATE_PAUSED to MISSION_STATE_ACTIVE. If the system is in mission mode, on those systems this command might therefore start, restart or resume the mission. If the system is not in mission mode this message must not trigger a switch to mission mode.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+		},
+	},
+	42: {
+		ID:          42,
+		Name:        "MISSION_CURRENT",
+		Description: "Message that announces the sequence number of the current target mission item (that the system will fly towards/execute when the mission is running). This message should be streamed all the time (nominally at 1Hz). This message should be emitted following a call to MAV_CMD_DO_SET_MISSION_CURRENT or MISSION_SET_CURRENT.",
+		Fields: []*message.FieldMetadata{
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+			{Name: "total", Type: "uint16_t", Extension: true, Description: "Total number of mission items on vehicle (on last item, sequence == total). If the autopilot stores its home location as part of the mission this will be excluded from the total. 0: Not supported, UINT16_MAX if no mission is present on the vehicle."},
+			{Name: "mission_state", Type: "uint8_t", Extension: true, Enum: "MISSION_STATE", Description: "Mission state machine state. MISSION_STATE_UNKNOWN if state reporting not supported."},
+			{Name: "mission_mode", Type: "uint8_t", Extension: true, Description: "Vehicle is in a mode that can execute mission items or suspended. 0: Unknown, 1: In mission mode, 2: Suspended (not in mission mode)."},
+			{Name: "mission_id", Type: "uint32_t", Extension: true, Description: "Id of current on-vehicle mission plan, or 0 if IDs are not supported or there is no mission loaded. GCS can use this to track changes to the mission plan type. The same value is returned on mission upload (in the MISSION_ACK)."},
+			{Name: "fence_id", Type: "uint32_t", Extension: true, Description: "Id of current on-vehicle fence plan, or 0 if IDs are not supported or there is no fence loaded. GCS can use this to track changes to the fence plan type. The same value is returned on fence upload (in the MISSION_ACK)."},
+			{Name: "rally_points_id", Type: "uint32_t", Extension: true, Description: "Id of current on-vehicle rally point plan, or 0 if IDs are not supported or there are no rally points loaded. GCS can use this to track changes to the rally point plan type. The same value is returned on rally point upload (in the MISSION_ACK)."},
+		},
+	},
+	43: {
+		ID:          43,
+		Name:        "MISSION_REQUEST_LIST",
+		Description: "Request the overall list of mission items from the system/component.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	44: {
+		ID:          44,
+		Name:        "MISSION_COUNT",
+		Description: "This message is emitted as response to MISSION_REQUEST_LIST by the MAV and to initiate a write transaction. The GCS can then request the individual mission item based on the knowledge of the total number of waypoints.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "count", Type: "uint16_t", Description: "Number of mission items in the sequence"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+			{Name: "opaque_id", Type: "uint32_t", Extension: true, Description: "Id of current on-vehicle mission, fence, or rally point plan (on download from vehicle). This field is used when downloading a plan from a vehicle to a GCS. 0 on upload to the vehicle from GCS. 0 if plan ids are not supported. The current on-vehicle plan ids are streamed in `MISSION_CURRENT`, allowing a GCS to determine if any part of the plan has changed and needs to be re-uploaded. The ids are recalculated by the vehicle when any part of the on-vehicle plan changes (when a new plan is uploaded, the vehicle returns the new id to the GCS in MISSION_ACK)."},
+		},
+	},
+	45: {
+		ID:          45,
+		Name:        "MISSION_CLEAR_ALL",
+		Description: "Delete all mission items at once.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	46: {
+		ID:          46,
+		Name:        "MISSION_ITEM_REACHED",
+		Description: "A certain mission item has been reached. The system will either hold this position (or circle on the orbit) or (if the autocontinue on the WP was set) continue to the next waypoint.",
+		Fields: []*message.FieldMetadata{
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+		},
+	},
+	47: {
+		ID:          47,
+		Name:        "MISSION_ACK",
+		Description: "Acknowledgment message during waypoint handling. The type field states if this message is a positive ack (type=0) or if an error happened (type=non-zero).",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_MISSION_RESULT", Description: "Mission result."},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+			{Name: "opaque_id", Type: "uint32_t", Extension: true, Description: "Id of new on-vehicle mission, fence, or rally point plan (on upload to vehicle). The id is calculated and returned by a vehicle when a new plan is uploaded by a GCS. The only requirement on the id is that it must change when there is any change to the on-vehicle plan type (there is no requirement that the id be globally unique). 0 on download from the vehicle to the GCS (on download the ID is set in MISSION_COUNT). 0 if plan ids are not supported. The current on-vehicle plan ids are streamed in `MISSION_CURRENT`, allowing a GCS to determine if any part of the plan has changed and needs to be re-uploaded."},
+		},
+	},
+	48: {
+		ID:          48,
+		Name:        "SET_GPS_GLOBAL_ORIGIN",
+		Description: "Sets the GPS coordinates of the vehicle local origin (0,0,0) position. Vehicle should emit GPS_GLOBAL_ORIGIN irrespective of whether the origin is changed. This enables transform between the local coordinate frame and the global (GPS) coordinate frame, which may be necessary when (for example) indoor and outdoor settings are connected and the MAV should move from in- to outdoor.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "latitude", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "altitude", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "time_usec", Type: "uint64_t", Extension: true, Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	49: {
+		ID:          49,
+		Name:        "GPS_GLOBAL_ORIGIN",
+		Description: "Publishes the GPS coordinates of the vehicle local origin (0,0,0) position. Emitted whenever a new GPS-Local position mapping is requested or set - e.g. following SET_GPS_GLOBAL_ORIGIN message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "latitude", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "altitude", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "time_usec", Type: "uint64_t", Extension: true, Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	50: {
+		ID:          50,
+		Name:        "PARAM_MAP_RC",
+		Description: "Bind a RC channel to a parameter. The parameter should change according to the RC channel value.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_index", Type: "int16_t", Description: "Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored), send -2 to disable any existing map for this rc_channel_index."},
+			{Name: "parameter_rc_channel_index", Type: "uint8_t", Description: "Index of parameter RC channel. Not equal to the RC channel id. Typically corresponds to a potentiometer-knob on the RC."},
+			{Name: "param_value0", Type: "float", Description: "Initial parameter value"},
+			{Name: "scale", Type: "float", Description: "Scale, maps the RC range [-1, 1] to a parameter value"},
+			{Name: "param_value_min", Type: "float", Description: "Minimum param value. The protocol does not define if this overwrites an onboard minimum value. (Depends on implementation)"},
+			{Name: "param_value_max", Type: "float", Description: "Maximum param value. The protocol does not define if this overwrites an onboard maximum value. (Depends on implementation)"},
+		},
+	},
+	51: {
+		ID:          51,
+		Name:        "MISSION_REQUEST_INT",
+		Description: "Request the information of the mission item with the sequence number seq. The response of the system to this message should be a MISSION_ITEM_INT message. https://mavlink.io/en/services/mission.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	54: {
+		ID:          54,
+		Name:        "SAFETY_SET_ALLOWED_AREA",
+		Description: "Set a safety zone (volume), which is defined by two corners of a cube. This message can be used to tell the MAV which setpoints/waypoints to accept and which to reject. Safety areas are often enforced by national or competition regulations.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down."},
+			{Name: "p1x", Type: "float", Description: "x position 1 / Latitude 1"},
+			{Name: "p1y", Type: "float", Description: "y position 1 / Longitude 1"},
+			{Name: "p1z", Type: "float", Description: "z position 1 / Altitude 1"},
+			{Name: "p2x", Type: "float", Description: "x position 2 / Latitude 2"},
+			{Name: "p2y", Type: "float", Description: "y position 2 / Longitude 2"},
+			{Name: "p2z", Type: "float", Description: "z position 2 / Altitude 2"},
+		},
+	},
+	55: {
+		ID:          55,
+		Name:        "SAFETY_ALLOWED_AREA",
+		Description: "Read out the safety zone the MAV currently assumes.",
+		Fields: []*message.FieldMetadata{
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down."},
+			{Name: "p1x", Type: "float", Description: "x position 1 / Latitude 1"},
+			{Name: "p1y", Type: "float", Description: "y position 1 / Longitude 1"},
+			{Name: "p1z", Type: "float", Description: "z position 1 / Altitude 1"},
+			{Name: "p2x", Type: "float", Description: "x position 2 / Latitude 2"},
+			{Name: "p2y", Type: "float", Description: "y position 2 / Longitude 2"},
+			{Name: "p2z", Type: "float", Description: "z position 2 / Altitude 2"},
+		},
+	},
+	61: {
+		ID:          61,
+		Name:        "ATTITUDE_QUATERNION_COV",
+		Description: "The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)"},
+			{Name: "rollspeed", Type: "float", Description: "Roll angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Pitch angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Yaw angular speed"},
+			{Name: "covariance", Type: "float", ArrayLength: 9, Description: "Row-major representation of a 3x3 attitude covariance matrix (states: roll, pitch, yaw; first three entries are the first ROW, next three entries are the second row, etc.). If unknown, assign NaN value to first element in the array."},
+		},
+	},
+	62: {
+		ID:          62,
+		Name:        "NAV_CONTROLLER_OUTPUT",
+		Description: "The state of the navigation and position controller.",
+		Fields: []*message.FieldMetadata{
+			{Name: "nav_roll", Type: "float", Description: "Current desired roll"},
+			{Name: "nav_pitch", Type: "float", Description: "Current desired pitch"},
+			{Name: "nav_bearing", Type: "int16_t", Description: "Current desired heading"},
+			{Name: "target_bearing", Type: "int16_t", Description: "Bearing to current waypoint/target"},
+			{Name: "wp_dist", Type: "uint16_t", Description: "Distance to active waypoint"},
+			{Name: "alt_error", Type: "float", Description: "Current altitude error"},
+			{Name: "aspd_error", Type: "float", Description: "Current airspeed error"},
+			{Name: "xtrack_error", Type: "float", Description: "Current crosstrack error on x-y plane"},
+		},
+	},
+	63: {
+		ID:          63,
+		Name:        "GLOBAL_POSITION_INT_COV",
+		Description: "The filtered global position (e.g. fused GPS and accelerometers). The position is in GPS-frame (right-handed, Z-up). It  is designed as scaled integer message since the resolution of float is not sufficient. NOTE: This message is intended for onboard networks / companion computers and higher-bandwidth links and optimized for accuracy and completeness. Please use the GLOBAL_POSITION_INT message for a minimal subset.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "estimator_type", Type: "uint8_t", Enum: "MAV_ESTIMATOR_TYPE", Description: "Class id of the estimator this estimate originated from."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude in meters above MSL"},
+			{Name: "relative_alt", Type: "int32_t", Description: "Altitude above ground"},
+			{Name: "vx", Type: "float", Description: "Ground X Speed (Latitude)"},
+			{Name: "vy", Type: "float", Description: "Ground Y Speed (Longitude)"},
+			{Name: "vz", Type: "float", Description: "Ground Z Speed (Altitude)"},
+			{Name: "covariance", Type: "float", ArrayLength: 36, Description: "Row-major representation of a 6x6 position and velocity 6x6 cross-covariance matrix (states: lat, lon, alt, vx, vy, vz; first six entries are the first ROW, next six entries are the second row, etc.). If unknown, assign NaN value to first element in the array."},
+		},
+	},
+	64: {
+		ID:          64,
+		Name:        "LOCAL_POSITION_NED_COV",
+		Description: "The filtered local position (e.g. fused computer vision and accelerometers). Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "estimator_type", Type: "uint8_t", Enum: "MAV_ESTIMATOR_TYPE", Description: "Class id of the estimator this estimate originated from."},
+			{Name: "x", Type: "float", Description: "X Position"},
+			{Name: "y", Type: "float", Description: "Y Position"},
+			{Name: "z", Type: "float", Description: "Z Position"},
+			{Name: "vx", Type: "float", Description: "X Speed"},
+			{Name: "vy", Type: "float", Description: "Y Speed"},
+			{Name: "vz", Type: "float", Description: "Z Speed"},
+			{Name: "ax", Type: "float", Description: "X Acceleration"},
+			{Name: "ay", Type: "float", Description: "Y Acceleration"},
+			{Name: "az", Type: "float", Description: "Z Acceleration"},
+			{Name: "covariance", Type: "float", ArrayLength: 45, Description: "Row-major representation of position, velocity and acceleration 9x9 cross-covariance matrix upper right triangle (states: x, y, z, vx, vy, vz, ax, ay, az; first nine entries are the first ROW, next eight entries are the second row, etc.). If unknown, assign NaN value to first element in the array."},
+		},
+	},
+	65: {
+		ID:          65,
+		Name:        "RC_CHANNELS",
+		Description: "The PPM values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%.  A value of UINT16_MAX implies the channel is unused. Individual receivers/transmitters might violate this specification.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "chancount", Type: "uint8_t", Description: "Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in this message. This value should be 0 when no RC channels are available."},
+			{Name: "chan1_raw", Type: "uint16_t", Description: "RC channel 1 value."},
+			{Name: "chan2_raw", Type: "uint16_t", Description: "RC channel 2 value."},
+			{Name: "chan3_raw", Type: "uint16_t", Description: "RC channel 3 value."},
+			{Name: "chan4_raw", Type: "uint16_t", Description: "RC channel 4 value."},
+			{Name: "chan5_raw", Type: "uint16_t", Description: "RC channel 5 value."},
+			{Name: "chan6_raw", Type: "uint16_t", Description: "RC channel 6 value."},
+			{Name: "chan7_raw", Type: "uint16_t", Description: "RC channel 7 value."},
+			{Name: "chan8_raw", Type: "uint16_t", Description: "RC channel 8 value."},
+			{Name: "chan9_raw", Type: "uint16_t", Description: "RC channel 9 value."},
+			{Name: "chan10_raw", Type: "uint16_t", Description: "RC channel 10 value."},
+			{Name: "chan11_raw", Type: "uint16_t", Description: "RC channel 11 value."},
+			{Name: "chan12_raw", Type: "uint16_t", Description: "RC channel 12 value."},
+			{Name: "chan13_raw", Type: "uint16_t", Description: "RC channel 13 value."},
+			{Name: "chan14_raw", Type: "uint16_t", Description: "RC channel 14 value."},
+			{Name: "chan15_raw", Type: "uint16_t", Description: "RC channel 15 value."},
+			{Name: "chan16_raw", Type: "uint16_t", Description: "RC channel 16 value."},
+			{Name: "chan17_raw", Type: "uint16_t", Description: "RC channel 17 value."},
+			{Name: "chan18_raw", Type: "uint16_t", Description: "RC channel 18 value."},
+			{Name: "rssi", Type: "uint8_t", Description: "Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+		},
+	},
+	66: {
+		ID:          66,
+		Name:        "REQUEST_DATA_STREAM",
+		Description: "Request a data stream.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "The target requested to send the message stream."},
+			{Name: "target_component", Type: "uint8_t", Description: "The target requested to send the message stream."},
+			{Name: "req_stream_id", Type: "uint8_t", Enum: "MAV_DATA_STREAM", Description: "The ID of the requested data stream."},
+			{Name: "req_message_rate", Type: "uint16_t", Description: "The requested message rate"},
+			{Name: "start_stop", Type: "uint8_t", Description: "1 to start sending, 0 to stop sending."},
+		},
+	},
+	67: {
+		ID:          67,
+		Name:        "DATA_STREAM",
+		Description: "Data stream status information.",
+		Fields: []*message.FieldMetadata{
+			{Name: "stream_id", Type: "uint8_t", Enum: "MAV_DATA_STREAM", Description: "The ID of the requested data stream."},
+			{Name: "message_rate", Type: "uint16_t", Description: "The message rate"},
+			{Name: "on_off", Type: "uint8_t", Description: "1 stream is enabled, 0 stream is stopped."},
+		},
+	},
+	69: {
+		ID:          69,
+		Name:        "MANUAL_CONTROL",
+		Description: "Manual (joystick) control message. This message represents movement axes and button using standard joystick axes nomenclature. Unused axes can be disabled and buttons states are transmitted as individual on/off bits of a bitmask. For more information see https://mavlink.io/en/services/manual_control.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target", Type: "uint8_t", Description: "The system to be controlled."},
+			{Name: "x", Type: "int16_t", Description: "X-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to forward(1000)-backward(-1000) movement on a joystick and the pitch of a vehicle."},
+			{Name: "y", Type: "int16_t", Description: "Y-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to left(-1000)-right(1000) movement on a joystick and the roll of a vehicle."},
+			{Name: "z", Type: "int16_t", Description: "Z-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a separate slider movement with maximum being 1000 and minimum being -1000 on a joystick and the thrust of a vehicle. Positive values are positive thrust, negative values are negative thrust."},
+			{Name: "r", Type: "int16_t", Description: "R-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a twisting of the joystick, with clockwise being 1000 and counter-clockwise being -1000, and the yaw of a vehicle."},
+			{Name: "buttons", Type: "uint16_t", Description: "A bitfield corresponding to the joystick buttons' 0-15 current state, 1 for pressed, 0 for released. The lowest bit corresponds to Button 1."},
+			{Name: "buttons2", Type: "uint16_t", Extension: true, Description: "A bitfield corresponding to the joystick buttons' 16-31 current state, 1 for pressed, 0 for released. The lowest bit corresponds to Button 16."},
+			{Name: "enabled_extensions", Type: "uint8_t", Extension: true, Description: "Set bits to 1 to indicate which of the following extension fields contain valid data: bit 0: pitch, bit 1: roll, bit 2: aux1, bit 3: aux2, bit 4: aux3, bit 5: aux4, bit 6: aux5, bit 7: aux6"},
+			{Name: "s", Type: "int16_t", Extension: true, Description: "Pitch-only-axis, normalized to the range [-1000,1000]. Generally corresponds to pitch on vehicles with additional degrees of freedom. Valid if bit 0 of enabled_extensions field is set. Set to 0 if invalid."},
+			{Name: "t", Type: "int16_t", Extension: true, Description: "Roll-only-axis, normalized to the range [-1000,1000]. Generally corresponds to roll on vehicles with additional degrees of freedom. Valid if bit 1 of enabled_extensions field is set. Set to 0 if invalid."},
+			{Name: "aux1", Type: "int16_t", Extension: true, Description: "Aux continuous input field 1. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 2 of enabled_extensions field is set. 0 if bit 2 is unset."},
+			{Name: "aux2", Type: "int16_t", Extension: true, Description: "Aux continuous input field 2. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 3 of enabled_extensions field is set. 0 if bit 3 is unset."},
+			{Name: "aux3", Type: "int16_t", Extension: true, Description: "Aux continuous input field 3. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 4 of enabled_extensions field is set. 0 if bit 4 is unset."},
+			{Name: "aux4", Type: "int16_t", Extension: true, Description: "Aux continuous input field 4. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 5 of enabled_extensions field is set. 0 if bit 5 is unset."},
+			{Name: "aux5", Type: "int16_t", Extension: true, Description: "Aux continuous input field 5. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 6 of enabled_extensions field is set. 0 if bit 6 is unset."},
+			{Name: "aux6", Type: "int16_t", Extension: true, Description: "Aux continuous input field 6. Normalized in the range [-1000,1000]. Purpose defined by recipient. Valid data if bit 7 of enabled_extensions field is set. 0 if bit 7 is unset."},
+		},
+	},
+	70: {
+		ID:          70,
+		Name:        "RC_CHANNELS_OVERRIDE",
+		Description: "The RAW values of the RC channels sent to the MAV to override info received from the RC radio. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.  Note carefully the semantic differences between the first 8 channels and the subsequent channels",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "chan1_raw", Type: "uint16_t", Description: "RC channel 1 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan2_raw", Type: "uint16_t", Description: "RC channel 2 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan3_raw", Type: "uint16_t", Description: "RC channel 3 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan4_raw", Type: "uint16_t", Description: "RC channel 4 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan5_raw", Type: "uint16_t", Description: "RC channel 5 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan6_raw", Type: "uint16_t", Description: "RC channel 6 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan7_raw", Type: "uint16_t", Description: "RC channel 7 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan8_raw", Type: "uint16_t", Description: "RC channel 8 value. A value of UINT16_MAX means to ignore this field. A value of 0 means to release this channel back to the RC radio."},
+			{Name: "chan9_raw", Type: "uint16_t", Extension: true, Description: "RC channel 9 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan10_raw", Type: "uint16_t", Extension: true, Description: "RC channel 10 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan11_raw", Type: "uint16_t", Extension: true, Description: "RC channel 11 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan12_raw", Type: "uint16_t", Extension: true, Description: "RC channel 12 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan13_raw", Type: "uint16_t", Extension: true, Description: "RC channel 13 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan14_raw", Type: "uint16_t", Extension: true, Description: "RC channel 14 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan15_raw", Type: "uint16_t", Extension: true, Description: "RC channel 15 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan16_raw", Type: "uint16_t", Extension: true, Description: "RC channel 16 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan17_raw", Type: "uint16_t", Extension: true, Description: "RC channel 17 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+			{Name: "chan18_raw", Type: "uint16_t", Extension: true, Description: "RC channel 18 value. A value of 0 or UINT16_MAX means to ignore this field. A value of UINT16_MAX-1 means to release this channel back to the RC radio."},
+		},
+	},
+	73: {
+		ID:          73,
+		Name:        "MISSION_ITEM_INT",
+		Description: "Message encoding a mission item. This message is emitted to announce the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). NaN or INT32_MAX may be used in float/integer params (respectively) to indicate optional/default values (e.g. to use the component's current latitude, yaw rather than a specific value). See also https://mavlink.io/en/services/mission.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Waypoint ID (sequence number). Starts at zero. Increases monotonically for each waypoint, no gaps in the sequence (0,1,2,3,4)."},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the waypoint."},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "The scheduled action for the waypoint."},
+			{Name: "current", Type: "uint8_t", Description: "false:0, true:1"},
+			{Name: "autocontinue", Type: "uint8_t", Description: "Autocontinue to next waypoint. 0: false, 1: true. Set false to pause mission after the item completes."},
+			{Name: "param1", Type: "float", Description: "PARAM1, see MAV_CMD enum"},
+			{Name: "param2", Type: "float", Description: "PARAM2, see MAV_CMD enum"},
+			{Name: "param3", Type: "float", Description: "PARAM3, see MAV_CMD enum"},
+			{Name: "param4", Type: "float", Description: "PARAM4, see MAV_CMD enum"},
+			{Name: "x", Type: "int32_t", Description: "PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7"},
+			{Name: "y", Type: "int32_t", Description: "PARAM6 / y position: local: x position in meters * 1e4, global: longitude in degrees *10^7"},
+			{Name: "z", Type: "float", Description: "PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame."},
+			{Name: "mission_type", Type: "uint8_t", Extension: true, Enum: "MAV_MISSION_TYPE", Description: "Mission type."},
+		},
+	},
+	74: {
+		ID:          74,
+		Name:        "VFR_HUD",
+		Description: "Metrics typically displayed on a HUD for fixed wing aircraft.",
+		Fields: []*message.FieldMetadata{
+			{Name: "airspeed", Type: "float", Description: "Vehicle speed in form appropriate for vehicle type. For standard aircraft this is typically calibrated airspeed (CAS) or indicated airspeed (IAS) - either of which can be used by a pilot to estimate stall speed."},
+			{Name: "groundspeed", Type: "float", Description: "Current ground speed."},
+			{Name: "heading", Type: "int16_t", Description: "Current heading in compass units (0-360, 0=north)."},
+			{Name: "throttle", Type: "uint16_t", Description: "Current throttle setting (0 to 100)."},
+			{Name: "alt", Type: "float", Description: "Current altitude (MSL)."},
+			{Name: "climb", Type: "float", Description: "Current climb rate."},
+		},
+	},
+	75: {
+		ID:          75,
+		Name:        "COMMAND_INT",
+		Description: "Send a command with up to seven parameters to the MAV, where params 5 and 6 are integers and the other values are floats. This is preferred over COMMAND_LONG as it allows the MAV_FRAME to be specified for interpreting positional information, such as altitude. COMMAND_INT is also preferred when sending latitude and longitude data in params 5 and 6, as it allows for greater precision. Param 5 and 6 encode positional data as scaled integers, where the scaling depends on the actual command value. NaN or INT32_MAX may be used in float/integer params (respectively) to indicate optional/default values (e.g. to use the component's current latitude, yaw rather than a specific value). The command microservice is documented at https://mavlink.io/en/services/command.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the COMMAND."},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "The scheduled action for the mission item."},
+			{Name: "current", Type: "uint8_t", Description: "Not used."},
+			{Name: "autocontinue", Type: "uint8_t", Description: "Not used (set 0)."},
+			{Name: "param1", Type: "float", Description: "PARAM1, see MAV_CMD enum"},
+			{Name: "param2", Type: "float", Description: "PARAM2, see MAV_CMD enum"},
+			{Name: "param3", Type: "float", Description: "PARAM3, see MAV_CMD enum"},
+			{Name: "param4", Type: "float", Description: "PARAM4, see MAV_CMD enum"},
+			{Name: "x", Type: "int32_t", Description: "PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7"},
+			{Name: "y", Type: "int32_t", Description: "PARAM6 / local: y position in meters * 1e4, global: longitude in degrees * 10^7"},
+			{Name: "z", Type: "float", Description: "PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame)."},
+		},
+	},
+	76: {
+		ID:          76,
+		Name:        "COMMAND_LONG",
+		Description: "Send a command with up to seven parameters to the MAV. COMMAND_INT is generally preferred when sending MAV_CMD commands that include positional information; it offers higher precision and allows the MAV_FRAME to be specified (which may otherwise be ambiguous, particularly for altitude). The command microservice is documented at https://mavlink.io/en/services/command.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System which should execute the command"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component which should execute the command, 0 for all components"},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "Command ID (of command to send)."},
+			{Name: "confirmation", Type: "uint8_t", Description: "0: First transmission of this command. 1-255: Confirmation transmissions (e.g. for kill command)"},
+			{Name: "param1", Type: "float", Description: "Parameter 1 (for the specific command)."},
+			{Name: "param2", Type: "float", Description: "Parameter 2 (for the specific command)."},
+			{Name: "param3", Type: "float", Description: "Parameter 3 (for the specific command)."},
+			{Name: "param4", Type: "float", Description: "Parameter 4 (for the specific command)."},
+			{Name: "param5", Type: "float", Description: "Parameter 5 (for the specific command)."},
+			{Name: "param6", Type: "float", Description: "Parameter 6 (for the specific command)."},
+			{Name: "param7", Type: "float", Description: "Parameter 7 (for the specific command)."},
+		},
+	},
+	77: {
+		ID:          77,
+		Name:        "COMMAND_ACK",
+		Description: "Report status of a command. Includes feedback whether the command was executed. The command microservice is documented at https://mavlink.io/en/services/command.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "Command ID (of acknowledged command)."},
+			{Name: "result", Type: "uint8_t", Enum: "MAV_RESULT", Description: "Result of command."},
+			{Name: "progress", Type: "uint8_t", Extension: true, Description: "The progress percentage when result is MAV_RESULT_IN_PROGRESS. Values: [0-100], or UINT8_MAX if the progress is unknown."},
+			{Name: "result_param2", Type: "int32_t", Extension: true, Description: "Additional result information. Can be set with a command-specific enum containing command-specific error reasons for why the command might be denied. If used, the associated enum must be documented in the corresponding MAV_CMD (this enum should have a 0 value to indicate \"unused\" or \"unknown\")."},
+			{Name: "target_system", Type: "uint8_t", Extension: true, Description: "System ID of the target recipient. This is the ID of the system that sent the command for which this COMMAND_ACK is an acknowledgement."},
+			{Name: "target_component", Type: "uint8_t", Extension: true, Description: "Component ID of the target recipient. This is the ID of the system that sent the command for which this COMMAND_ACK is an acknowledgement."},
+		},
+	},
+	80: {
+		ID:          80,
+		Name:        "COMMAND_CANCEL",
+		Description: "Cancel a long running command. The target system should respond with a COMMAND_ACK to the original command with result=MAV_RESULT_CANCELLED if the long running process was cancelled. If it has already completed, the cancel action can be ignored. The cancel action can be retried until some sort of acknowledgement to the original command has been received. The command microservice is documented at https://mavlink.io/en/services/command.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System executing long running command. Should not be broadcast (0)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component executing long running command."},
+			{Name: "command", Type: "uint16_t", Enum: "MAV_CMD", Description: "Command ID (of command to cancel)."},
+		},
+	},
+	81: {
+		ID:          81,
+		Name:        "MANUAL_SETPOINT",
+		Description: "Setpoint in roll, pitch, yaw and thrust from the operator",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "roll", Type: "float", Description: "Desired roll rate"},
+			{Name: "pitch", Type: "float", Description: "Desired pitch rate"},
+			{Name: "yaw", Type: "float", Description: "Desired yaw rate"},
+			{Name: "thrust", Type: "float", Description: "Collective thrust, normalized to 0 .. 1"},
+			{Name: "mode_switch", Type: "uint8_t", Description: "Flight mode switch position, 0.. 255"},
+			{Name: "manual_override_switch", Type: "uint8_t", Description: "Override mode switch position, 0.. 255"},
+		},
+	},
+	82: {
+		ID:          82,
+		Name:        "SET_ATTITUDE_TARGET",
+		Description: "Sets a desired vehicle attitude. Used by an external controller to command the vehicle (manual controller or other system).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "type_mask", Type: "uint8_t", Enum: "ATTITUDE_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0) from MAV_FRAME_LOCAL_NED to MAV_FRAME_BODY_FRD"},
+			{Name: "body_roll_rate", Type: "float", Description: "Body roll rate"},
+			{Name: "body_pitch_rate", Type: "float", Description: "Body pitch rate"},
+			{Name: "body_yaw_rate", Type: "float", Description: "Body yaw rate"},
+			{Name: "thrust", Type: "float", Description: "Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse thrust)"},
+			{Name: "thrust_body", Type: "float", ArrayLength: 3, Extension: true, Description: "3D thrust setpoint in the body NED frame, normalized to -1 .. 1"},
+		},
+	},
+	83: {
+		ID:          83,
+		Name:        "ATTITUDE_TARGET",
+		Description: "Reports the current commanded attitude of the vehicle as specified by the autopilot. This should match the commands sent in a SET_ATTITUDE_TARGET message if the vehicle is being controlled this way.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "type_mask", Type: "uint8_t", Enum: "ATTITUDE_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "body_roll_rate", Type: "float", Description: "Body roll rate"},
+			{Name: "body_pitch_rate", Type: "float", Description: "Body pitch rate"},
+			{Name: "body_yaw_rate", Type: "float", Description: "Body yaw rate"},
+			{Name: "thrust", Type: "float", Description: "Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse thrust)"},
+		},
+	},
+	84: {
+		ID:          84,
+		Name:        "SET_POSITION_TARGET_LOCAL_NED",
+		Description: "Sets a desired vehicle position in a local north-east-down coordinate frame. Used by an external controller to command the vehicle (manual controller or other system).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "coordinate_frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9"},
+			{Name: "type_mask", Type: "uint16_t", Enum: "POSITION_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "x", Type: "float", Description: "X Position in NED frame"},
+			{Name: "y", Type: "float", Description: "Y Position in NED frame"},
+			{Name: "z", Type: "float", Description: "Z Position in NED frame (note, altitude is negative in NED)"},
+			{Name: "vx", Type: "float", Description: "X velocity in NED frame"},
+			{Name: "vy", Type: "float", Description: "Y velocity in NED frame"},
+			{Name: "vz", Type: "float", Description: "Z velocity in NED frame"},
+			{Name: "afx", Type: "float", Description: "X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afy", Type: "float", Description: "Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afz", Type: "float", Description: "Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "yaw", Type: "float", Description: "yaw setpoint"},
+			{Name: "yaw_rate", Type: "float", Description: "yaw rate setpoint"},
+		},
+	},
+	85: {
+		ID:          85,
+		Name:        "POSITION_TARGET_LOCAL_NED",
+		Description: "Reports the current commanded vehicle position, velocity, and acceleration as specified by the autopilot. This should match the commands sent in SET_POSITION_TARGET_LOCAL_NED if the vehicle is being controlled this way.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "coordinate_frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9"},
+			{Name: "type_mask", Type: "uint16_t", Enum: "POSITION_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "x", Type: "float", Description: "X Position in NED frame"},
+			{Name: "y", Type: "float", Description: "Y Position in NED frame"},
+			{Name: "z", Type: "float", Description: "Z Position in NED frame (note, altitude is negative in NED)"},
+			{Name: "vx", Type: "float", Description: "X velocity in NED frame"},
+			{Name: "vy", Type: "float", Description: "Y velocity in NED frame"},
+			{Name: "vz", Type: "float", Description: "Z velocity in NED frame"},
+			{Name: "afx", Type: "float", Description: "X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afy", Type: "float", Description: "Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afz", Type: "float", Description: "Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "yaw", Type: "float", Description: "yaw setpoint"},
+			{Name: "yaw_rate", Type: "float", Description: "yaw rate setpoint"},
+		},
+	},
+	86: {
+		ID:          86,
+		Name:        "SET_POSITION_TARGET_GLOBAL_INT",
+		Description: "Sets a desired vehicle position, velocity, and/or acceleration in a global coordinate system (WGS84). Used by an external controller to command the vehicle (manual controller or other system).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot). The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "coordinate_frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Valid options are: MAV_FRAME_GLOBAL = 0, MAV_FRAME_GLOBAL_RELATIVE_ALT = 3, MAV_FRAME_GLOBAL_TERRAIN_ALT = 10 (MAV_FRAME_GLOBAL_INT, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT are allowed synonyms, but have been deprecated)"},
+			{Name: "type_mask", Type: "uint16_t", Enum: "POSITION_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "lat_int", Type: "int32_t", Description: "Latitude in WGS84 frame"},
+			{Name: "lon_int", Type: "int32_t", Description: "Longitude in WGS84 frame"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL, Relative to home, or AGL - depending on frame)"},
+			{Name: "vx", Type: "float", Description: "X velocity in NED frame"},
+			{Name: "vy", Type: "float", Description: "Y velocity in NED frame"},
+			{Name: "vz", Type: "float", Description: "Z velocity in NED frame"},
+			{Name: "afx", Type: "float", Description: "X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afy", Type: "float", Description: "Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afz", Type: "float", Description: "Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "yaw", Type: "float", Description: "yaw setpoint"},
+			{Name: "yaw_rate", Type: "float", Description: "yaw rate setpoint"},
+		},
+	},
+	87: {
+		ID:          87,
+		Name:        "POSITION_TARGET_GLOBAL_INT",
+		Description: "Reports the current commanded vehicle position, velocity, and acceleration as specified by the autopilot. This should match the commands sent in SET_POSITION_TARGET_GLOBAL_INT if the vehicle is being controlled this way.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot). The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency."},
+			{Name: "coordinate_frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Valid options are: MAV_FRAME_GLOBAL = 0, MAV_FRAME_GLOBAL_RELATIVE_ALT = 3, MAV_FRAME_GLOBAL_TERRAIN_ALT = 10 (MAV_FRAME_GLOBAL_INT, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT are allowed synonyms, but have been deprecated)"},
+			{Name: "type_mask", Type: "uint16_t", Enum: "POSITION_TARGET_TYPEMASK", Bitmask: true, Description: "Bitmap to indicate which dimensions should be ignored by the vehicle."},
+			{Name: "lat_int", Type: "int32_t", Description: "Latitude in WGS84 frame"},
+			{Name: "lon_int", Type: "int32_t", Description: "Longitude in WGS84 frame"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL, AGL or relative to home altitude, depending on frame)"},
+			{Name: "vx", Type: "float", Description: "X velocity in NED frame"},
+			{Name: "vy", Type: "float", Description: "Y velocity in NED frame"},
+			{Name: "vz", Type: "float", Description: "Z velocity in NED frame"},
+			{Name: "afx", Type: "float", Description: "X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afy", Type: "float", Description: "Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "afz", Type: "float", Description: "Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N"},
+			{Name: "yaw", Type: "float", Description: "yaw setpoint"},
+			{Name: "yaw_rate", Type: "float", Description: "yaw rate setpoint"},
+		},
+	},
+	89: {
+		ID:          89,
+		Name:        "LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET",
+		Description: "The offset in X, Y, Z and yaw between the LOCAL_POSITION_NED messages of MAV X and the global coordinate frame in NED coordinates. Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "x", Type: "float", Description: "X Position"},
+			{Name: "y", Type: "float", Description: "Y Position"},
+			{Name: "z", Type: "float", Description: "Z Position"},
+			{Name: "roll", Type: "float", Description: "Roll"},
+			{Name: "pitch", Type: "float", Description: "Pitch"},
+			{Name: "yaw", Type: "float", Description: "Yaw"},
+		},
+	},
+	90: {
+		ID:          90,
+		Name:        "HIL_STATE",
+		Description: "Sent from simulation to autopilot. This packet is useful for high throughput applications such as hardware in the loop simulations.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "roll", Type: "float", Description: "Roll angle"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle"},
+			{Name: "rollspeed", Type: "float", Description: "Body frame roll / phi angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Body frame pitch / theta angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Body frame yaw / psi angular speed"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude"},
+			{Name: "vx", Type: "int16_t", Description: "Ground X Speed (Latitude)"},
+			{Name: "vy", Type: "int16_t", Description: "Ground Y Speed (Longitude)"},
+			{Name: "vz", Type: "int16_t", Description: "Ground Z Speed (Altitude)"},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration"},
+		},
+	},
+	91: {
+		ID:          91,
+		Name:        "HIL_CONTROLS",
+		Description: "Sent from autopilot to simulation. Hardware in the loop control outputs. Alternative to HIL_ACTUATOR_CONTROLS.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "roll_ailerons", Type: "float", Description: "Control output -1 .. 1"},
+			{Name: "pitch_elevator", Type: "float", Description: "Control output -1 .. 1"},
+			{Name: "yaw_rudder", Type: "float", Description: "Control output -1 .. 1"},
+			{Name: "throttle", Type: "float", Description: "Throttle 0 .. 1"},
+			{Name: "aux1", Type: "float", Description: "Aux 1, -1 .. 1"},
+			{Name: "aux2", Type: "float", Description: "Aux 2, -1 .. 1"},
+			{Name: "aux3", Type: "float", Description: "Aux 3, -1 .. 1"},
+			{Name: "aux4", Type: "float", Description: "Aux 4, -1 .. 1"},
+			{Name: "mode", Type: "uint8_t", Enum: "MAV_MODE", Description: "System mode."},
+			{Name: "nav_mode", Type: "uint8_t", Description: "Navigation mode (MAV_NAV_MODE)"},
+		},
+	},
+	92: {
+		ID:          92,
+		Name:        "HIL_RC_INPUTS_RAW",
+		Description: "Sent from simulation to autopilot. The RAW values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "chan1_raw", Type: "uint16_t", Description: "RC channel 1 value"},
+			{Name: "chan2_raw", Type: "uint16_t", Description: "RC channel 2 value"},
+			{Name: "chan3_raw", Type: "uint16_t", Description: "RC channel 3 value"},
+			{Name: "chan4_raw", Type: "uint16_t", Description: "RC channel 4 value"},
+			{Name: "chan5_raw", Type: "uint16_t", Description: "RC channel 5 value"},
+			{Name: "chan6_raw", Type: "uint16_t", Description: "RC channel 6 value"},
+			{Name: "chan7_raw", Type: "uint16_t", Description: "RC channel 7 value"},
+			{Name: "chan8_raw", Type: "uint16_t", Description: "RC channel 8 value"},
+			{Name: "chan9_raw", Type: "uint16_t", Description: "RC channel 9 value"},
+			{Name: "chan10_raw", Type: "uint16_t", Description: "RC channel 10 value"},
+			{Name: "chan11_raw", Type: "uint16_t", Description: "RC channel 11 value"},
+			{Name: "chan12_raw", Type: "uint16_t", Description: "RC channel 12 value"},
+			{Name: "rssi", Type: "uint8_t", Description: "Receive signal strength indicator in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+		},
+	},
+	93: {
+		ID:          93,
+		Name:        "HIL_ACTUATOR_CONTROLS",
+		Description: "Sent from autopilot to simulation. Hardware in the loop control outputs. Alternative to HIL_CONTROLS.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "controls", Type: "float", ArrayLength: 16, Description: "Control outputs -1 .. 1. Channel assignment depends on the simulated hardware."},
+			{Name: "mode", Type: "uint8_t", Enum: "MAV_MODE_FLAG", Bitmask: true, Description: "System mode. Includes arming state."},
+			{Name: "flags", Type: "uint64_t", Enum: "HIL_ACTUATOR_CONTROLS_FLAGS", Bitmask: true, Description: "Flags bitmask."},
+		},
+	},
+	100: {
+		ID:          100,
+		Name:        "OPTICAL_FLOW",
+		Description: "Optical flow from a flow sensor (e.g. optical mouse sensor)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "sensor_id", Type: "uint8_t", Description: "Sensor ID"},
+			{Name: "flow_x", Type: "int16_t", Description: "Flow in x-sensor direction"},
+			{Name: "flow_y", Type: "int16_t", Description: "Flow in y-sensor direction"},
+			{Name: "flow_comp_m_x", Type: "float", Description: "Flow in x-sensor direction, angular-speed compensated"},
+			{Name: "flow_comp_m_y", Type: "float", Description: "Flow in y-sensor direction, angular-speed compensated"},
+			{Name: "quality", Type: "uint8_t", Description: "Optical flow quality / confidence. 0: bad, 255: maximum quality"},
+			{Name: "ground_distance", Type: "float", Description: "Ground distance. Positive value: distance known. Negative value: Unknown distance"},
+			{Name: "flow_rate_x", Type: "float", Extension: true, Description: "Flow rate about X axis"},
+			{Name: "flow_rate_y", Type: "float", Extension: true, Description: "Flow rate about Y axis"},
+		},
+	},
+	101: {
+		ID:          101,
+		Name:        "GLOBAL_VISION_POSITION_ESTIMATE",
+		Description: "Global position/attitude estimate from a vision source.",
+		Fields: []*message.FieldMetadata{
+			{Name: "usec", Type: "uint64_t", Description: "Timestamp (UNIX time or since system boot)"},
+			{Name: "x", Type: "float", Description: "Global X position"},
+			{Name: "y", Type: "float", Description: "Global Y position"},
+			{Name: "z", Type: "float", Description: "Global Z position"},
+			{Name: "roll", Type: "float", Description: "Roll angle"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle"},
+			{Name: "covariance", Type: "float", ArrayLength: 21, Extension: true, Description: "Row-major representation of pose 6x6 cross-covariance matrix upper right triangle (states: x_global, y_global, z_global, roll, pitch, yaw; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+			{Name: "reset_counter", Type: "uint8_t", Extension: true, Description: "Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed). This is designed to be used when e.g an external SLAM system detects a loop-closure and the estimate jumps."},
+		},
+	},
+	102: {
+		ID:          102,
+		Name:        "VISION_POSITION_ESTIMATE",
+		Description: "Local position/attitude estimate from a vision source.",
+		Fields: []*message.FieldMetadata{
+			{Name: "usec", Type: "uint64_t", Description: "Timestamp (UNIX time or time since system boot)"},
+			{Name: "x", Type: "float", Description: "Local X position"},
+			{Name: "y", Type: "float", Description: "Local Y position"},
+			{Name: "z", Type: "float", Description: "Local Z position"},
+			{Name: "roll", Type: "float", Description: "Roll angle"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle"},
+			{Name: "covariance", Type: "float", ArrayLength: 21, Extension: true, Description: "Row-major representation of pose 6x6 cross-covariance matrix upper right triangle (states: x, y, z, roll, pitch, yaw; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+			{Name: "reset_counter", Type: "uint8_t", Extension: true, Description: "Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed). This is designed to be used when e.g an external SLAM system detects a loop-closure and the estimate jumps."},
+		},
+	},
+	103: {
+		ID:          103,
+		Name:        "VISION_SPEED_ESTIMATE",
+		Description: "Speed estimate from a vision source.",
+		Fields: []*message.FieldMetadata{
+			{Name: "usec", Type: "uint64_t", Description: "Timestamp (UNIX time or time since system boot)"},
+			{Name: "x", Type: "float", Description: "Global X speed"},
+			{Name: "y", Type: "float", Description: "Global Y speed"},
+			{Name: "z", Type: "float", Description: "Global Z speed"},
+			{Name: "covariance", Type: "float", ArrayLength: 9, Extension: true, Description: "Row-major representation of 3x3 linear velocity covariance matrix (states: vx, vy, vz; 1st three entries - 1st row, etc.). If unknown, assign NaN value to first element in the array."},
+			{Name: "reset_counter", Type: "uint8_t", Extension: true, Description: "Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed). This is designed to be used when e.g an external SLAM system detects a loop-closure and the estimate jumps."},
+		},
+	},
+	104: {
+		ID:          104,
+		Name:        "VICON_POSITION_ESTIMATE",
+		Description: "Global position estimate from a Vicon motion system source.",
+		Fields: []*message.FieldMetadata{
+			{Name: "usec", Type: "uint64_t", Description: "Timestamp (UNIX time or time since system boot)"},
+			{Name: "x", Type: "float", Description: "Global X position"},
+			{Name: "y", Type: "float", Description: "Global Y position"},
+			{Name: "z", Type: "float", Description: "Global Z position"},
+			{Name: "roll", Type: "float", Description: "Roll angle"},
+			{Name: "pitch", Type: "float", Description: "Pitch angle"},
+			{Name: "yaw", Type: "float", Description: "Yaw angle"},
+			{Name: "covariance", Type: "float", ArrayLength: 21, Extension: true, Description: "Row-major representation of 6x6 pose cross-covariance matrix upper right triangle (states: x, y, z, roll, pitch, yaw; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+		},
+	},
+	105: {
+		ID:          105,
+		Name:        "HIGHRES_IMU",
+		Description: "The IMU readings in SI units in NED body frame",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "xacc", Type: "float", Description: "X acceleration"},
+			{Name: "yacc", Type: "float", Description: "Y acceleration"},
+			{Name: "zacc", Type: "float", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "float", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "float", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "float", Description: "Angular speed around Z axis"},
+			{Name: "xmag", Type: "float", Description: "X Magnetic field"},
+			{Name: "ymag", Type: "float", Description: "Y Magnetic field"},
+			{Name: "zmag", Type: "float", Description: "Z Magnetic field"},
+			{Name: "abs_pressure", Type: "float", Description: "Absolute pressure"},
+			{Name: "diff_pressure", Type: "float", Description: "Differential pressure"},
+			{Name: "pressure_alt", Type: "float", Description: "Altitude calculated from pressure"},
+			{Name: "temperature", Type: "float", Description: "Temperature"},
+			{Name: "fields_updated", Type: "uint16_t", Enum: "HIGHRES_IMU_UPDATED_FLAGS", Bitmask: true, Description: "Bitmap for fields that have updated since last message"},
+			{Name: "id", Type: "uint8_t", Extension: true, Description: "Id. Ids are numbered from 0 and map to IMUs numbered from 1 (e.g. IMU1 will have a message with id=0)"},
+		},
+	},
+	106: {
+		ID:          106,
+		Name:        "OPTICAL_FLOW_RAD",
+		Description: "Optical flow from an angular rate flow sensor (e.g. PX4FLOW or mouse sensor)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "sensor_id", Type: "uint8_t", Description: "Sensor ID"},
+			{Name: "integration_time_us", Type: "uint32_t", Description: "Integration time. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the."},
+			{Name: "integrated_x", Type: "float", Description: "Flow around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)"},
+			{Name: "integrated_y", Type: "float", Description: "Flow around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)"},
+			{Name: "integrated_xgyro", Type: "float", Description: "RH rotation around X axis"},
+			{Name: "integrated_ygyro", Type: "float", Description: "RH rotation around Y axis"},
+			{Name: "integrated_zgyro", Type: "float", Description: "RH rotation around Z axis"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature"},
+			{Name: "quality", Type: "uint8_t", Description: "Optical flow quality / confidence. 0: no valid flow, 255: maximum quality"},
+			{Name: "time_delta_distance_us", Type: "uint32_t", Description: "Time since the distance was sampled."},
+			{Name: "distance", Type: "float", Description: "Distance to the center of the flow field. Positive value (including zero): distance known. Negative value: Unknown distance."},
+		},
+	},
+	107: {
+		ID:          107,
+		Name:        "HIL_SENSOR",
+		Description: "The IMU readings in SI units in NED body frame",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "xacc", Type: "float", Description: "X acceleration"},
+			{Name: "yacc", Type: "float", Description: "Y acceleration"},
+			{Name: "zacc", Type: "float", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "float", Description: "Angular speed around X axis in body frame"},
+			{Name: "ygyro", Type: "float", Description: "Angular speed around Y axis in body frame"},
+			{Name: "zgyro", Type: "float", Description: "Angular speed around Z axis in body frame"},
+			{Name: "xmag", Type: "float", Description: "X Magnetic field"},
+			{Name: "ymag", Type: "float", Description: "Y Magnetic field"},
+			{Name: "zmag", Type: "float", Description: "Z Magnetic field"},
+			{Name: "abs_pressure", Type: "float", Description: "Absolute pressure"},
+			{Name: "diff_pressure", Type: "float", Description: "Differential pressure (airspeed)"},
+			{Name: "pressure_alt", Type: "float", Description: "Altitude calculated from pressure"},
+			{Name: "temperature", Type: "float", Description: "Temperature"},
+			{Name: "fields_updated", Type: "uint32_t", Enum: "HIL_SENSOR_UPDATED_FLAGS", Bitmask: true, Description: "Bitmap for fields that have updated since last message"},
+			{Name: "id", Type: "uint8_t", Extension: true, Description: "Sensor ID (zero indexed). Used for multiple sensor inputs"},
+		},
+	},
+	108: {
+		ID:          108,
+		Name:        "SIM_STATE",
+		Description: "Status of simulation environment, if used",
+		Fields: []*message.FieldMetadata{
+			{Name: "q1", Type: "float", Description: "True attitude quaternion component 1, w (1 in null-rotation)"},
+			{Name: "q2", Type: "float", Description: "True attitude quaternion component 2, x (0 in null-rotation)"},
+			{Name: "q3", Type: "float", Description: "True attitude quaternion component 3, y (0 in null-rotation)"},
+			{Name: "q4", Type: "float", Description: "True attitude quaternion component 4, z (0 in null-rotation)"},
+			{Name: "roll", Type: "float", Description: "Attitude roll expressed as Euler angles, not recommended except for human-readable outputs"},
+			{Name: "pitch", Type: "float", Description: "Attitude pitch expressed as Euler angles, not recommended except for human-readable outputs"},
+			{Name: "yaw", Type: "float", Description: "Attitude yaw expressed as Euler angles, not recommended except for human-readable outputs"},
+			{Name: "xacc", Type: "float", Description: "X acceleration"},
+			{Name: "yacc", Type: "float", Description: "Y acceleration"},
+			{Name: "zacc", Type: "float", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "float", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "float", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "float", Description: "Angular speed around Z axis"},
+			{Name: "lat", Type: "float", Description: "Latitude (lower precision). Both this and the lat_int field should be set."},
+			{Name: "lon", Type: "float", Description: "Longitude (lower precision). Both this and the lon_int field should be set."},
+			{Name: "alt", Type: "float", Description: "Altitude"},
+			{Name: "std_dev_horz", Type: "float", Description: "Horizontal position standard deviation"},
+			{Name: "std_dev_vert", Type: "float", Description: "Vertical position standard deviation"},
+			{Name: "vn", Type: "float", Description: "True velocity in north direction in earth-fixed NED frame"},
+			{Name: "ve", Type: "float", Description: "True velocity in east direction in earth-fixed NED frame"},
+			{Name: "vd", Type: "float", Description: "True velocity in down direction in earth-fixed NED frame"},
+			{Name: "lat_int", Type: "int32_t", Extension: true, Description: "Latitude (higher precision). If 0, recipients should use the lat field value (otherwise this field is preferred)."},
+			{Name: "lon_int", Type: "int32_t", Extension: true, Description: "Longitude (higher precision). If 0, recipients should use the lon field value (otherwise this field is preferred)."},
+		},
+	},
+	109: {
+		ID:          109,
+		Name:        "RADIO_STATUS",
+		Description: "Status generated by radio and injected into MAVLink stream.",
+		Fields: []*message.FieldMetadata{
+			{Name: "rssi", Type: "uint8_t", Description: "Local (message sender) received signal strength indication in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+			{Name: "remrssi", Type: "uint8_t", Description: "Remote (message receiver) signal strength indication in device-dependent units/scale. Values: [0-254], UINT8_MAX: invalid/unknown."},
+			{Name: "txbuf", Type: "uint8_t", Description: "Remaining free transmitter buffer space."},
+			{Name: "noise", Type: "uint8_t", Description: "Local background noise level. These are device dependent RSSI values (scale as approx 2x dB on SiK radios). Values: [0-254], UINT8_MAX: invalid/unknown."},
+			{Name: "remnoise", Type: "uint8_t", Description: "Remote background noise level. These are device dependent RSSI values (scale as approx 2x dB on SiK radios). Values: [0-254], UINT8_MAX: invalid/unknown."},
+			{Name: "rxerrors", Type: "uint16_t", Description: "Count of radio packet receive errors (since boot)."},
+			{Name: "fixed", Type: "uint16_t", Description: "Count of error corrected radio packets (since boot)."},
+		},
+	},
+	110: {
+		ID:          110,
+		Name:        "FILE_TRANSFER_PROTOCOL",
+		Description: "File transfer protocol message: https://mavlink.io/en/services/ftp.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_network", Type: "uint8_t", Description: "Network ID (0 for broadcast)"},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)"},
+			{Name: "payload", Type: "uint8_t", ArrayLength: 251, Description: "Variable length payload. The content/format of this block is defined in https://mavlink.io/en/services/ftp.html. The length is defined by the remaining message length when subtracting the header and other fields. See also MAV_FTP_OPCODE and MAV_FTP_ERR."},
+		},
+	},
+	111: {
+		ID:          111,
+		Name:        "TIMESYNC",
+		Description: "Time synchronization message. The message is used for both timesync requests and responses. The request is sent with `ts1=syncing component timestamp` and `tc1=0`, and may be broadcast or targeted to a specific system/component. The response is sent with `ts1=syncing component timestamp` (mirror back unchanged), and `tc1=responding component timestamp`, with the `target_system` and `target_component` set to ids of the original request. Systems can determine if they are receiving a request or response based on the value of `tc`. If the response has `target_system==target_component==0` the remote system has not been updated to use the component IDs and cannot reliably timesync; the requester may report an error. Timestamps are UNIX Epoch time or time since system boot in nanoseconds (the timestamp format can be inferred by checking for the magnitude of the number; generally it doesn't matter as only the offset is used). The message sequence is repeated numerous times with results being filtered/averaged to estimate the offset. See also: https://mavlink.io/en/services/timesync.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "tc1", Type: "int64_t", Description: "Time sync timestamp 1. Syncing: 0. Responding: Timestamp of responding component."},
+			{Name: "ts1", Type: "int64_t", Description: "Time sync timestamp 2. Timestamp of syncing component (mirrored in response)."},
+			{Name: "target_system", Type: "uint8_t", Extension: true, Description: "Target system id. Request: 0 (broadcast) or id of specific system. Response must contain system id of the requesting component."},
+			{Name: "target_component", Type: "uint8_t", Extension: true, Description: "Target component id. Request: 0 (broadcast) or id of specific component. Response must contain component id of the requesting component."},
+		},
+	},
+	112: {
+		ID:          112,
+		Name:        "CAMERA_TRIGGER",
+		Description: "Camera-IMU triggering and synchronisation message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp for image frame (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "seq", Type: "uint32_t", Description: "Image frame sequence"},
+		},
+	},
+	113: {
+		ID:          113,
+		Name:        "HIL_GPS",
+		Description: "The global position, as returned by the Global Positioning System (GPS). This is NOT the global position estimate of the system, but rather a RAW sensor value. See message GLOBAL_POSITION_INT for the global position estimate.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "fix_type", Type: "uint8_t", Description: "0-1: no fix, 2: 2D fix, 3: 3D fix. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "eph", Type: "uint16_t", Description: "GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "epv", Type: "uint16_t", Description: "GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "vel", Type: "uint16_t", Description: "GPS ground speed. If unknown, set to: UINT16_MAX"},
+			{Name: "vn", Type: "int16_t", Description: "GPS velocity in north direction in earth-fixed NED frame"},
+			{Name: "ve", Type: "int16_t", Description: "GPS velocity in east direction in earth-fixed NED frame"},
+			{Name: "vd", Type: "int16_t", Description: "GPS velocity in down direction in earth-fixed NED frame"},
+			{Name: "cog", Type: "uint16_t", Description: "Course over ground (NOT heading, but direction of movement), 0.0..359.99 degrees. If unknown, set to: UINT16_MAX"},
+			{Name: "satellites_visible", Type: "uint8_t", Description: "Number of satellites visible. If unknown, set to UINT8_MAX"},
+			{Name: "id", Type: "uint8_t", Extension: true, Description: "GPS ID (zero indexed). Used for multiple GPS inputs"},
+			{Name: "yaw", Type: "uint16_t", Extension: true, Description: "Yaw of vehicle relative to Earth's North, zero means not available, use 36000 for north"},
+		},
+	},
+	114: {
+		ID:          114,
+		Name:        "HIL_OPTICAL_FLOW",
+		Description: "Simulated optical flow from a flow sensor (e.g. PX4FLOW or optical mouse sensor)",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "sensor_id", Type: "uint8_t", Description: "Sensor ID"},
+			{Name: "integration_time_us", Type: "uint32_t", Description: "Integration time. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the."},
+			{Name: "integrated_x", Type: "float", Description: "Flow in radians around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)"},
+			{Name: "integrated_y", Type: "float", Description: "Flow in radians around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)"},
+			{Name: "integrated_xgyro", Type: "float", Description: "RH rotation around X axis"},
+			{Name: "integrated_ygyro", Type: "float", Description: "RH rotation around Y axis"},
+			{Name: "integrated_zgyro", Type: "float", Description: "RH rotation around Z axis"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature"},
+			{Name: "quality", Type: "uint8_t", Description: "Optical flow quality / confidence. 0: no valid flow, 255: maximum quality"},
+			{Name: "time_delta_distance_us", Type: "uint32_t", Description: "Time since the distance was sampled."},
+			{Name: "distance", Type: "float", Description: "Distance to the center of the flow field. Positive value (including zero): distance known. Negative value: Unknown distance."},
+		},
+	},
+	115: {
+		ID:          115,
+		Name:        "HIL_STATE_QUATERNION",
+		Description: "Sent from simulation to autopilot, avoids in contrast to HIL_STATE singularities. This packet is useful for high throughput applications such as hardware in the loop simulations.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "attitude_quaternion", Type: "float", ArrayLength: 4, Description: "Vehicle attitude expressed as normalized quaternion in w, x, y, z order (with 1 0 0 0 being the null-rotation)"},
+			{Name: "rollspeed", Type: "float", Description: "Body frame roll / phi angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Body frame pitch / theta angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Body frame yaw / psi angular speed"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude"},
+			{Name: "vx", Type: "int16_t", Description: "Ground X Speed (Latitude)"},
+			{Name: "vy", Type: "int16_t", Description: "Ground Y Speed (Longitude)"},
+			{Name: "vz", Type: "int16_t", Description: "Ground Z Speed (Altitude)"},
+			{Name: "ind_airspeed", Type: "uint16_t", Description: "Indicated airspeed"},
+			{Name: "true_airspeed", Type: "uint16_t", Description: "True airspeed"},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration"},
+		},
+	},
+	116: {
+		ID:          116,
+		Name:        "SCALED_IMU2",
+		Description: "The RAW IMU readings for secondary 9DOF sensor setup. This message should contain the scaled values to the described units",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "int16_t", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "int16_t", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "int16_t", Description: "Angular speed around Z axis"},
+			{Name: "xmag", Type: "int16_t", Description: "X Magnetic field"},
+			{Name: "ymag", Type: "int16_t", Description: "Y Magnetic field"},
+			{Name: "zmag", Type: "int16_t", Description: "Z Magnetic field"},
+			{Name: "temperature", Type: "int16_t", Extension: true, Description: "Temperature, 0: IMU does not provide temperature values. If the IMU is at 0C it must send 1 (0.01C)."},
+		},
+	},
+	117: {
+		ID:          117,
+		Name:        "LOG_REQUEST_LIST",
+		Description: "Request a list of available logs. On some systems calling this may stop on-board logging until LOG_REQUEST_END is called. If there are no log files available this request shall be answered with one LOG_ENTRY message with id = 0 and num_logs = 0. LOG_ENTRY messages can start with id 1 or 0. The ground station needs to be able to process either.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "start", Type: "uint16_t", Description: "First log id (0 for first available)"},
+			{Name: "end", Type: "uint16_t", Description: "Last log id (0xffff for last available)"},
+		},
+	},
+	118: {
+		ID:          118,
+		Name:        "LOG_ENTRY",
+		Description: "Reply to LOG_REQUEST_LIST",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint16_t", Description: "Log id"},
+			{Name: "num_logs", Type: "uint16_t", Description: "Total number of logs"},
+			{Name: "last_log_num", Type: "uint16_t", Description: "High log number"},
+			{Name: "time_utc", Type: "uint32_t", Description: "UTC timestamp of log since 1970, or 0 if not available"},
+			{Name: "size", Type: "uint32_t", Description: "Size of the log (may be approximate)"},
+		},
+	},
+	119: {
+		ID:          119,
+		Name:        "LOG_REQUEST_DATA",
+		Description: "Request a chunk of a log",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "id", Type: "uint16_t", Description: "Log id (from LOG_ENTRY reply)"},
+			{Name: "ofs", Type: "uint32_t", Description: "Offset into the log"},
+			{Name: "count", Type: "uint32_t", Description: "Number of bytes"},
+		},
+	},
+	120: {
+		ID:          120,
+		Name:        "LOG_DATA",
+		Description: "Reply to LOG_REQUEST_DATA",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint16_t", Description: "Log id (from LOG_ENTRY reply)"},
+			{Name: "ofs", Type: "uint32_t", Description: "Offset into the log"},
+			{Name: "count", Type: "uint8_t", Description: "Number of bytes (zero for end of log)"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 90, Description: "log data"},
+		},
+	},
+	121: {
+		ID:          121,
+		Name:        "LOG_ERASE",
+		Description: "Erase all logs",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+		},
+	},
+	122: {
+		ID:          122,
+		Name:        "LOG_REQUEST_END",
+		Description: "Stop log transfer and resume normal logging",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+		},
+	},
+	123: {
+		ID:          123,
+		Name:        "GPS_INJECT_DATA",
+		Description: "Data for injecting into the onboard GPS (used for DGPS)",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "len", Type: "uint8_t", Description: "Data length"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 110, Description: "Raw data (110 is enough for 12 satellites of RTCMv2)"},
+		},
+	},
+	124: {
+		ID:          124,
+		Name:        "GPS2_RAW",
+		Description: "Second GPS data.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "fix_type", Type: "uint8_t", Enum: "GPS_FIX_TYPE", Description: "GPS fix type."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "eph", Type: "uint16_t", Description: "GPS HDOP horizontal dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "epv", Type: "uint16_t", Description: "GPS VDOP vertical dilution of position (unitless * 100). If unknown, set to: UINT16_MAX"},
+			{Name: "vel", Type: "uint16_t", Description: "GPS ground speed. If unknown, set to: UINT16_MAX"},
+			{Name: "cog", Type: "uint16_t", Description: "Course over ground (NOT heading, but direction of movement): 0.0..359.99 degrees. If unknown, set to: UINT16_MAX"},
+			{Name: "satellites_visible", Type: "uint8_t", Description: "Number of satellites visible. If unknown, set to UINT8_MAX"},
+			{Name: "dgps_numch", Type: "uint8_t", Description: "Number of DGPS satellites"},
+			{Name: "dgps_age", Type: "uint32_t", Description: "Age of DGPS info"},
+			{Name: "yaw", Type: "uint16_t", Extension: true, Description: "Yaw in earth frame from north. Use 0 if this GPS does not provide yaw. Use UINT16_MAX if this GPS is configured to provide yaw and is currently unable to provide it. Use 36000 for north."},
+			{Name: "alt_ellipsoid", Type: "int32_t", Extension: true, Description: "Altitude (above WGS84, EGM96 ellipsoid). Positive for up."},
+			{Name: "h_acc", Type: "uint32_t", Extension: true, Description: "Position uncertainty."},
+			{Name: "v_acc", Type: "uint32_t", Extension: true, Description: "Altitude uncertainty."},
+			{Name: "vel_acc", Type: "uint32_t", Extension: true, Description: "Speed uncertainty."},
+			{Name: "hdg_acc", Type: "uint32_t", Extension: true, Description: "Heading / track uncertainty"},
+		},
+	},
+	125: {
+		ID:          125,
+		Name:        "POWER_STATUS",
+		Description: "Power supply status",
+		Fields: []*message.FieldMetadata{
+			{Name: "Vcc", Type: "uint16_t", Description: "5V rail voltage."},
+			{Name: "Vservo", Type: "uint16_t", Description: "Servo rail voltage."},
+			{Name: "flags", Type: "uint16_t", Enum: "MAV_POWER_STATUS", Bitmask: true, Description: "Bitmap of power supply status flags."},
+		},
+	},
+	126: {
+		ID:          126,
+		Name:        "SERIAL_CONTROL",
+		Description: "Control a serial port. This can be used for raw access to an onboard serial peripheral such as a GPS or telemetry radio. It is designed to make it possible to update the devices firmware via MAVLink messages or change the devices settings. A message with zero bytes can be used to change just the baudrate.",
+		Fields: []*message.FieldMetadata{
+			{Name: "device", Type: "uint8_t", Enum: "SERIAL_CONTROL_DEV", Description: "Serial control device type."},
+			{Name: "flags", Type: "uint8_t", Enum: "SERIAL_CONTROL_FLAG", Bitmask: true, Description: "Bitmap of serial control flags."},
+			{Name: "timeout", Type: "uint16_t", Description: "Timeout for reply data"},
+			{Name: "baudrate", Type: "uint32_t", Description: "Baudrate of transfer. Zero means no change."},
+			{Name: "count", Type: "uint8_t", Description: "how many bytes in this transfer"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 70, Description: "serial data"},
+			{Name: "target_system", Type: "uint8_t", Extension: true, Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Extension: true, Description: "Component ID"},
+		},
+	},
+	127: {
+		ID:          127,
+		Name:        "GPS_RTK",
+		Description: "RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_last_baseline_ms", Type: "uint32_t", Description: "Time since boot of last baseline message received."},
+			{Name: "rtk_receiver_id", Type: "uint8_t", Description: "Identification of connected RTK receiver."},
+			{Name: "wn", Type: "uint16_t", Description: "GPS Week Number of last baseline"},
+			{Name: "tow", Type: "uint32_t", Description: "GPS Time of Week of last baseline"},
+			{Name: "rtk_health", Type: "uint8_t", Description: "GPS-specific health report for RTK data."},
+			{Name: "rtk_rate", Type: "uint8_t", Description: "Rate of baseline messages being received by GPS"},
+			{Name: "nsats", Type: "uint8_t", Description: "Current number of sats used for RTK calculation."},
+			{Name: "baseline_coords_type", Type: "uint8_t", Enum: "RTK_BASELINE_COORDINATE_SYSTEM", Description: "Coordinate system of baseline"},
+			{Name: "baseline_a_mm", Type: "int32_t", Description: "Current baseline in ECEF x or NED north component."},
+			{Name: "baseline_b_mm", Type: "int32_t", Description: "Current baseline in ECEF y or NED east component."},
+			{Name: "baseline_c_mm", Type: "int32_t", Description: "Current baseline in ECEF z or NED down component."},
+			{Name: "accuracy", Type: "uint32_t", Description: "Current estimate of baseline accuracy."},
+			{Name: "iar_num_hypotheses", Type: "int32_t", Description: "Current number of integer ambiguity hypotheses."},
+		},
+	},
+	128: {
+		ID:          128,
+		Name:        "GPS2_RTK",
+		Description: "RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_last_baseline_ms", Type: "uint32_t", Description: "Time since boot of last baseline message received."},
+			{Name: "rtk_receiver_id", Type: "uint8_t", Description: "Identification of connected RTK receiver."},
+			{Name: "wn", Type: "uint16_t", Description: "GPS Week Number of last baseline"},
+			{Name: "tow", Type: "uint32_t", Description: "GPS Time of Week of last baseline"},
+			{Name: "rtk_health", Type: "uint8_t", Description: "GPS-specific health report for RTK data."},
+			{Name: "rtk_rate", Type: "uint8_t", Description: "Rate of baseline messages being received by GPS"},
+			{Name: "nsats", Type: "uint8_t", Description: "Current number of sats used for RTK calculation."},
+			{Name: "baseline_coords_type", Type: "uint8_t", Enum: "RTK_BASELINE_COORDINATE_SYSTEM", Description: "Coordinate system of baseline"},
+			{Name: "baseline_a_mm", Type: "int32_t", Description: "Current baseline in ECEF x or NED north component."},
+			{Name: "baseline_b_mm", Type: "int32_t", Description: "Current baseline in ECEF y or NED east component."},
+			{Name: "baseline_c_mm", Type: "int32_t", Description: "Current baseline in ECEF z or NED down component."},
+			{Name: "accuracy", Type: "uint32_t", Description: "Current estimate of baseline accuracy."},
+			{Name: "iar_num_hypotheses", Type: "int32_t", Description: "Current number of integer ambiguity hypotheses."},
+		},
+	},
+	129: {
+		ID:          129,
+		Name:        "SCALED_IMU3",
+		Description: "The RAW IMU readings for 3rd 9DOF sensor setup. This message should contain the scaled values to the described units",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "xacc", Type: "int16_t", Description: "X acceleration"},
+			{Name: "yacc", Type: "int16_t", Description: "Y acceleration"},
+			{Name: "zacc", Type: "int16_t", Description: "Z acceleration"},
+			{Name: "xgyro", Type: "int16_t", Description: "Angular speed around X axis"},
+			{Name: "ygyro", Type: "int16_t", Description: "Angular speed around Y axis"},
+			{Name: "zgyro", Type: "int16_t", Description: "Angular speed around Z axis"},
+			{Name: "xmag", Type: "int16_t", Description: "X Magnetic field"},
+			{Name: "ymag", Type: "int16_t", Description: "Y Magnetic field"},
+			{Name: "zmag", Type: "int16_t", Description: "Z Magnetic field"},
+			{Name: "temperature", Type: "int16_t", Extension: true, Description: "Temperature, 0: IMU does not provide temperature values. If the IMU is at 0C it must send 1 (0.01C)."},
+		},
+	},
+	130: {
+		ID:          130,
+		Name:        "DATA_TRANSMISSION_HANDSHAKE",
+		Description: "Handshake message to initiate, control and stop image streaming when using the Image Transmission Protocol: https://mavlink.io/en/services/image_transmission.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Enum: "MAVLINK_DATA_STREAM_TYPE", Description: "Type of requested/acknowledged data."},
+			{Name: "size", Type: "uint32_t", Description: "total data size (set on ACK only)."},
+			{Name: "width", Type: "uint16_t", Description: "Width of a matrix or image."},
+			{Name: "height", Type: "uint16_t", Description: "Height of a matrix or image."},
+			{Name: "packets", Type: "uint16_t", Description: "Number of packets being sent (set on ACK only)."},
+			{Name: "payload", Type: "uint8_t", Description: "Payload size per packet (normally 253 byte, see DATA field size in message ENCAPSULATED_DATA) (set on ACK only)."},
+			{Name: "jpg_quality", Type: "uint8_t", Description: "JPEG quality. Values: [1-100]."},
+		},
+	},
+	131: {
+		ID:          131,
+		Name:        "ENCAPSULATED_DATA",
+		Description: "Data packet for images sent using the Image Transmission Protocol: https://mavlink.io/en/services/image_transmission.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "seqnr", Type: "uint16_t", Description: "sequence number (starting with 0 on every transmission)"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 253, Description: "image data bytes"},
+		},
+	},
+	132: {
+		ID:          132,
+		Name:        "DISTANCE_SENSOR",
+		Description: "Distance sensor information for an onboard rangefinder.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "min_distance", Type: "uint16_t", Description: "Minimum distance the sensor can measure"},
+			{Name: "max_distance", Type: "uint16_t", Description: "Maximum distance the sensor can measure"},
+			{Name: "current_distance", Type: "uint16_t", Description: "Current distance reading"},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_DISTANCE_SENSOR", Description: "Type of distance sensor."},
+			{Name: "id", Type: "uint8_t", Description: "Onboard ID of the sensor"},
+			{Name: "orientation", Type: "uint8_t", Enum: "MAV_SENSOR_ORIENTATION", Description: "Direction the sensor faces. downward-facing: ROTATION_PITCH_270, upward-facing: ROTATION_PITCH_90, backward-facing: ROTATION_PITCH_180, forward-facing: ROTATION_NONE, left-facing: ROTATION_YAW_90, right-facing: ROTATION_YAW_270"},
+			{Name: "covariance", Type: "uint8_t", Description: "Measurement variance. Max standard deviation is 6cm. UINT8_MAX if unknown."},
+			{Name: "horizontal_fov", Type: "float", Extension: true, Description: "Horizontal Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0."},
+			{Name: "vertical_fov", Type: "float", Extension: true, Description: "Vertical Field of View (angle) where the distance measurement is valid and the field of view is known. Otherwise this is set to 0."},
+			{Name: "quaternion", Type: "float", ArrayLength: 4, Extension: true, Description: "Quaternion of the sensor orientation in vehicle body frame (w, x, y, z order, zero-rotation is 1, 0, 0, 0). Zero-rotation is along the vehicle body x-axis. This field is required if the orientation is set to MAV_SENSOR_ROTATION_CUSTOM. Set it to 0 if invalid.\""},
+			{Name: "signal_quality", Type: "uint8_t", Extension: true, Description: "Signal quality of the sensor. Specific to each sensor type, representing the relation of the signal strength with the target reflectivity, distance, size or aspect, but normalised as a percentage. 0 = unknown/unset signal quality, 1 = invalid signal, 100 = perfect signal."},
+		},
+	},
+	133: {
+		ID:          133,
+		Name:        "TERRAIN_REQUEST",
+		Description: "Request for terrain data and terrain status. See terrain protocol docs: https://mavlink.io/en/services/terrain.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "lat", Type: "int32_t", Description: "Latitude of SW corner of first grid"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude of SW corner of first grid"},
+			{Name: "grid_spacing", Type: "uint16_t", Description: "Grid spacing"},
+			{Name: "mask", Type: "uint64_t", Description: "Bitmask of requested 4x4 grids (row major 8x7 array of grids, 56 bits)"},
+		},
+	},
+	134: {
+		ID:          134,
+		Name:        "TERRAIN_DATA",
+		Description: "Terrain data sent from GCS. The lat/lon and grid_spacing must be the same as a lat/lon from a TERRAIN_REQUEST. See terrain protocol docs: https://mavlink.io/en/services/terrain.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "lat", Type: "int32_t", Description: "Latitude of SW corner of first grid"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude of SW corner of first grid"},
+			{Name: "grid_spacing", Type: "uint16_t", Description: "Grid spacing"},
+			{Name: "gridbit", Type: "uint8_t", Description: "bit within the terrain request mask"},
+			{Name: "data", Type: "int16_t", ArrayLength: 16, Description: "Terrain data MSL"},
+		},
+	},
+	135: {
+		ID:          135,
+		Name:        "TERRAIN_CHECK",
+		Description: "Request that the vehicle report terrain height at the given location (expected response is a TERRAIN_REPORT). Used by GCS to check if vehicle has all terrain data needed for a mission.",
+		Fields: []*message.FieldMetadata{
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+		},
+	},
+	136: {
+		ID:          136,
+		Name:        "TERRAIN_REPORT",
+		Description: "Streamed from drone to report progress of terrain map download (initiated by TERRAIN_REQUEST), or sent as a response to a TERRAIN_CHECK request. See terrain protocol docs: https://mavlink.io/en/services/terrain.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "spacing", Type: "uint16_t", Description: "grid spacing (zero if terrain at this location unavailable)"},
+			{Name: "terrain_height", Type: "float", Description: "Terrain height MSL"},
+			{Name: "current_height", Type: "float", Description: "Current vehicle height above lat/lon terrain height"},
+			{Name: "pending", Type: "uint16_t", Description: "Number of 4x4 terrain blocks waiting to be received or read from disk"},
+			{Name: "loaded", Type: "uint16_t", Description: "Number of 4x4 terrain blocks in memory"},
+		},
+	},
+	137: {
+		ID:          137,
+		Name:        "SCALED_PRESSURE2",
+		Description: "Barometer readings for 2nd barometer",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "press_abs", Type: "float", Description: "Absolute pressure"},
+			{Name: "press_diff", Type: "float", Description: "Differential pressure"},
+			{Name: "temperature", Type: "int16_t", Description: "Absolute pressure temperature"},
+			{Name: "temperature_press_diff", Type: "int16_t", Extension: true, Description: "Differential pressure temperature (0, if not available). Report values of 0 (or 1) as 1 cdegC."},
+		},
+	},
+	138: {
+		ID:          138,
+		Name:        "ATT_POS_MOCAP",
+		Description: "Motion capture attitude and position",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "x", Type: "float", Description: "X position (NED)"},
+			{Name: "y", Type: "float", Description: "Y position (NED)"},
+			{Name: "z", Type: "float", Description: "Z position (NED)"},
+			{Name: "covariance", Type: "float", ArrayLength: 21, Extension: true, Description: "Row-major representation of a pose 6x6 cross-covariance matrix upper right triangle (states: x, y, z, roll, pitch, yaw; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+		},
+	},
+	139: {
+		ID:          139,
+		Name:        "SET_ACTUATOR_CONTROL_TARGET",
+		Description: "Set the vehicle attitude and body angular rates.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "group_mlx", Type: "uint8_t", Description: "Actuator group. The \"_mlx\" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "controls", Type: "float", ArrayLength: 8, Description: "Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs."},
+		},
+	},
+	140: {
+		ID:          140,
+		Name:        "ACTUATOR_CONTROL_TARGET",
+		Description: "Set the vehicle attitude and body angular rates.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "group_mlx", Type: "uint8_t", Description: "Actuator group. The \"_mlx\" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances."},
+			{Name: "controls", Type: "float", ArrayLength: 8, Description: "Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs."},
+		},
+	},
+	141: {
+		ID:          141,
+		Name:        "ALTITUDE",
+		Description: "The current system altitude.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "altitude_monotonic", Type: "float", Description: "This altitude measure is initialized on system boot and monotonic (it is never reset, but represents the local altitude change). The only guarantee on this field is that it will never be reset and is consistent within a flight. The recommended value for this field is the uncorrected barometric altitude at boot time. This altitude will also drift and vary between flights."},
+			{Name: "altitude_amsl", Type: "float", Description: "This altitude measure is strictly above mean sea level and might be non-monotonic (it might reset on events like GPS lock or when a new QNH value is set). It should be the altitude to which global altitude waypoints are compared to. Note that it is *not* the GPS altitude, however, most GPS modules already output MSL by default and not the WGS84 altitude."},
+			{Name: "altitude_local", Type: "float", Description: "This is the local altitude in the local coordinate frame. It is not the altitude above home, but in reference to the coordinate origin (0, 0, 0). It is up-positive."},
+			{Name: "altitude_relative", Type: "float", Description: "This is the altitude above the home position. It resets on each change of the current home position."},
+			{Name: "altitude_terrain", Type: "float", Description: "This is the altitude above terrain. It might be fed by a terrain database or an altimeter. Values smaller than -1000 should be interpreted as unknown."},
+			{Name: "bottom_clearance", Type: "float", Description: "This is not the altitude, but the clear space below the system according to the fused clearance estimate. It generally should max out at the maximum range of e.g. the laser altimeter. It is generally a moving target. A negative value indicates no measurement available."},
+		},
+	},
+	142: {
+		ID:          142,
+		Name:        "RESOURCE_REQUEST",
+		Description: "The autopilot is requesting a resource (file, binary, other type of data)",
+		Fields: []*message.FieldMetadata{
+			{Name: "request_id", Type: "uint8_t", Description: "Request ID. This ID should be reused when sending back URI contents"},
+			{Name: "uri_type", Type: "uint8_t", Description: "The type of requested URI. 0 = a file via URL. 1 = a UAVCAN binary"},
+			{Name: "uri", Type: "uint8_t", ArrayLength: 120, Description: "The requested unique resource identifier (URI). It is not necessarily a straight domain name (depends on the URI type enum)"},
+			{Name: "transfer_type", Type: "uint8_t", Description: "The way the autopilot wants to receive the URI. 0 = MAVLink FTP. 1 = binary stream."},
+			{Name: "storage", Type: "uint8_t", ArrayLength: 120, Description: "The storage path the autopilot wants the URI to be stored in. Will only be valid if the transfer_type has a storage associated (e.g. MAVLink FTP)."},
+		},
+	},
+	143: {
+		ID:          143,
+		Name:        "SCALED_PRESSURE3",
+		Description: "Barometer readings for 3rd barometer",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "press_abs", Type: "float", Description: "Absolute pressure"},
+			{Name: "press_diff", Type: "float", Description: "Differential pressure"},
+			{Name: "temperature", Type: "int16_t", Description: "Absolute pressure temperature"},
+			{Name: "temperature_press_diff", Type: "int16_t", Extension: true, Description: "Differential pressure temperature (0, if not available). Report values of 0 (or 1) as 1 cdegC."},
+		},
+	},
+	144: {
+		ID:          144,
+		Name:        "FOLLOW_TARGET",
+		Description: "Current motion information from a designated system",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (time since system boot)."},
+			{Name: "est_capabilities", Type: "uint8_t", Description: "bit positions for tracker reporting capabilities (POS = 0, VEL = 1, ACCEL = 2, ATT + RATES = 3)"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL)"},
+			{Name: "vel", Type: "float", ArrayLength: 3, Description: "target velocity (0,0,0) for unknown"},
+			{Name: "acc", Type: "float", ArrayLength: 3, Description: "linear target acceleration (0,0,0) for unknown"},
+			{Name: "attitude_q", Type: "float", ArrayLength: 4, Description: "(0 0 0 0 for unknown)"},
+			{Name: "rates", Type: "float", ArrayLength: 3, Description: "(0 0 0 for unknown)"},
+			{Name: "position_cov", Type: "float", ArrayLength: 3, Description: "eph epv"},
+			{Name: "custom_state", Type: "uint64_t", Description: "button states or switches of a tracker device"},
+		},
+	},
+	146: {
+		ID:          146,
+		Name:        "CONTROL_SYSTEM_STATE",
+		Description: "The smoothed, monotonic system state used to feed the control loops of the system.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "x_acc", Type: "float", Description: "X acceleration in body frame"},
+			{Name: "y_acc", Type: "float", Description: "Y acceleration in body frame"},
+			{Name: "z_acc", Type: "float", Description: "Z acceleration in body frame"},
+			{Name: "x_vel", Type: "float", Description: "X velocity in body frame"},
+			{Name: "y_vel", Type: "float", Description: "Y velocity in body frame"},
+			{Name: "z_vel", Type: "float", Description: "Z velocity in body frame"},
+			{Name: "x_pos", Type: "float", Description: "X position in local frame"},
+			{Name: "y_pos", Type: "float", Description: "Y position in local frame"},
+			{Name: "z_pos", Type: "float", Description: "Z position in local frame"},
+			{Name: "airspeed", Type: "float", Description: "Airspeed, set to -1 if unknown"},
+			{Name: "vel_variance", Type: "float", ArrayLength: 3, Description: "Variance of body velocity estimate"},
+			{Name: "pos_variance", Type: "float", ArrayLength: 3, Description: "Variance in local position"},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "The attitude, represented as Quaternion"},
+			{Name: "roll_rate", Type: "float", Description: "Angular rate in roll axis"},
+			{Name: "pitch_rate", Type: "float", Description: "Angular rate in pitch axis"},
+			{Name: "yaw_rate", Type: "float", Description: "Angular rate in yaw axis"},
+		},
+	},
+	147: {
+		ID:          147,
+		Name:        "BATTERY_STATUS",
+		Description: "Battery information. Updates GCS with flight controller battery status. Smart batteries also use this message, but may additionally send BATTERY_INFO.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Battery ID"},
+			{Name: "battery_function", Type: "uint8_t", Enum: "MAV_BATTERY_FUNCTION", Description: "Function of the battery"},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_BATTERY_TYPE", Description: "Type (chemistry) of the battery"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature of the battery. INT16_MAX for unknown temperature."},
+			{Name: "voltages", Type: "uint16_t", ArrayLength: 10, Description: "Battery voltage of cells 1 to 10 (see voltages_ext for cells 11-14). Cells in this field above the valid cell count for this battery should have the UINT16_MAX value. If individual cell voltages are unknown or not measured for this battery, then the overall battery voltage should be filled in cell 0, with all others set to UINT16_MAX. If the voltage of the battery is greater than (UINT16_MAX - 1), then cell 0 should be set to (UINT16_MAX - 1), and cell 1 to the remaining voltage. This can be extended to multiple cells if the total voltage is greater than 2 * (UINT16_MAX - 1)."},
+			{Name: "current_battery", Type: "int16_t", Description: "Battery current, -1: autopilot does not measure the current. Value may overflow/rollover for very high currents (> 327.67A)"},
+			{Name: "current_consumed", Type: "int32_t", Description: "Consumed charge, -1: autopilot does not provide consumption estimate"},
+			{Name: "energy_consumed", Type: "int32_t", Description: "Consumed energy, -1: autopilot does not provide energy consumption estimate"},
+			{Name: "battery_remaining", Type: "int8_t", Description: "Remaining battery energy. Values: [0-100], -1: autopilot does not estimate the remaining battery."},
+			{Name: "time_remaining", Type: "int32_t", Extension: true, Description: "Remaining battery time, 0: autopilot does not provide remaining battery time estimate"},
+			{Name: "charge_state", Type: "uint8_t", Extension: true, Enum: "MAV_BATTERY_CHARGE_STATE", Description: "State for extent of discharge, provided by autopilot for warning or external reactions"},
+			{Name: "voltages_ext", Type: "uint16_t", ArrayLength: 4, Extension: true, Description: "Battery voltages for cells 11 to 14. Cells above the valid cell count for this battery should have a value of 0, where zero indicates not supported (note, this is different than for the voltages field and allows empty byte truncation). If the measured value is 0 then 1 should be sent instead."},
+			{Name: "mode", Type: "uint8_t", Extension: true, Enum: "MAV_BATTERY_MODE", Description: "Battery mode. Default (0) is that battery mode reporting is not supported or battery is in normal-use mode."},
+			{Name: "fault_bitmask", Type: "uint32_t", Extension: true, Enum: "MAV_BATTERY_FAULT", Bitmask: true, Description: "Fault/health indications. These should be set when charge_state is MAV_BATTERY_CHARGE_STATE_FAILED or MAV_BATTERY_CHARGE_STATE_UNHEALTHY (if not, fault reporting is not supported)."},
+		},
+	},
+	149: {
+		ID:          149,
+		Name:        "LANDING_TARGET",
+		Description: "The location of a landing target. See: https://mavlink.io/en/services/landing_target.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "target_num", Type: "uint8_t", Description: "The ID of the target if multiple targets are present"},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame used for following fields."},
+			{Name: "angle_x", Type: "float", Description: "X-axis angular offset of the target from the center of the image"},
+			{Name: "angle_y", Type: "float", Description: "Y-axis angular offset of the target from the center of the image"},
+			{Name: "distance", Type: "float", Description: "Distance to the target from the vehicle"},
+			{Name: "size_x", Type: "float", Description: "Size of target along x-axis"},
+			{Name: "size_y", Type: "float", Description: "Size of target along y-axis"},
+			{Name: "x", Type: "float", Extension: true, Description: "X Position of the landing target in MAV_FRAME"},
+			{Name: "y", Type: "float", Extension: true, Description: "Y Position of the landing target in MAV_FRAME"},
+			{Name: "z", Type: "float", Extension: true, Description: "Z Position of the landing target in MAV_FRAME"},
+			{Name: "q", Type: "float", ArrayLength: 4, Extension: true, Description: "Quaternion of landing target orientation (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "type", Type: "uint8_t", Extension: true, Enum: "LANDING_TARGET_TYPE", Description: "Type of landing target"},
+			{Name: "position_valid", Type: "uint8_t", Extension: true, Enum: "MAV_BOOL", Description: "Position fields (x, y, z, q, type) contain valid target position information (MAV_BOOL_FALSE: invalid values). Values not equal to 0 or 1 are invalid."},
+		},
+	},
+	162: {
+		ID:          162,
+		Name:        "FENCE_STATUS",
+		Description: "Status of geo-fencing. Sent in extended status stream when fencing enabled.",
+		Fields: []*message.FieldMetadata{
+			{Name: "breach_status", Type: "uint8_t", Description: "Breach status (0 if currently inside fence, 1 if outside)."},
+			{Name: "breach_count", Type: "uint16_t", Description: "Number of fence breaches."},
+			{Name: "breach_type", Type: "uint8_t", Enum: "FENCE_BREACH", Description: "Last breach type."},
+			{Name: "breach_time", Type: "uint32_t", Description: "Time (since boot) of last breach."},
+			{Name: "breach_mitigation", Type: "uint8_t", Extension: true, Enum: "FENCE_MITIGATE", Description: "Active action to prevent fence breach"},
+		},
+	},
+	192: {
+		ID:          192,
+		Name:        "MAG_CAL_REPORT",
+		Description: "Reports results of completed compass calibration. Sent until MAG_CAL_ACK received.",
+		Fields: []*message.FieldMetadata{
+			{Name: "compass_id", Type: "uint8_t", Description: "Compass being calibrated."},
+			{Name: "cal_mask", Type: "uint8_t", Description: "Bitmask of compasses being calibrated."},
+			{Name: "cal_status", Type: "uint8_t", Enum: "MAG_CAL_STATUS", Description: "Calibration Status."},
+			{Name: "autosaved", Type: "uint8_t", Description: "0=requires a MAV_CMD_DO_ACCEPT_MAG_CAL, 1=saved to parameters."},
+			{Name: "fitness", Type: "float", Description: "RMS milligauss residuals."},
+			{Name: "ofs_x", Type: "float", Description: "X offset."},
+			{Name: "ofs_y", Type: "float", Description: "Y offset."},
+			{Name: "ofs_z", Type: "float", Description: "Z offset."},
+			{Name: "diag_x", Type: "float", Description: "X diagonal (matrix 11)."},
+			{Name: "diag_y", Type: "float", Description: "Y diagonal (matrix 22)."},
+			{Name: "diag_z", Type: "float", Description: "Z diagonal (matrix 33)."},
+			{Name: "offdiag_x", Type: "float", Description: "X off-diagonal (matrix 12 and 21)."},
+			{Name: "offdiag_y", Type: "float", Description: "Y off-diagonal (matrix 13 and 31)."},
+			{Name: "offdiag_z", Type: "float", Description: "Z off-diagonal (matrix 32 and 23)."},
+			{Name: "orientation_confidence", Type: "float", Extension: true, Description: "Confidence in orientation (higher is better)."},
+			{Name: "old_orientation", Type: "uint8_t", Extension: true, Enum: "MAV_SENSOR_ORIENTATION", Description: "orientation before calibration."},
+			{Name: "new_orientation", Type: "uint8_t", Extension: true, Enum: "MAV_SENSOR_ORIENTATION", Description: "orientation after calibration."},
+			{Name: "scale_factor", Type: "float", Extension: true, Description: "field radius correction factor"},
+		},
+	},
+	225: {
+		ID:          225,
+		Name:        "EFI_STATUS",
+		Description: "EFI status output",
+		Fields: []*message.FieldMetadata{
+			{Name: "health", Type: "uint8_t", Description: "EFI health status"},
+			{Name: "ecu_index", Type: "float", Description: "ECU index"},
+			{Name: "rpm", Type: "float", Description: "RPM"},
+			{Name: "fuel_consumed", Type: "float", Description: "Fuel consumed"},
+			{Name: "fuel_flow", Type: "float", Description: "Fuel flow rate"},
+			{Name: "engine_load", Type: "float", Description: "Engine load"},
+			{Name: "throttle_position", Type: "float", Description: "Throttle position"},
+			{Name: "spark_dwell_time", Type: "float", Description: "Spark dwell time"},
+			{Name: "barometric_pressure", Type: "float", Description: "Barometric pressure"},
+			{Name: "intake_manifold_pressure", Type: "float", Description: "Intake manifold pressure("},
+			{Name: "intake_manifold_temperature", Type: "float", Description: "Intake manifold temperature"},
+			{Name: "cylinder_head_temperature", Type: "float", Description: "Cylinder head temperature"},
+			{Name: "ignition_timing", Type: "float", Description: "Ignition timing (Crank angle degrees)"},
+			{Name: "injection_time", Type: "float", Description: "Injection time"},
+			{Name: "exhaust_gas_temperature", Type: "float", Description: "Exhaust gas temperature"},
+			{Name: "throttle_out", Type: "float", Description: "Output throttle"},
+			{Name: "pt_compensation", Type: "float", Description: "Pressure/temperature compensation"},
+			{Name: "ignition_voltage", Type: "float", Extension: true, Description: "Supply voltage to EFI sparking system.  Zero in this value means \"unknown\", so if the supply voltage really is zero volts use 0.0001 instead."},
+			{Name: "fuel_pressure", Type: "float", Extension: true, Description: "Fuel pressure. Zero in this value means \"unknown\", so if the fuel pressure really is zero kPa use 0.0001 instead."},
+		},
+	},
+	230: {
+		ID:          230,
+		Name:        "ESTIMATOR_STATUS",
+		Description: "Estimator status message including flags, innovation test ratios and estimated accuracies. The flags message is an integer bitmask containing information on which EKF outputs are valid. See the ESTIMATOR_STATUS_FLAGS enum definition for further information. The innovation test ratios show the magnitude of the sensor innovation divided by the innovation check threshold. Under normal operation the innovation test ratios should be below 0.5 with occasional values up to 1.0. Values greater than 1.0 should be rare under normal operation and indicate that a measurement has been rejected by the filter. The user should be notified if an innovation test ratio greater than 1.0 is recorded. Notifications for values in the range between 0.5 and 1.0 should be optional and controllable by the user.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "flags", Type: "uint16_t", Enum: "ESTIMATOR_STATUS_FLAGS", Bitmask: true, Description: "Bitmap indicating which EKF outputs are valid."},
+			{Name: "vel_ratio", Type: "float", Description: "Velocity innovation test ratio"},
+			{Name: "pos_horiz_ratio", Type: "float", Description: "Horizontal position innovation test ratio"},
+			{Name: "pos_vert_ratio", Type: "float", Description: "Vertical position innovation test ratio"},
+			{Name: "mag_ratio", Type: "float", Description: "Magnetometer innovation test ratio"},
+			{Name: "hagl_ratio", Type: "float", Description: "Height above terrain innovation test ratio"},
+			{Name: "tas_ratio", Type: "float", Description: "True airspeed innovation test ratio"},
+			{Name: "pos_horiz_accuracy", Type: "float", Description: "Horizontal position 1-STD accuracy relative to the EKF local origin"},
+			{Name: "pos_vert_accuracy", Type: "float", Description: "Vertical position 1-STD accuracy relative to the EKF local origin"},
+		},
+	},
+	231: {
+		ID:          231,
+		Name:        "WIND_COV",
+		Description: "Wind estimate from vehicle. Note that despite the name, this message does not actually contain any covariances but instead variability and accuracy fields in terms of standard deviation (1-STD).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "wind_x", Type: "float", Description: "Wind in North (NED) direction (NAN if unknown)"},
+			{Name: "wind_y", Type: "float", Description: "Wind in East (NED) direction (NAN if unknown)"},
+			{Name: "wind_z", Type: "float", Description: "Wind in down (NED) direction (NAN if unknown)"},
+			{Name: "var_horiz", Type: "float", Description: "Variability of wind in XY, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown)"},
+			{Name: "var_vert", Type: "float", Description: "Variability of wind in Z, 1-STD estimated from a 1 Hz lowpassed wind estimate (NAN if unknown)"},
+			{Name: "wind_alt", Type: "float", Description: "Altitude (MSL) that this measurement was taken at (NAN if unknown)"},
+			{Name: "horiz_accuracy", Type: "float", Description: "Horizontal speed 1-STD accuracy (0 if unknown)"},
+			{Name: "vert_accuracy", Type: "float", Description: "Vertical speed 1-STD accuracy (0 if unknown)"},
+		},
+	},
+	232: {
+		ID:          232,
+		Name:        "GPS_INPUT",
+		Description: "GPS sensor input message.  This is a raw sensor value sent by the GPS. This is NOT the global position estimate of the system.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "gps_id", Type: "uint8_t", Description: "ID of the GPS for multiple GPS inputs"},
+			{Name: "ignore_flags", Type: "uint16_t", Enum: "GPS_INPUT_IGNORE_FLAGS", Bitmask: true, Description: "Bitmap indicating which GPS input flags fields to ignore.  All other fields must be provided."},
+			{Name: "time_week_ms", Type: "uint32_t", Description: "GPS time (from start of GPS week)"},
+			{Name: "time_week", Type: "uint16_t", Description: "GPS week number"},
+			{Name: "fix_type", Type: "uint8_t", Enum: "GPS_FIX_TYPE", Description: "GNSS fix type"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL). Positive for up."},
+			{Name: "hdop", Type: "float", Description: "GPS HDOP horizontal dilution of position (unitless). If unknown, set to: UINT16_MAX"},
+			{Name: "vdop", Type: "float", Description: "GPS VDOP vertical dilution of position (unitless). If unknown, set to: UINT16_MAX"},
+			{Name: "vn", Type: "float", Description: "GPS velocity in north direction in earth-fixed NED frame"},
+			{Name: "ve", Type: "float", Description: "GPS velocity in east direction in earth-fixed NED frame"},
+			{Name: "vd", Type: "float", Description: "GPS velocity in down direction in earth-fixed NED frame"},
+			{Name: "speed_accuracy", Type: "float", Description: "GPS speed accuracy"},
+			{Name: "horiz_accuracy", Type: "float", Description: "GPS horizontal accuracy"},
+			{Name: "vert_accuracy", Type: "float", Description: "GPS vertical accuracy"},
+			{Name: "satellites_visible", Type: "uint8_t", Description: "Number of satellites visible."},
+			{Name: "yaw", Type: "uint16_t", Extension: true, Description: "Yaw of vehicle relative to Earth's North, zero means not available, use 36000 for north"},
+		},
+	},
+	233: {
+		ID:          233,
+		Name:        "GPS_RTCM_DATA",
+		Description: "RTCM message for injecting into the onboard GPS (used for DGPS)",
+		Fields: []*message.FieldMetadata{
+			{Name: "flags", Type: "uint8_t", Description: "LSB: 1 means message is fragmented, next 2 bits are the fragment ID, the remaining 5 bits are used for the sequence ID. Messages are only to be flushed to the GPS when the entire message has been reconstructed on the autopilot. The fragment ID specifies which order the fragments should be assembled into a buffer, while the sequence ID is used to detect a mismatch between different buffers. The buffer is considered fully reconstructed when either all 4 fragments are present, or all the fragments before the first fragment with a non full payload is received. This management is used to ensure that normal GPS operation doesn't corrupt RTCM data, and to recover from a unreliable transport delivery order."},
+			{Name: "len", Type: "uint8_t", Description: "data length"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 180, Description: "RTCM message (may be fragmented)"},
+		},
+	},
+	234: {
+		ID:          234,
+		Name:        "HIGH_LATENCY",
+		Description: "Message appropriate for high latency connections like Iridium",
+		Fields: []*message.FieldMetadata{
+			{Name: "base_mode", Type: "uint8_t", Enum: "MAV_MODE_FLAG", Bitmask: true, Description: "Bitmap of enabled system modes."},
+			{Name: "custom_mode", Type: "uint32_t", Description: "A bitfield for use for autopilot-specific flags."},
+			{Name: "landed_state", Type: "uint8_t", Enum: "MAV_LANDED_STATE", Description: "The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown."},
+			{Name: "roll", Type: "int16_t", Description: "roll"},
+			{Name: "pitch", Type: "int16_t", Description: "pitch"},
+			{Name: "heading", Type: "uint16_t", Description: "heading"},
+			{Name: "throttle", Type: "int8_t", Description: "throttle (percentage)"},
+			{Name: "heading_sp", Type: "int16_t", Description: "heading setpoint"},
+			{Name: "latitude", Type: "int32_t", Description: "Latitude"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude"},
+			{Name: "altitude_amsl", Type: "int16_t", Description: "Altitude above mean sea level"},
+			{Name: "altitude_sp", Type: "int16_t", Description: "Altitude setpoint relative to the home position"},
+			{Name: "airspeed", Type: "uint8_t", Description: "airspeed"},
+			{Name: "airspeed_sp", Type: "uint8_t", Description: "airspeed setpoint"},
+			{Name: "groundspeed", Type: "uint8_t", Description: "groundspeed"},
+			{Name: "climb_rate", Type: "int8_t", Description: "climb rate"},
+			{Name: "gps_nsat", Type: "uint8_t", Description: "Number of satellites visible. If unknown, set to UINT8_MAX"},
+			{Name: "gps_fix_type", Type: "uint8_t", Enum: "GPS_FIX_TYPE", Description: "GPS Fix type."},
+			{Name: "battery_remaining", Type: "uint8_t", Description: "Remaining battery (percentage)"},
+			{Name: "temperature", Type: "int8_t", Description: "Autopilot temperature (degrees C)"},
+			{Name: "temperature_air", Type: "int8_t", Description: "Air temperature (degrees C) from airspeed sensor"},
+			{Name: "failsafe", Type: "uint8_t", Description: "failsafe (each bit represents a failsafe where 0=ok, 1=failsafe active (bit0:RC, bit1:batt, bit2:GPS, bit3:GCS, bit4:fence)"},
+			{Name: "wp_num", Type: "uint8_t", Description: "current waypoint number"},
+			{Name: "wp_distance", Type: "uint16_t", Description: "distance to target"},
+		},
+	},
+	235: {
+		ID:          235,
+		Name:        "HIGH_LATENCY2",
+		Description: "Message appropriate for high latency connections like Iridium (version 2)",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint32_t", Description: "Timestamp (milliseconds since boot or Unix epoch)"},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_TYPE", Description: "Type of the MAV (quadrotor, helicopter, etc.)"},
+			{Name: "autopilot", Type: "uint8_t", Enum: "MAV_AUTOPILOT", Description: "Autopilot type / class. Use MAV_AUTOPILOT_INVALID for components that are not flight controllers."},
+			{Name: "custom_mode", Type: "uint16_t", Description: "A bitfield for use for autopilot-specific flags (2 byte version)."},
+			{Name: "latitude", Type: "int32_t", Description: "Latitude"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude"},
+			{Name: "altitude", Type: "int16_t", Description: "Altitude above mean sea level"},
+			{Name: "target_altitude", Type: "int16_t", Description: "Altitude setpoint"},
+			{Name: "heading", Type: "uint8_t", Description: "Heading"},
+			{Name: "target_heading", Type: "uint8_t", Description: "Heading setpoint"},
+			{Name: "target_distance", Type: "uint16_t", Description: "Distance to target waypoint or position"},
+			{Name: "throttle", Type: "uint8_t", Description: "Throttle"},
+			{Name: "airspeed", Type: "uint8_t", Description: "Airspeed"},
+			{Name: "airspeed_sp", Type: "uint8_t", Description: "Airspeed setpoint"},
+			{Name: "groundspeed", Type: "uint8_t", Description: "Groundspeed"},
+			{Name: "windspeed", Type: "uint8_t", Description: "Windspeed"},
+			{Name: "wind_heading", Type: "uint8_t", Description: "Wind heading"},
+			{Name: "eph", Type: "uint8_t", Description: "Maximum error horizontal position since last message"},
+			{Name: "epv", Type: "uint8_t", Description: "Maximum error vertical position since last message"},
+			{Name: "temperature_air", Type: "int8_t", Description: "Air temperature"},
+			{Name: "climb_rate", Type: "int8_t", Description: "Maximum climb rate magnitude since last message"},
+			{Name: "battery", Type: "int8_t", Description: "Battery level (-1 if field not provided)."},
+			{Name: "wp_num", Type: "uint16_t", Description: "Current waypoint number"},
+			{Name: "failure_flags", Type: "uint16_t", Enum: "HL_FAILURE_FLAG", Bitmask: true, Description: "Bitmap of failure flags."},
+			{Name: "custom0", Type: "int8_t", Description: "Field for custom payload."},
+			{Name: "custom1", Type: "int8_t", Description: "Field for custom payload."},
+			{Name: "custom2", Type: "int8_t", Description: "Field for custom payload."},
+		},
+	},
+	241: {
+		ID:          241,
+		Name:        "VIBRATION",
+		Description: "Vibration levels and accelerometer clipping",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "vibration_x", Type: "float", Description: "Vibration levels on X-axis"},
+			{Name: "vibration_y", Type: "float", Description: "Vibration levels on Y-axis"},
+			{Name: "vibration_z", Type: "float", Description: "Vibration levels on Z-axis"},
+			{Name: "clipping_0", Type: "uint32_t", Description: "first accelerometer clipping count"},
+			{Name: "clipping_1", Type: "uint32_t", Description: "second accelerometer clipping count"},
+			{Name: "clipping_2", Type: "uint32_t", Description: "third accelerometer clipping count"},
+		},
+	},
+	242: {
+		ID:          242,
+		Name:        "HOME_POSITION",
+		Description: "Contains the home position. The home position is the default position that the system will return to and land on. The position must be set automatically by the system during the takeoff, and may also be explicitly set using MAV_CMD_DO_SET_HOME. The global and local positions encode the position in the respective coordinate frames, while the q parameter encodes the orientation of the surface. Under normal conditions it describes the heading and terrain slope, which can be used by the aircraft to adjust the approach. The approach 3D vector describes the point to which the system should fly in normal flight mode and then perform a landing sequence along the vector. Note: this message can be requested by sending the MAV_CMD_REQUEST_MESSAGE with param1=242.",
+		Fields: []*message.FieldMetadata{
+			{Name: "latitude", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "altitude", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "x", Type: "float", Description: "Local X position of this position in the local coordinate frame (NED)"},
+			{Name: "y", Type: "float", Description: "Local Y position of this position in the local coordinate frame (NED)"},
+			{Name: "z", Type: "float", Description: "Local Z position of this position in the local coordinate frame (NED: positive \"down\")"},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion indicating world-to-surface-normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground. All fields should be set to NaN if an accurate quaternion for both heading and surface slope cannot be supplied."},
+			{Name: "approach_x", Type: "float", Description: "Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "approach_y", Type: "float", Description: "Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "approach_z", Type: "float", Description: "Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "time_usec", Type: "uint64_t", Extension: true, Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	243: {
+		ID:          243,
+		Name:        "SET_HOME_POSITION",
+		Description: "Sets the home position. The home position is the default position that the system will return to and land on. The position is set automatically by the system during the takeoff (and may also be set using this message). The global and local positions encode the position in the respective coordinate frames, while the q parameter encodes the orientation of the surface. Under normal conditions it describes the heading and terrain slope, which can be used by the aircraft to adjust the approach. The approach 3D vector describes the point to which the system should fly in normal flight mode and then perform a landing sequence along the vector. Note: the current home position may be emitted in a HOME_POSITION message on request (using MAV_CMD_REQUEST_MESSAGE with param1=242).",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "latitude", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "longitude", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "altitude", Type: "int32_t", Description: "Altitude (MSL). Positive for up."},
+			{Name: "x", Type: "float", Description: "Local X position of this position in the local coordinate frame (NED)"},
+			{Name: "y", Type: "float", Description: "Local Y position of this position in the local coordinate frame (NED)"},
+			{Name: "z", Type: "float", Description: "Local Z position of this position in the local coordinate frame (NED: positive \"down\")"},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "World to surface normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground"},
+			{Name: "approach_x", Type: "float", Description: "Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "approach_y", Type: "float", Description: "Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "approach_z", Type: "float", Description: "Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone."},
+			{Name: "time_usec", Type: "uint64_t", Extension: true, Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	244: {
+		ID:          244,
+		Name:        "MESSAGE_INTERVAL",
+		Description: "The interval between messages for a particular MAVLink message ID. This message is sent in response to the MAV_CMD_REQUEST_MESSAGE command with param1=244 (this message) and param2=message_id (the id of the message for which the interval is required). This interface replaces DATA_STREAM.",
+		Fields: []*message.FieldMetadata{
+			{Name: "message_id", Type: "uint16_t", Description: "The ID of the requested MAVLink message. v1.0 is limited to 254 messages."},
+			{Name: "interval_us", Type: "int32_t", Description: "The interval between two messages. A value of -1 indicates this stream is disabled, 0 indicates it is not available, > 0 indicates the interval at which it is sent."},
+		},
+	},
+	245: {
+		ID:          245,
+		Name:        "EXTENDED_SYS_STATE",
+		Description: "Provides state for additional features",
+		Fields: []*message.FieldMetadata{
+			{Name: "vtol_state", Type: "uint8_t", Enum: "MAV_VTOL_STATE", Description: "The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration."},
+			{Name: "landed_state", Type: "uint8_t", Enum: "MAV_LANDED_STATE", Description: "The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown."},
+		},
+	},
+	246: {
+		ID:          246,
+		Name:        "ADSB_VEHICLE",
+		Description: "The location and information of an ADSB vehicle",
+		Fields: []*message.FieldMetadata{
+			{Name: "ICAO_address", Type: "uint32_t", Description: "ICAO address"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "altitude_type", Type: "uint8_t", Enum: "ADSB_ALTITUDE_TYPE", Description: "ADSB altitude type."},
+			{Name: "altitude", Type: "int32_t", Description: "Altitude (ASL)"},
+			{Name: "heading", Type: "uint16_t", Description: "Course over ground"},
+			{Name: "hor_velocity", Type: "uint16_t", Description: "The horizontal velocity"},
+			{Name: "ver_velocity", Type: "int16_t", Description: "The vertical velocity. Positive is up"},
+			{Name: "callsign", Type: "char", ArrayLength: 9, Description: "The callsign, 8+null"},
+			{Name: "emitter_type", Type: "uint8_t", Enum: "ADSB_EMITTER_TYPE", Description: "ADSB emitter type."},
+			{Name: "tslc", Type: "uint8_t", Description: "Time since last communication. This is the age of the ADS-B information in this message, in seconds."},
+			{Name: "flags", Type: "uint16_t", Enum: "ADSB_FLAGS", Bitmask: true, Description: "Bitmap to indicate various statuses including valid data fields"},
+			{Name: "squawk", Type: "uint16_t", Description: "Squawk code. Note that the code is in decimal: e.g. 7700 (general emergency) is encoded as binary 0b0001_1110_0001_0100, not(!) as 0b0000_111_111_000_000"},
+		},
+	},
+	247: {
+		ID:          247,
+		Name:        "COLLISION",
+		Description: "Information about a potential collision",
+		Fields: []*message.FieldMetadata{
+			{Name: "src", Type: "uint8_t", Enum: "MAV_COLLISION_SRC", Description: "Collision data source"},
+			{Name: "id", Type: "uint32_t", Description: "Unique identifier, domain based on src field"},
+			{Name: "action", Type: "uint8_t", Enum: "MAV_COLLISION_ACTION", Description: "Action that is being taken to avoid this collision"},
+			{Name: "threat_level", Type: "uint8_t", Enum: "MAV_COLLISION_THREAT_LEVEL", Description: "How concerned the aircraft is about this collision"},
+			{Name: "time_to_minimum_delta", Type: "float", Description: "Estimated time until collision occurs"},
+			{Name: "altitude_minimum_delta", Type: "float", Description: "Closest vertical distance between vehicle and object"},
+			{Name: "horizontal_minimum_delta", Type: "float", Description: "Closest horizontal distance between vehicle and object"},
+		},
+	},
+	248: {
+		ID:          248,
+		Name:        "V2_EXTENSION",
+		Description: "Message implementing parts of the V2 payload specs in V1 frames for transitional support.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_network", Type: "uint8_t", Description: "Network ID (0 for broadcast)"},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)"},
+			{Name: "message_type", Type: "uint16_t", Description: "A code that identifies the software component that understands this message (analogous to USB device classes or mime type strings). If this code is less than 32768, it is considered a 'registered' protocol extension and the corresponding entry should be added to https://github.com/mavlink/mavlink/definition_files/extension_message_ids.xml. Software creators can register blocks of message IDs as needed (useful for GCS specific metadata, etc...). Message_types greater than 32767 are considered local experiments and should not be checked in to any widely distributed codebase."},
+			{Name: "payload", Type: "uint8_t", ArrayLength: 249, Description: "Variable length payload. The length must be encoded in the payload as part of the message_type protocol, e.g. by including the length as payload data, or by terminating the payload data with a non-zero marker. This is required in order to reconstruct zero-terminated payloads that are (or otherwise would be) trimmed by MAVLink 2 empty-byte truncation. The entire content of the payload block is opaque unless you understand the encoding message_type. The particular encoding used can be extension specific and might not always be documented as part of the MAVLink specification."},
+		},
+	},
+	249: {
+		ID:          249,
+		Name:        "MEMORY_VECT",
+		Description: "Send raw controller memory. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "address", Type: "uint16_t", Description: "Starting address of the debug variables"},
+			{Name: "ver", Type: "uint8_t", Description: "Version code of the type variable. 0=unknown, type ignored and assumed int16_t. 1=as below"},
+			{Name: "type", Type: "uint8_t", Description: "Type code of the memory variables. for ver = 1: 0=16 x int16_t, 1=16 x uint16_t, 2=16 x Q15, 3=16 x 1Q14"},
+			{Name: "value", Type: "int8_t", ArrayLength: 32, Description: "Memory contents at specified address"},
+		},
+	},
+	250: {
+		ID:          250,
+		Name:        "DEBUG_VECT",
+		Description: "To debug something using a named 3D vector.",
+		Fields: []*message.FieldMetadata{
+			{Name: "name", Type: "char", ArrayLength: 10, Description: "Name"},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "x", Type: "float", Description: "x"},
+			{Name: "y", Type: "float", Description: "y"},
+			{Name: "z", Type: "float", Description: "z"},
+		},
+	},
+	251: {
+		ID:          251,
+		Name:        "NAMED_VALUE_FLOAT",
+		Description: "Send a key-value pair as float. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "name", Type: "char", ArrayLength: 10, Description: "Name of the debug variable"},
+			{Name: "value", Type: "float", Description: "Floating point value"},
+		},
+	},
+	252: {
+		ID:          252,
+		Name:        "NAMED_VALUE_INT",
+		Description: "Send a key-value pair as integer. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "name", Type: "char", ArrayLength: 10, Description: "Name of the debug variable"},
+			{Name: "value", Type: "int32_t", Description: "Signed integer value"},
+		},
+	},
+	253: {
+		ID:          253,
+		Name:        "STATUSTEXT",
+		Description: "Status text message. These messages are printed in yellow in the COMM console of QGroundControl. WARNING: They consume quite some bandwidth, so use only for important status and error messages. If implemented wisely, these messages are buffered on the MCU and sent only at a limited rate (e.g. 10 Hz).",
+		Fields: []*message.FieldMetadata{
+			{Name: "severity", Type: "uint8_t", Enum: "MAV_SEVERITY", Description: "Severity of status. Relies on the definitions within RFC-5424."},
+			{Name: "text", Type: "char", ArrayLength: 50, Description: "Status text message, without null termination character. UTF-8 encoded."},
+			{Name: "id", Type: "uint16_t", Extension: true, Description: "Unique (opaque) identifier for this statustext message.  May be used to reassemble a logical long-statustext message from a sequence of chunks.  A value of zero indicates this is the only chunk in the sequence and the message can be emitted immediately."},
+			{Name: "chunk_seq", Type: "uint8_t", Extension: true, Description: "This chunk's sequence number; indexing is from zero.  Any null character in the text field is taken to mean this was the last chunk."},
+		},
+	},
+	254: {
+		ID:          254,
+		Name:        "DEBUG",
+		Description: "Send a debug value. The index is used to discriminate between values. These values show up in the plot of QGroundControl as DEBUG N.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "ind", Type: "uint8_t", Description: "index of debug variable"},
+			{Name: "value", Type: "float", Description: "DEBUG value"},
+		},
+	},
+	256: {
+		ID:          256,
+		Name:        "SETUP_SIGNING",
+		Description: "Setup a MAVLink2 signing key. If called with secret_key of all zero and zero initial_timestamp will disable signing",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "system id of the target"},
+			{Name: "target_component", Type: "uint8_t", Description: "component ID of the target"},
+			{Name: "secret_key", Type: "uint8_t", ArrayLength: 32, Description: "signing key"},
+			{Name: "initial_timestamp", Type: "uint64_t", Description: "initial timestamp"},
+		},
+	},
+	257: {
+		ID:          257,
+		Name:        "BUTTON_CHANGE",
+		Description: "Report button state change.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "last_change_ms", Type: "uint32_t", Description: "Time of last change of button state."},
+			{Name: "state", Type: "uint8_t", Description: "Bitmap for state of buttons."},
+		},
+	},
+	258: {
+		ID:          258,
+		Name:        "PLAY_TUNE",
+		Description: "Control vehicle tone generation (buzzer).",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "tune", Type: "char", ArrayLength: 30, Description: "tune in board specific format"},
+			{Name: "tune2", Type: "char", ArrayLength: 200, Extension: true, Description: "tune extension (appended to tune)"},
+		},
+	},
+	259: {
+		ID:          259,
+		Name:        "CAMERA_INFORMATION",
+		Description: "Information about a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "vendor_name", Type: "uint8_t", ArrayLength: 32, Description: "Name of the camera vendor"},
+			{Name: "model_name", Type: "uint8_t", ArrayLength: 32, Description: "Name of the camera model"},
+			{Name: "firmware_version", Type: "uint32_t", Description: "Version of the camera firmware, encoded as: `(Dev & 0xff) << 24 + (Patch & 0xff) << 16 + (Minor & 0xff) << 8 + (Major & 0xff)`. Use 0 if not known."},
+			{Name: "focal_length", Type: "float", Description: "Focal length. Use NaN if not known."},
+			{Name: "sensor_size_h", Type: "float", Description: "Image sensor size horizontal. Use NaN if not known."},
+			{Name: "sensor_size_v", Type: "float", Description: "Image sensor size vertical. Use NaN if not known."},
+			{Name: "resolution_h", Type: "uint16_t", Description: "Horizontal image resolution. Use 0 if not known."},
+			{Name: "resolution_v", Type: "uint16_t", Description: "Vertical image resolution. Use 0 if not known."},
+			{Name: "lens_id", Type: "uint8_t", Description: "Reserved for a lens ID.  Use 0 if not known."},
+			{Name: "flags", Type: "uint32_t", Enum: "CAMERA_CAP_FLAGS", Bitmask: true, Description: "Bitmap of camera capability flags."},
+			{Name: "cam_definition_version", Type: "uint16_t", Description: "Camera definition version (iteration).  Use 0 if not known."},
+			{Name: "cam_definition_uri", Type: "char", ArrayLength: 140, Description: "Camera definition URI (if any, otherwise only basic functions will be available). HTTP- (http://) and MAVLink FTP- (mavlinkftp://) formatted URIs are allowed (and both must be supported by any GCS that implements the Camera Protocol). The definition file may be xz compressed, which will be indicated by the file extension .xml.xz (a GCS that implements the protocol must support decompressing the file). The string needs to be zero terminated.  Use a zero-length string if not known."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Extension: true, Description: "Gimbal id of a gimbal associated with this camera. This is the component id of the gimbal device, or 1-6 for non mavlink gimbals. Use 0 if no gimbal is associated with the camera."},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	260: {
+		ID:          260,
+		Name:        "CAMERA_SETTINGS",
+		Description: "Settings of a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "mode_id", Type: "uint8_t", Enum: "CAMERA_MODE", Description: "Camera mode"},
+			{Name: "zoomLevel", Type: "float", Extension: true, Description: "Current zoom level as a percentage of the full range (0.0 to 100.0, NaN if not known)"},
+			{Name: "focusLevel", Type: "float", Extension: true, Description: "Current focus level as a percentage of the full range (0.0 to 100.0, NaN if not known)"},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	261: {
+		ID:          261,
+		Name:        "STORAGE_INFORMATION",
+		Description: "Information about a storage medium. This message is sent in response to a request with MAV_CMD_REQUEST_MESSAGE and whenever the status of the storage changes (STORAGE_STATUS). Use MAV_CMD_REQUEST_MESSAGE.param2 to indicate the index/id of requested storage: 0 for all, 1 for first, 2 for second, etc.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "storage_id", Type: "uint8_t", Description: "Storage ID (1 for first, 2 for second, etc.)"},
+			{Name: "storage_count", Type: "uint8_t", Description: "Number of storage devices"},
+			{Name: "status", Type: "uint8_t", Enum: "STORAGE_STATUS", Description: "Status of storage"},
+			{Name: "total_capacity", Type: "float", Description: "Total capacity. If storage is not ready (STORAGE_STATUS_READY) value will be ignored."},
+			{Name: "used_capacity", Type: "float", Description: "Used capacity. If storage is not ready (STORAGE_STATUS_READY) value will be ignored."},
+			{Name: "available_capacity", Type: "float", Description: "Available storage capacity. If storage is not ready (STORAGE_STATUS_READY) value will be ignored."},
+			{Name: "read_speed", Type: "float", Description: "Read speed."},
+			{Name: "write_speed", Type: "float", Description: "Write speed."},
+			{Name: "type", Type: "uint8_t", Extension: true, Enum: "STORAGE_TYPE", Description: "Type of storage"},
+			{Name: "name", Type: "char", ArrayLength: 32, Extension: true, Description: "Textual storage name to be used in UI (microSD 1, Internal Memory, etc.) This is a NULL terminated string. If it is exactly 32 characters long, add a terminating NULL. If this string is empty, the generic type is shown to the user."},
+			{Name: "storage_usage", Type: "uint8_t", Extension: true, Enum: "STORAGE_USAGE_FLAG", Bitmask: true, Description: "Flags indicating whether this instance is preferred storage for photos, videos, etc. Note: Implementations should initially set the flags on the system-default storage id used for saving media (if possible/supported). This setting can then be overridden using MAV_CMD_SET_STORAGE_USAGE. If the media usage flags are not set, a GCS may assume storage ID 1 is the default storage for all media types."},
+		},
+	},
+	262: {
+		ID:          262,
+		Name:        "CAMERA_CAPTURE_STATUS",
+		Description: "Information about the status of a capture. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "image_status", Type: "uint8_t", Description: "Current status of image capturing (0: idle, 1: capture in progress, 2: interval set but idle, 3: interval set and capture in progress)"},
+			{Name: "video_status", Type: "uint8_t", Description: "Current status of video capturing (0: idle, 1: capture in progress)"},
+			{Name: "image_interval", Type: "float", Description: "Image capture interval"},
+			{Name: "recording_time_ms", Type: "uint32_t", Description: "Elapsed time since recording started (0: Not supported/available). A GCS should compute recording time and use non-zero values of this field to correct any discrepancy."},
+			{Name: "available_capacity", Type: "float", Description: "Available storage capacity."},
+			{Name: "image_count", Type: "int32_t", Extension: true, Description: "Total number of images captured ('forever', or until reset using MAV_CMD_STORAGE_FORMAT)."},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	263: {
+		ID:          263,
+		Name:        "CAMERA_IMAGE_CAPTURED",
+		Description: "Information about a captured image. This is emitted every time a message is captured. MAV_CMD_REQUEST_MESSAGE can be used to (re)request this message for a specific sequence number or range of sequence numbers: MAV_CMD_REQUEST_MESSAGE.param2 indicates the sequence number the first image to send, or set to -1 to send the message for all sequence numbers. MAV_CMD_REQUEST_MESSAGE.param3 is used to specify a range of messages to send: set to 0 (default) to send just the the message for the sequence number in param 2, set to -1 to send the message for the sequence number in param 2 and all the following sequence numbers, set to the sequence number of the final message in the range.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "time_utc", Type: "uint64_t", Description: "Timestamp (time since UNIX epoch) in UTC. 0 for unknown."},
+			{Name: "camera_id", Type: "uint8_t", Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id). Field name is usually camera_device_id."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude where image was taken"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude where capture was taken"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL) where image was taken"},
+			{Name: "relative_alt", Type: "int32_t", Description: "Altitude above ground"},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion of camera orientation (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "image_index", Type: "int32_t", Description: "Zero based index of this image (i.e. a new image will have index CAMERA_CAPTURE_STATUS.image count -1)"},
+			{Name: "capture_result", Type: "int8_t", Enum: "MAV_BOOL", Description: "Image was captured successfully (MAV_BOOL_TRUE). Values not equal to 0 or 1 are invalid."},
+			{Name: "file_url", Type: "char", ArrayLength: 205, Description: "URL of image taken. Either local storage or http://foo.jpg if camera provides an HTTP interface."},
+		},
+	},
+	264: {
+		ID:          264,
+		Name:        "FLIGHT_INFORMATION",
+		Description: "Flight information. This includes time since boot for arm, takeoff, and land, and a flight number. Takeoff and landing values reset to zero on arm. This can be requested using MAV_CMD_REQUEST_MESSAGE. Note, some fields are misnamed - timestamps are from boot (not UTC) and the flight_uuid is a sequence number.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "arming_time_utc", Type: "uint64_t", Description: "Timestamp at arming (since system boot). Set to 0 on boot. Set value on arming. Note, field is misnamed UTC."},
+			{Name: "takeoff_time_utc", Type: "uint64_t", Description: "Timestamp at takeoff (since system boot). Set to 0 at boot and on arming. Note, field is misnamed UTC."},
+			{Name: "flight_uuid", Type: "uint64_t", Description: "Flight number. Note, field is misnamed UUID."},
+			{Name: "landing_time", Type: "uint32_t", Extension: true, Description: "Timestamp at landing (in ms since system boot). Set to 0 at boot and on arming."},
+		},
+	},
+	265: {
+		ID:          265,
+		Name:        "MOUNT_ORIENTATION",
+		Description: "Orientation of a mount",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "roll", Type: "float", Description: "Roll in global frame (set to NaN for invalid)."},
+			{Name: "pitch", Type: "float", Description: "Pitch in global frame (set to NaN for invalid)."},
+			{Name: "yaw", Type: "float", Description: "Yaw relative to vehicle (set to NaN for invalid)."},
+			{Name: "yaw_absolute", Type: "float", Extension: true, Description: "Yaw in absolute frame relative to Earth's North, north is 0 (set to NaN for invalid)."},
+		},
+	},
+	266: {
+		ID:          266,
+		Name:        "LOGGING_DATA",
+		Description: "A message containing logged data (see also MAV_CMD_LOGGING_START)",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "system ID of the target"},
+			{Name: "target_component", Type: "uint8_t", Description: "component ID of the target"},
+			{Name: "sequence", Type: "uint16_t", Description: "sequence number (can wrap)"},
+			{Name: "length", Type: "uint8_t", Description: "data length"},
+			{Name: "first_message_offset", Type: "uint8_t", Description: "offset into data where first message starts. This can be used for recovery, when a previous message got lost (set to UINT8_MAX if no start exists)."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 249, Description: "logged data"},
+		},
+	},
+	267: {
+		ID:          267,
+		Name:        "LOGGING_DATA_ACKED",
+		Description: "A message containing logged data which requires a LOGGING_ACK to be sent back",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "system ID of the target"},
+			{Name: "target_component", Type: "uint8_t", Description: "component ID of the target"},
+			{Name: "sequence", Type: "uint16_t", Description: "sequence number (can wrap)"},
+			{Name: "length", Type: "uint8_t", Description: "data length"},
+			{Name: "first_message_offset", Type: "uint8_t", Description: "offset into data where first message starts. This can be used for recovery, when a previous message got lost (set to UINT8_MAX if no start exists)."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 249, Description: "logged data"},
+		},
+	},
+	268: {
+		ID:          268,
+		Name:        "LOGGING_ACK",
+		Description: "An ack for a LOGGING_DATA_ACKED message",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "system ID of the target"},
+			{Name: "target_component", Type: "uint8_t", Description: "component ID of the target"},
+			{Name: "sequence", Type: "uint16_t", Description: "sequence number (must match the one in LOGGING_DATA_ACKED)"},
+		},
+	},
+	269: {
+		ID:          269,
+		Name:        "VIDEO_STREAM_INFORMATION",
+		Description: "Information about video stream. It may be requested using MAV_CMD_REQUEST_MESSAGE, where param2 indicates the video stream id: 0 for all streams, 1 for first, 2 for second, etc.",
+		Fields: []*message.FieldMetadata{
+			{Name: "stream_id", Type: "uint8_t", Description: "Video Stream ID (1 for first, 2 for second, etc.)"},
+			{Name: "count", Type: "uint8_t", Description: "Number of streams available."},
+			{Name: "type", Type: "uint8_t", Enum: "VIDEO_STREAM_TYPE", Description: "Type of stream."},
+			{Name: "flags", Type: "uint16_t", Enum: "VIDEO_STREAM_STATUS_FLAGS", Bitmask: true, Description: "Bitmap of stream status flags."},
+			{Name: "framerate", Type: "float", Description: "Frame rate."},
+			{Name: "resolution_h", Type: "uint16_t", Description: "Horizontal resolution."},
+			{Name: "resolution_v", Type: "uint16_t", Description: "Vertical resolution."},
+			{Name: "bitrate", Type: "uint32_t", Description: "Bit rate."},
+			{Name: "rotation", Type: "uint16_t", Description: "Video image rotation clockwise."},
+			{Name: "hfov", Type: "uint16_t", Description: "Horizontal Field of view."},
+			{Name: "name", Type: "char", ArrayLength: 32, Description: "Stream name."},
+			{Name: "uri", Type: "char", ArrayLength: 160, Description: "Video stream URI (TCP or RTSP URI ground station should connect to) or port number (UDP port ground station should listen to)."},
+			{Name: "encoding", Type: "uint8_t", Extension: true, Enum: "VIDEO_STREAM_ENCODING", Description: "Encoding of stream."},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	270: {
+		ID:          270,
+		Name:        "VIDEO_STREAM_STATUS",
+		Description: "Information about the status of a video stream. It may be requested using MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "stream_id", Type: "uint8_t", Description: "Video Stream ID (1 for first, 2 for second, etc.)"},
+			{Name: "flags", Type: "uint16_t", Enum: "VIDEO_STREAM_STATUS_FLAGS", Bitmask: true, Description: "Bitmap of stream status flags"},
+			{Name: "framerate", Type: "float", Description: "Frame rate"},
+			{Name: "resolution_h", Type: "uint16_t", Description: "Horizontal resolution"},
+			{Name: "resolution_v", Type: "uint16_t", Description: "Vertical resolution"},
+			{Name: "bitrate", Type: "uint32_t", Description: "Bit rate"},
+			{Name: "rotation", Type: "uint16_t", Description: "Video image rotation clockwise"},
+			{Name: "hfov", Type: "uint16_t", Description: "Horizontal Field of view"},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	271: {
+		ID:          271,
+		Name:        "CAMERA_FOV_STATUS",
+		Description: "Information about the field of view of a camera. Can be requested with a MAV_CMD_REQUEST_MESSAGE command.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "lat_camera", Type: "int32_t", Description: "Latitude of camera (INT32_MAX if unknown)."},
+			{Name: "lon_camera", Type: "int32_t", Description: "Longitude of camera (INT32_MAX if unknown)."},
+			{Name: "alt_camera", Type: "int32_t", Description: "Altitude (MSL) of camera (INT32_MAX if unknown)."},
+			{Name: "lat_image", Type: "int32_t", Description: "Latitude of center of image (INT32_MAX if unknown, INT32_MIN if at infinity, not intersecting with horizon)."},
+			{Name: "lon_image", Type: "int32_t", Description: "Longitude of center of image (INT32_MAX if unknown, INT32_MIN if at infinity, not intersecting with horizon)."},
+			{Name: "alt_image", Type: "int32_t", Description: "Altitude (MSL) of center of image (INT32_MAX if unknown, INT32_MIN if at infinity, not intersecting with horizon)."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion of camera orientation (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "hfov", Type: "float", Description: "Horizontal field of view (NaN if unknown)."},
+			{Name: "vfov", Type: "float", Description: "Vertical field of view (NaN if unknown)."},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	275: {
+		ID:          275,
+		Name:        "CAMERA_TRACKING_IMAGE_STATUS",
+		Description: "Camera tracking status, sent while in active tracking. Use MAV_CMD_SET_MESSAGE_INTERVAL to define message interval.",
+		Fields: []*message.FieldMetadata{
+			{Name: "tracking_status", Type: "uint8_t", Enum: "CAMERA_TRACKING_STATUS_FLAGS", Bitmask: true, Description: "Current tracking status"},
+			{Name: "tracking_mode", Type: "uint8_t", Enum: "CAMERA_TRACKING_MODE", Description: "Current tracking mode"},
+			{Name: "target_data", Type: "uint8_t", Enum: "CAMERA_TRACKING_TARGET_DATA", Bitmask: true, Description: "Defines location of target data"},
+			{Name: "point_x", Type: "float", Description: "Current tracked point x value if CAMERA_TRACKING_MODE_POINT (normalized 0..1, 0 is left, 1 is right), NAN if unknown"},
+			{Name: "point_y", Type: "float", Description: "Current tracked point y value if CAMERA_TRACKING_MODE_POINT (normalized 0..1, 0 is top, 1 is bottom), NAN if unknown"},
+			{Name: "radius", Type: "float", Description: "Current tracked radius if CAMERA_TRACKING_MODE_POINT (normalized 0..1, 0 is image left, 1 is image right), NAN if unknown"},
+			{Name: "rec_top_x", Type: "float", Description: "Current tracked rectangle top x value if CAMERA_TRACKING_MODE_RECTANGLE (normalized 0..1, 0 is left, 1 is right), NAN if unknown"},
+			{Name: "rec_top_y", Type: "float", Description: "Current tracked rectangle top y value if CAMERA_TRACKING_MODE_RECTANGLE (normalized 0..1, 0 is top, 1 is bottom), NAN if unknown"},
+			{Name: "rec_bottom_x", Type: "float", Description: "Current tracked rectangle bottom x value if CAMERA_TRACKING_MODE_RECTANGLE (normalized 0..1, 0 is left, 1 is right), NAN if unknown"},
+			{Name: "rec_bottom_y", Type: "float", Description: "Current tracked rectangle bottom y value if CAMERA_TRACKING_MODE_RECTANGLE (normalized 0..1, 0 is top, 1 is bottom), NAN if unknown"},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	276: {
+		ID:          276,
+		Name:        "CAMERA_TRACKING_GEO_STATUS",
+		Description: "Camera tracking status, sent while in active tracking. Use MAV_CMD_SET_MESSAGE_INTERVAL to define message interval.",
+		Fields: []*message.FieldMetadata{
+			{Name: "tracking_status", Type: "uint8_t", Enum: "CAMERA_TRACKING_STATUS_FLAGS", Bitmask: true, Description: "Current tracking status"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude of tracked object"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude of tracked object"},
+			{Name: "alt", Type: "float", Description: "Altitude of tracked object(AMSL, WGS84)"},
+			{Name: "h_acc", Type: "float", Description: "Horizontal accuracy. NAN if unknown"},
+			{Name: "v_acc", Type: "float", Description: "Vertical accuracy. NAN if unknown"},
+			{Name: "vel_n", Type: "float", Description: "North velocity of tracked object. NAN if unknown"},
+			{Name: "vel_e", Type: "float", Description: "East velocity of tracked object. NAN if unknown"},
+			{Name: "vel_d", Type: "float", Description: "Down velocity of tracked object. NAN if unknown"},
+			{Name: "vel_acc", Type: "float", Description: "Velocity accuracy. NAN if unknown"},
+			{Name: "dist", Type: "float", Description: "Distance between camera and tracked object. NAN if unknown"},
+			{Name: "hdg", Type: "float", Description: "Heading in radians, in NED. NAN if unknown"},
+			{Name: "hdg_acc", Type: "float", Description: "Accuracy of heading, in NED. NAN if unknown"},
+			{Name: "camera_device_id", Type: "uint8_t", Extension: true, Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+		},
+	},
+	277: {
+		ID:          277,
+		Name:        "CAMERA_THERMAL_RANGE",
+		Description: "Camera absolute thermal range. This can be streamed when the associated VIDEO_STREAM_STATUS `flag` field bit VIDEO_STREAM_STATUS_FLAGS_THERMAL_RANGE_ENABLED is set, but a GCS may choose to only request it for the current active stream. Use MAV_CMD_SET_MESSAGE_INTERVAL to define message interval (param3 indicates the stream id of the current camera, or 0 for all streams, param4 indicates the target camera_device_id for autopilot-attached cameras or 0 for MAVLink cameras).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "stream_id", Type: "uint8_t", Description: "Video Stream ID (1 for first, 2 for second, etc.)"},
+			{Name: "camera_device_id", Type: "uint8_t", Description: "Camera id of a non-MAVLink camera attached to an autopilot (1-6).  0 if the component is a MAVLink camera (with its own component id)."},
+			{Name: "max", Type: "float", Description: "Temperature max."},
+			{Name: "max_point_x", Type: "float", Description: "Temperature max point x value (normalized 0..1, 0 is left, 1 is right), NAN if unknown."},
+			{Name: "max_point_y", Type: "float", Description: "Temperature max point y value (normalized 0..1, 0 is top, 1 is bottom), NAN if unknown."},
+			{Name: "min", Type: "float", Description: "Temperature min."},
+			{Name: "min_point_x", Type: "float", Description: "Temperature min point x value (normalized 0..1, 0 is left, 1 is right), NAN if unknown."},
+			{Name: "min_point_y", Type: "float", Description: "Temperature min point y value (normalized 0..1, 0 is top, 1 is bottom), NAN if unknown."},
+		},
+	},
+	280: {
+		ID:          280,
+		Name:        "GIMBAL_MANAGER_INFORMATION",
+		Description: "Information about a high level gimbal manager. This message should be requested by a ground station using MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "cap_flags", Type: "uint32_t", Enum: "GIMBAL_MANAGER_CAP_FLAGS", Bitmask: true, Description: "Bitmap of gimbal capability flags."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Description: "Gimbal device ID that this gimbal manager is responsible for. Component ID of gimbal device (or 1-6 for non-MAVLink gimbal)."},
+			{Name: "roll_min", Type: "float", Description: "Minimum hardware roll angle (positive: rolling to the right, negative: rolling to the left)"},
+			{Name: "roll_max", Type: "float", Description: "Maximum hardware roll angle (positive: rolling to the right, negative: rolling to the left)"},
+			{Name: "pitch_min", Type: "float", Description: "Minimum pitch angle (positive: up, negative: down)"},
+			{Name: "pitch_max", Type: "float", Description: "Maximum pitch angle (positive: up, negative: down)"},
+			{Name: "yaw_min", Type: "float", Description: "Minimum yaw angle (positive: to the right, negative: to the left)"},
+			{Name: "yaw_max", Type: "float", Description: "Maximum yaw angle (positive: to the right, negative: to the left)"},
+		},
+	},
+	281: {
+		ID:          281,
+		Name:        "GIMBAL_MANAGER_STATUS",
+		Description: "Current status about a high level gimbal manager. This message should be broadcast at a low regular rate (e.g. 5Hz).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "flags", Type: "uint32_t", Enum: "GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "High level gimbal manager flags currently applied."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Description: "Gimbal device ID that this gimbal manager is responsible for. Component ID of gimbal device (or 1-6 for non-MAVLink gimbal)."},
+			{Name: "primary_control_sysid", Type: "uint8_t", Description: "System ID of MAVLink component with primary control, 0 for none."},
+			{Name: "primary_control_compid", Type: "uint8_t", Description: "Component ID of MAVLink component with primary control, 0 for none."},
+			{Name: "secondary_control_sysid", Type: "uint8_t", Description: "System ID of MAVLink component with secondary control, 0 for none."},
+			{Name: "secondary_control_compid", Type: "uint8_t", Description: "Component ID of MAVLink component with secondary control, 0 for none."},
+		},
+	},
+	282: {
+		ID:          282,
+		Name:        "GIMBAL_MANAGER_SET_ATTITUDE",
+		Description: "High level message to control a gimbal's attitude. This message is to be sent to the gimbal manager (e.g. from a ground station). Angles and rates can be set to NaN according to use case.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "flags", Type: "uint32_t", Enum: "GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "High level gimbal manager flags to use."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Description: "Component ID of gimbal device to address (or 1-6 for non-MAVLink gimbal), 0 for all gimbal device components. Send command multiple times for more than one gimbal (but not all gimbals)."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation, the frame is depends on whether the flag GIMBAL_MANAGER_FLAGS_YAW_LOCK is set)"},
+			{Name: "angular_velocity_x", Type: "float", Description: "X component of angular velocity, positive is rolling to the right, NaN to be ignored."},
+			{Name: "angular_velocity_y", Type: "float", Description: "Y component of angular velocity, positive is pitching up, NaN to be ignored."},
+			{Name: "angular_velocity_z", Type: "float", Description: "Z component of angular velocity, positive is yawing to the right, NaN to be ignored."},
+		},
+	},
+	283: {
+		ID:          283,
+		Name:        "GIMBAL_DEVICE_INFORMATION",
+		Description: "Information about a low level gimbal. This message should be requested by the gimbal manager or a ground station using MAV_CMD_REQUEST_MESSAGE. The maximum angles and rates are the limits by hardware. However, the limits by software used are likely different/smaller and dependent on mode/settings/etc..",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "vendor_name", Type: "char", ArrayLength: 32, Description: "Name of the gimbal vendor."},
+			{Name: "model_name", Type: "char", ArrayLength: 32, Description: "Name of the gimbal model."},
+			{Name: "custom_name", Type: "char", ArrayLength: 32, Description: "Custom name of the gimbal given to it by the user."},
+			{Name: "firmware_version", Type: "uint32_t", Description: "Version of the gimbal firmware, encoded as: `(Dev & 0xff) << 24 + (Patch & 0xff) << 16 + (Minor & 0xff) << 8 + (Major & 0xff)`."},
+			{Name: "hardware_version", Type: "uint32_t", Description: "Version of the gimbal hardware, encoded as: `(Dev & 0xff) << 24 + (Patch & 0xff) << 16 + (Minor & 0xff) << 8 + (Major & 0xff)`."},
+			{Name: "uid", Type: "uint64_t", Description: "UID of gimbal hardware (0 if unknown)."},
+			{Name: "cap_flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_CAP_FLAGS", Bitmask: true, Description: "Bitmap of gimbal capability flags."},
+			{Name: "custom_cap_flags", Type: "uint16_t", Description: "Bitmap for use for gimbal-specific capability flags."},
+			{Name: "roll_min", Type: "float", Description: "Minimum hardware roll angle (positive: rolling to the right, negative: rolling to the left). NAN if unknown."},
+			{Name: "roll_max", Type: "float", Description: "Maximum hardware roll angle (positive: rolling to the right, negative: rolling to the left). NAN if unknown."},
+			{Name: "pitch_min", Type: "float", Description: "Minimum hardware pitch angle (positive: up, negative: down). NAN if unknown."},
+			{Name: "pitch_max", Type: "float", Description: "Maximum hardware pitch angle (positive: up, negative: down). NAN if unknown."},
+			{Name: "yaw_min", Type: "float", Description: "Minimum hardware yaw angle (positive: to the right, negative: to the left). NAN if unknown."},
+			{Name: "yaw_max", Type: "float", Description: "Maximum hardware yaw angle (positive: to the right, negative: to the left). NAN if unknown."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Extension: true, Description: "This field is to be used if the gimbal manager and the gimbal device are the same component and hence have the same component ID. This field is then set to a number between 1-6. If the component ID is separate, this field is not required and must be set to 0."},
+			{Name: "cap_flags2", Type: "uint32_t", Extension: true, Enum: "GIMBAL_DEVICE_CAP_FLAGS", Bitmask: true, Description: "Extended bitmap of gimbal capability flags (32 bit). For backwards compatibility, the lower 16 bits should also be set in cap_flags. Ground stations should prefer this field if non-zero."},
+		},
+	},
+	284: {
+		ID:          284,
+		Name:        "GIMBAL_DEVICE_SET_ATTITUDE",
+		Description: "Low level message to control a gimbal device's attitude. This message is to be sent from the gimbal manager to the gimbal device component. The quaternion and angular velocities can be set to NaN according to use case. For the angles encoded in the quaternion and the angular velocities holds: If the flag GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME is set, then they are relative to the vehicle heading (vehicle frame). If the flag GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME is set, then they are relative to absolute North (earth frame). If neither of these flags are set, then (for backwards compatibility) it holds: If the flag GIMBAL_DEVICE_FLAGS_YAW_LOCK is set, then they are relative to absolute North (earth frame), else they are relative to the vehicle heading (vehicle frame). Setting both GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME and GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME is not allowed. These rules are to ensure backwards compatibility. New implementations should always set either GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME or GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_FLAGS", Bitmask: true, Description: "Low level gimbal flags."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation). The frame is described in the message description. Set fields to NaN to be ignored."},
+			{Name: "angular_velocity_x", Type: "float", Description: "X component of angular velocity (positive: rolling to the right). The frame is described in the message description. NaN to be ignored."},
+			{Name: "angular_velocity_y", Type: "float", Description: "Y component of angular velocity (positive: pitching up). The frame is described in the message description. NaN to be ignored."},
+			{Name: "angular_velocity_z", Type: "float", Description: "Z component of angular velocity (positive: yawing to the right). The frame is described in the message description. NaN to be ignored."},
+		},
+	},
+	285: {
+		ID:          285,
+		Name:        "GIMBAL_DEVICE_ATTITUDE_STATUS",
+		Description: "Message reporting the status of a gimbal device. This message should be broadcast by a gimbal device component at a low regular rate (e.g. 5 Hz). For the angles encoded in the quaternion and the angular velocities holds: If the flag GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME is set, then they are relative to the vehicle heading (vehicle frame). If the flag GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME is set, then they are relative to absolute North (earth frame). If neither of these flags are set, then (for backwards compatibility) it holds: If the flag GIMBAL_DEVICE_FLAGS_YAW_LOCK is set, then they are relative to absolute North (earth frame), else they are relative to the vehicle heading (vehicle frame). Other conditions of the flags are not allowed. The quaternion and angular velocities in the other frame can be calculated from delta_yaw and delta_yaw_velocity as q_earth = q_delta_yaw * q_vehicle and w_earth = w_delta_yaw_velocity + w_vehicle (if not NaN). If neither the GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME nor the GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME flag is set, then (for backwards compatibility) the data in the delta_yaw and delta_yaw_velocity fields are to be ignored. New implementations should always set either GIMBAL_DEVICE_FLAGS_YAW_IN_VEHICLE_FRAME or GIMBAL_DEVICE_FLAGS_YAW_IN_EARTH_FRAME, and always should set delta_yaw and delta_yaw_velocity either to the proper value or NaN.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_FLAGS", Bitmask: true, Description: "Current gimbal flags set."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation). The frame is described in the message description."},
+			{Name: "angular_velocity_x", Type: "float", Description: "X component of angular velocity (positive: rolling to the right). The frame is described in the message description. NaN if unknown."},
+			{Name: "angular_velocity_y", Type: "float", Description: "Y component of angular velocity (positive: pitching up). The frame is described in the message description. NaN if unknown."},
+			{Name: "angular_velocity_z", Type: "float", Description: "Z component of angular velocity (positive: yawing to the right). The frame is described in the message description. NaN if unknown."},
+			{Name: "failure_flags", Type: "uint32_t", Enum: "GIMBAL_DEVICE_ERROR_FLAGS", Bitmask: true, Description: "Failure flags (0 for no failure)"},
+			{Name: "delta_yaw", Type: "float", Extension: true, Description: "Yaw angle relating the quaternions in earth and body frames (see message description). NaN if unknown."},
+			{Name: "delta_yaw_velocity", Type: "float", Extension: true, Description: "Yaw angular velocity relating the angular velocities in earth and body frames (see message description). NaN if unknown."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Extension: true, Description: "This field is to be used if the gimbal manager and the gimbal device are the same component and hence have the same component ID. This field is then set a number between 1-6. If the component ID is separate, this field is not required and must be set to 0."},
+		},
+	},
+	286: {
+		ID:          286,
+		Name:        "AUTOPILOT_STATE_FOR_GIMBAL_DEVICE",
+		Description: "Low level message containing autopilot state relevant for a gimbal device. This message is to be sent from the autopilot to the gimbal device component. The data of this message are for the gimbal device's estimator corrections, in particular horizon compensation, as well as indicates autopilot control intentions, e.g. feed forward angular control in the z-axis.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "time_boot_us", Type: "uint64_t", Description: "Timestamp (time since system boot)."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components of autopilot attitude: w, x, y, z (1 0 0 0 is the null-rotation, Hamilton convention)."},
+			{Name: "q_estimated_delay_us", Type: "uint32_t", Description: "Estimated delay of the attitude data. 0 if unknown."},
+			{Name: "vx", Type: "float", Description: "X Speed in NED (North, East, Down). NAN if unknown."},
+			{Name: "vy", Type: "float", Description: "Y Speed in NED (North, East, Down). NAN if unknown."},
+			{Name: "vz", Type: "float", Description: "Z Speed in NED (North, East, Down). NAN if unknown."},
+			{Name: "v_estimated_delay_us", Type: "uint32_t", Description: "Estimated delay of the speed data. 0 if unknown."},
+			{Name: "feed_forward_angular_velocity_z", Type: "float", Description: "Feed forward Z component of angular velocity (positive: yawing to the right). NaN to be ignored. This is to indicate if the autopilot is actively yawing."},
+			{Name: "estimator_status", Type: "uint16_t", Enum: "ESTIMATOR_STATUS_FLAGS", Bitmask: true, Description: "Bitmap indicating which estimator outputs are valid."},
+			{Name: "landed_state", Type: "uint8_t", Enum: "MAV_LANDED_STATE", Description: "The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown."},
+			{Name: "angular_velocity_z", Type: "float", Extension: true, Description: "Z component of angular velocity in NED (North, East, Down). 0 if unknown. Use 0.00001 to represent a measured value of zero."},
+		},
+	},
+	287: {
+		ID:          287,
+		Name:        "GIMBAL_MANAGER_SET_PITCHYAW",
+		Description: "Set gimbal manager pitch and yaw angles (high rate message). This message is to be sent to the gimbal manager (e.g. from a ground station) and will be ignored by gimbal devices. Angles and rates can be set to NaN according to use case. Use MAV_CMD_DO_GIMBAL_MANAGER_PITCHYAW for low-rate adjustments that require confirmation.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "flags", Type: "uint32_t", Enum: "GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "High level gimbal manager flags to use."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Description: "Component ID of gimbal device to address (or 1-6 for non-MAVLink gimbal), 0 for all gimbal device components. Send command multiple times for more than one gimbal (but not all gimbals)."},
+			{Name: "pitch", Type: "float", Description: "Pitch angle (positive: up, negative: down, NaN to be ignored)."},
+			{Name: "yaw", Type: "float", Description: "Yaw angle (positive: to the right, negative: to the left, NaN to be ignored)."},
+			{Name: "pitch_rate", Type: "float", Description: "Pitch angular rate (positive: up, negative: down, NaN to be ignored)."},
+			{Name: "yaw_rate", Type: "float", Description: "Yaw angular rate (positive: to the right, negative: to the left, NaN to be ignored)."},
+		},
+	},
+	288: {
+		ID:          288,
+		Name:        "GIMBAL_MANAGER_SET_MANUAL_CONTROL",
+		Description: "High level message to control a gimbal manually. The angles or angular rates are unitless; the actual rates will depend on internal gimbal manager settings/configuration (e.g. set by parameters). This message is to be sent to the gimbal manager (e.g. from a ground station). Angles and rates can be set to NaN according to use case.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "flags", Type: "uint32_t", Enum: "GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "High level gimbal manager flags."},
+			{Name: "gimbal_device_id", Type: "uint8_t", Description: "Component ID of gimbal device to address (or 1-6 for non-MAVLink gimbal), 0 for all gimbal device components. Send command multiple times for more than one gimbal (but not all gimbals)."},
+			{Name: "pitch", Type: "float", Description: "Pitch angle unitless (-1..1, positive: up, negative: down, NaN to be ignored)."},
+			{Name: "yaw", Type: "float", Description: "Yaw angle unitless (-1..1, positive: to the right, negative: to the left, NaN to be ignored)."},
+			{Name: "pitch_rate", Type: "float", Description: "Pitch angular rate unitless (-1..1, positive: up, negative: down, NaN to be ignored)."},
+			{Name: "yaw_rate", Type: "float", Description: "Yaw angular rate unitless (-1..1, positive: to the right, negative: to the left, NaN to be ignored)."},
+		},
+	},
+	290: {
+		ID:          290,
+		Name:        "ESC_INFO",
+		Description: "ESC information for lower rate streaming. Recommended streaming rate 1Hz. See ESC_STATUS for higher-rate ESC data.",
+		Fields: []*message.FieldMetadata{
+			{Name: "index", Type: "uint8_t", Description: "Index of the first ESC in this message (ESC are indexed in motor order). minValue = 0, maxValue = 60, increment = 4."},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude the number."},
+			{Name: "counter", Type: "uint16_t", Description: "Counter of data packets received."},
+			{Name: "count", Type: "uint8_t", Description: "Total number of ESCs in all messages of this type. Message fields with an index higher than this should be ignored because they contain invalid data."},
+			{Name: "connection_type", Type: "uint8_t", Enum: "ESC_CONNECTION_TYPE", Description: "Connection type protocol for all ESC."},
+			{Name: "info", Type: "uint8_t", Description: "Information regarding online/offline status of each ESC."},
+			{Name: "failure_flags", Type: "uint16_t", ArrayLength: 4, Enum: "ESC_FAILURE_FLAGS", Bitmask: true, Description: "Bitmap of ESC failure flags."},
+			{Name: "error_count", Type: "uint32_t", ArrayLength: 4, Description: "Number of reported errors by each ESC since boot."},
+			{Name: "temperature", Type: "int16_t", ArrayLength: 4, Description: "Temperature of each ESC. INT16_MAX: if data not supplied by ESC."},
+		},
+	},
+	291: {
+		ID:          291,
+		Name:        "ESC_STATUS",
+		Description: "ESC information for higher rate streaming. Recommended streaming rate is ~10 Hz. Information that changes more slowly is sent in ESC_INFO. It should typically only be streamed on high-bandwidth links (i.e. to a companion computer).",
+		Fields: []*message.FieldMetadata{
+			{Name: "index", Type: "uint8_t", Description: "Index of the first ESC in this message (ESC are indexed in motor order). minValue = 0, maxValue = 60, increment = 4."},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude the number."},
+			{Name: "rpm", Type: "int32_t", ArrayLength: 4, Description: "Reported motor RPM from each ESC (negative for reverse rotation)."},
+			{Name: "voltage", Type: "float", ArrayLength: 4, Description: "Voltage measured from each ESC."},
+			{Name: "current", Type: "float", ArrayLength: 4, Description: "Current measured from each ESC."},
+		},
+	},
+	295: {
+		ID:          295,
+		Name:        "AIRSPEED",
+		Description: "Airspeed information from a sensor.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Sensor ID."},
+			{Name: "airspeed", Type: "float", Description: "Calibrated airspeed (CAS)."},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature."},
+			{Name: "raw_press", Type: "float", Description: "Raw differential pressure."},
+			{Name: "flags", Type: "uint8_t", Enum: "AIRSPEED_SENSOR_FLAGS", Bitmask: true, Description: "Airspeed sensor flags."},
+		},
+	},
+	296: {
+		ID:          296,
+		Name:        "GLOBAL_POSITION_SENSOR",
+		Description: "Reports measurement/estimate from a global position sensor. Used as navigation fusion source and optionally displayed in the UI.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (ID of target system, normally autopilot and ground station)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (normally 0 for broadcast)."},
+			{Name: "id", Type: "uint8_t", Description: "Sensor ID"},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp of message transmission (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "processing_time", Type: "uint32_t", Description: "The time spent in processing the sensor data that is the basis for this position. The recipient can use this to improve time alignment of the data. This is the time between measurement (e.g. camera exposure time) and transmission of this message. Set to NaN if not known."},
+			{Name: "source", Type: "uint8_t", Enum: "GLOBAL_POSITION_SRC", Description: "Source of position/estimate (such as GNSS, estimator, etc.)"},
+			{Name: "flags", Type: "uint8_t", Enum: "GLOBAL_POSITION_FLAGS", Bitmask: true, Description: "Status flags"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt_ellipsoid", Type: "float", Description: "Altitude (WGS84 elipsoid), preferred if available"},
+			{Name: "alt", Type: "float", Description: "Altitude (MSL - position-system specific value) use if no alt_ellipsoid available"},
+			{Name: "eph", Type: "float", Description: "Standard deviation of horizontal position error"},
+			{Name: "epv", Type: "float", Description: "Standard deviation of vertical position error"},
+		},
+	},
+	299: {
+		ID:          299,
+		Name:        "WIFI_CONFIG_AP",
+		Description: "Configure WiFi AP SSID, password, and mode. This message is re-emitted as an acknowledgement by the AP. The message may also be explicitly requested using MAV_CMD_REQUEST_MESSAGE",
+		Fields: []*message.FieldMetadata{
+			{Name: "ssid", Type: "char", ArrayLength: 32, Description: "Name of Wi-Fi network (SSID). Blank to leave it unchanged when setting. Current SSID when sent back as a response."},
+			{Name: "password", Type: "char", ArrayLength: 64, Description: "Password. Blank for an open AP. MD5 hash when message is sent back as a response."},
+			{Name: "mode", Type: "int8_t", Extension: true, Enum: "WIFI_CONFIG_AP_MODE", Description: "WiFi Mode."},
+			{Name: "response", Type: "int8_t", Extension: true, Enum: "WIFI_CONFIG_AP_RESPONSE", Description: "Message acceptance response (sent back to GS)."},
+		},
+	},
+	300: {
+		ID:          300,
+		Name:        "PROTOCOL_VERSION",
+		Description: "Version and capability of protocol version. This message can be requested with MAV_CMD_REQUEST_MESSAGE and is used as part of the handshaking to establish which MAVLink version should be used on the network. Every node should respond to a request for PROTOCOL_VERSION to enable the handshaking. Library implementers should consider adding this into the default decoding state machine to allow the protocol core to respond directly.",
+		Fields: []*message.FieldMetadata{
+			{Name: "version", Type: "uint16_t", Description: "Currently active MAVLink version number * 100: v1.0 is 100, v2.0 is 200, etc."},
+			{Name: "min_version", Type: "uint16_t", Description: "Minimum MAVLink version supported"},
+			{Name: "max_version", Type: "uint16_t", Description: "Maximum MAVLink version supported (set to the same value as version by default)"},
+			{Name: "spec_version_hash", Type: "uint8_t", ArrayLength: 8, Description: "The first 8 bytes (not characters printed in hex!) of the git hash."},
+			{Name: "library_version_hash", Type: "uint8_t", ArrayLength: 8, Description: "The first 8 bytes (not characters printed in hex!) of the git hash."},
+		},
+	},
+	301: {
+		ID:          301,
+		Name:        "AIS_VESSEL",
+		Description: "The location and information of an AIS vessel",
+		Fields: []*message.FieldMetadata{
+			{Name: "MMSI", Type: "uint32_t", Description: "Mobile Marine Service Identifier, 9 decimal digits"},
+			{Name: "lat", Type: "int32_t", Description: "Latitude"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude"},
+			{Name: "COG", Type: "uint16_t", Description: "Course over ground"},
+			{Name: "heading", Type: "uint16_t", Description: "True heading"},
+			{Name: "velocity", Type: "uint16_t", Description: "Speed over ground"},
+			{Name: "turn_rate", Type: "int8_t", Description: "Turn rate, 0.1 degrees per second"},
+			{Name: "navigational_status", Type: "uint8_t", Enum: "AIS_NAV_STATUS", Description: "Navigational status"},
+			{Name: "type", Type: "uint8_t", Enum: "AIS_TYPE", Description: "Type of vessels"},
+			{Name: "dimension_bow", Type: "uint16_t", Description: "Distance from lat/lon location to bow"},
+			{Name: "dimension_stern", Type: "uint16_t", Description: "Distance from lat/lon location to stern"},
+			{Name: "dimension_port", Type: "uint8_t", Description: "Distance from lat/lon location to port side"},
+			{Name: "dimension_starboard", Type: "uint8_t", Description: "Distance from lat/lon location to starboard side"},
+			{Name: "callsign", Type: "char", ArrayLength: 7, Description: "The vessel callsign. Characters are encoded as 7-bit ASCII, but only characters in the [AIS 6-bit ASCII subset](https://en.wikipedia.org/wiki/Six-bit_character_code#AIS_SixBit_ASCII) are permitted. Also set AIS_FLAGS_VALID_CALLSIGN if valid. The string is NULL-terminated if it is shorter than the array length."},
+			{Name: "name", Type: "char", ArrayLength: 20, Description: "The vessel name. Characters are encoded as 7-bit ASCII, but only characters in the [AIS 6-bit ASCII subset](https://en.wikipedia.org/wiki/Six-bit_character_code#AIS_SixBit_ASCII) are permitted. Also set AIS_FLAGS_VALID_NAME if valid. The string is NULL-terminated if it is shorter than the array length."},
+			{Name: "tslc", Type: "uint16_t", Description: "Time since last communication. This is the age of the AIS information in this message, in seconds."},
+			{Name: "flags", Type: "uint16_t", Enum: "AIS_FLAGS", Bitmask: true, Description: "Bitmask to indicate various statuses including valid data fields"},
+		},
+	},
+	310: {
+		ID:          310,
+		Name:        "UAVCAN_NODE_STATUS",
+		Description: "General status information of an UAVCAN node. Please refer to the definition of the UAVCAN message \"uavcan.protocol.NodeStatus\" for the background information. The UAVCAN specification is available at http://uavcan.org.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "uptime_sec", Type: "uint32_t", Description: "Time since the start-up of the node."},
+			{Name: "health", Type: "uint8_t", Enum: "UAVCAN_NODE_HEALTH", Description: "Generalized node health status."},
+			{Name: "mode", Type: "uint8_t", Enum: "UAVCAN_NODE_MODE", Description: "Generalized operating mode."},
+			{Name: "sub_mode", Type: "uint8_t", Description: "Not used currently."},
+			{Name: "vendor_specific_status_code", Type: "uint16_t", Description: "Vendor-specific status information."},
+		},
+	},
+	311: {
+		ID:          311,
+		Name:        "UAVCAN_NODE_INFO",
+		Description: "General information describing a particular UAVCAN node. Please refer to the definition of the UAVCAN service \"uavcan.protocol.GetNodeInfo\" for the background information. This message should be emitted by the system whenever a new node appears online, or an existing node reboots. The message may also be explicitly requested using MAV_CMD_REQUEST_MESSAGE. It is also not prohibited to emit this message unconditionally at a low frequency. The DroneCAN specification is available at https://dronecan.github.io/Specification/1._Introduction/.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "uptime_sec", Type: "uint32_t", Description: "Time since the start-up of the node."},
+			{Name: "name", Type: "char", ArrayLength: 80, Description: "Node name string. For example, \"sapog.px4.io\"."},
+			{Name: "hw_version_major", Type: "uint8_t", Description: "Hardware major version number."},
+			{Name: "hw_version_minor", Type: "uint8_t", Description: "Hardware minor version number."},
+			{Name: "hw_unique_id", Type: "uint8_t", ArrayLength: 16, Description: "Hardware unique 128-bit ID."},
+			{Name: "sw_version_major", Type: "uint8_t", Description: "Software major version number."},
+			{Name: "sw_version_minor", Type: "uint8_t", Description: "Software minor version number."},
+			{Name: "sw_vcs_commit", Type: "uint32_t", Description: "Version control system (VCS) revision identifier (e.g. git short commit hash). 0 if unknown."},
+		},
+	},
+	320: {
+		ID:          320,
+		Name:        "PARAM_EXT_REQUEST_READ",
+		Description: "Request to read the value of a parameter with either the param_id string id or param_index. PARAM_EXT_VALUE should be emitted in response.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_index", Type: "int16_t", Description: "Parameter index. Set to -1 to use the Parameter ID field as identifier (else param_id will be ignored)"},
+		},
+	},
+	321: {
+		ID:          321,
+		Name:        "PARAM_EXT_REQUEST_LIST",
+		Description: "Request all parameters of this component. All parameters should be emitted in response as PARAM_EXT_VALUE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+		},
+	},
+	322: {
+		ID:          322,
+		Name:        "PARAM_EXT_VALUE",
+		Description: "Emit the value of a parameter. The inclusion of param_count and param_index in the message allows the recipient to keep track of received parameters and allows them to re-request missing parameters after a loss or timeout.",
+		Fields: []*message.FieldMetadata{
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_value", Type: "char", ArrayLength: 128, Description: "Parameter value"},
+			{Name: "param_type", Type: "uint8_t", Enum: "MAV_PARAM_EXT_TYPE", Description: "Parameter type."},
+			{Name: "param_count", Type: "uint16_t", Description: "Total number of parameters"},
+			{Name: "param_index", Type: "uint16_t", Description: "Index of this parameter"},
+		},
+	},
+	323: {
+		ID:          323,
+		Name:        "PARAM_EXT_SET",
+		Description: "Set a parameter value. In order to deal with message loss (and retransmission of PARAM_EXT_SET), when setting a parameter value and the new value is the same as the current value, you will immediately get a PARAM_ACK_ACCEPTED response. If the current state is PARAM_ACK_IN_PROGRESS, you will accordingly receive a PARAM_ACK_IN_PROGRESS in response.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_value", Type: "char", ArrayLength: 128, Description: "Parameter value"},
+			{Name: "param_type", Type: "uint8_t", Enum: "MAV_PARAM_EXT_TYPE", Description: "Parameter type."},
+		},
+	},
+	324: {
+		ID:          324,
+		Name:        "PARAM_EXT_ACK",
+		Description: "Response from a PARAM_EXT_SET message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_value", Type: "char", ArrayLength: 128, Description: "Parameter value (new value if PARAM_ACK_ACCEPTED, current value otherwise)"},
+			{Name: "param_type", Type: "uint8_t", Enum: "MAV_PARAM_EXT_TYPE", Description: "Parameter type."},
+			{Name: "param_result", Type: "uint8_t", Enum: "PARAM_ACK", Description: "Result code."},
+		},
+	},
+	330: {
+		ID:          330,
+		Name:        "OBSTACLE_DISTANCE",
+		Description: "Obstacle distances in front of the sensor, starting from the left in increment degrees to the right",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "sensor_type", Type: "uint8_t", Enum: "MAV_DISTANCE_SENSOR", Description: "Class id of the distance sensor type."},
+			{Name: "distances", Type: "uint16_t", ArrayLength: 72, Description: "Distance of obstacles around the vehicle with index 0 corresponding to north + angle_offset, unless otherwise specified in the frame. A value of 0 is valid and means that the obstacle is practically touching the sensor. A value of max_distance +1 means no obstacle is present. A value of UINT16_MAX for unknown/not used. In a array element, one unit corresponds to 1cm."},
+			{Name: "increment", Type: "uint8_t", Description: "Angular width in degrees of each array element. Increment direction is clockwise. This field is ignored if increment_f is non-zero."},
+			{Name: "min_distance", Type: "uint16_t", Description: "Minimum distance the sensor can measure."},
+			{Name: "max_distance", Type: "uint16_t", Description: "Maximum distance the sensor can measure."},
+			{Name: "increment_f", Type: "float", Extension: true, Description: "Angular width in degrees of each array element as a float. If non-zero then this value is used instead of the uint8_t increment field. Positive is clockwise direction, negative is counter-clockwise."},
+			{Name: "angle_offset", Type: "float", Extension: true, Description: "Relative angle offset of the 0-index element in the distances array. Value of 0 corresponds to forward. Positive is clockwise direction, negative is counter-clockwise."},
+			{Name: "frame", Type: "uint8_t", Extension: true, Enum: "MAV_FRAME", Description: "Coordinate frame of reference for the yaw rotation and offset of the sensor data. Defaults to MAV_FRAME_GLOBAL, which is north aligned. For body-mounted sensors use MAV_FRAME_BODY_FRD, which is vehicle front aligned."},
+		},
+	},
+	331: {
+		ID:          331,
+		Name:        "ODOMETRY",
+		Description: "Odometry message to communicate odometry information with an external interface. Fits ROS REP 147 standard for aerial vehicles (http://www.ros.org/reps/rep-0147.html).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "frame_id", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame of reference for the pose data."},
+			{Name: "child_frame_id", Type: "uint8_t", Enum: "MAV_FRAME", Description: "Coordinate frame of reference for the velocity in free space (twist) data."},
+			{Name: "x", Type: "float", Description: "X Position"},
+			{Name: "y", Type: "float", Description: "Y Position"},
+			{Name: "z", Type: "float", Description: "Z Position"},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)"},
+			{Name: "vx", Type: "float", Description: "X linear speed"},
+			{Name: "vy", Type: "float", Description: "Y linear speed"},
+			{Name: "vz", Type: "float", Description: "Z linear speed"},
+			{Name: "rollspeed", Type: "float", Description: "Roll angular speed"},
+			{Name: "pitchspeed", Type: "float", Description: "Pitch angular speed"},
+			{Name: "yawspeed", Type: "float", Description: "Yaw angular speed"},
+			{Name: "pose_covariance", Type: "float", ArrayLength: 21, Description: "Row-major representation of a 6x6 pose cross-covariance matrix upper right triangle (states: x, y, z, roll, pitch, yaw; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+			{Name: "velocity_covariance", Type: "float", ArrayLength: 21, Description: "Row-major representation of a 6x6 velocity cross-covariance matrix upper right triangle (states: vx, vy, vz, rollspeed, pitchspeed, yawspeed; first six entries are the first ROW, next five entries are the second ROW, etc.). If unknown, assign NaN value to first element in the array."},
+			{Name: "reset_counter", Type: "uint8_t", Extension: true, Description: "Estimate reset counter. This should be incremented when the estimate resets in any of the dimensions (position, velocity, attitude, angular speed). This is designed to be used when e.g an external SLAM system detects a loop-closure and the estimate jumps."},
+			{Name: "estimator_type", Type: "uint8_t", Extension: true, Enum: "MAV_ESTIMATOR_TYPE", Description: "Type of estimator that is providing the odometry."},
+			{Name: "quality", Type: "int8_t", Extension: true, Description: "Optional odometry quality metric as a percentage. -1 = odometry has failed, 0 = unknown/unset quality, 1 = worst quality, 100 = best quality"},
+		},
+	},
+	332: {
+		ID:          332,
+		Name:        "TRAJECTORY_REPRESENTATION_WAYPOINTS",
+		Description: "Describe a trajectory using an array of up-to 5 waypoints in the local frame (MAV_FRAME_LOCAL_NED).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "valid_points", Type: "uint8_t", Description: "Number of valid points (up-to 5 waypoints are possible)"},
+			{Name: "pos_x", Type: "float", ArrayLength: 5, Description: "X-coordinate of waypoint, set to NaN if not being used"},
+			{Name: "pos_y", Type: "float", ArrayLength: 5, Description: "Y-coordinate of waypoint, set to NaN if not being used"},
+			{Name: "pos_z", Type: "float", ArrayLength: 5, Description: "Z-coordinate of waypoint, set to NaN if not being used"},
+			{Name: "vel_x", Type: "float", ArrayLength: 5, Description: "X-velocity of waypoint, set to NaN if not being used"},
+			{Name: "vel_y", Type: "float", ArrayLength: 5, Description: "Y-velocity of waypoint, set to NaN if not being used"},
+			{Name: "vel_z", Type: "float", ArrayLength: 5, Description: "Z-velocity of waypoint, set to NaN if not being used"},
+			{Name: "acc_x", Type: "float", ArrayLength: 5, Description: "X-acceleration of waypoint, set to NaN if not being used"},
+			{Name: "acc_y", Type: "float", ArrayLength: 5, Description: "Y-acceleration of waypoint, set to NaN if not being used"},
+			{Name: "acc_z", Type: "float", ArrayLength: 5, Description: "Z-acceleration of waypoint, set to NaN if not being used"},
+			{Name: "pos_yaw", Type: "float", ArrayLength: 5, Description: "Yaw angle, set to NaN if not being used"},
+			{Name: "vel_yaw", Type: "float", ArrayLength: 5, Description: "Yaw rate, set to NaN if not being used"},
+			{Name: "command", Type: "uint16_t", ArrayLength: 5, Enum: "MAV_CMD", Description: "MAV_CMD command id of waypoint, set to UINT16_MAX if not being used."},
+		},
+	},
+	333: {
+		ID:          333,
+		Name:        "TRAJECTORY_REPRESENTATION_BEZIER",
+		Description: "Describe a trajectory using an array of up-to 5 bezier control points in the local frame (MAV_FRAME_LOCAL_NED).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "valid_points", Type: "uint8_t", Description: "Number of valid control points (up-to 5 points are possible)"},
+			{Name: "pos_x", Type: "float", ArrayLength: 5, Description: "X-coordinate of bezier control points. Set to NaN if not being used"},
+			{Name: "pos_y", Type: "float", ArrayLength: 5, Description: "Y-coordinate of bezier control points. Set to NaN if not being used"},
+			{Name: "pos_z", Type: "float", ArrayLength: 5, Description: "Z-coordinate of bezier control points. Set to NaN if not being used"},
+			{Name: "delta", Type: "float", ArrayLength: 5, Description: "Bezier time horizon. Set to NaN if velocity/acceleration should not be incorporated"},
+			{Name: "pos_yaw", Type: "float", ArrayLength: 5, Description: "Yaw. Set to NaN for unchanged"},
+		},
+	},
+	334: {
+		ID:          334,
+		Name:        "CELLULAR_STATUS",
+		Description: "Cellular network status as reported by a particular modem. This is primarily intended for logging, but a GCS may choose to display link_tx_rate and link_rx_rate. Note that a value of 0 in the id field indicates that the sender does not support reporting of multiple modems. Message data should be from a single modem, but that is not guaranteed.",
+		Fields: []*message.FieldMetadata{
+			{Name: "status", Type: "uint8_t", Enum: "CELLULAR_STATUS_FLAG", Description: "Cellular modem status"},
+			{Name: "failure_reason", Type: "uint8_t", Enum: "CELLULAR_NETWORK_FAILED_REASON", Description: "Failure reason when status in in CELLULAR_STATUS_FLAG_FAILED"},
+			{Name: "type", Type: "uint8_t", Enum: "CELLULAR_NETWORK_RADIO_TYPE", Description: "Cellular network radio type: gsm, cdma, lte..."},
+			{Name: "quality", Type: "uint8_t", Description: "Signal quality in percent. If unknown, set to UINT8_MAX"},
+			{Name: "mcc", Type: "uint16_t", Description: "Mobile country code. If unknown, set to UINT16_MAX"},
+			{Name: "mnc", Type: "uint16_t", Description: "Mobile network code. If unknown, set to UINT16_MAX"},
+			{Name: "lac", Type: "uint16_t", Description: "Location area code. If unknown, set to 0"},
+			{Name: "id", Type: "uint8_t", Extension: true, Description: "Cellular modem instance number. Indexed from 1."},
+			{Name: "link_tx_rate", Type: "uint32_t", Extension: true, Description: "Download rate."},
+			{Name: "link_rx_rate", Type: "uint32_t", Extension: true, Description: "Upload rate."},
+			{Name: "cell_tower_id", Type: "char", ArrayLength: 9, Extension: true, Description: "ID of the currently connected cell tower. This must be NULL terminated if the length is less than 9 human-readable chars, and without the null termination (NULL) byte if the length is exactly 9 chars."},
+			{Name: "band_number", Type: "uint8_t", Extension: true, Description: "LTE frequency band number."},
+			{Name: "band_frequency", Type: "float", Extension: true, Description: "LTE radio frequency."},
+			{Name: "channel_number", Type: "uint32_t", Extension: true, Description: "The channel number (CN). Absolute radio-frequency (ARFCN) / E-UTRA (EARFCN) / UTRA (UARFCN) / New radio (NR_CH)."},
+			{Name: "rx_level", Type: "float", Extension: true, Description: "On 3G is Received Signal Code Power (RSCP). On LTE is Reference Signal Received Power (RSRP). On 5G is New Radio Reference Signal Received Power (NR_RSRP)."},
+			{Name: "tx_level", Type: "float", Extension: true, Description: "Transmitter (modem) signal absolute power level."},
+			{Name: "rx_quality", Type: "float", Extension: true, Description: "On 3G is Receiver Quality (RxQual). On LTE is Reference Signal Received Quality (RSRQ). On 5G is New Radio Reference Signal Received Quality (NR_RSRQ)."},
+			{Name: "sinr", Type: "float", Extension: true, Description: "Signal to interference plus noise ratio (SINR)."},
+		},
+	},
+	335: {
+		ID:          335,
+		Name:        "ISBD_LINK_STATUS",
+		Description: "Status of the Iridium SBD link.",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "last_heartbeat", Type: "uint64_t", Description: "Timestamp of the last successful sbd session. The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "failed_sessions", Type: "uint16_t", Description: "Number of failed SBD sessions."},
+			{Name: "successful_sessions", Type: "uint16_t", Description: "Number of successful SBD sessions."},
+			{Name: "signal_quality", Type: "uint8_t", Description: "Signal quality equal to the number of bars displayed on the ISU signal strength indicator. Range is 0 to 5, where 0 indicates no signal and 5 indicates maximum signal strength."},
+			{Name: "ring_pending", Type: "uint8_t", Description: "1: Ring call pending, 0: No call pending."},
+			{Name: "tx_session_pending", Type: "uint8_t", Description: "1: Transmission session pending, 0: No transmission session pending."},
+			{Name: "rx_session_pending", Type: "uint8_t", Description: "1: Receiving session pending, 0: No receiving session pending."},
+		},
+	},
+	336: {
+		ID:          336,
+		Name:        "CELLULAR_CONFIG",
+		Description: "Configure cellular modems. This message is re-emitted as an acknowledgement by the modem. The message may also be explicitly requested using MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "enable_lte", Type: "uint8_t", Description: "Enable/disable LTE. 0: setting unchanged, 1: disabled, 2: enabled. Current setting when sent back as a response."},
+			{Name: "enable_pin", Type: "uint8_t", Description: "Enable/disable PIN on the SIM card. 0: setting unchanged, 1: disabled, 2: enabled. Current setting when sent back as a response."},
+			{Name: "pin", Type: "char", ArrayLength: 16, Description: "PIN sent to the SIM card. Blank when PIN is disabled. Empty when message is sent back as a response."},
+			{Name: "new_pin", Type: "char", ArrayLength: 16, Description: "New PIN when changing the PIN. Blank to leave it unchanged. Empty when message is sent back as a response."},
+			{Name: "apn", Type: "char", ArrayLength: 32, Description: "Name of the cellular APN. Blank to leave it unchanged. Current APN when sent back as a response."},
+			{Name: "puk", Type: "char", ArrayLength: 16, Description: "Required PUK code in case the user failed to authenticate 3 times with the PIN. Empty when message is sent back as a response."},
+			{Name: "roaming", Type: "uint8_t", Description: "Enable/disable roaming. 0: setting unchanged, 1: disabled, 2: enabled. Current setting when sent back as a response."},
+			{Name: "response", Type: "uint8_t", Enum: "CELLULAR_CONFIG_RESPONSE", Description: "Message acceptance response (sent back to GS)."},
+		},
+	},
+	339: {
+		ID:          339,
+		Name:        "RAW_RPM",
+		Description: "RPM sensor data message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "index", Type: "uint8_t", Description: "Index of this RPM sensor (0-indexed)"},
+			{Name: "frequency", Type: "float", Description: "Indicated rate"},
+		},
+	},
+	340: {
+		ID:          340,
+		Name:        "UTM_GLOBAL_POSITION",
+		Description: "The global position resulting from GPS and sensor fusion.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time", Type: "uint64_t", Description: "Time of applicability of position (microseconds since UNIX epoch)."},
+			{Name: "uas_id", Type: "uint8_t", ArrayLength: 18, Description: "Unique UAS ID."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude (WGS84)"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (WGS84)"},
+			{Name: "relative_alt", Type: "int32_t", Description: "Altitude above ground"},
+			{Name: "vx", Type: "int16_t", Description: "Ground X speed (latitude, positive north)"},
+			{Name: "vy", Type: "int16_t", Description: "Ground Y speed (longitude, positive east)"},
+			{Name: "vz", Type: "int16_t", Description: "Ground Z speed (altitude, positive down)"},
+			{Name: "h_acc", Type: "uint16_t", Description: "Horizontal position uncertainty (standard deviation)"},
+			{Name: "v_acc", Type: "uint16_t", Description: "Altitude uncertainty (standard deviation)"},
+			{Name: "vel_acc", Type: "uint16_t", Description: "Speed uncertainty (standard deviation)"},
+			{Name: "next_lat", Type: "int32_t", Description: "Next waypoint, latitude (WGS84)"},
+			{Name: "next_lon", Type: "int32_t", Description: "Next waypoint, longitude (WGS84)"},
+			{Name: "next_alt", Type: "int32_t", Description: "Next waypoint, altitude (WGS84)"},
+			{Name: "update_rate", Type: "uint16_t", Description: "Time until next update. Set to 0 if unknown or in data driven mode."},
+			{Name: "flight_state", Type: "uint8_t", Enum: "UTM_FLIGHT_STATE", Description: "Flight state"},
+			{Name: "flags", Type: "uint8_t", Enum: "UTM_DATA_AVAIL_FLAGS", Bitmask: true, Description: "Bitwise OR combination of the data available flags."},
+		},
+	},
+	345: {
+		ID:          345,
+		Name:        "PARAM_ERROR",
+		Description: "Parameter set/get error. Returned from a MAVLink node in response to an error in the parameter protocol, for example failing to set a parameter because it does not exist.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "param_id", Type: "char", ArrayLength: 16, Description: "Parameter id. Terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string"},
+			{Name: "param_index", Type: "int16_t", Description: "Parameter index. Will be -1 if the param ID field should be used as an identifier (else the param id will be ignored)"},
+			{Name: "error", Type: "uint8_t", Enum: "MAV_PARAM_ERROR", Description: "Error being returned to client."},
+		},
+	},
+	350: {
+		ID:          350,
+		Name:        "DEBUG_FLOAT_ARRAY",
+		Description: "Large debug/prototyping array. The message uses the maximum available payload for data. The array_id and name fields are used to discriminate between messages in code and in user interfaces (respectively). Do not use in production code.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "name", Type: "char", ArrayLength: 10, Description: "Name, for human-friendly display in a Ground Control Station"},
+			{Name: "array_id", Type: "uint16_t", Description: "Unique ID used to discriminate between arrays"},
+			{Name: "data", Type: "float", ArrayLength: 58, Extension: true, Description: "data"},
+		},
+	},
+	360: {
+		ID:          360,
+		Name:        "ORBIT_EXECUTION_STATUS",
+		Description: "Vehicle status report that is sent out while orbit execution is in progress (see MAV_CMD_DO_ORBIT).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "radius", Type: "float", Description: "Radius of the orbit circle. Positive values orbit clockwise, negative values orbit counter-clockwise."},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the fields: x, y, z."},
+			{Name: "x", Type: "int32_t", Description: "X coordinate of center point. Coordinate system depends on frame field: local = x position in meters * 1e4, global = latitude in degrees * 1e7."},
+			{Name: "y", Type: "int32_t", Description: "Y coordinate of center point.  Coordinate system depends on frame field: local = x position in meters * 1e4, global = latitude in degrees * 1e7."},
+			{Name: "z", Type: "float", Description: "Altitude of center point. Coordinate system depends on frame field."},
+		},
+	},
+	361: {
+		ID:          361,
+		Name:        "FIGURE_EIGHT_EXECUTION_STATUS",
+		Description: "Vehicle status report that is sent out while figure eight execution is in progress (see MAV_CMD_DO_FIGURE_EIGHT). This may typically send at low rates: of the order of 2Hz.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "major_radius", Type: "float", Description: "Major axis radius of the figure eight. Positive: orbit the north circle clockwise. Negative: orbit the north circle counter-clockwise."},
+			{Name: "minor_radius", Type: "float", Description: "Minor axis radius of the figure eight. Defines the radius of two circles that make up the figure."},
+			{Name: "orientation", Type: "float", Description: "Orientation of the figure eight major axis with respect to true north in [-pi,pi)."},
+			{Name: "frame", Type: "uint8_t", Enum: "MAV_FRAME", Description: "The coordinate system of the fields: x, y, z."},
+			{Name: "x", Type: "int32_t", Description: "X coordinate of center point. Coordinate system depends on frame field."},
+			{Name: "y", Type: "int32_t", Description: "Y coordinate of center point. Coordinate system depends on frame field."},
+			{Name: "z", Type: "float", Description: "Altitude of center point. Coordinate system depends on frame field."},
+		},
+	},
+	370: {
+		ID:          370,
+		Name:        "SMART_BATTERY_INFO",
+		Description: "Smart Battery information (static/infrequent update). Use for updates from: smart battery to flight stack, flight stack to GCS. Use BATTERY_STATUS for the frequent battery updates.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Battery ID"},
+			{Name: "battery_function", Type: "uint8_t", Enum: "MAV_BATTERY_FUNCTION", Description: "Function of the battery"},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_BATTERY_TYPE", Description: "Type (chemistry) of the battery"},
+			{Name: "capacity_full_specification", Type: "int32_t", Description: "Capacity when full according to manufacturer, -1: field not provided."},
+			{Name: "capacity_full", Type: "int32_t", Description: "Capacity when full (accounting for battery degradation), -1: field not provided."},
+			{Name: "cycle_count", Type: "uint16_t", Description: "Charge/discharge cycle count. UINT16_MAX: field not provided."},
+			{Name: "serial_number", Type: "char", ArrayLength: 16, Description: "Serial number in ASCII characters, 0 terminated. All 0: field not provided."},
+			{Name: "device_name", Type: "char", ArrayLength: 50, Description: "Static device name in ASCII characters, 0 terminated. All 0: field not provided. Encode as manufacturer name then product name separated using an underscore."},
+			{Name: "weight", Type: "uint16_t", Description: "Battery weight. 0: field not provided."},
+			{Name: "discharge_minimum_voltage", Type: "uint16_t", Description: "Minimum per-cell voltage when discharging. If not supplied set to UINT16_MAX value."},
+			{Name: "charging_minimum_voltage", Type: "uint16_t", Description: "Minimum per-cell voltage when charging. If not supplied set to UINT16_MAX value."},
+			{Name: "resting_minimum_voltage", Type: "uint16_t", Description: "Minimum per-cell voltage when resting. If not supplied set to UINT16_MAX value."},
+			{Name: "charging_maximum_voltage", Type: "uint16_t", Extension: true, Description: "Maximum per-cell voltage when charged. 0: field not provided."},
+			{Name: "cells_in_series", Type: "uint8_t", Extension: true, Description: "Number of battery cells in series. 0: field not provided."},
+			{Name: "discharge_maximum_current", Type: "uint32_t", Extension: true, Description: "Maximum pack discharge current. 0: field not provided."},
+			{Name: "discharge_maximum_burst_current", Type: "uint32_t", Extension: true, Description: "Maximum pack discharge burst current. 0: field not provided."},
+			{Name: "manufacture_date", Type: "char", ArrayLength: 11, Extension: true, Description: "Manufacture date (DD/MM/YYYY) in ASCII characters, 0 terminated. All 0: field not provided."},
+		},
+	},
+	371: {
+		ID:          371,
+		Name:        "FUEL_STATUS",
+		Description: "Fuel status. This message provides \"generic\" fuel level information for  in a GCS and for triggering failsafes in an autopilot. The fuel type and associated units for fields in this message are defined in the enum MAV_FUEL_TYPE. The reported `consumed_fuel` and `remaining_fuel` must only be supplied if measured: they must not be inferred from the `maximum_fuel` and the other value. A recipient can assume that if these fields are supplied they are accurate. If not provided, the recipient can infer `remaining_fuel` from `maximum_fuel` and `consumed_fuel` on the assumption that the fuel was initially at its maximum (this is what battery monitors assume). Note however that this is an assumption, and the UI should prompt the user appropriately (i.e. notify user that they should fill the tank before boot). This kind of information may also be sent in fuel-specific messages such as BATTERY_STATUS_V2. If both messages are sent for the same fuel system, the ids and corresponding information must match. This should be streamed (nominally at 0.1 Hz).",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Fuel ID. Must match ID of other messages for same fuel system, such as BATTERY_STATUS_V2."},
+			{Name: "maximum_fuel", Type: "float", Description: "Capacity when full. Must be provided."},
+			{Name: "consumed_fuel", Type: "float", Description: "Consumed fuel (measured). This value should not be inferred: if not measured set to NaN. NaN: field not provided."},
+			{Name: "remaining_fuel", Type: "float", Description: "Remaining fuel until empty (measured). The value should not be inferred: if not measured set to NaN. NaN: field not provided."},
+			{Name: "percent_remaining", Type: "uint8_t", Description: "Percentage of remaining fuel, relative to full. Values: [0-100], UINT8_MAX: field not provided."},
+			{Name: "flow_rate", Type: "float", Description: "Positive value when emptying/using, and negative if filling/replacing. NaN: field not provided."},
+			{Name: "temperature", Type: "float", Description: "Fuel temperature. NaN: field not provided."},
+			{Name: "fuel_type", Type: "uint32_t", Enum: "MAV_FUEL_TYPE", Description: "Fuel type. Defines units for fuel capacity and consumption fields above."},
+		},
+	},
+	372: {
+		ID:          372,
+		Name:        "BATTERY_INFO",
+		Description: "Battery information that is static, or requires infrequent update. This message should requested using MAV_CMD_REQUEST_MESSAGE and/or streamed at very low rate. BATTERY_STATUS_V2 is used for higher-rate battery status information.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Battery ID"},
+			{Name: "battery_function", Type: "uint8_t", Enum: "MAV_BATTERY_FUNCTION", Description: "Function of the battery."},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_BATTERY_TYPE", Description: "Type (chemistry) of the battery."},
+			{Name: "state_of_health", Type: "uint8_t", Description: "State of Health (SOH) estimate. Typically 100% at the time of manufacture and will decrease over time and use. -1: field not provided."},
+			{Name: "cells_in_series", Type: "uint8_t", Description: "Number of battery cells in series. 0: field not provided."},
+			{Name: "cycle_count", Type: "uint16_t", Description: "Lifetime count of the number of charge/discharge cycles (https://en.wikipedia.org/wiki/Charge_cycle). UINT16_MAX: field not provided."},
+			{Name: "weight", Type: "uint16_t", Description: "Battery weight. 0: field not provided."},
+			{Name: "discharge_minimum_voltage", Type: "float", Description: "Minimum per-cell voltage when discharging. 0: field not provided."},
+			{Name: "charging_minimum_voltage", Type: "float", Description: "Minimum per-cell voltage when charging. 0: field not provided."},
+			{Name: "resting_minimum_voltage", Type: "float", Description: "Minimum per-cell voltage when resting. 0: field not provided."},
+			{Name: "charging_maximum_voltage", Type: "float", Description: "Maximum per-cell voltage when charged. 0: field not provided."},
+			{Name: "charging_maximum_current", Type: "float", Description: "Maximum pack continuous charge current. 0: field not provided."},
+			{Name: "nominal_voltage", Type: "float", Description: "Battery nominal voltage. Used for conversion between Wh and Ah. 0: field not provided."},
+			{Name: "discharge_maximum_current", Type: "float", Description: "Maximum pack discharge current. 0: field not provided."},
+			{Name: "discharge_maximum_burst_current", Type: "float", Description: "Maximum pack discharge burst current. 0: field not provided."},
+			{Name: "design_capacity", Type: "float", Description: "Fully charged design capacity. 0: field not provided."},
+			{Name: "full_charge_capacity", Type: "float", Description: "Predicted battery capacity when fully charged (accounting for battery degradation). NAN: field not provided."},
+			{Name: "manufacture_date", Type: "char", ArrayLength: 9, Description: "Manufacture date (DDMMYYYY) in ASCII characters, 0 terminated. All 0: field not provided."},
+			{Name: "serial_number", Type: "char", ArrayLength: 32, Description: "Serial number in ASCII characters, 0 terminated. All 0: field not provided."},
+			{Name: "name", Type: "char", ArrayLength: 50, Description: "Battery device name. Formatted as manufacturer name then product name, separated with an underscore (in ASCII characters), 0 terminated. All 0: field not provided."},
+		},
+	},
+	373: {
+		ID:          373,
+		Name:        "GENERATOR_STATUS",
+		Description: "Telemetry of power generation system. Alternator or mechanical generator.",
+		Fields: []*message.FieldMetadata{
+			{Name: "status", Type: "uint64_t", Enum: "MAV_GENERATOR_STATUS_FLAG", Bitmask: true, Description: "Status flags."},
+			{Name: "generator_speed", Type: "uint16_t", Description: "Speed of electrical generator or alternator. UINT16_MAX: field not provided."},
+			{Name: "battery_current", Type: "float", Description: "Current into/out of battery. Positive for out. Negative for in. NaN: field not provided."},
+			{Name: "load_current", Type: "float", Description: "Current going to the UAV. If battery current not available this is the DC current from the generator. Positive for out. Negative for in. NaN: field not provided"},
+			{Name: "power_generated", Type: "float", Description: "The power being generated. NaN: field not provided"},
+			{Name: "bus_voltage", Type: "float", Description: "Voltage of the bus seen at the generator, or battery bus if battery bus is controlled by generator and at a different voltage to main bus."},
+			{Name: "rectifier_temperature", Type: "int16_t", Description: "The temperature of the rectifier or power converter. INT16_MAX: field not provided."},
+			{Name: "bat_current_setpoint", Type: "float", Description: "The target battery current. Positive for out. Negative for in. NaN: field not provided"},
+			{Name: "generator_temperature", Type: "int16_t", Description: "The temperature of the mechanical motor, fuel cell core or generator. INT16_MAX: field not provided."},
+			{Name: "runtime", Type: "uint32_t", Description: "Seconds this generator has run since it was rebooted. UINT32_MAX: field not provided."},
+			{Name: "time_until_maintenance", Type: "int32_t", Description: "Seconds until this generator requires maintenance.  A negative value indicates maintenance is past-due. INT32_MAX: field not provided."},
+		},
+	},
+	375: {
+		ID:          375,
+		Name:        "ACTUATOR_OUTPUT_STATUS",
+		Description: "The raw values of the actuator outputs (e.g. on Pixhawk, from MAIN, AUX ports). This message supersedes SERVO_OUTPUT_RAW.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (since system boot)."},
+			{Name: "active", Type: "uint32_t", Description: "Active outputs"},
+			{Name: "actuator", Type: "float", ArrayLength: 32, Description: "Servo / motor output array values. Zero values indicate unused channels."},
+		},
+	},
+	376: {
+		ID:          376,
+		Name:        "RELAY_STATUS",
+		Description: "Reports the on/off state of relays, as controlled by MAV_CMD_DO_SET_RELAY. Message streaming should be requested using MAV_CMD_SET_MESSAGE_INTERVAL. Note that it should not be sent on every relay state change to avoid flooding the link.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "on", Type: "uint16_t", Description: "Relay states. Relay instance numbers are represented as individual bits in this mask by offset."},
+			{Name: "present", Type: "uint16_t", Description: "Relay present. Relay instance numbers are represented as individual bits in this mask by offset.  Bits will be true if a relay instance is configured."},
+		},
+	},
+	380: {
+		ID:          380,
+		Name:        "TIME_ESTIMATE_TO_TARGET",
+		Description: "Time/duration estimates for various events and actions given the current vehicle state and position.",
+		Fields: []*message.FieldMetadata{
+			{Name: "safe_return", Type: "int32_t", Description: "Estimated time to complete the vehicle's configured \"safe return\" action from its current position (e.g. RTL, Smart RTL, etc.). -1 indicates that the vehicle is landed, or that no time estimate available."},
+			{Name: "land", Type: "int32_t", Description: "Estimated time for vehicle to complete the LAND action from its current position. -1 indicates that the vehicle is landed, or that no time estimate available."},
+			{Name: "mission_next_item", Type: "int32_t", Description: "Estimated time for reaching/completing the currently active mission item. -1 means no time estimate available."},
+			{Name: "mission_end", Type: "int32_t", Description: "Estimated time for completing the current mission. -1 means no mission active and/or no estimate available."},
+			{Name: "commanded_action", Type: "int32_t", Description: "Estimated time for completing the current commanded action (i.e. Go To, Takeoff, Land, etc.). -1 means no action active and/or no estimate available."},
+		},
+	},
+	385: {
+		ID:          385,
+		Name:        "TUNNEL",
+		Description: "Message for transporting \"arbitrary\" variable-length data from one component to another (broadcast is not forbidden, but discouraged). The encoding of the data is usually extension specific, i.e. determined by the source, and is usually not documented as part of the MAVLink specification.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (can be 0 for broadcast, but this is discouraged)"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (can be 0 for broadcast, but this is discouraged)"},
+			{Name: "payload_type", Type: "uint16_t", Enum: "MAV_TUNNEL_PAYLOAD_TYPE", Description: "A code that identifies the content of the payload (0 for unknown, which is the default). If this code is less than 32768, it is a 'registered' payload type and the corresponding code should be added to the MAV_TUNNEL_PAYLOAD_TYPE enum. Software creators can register blocks of types as needed. Codes greater than 32767 are considered local experiments and should not be checked in to any widely distributed codebase."},
+			{Name: "payload_length", Type: "uint8_t", Description: "Length of the data transported in payload"},
+			{Name: "payload", Type: "uint8_t", ArrayLength: 128, Description: "Variable length payload. The payload length is defined by payload_length. The entire content of this block is opaque unless you understand the encoding specified by payload_type."},
+		},
+	},
+	386: {
+		ID:          386,
+		Name:        "CAN_FRAME",
+		Description: "A forwarded CAN frame as requested by MAV_CMD_CAN_FORWARD.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "bus", Type: "uint8_t", Description: "Bus number"},
+			{Name: "len", Type: "uint8_t", Description: "Frame length"},
+			{Name: "id", Type: "uint32_t", Description: "Frame ID"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 8, Description: "Frame data"},
+		},
+	},
+	387: {
+		ID:          387,
+		Name:        "CANFD_FRAME",
+		Description: "A forwarded CANFD frame as requested by MAV_CMD_CAN_FORWARD. These are separated from CAN_FRAME as they need different handling (eg. TAO handling)",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "bus", Type: "uint8_t", Description: "bus number"},
+			{Name: "len", Type: "uint8_t", Description: "Frame length"},
+			{Name: "id", Type: "uint32_t", Description: "Frame ID"},
+			{Name: "data", Type: "uint8_t", ArrayLength: 64, Description: "Frame data"},
+		},
+	},
+	388: {
+		ID:          388,
+		Name:        "CAN_FILTER_MODIFY",
+		Description: "Modify the filter of what CAN messages to forward over the mavlink. This can be used to make CAN forwarding work well on low bandwidth links. The filtering is applied on bits 8 to 24 of the CAN id (2nd and 3rd bytes) which corresponds to the DroneCAN message ID for DroneCAN. Filters with more than 16 IDs can be constructed by sending multiple CAN_FILTER_MODIFY messages.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "bus", Type: "uint8_t", Description: "bus number"},
+			{Name: "operation", Type: "uint8_t", Enum: "CAN_FILTER_OP", Description: "what operation to perform on the filter list. See CAN_FILTER_OP enum."},
+			{Name: "num_ids", Type: "uint8_t", Description: "number of IDs in filter list"},
+			{Name: "ids", Type: "uint16_t", ArrayLength: 16, Description: "filter IDs, length num_ids"},
+		},
+	},
+	390: {
+		ID:          390,
+		Name:        "ONBOARD_COMPUTER_STATUS",
+		Description: "Hardware status sent by an onboard computer.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "uptime", Type: "uint32_t", Description: "Time since system boot."},
+			{Name: "type", Type: "uint8_t", Description: "Type of the onboard computer: 0: Mission computer primary, 1: Mission computer backup 1, 2: Mission computer backup 2, 3: Compute node, 4-5: Compute spares, 6-9: Payload computers."},
+			{Name: "cpu_cores", Type: "uint8_t", ArrayLength: 8, Description: "CPU usage on the component in percent (100 - idle). A value of UINT8_MAX implies the field is unused."},
+			{Name: "cpu_combined", Type: "uint8_t", ArrayLength: 10, Description: "Combined CPU usage as the last 10 slices of 100 MS (a histogram). This allows to identify spikes in load that max out the system, but only for a short amount of time. A value of UINT8_MAX implies the field is unused."},
+			{Name: "gpu_cores", Type: "uint8_t", ArrayLength: 4, Description: "GPU usage on the component in percent (100 - idle). A value of UINT8_MAX implies the field is unused."},
+			{Name: "gpu_combined", Type: "uint8_t", ArrayLength: 10, Description: "Combined GPU usage as the last 10 slices of 100 MS (a histogram). This allows to identify spikes in load that max out the system, but only for a short amount of time. A value of UINT8_MAX implies the field is unused."},
+			{Name: "temperature_board", Type: "int8_t", Description: "Temperature of the board. A value of INT8_MAX implies the field is unused."},
+			{Name: "temperature_core", Type: "int8_t", ArrayLength: 8, Description: "Temperature of the CPU core. A value of INT8_MAX implies the field is unused."},
+			{Name: "fan_speed", Type: "int16_t", ArrayLength: 4, Description: "Fan speeds. A value of INT16_MAX implies the field is unused."},
+			{Name: "ram_usage", Type: "uint32_t", Description: "Amount of used RAM on the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "ram_total", Type: "uint32_t", Description: "Total amount of RAM on the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "storage_type", Type: "uint32_t", ArrayLength: 4, Description: "Storage type: 0: HDD, 1: SSD, 2: EMMC, 3: SD card (non-removable), 4: SD card (removable). A value of UINT32_MAX implies the field is unused."},
+			{Name: "storage_usage", Type: "uint32_t", ArrayLength: 4, Description: "Amount of used storage space on the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "storage_total", Type: "uint32_t", ArrayLength: 4, Description: "Total amount of storage space on the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "link_type", Type: "uint32_t", ArrayLength: 6, Description: "Link type: 0-9: UART, 10-19: Wired network, 20-29: Wifi, 30-39: Point-to-point proprietary, 40-49: Mesh proprietary"},
+			{Name: "link_tx_rate", Type: "uint32_t", ArrayLength: 6, Description: "Network traffic from the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "link_rx_rate", Type: "uint32_t", ArrayLength: 6, Description: "Network traffic to the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "link_tx_max", Type: "uint32_t", ArrayLength: 6, Description: "Network capacity from the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "link_rx_max", Type: "uint32_t", ArrayLength: 6, Description: "Network capacity to the component system. A value of UINT32_MAX implies the field is unused."},
+			{Name: "status_flags", Type: "uint16_t", Extension: true, Enum: "COMPUTER_STATUS_FLAGS", Bitmask: true, Description: "Bitmap of status flags."},
+		},
+	},
+	395: {
+		ID:          395,
+		Name:        "COMPONENT_INFORMATION",
+		Description: "Component information message, which may be requested using MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "general_metadata_file_crc", Type: "uint32_t", Description: "CRC32 of the general metadata file (general_metadata_uri)."},
+			{Name: "general_metadata_uri", Type: "char", ArrayLength: 100, Description: "MAVLink FTP URI for the general metadata file (COMP_METADATA_TYPE_GENERAL), which may be compressed with xz. The file contains general component metadata, and may contain URI links for additional metadata (see COMP_METADATA_TYPE). The information is static from boot, and may be generated at compile time. The string needs to be zero terminated."},
+			{Name: "peripherals_metadata_file_crc", Type: "uint32_t", Description: "CRC32 of peripherals metadata file (peripherals_metadata_uri)."},
+			{Name: "peripherals_metadata_uri", Type: "char", ArrayLength: 100, Description: "(Optional) MAVLink FTP URI for the peripherals metadata file (COMP_METADATA_TYPE_PERIPHERALS), which may be compressed with xz. This contains data about \"attached components\" such as UAVCAN nodes. The peripherals are in a separate file because the information must be generated dynamically at runtime. The string needs to be zero terminated."},
+		},
+	},
+	396: {
+		ID:          396,
+		Name:        "COMPONENT_INFORMATION_BASIC",
+		Description: "Basic component information data. Should be requested using MAV_CMD_REQUEST_MESSAGE on startup, or when required.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "capabilities", Type: "uint64_t", Enum: "MAV_PROTOCOL_CAPABILITY", Bitmask: true, Description: "Component capability flags"},
+			{Name: "time_manufacture_s", Type: "uint32_t", Description: "Date of manufacture as a UNIX Epoch time (since 1.1.1970) in seconds."},
+			{Name: "vendor_name", Type: "char", ArrayLength: 32, Description: "Name of the component vendor. Needs to be zero terminated. The field is optional and can be empty/all zeros."},
+			{Name: "model_name", Type: "char", ArrayLength: 32, Description: "Name of the component model. Needs to be zero terminated. The field is optional and can be empty/all zeros."},
+			{Name: "software_version", Type: "char", ArrayLength: 24, Description: "Software version. The recommended format is SEMVER: 'major.minor.patch'  (any format may be used). The field must be zero terminated if it has a value. The field is optional and can be empty/all zeros."},
+			{Name: "hardware_version", Type: "char", ArrayLength: 24, Description: "Hardware version. The recommended format is SEMVER: 'major.minor.patch'  (any format may be used). The field must be zero terminated if it has a value. The field is optional and can be empty/all zeros."},
+			{Name: "serial_number", Type: "char", ArrayLength: 32, Description: "Hardware serial number. The field must be zero terminated if it has a value. The field is optional and can be empty/all zeros."},
+		},
+	},
+	397: {
+		ID:          397,
+		Name:        "COMPONENT_METADATA",
+		Description: "Component metadata message, which may be requested using MAV_CMD_REQUEST_MESSAGE. This contains the MAVLink FTP URI and CRC for the component's general metadata file. The file must be hosted on the component, and may be xz compressed. The file CRC can be used for file caching. The general metadata file can be read to get the locations of other metadata files (COMP_METADATA_TYPE) and translations, which may be hosted either on the vehicle or the internet. For more information see: https://mavlink.io/en/services/component_information.html. Note: Camera components should use CAMERA_INFORMATION instead, and autopilots may use both this message and AUTOPILOT_VERSION.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "file_crc", Type: "uint32_t", Description: "CRC32 of the general metadata file."},
+			{Name: "uri", Type: "char", ArrayLength: 100, Description: "MAVLink FTP URI for the general metadata file (COMP_METADATA_TYPE_GENERAL), which may be compressed with xz. The file contains general component metadata, and may contain URI links for additional metadata (see COMP_METADATA_TYPE). The information is static from boot, and may be generated at compile time. The string needs to be zero terminated."},
+		},
+	},
+	400: {
+		ID:          400,
+		Name:        "PLAY_TUNE_V2",
+		Description: "Play vehicle tone/tune (buzzer). Supported tunes can be determined using SUPPORTED_TUNES. Supersedes message PLAY_TUNE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "format", Type: "uint32_t", Enum: "TUNE_FORMAT", Description: "Tune format"},
+			{Name: "tune", Type: "char", ArrayLength: 248, Description: "Tune definition as a NULL-terminated string."},
+		},
+	},
+	401: {
+		ID:          401,
+		Name:        "SUPPORTED_TUNES",
+		Description: "Tune formats supported by vehicle, i.e. via PLAY_TUNE_V2. This should be emitted as response to MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "format", Type: "uint32_t", Enum: "TUNE_FORMAT", Description: "Bitfield of supported tune formats."},
+		},
+	},
+	410: {
+		ID:          410,
+		Name:        "EVENT",
+		Description: "Event message. Each new event from a particular component gets a new sequence number. The same message might be sent multiple times if (re-)requested. Most events are broadcast, some can be specific to a target component (as receivers keep track of the sequence for missed events, all events need to be broadcast. Thus we use destination_component instead of target_component).",
+		Fields: []*message.FieldMetadata{
+			{Name: "destination_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "destination_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "id", Type: "uint32_t", Description: "Event ID (as defined in the component metadata)"},
+			{Name: "event_time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot when the event happened)."},
+			{Name: "sequence", Type: "uint16_t", Description: "Sequence number."},
+			{Name: "log_levels", Type: "uint8_t", Description: "Log levels: 4 bits MSB: internal (for logging purposes), 4 bits LSB: external. Levels: Emergency = 0, Alert = 1, Critical = 2, Error = 3, Warning = 4, Notice = 5, Info = 6, Debug = 7, Protocol = 8, Disabled = 9"},
+			{Name: "arguments", Type: "uint8_t", ArrayLength: 40, Description: "Arguments (depend on event ID)."},
+		},
+	},
+	411: {
+		ID:          411,
+		Name:        "CURRENT_EVENT_SEQUENCE",
+		Description: "Regular broadcast for the current latest event sequence number for a component. This is used to check for dropped events.",
+		Fields: []*message.FieldMetadata{
+			{Name: "sequence", Type: "uint16_t", Description: "Sequence number."},
+			{Name: "flags", Type: "uint8_t", Enum: "MAV_EVENT_CURRENT_SEQUENCE_FLAGS", Bitmask: true, Description: "Flag bitset."},
+		},
+	},
+	412: {
+		ID:          412,
+		Name:        "REQUEST_EVENT",
+		Description: "Request one or more events to be (re-)sent. If first_sequence==last_sequence, only a single event is requested. Note that first_sequence can be larger than last_sequence (because the sequence number can wrap). Each sequence will trigger an EVENT or EVENT_ERROR response.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "first_sequence", Type: "uint16_t", Description: "First sequence number of the requested event."},
+			{Name: "last_sequence", Type: "uint16_t", Description: "Last sequence number of the requested event."},
+		},
+	},
+	413: {
+		ID:          413,
+		Name:        "RESPONSE_EVENT_ERROR",
+		Description: "Response to a REQUEST_EVENT in case of an error (e.g. the event is not available anymore).",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "sequence", Type: "uint16_t", Description: "Sequence number."},
+			{Name: "sequence_oldest_available", Type: "uint16_t", Description: "Oldest Sequence number that is still available after the sequence set in REQUEST_EVENT."},
+			{Name: "reason", Type: "uint8_t", Enum: "MAV_EVENT_ERROR_REASON", Description: "Error reason."},
+		},
+	},
+	435: {
+		ID:          435,
+		Name:        "AVAILABLE_MODES",
+		Description: "Information about a flight mode. The message can be enumerated to get information for all modes, or requested for a particular mode, using MAV_CMD_REQUEST_MESSAGE. Specify 0 in param2 to request that the message is emitted for all available modes or the specific index for just one mode. The modes must be available/settable for the current vehicle/frame type. Each mode should only be emitted once (even if it is both standard and custom). Note that the current mode should be emitted in CURRENT_MODE, and that if the mode list can change then AVAILABLE_MODES_MONITOR must be emitted on first change and subsequently streamed. See https://mavlink.io/en/services/standard_modes.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "number_modes", Type: "uint8_t", Description: "The total number of available modes for the current vehicle type."},
+			{Name: "mode_index", Type: "uint8_t", Description: "The current mode index within number_modes, indexed from 1. The index is not guaranteed to be persistent, and may change between reboots or if the set of modes change."},
+			{Name: "standard_mode", Type: "uint8_t", Enum: "MAV_STANDARD_MODE", Description: "Standard mode."},
+			{Name: "custom_mode", Type: "uint32_t", Description: "A bitfield for use for autopilot-specific flags"},
+			{Name: "properties", Type: "uint32_t", Enum: "MAV_MODE_PROPERTY", Bitmask: true, Description: "Mode properties."},
+			{Name: "mode_name", Type: "char", ArrayLength: 35, Description: "Name of custom mode, with null termination character. Should be omitted for standard modes."},
+			{Name: "seq", Type: "uint8_t", Extension: true, Description: "Sequence number. The value iterates sequentially whenever the set of AVAILABLE_MODES changes and should match value of AVAILABLE_MODES_MONITOR. Note, a GCS must ignore 0 values, and should re-start the download if the value changes part-way through fetching modes."},
+		},
+	},
+	436: {
+		ID:          436,
+		Name:        "CURRENT_MODE",
+		Description: "Get the current mode. This should be emitted on any mode change, and broadcast at low rate (nominally 0.5 Hz). It may be requested using MAV_CMD_REQUEST_MESSAGE. See https://mavlink.io/en/services/standard_modes.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "standard_mode", Type: "uint8_t", Enum: "MAV_STANDARD_MODE", Description: "Standard mode."},
+			{Name: "custom_mode", Type: "uint32_t", Description: "A bitfield for use for autopilot-specific flags"},
+			{Name: "intended_custom_mode", Type: "uint32_t", Description: "The custom_mode of the mode that was last commanded by the user (for example, with MAV_CMD_DO_SET_STANDARD_MODE, MAV_CMD_DO_SET_MODE or via RC). This should usually be the same as custom_mode. It will be different if the vehicle is unable to enter the intended mode, or has left that mode due to a failsafe condition. 0 indicates the intended custom mode is unknown/not supplied"},
+		},
+	},
+	437: {
+		ID:          437,
+		Name:        "AVAILABLE_MODES_MONITOR",
+		Description: "A change to the sequence number indicates that the set of AVAILABLE_MODES has changed, and that the receiver should re-request all available modes. The message is optional, and is only needed when the set of modes can change dynamically after boot. It should be emitted whenever the set of modes change. It should be streamed at low rate (nominally 0.3 Hz). See https://mavlink.io/en/services/standard_modes.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "seq", Type: "uint8_t", Description: "Sequence number. Iterates sequentially whenever AVAILABLE_MODES changes (e.g. support for a new mode is added/removed dynamically). 0 initially. 1 on first change of mode set."},
+		},
+	},
+	440: {
+		ID:          440,
+		Name:        "ILLUMINATOR_STATUS",
+		Description: "Illuminator status",
+		Fields: []*message.FieldMetadata{
+			{Name: "uptime_ms", Type: "uint32_t", Description: "Time since the start-up of the illuminator in ms"},
+			{Name: "enable", Type: "uint8_t", Description: "0: Illuminators OFF, 1: Illuminators ON"},
+			{Name: "mode_bitmask", Type: "uint8_t", Enum: "ILLUMINATOR_MODE", Description: "Supported illuminator modes"},
+			{Name: "error_status", Type: "uint32_t", Enum: "ILLUMINATOR_ERROR_FLAGS", Bitmask: true, Description: "Errors"},
+			{Name: "mode", Type: "uint8_t", Enum: "ILLUMINATOR_MODE", Description: "Illuminator mode"},
+			{Name: "brightness", Type: "float", Description: "Illuminator brightness"},
+			{Name: "strobe_period", Type: "float", Description: "Illuminator strobing period in seconds"},
+			{Name: "strobe_duty_cycle", Type: "float", Description: "Illuminator strobing duty cycle"},
+			{Name: "temp_c", Type: "float", Description: "Temperature in Celsius"},
+			{Name: "min_strobe_period", Type: "float", Description: "Minimum strobing period in seconds"},
+			{Name: "max_strobe_period", Type: "float", Description: "Maximum strobing period in seconds"},
+		},
+	},
+	9000: {
+		ID:          9000,
+		Name:        "WHEEL_DISTANCE",
+		Description: "Cumulative distance traveled for each reported wheel.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (synced to UNIX time or since system boot)."},
+			{Name: "count", Type: "uint8_t", Description: "Number of wheels reported."},
+			{Name: "distance", Type: "double", ArrayLength: 16, Description: "Distance reported by individual wheel encoders. Forward rotations increase values, reverse rotations decrease them. Not all wheels will necessarily have wheel encoders; the mapping of encoders to wheel positions must be agreed/understood by the endpoints."},
+		},
+	},
+	9005: {
+		ID:          9005,
+		Name:        "WINCH_STATUS",
+		Description: "Winch status.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (synced to UNIX time or since system boot)."},
+			{Name: "line_length", Type: "float", Description: "Length of line released. NaN if unknown"},
+			{Name: "speed", Type: "float", Description: "Speed line is being released or retracted. Positive values if being released, negative values if being retracted, NaN if unknown"},
+			{Name: "tension", Type: "float", Description: "Tension on the line. NaN if unknown"},
+			{Name: "voltage", Type: "float", Description: "Voltage of the battery supplying the winch. NaN if unknown"},
+			{Name: "current", Type: "float", Description: "Current draw from the winch. NaN if unknown"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature of the motor. INT16_MAX if unknown"},
+			{Name: "status", Type: "uint32_t", Enum: "MAV_WINCH_STATUS_FLAG", Bitmask: true, Description: "Status flags"},
+		},
+	},
+	12900: {
+		ID:          12900,
+		Name:        "OPEN_DRONE_ID_BASIC_ID",
+		Description: "Data for filling the OpenDroneID Basic ID message. This and the below messages are primarily meant for feeding data to/from an OpenDroneID implementation. E.g. https://github.com/opendroneid/opendroneid-core-c. These messages are compatible with the ASTM F3411 Remote ID standard and the ASD-STAN prEN 4709-002 Direct Remote ID standard. Additional information and usage of these messages is documented at https://mavlink.io/en/services/opendroneid.html.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "id_type", Type: "uint8_t", Enum: "MAV_ODID_ID_TYPE", Description: "Indicates the format for the uas_id field of this message."},
+			{Name: "ua_type", Type: "uint8_t", Enum: "MAV_ODID_UA_TYPE", Description: "Indicates the type of UA (Unmanned Aircraft)."},
+			{Name: "uas_id", Type: "uint8_t", ArrayLength: 20, Description: "UAS (Unmanned Aircraft System) ID following the format specified by id_type. Shall be filled with nulls in the unused portion of the field."},
+		},
+	},
+	12901: {
+		ID:          12901,
+		Name:        "OPEN_DRONE_ID_LOCATION",
+		Description: "Data for filling the OpenDroneID Location message. The float data types are 32-bit IEEE 754. The Location message provides the location, altitude, direction and speed of the aircraft.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "status", Type: "uint8_t", Enum: "MAV_ODID_STATUS", Description: "Indicates whether the unmanned aircraft is on the ground or in the air."},
+			{Name: "direction", Type: "uint16_t", Description: "Direction over ground (not heading, but direction of movement) measured clockwise from true North: 0 - 35999 centi-degrees. If unknown: 36100 centi-degrees."},
+			{Name: "speed_horizontal", Type: "uint16_t", Description: "Ground speed. Positive only. If unknown: 25500 cm/s. If speed is larger than 25425 cm/s, use 25425 cm/s."},
+			{Name: "speed_vertical", Type: "int16_t", Description: "The vertical speed. Up is positive. If unknown: 6300 cm/s. If speed is larger than 6200 cm/s, use 6200 cm/s. If lower than -6200 cm/s, use -6200 cm/s."},
+			{Name: "latitude", Type: "int32_t", Description: "Current latitude of the unmanned aircraft. If unknown: 0 (both Lat/Lon)."},
+			{Name: "longitude", Type: "int32_t", Description: "Current longitude of the unmanned aircraft. If unknown: 0 (both Lat/Lon)."},
+			{Name: "altitude_barometric", Type: "float", Description: "The altitude calculated from the barometric pressure. Reference is against 29.92inHg or 1013.2mb. If unknown: -1000 m."},
+			{Name: "altitude_geodetic", Type: "float", Description: "The geodetic altitude as defined by WGS84. If unknown: -1000 m."},
+			{Name: "height_reference", Type: "uint8_t", Enum: "MAV_ODID_HEIGHT_REF", Description: "Indicates the reference point for the height field."},
+			{Name: "height", Type: "float", Description: "The current height of the unmanned aircraft above the take-off location or the ground as indicated by height_reference. If unknown: -1000 m."},
+			{Name: "horizontal_accuracy", Type: "uint8_t", Enum: "MAV_ODID_HOR_ACC", Description: "The accuracy of the horizontal position."},
+			{Name: "vertical_accuracy", Type: "uint8_t", Enum: "MAV_ODID_VER_ACC", Description: "The accuracy of the vertical position."},
+			{Name: "barometer_accuracy", Type: "uint8_t", Enum: "MAV_ODID_VER_ACC", Description: "The accuracy of the barometric altitude."},
+			{Name: "speed_accuracy", Type: "uint8_t", Enum: "MAV_ODID_SPEED_ACC", Description: "The accuracy of the horizontal and vertical speed."},
+			{Name: "timestamp", Type: "float", Description: "Seconds after the full hour with reference to UTC time. Typically the GPS outputs a time-of-week value in milliseconds. First convert that to UTC and then convert for this field using ((float) (time_week_ms % (60*60*1000))) / 1000. If unknown: 0xFFFF."},
+			{Name: "timestamp_accuracy", Type: "uint8_t", Enum: "MAV_ODID_TIME_ACC", Description: "The accuracy of the timestamps."},
+		},
+	},
+	12902: {
+		ID:          12902,
+		Name:        "OPEN_DRONE_ID_AUTHENTICATION",
+		Description: "Data for filling the OpenDroneID Authentication message. The Authentication Message defines a field that can provide a means of authenticity for the identity of the UAS (Unmanned Aircraft System). The Authentication message can have two different formats. For data page 0, the fields PageCount, Length and TimeStamp are present and AuthData is only 17 bytes. For data page 1 through 15, PageCount, Length and TimeStamp are not present and the size of AuthData is 23 bytes.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "authentication_type", Type: "uint8_t", Enum: "MAV_ODID_AUTH_TYPE", Description: "Indicates the type of authentication."},
+			{Name: "data_page", Type: "uint8_t", Description: "Allowed range is 0 - 15."},
+			{Name: "last_page_index", Type: "uint8_t", Description: "This field is only present for page 0. Allowed range is 0 - 15. See the description of struct ODID_Auth_data at https://github.com/opendroneid/opendroneid-core-c/blob/master/libopendroneid/opendroneid.h."},
+			{Name: "length", Type: "uint8_t", Description: "This field is only present for page 0. Total bytes of authentication_data from all data pages. See the description of struct ODID_Auth_data at https://github.com/opendroneid/opendroneid-core-c/blob/master/libopendroneid/opendroneid.h."},
+			{Name: "timestamp", Type: "uint32_t", Description: "This field is only present for page 0. 32 bit Unix Timestamp in seconds since 00:00:00 01/01/2019."},
+			{Name: "authentication_data", Type: "uint8_t", ArrayLength: 23, Description: "Opaque authentication data. For page 0, the size is only 17 bytes. For other pages, the size is 23 bytes. Shall be filled with nulls in the unused portion of the field."},
+		},
+	},
+	12903: {
+		ID:          12903,
+		Name:        "OPEN_DRONE_ID_SELF_ID",
+		Description: "Data for filling the OpenDroneID Self ID message. The Self ID Message is an opportunity for the operator to (optionally) declare their identity and purpose of the flight. This message can provide additional information that could reduce the threat profile of a UA (Unmanned Aircraft) flying in a particular area or manner. This message can also be used to provide optional additional clarification in an emergency/remote ID system failure situation.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "description_type", Type: "uint8_t", Enum: "MAV_ODID_DESC_TYPE", Description: "Indicates the type of the description field."},
+			{Name: "description", Type: "char", ArrayLength: 23, Description: "Text description or numeric value expressed as ASCII characters. Shall be filled with nulls in the unused portion of the field."},
+		},
+	},
+	12904: {
+		ID:          12904,
+		Name:        "OPEN_DRONE_ID_SYSTEM",
+		Description: "Data for filling the OpenDroneID System message. The System Message contains general system information including the operator location/altitude and possible aircraft group and/or category/class information.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "operator_location_type", Type: "uint8_t", Enum: "MAV_ODID_OPERATOR_LOCATION_TYPE", Description: "Specifies the operator location type."},
+			{Name: "classification_type", Type: "uint8_t", Enum: "MAV_ODID_CLASSIFICATION_TYPE", Description: "Specifies the classification type of the UA."},
+			{Name: "operator_latitude", Type: "int32_t", Description: "Latitude of the operator. If unknown: 0 (both Lat/Lon)."},
+			{Name: "operator_longitude", Type: "int32_t", Description: "Longitude of the operator. If unknown: 0 (both Lat/Lon)."},
+			{Name: "area_count", Type: "uint16_t", Description: "Number of aircraft in the area, group or formation (default 1). Used only for swarms/multiple UA."},
+			{Name: "area_radius", Type: "uint16_t", Description: "Radius of the cylindrical area of the group or formation (default 0). Used only for swarms/multiple UA."},
+			{Name: "area_ceiling", Type: "float", Description: "Area Operations Ceiling relative to WGS84. If unknown: -1000 m. Used only for swarms/multiple UA."},
+			{Name: "area_floor", Type: "float", Description: "Area Operations Floor relative to WGS84. If unknown: -1000 m. Used only for swarms/multiple UA."},
+			{Name: "category_eu", Type: "uint8_t", Enum: "MAV_ODID_CATEGORY_EU", Description: "When classification_type is MAV_ODID_CLASSIFICATION_TYPE_EU, specifies the category of the UA."},
+			{Name: "class_eu", Type: "uint8_t", Enum: "MAV_ODID_CLASS_EU", Description: "When classification_type is MAV_ODID_CLASSIFICATION_TYPE_EU, specifies the class of the UA."},
+			{Name: "operator_altitude_geo", Type: "float", Description: "Geodetic altitude of the operator relative to WGS84. If unknown: -1000 m."},
+			{Name: "timestamp", Type: "uint32_t", Description: "32 bit Unix Timestamp in seconds since 00:00:00 01/01/2019."},
+		},
+	},
+	12905: {
+		ID:          12905,
+		Name:        "OPEN_DRONE_ID_OPERATOR_ID",
+		Description: "Data for filling the OpenDroneID Operator ID message, which contains the CAA (Civil Aviation Authority) issued operator ID.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "operator_id_type", Type: "uint8_t", Enum: "MAV_ODID_OPERATOR_ID_TYPE", Description: "Indicates the type of the operator_id field."},
+			{Name: "operator_id", Type: "char", ArrayLength: 20, Description: "Text description or numeric value expressed as ASCII characters. Shall be filled with nulls in the unused portion of the field."},
+		},
+	},
+	12915: {
+		ID:          12915,
+		Name:        "OPEN_DRONE_ID_MESSAGE_PACK",
+		Description: "An OpenDroneID message pack is a container for multiple encoded OpenDroneID messages (i.e. not in the format given for the above message descriptions but after encoding into the compressed OpenDroneID byte format). Used e.g. when transmitting on Bluetooth 5.0 Long Range/Extended Advertising or on WiFi Neighbor Aware Networking or on WiFi Beacon.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "id_or_mac", Type: "uint8_t", ArrayLength: 20, Description: "Only used for drone ID data received from other UAs. See detailed description at https://mavlink.io/en/services/opendroneid.html."},
+			{Name: "single_message_size", Type: "uint8_t", Description: "This field must currently always be equal to 25 (bytes), since all encoded OpenDroneID messages are specified to have this length."},
+			{Name: "msg_pack_size", Type: "uint8_t", Description: "Number of encoded messages in the pack (not the number of bytes). Allowed range is 1 - 9."},
+			{Name: "messages", Type: "uint8_t", ArrayLength: 225, Description: "Concatenation of encoded OpenDroneID messages. Shall be filled with nulls in the unused portion of the field."},
+		},
+	},
+	12918: {
+		ID:          12918,
+		Name:        "OPEN_DRONE_ID_ARM_STATUS",
+		Description: "Transmitter (remote ID system) is enabled and ready to start sending location and other required information. This is streamed by transmitter. A flight controller uses it as a condition to arm.",
+		Fields: []*message.FieldMetadata{
+			{Name: "status", Type: "uint8_t", Enum: "MAV_ODID_ARM_STATUS", Description: "Status level indicating if arming is allowed."},
+			{Name: "error", Type: "char", ArrayLength: 50, Description: "Text error message, should be empty if status is good to arm. Fill with nulls in unused portion."},
+		},
+	},
+	12919: {
+		ID:          12919,
+		Name:        "OPEN_DRONE_ID_SYSTEM_UPDATE",
+		Description: "Update the data in the OPEN_DRONE_ID_SYSTEM message with new location information. This can be sent to update the location information for the operator when no other information in the SYSTEM message has changed. This message allows for efficient operation on radio links which have limited uplink bandwidth while meeting requirements for update frequency of the operator location.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "operator_latitude", Type: "int32_t", Description: "Latitude of the operator. If unknown: 0 (both Lat/Lon)."},
+			{Name: "operator_longitude", Type: "int32_t", Description: "Longitude of the operator. If unknown: 0 (both Lat/Lon)."},
+			{Name: "operator_altitude_geo", Type: "float", Description: "Geodetic altitude of the operator relative to WGS84. If unknown: -1000 m."},
+			{Name: "timestamp", Type: "uint32_t", Description: "32 bit Unix Timestamp in seconds since 00:00:00 01/01/2019."},
+		},
+	},
+	12920: {
+		ID:          12920,
+		Name:        "HYGROMETER_SENSOR",
+		Description: "Temperature and humidity from hygrometer.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Hygrometer ID"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature"},
+			{Name: "humidity", Type: "uint16_t", Description: "Humidity"},
+		},
+	},
+}
@@ -1,5 +1,7 @@
 // Package csairlink contains the csairlink dialect.
 //
+// Definitions hash (SHA256): 31ea7ca208c6fae79bb8c4ecb12d9a242baec058e90c8881747d2554a940a2c3
+//
 //autogenerated:yes
 package csairlink
 
@@ -19,4 +21,5 @@ var dial = &dialect.Dialect{
 		&MessageAirlinkAuth{},
 		&MessageAirlinkAuthResponse{},
 	},
+	Enums: enums,
 }
@@ -16,6 +16,11 @@ const (
 	AIRLINK_AUTH_OK AIRLINK_AUTH_RESPONSE_TYPE = 1
 )
 
+var values_AIRLINK_AUTH_RESPONSE_TYPE = []AIRLINK_AUTH_RESPONSE_TYPE{
+	AIRLINK_ERROR_LOGIN_OR_PASS,
+	AIRLINK_AUTH_OK,
+}
+
 var value_to_label_AIRLINK_AUTH_RESPONSE_TYPE = map[AIRLINK_AUTH_RESPONSE_TYPE]string{
 	AIRLINK_ERROR_LOGIN_OR_PASS: "AIRLINK_ERROR_LOGIN_OR_PASS",
 	AIRLINK_AUTH_OK:             "AIRLINK_AUTH_OK",
@@ -51,3 +56,14 @@ func (e AIRLINK_AUTH_RESPONSE_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (AIRLINK_AUTH_RESPONSE_TYPE) Values() []AIRLINK_AUTH_RESPONSE_TYPE {
+	return append([]AIRLINK_AUTH_RESPONSE_TYPE(nil), values_AIRLINK_AUTH_RESPONSE_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e AIRLINK_AUTH_RESPONSE_TYPE) IsValid() bool {
+	_, ok := value_to_label_AIRLINK_AUTH_RESPONSE_TYPE[e]
+	return ok
+}
@@ -0,0 +1,19 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package csairlink
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{
+	"AIRLINK_AUTH_RESPONSE_TYPE": {
+		Name:        "AIRLINK_AUTH_RESPONSE_TYPE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "AIRLINK_ERROR_LOGIN_OR_PASS", Value: 0, Description: "Login or password error"},
+			{Name: "AIRLINK_AUTH_OK", Value: 1, Description: "Auth successful"},
+		},
+	},
+}
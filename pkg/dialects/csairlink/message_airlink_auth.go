@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package csairlink
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Authorization package
 type MessageAirlinkAuth struct {
 	// Login
@@ -14,3 +20,41 @@ type MessageAirlinkAuth struct {
 func (*MessageAirlinkAuth) GetID() uint32 {
 	return 52000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAirlinkAuth) MAVLinkCRCExtra() byte {
+	return 13
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAirlinkAuth) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 100
+	}
+	return 100
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirlinkAuth) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:50], m.Login)
+	copy(buf[50:100], m.Password)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirlinkAuth) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:50], 0); n >= 0 {
+		m.Login = string(buf[0 : 0+n])
+	} else {
+		m.Login = string(buf[0:50])
+	}
+	if n := bytes.IndexByte(buf[50:100], 0); n >= 0 {
+		m.Password = string(buf[50 : 50+n])
+	} else {
+		m.Password = string(buf[50:100])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAirlinkAuth) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52000]
+}
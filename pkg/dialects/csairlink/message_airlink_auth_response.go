@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package csairlink
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Response to the authorization request
 type MessageAirlinkAuthResponse struct {
 	// Response type
@@ -12,3 +16,31 @@ type MessageAirlinkAuthResponse struct {
 func (*MessageAirlinkAuthResponse) GetID() uint32 {
 	return 52001
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAirlinkAuthResponse) MAVLinkCRCExtra() byte {
+	return 239
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAirlinkAuthResponse) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 1
+	}
+	return 1
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirlinkAuthResponse) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.RespType)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAirlinkAuthResponse) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RespType = AIRLINK_AUTH_RESPONSE_TYPE(buf[0])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAirlinkAuthResponse) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52001]
+}
@@ -0,0 +1,28 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package csairlink
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	52000: {
+		ID:          52000,
+		Name:        "AIRLINK_AUTH",
+		Description: "Authorization package",
+		Fields: []*message.FieldMetadata{
+			{Name: "login", Type: "char", ArrayLength: 50, Description: "Login"},
+			{Name: "password", Type: "char", ArrayLength: 50, Description: "Password"},
+		},
+	},
+	52001: {
+		ID:          52001,
+		Name:        "AIRLINK_AUTH_RESPONSE",
+		Description: "Response to the authorization request",
+		Fields: []*message.FieldMetadata{
+			{Name: "resp_type", Type: "uint8_t", Enum: "AIRLINK_AUTH_RESPONSE_TYPE", Description: "Response type"},
+		},
+	},
+}
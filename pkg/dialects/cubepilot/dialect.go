@@ -1,5 +1,7 @@
 // Package cubepilot contains the cubepilot dialect.
 //
+// Definitions hash (SHA256): 2409b227bd5e511695fe2ca517a28e0f5f81b34ad0c9b1577c07534fe5092d48
+//
 //autogenerated:yes
 package cubepilot
 
@@ -259,4 +261,5 @@ var dial = &dialect.Dialect{
 		&MessageCubepilotFirmwareUpdateStart{},
 		&MessageCubepilotFirmwareUpdateResp{},
 	},
+	Enums: enums,
 }
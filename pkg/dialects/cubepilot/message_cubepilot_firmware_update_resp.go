@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package cubepilot
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // offset response to encapsulated data.
 type MessageCubepilotFirmwareUpdateResp struct {
 	// System ID.
@@ -16,3 +22,35 @@ type MessageCubepilotFirmwareUpdateResp struct {
 func (*MessageCubepilotFirmwareUpdateResp) GetID() uint32 {
 	return 50005
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCubepilotFirmwareUpdateResp) MAVLinkCRCExtra() byte {
+	return 152
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCubepilotFirmwareUpdateResp) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 6
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotFirmwareUpdateResp) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Offset)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotFirmwareUpdateResp) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Offset = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCubepilotFirmwareUpdateResp) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50005]
+}
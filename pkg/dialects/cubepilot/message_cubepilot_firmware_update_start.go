@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package cubepilot
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Start firmware update with encapsulated data.
 type MessageCubepilotFirmwareUpdateStart struct {
 	// System ID.
@@ -18,3 +24,37 @@ type MessageCubepilotFirmwareUpdateStart struct {
 func (*MessageCubepilotFirmwareUpdateStart) GetID() uint32 {
 	return 50004
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCubepilotFirmwareUpdateStart) MAVLinkCRCExtra() byte {
+	return 240
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCubepilotFirmwareUpdateStart) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 10
+	}
+	return 10
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotFirmwareUpdateStart) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Size)
+	binary.LittleEndian.PutUint32(buf[4:], m.Crc)
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotFirmwareUpdateStart) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Size = binary.LittleEndian.Uint32(buf[0:])
+	m.Crc = binary.LittleEndian.Uint32(buf[4:])
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCubepilotFirmwareUpdateStart) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50004]
+}
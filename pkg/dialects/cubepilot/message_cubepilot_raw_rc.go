@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package cubepilot
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Raw RC Data
 type MessageCubepilotRawRc struct {
 	RcRaw [32]uint8
@@ -11,3 +15,35 @@ type MessageCubepilotRawRc struct {
 func (*MessageCubepilotRawRc) GetID() uint32 {
 	return 50001
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageCubepilotRawRc) MAVLinkCRCExtra() byte {
+	return 246
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageCubepilotRawRc) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 32
+	}
+	return 32
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotRawRc) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.RcRaw {
+		buf[i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageCubepilotRawRc) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.RcRaw {
+		m.RcRaw[i] = buf[i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageCubepilotRawRc) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50001]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package cubepilot
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Herelink Telemetry
 type MessageHerelinkTelem struct {
 	Rssi      uint8
@@ -17,3 +23,43 @@ type MessageHerelinkTelem struct {
 func (*MessageHerelinkTelem) GetID() uint32 {
 	return 50003
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHerelinkTelem) MAVLinkCRCExtra() byte {
+	return 62
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHerelinkTelem) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 19
+	}
+	return 19
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHerelinkTelem) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.RfFreq)
+	binary.LittleEndian.PutUint32(buf[4:], m.LinkBw)
+	binary.LittleEndian.PutUint32(buf[8:], m.LinkRate)
+	binary.LittleEndian.PutUint16(buf[12:], uint16(m.Snr))
+	binary.LittleEndian.PutUint16(buf[14:], uint16(m.CpuTemp))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.BoardTemp))
+	buf[18] = m.Rssi
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHerelinkTelem) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.RfFreq = binary.LittleEndian.Uint32(buf[0:])
+	m.LinkBw = binary.LittleEndian.Uint32(buf[4:])
+	m.LinkRate = binary.LittleEndian.Uint32(buf[8:])
+	m.Snr = int16(binary.LittleEndian.Uint16(buf[12:]))
+	m.CpuTemp = int16(binary.LittleEndian.Uint16(buf[14:]))
+	m.BoardTemp = int16(binary.LittleEndian.Uint16(buf[16:]))
+	m.Rssi = buf[18]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHerelinkTelem) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50003]
+}
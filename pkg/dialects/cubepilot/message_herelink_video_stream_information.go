@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package cubepilot
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about video stream
 type MessageHerelinkVideoStreamInformation struct {
 	// Video Stream ID (1 for first, 2 for second, etc.)
@@ -26,3 +34,49 @@ type MessageHerelinkVideoStreamInformation struct {
 func (*MessageHerelinkVideoStreamInformation) GetID() uint32 {
 	return 50002
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHerelinkVideoStreamInformation) MAVLinkCRCExtra() byte {
+	return 181
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHerelinkVideoStreamInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 246
+	}
+	return 246
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHerelinkVideoStreamInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Framerate))
+	binary.LittleEndian.PutUint32(buf[4:], m.Bitrate)
+	binary.LittleEndian.PutUint16(buf[8:], m.ResolutionH)
+	binary.LittleEndian.PutUint16(buf[10:], m.ResolutionV)
+	binary.LittleEndian.PutUint16(buf[12:], m.Rotation)
+	buf[14] = m.CameraId
+	buf[15] = m.Status
+	copy(buf[16:246], m.Uri)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHerelinkVideoStreamInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Framerate = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Bitrate = binary.LittleEndian.Uint32(buf[4:])
+	m.ResolutionH = binary.LittleEndian.Uint16(buf[8:])
+	m.ResolutionV = binary.LittleEndian.Uint16(buf[10:])
+	m.Rotation = binary.LittleEndian.Uint16(buf[12:])
+	m.CameraId = buf[14]
+	m.Status = buf[15]
+	if n := bytes.IndexByte(buf[16:246], 0); n >= 0 {
+		m.Uri = string(buf[16 : 16+n])
+	} else {
+		m.Uri = string(buf[16:246])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHerelinkVideoStreamInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[50002]
+}
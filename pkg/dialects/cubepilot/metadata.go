@@ -0,0 +1,69 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package cubepilot
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	50001: {
+		ID:          50001,
+		Name:        "CUBEPILOT_RAW_RC",
+		Description: "Raw RC Data",
+		Fields: []*message.FieldMetadata{
+			{Name: "rc_raw", Type: "uint8_t", ArrayLength: 32},
+		},
+	},
+	50002: {
+		ID:          50002,
+		Name:        "HERELINK_VIDEO_STREAM_INFORMATION",
+		Description: "Information about video stream",
+		Fields: []*message.FieldMetadata{
+			{Name: "camera_id", Type: "uint8_t", Description: "Video Stream ID (1 for first, 2 for second, etc.)"},
+			{Name: "status", Type: "uint8_t", Description: "Number of streams available."},
+			{Name: "framerate", Type: "float", Description: "Frame rate."},
+			{Name: "resolution_h", Type: "uint16_t", Description: "Horizontal resolution."},
+			{Name: "resolution_v", Type: "uint16_t", Description: "Vertical resolution."},
+			{Name: "bitrate", Type: "uint32_t", Description: "Bit rate."},
+			{Name: "rotation", Type: "uint16_t", Description: "Video image rotation clockwise."},
+			{Name: "uri", Type: "char", ArrayLength: 230, Description: "Video stream URI (TCP or RTSP URI ground station should connect to) or port number (UDP port ground station should listen to)."},
+		},
+	},
+	50003: {
+		ID:          50003,
+		Name:        "HERELINK_TELEM",
+		Description: "Herelink Telemetry",
+		Fields: []*message.FieldMetadata{
+			{Name: "rssi", Type: "uint8_t"},
+			{Name: "snr", Type: "int16_t"},
+			{Name: "rf_freq", Type: "uint32_t"},
+			{Name: "link_bw", Type: "uint32_t"},
+			{Name: "link_rate", Type: "uint32_t"},
+			{Name: "cpu_temp", Type: "int16_t"},
+			{Name: "board_temp", Type: "int16_t"},
+		},
+	},
+	50004: {
+		ID:          50004,
+		Name:        "CUBEPILOT_FIRMWARE_UPDATE_START",
+		Description: "Start firmware update with encapsulated data.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "size", Type: "uint32_t", Description: "FW Size."},
+			{Name: "crc", Type: "uint32_t", Description: "FW CRC."},
+		},
+	},
+	50005: {
+		ID:          50005,
+		Name:        "CUBEPILOT_FIRMWARE_UPDATE_RESP",
+		Description: "offset response to encapsulated data.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "offset", Type: "uint32_t", Description: "FW Offset."},
+		},
+	},
+}
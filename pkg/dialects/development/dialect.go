@@ -1,5 +1,7 @@
 // Package development contains the development dialect.
 //
+// Definitions hash (SHA256): ed9aebc3756dd46b531ae56f234e197e33ae61e519c4450c02fd6b7e99283e0b
+//
 //autogenerated:yes
 package development
 
@@ -272,4 +274,5 @@ var dial = &dialect.Dialect{
 		&MessageDistanceSensorInfo{},
 		&MessageDistanceSensorV2{},
 	},
+	Enums: enums,
 }
@@ -27,6 +27,16 @@ const (
 	ACTUATOR_TEST_GROUP_Z_THRUST ACTUATOR_TEST_GROUP = 6
 )
 
+var values_ACTUATOR_TEST_GROUP = []ACTUATOR_TEST_GROUP{
+	ACTUATOR_TEST_GROUP_ROLL_TORQUE,
+	ACTUATOR_TEST_GROUP_PITCH_TORQUE,
+	ACTUATOR_TEST_GROUP_YAW_TORQUE,
+	ACTUATOR_TEST_GROUP_COLLECTIVE_TILT,
+	ACTUATOR_TEST_GROUP_X_THRUST,
+	ACTUATOR_TEST_GROUP_Y_THRUST,
+	ACTUATOR_TEST_GROUP_Z_THRUST,
+}
+
 var value_to_label_ACTUATOR_TEST_GROUP = map[ACTUATOR_TEST_GROUP]string{
 	ACTUATOR_TEST_GROUP_ROLL_TORQUE:     "ACTUATOR_TEST_GROUP_ROLL_TORQUE",
 	ACTUATOR_TEST_GROUP_PITCH_TORQUE:    "ACTUATOR_TEST_GROUP_PITCH_TORQUE",
@@ -72,3 +82,14 @@ func (e ACTUATOR_TEST_GROUP) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ACTUATOR_TEST_GROUP) Values() []ACTUATOR_TEST_GROUP {
+	return append([]ACTUATOR_TEST_GROUP(nil), values_ACTUATOR_TEST_GROUP...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ACTUATOR_TEST_GROUP) IsValid() bool {
+	_, ok := value_to_label_ACTUATOR_TEST_GROUP[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	ESC_FIRMWARE_BLHELI32 ESC_FIRMWARE = 3
 )
 
+var values_ESC_FIRMWARE = []ESC_FIRMWARE{
+	ESC_FIRMWARE_UNKNOWN,
+	ESC_FIRMWARE_AM32,
+	ESC_FIRMWARE_BLUEJAY,
+	ESC_FIRMWARE_BLHELI32,
+}
+
 var value_to_label_ESC_FIRMWARE = map[ESC_FIRMWARE]string{
 	ESC_FIRMWARE_UNKNOWN:  "ESC_FIRMWARE_UNKNOWN",
 	ESC_FIRMWARE_AM32:     "ESC_FIRMWARE_AM32",
@@ -60,3 +67,14 @@ func (e ESC_FIRMWARE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ESC_FIRMWARE) Values() []ESC_FIRMWARE {
+	return append([]ESC_FIRMWARE(nil), values_ESC_FIRMWARE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ESC_FIRMWARE) IsValid() bool {
+	_, ok := value_to_label_ESC_FIRMWARE[e]
+	return ok
+}
@@ -31,6 +31,18 @@ const (
 	ESTIMATOR_SENSOR_FUSION_SOURCE_RANGING_BEACON ESTIMATOR_SENSOR_FUSION_SOURCE = 8
 )
 
+var values_ESTIMATOR_SENSOR_FUSION_SOURCE = []ESTIMATOR_SENSOR_FUSION_SOURCE{
+	ESTIMATOR_SENSOR_FUSION_SOURCE_GPS,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_OF,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_EV,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_AGP,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_BARO,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_RNG,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_MAG,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_ASPD,
+	ESTIMATOR_SENSOR_FUSION_SOURCE_RANGING_BEACON,
+}
+
 var value_to_label_ESTIMATOR_SENSOR_FUSION_SOURCE = map[ESTIMATOR_SENSOR_FUSION_SOURCE]string{
 	ESTIMATOR_SENSOR_FUSION_SOURCE_GPS:            "ESTIMATOR_SENSOR_FUSION_SOURCE_GPS",
 	ESTIMATOR_SENSOR_FUSION_SOURCE_OF:             "ESTIMATOR_SENSOR_FUSION_SOURCE_OF",
@@ -80,3 +92,14 @@ func (e ESTIMATOR_SENSOR_FUSION_SOURCE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ESTIMATOR_SENSOR_FUSION_SOURCE) Values() []ESTIMATOR_SENSOR_FUSION_SOURCE {
+	return append([]ESTIMATOR_SENSOR_FUSION_SOURCE(nil), values_ESTIMATOR_SENSOR_FUSION_SOURCE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ESTIMATOR_SENSOR_FUSION_SOURCE) IsValid() bool {
+	_, ok := value_to_label_ESTIMATOR_SENSOR_FUSION_SOURCE[e]
+	return ok
+}
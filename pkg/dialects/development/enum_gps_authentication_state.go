@@ -23,6 +23,14 @@ const (
 	GPS_AUTHENTICATION_STATE_DISABLED GPS_AUTHENTICATION_STATE = 4
 )
 
+var values_GPS_AUTHENTICATION_STATE = []GPS_AUTHENTICATION_STATE{
+	GPS_AUTHENTICATION_STATE_UNKNOWN,
+	GPS_AUTHENTICATION_STATE_INITIALIZING,
+	GPS_AUTHENTICATION_STATE_ERROR,
+	GPS_AUTHENTICATION_STATE_OK,
+	GPS_AUTHENTICATION_STATE_DISABLED,
+}
+
 var value_to_label_GPS_AUTHENTICATION_STATE = map[GPS_AUTHENTICATION_STATE]string{
 	GPS_AUTHENTICATION_STATE_UNKNOWN:      "GPS_AUTHENTICATION_STATE_UNKNOWN",
 	GPS_AUTHENTICATION_STATE_INITIALIZING: "GPS_AUTHENTICATION_STATE_INITIALIZING",
@@ -64,3 +72,14 @@ func (e GPS_AUTHENTICATION_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_AUTHENTICATION_STATE) Values() []GPS_AUTHENTICATION_STATE {
+	return append([]GPS_AUTHENTICATION_STATE(nil), values_GPS_AUTHENTICATION_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GPS_AUTHENTICATION_STATE) IsValid() bool {
+	_, ok := value_to_label_GPS_AUTHENTICATION_STATE[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	GPS_JAMMING_STATE_DETECTED GPS_JAMMING_STATE = 3
 )
 
+var values_GPS_JAMMING_STATE = []GPS_JAMMING_STATE{
+	GPS_JAMMING_STATE_UNKNOWN,
+	GPS_JAMMING_STATE_NOT_JAMMED,
+	GPS_JAMMING_STATE_MITIGATED,
+	GPS_JAMMING_STATE_DETECTED,
+}
+
 var value_to_label_GPS_JAMMING_STATE = map[GPS_JAMMING_STATE]string{
 	GPS_JAMMING_STATE_UNKNOWN:    "GPS_JAMMING_STATE_UNKNOWN",
 	GPS_JAMMING_STATE_NOT_JAMMED: "GPS_JAMMING_STATE_NOT_JAMMED",
@@ -60,3 +67,14 @@ func (e GPS_JAMMING_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_JAMMING_STATE) Values() []GPS_JAMMING_STATE {
+	return append([]GPS_JAMMING_STATE(nil), values_GPS_JAMMING_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GPS_JAMMING_STATE) IsValid() bool {
+	_, ok := value_to_label_GPS_JAMMING_STATE[e]
+	return ok
+}
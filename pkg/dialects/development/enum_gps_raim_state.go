@@ -21,6 +21,13 @@ const (
 	GPS_RAIM_STATE_FAILED GPS_RAIM_STATE = 3
 )
 
+var values_GPS_RAIM_STATE = []GPS_RAIM_STATE{
+	GPS_RAIM_STATE_UNKNOWN,
+	GPS_RAIM_STATE_DISABLED,
+	GPS_RAIM_STATE_OK,
+	GPS_RAIM_STATE_FAILED,
+}
+
 var value_to_label_GPS_RAIM_STATE = map[GPS_RAIM_STATE]string{
 	GPS_RAIM_STATE_UNKNOWN:  "GPS_RAIM_STATE_UNKNOWN",
 	GPS_RAIM_STATE_DISABLED: "GPS_RAIM_STATE_DISABLED",
@@ -60,3 +67,14 @@ func (e GPS_RAIM_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_RAIM_STATE) Values() []GPS_RAIM_STATE {
+	return append([]GPS_RAIM_STATE(nil), values_GPS_RAIM_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GPS_RAIM_STATE) IsValid() bool {
+	_, ok := value_to_label_GPS_RAIM_STATE[e]
+	return ok
+}
@@ -21,6 +21,13 @@ const (
 	GPS_SPOOFING_STATE_DETECTED GPS_SPOOFING_STATE = 3
 )
 
+var values_GPS_SPOOFING_STATE = []GPS_SPOOFING_STATE{
+	GPS_SPOOFING_STATE_UNKNOWN,
+	GPS_SPOOFING_STATE_NOT_SPOOFED,
+	GPS_SPOOFING_STATE_MITIGATED,
+	GPS_SPOOFING_STATE_DETECTED,
+}
+
 var value_to_label_GPS_SPOOFING_STATE = map[GPS_SPOOFING_STATE]string{
 	GPS_SPOOFING_STATE_UNKNOWN:     "GPS_SPOOFING_STATE_UNKNOWN",
 	GPS_SPOOFING_STATE_NOT_SPOOFED: "GPS_SPOOFING_STATE_NOT_SPOOFED",
@@ -60,3 +67,14 @@ func (e GPS_SPOOFING_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_SPOOFING_STATE) Values() []GPS_SPOOFING_STATE {
+	return append([]GPS_SPOOFING_STATE(nil), values_GPS_SPOOFING_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e GPS_SPOOFING_STATE) IsValid() bool {
+	_, ok := value_to_label_GPS_SPOOFING_STATE[e]
+	return ok
+}
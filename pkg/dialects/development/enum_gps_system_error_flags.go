@@ -94,3 +94,33 @@ func (e GPS_SYSTEM_ERROR_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (GPS_SYSTEM_ERROR_FLAGS) Values() []GPS_SYSTEM_ERROR_FLAGS {
+	return append([]GPS_SYSTEM_ERROR_FLAGS(nil), values_GPS_SYSTEM_ERROR_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e GPS_SYSTEM_ERROR_FLAGS) IsValid() bool {
+	var mask GPS_SYSTEM_ERROR_FLAGS
+	for _, val := range values_GPS_SYSTEM_ERROR_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e GPS_SYSTEM_ERROR_FLAGS) Flags() []GPS_SYSTEM_ERROR_FLAGS {
+	var flags []GPS_SYSTEM_ERROR_FLAGS
+	for _, val := range values_GPS_SYSTEM_ERROR_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e GPS_SYSTEM_ERROR_FLAGS) Has(flags GPS_SYSTEM_ERROR_FLAGS) bool {
+	return e&flags == flags
+}
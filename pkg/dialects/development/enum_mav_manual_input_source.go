@@ -21,6 +21,13 @@ const (
 	MAV_MANUAL_INPUT_SOURCE_MIXED MAV_MANUAL_INPUT_SOURCE = 3
 )
 
+var values_MAV_MANUAL_INPUT_SOURCE = []MAV_MANUAL_INPUT_SOURCE{
+	MAV_MANUAL_INPUT_SOURCE_NONE,
+	MAV_MANUAL_INPUT_SOURCE_RC,
+	MAV_MANUAL_INPUT_SOURCE_MAVLINK,
+	MAV_MANUAL_INPUT_SOURCE_MIXED,
+}
+
 var value_to_label_MAV_MANUAL_INPUT_SOURCE = map[MAV_MANUAL_INPUT_SOURCE]string{
 	MAV_MANUAL_INPUT_SOURCE_NONE:    "MAV_MANUAL_INPUT_SOURCE_NONE",
 	MAV_MANUAL_INPUT_SOURCE_RC:      "MAV_MANUAL_INPUT_SOURCE_RC",
@@ -60,3 +67,14 @@ func (e MAV_MANUAL_INPUT_SOURCE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MANUAL_INPUT_SOURCE) Values() []MAV_MANUAL_INPUT_SOURCE {
+	return append([]MAV_MANUAL_INPUT_SOURCE(nil), values_MAV_MANUAL_INPUT_SOURCE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_MANUAL_INPUT_SOURCE) IsValid() bool {
+	_, ok := value_to_label_MAV_MANUAL_INPUT_SOURCE[e]
+	return ok
+}
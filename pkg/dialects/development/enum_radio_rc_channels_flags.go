@@ -69,3 +69,33 @@ func (e RADIO_RC_CHANNELS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RADIO_RC_CHANNELS_FLAGS) Values() []RADIO_RC_CHANNELS_FLAGS {
+	return append([]RADIO_RC_CHANNELS_FLAGS(nil), values_RADIO_RC_CHANNELS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e RADIO_RC_CHANNELS_FLAGS) IsValid() bool {
+	var mask RADIO_RC_CHANNELS_FLAGS
+	for _, val := range values_RADIO_RC_CHANNELS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e RADIO_RC_CHANNELS_FLAGS) Flags() []RADIO_RC_CHANNELS_FLAGS {
+	var flags []RADIO_RC_CHANNELS_FLAGS
+	for _, val := range values_RADIO_RC_CHANNELS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e RADIO_RC_CHANNELS_FLAGS) Has(flags RADIO_RC_CHANNELS_FLAGS) bool {
+	return e&flags == flags
+}
@@ -17,6 +17,11 @@ const (
 	RANGING_BEACON_ALT_TYPE_MSL RANGING_BEACON_ALT_TYPE = 1
 )
 
+var values_RANGING_BEACON_ALT_TYPE = []RANGING_BEACON_ALT_TYPE{
+	RANGING_BEACON_ALT_TYPE_WGS84,
+	RANGING_BEACON_ALT_TYPE_MSL,
+}
+
 var value_to_label_RANGING_BEACON_ALT_TYPE = map[RANGING_BEACON_ALT_TYPE]string{
 	RANGING_BEACON_ALT_TYPE_WGS84: "RANGING_BEACON_ALT_TYPE_WGS84",
 	RANGING_BEACON_ALT_TYPE_MSL:   "RANGING_BEACON_ALT_TYPE_MSL",
@@ -52,3 +57,14 @@ func (e RANGING_BEACON_ALT_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RANGING_BEACON_ALT_TYPE) Values() []RANGING_BEACON_ALT_TYPE {
+	return append([]RANGING_BEACON_ALT_TYPE(nil), values_RANGING_BEACON_ALT_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e RANGING_BEACON_ALT_TYPE) IsValid() bool {
+	_, ok := value_to_label_RANGING_BEACON_ALT_TYPE[e]
+	return ok
+}
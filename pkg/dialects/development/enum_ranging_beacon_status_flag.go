@@ -64,3 +64,33 @@ func (e RANGING_BEACON_STATUS_FLAG) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (RANGING_BEACON_STATUS_FLAG) Values() []RANGING_BEACON_STATUS_FLAG {
+	return append([]RANGING_BEACON_STATUS_FLAG(nil), values_RANGING_BEACON_STATUS_FLAG...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e RANGING_BEACON_STATUS_FLAG) IsValid() bool {
+	var mask RANGING_BEACON_STATUS_FLAG
+	for _, val := range values_RANGING_BEACON_STATUS_FLAG {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e RANGING_BEACON_STATUS_FLAG) Flags() []RANGING_BEACON_STATUS_FLAG {
+	var flags []RANGING_BEACON_STATUS_FLAG
+	for _, val := range values_RANGING_BEACON_STATUS_FLAG {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e RANGING_BEACON_STATUS_FLAG) Has(flags RANGING_BEACON_STATUS_FLAG) bool {
+	return e&flags == flags
+}
@@ -79,3 +79,33 @@ func (e TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) Values() []TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS {
+	return append([]TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS(nil), values_TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) IsValid() bool {
+	var mask TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS
+	for _, val := range values_TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) Flags() []TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS {
+	var flags []TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS
+	for _, val := range values_TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) Has(flags TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS) bool {
+	return e&flags == flags
+}
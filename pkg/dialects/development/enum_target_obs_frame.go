@@ -21,6 +21,13 @@ const (
 	TARGET_OBS_FRAME_OTHER TARGET_OBS_FRAME = 3
 )
 
+var values_TARGET_OBS_FRAME = []TARGET_OBS_FRAME{
+	TARGET_OBS_FRAME_LOCAL_NED,
+	TARGET_OBS_FRAME_BODY_FRD,
+	TARGET_OBS_FRAME_LOCAL_OFFSET_NED,
+	TARGET_OBS_FRAME_OTHER,
+}
+
 var value_to_label_TARGET_OBS_FRAME = map[TARGET_OBS_FRAME]string{
 	TARGET_OBS_FRAME_LOCAL_NED:        "TARGET_OBS_FRAME_LOCAL_NED",
 	TARGET_OBS_FRAME_BODY_FRD:         "TARGET_OBS_FRAME_BODY_FRD",
@@ -60,3 +67,14 @@ func (e TARGET_OBS_FRAME) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (TARGET_OBS_FRAME) Values() []TARGET_OBS_FRAME {
+	return append([]TARGET_OBS_FRAME(nil), values_TARGET_OBS_FRAME...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e TARGET_OBS_FRAME) IsValid() bool {
+	_, ok := value_to_label_TARGET_OBS_FRAME[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Battery dynamic information.
 // This should be streamed (nominally at 1Hz).
 // Static/invariant battery information is sent in BATTERY_INFO.
@@ -30,3 +37,45 @@ type MessageBatteryStatusV2 struct {
 func (*MessageBatteryStatusV2) GetID() uint32 {
 	return 369
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageBatteryStatusV2) MAVLinkCRCExtra() byte {
+	return 145
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageBatteryStatusV2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 27
+	}
+	return 27
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryStatusV2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Voltage))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Current))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.CapacityConsumed))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.CapacityRemaining))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.StateOfCharge))
+	binary.LittleEndian.PutUint32(buf[20:], uint32(m.StatusFlags))
+	binary.LittleEndian.PutUint16(buf[24:], uint16(m.Temperature))
+	buf[26] = m.Id
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageBatteryStatusV2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Voltage = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Current = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.CapacityConsumed = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.CapacityRemaining = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.StateOfCharge = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.StatusFlags = MAV_BATTERY_STATUS_FLAGS(binary.LittleEndian.Uint32(buf[20:]))
+	m.Temperature = int16(binary.LittleEndian.Uint16(buf[24:]))
+	m.Id = buf[26]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageBatteryStatusV2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[369]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about GCS(s) in control of this MAV.
 // This should be broadcast at low rate (nominally 1 Hz) and emitted when ownership or takeover status change.
 // Components in the system should only accept "state changing commands/messages" from any system id in `gcs_main` or  `gcs_secondary`.
@@ -25,3 +29,39 @@ type MessageControlStatus struct {
 func (*MessageControlStatus) GetID() uint32 {
 	return 512
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageControlStatus) MAVLinkCRCExtra() byte {
+	return 21
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageControlStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageControlStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Flags)
+	buf[1] = m.GcsMain
+	for i, v := range m.GcsSecondary {
+		buf[2+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageControlStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Flags = GCS_CONTROL_STATUS_FLAGS(buf[0])
+	m.GcsMain = buf[1]
+	for i := range m.GcsSecondary {
+		m.GcsSecondary[i] = buf[2+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageControlStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[512]
+}
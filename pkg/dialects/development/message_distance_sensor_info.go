@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Static characteristics of a distance sensor (onboard rangefinder).
 // Measurements are sent in DISTANCE_SENSOR_V2.
 type MessageDistanceSensorInfo struct {
@@ -27,3 +34,55 @@ type MessageDistanceSensorInfo struct {
 func (*MessageDistanceSensorInfo) GetID() uint32 {
 	return 516
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDistanceSensorInfo) MAVLinkCRCExtra() byte {
+	return 125
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDistanceSensorInfo) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 35
+	}
+	return 19
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensorInfo) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.MinDistance))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.MaxDistance))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.HorizontalFov))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.VerticalFov))
+	buf[16] = m.Id
+	buf[17] = byte(m.Type)
+	buf[18] = byte(m.Orientation)
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Quaternion {
+		binary.LittleEndian.PutUint32(buf[19+i*4:], math.Float32bits(v))
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensorInfo) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.MinDistance = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.MaxDistance = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.HorizontalFov = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.VerticalFov = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Id = buf[16]
+	m.Type = MAV_DISTANCE_SENSOR(buf[17])
+	m.Orientation = MAV_SENSOR_ORIENTATION(buf[18])
+	if !isV2 {
+		return
+	}
+	for i := range m.Quaternion {
+		m.Quaternion[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[19+i*4:]))
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDistanceSensorInfo) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[516]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Distance sensor measurement, for an onboard rangefinder described by DISTANCE_SENSOR_INFO. Streamed at the sensor update rate.
 type MessageDistanceSensorV2 struct {
 	// Timestamp (time since system boot)
@@ -18,3 +25,37 @@ type MessageDistanceSensorV2 struct {
 func (*MessageDistanceSensorV2) GetID() uint32 {
 	return 517
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageDistanceSensorV2) MAVLinkCRCExtra() byte {
+	return 126
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageDistanceSensorV2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 10
+	}
+	return 10
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensorV2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Distance))
+	buf[8] = m.Id
+	buf[9] = byte(m.SignalQuality)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageDistanceSensorV2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Distance = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Id = buf[8]
+	m.SignalQuality = int8(buf[9])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageDistanceSensorV2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[517]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // EFI performance and health telemetry. Primarily intended for debugging EFI system faults to determine whether problems are within the engine, fuel pump, actuators, or the ECU. Nominal stream rate is 1 Hz. Core engine telemetry (RPM, temperatures, pressures, fuel flow) is carried in EFI_STATUS; this message carries the electrical/actuator/diagnostic data not present there.
 type MessageEfiPerformance struct {
 	// Electronic Control Unit (ECU) index for systems with multiple EFI units. Set to 0 for the first (or only) ECU.
@@ -50,3 +57,69 @@ type MessageEfiPerformance struct {
 func (*MessageEfiPerformance) GetID() uint32 {
 	return 442
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEfiPerformance) MAVLinkCRCExtra() byte {
+	return 189
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEfiPerformance) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 49
+	}
+	return 49
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEfiPerformance) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], uint64(m.StatusFlags))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.FuelMassRate))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.CommandedRpm))
+	binary.LittleEndian.PutUint32(buf[16:], m.EngineRuntime)
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.BoostPressure))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.CoolantPressure))
+	binary.LittleEndian.PutUint16(buf[28:], m.FuelPumpVoltage)
+	binary.LittleEndian.PutUint16(buf[30:], m.FuelPumpCurrent)
+	binary.LittleEndian.PutUint16(buf[32:], m.EcuSupplyVoltage)
+	binary.LittleEndian.PutUint16(buf[34:], m.EcuCurrent)
+	binary.LittleEndian.PutUint16(buf[36:], uint16(m.EcuTemperature))
+	binary.LittleEndian.PutUint16(buf[38:], m.ServoSupplyVoltage)
+	binary.LittleEndian.PutUint16(buf[40:], uint16(m.EngineTorque))
+	buf[42] = m.EcuIndex
+	buf[43] = m.FuelPumpDutyCycle
+	buf[44] = m.EcuCpuLoad
+	buf[45] = m.ServoOutputLevel
+	buf[46] = m.WaterPumpDutyCycle
+	buf[47] = m.InjectorDutyCycle
+	buf[48] = m.ErrorMemoryCount
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEfiPerformance) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.StatusFlags = EFI_PERFORMANCE_STATUS_FLAGS(binary.LittleEndian.Uint64(buf[0:]))
+	m.FuelMassRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.CommandedRpm = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.EngineRuntime = binary.LittleEndian.Uint32(buf[16:])
+	m.BoostPressure = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.CoolantPressure = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.FuelPumpVoltage = binary.LittleEndian.Uint16(buf[28:])
+	m.FuelPumpCurrent = binary.LittleEndian.Uint16(buf[30:])
+	m.EcuSupplyVoltage = binary.LittleEndian.Uint16(buf[32:])
+	m.EcuCurrent = binary.LittleEndian.Uint16(buf[34:])
+	m.EcuTemperature = int16(binary.LittleEndian.Uint16(buf[36:]))
+	m.ServoSupplyVoltage = binary.LittleEndian.Uint16(buf[38:])
+	m.EngineTorque = int16(binary.LittleEndian.Uint16(buf[40:]))
+	m.EcuIndex = buf[42]
+	m.FuelPumpDutyCycle = buf[43]
+	m.EcuCpuLoad = buf[44]
+	m.ServoOutputLevel = buf[45]
+	m.WaterPumpDutyCycle = buf[46]
+	m.InjectorDutyCycle = buf[47]
+	m.ErrorMemoryCount = buf[48]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEfiPerformance) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[442]
+}
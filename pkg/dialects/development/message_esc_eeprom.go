@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ESC EEPROM data message for reading and writing ESC configuration.
 // Supports multiple ESC firmware types including AM32, Bluejay, and BLHeli32.
 // ESC data is read by sending the MAV_CMD_REQUEST_MESSAGE with `param1=292` and `param2=esc_index`, where esc_index is the zero-indexed ESC number for the corresponding motor, and 255 is used to request data for all ESC.
@@ -36,3 +42,55 @@ type MessageEscEeprom struct {
 func (*MessageEscEeprom) GetID() uint32 {
 	return 292
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEscEeprom) MAVLinkCRCExtra() byte {
+	return 227
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEscEeprom) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 223
+	}
+	return 223
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscEeprom) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.WriteMask {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[24] = m.TargetSystem
+	buf[25] = m.TargetComponent
+	buf[26] = byte(m.Firmware)
+	buf[27] = m.MsgIndex
+	buf[28] = m.MsgCount
+	buf[29] = m.EscIndex
+	buf[30] = m.Length
+	for i, v := range m.Data {
+		buf[31+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEscEeprom) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.WriteMask {
+		m.WriteMask[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.TargetSystem = buf[24]
+	m.TargetComponent = buf[25]
+	m.Firmware = ESC_FIRMWARE(buf[26])
+	m.MsgIndex = buf[27]
+	m.MsgCount = buf[28]
+	m.EscIndex = buf[29]
+	m.Length = buf[30]
+	for i := range m.Data {
+		m.Data[i] = buf[31+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEscEeprom) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[292]
+}
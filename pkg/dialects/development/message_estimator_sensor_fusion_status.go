@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of estimator sensor fusion sources. Each array is indexed by ESTIMATOR_SENSOR_FUSION_SOURCE - 1. Each element is a per-instance bitmask (bit 0 = instance 0, etc.). For single-instance sources only bit 0 is used. For multi-instance sources like AGP, multiple bits may be set.
 type MessageEstimatorSensorFusionStatus struct {
 	// Per-source instance bitmask of sensors the estimator intends to fuse (reflects CTRL params with runtime overrides via MAV_CMD_ESTIMATOR_SENSOR_ENABLE).
@@ -16,3 +23,47 @@ type MessageEstimatorSensorFusionStatus struct {
 func (*MessageEstimatorSensorFusionStatus) GetID() uint32 {
 	return 514
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEstimatorSensorFusionStatus) MAVLinkCRCExtra() byte {
+	return 197
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEstimatorSensorFusionStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 54
+	}
+	return 54
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEstimatorSensorFusionStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.TestRatio {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.Intended {
+		buf[36+i] = v
+	}
+	for i, v := range m.Active {
+		buf[45+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEstimatorSensorFusionStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.TestRatio {
+		m.TestRatio[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[i*4:]))
+	}
+	for i := range m.Intended {
+		m.Intended[i] = buf[36+i]
+	}
+	for i := range m.Active {
+		m.Active[i] = buf[45+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEstimatorSensorFusionStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[514]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about key components of GNSS receivers, like signal authentication, interference and system errors.
 type MessageGnssIntegrity struct {
 	// GNSS receiver id. Must match instance ids of other messages from same receiver.
@@ -34,3 +40,53 @@ type MessageGnssIntegrity struct {
 func (*MessageGnssIntegrity) GetID() uint32 {
 	return 441
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGnssIntegrity) MAVLinkCRCExtra() byte {
+	return 169
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGnssIntegrity) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGnssIntegrity) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.SystemErrors))
+	binary.LittleEndian.PutUint16(buf[4:], m.RaimHfom)
+	binary.LittleEndian.PutUint16(buf[6:], m.RaimVfom)
+	buf[8] = m.Id
+	buf[9] = byte(m.AuthenticationState)
+	buf[10] = byte(m.JammingState)
+	buf[11] = byte(m.SpoofingState)
+	buf[12] = byte(m.RaimState)
+	buf[13] = m.CorrectionsQuality
+	buf[14] = m.SystemStatusSummary
+	buf[15] = m.GnssSignalQuality
+	buf[16] = m.PostProcessingQuality
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGnssIntegrity) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.SystemErrors = GPS_SYSTEM_ERROR_FLAGS(binary.LittleEndian.Uint32(buf[0:]))
+	m.RaimHfom = binary.LittleEndian.Uint16(buf[4:])
+	m.RaimVfom = binary.LittleEndian.Uint16(buf[6:])
+	m.Id = buf[8]
+	m.AuthenticationState = GPS_AUTHENTICATION_STATE(buf[9])
+	m.JammingState = GPS_JAMMING_STATE(buf[10])
+	m.SpoofingState = GPS_SPOOFING_STATE(buf[11])
+	m.RaimState = GPS_RAIM_STATE(buf[12])
+	m.CorrectionsQuality = buf[13]
+	m.SystemStatusSummary = buf[14]
+	m.GnssSignalQuality = buf[15]
+	m.PostProcessingQuality = buf[16]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGnssIntegrity) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[441]
+}
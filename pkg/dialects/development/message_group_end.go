@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Emitted during mission execution when control reaches MAV_CMD_GROUP_END.
 type MessageGroupEnd struct {
 	// Mission-unique group id (from MAV_CMD_GROUP_END).
@@ -17,3 +23,35 @@ type MessageGroupEnd struct {
 func (*MessageGroupEnd) GetID() uint32 {
 	return 415
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGroupEnd) MAVLinkCRCExtra() byte {
+	return 161
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGroupEnd) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGroupEnd) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.GroupId)
+	binary.LittleEndian.PutUint32(buf[12:], m.MissionChecksum)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGroupEnd) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.GroupId = binary.LittleEndian.Uint32(buf[8:])
+	m.MissionChecksum = binary.LittleEndian.Uint32(buf[12:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGroupEnd) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[415]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Emitted during mission execution when control reaches MAV_CMD_GROUP_START.
 type MessageGroupStart struct {
 	// Mission-unique group id (from MAV_CMD_GROUP_START).
@@ -17,3 +23,35 @@ type MessageGroupStart struct {
 func (*MessageGroupStart) GetID() uint32 {
 	return 414
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageGroupStart) MAVLinkCRCExtra() byte {
+	return 109
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageGroupStart) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGroupStart) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.GroupId)
+	binary.LittleEndian.PutUint32(buf[12:], m.MissionChecksum)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageGroupStart) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.GroupId = binary.LittleEndian.Uint32(buf[8:])
+	m.MissionChecksum = binary.LittleEndian.Uint32(buf[12:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageGroupStart) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[414]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status of the manual control input arbitration on the autopilot: which source is currently active and, when a MAVLink enabled input device is selected, which system/component is providing it. Emitted by the autopilot for ground stations to display the active manual control source.
 type MessageManualInputStatus struct {
 	// Current manual control source active.
@@ -16,3 +20,35 @@ type MessageManualInputStatus struct {
 func (*MessageManualInputStatus) GetID() uint32 {
 	return 515
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageManualInputStatus) MAVLinkCRCExtra() byte {
+	return 128
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageManualInputStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 3
+	}
+	return 3
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualInputStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Source)
+	buf[1] = m.SenderSystemId
+	buf[2] = m.SenderComponentId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageManualInputStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Source = MAV_MANUAL_INPUT_SOURCE(buf[0])
+	m.SenderSystemId = buf[1]
+	m.SenderComponentId = buf[2]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageManualInputStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[515]
+}
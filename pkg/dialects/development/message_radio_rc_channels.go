@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // RC channel outputs from a MAVLink RC receiver for input to a flight controller or other components (allows an RC receiver to connect via MAVLink instead of some other protocol such as PPM-Sum or S.BUS).
 // Note that this is not intended to be an over-the-air format, and does not replace RC_CHANNELS and similar messages reported by the flight controller.
 // The target_system field should normally be set to the system id of the system to control, typically the flight controller.
@@ -35,3 +41,51 @@ type MessageRadioRcChannels struct {
 func (*MessageRadioRcChannels) GetID() uint32 {
 	return 420
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRadioRcChannels) MAVLinkCRCExtra() byte {
+	return 20
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRadioRcChannels) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 73
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRadioRcChannels) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeLastUpdateMs)
+	binary.LittleEndian.PutUint16(buf[4:], uint16(m.Flags))
+	buf[6] = m.TargetSystem
+	buf[7] = m.TargetComponent
+	buf[8] = m.Count
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Channels {
+		binary.LittleEndian.PutUint16(buf[9+i*2:], uint16(v))
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRadioRcChannels) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeLastUpdateMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Flags = RADIO_RC_CHANNELS_FLAGS(binary.LittleEndian.Uint16(buf[4:]))
+	m.TargetSystem = buf[6]
+	m.TargetComponent = buf[7]
+	m.Count = buf[8]
+	if !isV2 {
+		return
+	}
+	for i := range m.Channels {
+		m.Channels[i] = int16(binary.LittleEndian.Uint16(buf[9+i*2:]))
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRadioRcChannels) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[420]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Range information from a radio beacon for trilateration-based positioning.
 // This message is telemetry intended for consumption by an autopilot (MAVLink does not define the mechanism used to determine the range).
 type MessageRangingBeacon struct {
@@ -41,3 +48,59 @@ type MessageRangingBeacon struct {
 func (*MessageRangingBeacon) GetID() uint32 {
 	return 513
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRangingBeacon) MAVLinkCRCExtra() byte {
+	return 99
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRangingBeacon) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 45
+	}
+	return 45
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRangingBeacon) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], m.Range)
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Alt))
+	binary.LittleEndian.PutUint32(buf[24:], m.HaccEst)
+	binary.LittleEndian.PutUint32(buf[28:], m.VaccEst)
+	binary.LittleEndian.PutUint32(buf[32:], m.RangeAccuracy)
+	binary.LittleEndian.PutUint16(buf[36:], m.BeaconId)
+	binary.LittleEndian.PutUint16(buf[38:], m.CarrierFreq)
+	buf[40] = m.TargetSystem
+	buf[41] = m.TargetComponent
+	buf[42] = byte(m.AltType)
+	buf[43] = m.Sequence
+	buf[44] = byte(m.Status)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRangingBeacon) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.Range = binary.LittleEndian.Uint32(buf[8:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Alt = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.HaccEst = binary.LittleEndian.Uint32(buf[24:])
+	m.VaccEst = binary.LittleEndian.Uint32(buf[28:])
+	m.RangeAccuracy = binary.LittleEndian.Uint32(buf[32:])
+	m.BeaconId = binary.LittleEndian.Uint16(buf[36:])
+	m.CarrierFreq = binary.LittleEndian.Uint16(buf[38:])
+	m.TargetSystem = buf[40]
+	m.TargetComponent = buf[41]
+	m.AltType = RANGING_BEACON_ALT_TYPE(buf[42])
+	m.Sequence = buf[43]
+	m.Status = RANGING_BEACON_STATUS_FLAG(buf[44])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRangingBeacon) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[513]
+}
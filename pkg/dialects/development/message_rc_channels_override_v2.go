@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Highly bandwidth-efficient RC override message for professional networked operations (LTE, Zigbee, GCP).
 // Supersedes RC_CHANNELS_OVERRIDE by adding 32-channel support and a bitmask for multi-master conflict avoidance.
 // Uses centered-at-zero values and extension-field placement to maximize MAVLink 2 trailing-zero truncation.
@@ -22,3 +28,47 @@ type MessageRcChannelsOverrideV2 struct {
 func (*MessageRcChannelsOverrideV2) GetID() uint32 {
 	return 421
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRcChannelsOverrideV2) MAVLinkCRCExtra() byte {
+	return 149
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRcChannelsOverrideV2) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 70
+	}
+	return 6
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRcChannelsOverrideV2) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.ActiveMask)
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Channels {
+		binary.LittleEndian.PutUint16(buf[6+i*2:], uint16(v))
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRcChannelsOverrideV2) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ActiveMask = binary.LittleEndian.Uint32(buf[0:])
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	if !isV2 {
+		return
+	}
+	for i := range m.Channels {
+		m.Channels[i] = int16(binary.LittleEndian.Uint16(buf[6+i*2:]))
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRcChannelsOverrideV2) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[421]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set temporary maximum limits for horizontal speed, vertical speed and yaw rate.
 // The consumer must stream the current limits in VELOCITY_LIMITS at 1 Hz or more (when limits are being set).
 // The consumer should latch the limits until a new limit is received or the mode is changed.
@@ -22,3 +29,39 @@ type MessageSetVelocityLimits struct {
 func (*MessageSetVelocityLimits) GetID() uint32 {
 	return 354
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageSetVelocityLimits) MAVLinkCRCExtra() byte {
+	return 210
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageSetVelocityLimits) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetVelocityLimits) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.HorizontalSpeedLimit))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.VerticalSpeedLimit))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.YawRateLimit))
+	buf[12] = m.TargetSystem
+	buf[13] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageSetVelocityLimits) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.HorizontalSpeedLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.VerticalSpeedLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.YawRateLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.TargetSystem = buf[12]
+	m.TargetComponent = buf[13]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageSetVelocityLimits) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[354]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Current motion information from sensors on a target
 type MessageTargetAbsolute struct {
 	// Timestamp (UNIX epoch time).
@@ -36,3 +43,83 @@ type MessageTargetAbsolute struct {
 func (*MessageTargetAbsolute) GetID() uint32 {
 	return 510
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTargetAbsolute) MAVLinkCRCExtra() byte {
+	return 245
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTargetAbsolute) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 106
+	}
+	return 106
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTargetAbsolute) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Lat))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Lon))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Alt))
+	for i, v := range m.Vel {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.Acc {
+		binary.LittleEndian.PutUint32(buf[32+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.QTarget {
+		binary.LittleEndian.PutUint32(buf[44+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.Rates {
+		binary.LittleEndian.PutUint32(buf[60+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.PositionStd {
+		binary.LittleEndian.PutUint32(buf[72+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.VelStd {
+		binary.LittleEndian.PutUint32(buf[80+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.AccStd {
+		binary.LittleEndian.PutUint32(buf[92+i*4:], math.Float32bits(v))
+	}
+	buf[104] = m.Id
+	buf[105] = byte(m.SensorCapabilities)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTargetAbsolute) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.Lat = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Lon = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Alt = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	for i := range m.Vel {
+		m.Vel[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[20+i*4:]))
+	}
+	for i := range m.Acc {
+		m.Acc[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[32+i*4:]))
+	}
+	for i := range m.QTarget {
+		m.QTarget[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[44+i*4:]))
+	}
+	for i := range m.Rates {
+		m.Rates[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[60+i*4:]))
+	}
+	for i := range m.PositionStd {
+		m.PositionStd[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[72+i*4:]))
+	}
+	for i := range m.VelStd {
+		m.VelStd[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[80+i*4:]))
+	}
+	for i := range m.AccStd {
+		m.AccStd[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[92+i*4:]))
+	}
+	m.Id = buf[104]
+	m.SensorCapabilities = TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS(buf[105])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTargetAbsolute) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[510]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The location of a target measured by MAV's onboard sensors.
 type MessageTargetRelative struct {
 	// Timestamp (UNIX epoch time)
@@ -32,3 +39,63 @@ type MessageTargetRelative struct {
 func (*MessageTargetRelative) GetID() uint32 {
 	return 511
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTargetRelative) MAVLinkCRCExtra() byte {
+	return 28
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTargetRelative) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 71
+	}
+	return 71
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTargetRelative) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.Timestamp)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Z))
+	for i, v := range m.PosStd {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.YawStd))
+	for i, v := range m.QTarget {
+		binary.LittleEndian.PutUint32(buf[36+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.QSensor {
+		binary.LittleEndian.PutUint32(buf[52+i*4:], math.Float32bits(v))
+	}
+	buf[68] = m.Id
+	buf[69] = byte(m.Frame)
+	buf[70] = byte(m.Type)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTargetRelative) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Timestamp = binary.LittleEndian.Uint64(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	for i := range m.PosStd {
+		m.PosStd[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[20+i*4:]))
+	}
+	m.YawStd = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	for i := range m.QTarget {
+		m.QTarget[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[36+i*4:]))
+	}
+	for i := range m.QSensor {
+		m.QSensor[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[52+i*4:]))
+	}
+	m.Id = buf[68]
+	m.Frame = TARGET_OBS_FRAME(buf[69])
+	m.Type = LANDING_TARGET_TYPE(buf[70])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTargetRelative) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[511]
+}
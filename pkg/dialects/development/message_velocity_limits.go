@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package development
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Current limits for horizontal speed, vertical speed and yaw rate, as set by SET_VELOCITY_LIMITS.
 type MessageVelocityLimits struct {
 	// Limit for horizontal movement in MAV_FRAME_LOCAL_NED. NaN: No limit applied
@@ -16,3 +23,35 @@ type MessageVelocityLimits struct {
 func (*MessageVelocityLimits) GetID() uint32 {
 	return 355
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageVelocityLimits) MAVLinkCRCExtra() byte {
+	return 6
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageVelocityLimits) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 12
+	}
+	return 12
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVelocityLimits) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.HorizontalSpeedLimit))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.VerticalSpeedLimit))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.YawRateLimit))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageVelocityLimits) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.HorizontalSpeedLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.VerticalSpeedLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.YawRateLimit = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageVelocityLimits) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[355]
+}
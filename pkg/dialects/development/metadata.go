@@ -0,0 +1,270 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package development
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	292: {
+		ID:          292,
+		Name:        "ESC_EEPROM",
+		Description: "ESC EEPROM data message for reading and writing ESC configuration. Supports multiple ESC firmware types including AM32, Bluejay, and BLHeli32. ESC data is read by sending the MAV_CMD_REQUEST_MESSAGE with `param1=292` and `param2=esc_index`, where esc_index is the zero-indexed ESC number for the corresponding motor, and 255 is used to request data for all ESC. The message can be sent to set the ESC value. For write requests, a bitmask allows selective writing of specific bytes to avoid corrupting unchanged values. The data format is opaque to the autopilot. A GCS is required to understand the format in order to create an appropriate UI for display and setting configuration values, and to inform users when the ESC uses an unsupported data format. Note that for AM32 EEPROMs the data layout is defined in: https://github.com/am32-firmware/AM32/blob/main/Inc/eeprom.h (the second byte in the structure is the eeprom_version). The firmware field indicates which ESC firmware is in use, allowing the GCS to interpret the data correctly.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (ID of target system, normally flight controller)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (normally 0 for broadcast)."},
+			{Name: "firmware", Type: "uint8_t", Enum: "ESC_FIRMWARE", Description: "ESC firmware type."},
+			{Name: "msg_index", Type: "uint8_t", Description: "Zero-indexed sequence number of this message when multiple messages are required to transfer the complete EEPROM data. The first message has index 0. For single-message transfers, set to 0."},
+			{Name: "msg_count", Type: "uint8_t", Description: "Total number of messages required to transfer the complete EEPROM data. For single-message transfers, set to 1. Receivers should collect all messages from index 0 to msg_count-1 before reconstructing the complete data."},
+			{Name: "esc_index", Type: "uint8_t", Description: "Index of the ESC (0 = ESC1, 1 = ESC2, etc.)."},
+			{Name: "write_mask", Type: "uint32_t", ArrayLength: 6, Description: "Bitmask indicating which bytes in the data array should be written. Each bit corresponds to a byte index in the data array (bit 0 of write_mask[0] = data[0], bit 31 of write_mask[0] = data[31], bit 0 of write_mask[1] = data[32], etc.). Set bits indicate bytes to write, cleared bits indicate bytes to skip. This allows precise updates of individual parameters without overwriting the entire EEPROM."},
+			{Name: "length", Type: "uint8_t", Description: "Number of valid bytes in data array."},
+			{Name: "data", Type: "uint8_t", ArrayLength: 192, Description: "Raw ESC EEPROM data. Unused bytes should be set to zero."},
+		},
+	},
+	354: {
+		ID:          354,
+		Name:        "SET_VELOCITY_LIMITS",
+		Description: "Set temporary maximum limits for horizontal speed, vertical speed and yaw rate. The consumer must stream the current limits in VELOCITY_LIMITS at 1 Hz or more (when limits are being set). The consumer should latch the limits until a new limit is received or the mode is changed.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (0 for broadcast)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (0 for broadcast)."},
+			{Name: "horizontal_speed_limit", Type: "float", Description: "Limit for horizontal movement in MAV_FRAME_LOCAL_NED. NaN: Field not used (ignore)"},
+			{Name: "vertical_speed_limit", Type: "float", Description: "Limit for vertical movement in MAV_FRAME_LOCAL_NED. NaN: Field not used (ignore)"},
+			{Name: "yaw_rate_limit", Type: "float", Description: "Limit for vehicle turn rate around its yaw axis. NaN: Field not used (ignore)"},
+		},
+	},
+	355: {
+		ID:          355,
+		Name:        "VELOCITY_LIMITS",
+		Description: "Current limits for horizontal speed, vertical speed and yaw rate, as set by SET_VELOCITY_LIMITS.",
+		Fields: []*message.FieldMetadata{
+			{Name: "horizontal_speed_limit", Type: "float", Description: "Limit for horizontal movement in MAV_FRAME_LOCAL_NED. NaN: No limit applied"},
+			{Name: "vertical_speed_limit", Type: "float", Description: "Limit for vertical movement in MAV_FRAME_LOCAL_NED. NaN: No limit applied"},
+			{Name: "yaw_rate_limit", Type: "float", Description: "Limit for vehicle turn rate around its yaw axis. NaN: No limit applied"},
+		},
+	},
+	369: {
+		ID:          369,
+		Name:        "BATTERY_STATUS_V2",
+		Description: "Battery dynamic information. This should be streamed (nominally at 1Hz). Static/invariant battery information is sent in BATTERY_INFO. Note that smart batteries should set the MAV_BATTERY_STATUS_FLAGS_CAPACITY_RELATIVE_TO_FULL bit to indicate that supplied capacity values are relative to a battery that is known to be full. Power monitors would not set this bit, indicating that capacity_consumed is relative to drone power-on, and that other values are estimated based on the assumption that the battery was full on power-on.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Battery ID"},
+			{Name: "temperature", Type: "int16_t", Description: "Temperature of the whole battery pack (not internal electronics). INT16_MAX field not provided."},
+			{Name: "voltage", Type: "float", Description: "Battery voltage (total). NaN: field not provided."},
+			{Name: "current", Type: "float", Description: "Battery current (through all cells/loads). Positive value when discharging and negative if charging. NaN: field not provided."},
+			{Name: "capacity_consumed", Type: "float", Description: "Consumed charge. NaN: field not provided. This is either the consumption since power-on or since the battery was full, depending on the value of MAV_BATTERY_STATUS_FLAGS_CAPACITY_RELATIVE_TO_FULL."},
+			{Name: "capacity_remaining", Type: "float", Description: "Remaining charge (until empty). NaN: field not provided. Note: If MAV_BATTERY_STATUS_FLAGS_CAPACITY_RELATIVE_TO_FULL is unset, this value is based on the assumption the battery was full when the system was powered."},
+			{Name: "state_of_charge", Type: "float", Description: "State of Charge (SoC). Remaining capacity relative to current fully-charged capacity as a percentage. Values: [0-100], NaN: field not provided. Note that the value may be calculated from design_capacity if current full_charge_capacity is not known (see BATTERY_INFO)."},
+			{Name: "status_flags", Type: "uint32_t", Enum: "MAV_BATTERY_STATUS_FLAGS", Bitmask: true, Description: "Fault, health, readiness, and other status indications."},
+		},
+	},
+	414: {
+		ID:          414,
+		Name:        "GROUP_START",
+		Description: "Emitted during mission execution when control reaches MAV_CMD_GROUP_START.",
+		Fields: []*message.FieldMetadata{
+			{Name: "group_id", Type: "uint32_t", Description: "Mission-unique group id (from MAV_CMD_GROUP_START)."},
+			{Name: "mission_checksum", Type: "uint32_t", Description: "CRC32 checksum of current plan for MAV_MISSION_TYPE_ALL. As defined in MISSION_CHECKSUM message."},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	415: {
+		ID:          415,
+		Name:        "GROUP_END",
+		Description: "Emitted during mission execution when control reaches MAV_CMD_GROUP_END.",
+		Fields: []*message.FieldMetadata{
+			{Name: "group_id", Type: "uint32_t", Description: "Mission-unique group id (from MAV_CMD_GROUP_END)."},
+			{Name: "mission_checksum", Type: "uint32_t", Description: "CRC32 checksum of current plan for MAV_MISSION_TYPE_ALL. As defined in MISSION_CHECKSUM message."},
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+		},
+	},
+	420: {
+		ID:          420,
+		Name:        "RADIO_RC_CHANNELS",
+		Description: "RC channel outputs from a MAVLink RC receiver for input to a flight controller or other components (allows an RC receiver to connect via MAVLink instead of some other protocol such as PPM-Sum or S.BUS). Note that this is not intended to be an over-the-air format, and does not replace RC_CHANNELS and similar messages reported by the flight controller. The target_system field should normally be set to the system id of the system to control, typically the flight controller. The target_component field can normally be set to 0, so that all components of the system can receive the message. The channels array field can publish up to 32 channels; the number of channel items used in the array is specified in the count field. The time_last_update_ms field contains the timestamp of the last received valid channels data in the receiver's time domain. The count field indicates the first index of the channel array that is not used for channel data (this and later indexes are zero-filled). The RADIO_RC_CHANNELS_FLAGS_OUTDATED flag is set by the receiver if the channels data is not up-to-date (for example, if new data from the transmitter could not be validated so the last valid data is resent). The RADIO_RC_CHANNELS_FLAGS_FAILSAFE failsafe flag is set by the receiver if the receiver's failsafe condition is met (implementation dependent, e.g., connection to the RC radio is lost). In this case time_last_update_ms still contains the timestamp of the last valid channels data, but the content of the channels data is not defined by the protocol (it is up to the implementation of the receiver). For instance, the channels data could contain failsafe values configured in the receiver; the default is to carry the last valid data. Note: The RC channels fields are extensions to ensure that they are located at the end of the serialized payload and subject to MAVLink's trailing-zero trimming.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (ID of target system, normally flight controller)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (normally 0 for broadcast)."},
+			{Name: "time_last_update_ms", Type: "uint32_t", Description: "Time when the data in the channels field were last updated (time since boot in the receiver's time domain)."},
+			{Name: "flags", Type: "uint16_t", Enum: "RADIO_RC_CHANNELS_FLAGS", Bitmask: true, Description: "Radio RC channels status flags."},
+			{Name: "count", Type: "uint8_t", Description: "Total number of RC channels being received. This can be larger than 32, indicating that more channels are available but not given in this message."},
+			{Name: "channels", Type: "int16_t", ArrayLength: 32, Extension: true, Description: "RC channels. Channel values are in centered 13 bit format. Range is -4096 to 4096, center is 0. Conversion to PWM is x * 5/32 + 1500. Channels with indexes equal or above count should be set to 0, to benefit from MAVLink's trailing-zero trimming."},
+		},
+	},
+	421: {
+		ID:          421,
+		Name:        "RC_CHANNELS_OVERRIDE_V2",
+		Description: "Highly bandwidth-efficient RC override message for professional networked operations (LTE, Zigbee, GCP). Supersedes RC_CHANNELS_OVERRIDE by adding 32-channel support and a bitmask for multi-master conflict avoidance. Uses centered-at-zero values and extension-field placement to maximize MAVLink 2 trailing-zero truncation.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "active_mask", Type: "uint32_t", Description: "Bitmap of included channels (bit 0 = CH1). 1: Valid/Override, 0: Ignore."},
+			{Name: "channels", Type: "int16_t", ArrayLength: 32, Extension: true, Description: "RC channels in centered 13-bit format. Range: -4096 to 4096, Center: 0. Conversion to PWM: (x * 5/32) + 1500. Unused channels must be set to 0 to enable MAVLink 2 trailing-zero trimming."},
+		},
+	},
+	441: {
+		ID:          441,
+		Name:        "GNSS_INTEGRITY",
+		Description: "Information about key components of GNSS receivers, like signal authentication, interference and system errors.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "GNSS receiver id. Must match instance ids of other messages from same receiver."},
+			{Name: "system_errors", Type: "uint32_t", Enum: "GPS_SYSTEM_ERROR_FLAGS", Bitmask: true, Description: "Errors in the GPS system."},
+			{Name: "authentication_state", Type: "uint8_t", Enum: "GPS_AUTHENTICATION_STATE", Description: "Signal authentication state of the GPS system."},
+			{Name: "jamming_state", Type: "uint8_t", Enum: "GPS_JAMMING_STATE", Description: "Signal jamming state of the GPS system."},
+			{Name: "spoofing_state", Type: "uint8_t", Enum: "GPS_SPOOFING_STATE", Description: "Signal spoofing state of the GPS system."},
+			{Name: "raim_state", Type: "uint8_t", Enum: "GPS_RAIM_STATE", Description: "The state of the RAIM processing."},
+			{Name: "raim_hfom", Type: "uint16_t", Description: "Horizontal expected accuracy using satellites successfully validated using RAIM."},
+			{Name: "raim_vfom", Type: "uint16_t", Description: "Vertical expected accuracy using satellites successfully validated using RAIM."},
+			{Name: "corrections_quality", Type: "uint8_t", Description: "An abstract value representing the estimated quality of incoming corrections, or 255 if not available."},
+			{Name: "system_status_summary", Type: "uint8_t", Description: "An abstract value representing the overall status of the receiver, or 255 if not available."},
+			{Name: "gnss_signal_quality", Type: "uint8_t", Description: "An abstract value representing the quality of incoming GNSS signals, or 255 if not available."},
+			{Name: "post_processing_quality", Type: "uint8_t", Description: "An abstract value representing the estimated PPK quality, or 255 if not available."},
+		},
+	},
+	442: {
+		ID:          442,
+		Name:        "EFI_PERFORMANCE",
+		Description: "EFI performance and health telemetry. Primarily intended for debugging EFI system faults to determine whether problems are within the engine, fuel pump, actuators, or the ECU. Nominal stream rate is 1 Hz. Core engine telemetry (RPM, temperatures, pressures, fuel flow) is carried in EFI_STATUS; this message carries the electrical/actuator/diagnostic data not present there.",
+		Fields: []*message.FieldMetadata{
+			{Name: "ecu_index", Type: "uint8_t", Description: "Electronic Control Unit (ECU) index for systems with multiple EFI units. Set to 0 for the first (or only) ECU."},
+			{Name: "fuel_pump_duty_cycle", Type: "uint8_t", Description: "Fuel pump power output, as a percentage of maximum. 0 = off, 100 = full power."},
+			{Name: "fuel_pump_voltage", Type: "uint16_t", Description: "Fuel pump supply voltage."},
+			{Name: "fuel_pump_current", Type: "uint16_t", Description: "Fuel pump current draw."},
+			{Name: "ecu_supply_voltage", Type: "uint16_t", Description: "ECU supply voltage."},
+			{Name: "ecu_current", Type: "uint16_t", Description: "ECU current draw."},
+			{Name: "ecu_temperature", Type: "int16_t", Description: "ECU/electronics board temperature."},
+			{Name: "ecu_cpu_load", Type: "uint8_t", Description: "ECU processor load."},
+			{Name: "servo_supply_voltage", Type: "uint16_t", Description: "Throttle-servo / actuator supply voltage."},
+			{Name: "servo_output_level", Type: "uint8_t", Description: "Electronic-throttle / servo commanded output level."},
+			{Name: "water_pump_duty_cycle", Type: "uint8_t", Description: "Coolant-pump power output, as a percentage of maximum."},
+			{Name: "injector_duty_cycle", Type: "uint8_t", Description: "Primary injector duty cycle."},
+			{Name: "fuel_mass_rate", Type: "float", Description: "Instantaneous fuel consumption by mass. NaN if not provided."},
+			{Name: "commanded_rpm", Type: "float", Description: "Governor/target RPM setpoint."},
+			{Name: "engine_runtime", Type: "uint32_t", Description: "Cumulative engine run time (hobbs)."},
+			{Name: "error_memory_count", Type: "uint8_t", Description: "Number of faults currently stored in the ECU error memory."},
+			{Name: "boost_pressure", Type: "float", Description: "Forced-induction boost pressure. NaN if not provided."},
+			{Name: "coolant_pressure", Type: "float", Description: "Coolant-circuit pressure. NaN if not provided."},
+			{Name: "engine_torque", Type: "int16_t", Description: "Percent engine torque (signed; negative = motoring/braking)."},
+			{Name: "status_flags", Type: "uint64_t", Enum: "EFI_PERFORMANCE_STATUS_FLAGS", Bitmask: true, Description: "EFI status flags."},
+		},
+	},
+	510: {
+		ID:          510,
+		Name:        "TARGET_ABSOLUTE",
+		Description: "Current motion information from sensors on a target",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (UNIX epoch time)."},
+			{Name: "id", Type: "uint8_t", Description: "The ID of the target if multiple targets are present"},
+			{Name: "sensor_capabilities", Type: "uint8_t", Enum: "TARGET_ABSOLUTE_SENSOR_CAPABILITY_FLAGS", Bitmask: true, Description: "Bitmap to indicate the sensor's reporting capabilities"},
+			{Name: "lat", Type: "int32_t", Description: "Target's latitude (WGS84)"},
+			{Name: "lon", Type: "int32_t", Description: "Target's longitude (WGS84)"},
+			{Name: "alt", Type: "float", Description: "Target's altitude (AMSL)"},
+			{Name: "vel", Type: "float", ArrayLength: 3, Description: "Target's velocity in its body frame"},
+			{Name: "acc", Type: "float", ArrayLength: 3, Description: "Linear target's acceleration in its body frame"},
+			{Name: "q_target", Type: "float", ArrayLength: 4, Description: "Quaternion of the target's orientation from its body frame to the vehicle's NED frame."},
+			{Name: "rates", Type: "float", ArrayLength: 3, Description: "Target's roll, pitch and yaw rates"},
+			{Name: "position_std", Type: "float", ArrayLength: 2, Description: "Standard deviation of horizontal (eph) and vertical (epv) position errors"},
+			{Name: "vel_std", Type: "float", ArrayLength: 3, Description: "Standard deviation of the target's velocity in its body frame"},
+			{Name: "acc_std", Type: "float", ArrayLength: 3, Description: "Standard deviation of the target's acceleration in its body frame"},
+		},
+	},
+	511: {
+		ID:          511,
+		Name:        "TARGET_RELATIVE",
+		Description: "The location of a target measured by MAV's onboard sensors.",
+		Fields: []*message.FieldMetadata{
+			{Name: "timestamp", Type: "uint64_t", Description: "Timestamp (UNIX epoch time)"},
+			{Name: "id", Type: "uint8_t", Description: "The ID of the target if multiple targets are present"},
+			{Name: "frame", Type: "uint8_t", Enum: "TARGET_OBS_FRAME", Description: "Coordinate frame used for following fields."},
+			{Name: "x", Type: "float", Description: "X Position of the target in TARGET_OBS_FRAME"},
+			{Name: "y", Type: "float", Description: "Y Position of the target in TARGET_OBS_FRAME"},
+			{Name: "z", Type: "float", Description: "Z Position of the target in TARGET_OBS_FRAME"},
+			{Name: "pos_std", Type: "float", ArrayLength: 3, Description: "Standard deviation of the target's position in TARGET_OBS_FRAME"},
+			{Name: "yaw_std", Type: "float", Description: "Standard deviation of the target's orientation in TARGET_OBS_FRAME"},
+			{Name: "q_target", Type: "float", ArrayLength: 4, Description: "Quaternion of the target's orientation from the target's frame to the TARGET_OBS_FRAME (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "q_sensor", Type: "float", ArrayLength: 4, Description: "Quaternion of the sensor's orientation from TARGET_OBS_FRAME to vehicle-carried NED. (Ignored if set to (0,0,0,0)) (w, x, y, z order, zero-rotation is 1, 0, 0, 0)"},
+			{Name: "type", Type: "uint8_t", Enum: "LANDING_TARGET_TYPE", Description: "Type of target"},
+		},
+	},
+	512: {
+		ID:          512,
+		Name:        "CONTROL_STATUS",
+		Description: "Information about GCS(s) in control of this MAV. This should be broadcast at low rate (nominally 1 Hz) and emitted when ownership or takeover status change. Components in the system should only accept \"state changing commands/messages\" from any system id in `gcs_main` or  `gcs_secondary`. - In single-owner mode there is a single GCS that can send \"state changing commands/messages\" listed in `gcs_main` (`gcs_secondary` must be set to all-zero). - In multi-owner mode, all GCS with ids in `gcs_main` and `gcs_secondary` can send \"state changing commands/messages\". However `gcs_main` is the only GCS that can perform \"special controlled operations\" such as manual control. - Control over ownership of the `gcs_main` role is requested using MAV_CMD_REQUEST_OPERATOR_CONTROL. - GCS in `gcs_secondary` are set by the flight stack (cannot be set by this mechanism). It should only include IDs for connected GCS. If more than 11 GCS are in control and visible, the flight stack will at most be able to publish 11.",
+		Fields: []*message.FieldMetadata{
+			{Name: "flags", Type: "uint8_t", Enum: "GCS_CONTROL_STATUS_FLAGS", Bitmask: true, Description: "Control status. For example, whether takeover of the `gcs_main` role is allowed, and whether this CONTROL_STATUS instance defines the default controlling GCS for the whole system."},
+			{Name: "gcs_main", Type: "uint8_t", Description: "System ID of GCS in control. 0: no GCS in control."},
+			{Name: "gcs_secondary", Type: "uint8_t", ArrayLength: 10, Description: "System IDs from which the system can recieve state-changing commands/messages in multi-control mode. All values should be zero for single-ower mode."},
+		},
+	},
+	513: {
+		ID:          513,
+		Name:        "RANGING_BEACON",
+		Description: "Range information from a radio beacon for trilateration-based positioning. This message is telemetry intended for consumption by an autopilot (MAVLink does not define the mechanism used to determine the range).",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (UNIX Epoch time or time since system boot). The receiving end can infer timestamp format (since 1.1.1970 or since system boot) by checking for the magnitude of the number."},
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "beacon_id", Type: "uint16_t", Description: "ID of the ranging beacon/station."},
+			{Name: "range", Type: "uint32_t", Description: "Range measurement between a beacon and a vehicle."},
+			{Name: "lat", Type: "int32_t", Description: "Beacon latitude (WGS84)."},
+			{Name: "lon", Type: "int32_t", Description: "Beacon longitude (WGS84)."},
+			{Name: "alt", Type: "float", Description: "Beacon altitude (frame defined in alt_type)."},
+			{Name: "alt_type", Type: "uint8_t", Enum: "RANGING_BEACON_ALT_TYPE", Description: "Altitude frame for alt field. RANGING_BEACON_ALT_TYPE_WGS84 (0) preferred."},
+			{Name: "hacc_est", Type: "uint32_t", Description: "Beacon 1-sigma horizontal accuracy estimate."},
+			{Name: "vacc_est", Type: "uint32_t", Description: "Beacon 1-sigma vertical accuracy estimate."},
+			{Name: "carrier_freq", Type: "uint16_t", Description: "Ranging carrier frequency"},
+			{Name: "range_accuracy", Type: "uint32_t", Description: "Estimated 1-sigma range measurement accuracy."},
+			{Name: "sequence", Type: "uint8_t", Description: "Measurement sequence number."},
+			{Name: "status", Type: "uint8_t", Enum: "RANGING_BEACON_STATUS_FLAG", Bitmask: true, Description: "Ranging beacon status."},
+		},
+	},
+	514: {
+		ID:          514,
+		Name:        "ESTIMATOR_SENSOR_FUSION_STATUS",
+		Description: "Status of estimator sensor fusion sources. Each array is indexed by ESTIMATOR_SENSOR_FUSION_SOURCE - 1. Each element is a per-instance bitmask (bit 0 = instance 0, etc.). For single-instance sources only bit 0 is used. For multi-instance sources like AGP, multiple bits may be set.",
+		Fields: []*message.FieldMetadata{
+			{Name: "intended", Type: "uint8_t", ArrayLength: 9, Description: "Per-source instance bitmask of sensors the estimator intends to fuse (reflects CTRL params with runtime overrides via MAV_CMD_ESTIMATOR_SENSOR_ENABLE)."},
+			{Name: "active", Type: "uint8_t", ArrayLength: 9, Description: "Per-source instance bitmask of sensors the estimator is actively fusing."},
+			{Name: "test_ratio", Type: "float", ArrayLength: 9, Description: "Per-source normalized innovation test ratio. NaN if not available."},
+		},
+	},
+	515: {
+		ID:          515,
+		Name:        "MANUAL_INPUT_STATUS",
+		Description: "Status of the manual control input arbitration on the autopilot: which source is currently active and, when a MAVLink enabled input device is selected, which system/component is providing it. Emitted by the autopilot for ground stations to display the active manual control source.",
+		Fields: []*message.FieldMetadata{
+			{Name: "source", Type: "uint8_t", Enum: "MAV_MANUAL_INPUT_SOURCE", Description: "Current manual control source active."},
+			{Name: "sender_system_id", Type: "uint8_t", Description: "System ID of the MAVLink enabled input device currently providing manual control. Set whenever a MAVLink enabled input device is contributing, i.e. for both MAV_MANUAL_INPUT_SOURCE_MAVLINK and MAV_MANUAL_INPUT_SOURCE_MIXED. 0 otherwise."},
+			{Name: "sender_component_id", Type: "uint8_t", Description: "Component ID of the MAVLink enabled input device currently providing manual control. Set whenever a MAVLink enabled input device is contributing, i.e. for both MAV_MANUAL_INPUT_SOURCE_MAVLINK and MAV_MANUAL_INPUT_SOURCE_MIXED. 0 otherwise."},
+		},
+	},
+	516: {
+		ID:          516,
+		Name:        "DISTANCE_SENSOR_INFO",
+		Description: "Static characteristics of a distance sensor (onboard rangefinder). Measurements are sent in DISTANCE_SENSOR_V2.",
+		Fields: []*message.FieldMetadata{
+			{Name: "id", Type: "uint8_t", Description: "Sensor ID. Matches the id field of DISTANCE_SENSOR_V2."},
+			{Name: "type", Type: "uint8_t", Enum: "MAV_DISTANCE_SENSOR", Description: "Type of distance sensor."},
+			{Name: "min_distance", Type: "float", Description: "Minimum distance the sensor can measure."},
+			{Name: "max_distance", Type: "float", Description: "Maximum distance the sensor can measure."},
+			{Name: "orientation", Type: "uint8_t", Enum: "MAV_SENSOR_ORIENTATION", Description: "Direction the sensor faces."},
+			{Name: "horizontal_fov", Type: "float", Description: "Horizontal Field of View (angle) where the distance measurement is valid. NaN if unknown."},
+			{Name: "vertical_fov", Type: "float", Description: "Vertical Field of View (angle) where the distance measurement is valid. NaN if unknown."},
+			{Name: "quaternion", Type: "float", ArrayLength: 4, Extension: true, Description: "Quaternion of the sensor orientation in vehicle body frame (w, x, y, z order, zero-rotation is 1, 0, 0, 0). Zero-rotation is along the vehicle body x-axis. This field is required if orientation is set to MAV_SENSOR_ROTATION_CUSTOM. Set it to 0 if invalid."},
+		},
+	},
+	517: {
+		ID:          517,
+		Name:        "DISTANCE_SENSOR_V2",
+		Description: "Distance sensor measurement, for an onboard rangefinder described by DISTANCE_SENSOR_INFO. Streamed at the sensor update rate.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)"},
+			{Name: "id", Type: "uint8_t", Description: "Sensor ID. Matches the id field of DISTANCE_SENSOR_INFO."},
+			{Name: "distance", Type: "float", Description: "Current distance reading. NaN if not provided."},
+			{Name: "signal_quality", Type: "int8_t", Description: "Signal quality (sensor-type specific). Represents the relation of the signal strength with the target reflectivity, distance, size or aspect, but normalised as a percentage. Values: [0-100], 0 = unusable signal, 100 = perfect signal. INT8_MAX = unknown/not provided."},
+		},
+	},
+}
@@ -1,5 +1,7 @@
 // Package icarous contains the icarous dialect.
 //
+// Definitions hash (SHA256): 8873b9d8a6eeee5ef7bd2a610e49a21901b0ff8a0547c814c0817c44537e26cb
+//
 //autogenerated:yes
 package icarous
 
@@ -19,4 +21,5 @@ var dial = &dialect.Dialect{
 		&MessageIcarousHeartbeat{},
 		&MessageIcarousKinematicBands{},
 	},
+	Enums: enums,
 }
@@ -18,6 +18,15 @@ const (
 	ICAROUS_FMS_STATE_LAND     ICAROUS_FMS_STATE = 5
 )
 
+var values_ICAROUS_FMS_STATE = []ICAROUS_FMS_STATE{
+	ICAROUS_FMS_STATE_IDLE,
+	ICAROUS_FMS_STATE_TAKEOFF,
+	ICAROUS_FMS_STATE_CLIMB,
+	ICAROUS_FMS_STATE_CRUISE,
+	ICAROUS_FMS_STATE_APPROACH,
+	ICAROUS_FMS_STATE_LAND,
+}
+
 var value_to_label_ICAROUS_FMS_STATE = map[ICAROUS_FMS_STATE]string{
 	ICAROUS_FMS_STATE_IDLE:     "ICAROUS_FMS_STATE_IDLE",
 	ICAROUS_FMS_STATE_TAKEOFF:  "ICAROUS_FMS_STATE_TAKEOFF",
@@ -61,3 +70,14 @@ func (e ICAROUS_FMS_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ICAROUS_FMS_STATE) Values() []ICAROUS_FMS_STATE {
+	return append([]ICAROUS_FMS_STATE(nil), values_ICAROUS_FMS_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ICAROUS_FMS_STATE) IsValid() bool {
+	_, ok := value_to_label_ICAROUS_FMS_STATE[e]
+	return ok
+}
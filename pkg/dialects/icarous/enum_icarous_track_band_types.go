@@ -15,6 +15,12 @@ const (
 	ICAROUS_TRACK_BAND_TYPE_RECOVERY ICAROUS_TRACK_BAND_TYPES = 2
 )
 
+var values_ICAROUS_TRACK_BAND_TYPES = []ICAROUS_TRACK_BAND_TYPES{
+	ICAROUS_TRACK_BAND_TYPE_NONE,
+	ICAROUS_TRACK_BAND_TYPE_NEAR,
+	ICAROUS_TRACK_BAND_TYPE_RECOVERY,
+}
+
 var value_to_label_ICAROUS_TRACK_BAND_TYPES = map[ICAROUS_TRACK_BAND_TYPES]string{
 	ICAROUS_TRACK_BAND_TYPE_NONE:     "ICAROUS_TRACK_BAND_TYPE_NONE",
 	ICAROUS_TRACK_BAND_TYPE_NEAR:     "ICAROUS_TRACK_BAND_TYPE_NEAR",
@@ -52,3 +58,14 @@ func (e ICAROUS_TRACK_BAND_TYPES) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (ICAROUS_TRACK_BAND_TYPES) Values() []ICAROUS_TRACK_BAND_TYPES {
+	return append([]ICAROUS_TRACK_BAND_TYPES(nil), values_ICAROUS_TRACK_BAND_TYPES...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e ICAROUS_TRACK_BAND_TYPES) IsValid() bool {
+	_, ok := value_to_label_ICAROUS_TRACK_BAND_TYPES[e]
+	return ok
+}
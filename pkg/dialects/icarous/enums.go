@@ -0,0 +1,32 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package icarous
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{
+	"ICAROUS_FMS_STATE": {
+		Name:        "ICAROUS_FMS_STATE",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ICAROUS_FMS_STATE_IDLE", Value: 0},
+			{Name: "ICAROUS_FMS_STATE_TAKEOFF", Value: 1},
+			{Name: "ICAROUS_FMS_STATE_CLIMB", Value: 2},
+			{Name: "ICAROUS_FMS_STATE_CRUISE", Value: 3},
+			{Name: "ICAROUS_FMS_STATE_APPROACH", Value: 4},
+			{Name: "ICAROUS_FMS_STATE_LAND", Value: 5},
+		},
+	},
+	"ICAROUS_TRACK_BAND_TYPES": {
+		Name:        "ICAROUS_TRACK_BAND_TYPES",
+		Description: "",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "ICAROUS_TRACK_BAND_TYPE_NONE", Value: 0},
+			{Name: "ICAROUS_TRACK_BAND_TYPE_NEAR", Value: 1},
+			{Name: "ICAROUS_TRACK_BAND_TYPE_RECOVERY", Value: 2},
+		},
+	},
+}
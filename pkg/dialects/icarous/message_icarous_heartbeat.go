@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package icarous
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // ICAROUS heartbeat
 type MessageIcarousHeartbeat struct {
 	// See the FMS_STATE enum.
@@ -12,3 +16,31 @@ type MessageIcarousHeartbeat struct {
 func (*MessageIcarousHeartbeat) GetID() uint32 {
 	return 42000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageIcarousHeartbeat) MAVLinkCRCExtra() byte {
+	return 227
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageIcarousHeartbeat) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 1
+	}
+	return 1
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIcarousHeartbeat) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Status)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIcarousHeartbeat) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Status = ICAROUS_FMS_STATE(buf[0])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageIcarousHeartbeat) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[42000]
+}
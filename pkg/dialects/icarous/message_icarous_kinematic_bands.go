@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package icarous
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Kinematic multi bands (track) output from Daidalus
 type MessageIcarousKinematicBands struct {
 	// Number of track bands
@@ -42,3 +49,61 @@ type MessageIcarousKinematicBands struct {
 func (*MessageIcarousKinematicBands) GetID() uint32 {
 	return 42001
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageIcarousKinematicBands) MAVLinkCRCExtra() byte {
+	return 239
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageIcarousKinematicBands) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 46
+	}
+	return 46
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIcarousKinematicBands) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Min1))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Max1))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Min2))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Max2))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Min3))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Max3))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Min4))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Max4))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Min5))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.Max5))
+	buf[40] = byte(m.Numbands)
+	buf[41] = byte(m.Type1)
+	buf[42] = byte(m.Type2)
+	buf[43] = byte(m.Type3)
+	buf[44] = byte(m.Type4)
+	buf[45] = byte(m.Type5)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageIcarousKinematicBands) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Min1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Max1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Min2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Max2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Min3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Max3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Min4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Max4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Min5 = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.Max5 = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.Numbands = int8(buf[40])
+	m.Type1 = ICAROUS_TRACK_BAND_TYPES(buf[41])
+	m.Type2 = ICAROUS_TRACK_BAND_TYPES(buf[42])
+	m.Type3 = ICAROUS_TRACK_BAND_TYPES(buf[43])
+	m.Type4 = ICAROUS_TRACK_BAND_TYPES(buf[44])
+	m.Type5 = ICAROUS_TRACK_BAND_TYPES(buf[45])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageIcarousKinematicBands) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[42001]
+}
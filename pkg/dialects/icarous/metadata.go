@@ -0,0 +1,42 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package icarous
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	42000: {
+		ID:          42000,
+		Name:        "ICAROUS_HEARTBEAT",
+		Description: "ICAROUS heartbeat",
+		Fields: []*message.FieldMetadata{
+			{Name: "status", Type: "uint8_t", Enum: "ICAROUS_FMS_STATE", Description: "See the FMS_STATE enum."},
+		},
+	},
+	42001: {
+		ID:          42001,
+		Name:        "ICAROUS_KINEMATIC_BANDS",
+		Description: "Kinematic multi bands (track) output from Daidalus",
+		Fields: []*message.FieldMetadata{
+			{Name: "numBands", Type: "int8_t", Description: "Number of track bands"},
+			{Name: "type1", Type: "uint8_t", Enum: "ICAROUS_TRACK_BAND_TYPES", Description: "See the TRACK_BAND_TYPES enum."},
+			{Name: "min1", Type: "float", Description: "min angle (degrees)"},
+			{Name: "max1", Type: "float", Description: "max angle (degrees)"},
+			{Name: "type2", Type: "uint8_t", Enum: "ICAROUS_TRACK_BAND_TYPES", Description: "See the TRACK_BAND_TYPES enum."},
+			{Name: "min2", Type: "float", Description: "min angle (degrees)"},
+			{Name: "max2", Type: "float", Description: "max angle (degrees)"},
+			{Name: "type3", Type: "uint8_t", Enum: "ICAROUS_TRACK_BAND_TYPES", Description: "See the TRACK_BAND_TYPES enum."},
+			{Name: "min3", Type: "float", Description: "min angle (degrees)"},
+			{Name: "max3", Type: "float", Description: "max angle (degrees)"},
+			{Name: "type4", Type: "uint8_t", Enum: "ICAROUS_TRACK_BAND_TYPES", Description: "See the TRACK_BAND_TYPES enum."},
+			{Name: "min4", Type: "float", Description: "min angle (degrees)"},
+			{Name: "max4", Type: "float", Description: "max angle (degrees)"},
+			{Name: "type5", Type: "uint8_t", Enum: "ICAROUS_TRACK_BAND_TYPES", Description: "See the TRACK_BAND_TYPES enum."},
+			{Name: "min5", Type: "float", Description: "min angle (degrees)"},
+			{Name: "max5", Type: "float", Description: "max angle (degrees)"},
+		},
+	},
+}
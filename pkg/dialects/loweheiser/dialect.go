@@ -1,5 +1,7 @@
 // Package loweheiser contains the loweheiser dialect.
 //
+// Definitions hash (SHA256): 9a10c0c82d69217cc29778695c250a06cb132ce85f9ec5240be8b4c323356b74
+//
 //autogenerated:yes
 package loweheiser
 
@@ -20,4 +22,5 @@ var dial = &dialect.Dialect{
 		// loweheiser
 		&MessageLoweheiserGovEfi{},
 	},
+	Enums: enums,
 }
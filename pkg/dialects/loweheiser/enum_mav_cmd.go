@@ -14,6 +14,10 @@ const (
 	MAV_CMD_LOWEHEISER_SET_STATE MAV_CMD = 10151
 )
 
+var values_MAV_CMD = []MAV_CMD{
+	MAV_CMD_LOWEHEISER_SET_STATE,
+}
+
 var value_to_label_MAV_CMD = map[MAV_CMD]string{
 	MAV_CMD_LOWEHEISER_SET_STATE: "MAV_CMD_LOWEHEISER_SET_STATE",
 }
@@ -47,3 +51,14 @@ func (e MAV_CMD) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_CMD) Values() []MAV_CMD {
+	return append([]MAV_CMD(nil), values_MAV_CMD...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_CMD) IsValid() bool {
+	_, ok := value_to_label_MAV_CMD[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package loweheiser
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Composite EFI and Governor data from Loweheiser equipment.  This message is created by the EFI unit based on its own data and data received from a governor attached to that EFI unit.
 type MessageLoweheiserGovEfi struct {
 	// Generator Battery voltage.
@@ -56,3 +63,75 @@ type MessageLoweheiserGovEfi struct {
 func (*MessageLoweheiserGovEfi) GetID() uint32 {
 	return 10151
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLoweheiserGovEfi) MAVLinkCRCExtra() byte {
+	return 195
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLoweheiserGovEfi) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 85
+	}
+	return 85
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoweheiserGovEfi) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.VoltBatt))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.CurrBatt))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.CurrGen))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.CurrRot))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.FuelLevel))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Throttle))
+	binary.LittleEndian.PutUint32(buf[24:], m.Runtime)
+	binary.LittleEndian.PutUint32(buf[28:], uint32(m.UntilMaintenance))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.RectifierTemp))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.GeneratorTemp))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.EfiBatt))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.EfiRpm))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.EfiPw))
+	binary.LittleEndian.PutUint32(buf[52:], math.Float32bits(m.EfiFuelFlow))
+	binary.LittleEndian.PutUint32(buf[56:], math.Float32bits(m.EfiFuelConsumed))
+	binary.LittleEndian.PutUint32(buf[60:], math.Float32bits(m.EfiBaro))
+	binary.LittleEndian.PutUint32(buf[64:], math.Float32bits(m.EfiMat))
+	binary.LittleEndian.PutUint32(buf[68:], math.Float32bits(m.EfiClt))
+	binary.LittleEndian.PutUint32(buf[72:], math.Float32bits(m.EfiTps))
+	binary.LittleEndian.PutUint32(buf[76:], math.Float32bits(m.EfiExhaustGasTemperature))
+	binary.LittleEndian.PutUint16(buf[80:], m.GeneratorStatus)
+	binary.LittleEndian.PutUint16(buf[82:], m.EfiStatus)
+	buf[84] = m.EfiIndex
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLoweheiserGovEfi) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.VoltBatt = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.CurrBatt = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.CurrGen = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.CurrRot = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.FuelLevel = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Throttle = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Runtime = binary.LittleEndian.Uint32(buf[24:])
+	m.UntilMaintenance = int32(binary.LittleEndian.Uint32(buf[28:]))
+	m.RectifierTemp = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.GeneratorTemp = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.EfiBatt = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.EfiRpm = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.EfiPw = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	m.EfiFuelFlow = math.Float32frombits(binary.LittleEndian.Uint32(buf[52:]))
+	m.EfiFuelConsumed = math.Float32frombits(binary.LittleEndian.Uint32(buf[56:]))
+	m.EfiBaro = math.Float32frombits(binary.LittleEndian.Uint32(buf[60:]))
+	m.EfiMat = math.Float32frombits(binary.LittleEndian.Uint32(buf[64:]))
+	m.EfiClt = math.Float32frombits(binary.LittleEndian.Uint32(buf[68:]))
+	m.EfiTps = math.Float32frombits(binary.LittleEndian.Uint32(buf[72:]))
+	m.EfiExhaustGasTemperature = math.Float32frombits(binary.LittleEndian.Uint32(buf[76:]))
+	m.GeneratorStatus = binary.LittleEndian.Uint16(buf[80:])
+	m.EfiStatus = binary.LittleEndian.Uint16(buf[82:])
+	m.EfiIndex = buf[84]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLoweheiserGovEfi) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10151]
+}
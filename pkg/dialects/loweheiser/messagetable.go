@@ -0,0 +1,15 @@
+//autogenerated:yes
+//nolint:revive
+package loweheiser
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// MessageTable contains the CRC extra, the length and the target offsets
+// of all messages of the dialect. It can be used to validate and route frames
+// without decoding them.
+var MessageTable = dialect.MessageTable{
+	0:     {CRCExtra: 50, MinLength: 9, MaxLength: 9, TargetSystemOffset: -1, TargetComponentOffset: -1},
+	10151: {CRCExtra: 195, MinLength: 85, MaxLength: 85, TargetSystemOffset: -1, TargetComponentOffset: -1},
+}
@@ -0,0 +1,41 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package loweheiser
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	10151: {
+		ID:          10151,
+		Name:        "LOWEHEISER_GOV_EFI",
+		Description: "Composite EFI and Governor data from Loweheiser equipment.  This message is created by the EFI unit based on its own data and data received from a governor attached to that EFI unit.",
+		Fields: []*message.FieldMetadata{
+			{Name: "volt_batt", Type: "float", Description: "Generator Battery voltage."},
+			{Name: "curr_batt", Type: "float", Description: "Generator Battery current."},
+			{Name: "curr_gen", Type: "float", Description: "Current being produced by generator."},
+			{Name: "curr_rot", Type: "float", Description: "Load current being consumed by the UAV (sum of curr_gen and curr_batt)"},
+			{Name: "fuel_level", Type: "float", Description: "Generator fuel remaining in litres."},
+			{Name: "throttle", Type: "float", Description: "Throttle Output."},
+			{Name: "runtime", Type: "uint32_t", Description: "Seconds this generator has run since it was rebooted."},
+			{Name: "until_maintenance", Type: "int32_t", Description: "Seconds until this generator requires maintenance.  A negative value indicates maintenance is past due."},
+			{Name: "rectifier_temp", Type: "float", Description: "The Temperature of the rectifier."},
+			{Name: "generator_temp", Type: "float", Description: "The temperature of the mechanical motor, fuel cell core or generator."},
+			{Name: "efi_batt", Type: "float", Description: "EFI Supply Voltage."},
+			{Name: "efi_rpm", Type: "float", Description: "Motor RPM."},
+			{Name: "efi_pw", Type: "float", Description: "Injector pulse-width in milliseconds."},
+			{Name: "efi_fuel_flow", Type: "float", Description: "Fuel flow rate in litres/hour."},
+			{Name: "efi_fuel_consumed", Type: "float", Description: "Fuel consumed."},
+			{Name: "efi_baro", Type: "float", Description: "Atmospheric pressure."},
+			{Name: "efi_mat", Type: "float", Description: "Manifold Air Temperature."},
+			{Name: "efi_clt", Type: "float", Description: "Cylinder Head Temperature."},
+			{Name: "efi_tps", Type: "float", Description: "Throttle Position."},
+			{Name: "efi_exhaust_gas_temperature", Type: "float", Description: "Exhaust gas temperature."},
+			{Name: "efi_index", Type: "uint8_t", Description: "EFI index."},
+			{Name: "generator_status", Type: "uint16_t", Description: "Generator status."},
+			{Name: "efi_status", Type: "uint16_t", Description: "EFI status."},
+		},
+	},
+}
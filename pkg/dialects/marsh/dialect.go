@@ -1,5 +1,7 @@
 // Package marsh contains the marsh dialect.
 //
+// Definitions hash (SHA256): 918dd2b959955304e5fe4c175e44ef731d6813ce9970c5edd90a9f88fe0324f0
+//
 //autogenerated:yes
 package marsh
 
@@ -259,4 +261,5 @@ var dial = &dialect.Dialect{
 		&MessageMotionCueExtra{},
 		&MessageEyeTrackingData{},
 	},
+	Enums: enums,
 }
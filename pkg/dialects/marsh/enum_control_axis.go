@@ -21,6 +21,13 @@ const (
 	CONTROL_AXIS_YAW CONTROL_AXIS = 3
 )
 
+var values_CONTROL_AXIS = []CONTROL_AXIS{
+	CONTROL_AXIS_ROLL,
+	CONTROL_AXIS_PITCH,
+	CONTROL_AXIS_THRUST,
+	CONTROL_AXIS_YAW,
+}
+
 var value_to_label_CONTROL_AXIS = map[CONTROL_AXIS]string{
 	CONTROL_AXIS_ROLL:   "CONTROL_AXIS_ROLL",
 	CONTROL_AXIS_PITCH:  "CONTROL_AXIS_PITCH",
@@ -60,3 +67,14 @@ func (e CONTROL_AXIS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (CONTROL_AXIS) Values() []CONTROL_AXIS {
+	return append([]CONTROL_AXIS(nil), values_CONTROL_AXIS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e CONTROL_AXIS) IsValid() bool {
+	_, ok := value_to_label_CONTROL_AXIS[e]
+	return ok
+}
@@ -17,6 +17,11 @@ const (
 	MARSH_MANUAL_SETPOINT_MODE_TRIM MARSH_MANUAL_SETPOINT_MODE = 1
 )
 
+var values_MARSH_MANUAL_SETPOINT_MODE = []MARSH_MANUAL_SETPOINT_MODE{
+	MARSH_MANUAL_SETPOINT_MODE_TARGET,
+	MARSH_MANUAL_SETPOINT_MODE_TRIM,
+}
+
 var value_to_label_MARSH_MANUAL_SETPOINT_MODE = map[MARSH_MANUAL_SETPOINT_MODE]string{
 	MARSH_MANUAL_SETPOINT_MODE_TARGET: "MARSH_MANUAL_SETPOINT_MODE_TARGET",
 	MARSH_MANUAL_SETPOINT_MODE_TRIM:   "MARSH_MANUAL_SETPOINT_MODE_TRIM",
@@ -52,3 +57,14 @@ func (e MARSH_MANUAL_SETPOINT_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MARSH_MANUAL_SETPOINT_MODE) Values() []MARSH_MANUAL_SETPOINT_MODE {
+	return append([]MARSH_MANUAL_SETPOINT_MODE(nil), values_MARSH_MANUAL_SETPOINT_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MARSH_MANUAL_SETPOINT_MODE) IsValid() bool {
+	_, ok := value_to_label_MARSH_MANUAL_SETPOINT_MODE[e]
+	return ok
+}
@@ -19,6 +19,11 @@ const (
 	MARSH_MODE_ALL_MESSAGES MARSH_MODE_FLAGS = 33554432
 )
 
+var values_MARSH_MODE_FLAGS = []MARSH_MODE_FLAGS{
+	MARSH_MODE_SINGLE_MESSAGE,
+	MARSH_MODE_ALL_MESSAGES,
+}
+
 var value_to_label_MARSH_MODE_FLAGS = map[MARSH_MODE_FLAGS]string{
 	MARSH_MODE_SINGLE_MESSAGE: "MARSH_MODE_SINGLE_MESSAGE",
 	MARSH_MODE_ALL_MESSAGES:   "MARSH_MODE_ALL_MESSAGES",
@@ -54,3 +59,14 @@ func (e MARSH_MODE_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MARSH_MODE_FLAGS) Values() []MARSH_MODE_FLAGS {
+	return append([]MARSH_MODE_FLAGS(nil), values_MARSH_MODE_FLAGS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MARSH_MODE_FLAGS) IsValid() bool {
+	_, ok := value_to_label_MARSH_MODE_FLAGS[e]
+	return ok
+}
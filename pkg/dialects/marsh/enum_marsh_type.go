@@ -37,6 +37,21 @@ const (
 	MARSH_TYPE_EXPERIMENT_DIRECTOR MARSH_TYPE = 111
 )
 
+var values_MARSH_TYPE = []MARSH_TYPE{
+	MARSH_TYPE_MANAGER,
+	MARSH_TYPE_FLIGHT_MODEL,
+	MARSH_TYPE_CONTROLS,
+	MARSH_TYPE_VISUALISATION,
+	MARSH_TYPE_INSTRUMENTS,
+	MARSH_TYPE_MOTION_PLATFORM,
+	MARSH_TYPE_GSEAT,
+	MARSH_TYPE_EYE_TRACKER,
+	MARSH_TYPE_CONTROL_LOADING,
+	MARSH_TYPE_VIBRATION_SOURCE,
+	MARSH_TYPE_PILOT_TARGET,
+	MARSH_TYPE_EXPERIMENT_DIRECTOR,
+}
+
 var value_to_label_MARSH_TYPE = map[MARSH_TYPE]string{
 	MARSH_TYPE_MANAGER:             "MARSH_TYPE_MANAGER",
 	MARSH_TYPE_FLIGHT_MODEL:        "MARSH_TYPE_FLIGHT_MODEL",
@@ -92,3 +107,14 @@ func (e MARSH_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MARSH_TYPE) Values() []MARSH_TYPE {
+	return append([]MARSH_TYPE(nil), values_MARSH_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MARSH_TYPE) IsValid() bool {
+	_, ok := value_to_label_MARSH_TYPE[e]
+	return ok
+}
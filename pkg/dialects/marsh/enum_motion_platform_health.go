@@ -21,6 +21,13 @@ const (
 	MOTION_PLATFORM_HEALTH_CRITICAL MOTION_PLATFORM_HEALTH = 3
 )
 
+var values_MOTION_PLATFORM_HEALTH = []MOTION_PLATFORM_HEALTH{
+	MOTION_PLATFORM_HEALTH_OK,
+	MOTION_PLATFORM_HEALTH_WARNING,
+	MOTION_PLATFORM_HEALTH_ERROR,
+	MOTION_PLATFORM_HEALTH_CRITICAL,
+}
+
 var value_to_label_MOTION_PLATFORM_HEALTH = map[MOTION_PLATFORM_HEALTH]string{
 	MOTION_PLATFORM_HEALTH_OK:       "MOTION_PLATFORM_HEALTH_OK",
 	MOTION_PLATFORM_HEALTH_WARNING:  "MOTION_PLATFORM_HEALTH_WARNING",
@@ -60,3 +67,14 @@ func (e MOTION_PLATFORM_HEALTH) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MOTION_PLATFORM_HEALTH) Values() []MOTION_PLATFORM_HEALTH {
+	return append([]MOTION_PLATFORM_HEALTH(nil), values_MOTION_PLATFORM_HEALTH...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MOTION_PLATFORM_HEALTH) IsValid() bool {
+	_, ok := value_to_label_MOTION_PLATFORM_HEALTH[e]
+	return ok
+}
@@ -27,6 +27,16 @@ const (
 	MOTION_PLATFORM_MODE_ENGAGED MOTION_PLATFORM_MODE = 6
 )
 
+var values_MOTION_PLATFORM_MODE = []MOTION_PLATFORM_MODE{
+	MOTION_PLATFORM_MODE_UNKNOWN,
+	MOTION_PLATFORM_MODE_UNINITIALIZED,
+	MOTION_PLATFORM_MODE_OFF,
+	MOTION_PLATFORM_MODE_SETTLED,
+	MOTION_PLATFORM_MODE_NEUTRAL,
+	MOTION_PLATFORM_MODE_FROZEN,
+	MOTION_PLATFORM_MODE_ENGAGED,
+}
+
 var value_to_label_MOTION_PLATFORM_MODE = map[MOTION_PLATFORM_MODE]string{
 	MOTION_PLATFORM_MODE_UNKNOWN:       "MOTION_PLATFORM_MODE_UNKNOWN",
 	MOTION_PLATFORM_MODE_UNINITIALIZED: "MOTION_PLATFORM_MODE_UNINITIALIZED",
@@ -72,3 +82,14 @@ func (e MOTION_PLATFORM_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MOTION_PLATFORM_MODE) Values() []MOTION_PLATFORM_MODE {
+	return append([]MOTION_PLATFORM_MODE(nil), values_MOTION_PLATFORM_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MOTION_PLATFORM_MODE) IsValid() bool {
+	_, ok := value_to_label_MOTION_PLATFORM_MODE[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package marsh
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Send data about a control axis from a control loading system. This is the primary message for logging data from MARSH_TYPE_CONTROL_LOADING.
 type MessageControlLoadingAxis struct {
 	// Timestamp (time since system boot).
@@ -20,3 +27,39 @@ type MessageControlLoadingAxis struct {
 func (*MessageControlLoadingAxis) GetID() uint32 {
 	return 52501
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageControlLoadingAxis) MAVLinkCRCExtra() byte {
+	return 240
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageControlLoadingAxis) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageControlLoadingAxis) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Position))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Velocity))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Force))
+	buf[16] = byte(m.Axis)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageControlLoadingAxis) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Position = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Velocity = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Force = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Axis = CONTROL_AXIS(buf[16])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageControlLoadingAxis) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52501]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package marsh
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Data for tracking of pilot eye gaze. This is the primary message for MARSH_TYPE_EYE_TRACKER.
 type MessageEyeTrackingData struct {
 	// Timestamp (time since system boot).
@@ -36,3 +43,55 @@ type MessageEyeTrackingData struct {
 func (*MessageEyeTrackingData) GetID() uint32 {
 	return 52505
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageEyeTrackingData) MAVLinkCRCExtra() byte {
+	return 215
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageEyeTrackingData) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 50
+	}
+	return 50
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEyeTrackingData) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeUsec)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.GazeOriginX))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.GazeOriginY))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.GazeOriginZ))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.GazeDirectionX))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.GazeDirectionY))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.GazeDirectionZ))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.VideoGazeX))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.VideoGazeY))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.SurfaceGazeX))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.SurfaceGazeY))
+	buf[48] = m.SensorId
+	buf[49] = m.SurfaceId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageEyeTrackingData) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeUsec = binary.LittleEndian.Uint64(buf[0:])
+	m.GazeOriginX = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.GazeOriginY = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.GazeOriginZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.GazeDirectionX = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.GazeDirectionY = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.GazeDirectionZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.VideoGazeX = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.VideoGazeY = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.SurfaceGazeX = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.SurfaceGazeY = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.SensorId = buf[48]
+	m.SurfaceId = buf[49]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageEyeTrackingData) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52505]
+}
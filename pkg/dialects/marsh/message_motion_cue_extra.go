@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package marsh
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // These values are an extra cue that should be added to accelerations and rotations etc. resulting from aircraft state, with the resulting cue being the sum of the latest aircraft and extra values. An example use case would be a cockpit shaker.
 type MessageMotionCueExtra struct {
 	// Timestamp (time since system boot).
@@ -24,3 +31,43 @@ type MessageMotionCueExtra struct {
 func (*MessageMotionCueExtra) GetID() uint32 {
 	return 52504
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMotionCueExtra) MAVLinkCRCExtra() byte {
+	return 177
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMotionCueExtra) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMotionCueExtra) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.VelRoll))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.VelPitch))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.VelYaw))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.AccX))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AccY))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.AccZ))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMotionCueExtra) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.VelRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.VelPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.VelYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.AccX = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.AccY = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.AccZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMotionCueExtra) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52504]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package marsh
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // State report for motion platform used for moving the cockpit with the pilot for motion cueing. This is the primary message for MARSH_TYPE_MOTION_PLATFORM.
 type MessageMotionPlatformState struct {
 	// Timestamp (time since system boot).
@@ -52,3 +59,71 @@ type MessageMotionPlatformState struct {
 func (*MessageMotionPlatformState) GetID() uint32 {
 	return 52502
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMotionPlatformState) MAVLinkCRCExtra() byte {
+	return 88
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMotionPlatformState) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 78
+	}
+	return 78
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMotionPlatformState) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.X))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.Y))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Z))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Roll))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.VelX))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.VelY))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.VelZ))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.VelRoll))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.VelPitch))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.VelYaw))
+	binary.LittleEndian.PutUint32(buf[52:], math.Float32bits(m.AccX))
+	binary.LittleEndian.PutUint32(buf[56:], math.Float32bits(m.AccY))
+	binary.LittleEndian.PutUint32(buf[60:], math.Float32bits(m.AccZ))
+	binary.LittleEndian.PutUint32(buf[64:], math.Float32bits(m.AccRoll))
+	binary.LittleEndian.PutUint32(buf[68:], math.Float32bits(m.AccPitch))
+	binary.LittleEndian.PutUint32(buf[72:], math.Float32bits(m.AccYaw))
+	buf[76] = byte(m.Health)
+	buf[77] = byte(m.Mode)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMotionPlatformState) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.VelX = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.VelY = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.VelZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.VelRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.VelPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.VelYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	m.AccX = math.Float32frombits(binary.LittleEndian.Uint32(buf[52:]))
+	m.AccY = math.Float32frombits(binary.LittleEndian.Uint32(buf[56:]))
+	m.AccZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[60:]))
+	m.AccRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[64:]))
+	m.AccPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[68:]))
+	m.AccYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[72:]))
+	m.Health = MOTION_PLATFORM_HEALTH(buf[76])
+	m.Mode = MOTION_PLATFORM_MODE(buf[77])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMotionPlatformState) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52502]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package marsh
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // State report specific for eMotion Motion System by Bosch Rexroth B.V. Values applicable to motion platforms in general are sent in MOTION_PLATFORM_STATE with the same timestamp. Actuators are numbered in a clockwise direction when looking from above, starting from the front right. Actuator position is 0 when actuator is in mid-stroke.
 type MessageRexrothMotionPlatform struct {
 	// Timestamp (time since system boot).
@@ -54,3 +61,73 @@ type MessageRexrothMotionPlatform struct {
 func (*MessageRexrothMotionPlatform) GetID() uint32 {
 	return 52503
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageRexrothMotionPlatform) MAVLinkCRCExtra() byte {
+	return 96
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageRexrothMotionPlatform) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 85
+	}
+	return 85
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRexrothMotionPlatform) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	binary.LittleEndian.PutUint32(buf[4:], m.FrameCount)
+	binary.LittleEndian.PutUint32(buf[8:], m.MotionStatus)
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.Actuator1))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.Actuator2))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.Actuator3))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.Actuator4))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.Actuator5))
+	binary.LittleEndian.PutUint32(buf[32:], math.Float32bits(m.Actuator6))
+	binary.LittleEndian.PutUint32(buf[36:], math.Float32bits(m.PlatformSetpointX))
+	binary.LittleEndian.PutUint32(buf[40:], math.Float32bits(m.PlatformSetpointY))
+	binary.LittleEndian.PutUint32(buf[44:], math.Float32bits(m.PlatformSetpointZ))
+	binary.LittleEndian.PutUint32(buf[48:], math.Float32bits(m.PlatformSetpointRoll))
+	binary.LittleEndian.PutUint32(buf[52:], math.Float32bits(m.PlatformSetpointPitch))
+	binary.LittleEndian.PutUint32(buf[56:], math.Float32bits(m.PlatformSetpointYaw))
+	binary.LittleEndian.PutUint32(buf[60:], math.Float32bits(m.EffectSetpointX))
+	binary.LittleEndian.PutUint32(buf[64:], math.Float32bits(m.EffectSetpointY))
+	binary.LittleEndian.PutUint32(buf[68:], math.Float32bits(m.EffectSetpointZ))
+	binary.LittleEndian.PutUint32(buf[72:], math.Float32bits(m.EffectSetpointRoll))
+	binary.LittleEndian.PutUint32(buf[76:], math.Float32bits(m.EffectSetpointPitch))
+	binary.LittleEndian.PutUint32(buf[80:], math.Float32bits(m.EffectSetpointYaw))
+	buf[84] = m.ErrorCode
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageRexrothMotionPlatform) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.FrameCount = binary.LittleEndian.Uint32(buf[4:])
+	m.MotionStatus = binary.LittleEndian.Uint32(buf[8:])
+	m.Actuator1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.Actuator2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.Actuator3 = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.Actuator4 = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.Actuator5 = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.Actuator6 = math.Float32frombits(binary.LittleEndian.Uint32(buf[32:]))
+	m.PlatformSetpointX = math.Float32frombits(binary.LittleEndian.Uint32(buf[36:]))
+	m.PlatformSetpointY = math.Float32frombits(binary.LittleEndian.Uint32(buf[40:]))
+	m.PlatformSetpointZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[44:]))
+	m.PlatformSetpointRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[48:]))
+	m.PlatformSetpointPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[52:]))
+	m.PlatformSetpointYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[56:]))
+	m.EffectSetpointX = math.Float32frombits(binary.LittleEndian.Uint32(buf[60:]))
+	m.EffectSetpointY = math.Float32frombits(binary.LittleEndian.Uint32(buf[64:]))
+	m.EffectSetpointZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[68:]))
+	m.EffectSetpointRoll = math.Float32frombits(binary.LittleEndian.Uint32(buf[72:]))
+	m.EffectSetpointPitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[76:]))
+	m.EffectSetpointYaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[80:]))
+	m.ErrorCode = buf[84]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageRexrothMotionPlatform) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52503]
+}
@@ -0,0 +1,114 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package marsh
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	52501: {
+		ID:          52501,
+		Name:        "CONTROL_LOADING_AXIS",
+		Description: "Send data about a control axis from a control loading system. This is the primary message for logging data from MARSH_TYPE_CONTROL_LOADING.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "axis", Type: "uint8_t", Enum: "CONTROL_AXIS", Description: "Control axis on which the measurements were taken."},
+			{Name: "position", Type: "float", Description: "Axis position"},
+			{Name: "velocity", Type: "float", Description: "Axis velocity"},
+			{Name: "force", Type: "float", Description: "Force applied in the pilot in the direction of movement axis (not gripping force), measured at the position of pilot's third finger (ring). Unit N (Newton), currently not part of mavschema.xsd"},
+		},
+	},
+	52502: {
+		ID:          52502,
+		Name:        "MOTION_PLATFORM_STATE",
+		Description: "State report for motion platform used for moving the cockpit with the pilot for motion cueing. This is the primary message for MARSH_TYPE_MOTION_PLATFORM.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "health", Type: "uint8_t", Enum: "MOTION_PLATFORM_HEALTH", Description: "Generic system health (error and warning) status."},
+			{Name: "mode", Type: "uint8_t", Enum: "MOTION_PLATFORM_MODE", Description: "Generic system operating mode."},
+			{Name: "x", Type: "float", Description: "X axis (surge) position, positive forward."},
+			{Name: "y", Type: "float", Description: "Y axis (sway) position, positive right."},
+			{Name: "z", Type: "float", Description: "Z axis (heave) position, positive down."},
+			{Name: "roll", Type: "float", Description: "Roll position, positive right."},
+			{Name: "pitch", Type: "float", Description: "Pitch position, positive nose up."},
+			{Name: "yaw", Type: "float", Description: "Yaw position, positive right."},
+			{Name: "vel_x", Type: "float", Description: "X axis (surge) velocity, positive forward."},
+			{Name: "vel_y", Type: "float", Description: "Y axis (sway) velocity, positive right."},
+			{Name: "vel_z", Type: "float", Description: "Z axis (heave) velocity, positive down."},
+			{Name: "vel_roll", Type: "float", Description: "Roll velocity, positive right."},
+			{Name: "vel_pitch", Type: "float", Description: "Pitch velocity, positive nose up."},
+			{Name: "vel_yaw", Type: "float", Description: "Yaw velocity, positive right."},
+			{Name: "acc_x", Type: "float", Description: "X axis (surge) acceleration, positive forward."},
+			{Name: "acc_y", Type: "float", Description: "Y axis (sway) acceleration, positive right."},
+			{Name: "acc_z", Type: "float", Description: "Z axis (heave) acceleration, positive down."},
+			{Name: "acc_roll", Type: "float", Description: "Roll acceleration, positive right. Unit rad/s/s, currently not part of mavschema.xsd"},
+			{Name: "acc_pitch", Type: "float", Description: "Pitch acceleration, positive nose up. Unit rad/s/s, currently not part of mavschema.xsd"},
+			{Name: "acc_yaw", Type: "float", Description: "Yaw acceleration, positive right. Unit rad/s/s, currently not part of mavschema.xsd"},
+		},
+	},
+	52503: {
+		ID:          52503,
+		Name:        "REXROTH_MOTION_PLATFORM",
+		Description: "State report specific for eMotion Motion System by Bosch Rexroth B.V. Values applicable to motion platforms in general are sent in MOTION_PLATFORM_STATE with the same timestamp. Actuators are numbered in a clockwise direction when looking from above, starting from the front right. Actuator position is 0 when actuator is in mid-stroke.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "frame_count", Type: "uint32_t", Description: "Number of message as sent by the Motion System."},
+			{Name: "motion_status", Type: "uint32_t", Description: "Motion Status variable as sent by the system."},
+			{Name: "error_code", Type: "uint8_t", Description: "Error code extracted from motion status."},
+			{Name: "actuator1", Type: "float", Description: "Current actuator 1 position."},
+			{Name: "actuator2", Type: "float", Description: "Current actuator 2 position."},
+			{Name: "actuator3", Type: "float", Description: "Current actuator 3 position."},
+			{Name: "actuator4", Type: "float", Description: "Current actuator 4 position."},
+			{Name: "actuator5", Type: "float", Description: "Current actuator 5 position."},
+			{Name: "actuator6", Type: "float", Description: "Current actuator 6 position."},
+			{Name: "platform_setpoint_x", Type: "float", Description: "X axis (surge) platform setpoint, positive forward."},
+			{Name: "platform_setpoint_y", Type: "float", Description: "Y axis (sway) platform setpoint, positive right."},
+			{Name: "platform_setpoint_z", Type: "float", Description: "Z axis (heave) platform setpoint, positive down."},
+			{Name: "platform_setpoint_roll", Type: "float", Description: "Roll platform setpoint, positive right."},
+			{Name: "platform_setpoint_pitch", Type: "float", Description: "Pitch platform setpoint, positive nose up."},
+			{Name: "platform_setpoint_yaw", Type: "float", Description: "Yaw platform setpoint, positive right."},
+			{Name: "effect_setpoint_x", Type: "float", Description: "X axis (surge) special effect setpoint, positive forward."},
+			{Name: "effect_setpoint_y", Type: "float", Description: "Y axis (sway) special effect setpoint, positive right."},
+			{Name: "effect_setpoint_z", Type: "float", Description: "Z axis (heave) special effect setpoint, positive down."},
+			{Name: "effect_setpoint_roll", Type: "float", Description: "Roll special effect setpoint, positive right."},
+			{Name: "effect_setpoint_pitch", Type: "float", Description: "Pitch special effect setpoint, positive nose up."},
+			{Name: "effect_setpoint_yaw", Type: "float", Description: "Yaw special effect setpoint, positive right."},
+		},
+	},
+	52504: {
+		ID:          52504,
+		Name:        "MOTION_CUE_EXTRA",
+		Description: "These values are an extra cue that should be added to accelerations and rotations etc. resulting from aircraft state, with the resulting cue being the sum of the latest aircraft and extra values. An example use case would be a cockpit shaker.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "vel_roll", Type: "float", Description: "Roll velocity, positive right."},
+			{Name: "vel_pitch", Type: "float", Description: "Pitch velocity, positive nose up."},
+			{Name: "vel_yaw", Type: "float", Description: "Yaw velocity, positive right."},
+			{Name: "acc_x", Type: "float", Description: "X axis (surge) acceleration, positive forward."},
+			{Name: "acc_y", Type: "float", Description: "Y axis (sway) acceleration, positive right."},
+			{Name: "acc_z", Type: "float", Description: "Z axis (heave) acceleration, positive down."},
+		},
+	},
+	52505: {
+		ID:          52505,
+		Name:        "EYE_TRACKING_DATA",
+		Description: "Data for tracking of pilot eye gaze. This is the primary message for MARSH_TYPE_EYE_TRACKER.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_usec", Type: "uint64_t", Description: "Timestamp (time since system boot)."},
+			{Name: "sensor_id", Type: "uint8_t", Description: "Sensor ID, used for identifying the device and/or person tracked. Set to zero if unknown/unused."},
+			{Name: "gaze_origin_x", Type: "float", Description: "X axis of gaze origin point, NaN if unknown. The reference system depends on specific application."},
+			{Name: "gaze_origin_y", Type: "float", Description: "Y axis of gaze origin point, NaN if unknown. The reference system depends on specific application."},
+			{Name: "gaze_origin_z", Type: "float", Description: "Z axis of gaze origin point, NaN if unknown. The reference system depends on specific application."},
+			{Name: "gaze_direction_x", Type: "float", Description: "X axis of gaze direction vector, expected to be normalized to unit magnitude, NaN if unknown. The reference system should match origin point."},
+			{Name: "gaze_direction_y", Type: "float", Description: "Y axis of gaze direction vector, expected to be normalized to unit magnitude, NaN if unknown. The reference system should match origin point."},
+			{Name: "gaze_direction_z", Type: "float", Description: "Z axis of gaze direction vector, expected to be normalized to unit magnitude, NaN if unknown. The reference system should match origin point."},
+			{Name: "video_gaze_x", Type: "float", Description: "Gaze focal point on video feed x value (normalized 0..1, 0 is left, 1 is right), NaN if unknown"},
+			{Name: "video_gaze_y", Type: "float", Description: "Gaze focal point on video feed y value (normalized 0..1, 0 is top, 1 is bottom), NaN if unknown"},
+			{Name: "surface_id", Type: "uint8_t", Description: "Identifier of surface for 2D gaze point, or an identified region when surface point is invalid. Set to zero if unknown/unused."},
+			{Name: "surface_gaze_x", Type: "float", Description: "Gaze focal point on surface x value (normalized 0..1, 0 is left, 1 is right), NaN if unknown"},
+			{Name: "surface_gaze_y", Type: "float", Description: "Gaze focal point on surface y value (normalized 0..1, 0 is top, 1 is bottom), NaN if unknown"},
+		},
+	},
+}
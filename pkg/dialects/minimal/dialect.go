@@ -1,5 +1,7 @@
 // Package minimal contains the minimal dialect.
 //
+// Definitions hash (SHA256): 9a64c30fdd25a82e1ecdc4372c7c57091e31f451bb9024ac849f618db20280d1
+//
 //autogenerated:yes
 package minimal
 
@@ -18,4 +20,5 @@ var dial = &dialect.Dialect{
 		// minimal
 		&MessageHeartbeat{},
 	},
+	Enums: enums,
 }
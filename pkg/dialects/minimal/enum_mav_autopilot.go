@@ -55,6 +55,30 @@ const (
 	MAV_AUTOPILOT_REFLEX MAV_AUTOPILOT = 20
 )
 
+var values_MAV_AUTOPILOT = []MAV_AUTOPILOT{
+	MAV_AUTOPILOT_GENERIC,
+	MAV_AUTOPILOT_RESERVED,
+	MAV_AUTOPILOT_SLUGS,
+	MAV_AUTOPILOT_ARDUPILOTMEGA,
+	MAV_AUTOPILOT_OPENPILOT,
+	MAV_AUTOPILOT_GENERIC_WAYPOINTS_ONLY,
+	MAV_AUTOPILOT_GENERIC_WAYPOINTS_AND_SIMPLE_NAVIGATION_ONLY,
+	MAV_AUTOPILOT_GENERIC_MISSION_FULL,
+	MAV_AUTOPILOT_INVALID,
+	MAV_AUTOPILOT_PPZ,
+	MAV_AUTOPILOT_UDB,
+	MAV_AUTOPILOT_FP,
+	MAV_AUTOPILOT_PX4,
+	MAV_AUTOPILOT_SMACCMPILOT,
+	MAV_AUTOPILOT_AUTOQUAD,
+	MAV_AUTOPILOT_ARMAZILA,
+	MAV_AUTOPILOT_AEROB,
+	MAV_AUTOPILOT_ASLUAV,
+	MAV_AUTOPILOT_SMARTAP,
+	MAV_AUTOPILOT_AIRRAILS,
+	MAV_AUTOPILOT_REFLEX,
+}
+
 var value_to_label_MAV_AUTOPILOT = map[MAV_AUTOPILOT]string{
 	MAV_AUTOPILOT_GENERIC:                                      "MAV_AUTOPILOT_GENERIC",
 	MAV_AUTOPILOT_RESERVED:                                     "MAV_AUTOPILOT_RESERVED",
@@ -128,3 +152,14 @@ func (e MAV_AUTOPILOT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_AUTOPILOT) Values() []MAV_AUTOPILOT {
+	return append([]MAV_AUTOPILOT(nil), values_MAV_AUTOPILOT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_AUTOPILOT) IsValid() bool {
+	_, ok := value_to_label_MAV_AUTOPILOT[e]
+	return ok
+}
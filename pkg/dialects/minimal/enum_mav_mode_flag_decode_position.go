@@ -99,3 +99,33 @@ func (e MAV_MODE_FLAG_DECODE_POSITION) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_MODE_FLAG_DECODE_POSITION) Values() []MAV_MODE_FLAG_DECODE_POSITION {
+	return append([]MAV_MODE_FLAG_DECODE_POSITION(nil), values_MAV_MODE_FLAG_DECODE_POSITION...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_MODE_FLAG_DECODE_POSITION) IsValid() bool {
+	var mask MAV_MODE_FLAG_DECODE_POSITION
+	for _, val := range values_MAV_MODE_FLAG_DECODE_POSITION {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_MODE_FLAG_DECODE_POSITION) Flags() []MAV_MODE_FLAG_DECODE_POSITION {
+	var flags []MAV_MODE_FLAG_DECODE_POSITION
+	for _, val := range values_MAV_MODE_FLAG_DECODE_POSITION {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_MODE_FLAG_DECODE_POSITION) Has(flags MAV_MODE_FLAG_DECODE_POSITION) bool {
+	return e&flags == flags
+}
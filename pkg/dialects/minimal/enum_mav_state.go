@@ -30,6 +30,18 @@ const (
 	MAV_STATE_FLIGHT_TERMINATION MAV_STATE = 8
 )
 
+var values_MAV_STATE = []MAV_STATE{
+	MAV_STATE_UNINIT,
+	MAV_STATE_BOOT,
+	MAV_STATE_CALIBRATING,
+	MAV_STATE_STANDBY,
+	MAV_STATE_ACTIVE,
+	MAV_STATE_CRITICAL,
+	MAV_STATE_EMERGENCY,
+	MAV_STATE_POWEROFF,
+	MAV_STATE_FLIGHT_TERMINATION,
+}
+
 var value_to_label_MAV_STATE = map[MAV_STATE]string{
 	MAV_STATE_UNINIT:             "MAV_STATE_UNINIT",
 	MAV_STATE_BOOT:               "MAV_STATE_BOOT",
@@ -79,3 +91,14 @@ func (e MAV_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STATE) Values() []MAV_STATE {
+	return append([]MAV_STATE(nil), values_MAV_STATE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_STATE) IsValid() bool {
+	_, ok := value_to_label_MAV_STATE[e]
+	return ok
+}
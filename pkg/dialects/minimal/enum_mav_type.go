@@ -113,6 +113,59 @@ const (
 	MAV_TYPE_RADIO MAV_TYPE = 49
 )
 
+var values_MAV_TYPE = []MAV_TYPE{
+	MAV_TYPE_GENERIC,
+	MAV_TYPE_FIXED_WING,
+	MAV_TYPE_QUADROTOR,
+	MAV_TYPE_COAXIAL,
+	MAV_TYPE_HELICOPTER,
+	MAV_TYPE_ANTENNA_TRACKER,
+	MAV_TYPE_GCS,
+	MAV_TYPE_AIRSHIP,
+	MAV_TYPE_FREE_BALLOON,
+	MAV_TYPE_ROCKET,
+	MAV_TYPE_GROUND_ROVER,
+	MAV_TYPE_SURFACE_BOAT,
+	MAV_TYPE_SUBMARINE,
+	MAV_TYPE_HEXAROTOR,
+	MAV_TYPE_OCTOROTOR,
+	MAV_TYPE_TRICOPTER,
+	MAV_TYPE_FLAPPING_WING,
+	MAV_TYPE_KITE,
+	MAV_TYPE_ONBOARD_CONTROLLER,
+	MAV_TYPE_VTOL_TAILSITTER_DUOROTOR,
+	MAV_TYPE_VTOL_TAILSITTER_QUADROTOR,
+	MAV_TYPE_VTOL_TILTROTOR,
+	MAV_TYPE_VTOL_FIXEDROTOR,
+	MAV_TYPE_VTOL_TAILSITTER,
+	MAV_TYPE_VTOL_TILTWING,
+	MAV_TYPE_VTOL_RESERVED5,
+	MAV_TYPE_GIMBAL,
+	MAV_TYPE_ADSB,
+	MAV_TYPE_PARAFOIL,
+	MAV_TYPE_DODECAROTOR,
+	MAV_TYPE_CAMERA,
+	MAV_TYPE_CHARGING_STATION,
+	MAV_TYPE_FLARM,
+	MAV_TYPE_SERVO,
+	MAV_TYPE_ODID,
+	MAV_TYPE_DECAROTOR,
+	MAV_TYPE_BATTERY,
+	MAV_TYPE_PARACHUTE,
+	MAV_TYPE_LOG,
+	MAV_TYPE_OSD,
+	MAV_TYPE_IMU,
+	MAV_TYPE_GPS,
+	MAV_TYPE_WINCH,
+	MAV_TYPE_GENERIC_MULTIROTOR,
+	MAV_TYPE_ILLUMINATOR,
+	MAV_TYPE_SPACECRAFT_ORBITER,
+	MAV_TYPE_GROUND_QUADRUPED,
+	MAV_TYPE_VTOL_GYRODYNE,
+	MAV_TYPE_GRIPPER,
+	MAV_TYPE_RADIO,
+}
+
 var value_to_label_MAV_TYPE = map[MAV_TYPE]string{
 	MAV_TYPE_GENERIC:                   "MAV_TYPE_GENERIC",
 	MAV_TYPE_FIXED_WING:                "MAV_TYPE_FIXED_WING",
@@ -244,3 +297,14 @@ func (e MAV_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_TYPE) Values() []MAV_TYPE {
+	return append([]MAV_TYPE(nil), values_MAV_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_TYPE[e]
+	return ok
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package minimal
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // The heartbeat message shows that a system or component is present and responding. The type and autopilot fields (along with the message component id), allow the receiving system to treat further messages from this system appropriately (e.g. by laying out the user interface based on the autopilot). This microservice is documented at https://mavlink.io/en/services/heartbeat.html
 type MessageHeartbeat struct {
 	// Vehicle or component type. For a flight controller component the vehicle type (quadrotor, helicopter, etc.). For other components the component type (e.g. camera, gimbal, etc.). This should be used in preference to component id for identifying the component type.
@@ -22,3 +28,41 @@ type MessageHeartbeat struct {
 func (*MessageHeartbeat) GetID() uint32 {
 	return 0
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageHeartbeat) MAVLinkCRCExtra() byte {
+	return 50
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageHeartbeat) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHeartbeat) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	buf[4] = byte(m.Type)
+	buf[5] = byte(m.Autopilot)
+	buf[6] = byte(m.BaseMode)
+	buf[7] = byte(m.SystemStatus)
+	buf[8] = m.MavlinkVersion
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageHeartbeat) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.Type = MAV_TYPE(buf[4])
+	m.Autopilot = MAV_AUTOPILOT(buf[5])
+	m.BaseMode = MAV_MODE_FLAG(buf[6])
+	m.SystemStatus = MAV_STATE(buf[7])
+	m.MavlinkVersion = buf[8]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageHeartbeat) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[0]
+}
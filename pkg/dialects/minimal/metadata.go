@@ -0,0 +1,24 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package minimal
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	0: {
+		ID:          0,
+		Name:        "HEARTBEAT",
+		Description: "The heartbeat message shows that a system or component is present and responding. The type and autopilot fields (along with the message component id), allow the receiving system to treat further messages from this system appropriately (e.g. by laying out the user interface based on the autopilot). This microservice is documented at https://mavlink.io/en/services/heartbeat.html",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Enum: "MAV_TYPE", Description: "Vehicle or component type. For a flight controller component the vehicle type (quadrotor, helicopter, etc.). For other components the component type (e.g. camera, gimbal, etc.). This should be used in preference to component id for identifying the component type."},
+			{Name: "autopilot", Type: "uint8_t", Enum: "MAV_AUTOPILOT", Description: "Autopilot type / class. Use MAV_AUTOPILOT_INVALID for components that are not flight controllers."},
+			{Name: "base_mode", Type: "uint8_t", Enum: "MAV_MODE_FLAG", Bitmask: true, Description: "System mode bitmap."},
+			{Name: "custom_mode", Type: "uint32_t", Description: "A bitfield for use for autopilot-specific flags"},
+			{Name: "system_status", Type: "uint8_t", Enum: "MAV_STATE", Description: "System status flag."},
+			{Name: "mavlink_version", Type: "uint8_t", Description: "MAVLink version, not writable by user, gets added by protocol because of magic data type: uint8_t_mavlink_version"},
+		},
+	},
+}
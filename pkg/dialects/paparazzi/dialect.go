@@ -1,5 +1,7 @@
 // Package paparazzi contains the paparazzi dialect.
 //
+// Definitions hash (SHA256): e6f9813f9ddc6ab6cfe825de42b95712c1e9f231927fab6be97a0db4344fa30f
+//
 //autogenerated:yes
 package paparazzi
 
@@ -259,4 +261,5 @@ var dial = &dialect.Dialect{
 		&MessageScriptCount{},
 		&MessageScriptCurrent{},
 	},
+	Enums: enums,
 }
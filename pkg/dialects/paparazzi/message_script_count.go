@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package paparazzi
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // This message is emitted as response to SCRIPT_REQUEST_LIST by the MAV to get the number of mission scripts.
 type MessageScriptCount struct {
 	// System ID
@@ -16,3 +22,35 @@ type MessageScriptCount struct {
 func (*MessageScriptCount) GetID() uint32 {
 	return 183
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScriptCount) MAVLinkCRCExtra() byte {
+	return 186
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScriptCount) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptCount) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Count)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptCount) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Count = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScriptCount) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[183]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package paparazzi
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // This message informs about the currently active SCRIPT.
 type MessageScriptCurrent struct {
 	// Active Sequence
@@ -12,3 +18,31 @@ type MessageScriptCurrent struct {
 func (*MessageScriptCurrent) GetID() uint32 {
 	return 184
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScriptCurrent) MAVLinkCRCExtra() byte {
+	return 40
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScriptCurrent) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptCurrent) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptCurrent) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScriptCurrent) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[184]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package paparazzi
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message encoding a mission script item. This message is emitted upon a request for the next script item.
 type MessageScriptItem struct {
 	// System ID
@@ -18,3 +25,41 @@ type MessageScriptItem struct {
 func (*MessageScriptItem) GetID() uint32 {
 	return 180
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScriptItem) MAVLinkCRCExtra() byte {
+	return 231
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScriptItem) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 54
+	}
+	return 54
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptItem) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	copy(buf[4:54], m.Name)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptItem) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	if n := bytes.IndexByte(buf[4:54], 0); n >= 0 {
+		m.Name = string(buf[4 : 4+n])
+	} else {
+		m.Name = string(buf[4:54])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScriptItem) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[180]
+}
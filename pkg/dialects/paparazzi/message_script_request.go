@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package paparazzi
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request script item with the sequence number seq. The response of the system to this message should be a SCRIPT_ITEM message.
 type MessageScriptRequest struct {
 	// System ID
@@ -16,3 +22,35 @@ type MessageScriptRequest struct {
 func (*MessageScriptRequest) GetID() uint32 {
 	return 181
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScriptRequest) MAVLinkCRCExtra() byte {
+	return 129
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScriptRequest) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptRequest) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Seq)
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptRequest) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Seq = binary.LittleEndian.Uint16(buf[0:])
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScriptRequest) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[181]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package paparazzi
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request the overall list of mission items from the system/component.
 type MessageScriptRequestList struct {
 	// System ID
@@ -14,3 +18,33 @@ type MessageScriptRequestList struct {
 func (*MessageScriptRequestList) GetID() uint32 {
 	return 182
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageScriptRequestList) MAVLinkCRCExtra() byte {
+	return 115
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageScriptRequestList) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 2
+	}
+	return 2
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptRequestList) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = m.TargetSystem
+	buf[1] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageScriptRequestList) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TargetSystem = buf[0]
+	m.TargetComponent = buf[1]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageScriptRequestList) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[182]
+}
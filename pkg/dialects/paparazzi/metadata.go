@@ -0,0 +1,59 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package paparazzi
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	180: {
+		ID:          180,
+		Name:        "SCRIPT_ITEM",
+		Description: "Message encoding a mission script item. This message is emitted upon a request for the next script item.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+			{Name: "name", Type: "char", ArrayLength: 50, Description: "The name of the mission script, NULL terminated."},
+		},
+	},
+	181: {
+		ID:          181,
+		Name:        "SCRIPT_REQUEST",
+		Description: "Request script item with the sequence number seq. The response of the system to this message should be a SCRIPT_ITEM message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "seq", Type: "uint16_t", Description: "Sequence"},
+		},
+	},
+	182: {
+		ID:          182,
+		Name:        "SCRIPT_REQUEST_LIST",
+		Description: "Request the overall list of mission items from the system/component.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+		},
+	},
+	183: {
+		ID:          183,
+		Name:        "SCRIPT_COUNT",
+		Description: "This message is emitted as response to SCRIPT_REQUEST_LIST by the MAV to get the number of mission scripts.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "count", Type: "uint16_t", Description: "Number of script items in the sequence"},
+		},
+	},
+	184: {
+		ID:          184,
+		Name:        "SCRIPT_CURRENT",
+		Description: "This message informs about the currently active SCRIPT.",
+		Fields: []*message.FieldMetadata{
+			{Name: "seq", Type: "uint16_t", Description: "Active Sequence"},
+		},
+	},
+}
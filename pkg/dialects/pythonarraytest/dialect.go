@@ -1,5 +1,7 @@
 // Package pythonarraytest contains the pythonarraytest dialect.
 //
+// Definitions hash (SHA256): efb8807ac3163da929674153129e459fbbf712bffaa9285ab79e71f755a87910
+//
 //autogenerated:yes
 package pythonarraytest
 
@@ -262,4 +264,5 @@ var dial = &dialect.Dialect{
 		&MessageArrayTest_7{},
 		&MessageArrayTest_8{},
 	},
+	Enums: enums,
 }
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #0.
 type MessageArrayTest_0 struct {
 	// Stub field
@@ -20,3 +26,55 @@ type MessageArrayTest_0 struct {
 func (*MessageArrayTest_0) GetID() uint32 {
 	return 17150
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_0) MAVLinkCRCExtra() byte {
+	return 26
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_0) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 33
+	}
+	return 33
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_0) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	for i, v := range m.ArU16 {
+		binary.LittleEndian.PutUint16(buf[16+i*2:], v)
+	}
+	buf[24] = m.V1
+	for i, v := range m.ArI8 {
+		buf[25+i] = byte(v)
+	}
+	for i, v := range m.ArU8 {
+		buf[29+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_0) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	for i := range m.ArU16 {
+		m.ArU16[i] = binary.LittleEndian.Uint16(buf[16+i*2:])
+	}
+	m.V1 = buf[24]
+	for i := range m.ArI8 {
+		m.ArI8[i] = int8(buf[25+i])
+	}
+	for i := range m.ArU8 {
+		m.ArU8[i] = buf[29+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_0) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17150]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #1.
 type MessageArrayTest_1 struct {
 	// Value array
@@ -12,3 +18,35 @@ type MessageArrayTest_1 struct {
 func (*MessageArrayTest_1) GetID() uint32 {
 	return 17151
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_1) MAVLinkCRCExtra() byte {
+	return 72
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_1) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 16
+	}
+	return 16
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_1) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_1) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_1) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17151]
+}
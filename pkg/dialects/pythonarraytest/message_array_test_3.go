@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #3.
 type MessageArrayTest_3 struct {
 	// Stub field
@@ -14,3 +20,37 @@ type MessageArrayTest_3 struct {
 func (*MessageArrayTest_3) GetID() uint32 {
 	return 17153
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_3) MAVLinkCRCExtra() byte {
+	return 19
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_3) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_3) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[16] = m.V
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_3) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.V = buf[16]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_3) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17153]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #4.
 type MessageArrayTest_4 struct {
 	// Value array
@@ -14,3 +20,37 @@ type MessageArrayTest_4 struct {
 func (*MessageArrayTest_4) GetID() uint32 {
 	return 17154
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_4) MAVLinkCRCExtra() byte {
+	return 89
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_4) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_4) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[16] = m.V
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_4) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.V = buf[16]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_4) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17154]
+}
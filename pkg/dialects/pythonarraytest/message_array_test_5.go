@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #5.
 type MessageArrayTest_5 struct {
 	// Value array
@@ -14,3 +20,41 @@ type MessageArrayTest_5 struct {
 func (*MessageArrayTest_5) GetID() uint32 {
 	return 17155
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_5) MAVLinkCRCExtra() byte {
+	return 27
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_5) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 10
+	}
+	return 10
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_5) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:5], m.C1)
+	copy(buf[5:10], m.C2)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_5) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:5], 0); n >= 0 {
+		m.C1 = string(buf[0 : 0+n])
+	} else {
+		m.C1 = string(buf[0:5])
+	}
+	if n := bytes.IndexByte(buf[5:10], 0); n >= 0 {
+		m.C2 = string(buf[5 : 5+n])
+	} else {
+		m.C2 = string(buf[5:10])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_5) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17155]
+}
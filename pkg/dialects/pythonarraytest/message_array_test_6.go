@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #6.
 type MessageArrayTest_6 struct {
 	// Stub field
@@ -34,3 +42,89 @@ type MessageArrayTest_6 struct {
 func (*MessageArrayTest_6) GetID() uint32 {
 	return 17156
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_6) MAVLinkCRCExtra() byte {
+	return 14
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_6) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 91
+	}
+	return 91
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_6) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArD {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[16:], m.V3)
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[20+i*4:], v)
+	}
+	for i, v := range m.ArI32 {
+		binary.LittleEndian.PutUint32(buf[28+i*4:], uint32(v))
+	}
+	for i, v := range m.ArF {
+		binary.LittleEndian.PutUint32(buf[36+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint16(buf[44:], m.V2)
+	for i, v := range m.ArU16 {
+		binary.LittleEndian.PutUint16(buf[46+i*2:], v)
+	}
+	for i, v := range m.ArI16 {
+		binary.LittleEndian.PutUint16(buf[50+i*2:], uint16(v))
+	}
+	buf[54] = m.V1
+	for i, v := range m.ArU8 {
+		buf[55+i] = v
+	}
+	for i, v := range m.ArI8 {
+		buf[57+i] = byte(v)
+	}
+	copy(buf[59:91], m.ArC)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_6) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArD {
+		m.ArD[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[i*8:]))
+	}
+	m.V3 = binary.LittleEndian.Uint32(buf[16:])
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[20+i*4:])
+	}
+	for i := range m.ArI32 {
+		m.ArI32[i] = int32(binary.LittleEndian.Uint32(buf[28+i*4:]))
+	}
+	for i := range m.ArF {
+		m.ArF[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[36+i*4:]))
+	}
+	m.V2 = binary.LittleEndian.Uint16(buf[44:])
+	for i := range m.ArU16 {
+		m.ArU16[i] = binary.LittleEndian.Uint16(buf[46+i*2:])
+	}
+	for i := range m.ArI16 {
+		m.ArI16[i] = int16(binary.LittleEndian.Uint16(buf[50+i*2:]))
+	}
+	m.V1 = buf[54]
+	for i := range m.ArU8 {
+		m.ArU8[i] = buf[55+i]
+	}
+	for i := range m.ArI8 {
+		m.ArI8[i] = int8(buf[57+i])
+	}
+	if n := bytes.IndexByte(buf[59:91], 0); n >= 0 {
+		m.ArC = string(buf[59 : 59+n])
+	} else {
+		m.ArC = string(buf[59:91])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_6) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17156]
+}
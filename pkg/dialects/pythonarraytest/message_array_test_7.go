@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #7.
 type MessageArrayTest_7 struct {
 	// Value array
@@ -28,3 +36,83 @@ type MessageArrayTest_7 struct {
 func (*MessageArrayTest_7) GetID() uint32 {
 	return 17157
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_7) MAVLinkCRCExtra() byte {
+	return 187
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_7) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 84
+	}
+	return 84
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_7) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArD {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(v))
+	}
+	for i, v := range m.ArF {
+		binary.LittleEndian.PutUint32(buf[16+i*4:], math.Float32bits(v))
+	}
+	for i, v := range m.ArU32 {
+		binary.LittleEndian.PutUint32(buf[24+i*4:], v)
+	}
+	for i, v := range m.ArI32 {
+		binary.LittleEndian.PutUint32(buf[32+i*4:], uint32(v))
+	}
+	for i, v := range m.ArU16 {
+		binary.LittleEndian.PutUint16(buf[40+i*2:], v)
+	}
+	for i, v := range m.ArI16 {
+		binary.LittleEndian.PutUint16(buf[44+i*2:], uint16(v))
+	}
+	for i, v := range m.ArU8 {
+		buf[48+i] = v
+	}
+	for i, v := range m.ArI8 {
+		buf[50+i] = byte(v)
+	}
+	copy(buf[52:84], m.ArC)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_7) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArD {
+		m.ArD[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[i*8:]))
+	}
+	for i := range m.ArF {
+		m.ArF[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[16+i*4:]))
+	}
+	for i := range m.ArU32 {
+		m.ArU32[i] = binary.LittleEndian.Uint32(buf[24+i*4:])
+	}
+	for i := range m.ArI32 {
+		m.ArI32[i] = int32(binary.LittleEndian.Uint32(buf[32+i*4:]))
+	}
+	for i := range m.ArU16 {
+		m.ArU16[i] = binary.LittleEndian.Uint16(buf[40+i*2:])
+	}
+	for i := range m.ArI16 {
+		m.ArI16[i] = int16(binary.LittleEndian.Uint16(buf[44+i*2:]))
+	}
+	for i := range m.ArU8 {
+		m.ArU8[i] = buf[48+i]
+	}
+	for i := range m.ArI8 {
+		m.ArI8[i] = int8(buf[50+i])
+	}
+	if n := bytes.IndexByte(buf[52:84], 0); n >= 0 {
+		m.ArC = string(buf[52 : 52+n])
+	} else {
+		m.ArC = string(buf[52:84])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_7) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17157]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package pythonarraytest
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Array test #8.
 type MessageArrayTest_8 struct {
 	// Stub field
@@ -16,3 +23,43 @@ type MessageArrayTest_8 struct {
 func (*MessageArrayTest_8) GetID() uint32 {
 	return 17158
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageArrayTest_8) MAVLinkCRCExtra() byte {
+	return 106
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageArrayTest_8) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 24
+	}
+	return 24
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_8) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.ArD {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[16:], m.V3)
+	for i, v := range m.ArU16 {
+		binary.LittleEndian.PutUint16(buf[20+i*2:], v)
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageArrayTest_8) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.ArD {
+		m.ArD[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[i*8:]))
+	}
+	m.V3 = binary.LittleEndian.Uint32(buf[16:])
+	for i := range m.ArU16 {
+		m.ArU16[i] = binary.LittleEndian.Uint16(buf[20+i*2:])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageArrayTest_8) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17158]
+}
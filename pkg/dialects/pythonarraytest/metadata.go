@@ -0,0 +1,103 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package pythonarraytest
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	17150: {
+		ID:          17150,
+		Name:        "ARRAY_TEST_0",
+		Description: "Array test #0.",
+		Fields: []*message.FieldMetadata{
+			{Name: "v1", Type: "uint8_t", Description: "Stub field"},
+			{Name: "ar_i8", Type: "int8_t", ArrayLength: 4, Description: "Value array"},
+			{Name: "ar_u8", Type: "uint8_t", ArrayLength: 4, Description: "Value array"},
+			{Name: "ar_u16", Type: "uint16_t", ArrayLength: 4, Description: "Value array"},
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 4, Description: "Value array"},
+		},
+	},
+	17151: {
+		ID:          17151,
+		Name:        "ARRAY_TEST_1",
+		Description: "Array test #1.",
+		Fields: []*message.FieldMetadata{
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 4, Description: "Value array"},
+		},
+	},
+	17153: {
+		ID:          17153,
+		Name:        "ARRAY_TEST_3",
+		Description: "Array test #3.",
+		Fields: []*message.FieldMetadata{
+			{Name: "v", Type: "uint8_t", Description: "Stub field"},
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 4, Description: "Value array"},
+		},
+	},
+	17154: {
+		ID:          17154,
+		Name:        "ARRAY_TEST_4",
+		Description: "Array test #4.",
+		Fields: []*message.FieldMetadata{
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 4, Description: "Value array"},
+			{Name: "v", Type: "uint8_t", Description: "Stub field"},
+		},
+	},
+	17155: {
+		ID:          17155,
+		Name:        "ARRAY_TEST_5",
+		Description: "Array test #5.",
+		Fields: []*message.FieldMetadata{
+			{Name: "c1", Type: "char", ArrayLength: 5, Description: "Value array"},
+			{Name: "c2", Type: "char", ArrayLength: 5, Description: "Value array"},
+		},
+	},
+	17156: {
+		ID:          17156,
+		Name:        "ARRAY_TEST_6",
+		Description: "Array test #6.",
+		Fields: []*message.FieldMetadata{
+			{Name: "v1", Type: "uint8_t", Description: "Stub field"},
+			{Name: "v2", Type: "uint16_t", Description: "Stub field"},
+			{Name: "v3", Type: "uint32_t", Description: "Stub field"},
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i32", Type: "int32_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u16", Type: "uint16_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i16", Type: "int16_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u8", Type: "uint8_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i8", Type: "int8_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_c", Type: "char", ArrayLength: 32, Description: "Value array"},
+			{Name: "ar_d", Type: "double", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_f", Type: "float", ArrayLength: 2, Description: "Value array"},
+		},
+	},
+	17157: {
+		ID:          17157,
+		Name:        "ARRAY_TEST_7",
+		Description: "Array test #7.",
+		Fields: []*message.FieldMetadata{
+			{Name: "ar_d", Type: "double", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_f", Type: "float", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u32", Type: "uint32_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i32", Type: "int32_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u16", Type: "uint16_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i16", Type: "int16_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u8", Type: "uint8_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_i8", Type: "int8_t", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_c", Type: "char", ArrayLength: 32, Description: "Value array"},
+		},
+	},
+	17158: {
+		ID:          17158,
+		Name:        "ARRAY_TEST_8",
+		Description: "Array test #8.",
+		Fields: []*message.FieldMetadata{
+			{Name: "v3", Type: "uint32_t", Description: "Stub field"},
+			{Name: "ar_d", Type: "double", ArrayLength: 2, Description: "Value array"},
+			{Name: "ar_u16", Type: "uint16_t", ArrayLength: 2, Description: "Value array"},
+		},
+	},
+}
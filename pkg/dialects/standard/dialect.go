@@ -1,5 +1,7 @@
 // Package standard contains the standard dialect.
 //
+// Definitions hash (SHA256): 0a69e51d8252ba6656a882ecb164a698e3eb189666be9186c605c002afa5f9c5
+//
 //autogenerated:yes
 package standard
 
@@ -21,4 +23,5 @@ var dial = &dialect.Dialect{
 		&MessageGlobalPositionInt{},
 		&MessageAutopilotVersion{},
 	},
+	Enums: enums,
 }
@@ -23,6 +23,14 @@ const (
 	FIRMWARE_VERSION_TYPE_OFFICIAL FIRMWARE_VERSION_TYPE = 255
 )
 
+var values_FIRMWARE_VERSION_TYPE = []FIRMWARE_VERSION_TYPE{
+	FIRMWARE_VERSION_TYPE_DEV,
+	FIRMWARE_VERSION_TYPE_ALPHA,
+	FIRMWARE_VERSION_TYPE_BETA,
+	FIRMWARE_VERSION_TYPE_RC,
+	FIRMWARE_VERSION_TYPE_OFFICIAL,
+}
+
 var value_to_label_FIRMWARE_VERSION_TYPE = map[FIRMWARE_VERSION_TYPE]string{
 	FIRMWARE_VERSION_TYPE_DEV:      "FIRMWARE_VERSION_TYPE_DEV",
 	FIRMWARE_VERSION_TYPE_ALPHA:    "FIRMWARE_VERSION_TYPE_ALPHA",
@@ -64,3 +72,14 @@ func (e FIRMWARE_VERSION_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (FIRMWARE_VERSION_TYPE) Values() []FIRMWARE_VERSION_TYPE {
+	return append([]FIRMWARE_VERSION_TYPE(nil), values_FIRMWARE_VERSION_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e FIRMWARE_VERSION_TYPE) IsValid() bool {
+	_, ok := value_to_label_FIRMWARE_VERSION_TYPE[e]
+	return ok
+}
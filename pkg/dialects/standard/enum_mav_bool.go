@@ -17,6 +17,11 @@ const (
 	MAV_BOOL_TRUE MAV_BOOL = 1
 )
 
+var values_MAV_BOOL = []MAV_BOOL{
+	MAV_BOOL_FALSE,
+	MAV_BOOL_TRUE,
+}
+
 var value_to_label_MAV_BOOL = map[MAV_BOOL]string{
 	MAV_BOOL_FALSE: "MAV_BOOL_FALSE",
 	MAV_BOOL_TRUE:  "MAV_BOOL_TRUE",
@@ -52,3 +57,14 @@ func (e MAV_BOOL) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_BOOL) Values() []MAV_BOOL {
+	return append([]MAV_BOOL(nil), values_MAV_BOOL...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_BOOL) IsValid() bool {
+	_, ok := value_to_label_MAV_BOOL[e]
+	return ok
+}
@@ -168,3 +168,33 @@ func (e MAV_PROTOCOL_CAPABILITY) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_PROTOCOL_CAPABILITY) Values() []MAV_PROTOCOL_CAPABILITY {
+	return append([]MAV_PROTOCOL_CAPABILITY(nil), values_MAV_PROTOCOL_CAPABILITY...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_PROTOCOL_CAPABILITY) IsValid() bool {
+	var mask MAV_PROTOCOL_CAPABILITY
+	for _, val := range values_MAV_PROTOCOL_CAPABILITY {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_PROTOCOL_CAPABILITY) Flags() []MAV_PROTOCOL_CAPABILITY {
+	var flags []MAV_PROTOCOL_CAPABILITY
+	for _, val := range values_MAV_PROTOCOL_CAPABILITY {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_PROTOCOL_CAPABILITY) Has(flags MAV_PROTOCOL_CAPABILITY) bool {
+	return e&flags == flags
+}
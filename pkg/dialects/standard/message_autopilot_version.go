@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package standard
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Version and capability of autopilot software. This should be emitted in response to a request with MAV_CMD_REQUEST_MESSAGE.
 type MessageAutopilotVersion struct {
 	// Bitmap of capabilities
@@ -35,3 +41,75 @@ type MessageAutopilotVersion struct {
 func (*MessageAutopilotVersion) GetID() uint32 {
 	return 148
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAutopilotVersion) MAVLinkCRCExtra() byte {
+	return 178
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAutopilotVersion) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 78
+	}
+	return 60
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotVersion) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], uint64(m.Capabilities))
+	binary.LittleEndian.PutUint64(buf[8:], m.Uid)
+	binary.LittleEndian.PutUint32(buf[16:], m.FlightSwVersion)
+	binary.LittleEndian.PutUint32(buf[20:], m.MiddlewareSwVersion)
+	binary.LittleEndian.PutUint32(buf[24:], m.OsSwVersion)
+	binary.LittleEndian.PutUint32(buf[28:], m.BoardVersion)
+	binary.LittleEndian.PutUint16(buf[32:], m.VendorId)
+	binary.LittleEndian.PutUint16(buf[34:], m.ProductId)
+	for i, v := range m.FlightCustomVersion {
+		buf[36+i] = v
+	}
+	for i, v := range m.MiddlewareCustomVersion {
+		buf[44+i] = v
+	}
+	for i, v := range m.OsCustomVersion {
+		buf[52+i] = v
+	}
+	if !isV2 {
+		return
+	}
+	for i, v := range m.Uid2 {
+		buf[60+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotVersion) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Capabilities = MAV_PROTOCOL_CAPABILITY(binary.LittleEndian.Uint64(buf[0:]))
+	m.Uid = binary.LittleEndian.Uint64(buf[8:])
+	m.FlightSwVersion = binary.LittleEndian.Uint32(buf[16:])
+	m.MiddlewareSwVersion = binary.LittleEndian.Uint32(buf[20:])
+	m.OsSwVersion = binary.LittleEndian.Uint32(buf[24:])
+	m.BoardVersion = binary.LittleEndian.Uint32(buf[28:])
+	m.VendorId = binary.LittleEndian.Uint16(buf[32:])
+	m.ProductId = binary.LittleEndian.Uint16(buf[34:])
+	for i := range m.FlightCustomVersion {
+		m.FlightCustomVersion[i] = buf[36+i]
+	}
+	for i := range m.MiddlewareCustomVersion {
+		m.MiddlewareCustomVersion[i] = buf[44+i]
+	}
+	for i := range m.OsCustomVersion {
+		m.OsCustomVersion[i] = buf[52+i]
+	}
+	if !isV2 {
+		return
+	}
+	for i := range m.Uid2 {
+		m.Uid2[i] = buf[60+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAutopilotVersion) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[148]
+}
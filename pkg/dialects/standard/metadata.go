@@ -0,0 +1,46 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package standard
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	33: {
+		ID:          33,
+		Name:        "GLOBAL_POSITION_INT",
+		Description: "The filtered global position (e.g. fused GPS and accelerometers). The position is in GPS-frame (right-handed, Z-up). It is designed as scaled integer message since the resolution of float is not sufficient.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "lat", Type: "int32_t", Description: "Latitude, expressed"},
+			{Name: "lon", Type: "int32_t", Description: "Longitude, expressed"},
+			{Name: "alt", Type: "int32_t", Description: "Altitude (MSL). Note that virtually all GPS modules provide both WGS84 and MSL."},
+			{Name: "relative_alt", Type: "int32_t", Description: "Altitude above home"},
+			{Name: "vx", Type: "int16_t", Description: "Ground X Speed (Latitude, positive north)"},
+			{Name: "vy", Type: "int16_t", Description: "Ground Y Speed (Longitude, positive east)"},
+			{Name: "vz", Type: "int16_t", Description: "Ground Z Speed (Altitude, positive down)"},
+			{Name: "hdg", Type: "uint16_t", Description: "Vehicle heading (yaw angle), 0.0..359.99 degrees. If unknown, set to: UINT16_MAX"},
+		},
+	},
+	148: {
+		ID:          148,
+		Name:        "AUTOPILOT_VERSION",
+		Description: "Version and capability of autopilot software. This should be emitted in response to a request with MAV_CMD_REQUEST_MESSAGE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "capabilities", Type: "uint64_t", Enum: "MAV_PROTOCOL_CAPABILITY", Bitmask: true, Description: "Bitmap of capabilities"},
+			{Name: "flight_sw_version", Type: "uint32_t", Description: "Firmware version number. The field must be encoded as 4 bytes, where each byte (shown from MSB to LSB) is part of a semantic version: (major) (minor) (patch) (FIRMWARE_VERSION_TYPE)."},
+			{Name: "middleware_sw_version", Type: "uint32_t", Description: "Middleware version number"},
+			{Name: "os_sw_version", Type: "uint32_t", Description: "Operating system version number"},
+			{Name: "board_version", Type: "uint32_t", Description: "HW / board version (last 8 bits should be silicon ID, if any). The first 16 bits of this field specify a board type from an enumeration stored at https://github.com/PX4/PX4-Bootloader/blob/master/board_types.txt and with extensive additions at https://github.com/ArduPilot/ardupilot/blob/master/Tools/AP_Bootloader/board_types.txt"},
+			{Name: "flight_custom_version", Type: "uint8_t", ArrayLength: 8, Description: "Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases."},
+			{Name: "middleware_custom_version", Type: "uint8_t", ArrayLength: 8, Description: "Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases."},
+			{Name: "os_custom_version", Type: "uint8_t", ArrayLength: 8, Description: "Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases."},
+			{Name: "vendor_id", Type: "uint16_t", Description: "ID of the board vendor"},
+			{Name: "product_id", Type: "uint16_t", Description: "ID of the product"},
+			{Name: "uid", Type: "uint64_t", Description: "UID if provided by hardware (see uid2)"},
+			{Name: "uid2", Type: "uint8_t", ArrayLength: 18, Extension: true, Description: "UID if provided by hardware (supersedes the uid field. If this is non-zero, use this field, otherwise use uid)"},
+		},
+	},
+}
@@ -1,5 +1,7 @@
 // Package stemstudios contains the stemstudios dialect.
 //
+// Definitions hash (SHA256): 825013e5f3d56f9709a8cf0efb203ba30bf6de10379b4a2ff2b766ec6ec65e53
+//
 //autogenerated:yes
 package stemstudios
 
@@ -256,4 +258,5 @@ var dial = &dialect.Dialect{
 		&MessageLedStripConfig{},
 		&MessageLedStripState{},
 	},
+	Enums: enums,
 }
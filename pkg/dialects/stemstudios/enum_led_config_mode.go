@@ -25,6 +25,13 @@ const (
 	LED_CONFIG_MODE_CLEAR LED_CONFIG_MODE = 3
 )
 
+var values_LED_CONFIG_MODE = []LED_CONFIG_MODE{
+	LED_CONFIG_MODE_ALL,
+	LED_CONFIG_MODE_INDEX,
+	LED_CONFIG_MODE_FOLLOW_FLIGHT_MODE,
+	LED_CONFIG_MODE_CLEAR,
+}
+
 var value_to_label_LED_CONFIG_MODE = map[LED_CONFIG_MODE]string{
 	LED_CONFIG_MODE_ALL:                "LED_CONFIG_MODE_ALL",
 	LED_CONFIG_MODE_INDEX:              "LED_CONFIG_MODE_INDEX",
@@ -64,3 +71,14 @@ func (e LED_CONFIG_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (LED_CONFIG_MODE) Values() []LED_CONFIG_MODE {
+	return append([]LED_CONFIG_MODE(nil), values_LED_CONFIG_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e LED_CONFIG_MODE) IsValid() bool {
+	_, ok := value_to_label_LED_CONFIG_MODE[e]
+	return ok
+}
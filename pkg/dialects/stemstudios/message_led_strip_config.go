@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package stemstudios
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Set the colors on an LED strip. The mode field determines how the colors are set. We can:
 // - Set all LEDs to the first color in our colors array.
 // - Set up to 8 consecutive LEDs, starting from a given index, to colors provided in an array.
@@ -31,3 +37,47 @@ type MessageLedStripConfig struct {
 func (*MessageLedStripConfig) GetID() uint32 {
 	return 52600
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLedStripConfig) MAVLinkCRCExtra() byte {
+	return 181
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLedStripConfig) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 38
+	}
+	return 38
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedStripConfig) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Colors {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[32] = m.TargetSystem
+	buf[33] = m.TargetComponent
+	buf[34] = byte(m.Mode)
+	buf[35] = m.Index
+	buf[36] = m.Length
+	buf[37] = m.Id
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedStripConfig) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Colors {
+		m.Colors[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.TargetSystem = buf[32]
+	m.TargetComponent = buf[33]
+	m.Mode = LED_CONFIG_MODE(buf[34])
+	m.Index = buf[35]
+	m.Length = buf[36]
+	m.Id = buf[37]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLedStripConfig) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52600]
+}
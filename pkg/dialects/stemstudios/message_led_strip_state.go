@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package stemstudios
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Current LED State. Can be emitted by LED Strip Controller.
 type MessageLedStripState struct {
 	// How many LEDs are being reported in this message.
@@ -20,3 +26,43 @@ type MessageLedStripState struct {
 func (*MessageLedStripState) GetID() uint32 {
 	return 52601
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageLedStripState) MAVLinkCRCExtra() byte {
+	return 102
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageLedStripState) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 36
+	}
+	return 36
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedStripState) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Colors {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
+	}
+	buf[32] = m.Length
+	buf[33] = m.Index
+	buf[34] = m.Id
+	buf[35] = m.FollowingFlightMode
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageLedStripState) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Colors {
+		m.Colors[i] = binary.LittleEndian.Uint32(buf[i*4:])
+	}
+	m.Length = buf[32]
+	m.Index = buf[33]
+	m.Id = buf[34]
+	m.FollowingFlightMode = buf[35]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageLedStripState) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[52601]
+}
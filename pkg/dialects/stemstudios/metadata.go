@@ -0,0 +1,37 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package stemstudios
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	52600: {
+		ID:          52600,
+		Name:        "LED_STRIP_CONFIG",
+		Description: "Set the colors on an LED strip. The mode field determines how the colors are set. We can: - Set all LEDs to the first color in our colors array. - Set up to 8 consecutive LEDs, starting from a given index, to colors provided in an array. - Set the LED colors to change according to the flight mode. - Turn all LEDs off (clear). Which LED strip to configure is specified by the id field. The colors field is an array of up to 8 colors, each represented as a 32-bit integer in the format 0xWWRRGGBB where WW is white, RR is the intensity of the red color channel, GG is green, and BB is blue.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (Normally 134 for an LED Strip Controller)."},
+			{Name: "mode", Type: "uint8_t", Enum: "LED_CONFIG_MODE", Description: "How to configure LEDs."},
+			{Name: "index", Type: "uint8_t", Description: "Set LEDs starting from this index."},
+			{Name: "length", Type: "uint8_t", Description: "The number of LEDs to set (up to 8)."},
+			{Name: "id", Type: "uint8_t", Description: "Which strip to configure. UINT8_MAX for all strips."},
+			{Name: "colors", Type: "uint32_t", ArrayLength: 8, Description: "Array of 32-bit color values (0xWWRRGGBB)."},
+		},
+	},
+	52601: {
+		ID:          52601,
+		Name:        "LED_STRIP_STATE",
+		Description: "Current LED State. Can be emitted by LED Strip Controller.",
+		Fields: []*message.FieldMetadata{
+			{Name: "length", Type: "uint8_t", Description: "How many LEDs are being reported in this message."},
+			{Name: "index", Type: "uint8_t", Description: "Index of first LED being reported."},
+			{Name: "id", Type: "uint8_t", Description: "Which strip is being reported."},
+			{Name: "following_flight_mode", Type: "uint8_t", Description: "Are the LED colors changing according to the flight mode (1) or not (0)."},
+			{Name: "colors", Type: "uint32_t", ArrayLength: 8, Description: "Array of 32-bit color values (0xWWRRGGBB)."},
+		},
+	},
+}
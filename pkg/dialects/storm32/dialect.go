@@ -1,5 +1,7 @@
 // Package storm32 contains the storm32 dialect.
 //
+// Definitions hash (SHA256): 18e141a8af745809caab4284121f6403b89e40fda9bf4f1f94e4e7f9bd68c8c3
+//
 //autogenerated:yes
 package storm32
 
@@ -363,4 +365,5 @@ var dial = &dialect.Dialect{
 		&MessageMlrsRadioLinkInformation{},
 		&MessageMlrsRadioLinkFlowControl{},
 	},
+	Enums: enums,
 }
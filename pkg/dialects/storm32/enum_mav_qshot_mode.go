@@ -33,6 +33,19 @@ const (
 	MAV_QSHOT_MODE_HOME_TARGETING MAV_QSHOT_MODE = 9
 )
 
+var values_MAV_QSHOT_MODE = []MAV_QSHOT_MODE{
+	MAV_QSHOT_MODE_UNDEFINED,
+	MAV_QSHOT_MODE_DEFAULT,
+	MAV_QSHOT_MODE_GIMBAL_RETRACT,
+	MAV_QSHOT_MODE_GIMBAL_NEUTRAL,
+	MAV_QSHOT_MODE_GIMBAL_MISSION,
+	MAV_QSHOT_MODE_GIMBAL_RC_CONTROL,
+	MAV_QSHOT_MODE_POI_TARGETING,
+	MAV_QSHOT_MODE_SYSID_TARGETING,
+	MAV_QSHOT_MODE_CABLECAM_2POINT,
+	MAV_QSHOT_MODE_HOME_TARGETING,
+}
+
 var value_to_label_MAV_QSHOT_MODE = map[MAV_QSHOT_MODE]string{
 	MAV_QSHOT_MODE_UNDEFINED:         "MAV_QSHOT_MODE_UNDEFINED",
 	MAV_QSHOT_MODE_DEFAULT:           "MAV_QSHOT_MODE_DEFAULT",
@@ -84,3 +97,14 @@ func (e MAV_QSHOT_MODE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_QSHOT_MODE) Values() []MAV_QSHOT_MODE {
+	return append([]MAV_QSHOT_MODE(nil), values_MAV_QSHOT_MODE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_QSHOT_MODE) IsValid() bool {
+	_, ok := value_to_label_MAV_QSHOT_MODE[e]
+	return ok
+}
@@ -31,6 +31,18 @@ const (
 	MAV_STORM32_GIMBAL_MANAGER_CLIENT_CUSTOM2 MAV_STORM32_GIMBAL_MANAGER_CLIENT = 8
 )
 
+var values_MAV_STORM32_GIMBAL_MANAGER_CLIENT = []MAV_STORM32_GIMBAL_MANAGER_CLIENT{
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_NONE,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_ONBOARD,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_AUTOPILOT,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_GCS,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_CAMERA,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_GCS2,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_CAMERA2,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_CUSTOM,
+	MAV_STORM32_GIMBAL_MANAGER_CLIENT_CUSTOM2,
+}
+
 var value_to_label_MAV_STORM32_GIMBAL_MANAGER_CLIENT = map[MAV_STORM32_GIMBAL_MANAGER_CLIENT]string{
 	MAV_STORM32_GIMBAL_MANAGER_CLIENT_NONE:      "MAV_STORM32_GIMBAL_MANAGER_CLIENT_NONE",
 	MAV_STORM32_GIMBAL_MANAGER_CLIENT_ONBOARD:   "MAV_STORM32_GIMBAL_MANAGER_CLIENT_ONBOARD",
@@ -80,3 +92,14 @@ func (e MAV_STORM32_GIMBAL_MANAGER_CLIENT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STORM32_GIMBAL_MANAGER_CLIENT) Values() []MAV_STORM32_GIMBAL_MANAGER_CLIENT {
+	return append([]MAV_STORM32_GIMBAL_MANAGER_CLIENT(nil), values_MAV_STORM32_GIMBAL_MANAGER_CLIENT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_STORM32_GIMBAL_MANAGER_CLIENT) IsValid() bool {
+	_, ok := value_to_label_MAV_STORM32_GIMBAL_MANAGER_CLIENT[e]
+	return ok
+}
@@ -114,3 +114,33 @@ func (e MAV_STORM32_GIMBAL_MANAGER_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STORM32_GIMBAL_MANAGER_FLAGS) Values() []MAV_STORM32_GIMBAL_MANAGER_FLAGS {
+	return append([]MAV_STORM32_GIMBAL_MANAGER_FLAGS(nil), values_MAV_STORM32_GIMBAL_MANAGER_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MAV_STORM32_GIMBAL_MANAGER_FLAGS) IsValid() bool {
+	var mask MAV_STORM32_GIMBAL_MANAGER_FLAGS
+	for _, val := range values_MAV_STORM32_GIMBAL_MANAGER_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MAV_STORM32_GIMBAL_MANAGER_FLAGS) Flags() []MAV_STORM32_GIMBAL_MANAGER_FLAGS {
+	var flags []MAV_STORM32_GIMBAL_MANAGER_FLAGS
+	for _, val := range values_MAV_STORM32_GIMBAL_MANAGER_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MAV_STORM32_GIMBAL_MANAGER_FLAGS) Has(flags MAV_STORM32_GIMBAL_MANAGER_FLAGS) bool {
+	return e&flags == flags
+}
@@ -25,6 +25,15 @@ const (
 	MAV_STORM32_GIMBAL_MANAGER_PROFILE_PRIORITY_EXCLUSIVE MAV_STORM32_GIMBAL_MANAGER_PROFILE = 5
 )
 
+var values_MAV_STORM32_GIMBAL_MANAGER_PROFILE = []MAV_STORM32_GIMBAL_MANAGER_PROFILE{
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_DEFAULT,
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_CUSTOM,
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_COOPERATIVE,
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_EXCLUSIVE,
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_PRIORITY_COOPERATIVE,
+	MAV_STORM32_GIMBAL_MANAGER_PROFILE_PRIORITY_EXCLUSIVE,
+}
+
 var value_to_label_MAV_STORM32_GIMBAL_MANAGER_PROFILE = map[MAV_STORM32_GIMBAL_MANAGER_PROFILE]string{
 	MAV_STORM32_GIMBAL_MANAGER_PROFILE_DEFAULT:              "MAV_STORM32_GIMBAL_MANAGER_PROFILE_DEFAULT",
 	MAV_STORM32_GIMBAL_MANAGER_PROFILE_CUSTOM:               "MAV_STORM32_GIMBAL_MANAGER_PROFILE_CUSTOM",
@@ -68,3 +77,14 @@ func (e MAV_STORM32_GIMBAL_MANAGER_PROFILE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STORM32_GIMBAL_MANAGER_PROFILE) Values() []MAV_STORM32_GIMBAL_MANAGER_PROFILE {
+	return append([]MAV_STORM32_GIMBAL_MANAGER_PROFILE(nil), values_MAV_STORM32_GIMBAL_MANAGER_PROFILE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_STORM32_GIMBAL_MANAGER_PROFILE) IsValid() bool {
+	_, ok := value_to_label_MAV_STORM32_GIMBAL_MANAGER_PROFILE[e]
+	return ok
+}
@@ -24,6 +24,15 @@ const (
 	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH3_OUT MAV_STORM32_TUNNEL_PAYLOAD_TYPE = 205
 )
 
+var values_MAV_STORM32_TUNNEL_PAYLOAD_TYPE = []MAV_STORM32_TUNNEL_PAYLOAD_TYPE{
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_IN,
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_OUT,
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH2_IN,
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH2_OUT,
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH3_IN,
+	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH3_OUT,
+}
+
 var value_to_label_MAV_STORM32_TUNNEL_PAYLOAD_TYPE = map[MAV_STORM32_TUNNEL_PAYLOAD_TYPE]string{
 	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_IN:  "MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_IN",
 	MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_OUT: "MAV_STORM32_TUNNEL_PAYLOAD_TYPE_STORM32_CH1_OUT",
@@ -67,3 +76,14 @@ func (e MAV_STORM32_TUNNEL_PAYLOAD_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MAV_STORM32_TUNNEL_PAYLOAD_TYPE) Values() []MAV_STORM32_TUNNEL_PAYLOAD_TYPE {
+	return append([]MAV_STORM32_TUNNEL_PAYLOAD_TYPE(nil), values_MAV_STORM32_TUNNEL_PAYLOAD_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MAV_STORM32_TUNNEL_PAYLOAD_TYPE) IsValid() bool {
+	_, ok := value_to_label_MAV_STORM32_TUNNEL_PAYLOAD_TYPE[e]
+	return ok
+}
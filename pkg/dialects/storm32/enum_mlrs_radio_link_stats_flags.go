@@ -99,3 +99,33 @@ func (e MLRS_RADIO_LINK_STATS_FLAGS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MLRS_RADIO_LINK_STATS_FLAGS) Values() []MLRS_RADIO_LINK_STATS_FLAGS {
+	return append([]MLRS_RADIO_LINK_STATS_FLAGS(nil), values_MLRS_RADIO_LINK_STATS_FLAGS...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e MLRS_RADIO_LINK_STATS_FLAGS) IsValid() bool {
+	var mask MLRS_RADIO_LINK_STATS_FLAGS
+	for _, val := range values_MLRS_RADIO_LINK_STATS_FLAGS {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e MLRS_RADIO_LINK_STATS_FLAGS) Flags() []MLRS_RADIO_LINK_STATS_FLAGS {
+	var flags []MLRS_RADIO_LINK_STATS_FLAGS
+	for _, val := range values_MLRS_RADIO_LINK_STATS_FLAGS {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e MLRS_RADIO_LINK_STATS_FLAGS) Has(flags MLRS_RADIO_LINK_STATS_FLAGS) bool {
+	return e&flags == flags
+}
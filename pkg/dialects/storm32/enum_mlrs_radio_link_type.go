@@ -27,6 +27,16 @@ const (
 	MLRS_RADIO_LINK_TYPE_MLRS MLRS_RADIO_LINK_TYPE = 6
 )
 
+var values_MLRS_RADIO_LINK_TYPE = []MLRS_RADIO_LINK_TYPE{
+	MLRS_RADIO_LINK_TYPE_GENERIC,
+	MLRS_RADIO_LINK_TYPE_HERELINK,
+	MLRS_RADIO_LINK_TYPE_DRAGONLINK,
+	MLRS_RADIO_LINK_TYPE_RFD900,
+	MLRS_RADIO_LINK_TYPE_CROSSFIRE,
+	MLRS_RADIO_LINK_TYPE_EXPRESSLRS,
+	MLRS_RADIO_LINK_TYPE_MLRS,
+}
+
 var value_to_label_MLRS_RADIO_LINK_TYPE = map[MLRS_RADIO_LINK_TYPE]string{
 	MLRS_RADIO_LINK_TYPE_GENERIC:    "MLRS_RADIO_LINK_TYPE_GENERIC",
 	MLRS_RADIO_LINK_TYPE_HERELINK:   "MLRS_RADIO_LINK_TYPE_HERELINK",
@@ -72,3 +82,14 @@ func (e MLRS_RADIO_LINK_TYPE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (MLRS_RADIO_LINK_TYPE) Values() []MLRS_RADIO_LINK_TYPE {
+	return append([]MLRS_RADIO_LINK_TYPE(nil), values_MLRS_RADIO_LINK_TYPE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e MLRS_RADIO_LINK_TYPE) IsValid() bool {
+	_, ok := value_to_label_MLRS_RADIO_LINK_TYPE[e]
+	return ok
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Addition to message AUTOPILOT_STATE_FOR_GIMBAL_DEVICE.
 type MessageAutopilotStateForGimbalDeviceExt struct {
 	// System ID.
@@ -22,3 +29,41 @@ type MessageAutopilotStateForGimbalDeviceExt struct {
 func (*MessageAutopilotStateForGimbalDeviceExt) GetID() uint32 {
 	return 60000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageAutopilotStateForGimbalDeviceExt) MAVLinkCRCExtra() byte {
+	return 4
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageAutopilotStateForGimbalDeviceExt) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 22
+	}
+	return 22
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotStateForGimbalDeviceExt) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.TimeBootUs)
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.WindX))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.WindY))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.WindCorrectionAngle))
+	buf[20] = m.TargetSystem
+	buf[21] = m.TargetComponent
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageAutopilotStateForGimbalDeviceExt) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootUs = binary.LittleEndian.Uint64(buf[0:])
+	m.WindX = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.WindY = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.WindCorrectionAngle = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.TargetSystem = buf[20]
+	m.TargetComponent = buf[21]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageAutopilotStateForGimbalDeviceExt) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60000]
+}
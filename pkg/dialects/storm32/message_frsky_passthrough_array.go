@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Frsky SPort passthrough multi packet container.
 type MessageFrskyPassthroughArray struct {
 	// Timestamp (time since system boot).
@@ -16,3 +22,39 @@ type MessageFrskyPassthroughArray struct {
 func (*MessageFrskyPassthroughArray) GetID() uint32 {
 	return 60040
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageFrskyPassthroughArray) MAVLinkCRCExtra() byte {
+	return 156
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageFrskyPassthroughArray) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 245
+	}
+	return 245
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFrskyPassthroughArray) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.TimeBootMs)
+	buf[4] = m.Count
+	for i, v := range m.PacketBuf {
+		buf[5+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageFrskyPassthroughArray) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TimeBootMs = binary.LittleEndian.Uint32(buf[0:])
+	m.Count = buf[4]
+	for i := range m.PacketBuf {
+		m.PacketBuf[i] = buf[5+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageFrskyPassthroughArray) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60040]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Injected by a radio link endpoint into the MAVLink stream for purposes of flow control. Should be emitted only by components with component id MAV_COMP_ID_TELEMETRY_RADIO.
 type MessageMlrsRadioLinkFlowControl struct {
 	// Transmitted bytes per second, UINT16_MAX: invalid/unknown.
@@ -20,3 +26,39 @@ type MessageMlrsRadioLinkFlowControl struct {
 func (*MessageMlrsRadioLinkFlowControl) GetID() uint32 {
 	return 60047
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkFlowControl) MAVLinkCRCExtra() byte {
+	return 55
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkFlowControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 7
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkFlowControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.TxSerRate)
+	binary.LittleEndian.PutUint16(buf[2:], m.RxSerRate)
+	buf[4] = m.TxUsedSerBandwidth
+	buf[5] = m.RxUsedSerBandwidth
+	buf[6] = m.Txbuf
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkFlowControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TxSerRate = binary.LittleEndian.Uint16(buf[0:])
+	m.RxSerRate = binary.LittleEndian.Uint16(buf[2:])
+	m.TxUsedSerBandwidth = buf[4]
+	m.RxUsedSerBandwidth = buf[5]
+	m.Txbuf = buf[6]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMlrsRadioLinkFlowControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60047]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Radio link information. Tx: ground-side device, Rx: vehicle-side device.
 // The values of the fields in this message do normally not or only slowly change with time, and for most times the message can be send at a low rate, like 0.2 Hz.
 // If values change then the message should temporarily be send more often to inform the system about the changes.
@@ -42,3 +49,65 @@ type MessageMlrsRadioLinkInformation struct {
 func (*MessageMlrsRadioLinkInformation) GetID() uint32 {
 	return 60046
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkInformation) MAVLinkCRCExtra() byte {
+	return 171
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 28
+	}
+	return 28
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.TxFrameRate)
+	binary.LittleEndian.PutUint16(buf[2:], m.RxFrameRate)
+	binary.LittleEndian.PutUint16(buf[4:], m.TxSerDataRate)
+	binary.LittleEndian.PutUint16(buf[6:], m.RxSerDataRate)
+	buf[8] = m.TargetSystem
+	buf[9] = m.TargetComponent
+	buf[10] = byte(m.Type)
+	buf[11] = m.Mode
+	buf[12] = byte(m.TxPower)
+	buf[13] = byte(m.RxPower)
+	copy(buf[14:20], m.ModeStr)
+	copy(buf[20:26], m.BandStr)
+	buf[26] = m.TxReceiveSensitivity
+	buf[27] = m.RxReceiveSensitivity
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.TxFrameRate = binary.LittleEndian.Uint16(buf[0:])
+	m.RxFrameRate = binary.LittleEndian.Uint16(buf[2:])
+	m.TxSerDataRate = binary.LittleEndian.Uint16(buf[4:])
+	m.RxSerDataRate = binary.LittleEndian.Uint16(buf[6:])
+	m.TargetSystem = buf[8]
+	m.TargetComponent = buf[9]
+	m.Type = MLRS_RADIO_LINK_TYPE(buf[10])
+	m.Mode = buf[11]
+	m.TxPower = int8(buf[12])
+	m.RxPower = int8(buf[13])
+	if n := bytes.IndexByte(buf[14:20], 0); n >= 0 {
+		m.ModeStr = string(buf[14 : 14+n])
+	} else {
+		m.ModeStr = string(buf[14:20])
+	}
+	if n := bytes.IndexByte(buf[20:26], 0); n >= 0 {
+		m.BandStr = string(buf[20 : 20+n])
+	} else {
+		m.BandStr = string(buf[20:26])
+	}
+	m.TxReceiveSensitivity = buf[26]
+	m.RxReceiveSensitivity = buf[27]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMlrsRadioLinkInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60046]
+}
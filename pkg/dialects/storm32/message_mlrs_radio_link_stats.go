@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Radio link statistics for a MAVLink RC receiver or transmitter and other links. Tx: ground-side device, Rx: vehicle-side device.
 // The message is normally emitted in regular time intervals upon each actual or expected reception of an over-the-air data packet on the link.
 // A MAVLink RC receiver should emit it shortly after it emits a RADIO_RC_CHANNELS message (if it is emitting that message).
@@ -49,3 +56,67 @@ type MessageMlrsRadioLinkStats struct {
 func (*MessageMlrsRadioLinkStats) GetID() uint32 {
 	return 60045
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkStats) MAVLinkCRCExtra() byte {
+	return 14
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageMlrsRadioLinkStats) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 23
+	}
+	return 15
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkStats) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.Flags))
+	buf[2] = m.TargetSystem
+	buf[3] = m.TargetComponent
+	buf[4] = m.RxLqRc
+	buf[5] = m.RxLqSer
+	buf[6] = m.RxRssi1
+	buf[7] = byte(m.RxSnr1)
+	buf[8] = m.TxLqSer
+	buf[9] = m.TxRssi1
+	buf[10] = byte(m.TxSnr1)
+	buf[11] = m.RxRssi2
+	buf[12] = byte(m.RxSnr2)
+	buf[13] = m.TxRssi2
+	buf[14] = byte(m.TxSnr2)
+	if !isV2 {
+		return
+	}
+	binary.LittleEndian.PutUint32(buf[15:], math.Float32bits(m.Frequency1))
+	binary.LittleEndian.PutUint32(buf[19:], math.Float32bits(m.Frequency2))
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageMlrsRadioLinkStats) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Flags = MLRS_RADIO_LINK_STATS_FLAGS(binary.LittleEndian.Uint16(buf[0:]))
+	m.TargetSystem = buf[2]
+	m.TargetComponent = buf[3]
+	m.RxLqRc = buf[4]
+	m.RxLqSer = buf[5]
+	m.RxRssi1 = buf[6]
+	m.RxSnr1 = int8(buf[7])
+	m.TxLqSer = buf[8]
+	m.TxRssi1 = buf[9]
+	m.TxSnr1 = int8(buf[10])
+	m.RxRssi2 = buf[11]
+	m.RxSnr2 = int8(buf[12])
+	m.TxRssi2 = buf[13]
+	m.TxSnr2 = int8(buf[14])
+	if !isV2 {
+		return
+	}
+	m.Frequency1 = math.Float32frombits(binary.LittleEndian.Uint32(buf[15:]))
+	m.Frequency2 = math.Float32frombits(binary.LittleEndian.Uint32(buf[19:]))
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageMlrsRadioLinkStats) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60045]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Parameter multi param value container.
 type MessageParamValueArray struct {
 	// Total number of onboard parameters.
@@ -20,3 +26,43 @@ type MessageParamValueArray struct {
 func (*MessageParamValueArray) GetID() uint32 {
 	return 60041
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageParamValueArray) MAVLinkCRCExtra() byte {
+	return 191
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageParamValueArray) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 255
+	}
+	return 255
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamValueArray) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.ParamCount)
+	binary.LittleEndian.PutUint16(buf[2:], m.ParamIndexFirst)
+	binary.LittleEndian.PutUint16(buf[4:], m.Flags)
+	buf[6] = m.ParamArrayLen
+	for i, v := range m.PacketBuf {
+		buf[7+i] = v
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageParamValueArray) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.ParamCount = binary.LittleEndian.Uint16(buf[0:])
+	m.ParamIndexFirst = binary.LittleEndian.Uint16(buf[2:])
+	m.Flags = binary.LittleEndian.Uint16(buf[4:])
+	m.ParamArrayLen = buf[6]
+	for i := range m.PacketBuf {
+		m.PacketBuf[i] = buf[7+i]
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageParamValueArray) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60041]
+}
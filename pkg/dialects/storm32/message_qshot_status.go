@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about the shot operation.
 type MessageQshotStatus struct {
 	// Current shot mode.
@@ -14,3 +20,33 @@ type MessageQshotStatus struct {
 func (*MessageQshotStatus) GetID() uint32 {
 	return 60020
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageQshotStatus) MAVLinkCRCExtra() byte {
+	return 202
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageQshotStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageQshotStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.Mode))
+	binary.LittleEndian.PutUint16(buf[2:], m.ShotState)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageQshotStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Mode = MAV_QSHOT_MODE(binary.LittleEndian.Uint16(buf[0:]))
+	m.ShotState = binary.LittleEndian.Uint16(buf[2:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageQshotStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60020]
+}
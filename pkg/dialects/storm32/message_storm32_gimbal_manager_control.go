@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message to a gimbal manager to control the gimbal attitude. Angles and rates can be set to NaN according to use case. A gimbal device is never to react to this message.
 type MessageStorm32GimbalManagerControl struct {
 	// System ID
@@ -30,3 +37,53 @@ type MessageStorm32GimbalManagerControl struct {
 func (*MessageStorm32GimbalManagerControl) GetID() uint32 {
 	return 60012
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerControl) MAVLinkCRCExtra() byte {
+	return 99
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 36
+	}
+	return 36
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	for i, v := range m.Q {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.AngularVelocityX))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.AngularVelocityY))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.AngularVelocityZ))
+	binary.LittleEndian.PutUint16(buf[28:], uint16(m.DeviceFlags))
+	binary.LittleEndian.PutUint16(buf[30:], uint16(m.ManagerFlags))
+	buf[32] = m.TargetSystem
+	buf[33] = m.TargetComponent
+	buf[34] = m.GimbalId
+	buf[35] = byte(m.Client)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	for i := range m.Q {
+		m.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[i*4:]))
+	}
+	m.AngularVelocityX = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.AngularVelocityY = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.AngularVelocityZ = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.DeviceFlags = GIMBAL_DEVICE_FLAGS(binary.LittleEndian.Uint16(buf[28:]))
+	m.ManagerFlags = MAV_STORM32_GIMBAL_MANAGER_FLAGS(binary.LittleEndian.Uint16(buf[30:]))
+	m.TargetSystem = buf[32]
+	m.TargetComponent = buf[33]
+	m.GimbalId = buf[34]
+	m.Client = MAV_STORM32_GIMBAL_MANAGER_CLIENT(buf[35])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorm32GimbalManagerControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60012]
+}
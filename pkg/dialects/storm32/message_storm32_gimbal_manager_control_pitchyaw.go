@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message to a gimbal manager to control the gimbal tilt and pan angles. Angles and rates can be set to NaN according to use case. A gimbal device is never to react to this message.
 type MessageStorm32GimbalManagerControlPitchyaw struct {
 	// System ID
@@ -30,3 +37,49 @@ type MessageStorm32GimbalManagerControlPitchyaw struct {
 func (*MessageStorm32GimbalManagerControlPitchyaw) GetID() uint32 {
 	return 60013
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerControlPitchyaw) MAVLinkCRCExtra() byte {
+	return 129
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerControlPitchyaw) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 24
+	}
+	return 24
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerControlPitchyaw) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Pitch))
+	binary.LittleEndian.PutUint32(buf[4:], math.Float32bits(m.Yaw))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.PitchRate))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.YawRate))
+	binary.LittleEndian.PutUint16(buf[16:], uint16(m.DeviceFlags))
+	binary.LittleEndian.PutUint16(buf[18:], uint16(m.ManagerFlags))
+	buf[20] = m.TargetSystem
+	buf[21] = m.TargetComponent
+	buf[22] = m.GimbalId
+	buf[23] = byte(m.Client)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerControlPitchyaw) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(buf[4:]))
+	m.PitchRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.DeviceFlags = GIMBAL_DEVICE_FLAGS(binary.LittleEndian.Uint16(buf[16:]))
+	m.ManagerFlags = MAV_STORM32_GIMBAL_MANAGER_FLAGS(binary.LittleEndian.Uint16(buf[18:]))
+	m.TargetSystem = buf[20]
+	m.TargetComponent = buf[21]
+	m.GimbalId = buf[22]
+	m.Client = MAV_STORM32_GIMBAL_MANAGER_CLIENT(buf[23])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorm32GimbalManagerControlPitchyaw) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60013]
+}
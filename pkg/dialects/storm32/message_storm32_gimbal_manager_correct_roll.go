@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message to a gimbal manager to correct the gimbal roll angle. This message is typically used to manually correct for a tilted horizon in operation. A gimbal device is never to react to this message.
 type MessageStorm32GimbalManagerCorrectRoll struct {
 	// System ID
@@ -20,3 +27,39 @@ type MessageStorm32GimbalManagerCorrectRoll struct {
 func (*MessageStorm32GimbalManagerCorrectRoll) GetID() uint32 {
 	return 60014
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerCorrectRoll) MAVLinkCRCExtra() byte {
+	return 134
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerCorrectRoll) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 8
+	}
+	return 8
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerCorrectRoll) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], math.Float32bits(m.Roll))
+	buf[4] = m.TargetSystem
+	buf[5] = m.TargetComponent
+	buf[6] = m.GimbalId
+	buf[7] = byte(m.Client)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerCorrectRoll) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Roll = math.Float32frombits(binary.LittleEndian.Uint32(buf[0:]))
+	m.TargetSystem = buf[4]
+	m.TargetComponent = buf[5]
+	m.GimbalId = buf[6]
+	m.Client = MAV_STORM32_GIMBAL_MANAGER_CLIENT(buf[7])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorm32GimbalManagerCorrectRoll) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60014]
+}
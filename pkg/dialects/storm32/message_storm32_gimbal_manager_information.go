@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Information about a gimbal manager. This message should be requested by a ground station using MAV_CMD_REQUEST_MESSAGE. It mirrors some fields of the GIMBAL_DEVICE_INFORMATION message, but not all. If the additional information is desired, also GIMBAL_DEVICE_INFORMATION should be requested.
 type MessageStorm32GimbalManagerInformation struct {
 	// Gimbal ID (component ID or 1-6 for non-MAVLink gimbal) that this gimbal manager is responsible for.
@@ -28,3 +35,47 @@ type MessageStorm32GimbalManagerInformation struct {
 func (*MessageStorm32GimbalManagerInformation) GetID() uint32 {
 	return 60010
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerInformation) MAVLinkCRCExtra() byte {
+	return 208
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerInformation) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 33
+	}
+	return 33
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerInformation) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.DeviceCapFlags))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.ManagerCapFlags))
+	binary.LittleEndian.PutUint32(buf[8:], math.Float32bits(m.RollMin))
+	binary.LittleEndian.PutUint32(buf[12:], math.Float32bits(m.RollMax))
+	binary.LittleEndian.PutUint32(buf[16:], math.Float32bits(m.PitchMin))
+	binary.LittleEndian.PutUint32(buf[20:], math.Float32bits(m.PitchMax))
+	binary.LittleEndian.PutUint32(buf[24:], math.Float32bits(m.YawMin))
+	binary.LittleEndian.PutUint32(buf[28:], math.Float32bits(m.YawMax))
+	buf[32] = m.GimbalId
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerInformation) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DeviceCapFlags = GIMBAL_DEVICE_CAP_FLAGS(binary.LittleEndian.Uint32(buf[0:]))
+	m.ManagerCapFlags = MAV_STORM32_GIMBAL_MANAGER_CAP_FLAGS(binary.LittleEndian.Uint32(buf[4:]))
+	m.RollMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[8:]))
+	m.RollMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[12:]))
+	m.PitchMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[16:]))
+	m.PitchMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[20:]))
+	m.YawMin = math.Float32frombits(binary.LittleEndian.Uint32(buf[24:]))
+	m.YawMax = math.Float32frombits(binary.LittleEndian.Uint32(buf[28:]))
+	m.GimbalId = buf[32]
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorm32GimbalManagerInformation) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60010]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package storm32
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Message reporting the current status of a gimbal manager. This message should be broadcast at a low regular rate (e.g. 1 Hz, may be increase momentarily to e.g. 5 Hz for a period of 1 sec after a change).
 type MessageStorm32GimbalManagerStatus struct {
 	// Gimbal ID (component ID or 1-6 for non-MAVLink gimbal) that this gimbal manager is responsible for.
@@ -20,3 +26,39 @@ type MessageStorm32GimbalManagerStatus struct {
 func (*MessageStorm32GimbalManagerStatus) GetID() uint32 {
 	return 60011
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerStatus) MAVLinkCRCExtra() byte {
+	return 183
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageStorm32GimbalManagerStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 7
+	}
+	return 7
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], uint16(m.DeviceFlags))
+	binary.LittleEndian.PutUint16(buf[2:], uint16(m.ManagerFlags))
+	buf[4] = m.GimbalId
+	buf[5] = byte(m.Supervisor)
+	buf[6] = byte(m.Profile)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageStorm32GimbalManagerStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.DeviceFlags = GIMBAL_DEVICE_FLAGS(binary.LittleEndian.Uint16(buf[0:]))
+	m.ManagerFlags = MAV_STORM32_GIMBAL_MANAGER_FLAGS(binary.LittleEndian.Uint16(buf[2:]))
+	m.GimbalId = buf[4]
+	m.Supervisor = MAV_STORM32_GIMBAL_MANAGER_CLIENT(buf[5])
+	m.Profile = MAV_STORM32_GIMBAL_MANAGER_PROFILE(buf[6])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageStorm32GimbalManagerStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[60011]
+}
@@ -0,0 +1,185 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package storm32
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	60000: {
+		ID:          60000,
+		Name:        "AUTOPILOT_STATE_FOR_GIMBAL_DEVICE_EXT",
+		Description: "Addition to message AUTOPILOT_STATE_FOR_GIMBAL_DEVICE.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID."},
+			{Name: "time_boot_us", Type: "uint64_t", Description: "Timestamp (time since system boot)."},
+			{Name: "wind_x", Type: "float", Description: "Wind X speed in NED (North, East, Down). NAN if unknown."},
+			{Name: "wind_y", Type: "float", Description: "Wind Y speed in NED (North, East, Down). NAN if unknown."},
+			{Name: "wind_correction_angle", Type: "float", Description: "Correction angle due to wind. NaN if unknown."},
+		},
+	},
+	60010: {
+		ID:          60010,
+		Name:        "STORM32_GIMBAL_MANAGER_INFORMATION",
+		Description: "Information about a gimbal manager. This message should be requested by a ground station using MAV_CMD_REQUEST_MESSAGE. It mirrors some fields of the GIMBAL_DEVICE_INFORMATION message, but not all. If the additional information is desired, also GIMBAL_DEVICE_INFORMATION should be requested.",
+		Fields: []*message.FieldMetadata{
+			{Name: "gimbal_id", Type: "uint8_t", Description: "Gimbal ID (component ID or 1-6 for non-MAVLink gimbal) that this gimbal manager is responsible for."},
+			{Name: "device_cap_flags", Type: "uint32_t", Enum: "GIMBAL_DEVICE_CAP_FLAGS", Bitmask: true, Description: "Gimbal device capability flags. Same flags as reported by GIMBAL_DEVICE_INFORMATION. The flag is only 16 bit wide, but stored in 32 bit, for backwards compatibility (high word is zero)."},
+			{Name: "manager_cap_flags", Type: "uint32_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_CAP_FLAGS", Bitmask: true, Description: "Gimbal manager capability flags."},
+			{Name: "roll_min", Type: "float", Description: "Hardware minimum roll angle (positive: roll to the right). NaN if unknown."},
+			{Name: "roll_max", Type: "float", Description: "Hardware maximum roll angle (positive: roll to the right). NaN if unknown."},
+			{Name: "pitch_min", Type: "float", Description: "Hardware minimum pitch/tilt angle (positive: tilt up). NaN if unknown."},
+			{Name: "pitch_max", Type: "float", Description: "Hardware maximum pitch/tilt angle (positive: tilt up). NaN if unknown."},
+			{Name: "yaw_min", Type: "float", Description: "Hardware minimum yaw/pan angle (positive: pan to the right, relative to the vehicle/gimbal base). NaN if unknown."},
+			{Name: "yaw_max", Type: "float", Description: "Hardware maximum yaw/pan angle (positive: pan to the right, relative to the vehicle/gimbal base). NaN if unknown."},
+		},
+	},
+	60011: {
+		ID:          60011,
+		Name:        "STORM32_GIMBAL_MANAGER_STATUS",
+		Description: "Message reporting the current status of a gimbal manager. This message should be broadcast at a low regular rate (e.g. 1 Hz, may be increase momentarily to e.g. 5 Hz for a period of 1 sec after a change).",
+		Fields: []*message.FieldMetadata{
+			{Name: "gimbal_id", Type: "uint8_t", Description: "Gimbal ID (component ID or 1-6 for non-MAVLink gimbal) that this gimbal manager is responsible for."},
+			{Name: "supervisor", Type: "uint8_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_CLIENT", Description: "Client who is currently supervisor (0 = none)."},
+			{Name: "device_flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_FLAGS", Bitmask: true, Description: "Gimbal device flags currently applied. Same flags as reported by GIMBAL_DEVICE_ATTITUDE_STATUS."},
+			{Name: "manager_flags", Type: "uint16_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "Gimbal manager flags currently applied."},
+			{Name: "profile", Type: "uint8_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_PROFILE", Description: "Profile currently applied (0 = default)."},
+		},
+	},
+	60012: {
+		ID:          60012,
+		Name:        "STORM32_GIMBAL_MANAGER_CONTROL",
+		Description: "Message to a gimbal manager to control the gimbal attitude. Angles and rates can be set to NaN according to use case. A gimbal device is never to react to this message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "gimbal_id", Type: "uint8_t", Description: "Gimbal ID of the gimbal manager to address (component ID or 1-6 for non-MAVLink gimbal, 0 for all gimbals). Send command multiple times for more than one but not all gimbals."},
+			{Name: "client", Type: "uint8_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_CLIENT", Description: "Client which is contacting the gimbal manager (must be set)."},
+			{Name: "device_flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_FLAGS", Bitmask: true, Description: "Gimbal device flags to be applied (UINT16_MAX to be ignored). Same flags as used in GIMBAL_DEVICE_SET_ATTITUDE."},
+			{Name: "manager_flags", Type: "uint16_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "Gimbal manager flags to be applied (0 to be ignored)."},
+			{Name: "q", Type: "float", ArrayLength: 4, Description: "Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation). Set first element to NaN to be ignored. The frame is determined by the GIMBAL_DEVICE_FLAGS_YAW_IN_xxx_FRAME flags."},
+			{Name: "angular_velocity_x", Type: "float", Description: "X component of angular velocity (positive: roll to the right). NaN to be ignored."},
+			{Name: "angular_velocity_y", Type: "float", Description: "Y component of angular velocity (positive: tilt up). NaN to be ignored."},
+			{Name: "angular_velocity_z", Type: "float", Description: "Z component of angular velocity (positive: pan to the right). NaN to be ignored. The frame is determined by the GIMBAL_DEVICE_FLAGS_YAW_IN_xxx_FRAME flags."},
+		},
+	},
+	60013: {
+		ID:          60013,
+		Name:        "STORM32_GIMBAL_MANAGER_CONTROL_PITCHYAW",
+		Description: "Message to a gimbal manager to control the gimbal tilt and pan angles. Angles and rates can be set to NaN according to use case. A gimbal device is never to react to this message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "gimbal_id", Type: "uint8_t", Description: "Gimbal ID of the gimbal manager to address (component ID or 1-6 for non-MAVLink gimbal, 0 for all gimbals). Send command multiple times for more than one but not all gimbals."},
+			{Name: "client", Type: "uint8_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_CLIENT", Description: "Client which is contacting the gimbal manager (must be set)."},
+			{Name: "device_flags", Type: "uint16_t", Enum: "GIMBAL_DEVICE_FLAGS", Bitmask: true, Description: "Gimbal device flags to be applied (UINT16_MAX to be ignored). Same flags as used in GIMBAL_DEVICE_SET_ATTITUDE."},
+			{Name: "manager_flags", Type: "uint16_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_FLAGS", Bitmask: true, Description: "Gimbal manager flags to be applied (0 to be ignored)."},
+			{Name: "pitch", Type: "float", Description: "Pitch/tilt angle (positive: tilt up). NaN to be ignored."},
+			{Name: "yaw", Type: "float", Description: "Yaw/pan angle (positive: pan the right). NaN to be ignored. The frame is determined by the GIMBAL_DEVICE_FLAGS_YAW_IN_xxx_FRAME flags."},
+			{Name: "pitch_rate", Type: "float", Description: "Pitch/tilt angular rate (positive: tilt up). NaN to be ignored."},
+			{Name: "yaw_rate", Type: "float", Description: "Yaw/pan angular rate (positive: pan to the right). NaN to be ignored. The frame is determined by the GIMBAL_DEVICE_FLAGS_YAW_IN_xxx_FRAME flags."},
+		},
+	},
+	60014: {
+		ID:          60014,
+		Name:        "STORM32_GIMBAL_MANAGER_CORRECT_ROLL",
+		Description: "Message to a gimbal manager to correct the gimbal roll angle. This message is typically used to manually correct for a tilted horizon in operation. A gimbal device is never to react to this message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID"},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID"},
+			{Name: "gimbal_id", Type: "uint8_t", Description: "Gimbal ID of the gimbal manager to address (component ID or 1-6 for non-MAVLink gimbal, 0 for all gimbals). Send command multiple times for more than one but not all gimbals."},
+			{Name: "client", Type: "uint8_t", Enum: "MAV_STORM32_GIMBAL_MANAGER_CLIENT", Description: "Client which is contacting the gimbal manager (must be set)."},
+			{Name: "roll", Type: "float", Description: "Roll angle (positive to roll to the right)."},
+		},
+	},
+	60020: {
+		ID:          60020,
+		Name:        "QSHOT_STATUS",
+		Description: "Information about the shot operation.",
+		Fields: []*message.FieldMetadata{
+			{Name: "mode", Type: "uint16_t", Enum: "MAV_QSHOT_MODE", Description: "Current shot mode."},
+			{Name: "shot_state", Type: "uint16_t", Description: "Current state in the shot. States are specific to the selected shot mode."},
+		},
+	},
+	60040: {
+		ID:          60040,
+		Name:        "FRSKY_PASSTHROUGH_ARRAY",
+		Description: "Frsky SPort passthrough multi packet container.",
+		Fields: []*message.FieldMetadata{
+			{Name: "time_boot_ms", Type: "uint32_t", Description: "Timestamp (time since system boot)."},
+			{Name: "count", Type: "uint8_t", Description: "Number of passthrough packets in this message."},
+			{Name: "packet_buf", Type: "uint8_t", ArrayLength: 240, Description: "Passthrough packet buffer. A packet has 6 bytes: uint16_t id + uint32_t data. The array has space for 40 packets."},
+		},
+	},
+	60041: {
+		ID:          60041,
+		Name:        "PARAM_VALUE_ARRAY",
+		Description: "Parameter multi param value container.",
+		Fields: []*message.FieldMetadata{
+			{Name: "param_count", Type: "uint16_t", Description: "Total number of onboard parameters."},
+			{Name: "param_index_first", Type: "uint16_t", Description: "Index of the first onboard parameter in this array."},
+			{Name: "param_array_len", Type: "uint8_t", Description: "Number of onboard parameters in this array."},
+			{Name: "flags", Type: "uint16_t", Description: "Flags."},
+			{Name: "packet_buf", Type: "uint8_t", ArrayLength: 248, Description: "Parameters buffer. Contains a series of variable length parameter blocks, one per parameter, with format as specified elsewhere."},
+		},
+	},
+	60045: {
+		ID:          60045,
+		Name:        "MLRS_RADIO_LINK_STATS",
+		Description: "Radio link statistics for a MAVLink RC receiver or transmitter and other links. Tx: ground-side device, Rx: vehicle-side device. The message is normally emitted in regular time intervals upon each actual or expected reception of an over-the-air data packet on the link. A MAVLink RC receiver should emit it shortly after it emits a RADIO_RC_CHANNELS message (if it is emitting that message). Per default, rssi values are in MAVLink units: 0 represents weakest signal, 254 represents maximum signal, UINT8_MAX represents unknown. The RADIO_LINK_STATS_FLAGS_RSSI_DBM flag is set if the rssi units are negative dBm: 1..254 correspond to -1..-254 dBm, 0 represents no reception, UINT8_MAX represents unknown. The target_system field should normally be set to the system id of the system the link is connected to, typically the flight controller. The target_component field can normally be set to 0, so that all components of the system can receive the message. Note: The frequency fields are extensions to ensure that they are located at the end of the serialized payload and subject to MAVLink's trailing-zero trimming.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (ID of target system, normally flight controller)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (normally 0 for broadcast)."},
+			{Name: "flags", Type: "uint16_t", Enum: "MLRS_RADIO_LINK_STATS_FLAGS", Bitmask: true, Description: "Radio link statistics flags."},
+			{Name: "rx_LQ_rc", Type: "uint8_t", Description: "Link quality of RC data stream from Tx to Rx. Values: 1..100, 0: no link connection, UINT8_MAX: unknown."},
+			{Name: "rx_LQ_ser", Type: "uint8_t", Description: "Link quality of serial MAVLink data stream from Tx to Rx. Values: 1..100, 0: no link connection, UINT8_MAX: unknown."},
+			{Name: "rx_rssi1", Type: "uint8_t", Description: "Rssi of antenna 1. 0: no reception, UINT8_MAX: unknown."},
+			{Name: "rx_snr1", Type: "int8_t", Description: "Noise on antenna 1. Radio link dependent. INT8_MAX: unknown."},
+			{Name: "tx_LQ_ser", Type: "uint8_t", Description: "Link quality of serial MAVLink data stream from Rx to Tx. Values: 1..100, 0: no link connection, UINT8_MAX: unknown."},
+			{Name: "tx_rssi1", Type: "uint8_t", Description: "Rssi of antenna 1. 0: no reception. UINT8_MAX: unknown."},
+			{Name: "tx_snr1", Type: "int8_t", Description: "Noise on antenna 1. Radio link dependent. INT8_MAX: unknown."},
+			{Name: "rx_rssi2", Type: "uint8_t", Description: "Rssi of antenna 2. 0: no reception, UINT8_MAX: use rx_rssi1 if it is known else unknown."},
+			{Name: "rx_snr2", Type: "int8_t", Description: "Noise on antenna 2. Radio link dependent. INT8_MAX: use rx_snr1 if it is known else unknown."},
+			{Name: "tx_rssi2", Type: "uint8_t", Description: "Rssi of antenna 2. 0: no reception. UINT8_MAX: use tx_rssi1 if it is known else unknown."},
+			{Name: "tx_snr2", Type: "int8_t", Description: "Noise on antenna 2. Radio link dependent. INT8_MAX: use tx_snr1 if it is known else unknown."},
+			{Name: "frequency1", Type: "float", Extension: true, Description: "Frequency on antenna1 in Hz. 0: unknown."},
+			{Name: "frequency2", Type: "float", Extension: true, Description: "Frequency on antenna2 in Hz. 0: unknown."},
+		},
+	},
+	60046: {
+		ID:          60046,
+		Name:        "MLRS_RADIO_LINK_INFORMATION",
+		Description: "Radio link information. Tx: ground-side device, Rx: vehicle-side device. The values of the fields in this message do normally not or only slowly change with time, and for most times the message can be send at a low rate, like 0.2 Hz. If values change then the message should temporarily be send more often to inform the system about the changes. The target_system field should normally be set to the system id of the system the link is connected to, typically the flight controller. The target_component field can normally be set to 0, so that all components of the system can receive the message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "target_system", Type: "uint8_t", Description: "System ID (ID of target system, normally flight controller)."},
+			{Name: "target_component", Type: "uint8_t", Description: "Component ID (normally 0 for broadcast)."},
+			{Name: "type", Type: "uint8_t", Enum: "MLRS_RADIO_LINK_TYPE", Description: "Radio link type. 0: unknown/generic type."},
+			{Name: "mode", Type: "uint8_t", Description: "Operation mode. Radio link dependent. UINT8_MAX: ignore/unknown."},
+			{Name: "tx_power", Type: "int8_t", Description: "Tx transmit power in dBm. INT8_MAX: unknown."},
+			{Name: "rx_power", Type: "int8_t", Description: "Rx transmit power in dBm. INT8_MAX: unknown."},
+			{Name: "tx_frame_rate", Type: "uint16_t", Description: "Frame rate in Hz (frames per second) for Tx to Rx transmission. 0: unknown."},
+			{Name: "rx_frame_rate", Type: "uint16_t", Description: "Frame rate in Hz (frames per second) for Rx to Tx transmission. Normally equal to tx_packet_rate. 0: unknown."},
+			{Name: "mode_str", Type: "char", ArrayLength: 6, Description: "Operation mode as human readable string. Radio link dependent. Terminated by NULL if the string length is less than 6 chars and WITHOUT NULL termination if the length is exactly 6 chars - applications have to provide 6+1 bytes storage if the mode is stored as string. Use a zero-length string if not known."},
+			{Name: "band_str", Type: "char", ArrayLength: 6, Description: "Frequency band as human readable string. Radio link dependent. Terminated by NULL if the string length is less than 6 chars and WITHOUT NULL termination if the length is exactly 6 chars - applications have to provide 6+1 bytes storage if the mode is stored as string. Use a zero-length string if not known."},
+			{Name: "tx_ser_data_rate", Type: "uint16_t", Description: "Maximum data rate of serial stream in bytes/s for Tx to Rx transmission. 0: unknown. UINT16_MAX: data rate is 64 KBytes/s or larger."},
+			{Name: "rx_ser_data_rate", Type: "uint16_t", Description: "Maximum data rate of serial stream in bytes/s for Rx to Tx transmission. 0: unknown. UINT16_MAX: data rate is 64 KBytes/s or larger."},
+			{Name: "tx_receive_sensitivity", Type: "uint8_t", Description: "Receive sensitivity of Tx in inverted dBm. 1..255 represents -1..-255 dBm, 0: unknown."},
+			{Name: "rx_receive_sensitivity", Type: "uint8_t", Description: "Receive sensitivity of Rx in inverted dBm. 1..255 represents -1..-255 dBm, 0: unknown."},
+		},
+	},
+	60047: {
+		ID:          60047,
+		Name:        "MLRS_RADIO_LINK_FLOW_CONTROL",
+		Description: "Injected by a radio link endpoint into the MAVLink stream for purposes of flow control. Should be emitted only by components with component id MAV_COMP_ID_TELEMETRY_RADIO.",
+		Fields: []*message.FieldMetadata{
+			{Name: "tx_ser_rate", Type: "uint16_t", Description: "Transmitted bytes per second, UINT16_MAX: invalid/unknown."},
+			{Name: "rx_ser_rate", Type: "uint16_t", Description: "Received bytes per second, UINT16_MAX: invalid/unknown."},
+			{Name: "tx_used_ser_bandwidth", Type: "uint8_t", Description: "Transmit bandwidth consumption. Values: 0..100, UINT8_MAX: invalid/unknown."},
+			{Name: "rx_used_ser_bandwidth", Type: "uint8_t", Description: "Receive bandwidth consumption. Values: 0..100, UINT8_MAX: invalid/unknown."},
+			{Name: "txbuf", Type: "uint8_t", Description: "For compatibility with legacy method. UINT8_MAX: unknown."},
+		},
+	},
+}
@@ -1,5 +1,7 @@
 // Package test contains the test dialect.
 //
+// Definitions hash (SHA256): 2eac7a1b59866377f22f0e1930aeda71106abbb37ebb816dc2a360eeb0848dbb
+//
 //autogenerated:yes
 package test
 
@@ -18,4 +20,5 @@ var dial = &dialect.Dialect{
 		// test
 		&MessageTestTypes{},
 	},
+	Enums: enums,
 }
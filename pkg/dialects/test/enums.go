@@ -0,0 +1,10 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package test
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// enums contains metadata of enums of the dialect, as written in the XML definitions.
+var enums = map[string]*message.EnumMetadata{}
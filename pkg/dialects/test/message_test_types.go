@@ -2,6 +2,14 @@
 //nolint:revive,misspell,govet,lll
 package test
 
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Test all field types
 type MessageTestTypes struct {
 	// char
@@ -54,3 +62,121 @@ type MessageTestTypes struct {
 func (*MessageTestTypes) GetID() uint32 {
 	return 17000
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageTestTypes) MAVLinkCRCExtra() byte {
+	return 17
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageTestTypes) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 179
+	}
+	return 179
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTestTypes) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint64(buf[0:], m.U64)
+	binary.LittleEndian.PutUint64(buf[8:], uint64(m.S64))
+	binary.LittleEndian.PutUint64(buf[16:], math.Float64bits(m.D))
+	for i, v := range m.U64Array {
+		binary.LittleEndian.PutUint64(buf[24+i*8:], v)
+	}
+	for i, v := range m.S64Array {
+		binary.LittleEndian.PutUint64(buf[48+i*8:], uint64(v))
+	}
+	for i, v := range m.DArray {
+		binary.LittleEndian.PutUint64(buf[72+i*8:], math.Float64bits(v))
+	}
+	binary.LittleEndian.PutUint32(buf[96:], m.U32)
+	binary.LittleEndian.PutUint32(buf[100:], uint32(m.S32))
+	binary.LittleEndian.PutUint32(buf[104:], math.Float32bits(m.F))
+	for i, v := range m.U32Array {
+		binary.LittleEndian.PutUint32(buf[108+i*4:], v)
+	}
+	for i, v := range m.S32Array {
+		binary.LittleEndian.PutUint32(buf[120+i*4:], uint32(v))
+	}
+	for i, v := range m.FArray {
+		binary.LittleEndian.PutUint32(buf[132+i*4:], math.Float32bits(v))
+	}
+	binary.LittleEndian.PutUint16(buf[144:], m.U16)
+	binary.LittleEndian.PutUint16(buf[146:], uint16(m.S16))
+	for i, v := range m.U16Array {
+		binary.LittleEndian.PutUint16(buf[148+i*2:], v)
+	}
+	for i, v := range m.S16Array {
+		binary.LittleEndian.PutUint16(buf[154+i*2:], uint16(v))
+	}
+	copy(buf[160:161], m.C)
+	copy(buf[161:171], m.S)
+	buf[171] = m.U8
+	buf[172] = byte(m.S8)
+	for i, v := range m.U8Array {
+		buf[173+i] = v
+	}
+	for i, v := range m.S8Array {
+		buf[176+i] = byte(v)
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageTestTypes) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.U64 = binary.LittleEndian.Uint64(buf[0:])
+	m.S64 = int64(binary.LittleEndian.Uint64(buf[8:]))
+	m.D = math.Float64frombits(binary.LittleEndian.Uint64(buf[16:]))
+	for i := range m.U64Array {
+		m.U64Array[i] = binary.LittleEndian.Uint64(buf[24+i*8:])
+	}
+	for i := range m.S64Array {
+		m.S64Array[i] = int64(binary.LittleEndian.Uint64(buf[48+i*8:]))
+	}
+	for i := range m.DArray {
+		m.DArray[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[72+i*8:]))
+	}
+	m.U32 = binary.LittleEndian.Uint32(buf[96:])
+	m.S32 = int32(binary.LittleEndian.Uint32(buf[100:]))
+	m.F = math.Float32frombits(binary.LittleEndian.Uint32(buf[104:]))
+	for i := range m.U32Array {
+		m.U32Array[i] = binary.LittleEndian.Uint32(buf[108+i*4:])
+	}
+	for i := range m.S32Array {
+		m.S32Array[i] = int32(binary.LittleEndian.Uint32(buf[120+i*4:]))
+	}
+	for i := range m.FArray {
+		m.FArray[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[132+i*4:]))
+	}
+	m.U16 = binary.LittleEndian.Uint16(buf[144:])
+	m.S16 = int16(binary.LittleEndian.Uint16(buf[146:]))
+	for i := range m.U16Array {
+		m.U16Array[i] = binary.LittleEndian.Uint16(buf[148+i*2:])
+	}
+	for i := range m.S16Array {
+		m.S16Array[i] = int16(binary.LittleEndian.Uint16(buf[154+i*2:]))
+	}
+	if n := bytes.IndexByte(buf[160:161], 0); n >= 0 {
+		m.C = string(buf[160 : 160+n])
+	} else {
+		m.C = string(buf[160:161])
+	}
+	if n := bytes.IndexByte(buf[161:171], 0); n >= 0 {
+		m.S = string(buf[161 : 161+n])
+	} else {
+		m.S = string(buf[161:171])
+	}
+	m.U8 = buf[171]
+	m.S8 = int8(buf[172])
+	for i := range m.U8Array {
+		m.U8Array[i] = buf[173+i]
+	}
+	for i := range m.S8Array {
+		m.S8Array[i] = int8(buf[176+i])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageTestTypes) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[17000]
+}
@@ -0,0 +1,14 @@
+//autogenerated:yes
+//nolint:revive
+package test
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+)
+
+// MessageTable contains the CRC extra, the length and the target offsets
+// of all messages of the dialect. It can be used to validate and route frames
+// without decoding them.
+var MessageTable = dialect.MessageTable{
+	17000: {CRCExtra: 17, MinLength: 179, MaxLength: 179, TargetSystemOffset: -1, TargetComponentOffset: -1},
+}
@@ -1,5 +1,7 @@
 // Package uavionix contains the uavionix dialect.
 //
+// Definitions hash (SHA256): 9d5d7b19c3a255514b197175a426281c6c61920125145fd1568792e10017f975
+//
 //autogenerated:yes
 package uavionix
 
@@ -262,4 +264,5 @@ var dial = &dialect.Dialect{
 		&MessageUavionixAdsbOutControl{},
 		&MessageUavionixAdsbOutStatus{},
 	},
+	Enums: enums,
 }
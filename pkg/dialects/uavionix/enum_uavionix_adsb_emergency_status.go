@@ -21,6 +21,17 @@ const (
 	UAVIONIX_ADSB_OUT_RESERVED                        UAVIONIX_ADSB_EMERGENCY_STATUS = 7
 )
 
+var values_UAVIONIX_ADSB_EMERGENCY_STATUS = []UAVIONIX_ADSB_EMERGENCY_STATUS{
+	UAVIONIX_ADSB_OUT_NO_EMERGENCY,
+	UAVIONIX_ADSB_OUT_GENERAL_EMERGENCY,
+	UAVIONIX_ADSB_OUT_LIFEGUARD_EMERGENCY,
+	UAVIONIX_ADSB_OUT_MINIMUM_FUEL_EMERGENCY,
+	UAVIONIX_ADSB_OUT_NO_COMM_EMERGENCY,
+	UAVIONIX_ADSB_OUT_UNLAWFUL_INTERFERANCE_EMERGENCY,
+	UAVIONIX_ADSB_OUT_DOWNED_AIRCRAFT_EMERGENCY,
+	UAVIONIX_ADSB_OUT_RESERVED,
+}
+
 var value_to_label_UAVIONIX_ADSB_EMERGENCY_STATUS = map[UAVIONIX_ADSB_EMERGENCY_STATUS]string{
 	UAVIONIX_ADSB_OUT_NO_EMERGENCY:                    "UAVIONIX_ADSB_OUT_NO_EMERGENCY",
 	UAVIONIX_ADSB_OUT_GENERAL_EMERGENCY:               "UAVIONIX_ADSB_OUT_GENERAL_EMERGENCY",
@@ -68,3 +79,14 @@ func (e UAVIONIX_ADSB_EMERGENCY_STATUS) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_EMERGENCY_STATUS) Values() []UAVIONIX_ADSB_EMERGENCY_STATUS {
+	return append([]UAVIONIX_ADSB_EMERGENCY_STATUS(nil), values_UAVIONIX_ADSB_EMERGENCY_STATUS...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_EMERGENCY_STATUS) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_EMERGENCY_STATUS[e]
+	return ok
+}
@@ -29,6 +29,25 @@ const (
 	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L85_W90M    UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE = 15
 )
 
+var values_UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE = []UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE{
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_NO_DATA,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L15M_W23M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L25M_W28P5M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L25_34M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L35_33M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L35_38M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L45_39P5M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L45_45M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L55_45M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L55_52M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L65_59P5M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L65_67M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L75_W72P5M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L75_W80M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L85_W80M,
+	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L85_W90M,
+}
+
 var value_to_label_UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE = map[UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE]string{
 	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_NO_DATA:     "UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_NO_DATA",
 	UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L15M_W23M:   "UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE_L15M_W23M",
@@ -92,3 +111,14 @@ func (e UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE) Values() []UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE {
+	return append([]UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE(nil), values_UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE[e]
+	return ok
+}
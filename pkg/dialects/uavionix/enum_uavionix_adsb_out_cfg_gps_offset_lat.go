@@ -21,6 +21,17 @@ const (
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_RIGHT_6M UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT = 7
 )
 
+var values_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT = []UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT{
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_NO_DATA,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_LEFT_2M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_LEFT_4M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_LEFT_6M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_RIGHT_0M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_RIGHT_2M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_RIGHT_4M,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_RIGHT_6M,
+}
+
 var value_to_label_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT = map[UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT]string{
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_NO_DATA:  "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_NO_DATA",
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_LEFT_2M:  "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT_LEFT_2M",
@@ -68,3 +79,14 @@ func (e UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT) Values() []UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT {
+	return append([]UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT(nil), values_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT[e]
+	return ok
+}
@@ -15,6 +15,11 @@ const (
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_APPLIED_BY_SENSOR UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON = 1
 )
 
+var values_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON = []UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON{
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_NO_DATA,
+	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_APPLIED_BY_SENSOR,
+}
+
 var value_to_label_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON = map[UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON]string{
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_NO_DATA:           "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_NO_DATA",
 	UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_APPLIED_BY_SENSOR: "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON_APPLIED_BY_SENSOR",
@@ -50,3 +55,14 @@ func (e UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON) Values() []UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON {
+	return append([]UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON(nil), values_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON[e]
+	return ok
+}
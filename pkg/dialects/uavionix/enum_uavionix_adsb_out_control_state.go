@@ -87,3 +87,33 @@ func (e UAVIONIX_ADSB_OUT_CONTROL_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_CONTROL_STATE) Values() []UAVIONIX_ADSB_OUT_CONTROL_STATE {
+	return append([]UAVIONIX_ADSB_OUT_CONTROL_STATE(nil), values_UAVIONIX_ADSB_OUT_CONTROL_STATE...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_OUT_CONTROL_STATE) IsValid() bool {
+	var mask UAVIONIX_ADSB_OUT_CONTROL_STATE
+	for _, val := range values_UAVIONIX_ADSB_OUT_CONTROL_STATE {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_OUT_CONTROL_STATE) Flags() []UAVIONIX_ADSB_OUT_CONTROL_STATE {
+	var flags []UAVIONIX_ADSB_OUT_CONTROL_STATE
+	for _, val := range values_UAVIONIX_ADSB_OUT_CONTROL_STATE {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_OUT_CONTROL_STATE) Has(flags UAVIONIX_ADSB_OUT_CONTROL_STATE) bool {
+	return e&flags == flags
+}
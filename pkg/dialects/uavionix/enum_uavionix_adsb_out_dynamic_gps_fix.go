@@ -19,6 +19,15 @@ const (
 	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_RTK    UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX = 5
 )
 
+var values_UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX = []UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX{
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_0,
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_1,
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_2D,
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_3D,
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_DGPS,
+	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_RTK,
+}
+
 var value_to_label_UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX = map[UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX]string{
 	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_0: "UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_0",
 	UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_1: "UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX_NONE_1",
@@ -62,3 +71,14 @@ func (e UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX) Values() []UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX {
+	return append([]UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX(nil), values_UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX[e]
+	return ok
+}
@@ -67,3 +67,33 @@ func (e UAVIONIX_ADSB_OUT_RF_SELECT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_RF_SELECT) Values() []UAVIONIX_ADSB_OUT_RF_SELECT {
+	return append([]UAVIONIX_ADSB_OUT_RF_SELECT(nil), values_UAVIONIX_ADSB_OUT_RF_SELECT...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_OUT_RF_SELECT) IsValid() bool {
+	var mask UAVIONIX_ADSB_OUT_RF_SELECT
+	for _, val := range values_UAVIONIX_ADSB_OUT_RF_SELECT {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_OUT_RF_SELECT) Flags() []UAVIONIX_ADSB_OUT_RF_SELECT {
+	var flags []UAVIONIX_ADSB_OUT_RF_SELECT
+	for _, val := range values_UAVIONIX_ADSB_OUT_RF_SELECT {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_OUT_RF_SELECT) Has(flags UAVIONIX_ADSB_OUT_RF_SELECT) bool {
+	return e&flags == flags
+}
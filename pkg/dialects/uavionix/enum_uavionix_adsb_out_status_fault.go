@@ -79,3 +79,33 @@ func (e UAVIONIX_ADSB_OUT_STATUS_FAULT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_STATUS_FAULT) Values() []UAVIONIX_ADSB_OUT_STATUS_FAULT {
+	return append([]UAVIONIX_ADSB_OUT_STATUS_FAULT(nil), values_UAVIONIX_ADSB_OUT_STATUS_FAULT...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_OUT_STATUS_FAULT) IsValid() bool {
+	var mask UAVIONIX_ADSB_OUT_STATUS_FAULT
+	for _, val := range values_UAVIONIX_ADSB_OUT_STATUS_FAULT {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_OUT_STATUS_FAULT) Flags() []UAVIONIX_ADSB_OUT_STATUS_FAULT {
+	var flags []UAVIONIX_ADSB_OUT_STATUS_FAULT
+	for _, val := range values_UAVIONIX_ADSB_OUT_STATUS_FAULT {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_OUT_STATUS_FAULT) Has(flags UAVIONIX_ADSB_OUT_STATUS_FAULT) bool {
+	return e&flags == flags
+}
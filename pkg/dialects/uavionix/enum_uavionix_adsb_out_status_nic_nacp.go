@@ -35,6 +35,31 @@ const (
 	UAVIONIX_ADSB_NACP_EPU_3_M     UAVIONIX_ADSB_OUT_STATUS_NIC_NACP = 176
 )
 
+var values_UAVIONIX_ADSB_OUT_STATUS_NIC_NACP = []UAVIONIX_ADSB_OUT_STATUS_NIC_NACP{
+	UAVIONIX_ADSB_NIC_CR_20_NM,
+	UAVIONIX_ADSB_NIC_CR_8_NM,
+	UAVIONIX_ADSB_NIC_CR_4_NM,
+	UAVIONIX_ADSB_NIC_CR_2_NM,
+	UAVIONIX_ADSB_NIC_CR_1_NM,
+	UAVIONIX_ADSB_NIC_CR_0_3_NM,
+	UAVIONIX_ADSB_NIC_CR_0_2_NM,
+	UAVIONIX_ADSB_NIC_CR_0_1_NM,
+	UAVIONIX_ADSB_NIC_CR_75_M,
+	UAVIONIX_ADSB_NIC_CR_25_M,
+	UAVIONIX_ADSB_NIC_CR_7_5_M,
+	UAVIONIX_ADSB_NACP_EPU_10_NM,
+	UAVIONIX_ADSB_NACP_EPU_4_NM,
+	UAVIONIX_ADSB_NACP_EPU_2_NM,
+	UAVIONIX_ADSB_NACP_EPU_1_NM,
+	UAVIONIX_ADSB_NACP_EPU_0_5_NM,
+	UAVIONIX_ADSB_NACP_EPU_0_3_NM,
+	UAVIONIX_ADSB_NACP_EPU_0_1_NM,
+	UAVIONIX_ADSB_NACP_EPU_0_05_NM,
+	UAVIONIX_ADSB_NACP_EPU_30_M,
+	UAVIONIX_ADSB_NACP_EPU_10_M,
+	UAVIONIX_ADSB_NACP_EPU_3_M,
+}
+
 var value_to_label_UAVIONIX_ADSB_OUT_STATUS_NIC_NACP = map[UAVIONIX_ADSB_OUT_STATUS_NIC_NACP]string{
 	UAVIONIX_ADSB_NIC_CR_20_NM:     "UAVIONIX_ADSB_NIC_CR_20_NM",
 	UAVIONIX_ADSB_NIC_CR_8_NM:      "UAVIONIX_ADSB_NIC_CR_8_NM",
@@ -110,3 +135,14 @@ func (e UAVIONIX_ADSB_OUT_STATUS_NIC_NACP) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_STATUS_NIC_NACP) Values() []UAVIONIX_ADSB_OUT_STATUS_NIC_NACP {
+	return append([]UAVIONIX_ADSB_OUT_STATUS_NIC_NACP(nil), values_UAVIONIX_ADSB_OUT_STATUS_NIC_NACP...)
+}
+
+// IsValid returns whether the value is defined by the enum.
+func (e UAVIONIX_ADSB_OUT_STATUS_NIC_NACP) IsValid() bool {
+	_, ok := value_to_label_UAVIONIX_ADSB_OUT_STATUS_NIC_NACP[e]
+	return ok
+}
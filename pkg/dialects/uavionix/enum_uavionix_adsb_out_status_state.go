@@ -91,3 +91,33 @@ func (e UAVIONIX_ADSB_OUT_STATUS_STATE) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_OUT_STATUS_STATE) Values() []UAVIONIX_ADSB_OUT_STATUS_STATE {
+	return append([]UAVIONIX_ADSB_OUT_STATUS_STATE(nil), values_UAVIONIX_ADSB_OUT_STATUS_STATE...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_OUT_STATUS_STATE) IsValid() bool {
+	var mask UAVIONIX_ADSB_OUT_STATUS_STATE
+	for _, val := range values_UAVIONIX_ADSB_OUT_STATUS_STATE {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_OUT_STATUS_STATE) Flags() []UAVIONIX_ADSB_OUT_STATUS_STATE {
+	var flags []UAVIONIX_ADSB_OUT_STATUS_STATE
+	for _, val := range values_UAVIONIX_ADSB_OUT_STATUS_STATE {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_OUT_STATUS_STATE) Has(flags UAVIONIX_ADSB_OUT_STATUS_STATE) bool {
+	return e&flags == flags
+}
@@ -71,3 +71,33 @@ func (e UAVIONIX_ADSB_RF_HEALTH) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_RF_HEALTH) Values() []UAVIONIX_ADSB_RF_HEALTH {
+	return append([]UAVIONIX_ADSB_RF_HEALTH(nil), values_UAVIONIX_ADSB_RF_HEALTH...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_RF_HEALTH) IsValid() bool {
+	var mask UAVIONIX_ADSB_RF_HEALTH
+	for _, val := range values_UAVIONIX_ADSB_RF_HEALTH {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_RF_HEALTH) Flags() []UAVIONIX_ADSB_RF_HEALTH {
+	var flags []UAVIONIX_ADSB_RF_HEALTH
+	for _, val := range values_UAVIONIX_ADSB_RF_HEALTH {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_RF_HEALTH) Has(flags UAVIONIX_ADSB_RF_HEALTH) bool {
+	return e&flags == flags
+}
@@ -63,3 +63,33 @@ func (e UAVIONIX_ADSB_XBIT) String() string {
 	val, _ := e.MarshalText()
 	return string(val)
 }
+
+// Values returns all the values of the enum.
+func (UAVIONIX_ADSB_XBIT) Values() []UAVIONIX_ADSB_XBIT {
+	return append([]UAVIONIX_ADSB_XBIT(nil), values_UAVIONIX_ADSB_XBIT...)
+}
+
+// IsValid returns whether all the flags that are set are defined by the enum.
+func (e UAVIONIX_ADSB_XBIT) IsValid() bool {
+	var mask UAVIONIX_ADSB_XBIT
+	for _, val := range values_UAVIONIX_ADSB_XBIT {
+		mask |= val
+	}
+	return e&^mask == 0
+}
+
+// Flags returns the flags that are set.
+func (e UAVIONIX_ADSB_XBIT) Flags() []UAVIONIX_ADSB_XBIT {
+	var flags []UAVIONIX_ADSB_XBIT
+	for _, val := range values_UAVIONIX_ADSB_XBIT {
+		if val != 0 && e&val == val {
+			flags = append(flags, val)
+		}
+	}
+	return flags
+}
+
+// Has returns whether all the given flags are set.
+func (e UAVIONIX_ADSB_XBIT) Has(flags UAVIONIX_ADSB_XBIT) bool {
+	return e&flags == flags
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Request messages.
 type MessageUavionixAdsbGet struct {
 	// Message ID to request. Supports any message in this 10000-10099 range
@@ -12,3 +18,31 @@ type MessageUavionixAdsbGet struct {
 func (*MessageUavionixAdsbGet) GetID() uint32 {
 	return 10006
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbGet) MAVLinkCRCExtra() byte {
+	return 193
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbGet) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 4
+	}
+	return 4
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbGet) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Reqmessageid)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbGet) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Reqmessageid = binary.LittleEndian.Uint32(buf[0:])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbGet) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10006]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Static data to configure the ADS-B transponder (send within 10 sec of a POR and every 10 sec thereafter)
 type MessageUavionixAdsbOutCfg struct {
 	// Vehicle address (24 bit)
@@ -26,3 +33,49 @@ type MessageUavionixAdsbOutCfg struct {
 func (*MessageUavionixAdsbOutCfg) GetID() uint32 {
 	return 10001
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfg) MAVLinkCRCExtra() byte {
+	return 209
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfg) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 20
+	}
+	return 20
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfg) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Icao)
+	binary.LittleEndian.PutUint16(buf[4:], m.Stallspeed)
+	copy(buf[6:15], m.Callsign)
+	buf[15] = byte(m.Emittertype)
+	buf[16] = byte(m.Aircraftsize)
+	buf[17] = byte(m.Gpsoffsetlat)
+	buf[18] = byte(m.Gpsoffsetlon)
+	buf[19] = byte(m.Rfselect)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfg) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Icao = binary.LittleEndian.Uint32(buf[0:])
+	m.Stallspeed = binary.LittleEndian.Uint16(buf[4:])
+	if n := bytes.IndexByte(buf[6:15], 0); n >= 0 {
+		m.Callsign = string(buf[6 : 6+n])
+	} else {
+		m.Callsign = string(buf[6:15])
+	}
+	m.Emittertype = ADSB_EMITTER_TYPE(buf[15])
+	m.Aircraftsize = UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE(buf[16])
+	m.Gpsoffsetlat = UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT(buf[17])
+	m.Gpsoffsetlon = UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON(buf[18])
+	m.Rfselect = UAVIONIX_ADSB_OUT_RF_SELECT(buf[19])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutCfg) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10001]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Flight Identification for ADSB-Out vehicles.
 type MessageUavionixAdsbOutCfgFlightid struct {
 	// Flight Identification: 8 ASCII characters, '0' through '9', 'A' through 'Z' or space. Spaces (0x20) used as a trailing pad character, or when call sign is unavailable. Reflects Control message setting. This is null-terminated.
@@ -12,3 +18,35 @@ type MessageUavionixAdsbOutCfgFlightid struct {
 func (*MessageUavionixAdsbOutCfgFlightid) GetID() uint32 {
 	return 10005
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfgFlightid) MAVLinkCRCExtra() byte {
+	return 103
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfgFlightid) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfgFlightid) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:9], m.FlightId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfgFlightid) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:9], 0); n >= 0 {
+		m.FlightId = string(buf[0 : 0+n])
+	} else {
+		m.FlightId = string(buf[0:9])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutCfgFlightid) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10005]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"bytes"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Aircraft Registration.
 type MessageUavionixAdsbOutCfgRegistration struct {
 	// Aircraft Registration (ASCII string A-Z, 0-9 only), e.g. "N8644B ". Trailing spaces (0x20) only. This is null-terminated.
@@ -12,3 +18,35 @@ type MessageUavionixAdsbOutCfgRegistration struct {
 func (*MessageUavionixAdsbOutCfgRegistration) GetID() uint32 {
 	return 10004
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfgRegistration) MAVLinkCRCExtra() byte {
+	return 133
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutCfgRegistration) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 9
+	}
+	return 9
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfgRegistration) MarshalMAVLink(buf []byte, isV2 bool) {
+	copy(buf[0:9], m.Registration)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutCfgRegistration) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	if n := bytes.IndexByte(buf[0:9], 0); n >= 0 {
+		m.Registration = string(buf[0 : 0+n])
+	} else {
+		m.Registration = string(buf[0:9])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutCfgRegistration) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10004]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Control message with all data sent in UCP control message.
 type MessageUavionixAdsbOutControl struct {
 	// ADS-B transponder control state flags
@@ -22,3 +29,45 @@ type MessageUavionixAdsbOutControl struct {
 func (*MessageUavionixAdsbOutControl) GetID() uint32 {
 	return 10007
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutControl) MAVLinkCRCExtra() byte {
+	return 71
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutControl) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 17
+	}
+	return 17
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutControl) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], uint32(m.Baroaltmsl))
+	binary.LittleEndian.PutUint16(buf[4:], m.Squawk)
+	buf[6] = byte(m.State)
+	buf[7] = byte(m.Emergencystatus)
+	copy(buf[8:16], m.FlightId)
+	buf[16] = byte(m.XBit)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutControl) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Baroaltmsl = int32(binary.LittleEndian.Uint32(buf[0:]))
+	m.Squawk = binary.LittleEndian.Uint16(buf[4:])
+	m.State = UAVIONIX_ADSB_OUT_CONTROL_STATE(buf[6])
+	m.Emergencystatus = UAVIONIX_ADSB_EMERGENCY_STATUS(buf[7])
+	if n := bytes.IndexByte(buf[8:16], 0); n >= 0 {
+		m.FlightId = string(buf[8 : 8+n])
+	} else {
+		m.FlightId = string(buf[8:16])
+	}
+	m.XBit = UAVIONIX_ADSB_XBIT(buf[16])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutControl) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10007]
+}
@@ -2,6 +2,12 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Dynamic data used to generate ADS-B out transponder data (send at 5Hz)
 type MessageUavionixAdsbOutDynamic struct {
 	// UTC time in seconds since GPS epoch (Jan 6, 1980). If unknown set to UINT32_MAX
@@ -42,3 +48,61 @@ type MessageUavionixAdsbOutDynamic struct {
 func (*MessageUavionixAdsbOutDynamic) GetID() uint32 {
 	return 10002
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutDynamic) MAVLinkCRCExtra() byte {
+	return 186
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutDynamic) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 41
+	}
+	return 41
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutDynamic) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.Utctime)
+	binary.LittleEndian.PutUint32(buf[4:], uint32(m.Gpslat))
+	binary.LittleEndian.PutUint32(buf[8:], uint32(m.Gpslon))
+	binary.LittleEndian.PutUint32(buf[12:], uint32(m.Gpsalt))
+	binary.LittleEndian.PutUint32(buf[16:], uint32(m.Baroaltmsl))
+	binary.LittleEndian.PutUint32(buf[20:], m.Accuracyhor)
+	binary.LittleEndian.PutUint16(buf[24:], m.Accuracyvert)
+	binary.LittleEndian.PutUint16(buf[26:], m.Accuracyvel)
+	binary.LittleEndian.PutUint16(buf[28:], uint16(m.Velvert))
+	binary.LittleEndian.PutUint16(buf[30:], uint16(m.Velns))
+	binary.LittleEndian.PutUint16(buf[32:], uint16(m.Velew))
+	binary.LittleEndian.PutUint16(buf[34:], uint16(m.State))
+	binary.LittleEndian.PutUint16(buf[36:], m.Squawk)
+	buf[38] = byte(m.Gpsfix)
+	buf[39] = m.Numsats
+	buf[40] = byte(m.Emergencystatus)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutDynamic) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Utctime = binary.LittleEndian.Uint32(buf[0:])
+	m.Gpslat = int32(binary.LittleEndian.Uint32(buf[4:]))
+	m.Gpslon = int32(binary.LittleEndian.Uint32(buf[8:]))
+	m.Gpsalt = int32(binary.LittleEndian.Uint32(buf[12:]))
+	m.Baroaltmsl = int32(binary.LittleEndian.Uint32(buf[16:]))
+	m.Accuracyhor = binary.LittleEndian.Uint32(buf[20:])
+	m.Accuracyvert = binary.LittleEndian.Uint16(buf[24:])
+	m.Accuracyvel = binary.LittleEndian.Uint16(buf[26:])
+	m.Velvert = int16(binary.LittleEndian.Uint16(buf[28:]))
+	m.Velns = int16(binary.LittleEndian.Uint16(buf[30:]))
+	m.Velew = int16(binary.LittleEndian.Uint16(buf[32:]))
+	m.State = UAVIONIX_ADSB_OUT_DYNAMIC_STATE(binary.LittleEndian.Uint16(buf[34:]))
+	m.Squawk = binary.LittleEndian.Uint16(buf[36:])
+	m.Gpsfix = UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX(buf[38])
+	m.Numsats = buf[39]
+	m.Emergencystatus = UAVIONIX_ADSB_EMERGENCY_STATUS(buf[40])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutDynamic) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10002]
+}
@@ -2,6 +2,13 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Status message with information from UCP Heartbeat and Status messages.
 type MessageUavionixAdsbOutStatus struct {
 	// ADS-B transponder status state flags
@@ -22,3 +29,45 @@ type MessageUavionixAdsbOutStatus struct {
 func (*MessageUavionixAdsbOutStatus) GetID() uint32 {
 	return 10008
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutStatus) MAVLinkCRCExtra() byte {
+	return 240
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbOutStatus) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 14
+	}
+	return 14
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutStatus) MarshalMAVLink(buf []byte, isV2 bool) {
+	binary.LittleEndian.PutUint16(buf[0:], m.Squawk)
+	buf[2] = byte(m.State)
+	buf[3] = byte(m.NicNacp)
+	buf[4] = m.Boardtemp
+	buf[5] = byte(m.Fault)
+	copy(buf[6:14], m.FlightId)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbOutStatus) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Squawk = binary.LittleEndian.Uint16(buf[0:])
+	m.State = UAVIONIX_ADSB_OUT_STATUS_STATE(buf[2])
+	m.NicNacp = UAVIONIX_ADSB_OUT_STATUS_NIC_NACP(buf[3])
+	m.Boardtemp = buf[4]
+	m.Fault = UAVIONIX_ADSB_OUT_STATUS_FAULT(buf[5])
+	if n := bytes.IndexByte(buf[6:14], 0); n >= 0 {
+		m.FlightId = string(buf[6 : 6+n])
+	} else {
+		m.FlightId = string(buf[6:14])
+	}
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbOutStatus) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10008]
+}
@@ -2,6 +2,10 @@
 //nolint:revive,misspell,govet,lll
 package uavionix
 
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
 // Transceiver heartbeat with health report (updated every 10s)
 type MessageUavionixAdsbTransceiverHealthReport struct {
 	// ADS-B transponder messages
@@ -12,3 +16,31 @@ type MessageUavionixAdsbTransceiverHealthReport struct {
 func (*MessageUavionixAdsbTransceiverHealthReport) GetID() uint32 {
 	return 10003
 }
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (*MessageUavionixAdsbTransceiverHealthReport) MAVLinkCRCExtra() byte {
+	return 4
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (*MessageUavionixAdsbTransceiverHealthReport) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return 1
+	}
+	return 1
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbTransceiverHealthReport) MarshalMAVLink(buf []byte, isV2 bool) {
+	buf[0] = byte(m.Rfhealth)
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *MessageUavionixAdsbTransceiverHealthReport) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.Rfhealth = UAVIONIX_ADSB_RF_HEALTH(buf[0])
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (*MessageUavionixAdsbTransceiverHealthReport) MAVLinkMetadata() *message.MessageMetadata {
+	return metadata[10003]
+}
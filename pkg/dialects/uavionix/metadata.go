@@ -0,0 +1,107 @@
+//autogenerated:yes
+//nolint:revive,misspell,lll
+package uavionix
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// metadata contains metadata of messages defined in this package, as written in the XML definitions.
+var metadata = map[uint32]*message.MessageMetadata{
+	10001: {
+		ID:          10001,
+		Name:        "UAVIONIX_ADSB_OUT_CFG",
+		Description: "Static data to configure the ADS-B transponder (send within 10 sec of a POR and every 10 sec thereafter)",
+		Fields: []*message.FieldMetadata{
+			{Name: "ICAO", Type: "uint32_t", Description: "Vehicle address (24 bit)"},
+			{Name: "callsign", Type: "char", ArrayLength: 9, Description: "Vehicle identifier (8 characters, null terminated, valid characters are A-Z, 0-9, \" \" only)"},
+			{Name: "emitterType", Type: "uint8_t", Enum: "ADSB_EMITTER_TYPE", Description: "Transmitting vehicle type. See ADSB_EMITTER_TYPE enum"},
+			{Name: "aircraftSize", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_CFG_AIRCRAFT_SIZE", Description: "Aircraft length and width encoding (table 2-35 of DO-282B)"},
+			{Name: "gpsOffsetLat", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LAT", Description: "GPS antenna lateral offset (table 2-36 of DO-282B)"},
+			{Name: "gpsOffsetLon", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_CFG_GPS_OFFSET_LON", Description: "GPS antenna longitudinal offset from nose [if non-zero, take position (in meters) divide by 2 and add one] (table 2-37 DO-282B)"},
+			{Name: "stallSpeed", Type: "uint16_t", Description: "Aircraft stall speed in cm/s"},
+			{Name: "rfSelect", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_RF_SELECT", Bitmask: true, Description: "ADS-B transponder receiver and transmit enable flags"},
+		},
+	},
+	10002: {
+		ID:          10002,
+		Name:        "UAVIONIX_ADSB_OUT_DYNAMIC",
+		Description: "Dynamic data used to generate ADS-B out transponder data (send at 5Hz)",
+		Fields: []*message.FieldMetadata{
+			{Name: "utcTime", Type: "uint32_t", Description: "UTC time in seconds since GPS epoch (Jan 6, 1980). If unknown set to UINT32_MAX"},
+			{Name: "gpsLat", Type: "int32_t", Description: "Latitude WGS84 (deg * 1E7). If unknown set to INT32_MAX"},
+			{Name: "gpsLon", Type: "int32_t", Description: "Longitude WGS84 (deg * 1E7). If unknown set to INT32_MAX"},
+			{Name: "gpsAlt", Type: "int32_t", Description: "Altitude (WGS84). UP +ve. If unknown set to INT32_MAX"},
+			{Name: "gpsFix", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_DYNAMIC_GPS_FIX", Description: "0-1: no fix, 2: 2D fix, 3: 3D fix, 4: DGPS, 5: RTK"},
+			{Name: "numSats", Type: "uint8_t", Description: "Number of satellites visible. If unknown set to UINT8_MAX"},
+			{Name: "baroAltMSL", Type: "int32_t", Description: "Barometric pressure altitude (MSL) relative to a standard atmosphere of 1013.2 mBar and NOT bar corrected altitude (m * 1E-3). (up +ve). If unknown set to INT32_MAX"},
+			{Name: "accuracyHor", Type: "uint32_t", Description: "Horizontal accuracy in mm (m * 1E-3). If unknown set to UINT32_MAX"},
+			{Name: "accuracyVert", Type: "uint16_t", Description: "Vertical accuracy in cm. If unknown set to UINT16_MAX"},
+			{Name: "accuracyVel", Type: "uint16_t", Description: "Velocity accuracy in mm/s (m * 1E-3). If unknown set to UINT16_MAX"},
+			{Name: "velVert", Type: "int16_t", Description: "GPS vertical speed in cm/s. If unknown set to INT16_MAX"},
+			{Name: "velNS", Type: "int16_t", Description: "North-South velocity over ground in cm/s North +ve. If unknown set to INT16_MAX"},
+			{Name: "VelEW", Type: "int16_t", Description: "East-West velocity over ground in cm/s East +ve. If unknown set to INT16_MAX"},
+			{Name: "emergencyStatus", Type: "uint8_t", Enum: "UAVIONIX_ADSB_EMERGENCY_STATUS", Description: "Emergency status"},
+			{Name: "state", Type: "uint16_t", Enum: "UAVIONIX_ADSB_OUT_DYNAMIC_STATE", Bitmask: true, Description: "ADS-B transponder dynamic input state flags"},
+			{Name: "squawk", Type: "uint16_t", Description: "Mode A code (typically 1200 [0x04B0] for VFR)"},
+		},
+	},
+	10003: {
+		ID:          10003,
+		Name:        "UAVIONIX_ADSB_TRANSCEIVER_HEALTH_REPORT",
+		Description: "Transceiver heartbeat with health report (updated every 10s)",
+		Fields: []*message.FieldMetadata{
+			{Name: "rfHealth", Type: "uint8_t", Enum: "UAVIONIX_ADSB_RF_HEALTH", Bitmask: true, Description: "ADS-B transponder messages"},
+		},
+	},
+	10004: {
+		ID:          10004,
+		Name:        "UAVIONIX_ADSB_OUT_CFG_REGISTRATION",
+		Description: "Aircraft Registration.",
+		Fields: []*message.FieldMetadata{
+			{Name: "registration", Type: "char", ArrayLength: 9, Description: "Aircraft Registration (ASCII string A-Z, 0-9 only), e.g. \"N8644B \". Trailing spaces (0x20) only. This is null-terminated."},
+		},
+	},
+	10005: {
+		ID:          10005,
+		Name:        "UAVIONIX_ADSB_OUT_CFG_FLIGHTID",
+		Description: "Flight Identification for ADSB-Out vehicles.",
+		Fields: []*message.FieldMetadata{
+			{Name: "flight_id", Type: "char", ArrayLength: 9, Description: "Flight Identification: 8 ASCII characters, '0' through '9', 'A' through 'Z' or space. Spaces (0x20) used as a trailing pad character, or when call sign is unavailable. Reflects Control message setting. This is null-terminated."},
+		},
+	},
+	10006: {
+		ID:          10006,
+		Name:        "UAVIONIX_ADSB_GET",
+		Description: "Request messages.",
+		Fields: []*message.FieldMetadata{
+			{Name: "ReqMessageId", Type: "uint32_t", Description: "Message ID to request. Supports any message in this 10000-10099 range"},
+		},
+	},
+	10007: {
+		ID:          10007,
+		Name:        "UAVIONIX_ADSB_OUT_CONTROL",
+		Description: "Control message with all data sent in UCP control message.",
+		Fields: []*message.FieldMetadata{
+			{Name: "state", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_CONTROL_STATE", Bitmask: true, Description: "ADS-B transponder control state flags"},
+			{Name: "baroAltMSL", Type: "int32_t", Description: "Barometric pressure altitude (MSL) relative to a standard atmosphere of 1013.2 mBar and NOT bar corrected altitude (m * 1E-3). (up +ve). If unknown set to INT32_MAX"},
+			{Name: "squawk", Type: "uint16_t", Description: "Mode A code (typically 1200 [0x04B0] for VFR)"},
+			{Name: "emergencyStatus", Type: "uint8_t", Enum: "UAVIONIX_ADSB_EMERGENCY_STATUS", Description: "Emergency status"},
+			{Name: "flight_id", Type: "char", ArrayLength: 8, Description: "Flight Identification: 8 ASCII characters, '0' through '9', 'A' through 'Z' or space. Spaces (0x20) used as a trailing pad character, or when call sign is unavailable."},
+			{Name: "x_bit", Type: "uint8_t", Enum: "UAVIONIX_ADSB_XBIT", Bitmask: true, Description: "X-Bit enable (military transponders only)"},
+		},
+	},
+	10008: {
+		ID:          10008,
+		Name:        "UAVIONIX_ADSB_OUT_STATUS",
+		Description: "Status message with information from UCP Heartbeat and Status messages.",
+		Fields: []*message.FieldMetadata{
+			{Name: "state", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_STATUS_STATE", Bitmask: true, Description: "ADS-B transponder status state flags"},
+			{Name: "squawk", Type: "uint16_t", Description: "Mode A code (typically 1200 [0x04B0] for VFR)"},
+			{Name: "NIC_NACp", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_STATUS_NIC_NACP", Description: "Integrity and Accuracy of traffic reported as a 4-bit value for each field (NACp 7:4, NIC 3:0) and encoded by Containment Radius (HPL) and Estimated Position Uncertainty (HFOM), respectively"},
+			{Name: "boardTemp", Type: "uint8_t", Description: "Board temperature in C"},
+			{Name: "fault", Type: "uint8_t", Enum: "UAVIONIX_ADSB_OUT_STATUS_FAULT", Bitmask: true, Description: "ADS-B transponder fault flags"},
+			{Name: "flight_id", Type: "char", ArrayLength: 8, Description: "Flight Identification: 8 ASCII characters, '0' through '9', 'A' through 'Z' or space. Spaces (0x20) used as a trailing pad character, or when call sign is unavailable."},
+		},
+	},
+}
@@ -0,0 +1,314 @@
+package dynamic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+	"github.com/bluenviron/gomavlib/v4/pkg/x25"
+)
+
+var typeSizes = map[string]int{
+	"double":   8,
+	"uint64_t": 8,
+	"int64_t":  8,
+	"float":    4,
+	"uint32_t": 4,
+	"int32_t":  4,
+	"uint16_t": 2,
+	"int16_t":  2,
+	"uint8_t":  1,
+	"int8_t":   1,
+	"char":     1,
+}
+
+// EnumEntry is an entry of an enum.
+type EnumEntry struct {
+	// name of the entry.
+	Name string
+
+	// value of the entry.
+	Value uint64
+}
+
+// EnumDefinition is the definition of an enum.
+type EnumDefinition struct {
+	// name of the enum.
+	Name string
+
+	// whether the enum is a bitmask.
+	Bitmask bool
+
+	// entries of the enum.
+	Entries []*EnumEntry
+}
+
+// Label returns the label of a value.
+// In case of bitmasks, labels of enabled bits are joined with " | ".
+// Values and bits that are not part of the enum are returned as numbers.
+func (e *EnumDefinition) Label(v uint64) string {
+	if e.Bitmask {
+		if v == 0 {
+			return "0"
+		}
+
+		var names []string
+		rest := v
+		for _, entry := range e.Entries {
+			if entry.Value != 0 && v&entry.Value == entry.Value {
+				names = append(names, entry.Name)
+				rest &^= entry.Value
+			}
+		}
+		if rest != 0 {
+			names = append(names, strconv.FormatUint(rest, 10))
+		}
+		return strings.Join(names, " | ")
+	}
+
+	for _, entry := range e.Entries {
+		if entry.Value == v {
+			return entry.Name
+		}
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+// Value returns the value of a label.
+// It is the inverse of Label.
+func (e *EnumDefinition) Value(label string) (uint64, error) {
+	if e.Bitmask {
+		var mask uint64
+		for _, l := range strings.Split(label, " | ") {
+			v, err := e.singleValue(l)
+			if err != nil {
+				return 0, err
+			}
+			mask |= v
+		}
+		return mask, nil
+	}
+
+	return e.singleValue(label)
+}
+
+func (e *EnumDefinition) singleValue(label string) (uint64, error) {
+	for _, entry := range e.Entries {
+		if entry.Name == label {
+			return entry.Value, nil
+		}
+	}
+
+	if v, err := strconv.ParseUint(label, 10, 64); err == nil {
+		return v, nil
+	}
+
+	return 0, fmt.Errorf("invalid label '%s'", label)
+}
+
+// FieldDefinition is the definition of a message field.
+type FieldDefinition struct {
+	// name of the field, as written in the XML definition.
+	Name string
+
+	// type of the field, as written in the XML definition, without array length
+	// (i.e. "uint8_t", "float", "char").
+	Type string
+
+	// length of the array, or of the string in case of char fields.
+	// It is zero for scalar fields.
+	ArrayLength int
+
+	// (optional) enum of the field.
+	Enum *EnumDefinition
+
+	// whether the field is an extension.
+	Extension bool
+
+	index int
+}
+
+func (f *FieldDefinition) length() int {
+	// a single char is handled like a string of length 1,
+	// consistently with message.ReadWriter
+	if f.Type == "char" && f.ArrayLength == 0 {
+		return 1
+	}
+	return f.ArrayLength
+}
+
+func (f *FieldDefinition) size() int {
+	if l := f.length(); l > 0 {
+		return typeSizes[f.Type] * l
+	}
+	return typeSizes[f.Type]
+}
+
+// MessageDefinition is the definition of a message.
+type MessageDefinition struct {
+	// ID of the message.
+	ID uint32
+
+	// name of the message, as written in the XML definition.
+	Name string
+
+	// fields of the message, in the order of the XML definition.
+	Fields []*FieldDefinition
+
+	// (optional) metadata of the message.
+	// If not provided, it is filled with names, types and enums of fields.
+	Metadata *message.MessageMetadata
+
+	wireFields    []*FieldDefinition
+	messageFields []*message.Field
+	fieldsByName  map[string]*FieldDefinition
+	crcExtra      byte
+	sizeNormal    int
+	sizeExtended  int
+	hasStrings    bool
+}
+
+// Initialize initializes a MessageDefinition.
+func (d *MessageDefinition) Initialize() error {
+	d.fieldsByName = make(map[string]*FieldDefinition)
+	d.sizeNormal = 0
+	d.sizeExtended = 0
+	d.hasStrings = false
+
+	for i, f := range d.Fields {
+		if _, ok := typeSizes[f.Type]; !ok {
+			return fmt.Errorf("field '%s': unsupported type: %s", f.Name, f.Type)
+		}
+
+		if f.Enum != nil {
+			switch f.Type {
+			case "uint8_t", "int8_t", "uint16_t", "uint32_t", "int32_t", "uint64_t":
+
+			default:
+				return fmt.Errorf("field '%s': type '%s' cannot be used as enum", f.Name, f.Type)
+			}
+		}
+
+		if f.ArrayLength < 0 || f.ArrayLength > 255 {
+			return fmt.Errorf("field '%s': invalid array length: %d", f.Name, f.ArrayLength)
+		}
+
+		if _, ok := d.fieldsByName[f.Name]; ok {
+			return fmt.Errorf("duplicate field '%s'", f.Name)
+		}
+
+		f.index = i
+		d.fieldsByName[f.Name] = f
+
+		d.sizeExtended += f.size()
+		if !f.Extension {
+			d.sizeNormal += f.size()
+		}
+
+		if f.Type == "char" {
+			d.hasStrings = true
+		}
+	}
+
+	if d.sizeExtended > 255 {
+		return fmt.Errorf("message is too big (%d bytes)", d.sizeExtended)
+	}
+
+	// reorder fields as described in
+	// https://mavlink.io/en/guide/serialization.html#field_reordering
+	d.wireFields = make([]*FieldDefinition, len(d.Fields))
+	copy(d.wireFields, d.Fields)
+	sort.SliceStable(d.wireFields, func(i, j int) bool {
+		if !d.wireFields[i].Extension && !d.wireFields[j].Extension {
+			return typeSizes[d.wireFields[i].Type] > typeSizes[d.wireFields[j].Type]
+		}
+		return !d.wireFields[i].Extension && d.wireFields[j].Extension
+	})
+
+	d.messageFields = make([]*message.Field, len(d.wireFields))
+	for i, f := range d.wireFields {
+		d.messageFields[i] = &message.Field{
+			Name:        f.Name,
+			Type:        f.Type,
+			ArrayLength: f.length(),
+			IsEnum:      f.Enum != nil,
+			IsExtension: f.Extension,
+		}
+	}
+
+	if d.Metadata == nil {
+		d.Metadata = &message.MessageMetadata{
+			ID:   d.ID,
+			Name: d.Name,
+		}
+		for _, f := range d.Fields {
+			fm := &message.FieldMetadata{
+				Name:        f.Name,
+				Type:        f.Type,
+				ArrayLength: f.length(),
+				Extension:   f.Extension,
+			}
+			if f.Enum != nil {
+				fm.Enum = f.Enum.Name
+				fm.Bitmask = f.Enum.Bitmask
+			}
+			d.Metadata.Fields = append(d.Metadata.Fields, fm)
+		}
+	}
+
+	// generate CRC extra
+	// https://mavlink.io/en/guide/serialization.html#crc_extra
+	h := x25.New()
+	h.Write([]byte(d.Name + " "))
+
+	for _, f := range d.wireFields {
+		// skip extensions
+		if f.Extension {
+			continue
+		}
+
+		h.Write([]byte(f.Type + " "))
+		h.Write([]byte(f.Name + " "))
+
+		if l := f.length(); l > 0 {
+			h.Write([]byte{byte(l)})
+		}
+	}
+
+	sum := h.Sum16()
+	d.crcExtra = byte((sum & 0xFF) ^ (sum >> 8))
+
+	return nil
+}
+
+// CRCExtra returns the CRC extra of the message.
+func (d *MessageDefinition) CRCExtra() byte {
+	return d.crcExtra
+}
+
+// Field returns the definition of a field.
+func (d *MessageDefinition) Field(name string) *FieldDefinition {
+	return d.fieldsByName[name]
+}
+
+// WireFields returns fields in the order in which they are encoded.
+func (d *MessageDefinition) WireFields() []*FieldDefinition {
+	return d.wireFields
+}
+
+// HasStrings returns whether the message contains string fields.
+func (d *MessageDefinition) HasStrings() bool {
+	return d.hasStrings
+}
+
+// NewMessage allocates a message with this definition, whose fields are set to zero.
+func (d *MessageDefinition) NewMessage() *Message {
+	m := &Message{
+		def:    d,
+		values: make([]any, len(d.Fields)),
+	}
+	m.reset()
+	return m
+}
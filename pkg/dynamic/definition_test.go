@@ -0,0 +1,104 @@
+package dynamic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+func TestEnumDefinitionLabel(t *testing.T) {
+	require.Equal(t, "MAV_SEVERITY_ALERT", testEnumSeverity.Label(1))
+	require.Equal(t, "15", testEnumSeverity.Label(15))
+	require.Equal(t, "0", testEnumModeFlag.Label(0))
+	require.Equal(t, "MAV_MODE_FLAG_SAFETY_ARMED | MAV_MODE_FLAG_HIL_ENABLED", testEnumModeFlag.Label(160))
+	require.Equal(t, "MAV_MODE_FLAG_SAFETY_ARMED | 3", testEnumModeFlag.Label(131))
+
+	enumWithZero := &dynamic.EnumDefinition{
+		Name:    "TEST_FLAGS",
+		Bitmask: true,
+		Entries: []*dynamic.EnumEntry{
+			{Name: "TEST_FLAGS_NONE", Value: 0},
+			{Name: "TEST_FLAGS_A", Value: 1},
+		},
+	}
+	require.Equal(t, "TEST_FLAGS_A", enumWithZero.Label(1))
+
+	v, err := testEnumSeverity.Value("MAV_SEVERITY_CRITICAL")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+
+	v, err = testEnumSeverity.Value("15")
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), v)
+
+	v, err = testEnumModeFlag.Value("MAV_MODE_FLAG_SAFETY_ARMED | MAV_MODE_FLAG_HIL_ENABLED")
+	require.NoError(t, err)
+	require.Equal(t, uint64(160), v)
+
+	v, err = testEnumModeFlag.Value(testEnumModeFlag.Label(131))
+	require.NoError(t, err)
+	require.Equal(t, uint64(131), v)
+
+	_, err = testEnumSeverity.Value("MISSING")
+	require.EqualError(t, err, "invalid label 'MISSING'")
+}
+
+func TestMessageDefinition(t *testing.T) {
+	def := testDefHeartbeat()
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	require.Equal(t, byte(50), def.CRCExtra())
+	require.Equal(t, "custom_mode", def.WireFields()[0].Name)
+	require.Equal(t, "uint32_t", def.Field("custom_mode").Type)
+	require.Nil(t, def.Field("missing"))
+	require.Equal(t, false, def.HasStrings())
+
+	require.Equal(t, &message.FieldMetadata{
+		Name:    "base_mode",
+		Type:    "uint8_t",
+		Enum:    "MAV_MODE_FLAG",
+		Bitmask: true,
+	}, def.Metadata.Field("base_mode"))
+}
+
+func TestMessageDefinitionErrors(t *testing.T) {
+	for _, ca := range []struct {
+		name   string
+		fields []*dynamic.FieldDefinition
+		err    string
+	}{
+		{
+			"unsupported type",
+			[]*dynamic.FieldDefinition{{Name: "a", Type: "uint128_t"}},
+			"field 'a': unsupported type: uint128_t",
+		},
+		{
+			"invalid enum",
+			[]*dynamic.FieldDefinition{{Name: "a", Type: "float", Enum: &dynamic.EnumDefinition{}}},
+			"field 'a': type 'float' cannot be used as enum",
+		},
+		{
+			"duplicate field",
+			[]*dynamic.FieldDefinition{{Name: "a", Type: "uint8_t"}, {Name: "a", Type: "uint8_t"}},
+			"duplicate field 'a'",
+		},
+		{
+			"too big",
+			[]*dynamic.FieldDefinition{{Name: "a", Type: "double", ArrayLength: 40}},
+			"message is too big (320 bytes)",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			def := &dynamic.MessageDefinition{
+				Name:   "TEST",
+				Fields: ca.fields,
+			}
+			err := def.Initialize()
+			require.EqualError(t, err, ca.err)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package dynamic contains messages whose definition is known at runtime only.
+package dynamic
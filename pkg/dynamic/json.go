@@ -0,0 +1,160 @@
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+func encodeJSONValue(buf *bytes.Buffer, f *FieldDefinition, v any) {
+	if f.Enum != nil {
+		enc, _ := json.Marshal(f.Enum.Label(v.(uint64)))
+		buf.Write(enc)
+		return
+	}
+
+	switch tv := v.(type) {
+	case float32:
+		buf.Write(message.EncodeJSONFloat(float64(tv), 32))
+
+	case float64:
+		buf.Write(message.EncodeJSONFloat(tv, 64))
+
+	default:
+		fmt.Fprint(buf, v)
+	}
+}
+
+func decodeJSONValue(f *FieldDefinition, raw json.RawMessage) (reflect.Value, error) {
+	typ := scalarType(f)
+
+	if f.Enum != nil {
+		var label string
+		if json.Unmarshal(raw, &label) == nil {
+			v, err := f.Enum.Value(label)
+			return reflect.ValueOf(v), err
+		}
+	}
+
+	switch typ.Kind() {
+	case reflect.Float32, reflect.Float64:
+		v, err := message.DecodeJSONFloat(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(v).Convert(typ), nil
+	}
+
+	v := reflect.New(typ)
+	err := json.Unmarshal(raw, v.Interface())
+	return v.Elem(), err
+}
+
+// MarshalJSON implements json.Marshaler.
+// Keys are the field names of the XML definition, in the same order,
+// enums are encoded into their labels and NaN or infinite floats are encoded as strings.
+func (m *Message) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, f := range m.def.Fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+
+		enc, _ := json.Marshal(f.Name)
+		buf.Write(enc)
+		buf.WriteByte(':')
+
+		v := m.values[f.index]
+
+		switch {
+		case f.Type == "char":
+			enc, _ = json.Marshal(v.(string))
+			buf.Write(enc)
+
+		case f.ArrayLength > 0:
+			rv := reflect.ValueOf(v)
+			buf.WriteByte('[')
+			for j := range rv.Len() {
+				if j != 0 {
+					buf.WriteByte(',')
+				}
+				encodeJSONValue(&buf, f, rv.Index(j).Interface())
+			}
+			buf.WriteByte(']')
+
+		default:
+			encodeJSONValue(&buf, f, v)
+		}
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Enums can be provided as labels or as numbers.
+// Fields that are not provided are set to zero.
+func (m *Message) UnmarshalJSON(buf []byte) error {
+	var raws map[string]json.RawMessage
+	err := json.Unmarshal(buf, &raws)
+	if err != nil {
+		return err
+	}
+
+	m.reset()
+
+	for name, raw := range raws {
+		f, ok := m.def.fieldsByName[name]
+		if !ok {
+			return fmt.Errorf("field '%s' does not exist", name)
+		}
+
+		switch {
+		case f.Type == "char":
+			var s string
+			err = json.Unmarshal(raw, &s)
+			if err != nil {
+				return fmt.Errorf("field '%s': %w", name, err)
+			}
+			if len(s) > f.length() {
+				return fmt.Errorf("field '%s': string is too long (%d > %d)", name, len(s), f.length())
+			}
+			m.values[f.index] = s
+
+		case f.ArrayLength > 0:
+			var raws2 []json.RawMessage
+			err = json.Unmarshal(raw, &raws2)
+			if err != nil {
+				return fmt.Errorf("field '%s': %w", name, err)
+			}
+
+			if len(raws2) > f.ArrayLength {
+				return fmt.Errorf("field '%s': array is too long (%d > %d)", name, len(raws2), f.ArrayLength)
+			}
+
+			arr := reflect.ValueOf(m.values[f.index])
+			for j, raw2 := range raws2 {
+				var v reflect.Value
+				v, err = decodeJSONValue(f, raw2)
+				if err != nil {
+					return fmt.Errorf("field '%s': %w", name, err)
+				}
+				arr.Index(j).Set(v)
+			}
+
+		default:
+			v, err := decodeJSONValue(f, raw)
+			if err != nil {
+				return fmt.Errorf("field '%s': %w", name, err)
+			}
+			m.values[f.index] = v.Interface()
+		}
+	}
+
+	return nil
+}
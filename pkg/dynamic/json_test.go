@@ -0,0 +1,74 @@
+package dynamic_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+func TestMessageJSON(t *testing.T) {
+	def := testDefStatustext()
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	m := def.NewMessage()
+	require.NoError(t, m.Set("severity", 2))
+	require.NoError(t, m.Set("text", "hello"))
+	require.NoError(t, m.Set("id", 12))
+
+	enc, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"severity":"MAV_SEVERITY_CRITICAL","text":"hello","id":12,"chunk_seq":0}`, string(enc))
+
+	// output is the same of generated messages
+	genRW := &message.ReadWriter{Message: &common.MessageStatustext{}}
+	err = genRW.Initialize()
+	require.NoError(t, err)
+
+	genEnc, err := genRW.EncodeJSON(&common.MessageStatustext{
+		Severity: common.MAV_SEVERITY_CRITICAL,
+		Text:     "hello",
+		Id:       12,
+	})
+	require.NoError(t, err)
+	require.Equal(t, string(genEnc), string(enc))
+
+	m2 := def.NewMessage()
+	err = json.Unmarshal(enc, m2)
+	require.NoError(t, err)
+	require.Equal(t, m, m2)
+}
+
+func TestMessageJSONArrays(t *testing.T) {
+	def := testDefHilActuatorControls()
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	rw := &message.ReadWriter{Message: def.NewMessage()}
+	err = rw.Initialize()
+	require.NoError(t, err)
+
+	m := def.NewMessage()
+	require.NoError(t, m.Set("controls", []float64{0.5, math.NaN(), math.Inf(1)}))
+	require.NoError(t, m.Set("mode", 160))
+
+	enc, err := rw.EncodeJSON(m)
+	require.NoError(t, err)
+	require.Equal(t, `{"time_usec":0,"controls":[0.5,"NaN","+Inf",0,0,0,0,0,0,0,0,0,0,0,0,0],`+
+		`"mode":"MAV_MODE_FLAG_SAFETY_ARMED | MAV_MODE_FLAG_HIL_ENABLED","flags":"0"}`, string(enc))
+
+	dec, err := rw.DecodeJSON(enc)
+	require.NoError(t, err)
+	require.Equal(t, m.String(), dec.(interface{ String() string }).String())
+
+	_, err = rw.DecodeJSON([]byte(`{"controls":[1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17]}`))
+	require.EqualError(t, err, "field 'controls': array is too long (17 > 16)")
+
+	_, err = rw.DecodeJSON([]byte(`{"mode":"MISSING"}`))
+	require.EqualError(t, err, "field 'mode': invalid label 'MISSING'")
+}
@@ -0,0 +1,414 @@
+package dynamic
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"iter"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+var scalarTypes = map[string]reflect.Type{
+	"double":   reflect.TypeFor[float64](),
+	"uint64_t": reflect.TypeFor[uint64](),
+	"int64_t":  reflect.TypeFor[int64](),
+	"float":    reflect.TypeFor[float32](),
+	"uint32_t": reflect.TypeFor[uint32](),
+	"int32_t":  reflect.TypeFor[int32](),
+	"uint16_t": reflect.TypeFor[uint16](),
+	"int16_t":  reflect.TypeFor[int16](),
+	"uint8_t":  reflect.TypeFor[uint8](),
+	"int8_t":   reflect.TypeFor[int8](),
+}
+
+func scalarType(f *FieldDefinition) reflect.Type {
+	// enums are uint64, like in generated dialects
+	if f.Enum != nil {
+		return reflect.TypeFor[uint64]()
+	}
+	return scalarTypes[f.Type]
+}
+
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// valueToBits returns the binary representation of a scalar value,
+// or of the i-th element of an array value.
+func valueToBits(v any, i int) uint64 {
+	switch tv := v.(type) {
+	case uint8:
+		return uint64(tv)
+	case int8:
+		return uint64(tv)
+	case uint16:
+		return uint64(tv)
+	case int16:
+		return uint64(tv)
+	case uint32:
+		return uint64(tv)
+	case int32:
+		return uint64(tv)
+	case uint64:
+		return tv
+	case int64:
+		return uint64(tv)
+	case float32:
+		return uint64(math.Float32bits(tv))
+	case float64:
+		return math.Float64bits(tv)
+	case []uint8:
+		return uint64(tv[i])
+	case []int8:
+		return uint64(tv[i])
+	case []uint16:
+		return uint64(tv[i])
+	case []int16:
+		return uint64(tv[i])
+	case []uint32:
+		return uint64(tv[i])
+	case []int32:
+		return uint64(tv[i])
+	case []uint64:
+		return tv[i]
+	case []int64:
+		return uint64(tv[i])
+	case []float32:
+		return uint64(math.Float32bits(tv[i]))
+	case []float64:
+		return math.Float64bits(tv[i])
+	}
+	return 0
+}
+
+// bitsToValue converts a binary representation into a scalar value.
+func bitsToValue(f *FieldDefinition, bits uint64) any {
+	if f.Enum != nil {
+		return bits
+	}
+
+	switch f.Type {
+	case "uint8_t":
+		return uint8(bits)
+	case "int8_t":
+		return int8(bits)
+	case "uint16_t":
+		return uint16(bits)
+	case "int16_t":
+		return int16(bits)
+	case "uint32_t":
+		return uint32(bits)
+	case "int32_t":
+		return int32(bits)
+	case "uint64_t":
+		return bits
+	case "int64_t":
+		return int64(bits)
+	case "float":
+		return math.Float32frombits(uint32(bits))
+	default: // double
+		return math.Float64frombits(bits)
+	}
+}
+
+// setArrayBits sets the i-th element of an array value from its binary representation.
+func setArrayBits(v any, i int, bits uint64) {
+	switch tv := v.(type) {
+	case []uint8:
+		tv[i] = uint8(bits)
+	case []int8:
+		tv[i] = int8(bits)
+	case []uint16:
+		tv[i] = uint16(bits)
+	case []int16:
+		tv[i] = int16(bits)
+	case []uint32:
+		tv[i] = uint32(bits)
+	case []int32:
+		tv[i] = int32(bits)
+	case []uint64:
+		tv[i] = bits
+	case []int64:
+		tv[i] = int64(bits)
+	case []float32:
+		tv[i] = math.Float32frombits(uint32(bits))
+	case []float64:
+		tv[i] = math.Float64frombits(bits)
+	}
+}
+
+func putBits(buf []byte, size int, bits uint64) {
+	switch size {
+	case 1:
+		buf[0] = byte(bits)
+	case 2:
+		binary.LittleEndian.PutUint16(buf, uint16(bits))
+	case 4:
+		binary.LittleEndian.PutUint32(buf, uint32(bits))
+	default:
+		binary.LittleEndian.PutUint64(buf, bits)
+	}
+}
+
+func getBits(buf []byte, size int) uint64 {
+	switch size {
+	case 1:
+		return uint64(buf[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(buf))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(buf))
+	default:
+		return binary.LittleEndian.Uint64(buf)
+	}
+}
+
+// Message is a message whose definition is known at runtime only.
+// Values of fields are stored with the following Go types:
+//   - char fields are strings;
+//   - enum fields are uint64, like in generated dialects;
+//   - other fields have the Go type that corresponds to the MAVLink type (i.e. uint16_t is uint16);
+//   - arrays are slices of the corresponding Go type.
+type Message struct {
+	def    *MessageDefinition
+	values []any
+}
+
+// GetID implements the message.Message interface.
+func (m *Message) GetID() uint32 {
+	return m.def.ID
+}
+
+// Definition returns the definition of the message.
+func (m *Message) Definition() *MessageDefinition {
+	return m.def
+}
+
+// Get returns the value of a field.
+// Slices are shared with the message and must not be modified.
+func (m *Message) Get(name string) (any, bool) {
+	f, ok := m.def.fieldsByName[name]
+	if !ok {
+		return nil, false
+	}
+	return m.values[f.index], true
+}
+
+// Set sets the value of a field.
+// Numeric values are converted into the type of the field.
+// Arrays can be set with slices or arrays that are not longer than the field.
+func (m *Message) Set(name string, v any) error {
+	f, ok := m.def.fieldsByName[name]
+	if !ok {
+		return fmt.Errorf("field '%s' does not exist", name)
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch {
+	case f.Type == "char":
+		if rv.Kind() != reflect.String {
+			return fmt.Errorf("field '%s': expected a string, got %T", name, v)
+		}
+		m.values[f.index] = rv.String()
+
+	case f.ArrayLength > 0:
+		if (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) || !isNumeric(rv.Type().Elem().Kind()) {
+			return fmt.Errorf("field '%s': expected a numeric slice, got %T", name, v)
+		}
+
+		if rv.Len() > f.ArrayLength {
+			return fmt.Errorf("field '%s': array is too long (%d > %d)", name, rv.Len(), f.ArrayLength)
+		}
+
+		typ := scalarType(f)
+		arr := reflect.MakeSlice(reflect.SliceOf(typ), f.ArrayLength, f.ArrayLength)
+		for i := range rv.Len() {
+			arr.Index(i).Set(rv.Index(i).Convert(typ))
+		}
+		m.values[f.index] = arr.Interface()
+
+	default:
+		if !isNumeric(rv.Kind()) {
+			return fmt.Errorf("field '%s': expected a number, got %T", name, v)
+		}
+		m.values[f.index] = rv.Convert(scalarType(f)).Interface()
+	}
+
+	return nil
+}
+
+// All returns an iterator over fields and their values, in wire order.
+func (m *Message) All() iter.Seq2[*FieldDefinition, any] {
+	return func(yield func(*FieldDefinition, any) bool) {
+		for _, f := range m.def.wireFields {
+			if !yield(f, m.values[f.index]) {
+				return
+			}
+		}
+	}
+}
+
+// String implements fmt.Stringer.
+// Enum values are printed with their labels.
+func (m *Message) String() string {
+	var b strings.Builder
+	b.WriteString(m.def.Name + "{")
+
+	for i, f := range m.def.Fields {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(f.Name + ": ")
+
+		v := m.values[f.index]
+
+		switch {
+		case f.Type == "char":
+			b.WriteString(strconv.Quote(v.(string)))
+
+		case f.Enum != nil && f.ArrayLength > 0:
+			labels := make([]string, f.ArrayLength)
+			for j := range labels {
+				labels[j] = f.Enum.Label(v.([]uint64)[j])
+			}
+			b.WriteString("[" + strings.Join(labels, " ") + "]")
+
+		case f.Enum != nil:
+			b.WriteString(f.Enum.Label(v.(uint64)))
+
+		default:
+			fmt.Fprint(&b, v)
+		}
+	}
+
+	b.WriteString("}")
+	return b.String()
+}
+
+// MAVLinkName implements the message.Dynamic interface.
+func (m *Message) MAVLinkName() string {
+	return m.def.Name
+}
+
+// MAVLinkFields implements the message.Dynamic interface.
+func (m *Message) MAVLinkFields() []*message.Field {
+	return m.def.messageFields
+}
+
+// NewMessage implements the message.Dynamic interface.
+func (m *Message) NewMessage() message.Message {
+	return m.def.NewMessage()
+}
+
+// MAVLinkMetadata implements the message.MetadataProvider interface.
+func (m *Message) MAVLinkMetadata() *message.MessageMetadata {
+	return m.def.Metadata
+}
+
+// MAVLinkCRCExtra implements the message.Marshaler interface.
+func (m *Message) MAVLinkCRCExtra() byte {
+	return m.def.crcExtra
+}
+
+// MAVLinkSize implements the message.Marshaler interface.
+func (m *Message) MAVLinkSize(isV2 bool) int {
+	if isV2 {
+		return m.def.sizeExtended
+	}
+	return m.def.sizeNormal
+}
+
+// MarshalMAVLink implements the message.Marshaler interface.
+func (m *Message) MarshalMAVLink(buf []byte, isV2 bool) {
+	for _, f := range m.def.wireFields {
+		// skip extensions in V1 frames
+		if !isV2 && f.Extension {
+			return
+		}
+
+		v := m.values[f.index]
+		size := typeSizes[f.Type]
+
+		switch {
+		case f.Type == "char":
+			copy(buf[:f.length()], v.(string))
+
+		case f.ArrayLength > 0:
+			for i := range f.ArrayLength {
+				putBits(buf[i*size:], size, valueToBits(v, i))
+			}
+
+		default:
+			putBits(buf, size, valueToBits(v, 0))
+		}
+
+		buf = buf[f.size():]
+	}
+}
+
+// UnmarshalMAVLink implements the message.Marshaler interface.
+func (m *Message) UnmarshalMAVLink(buf []byte, isV2 bool) {
+	m.reset()
+
+	for _, f := range m.def.wireFields {
+		// skip extensions in V1 frames
+		if !isV2 && f.Extension {
+			return
+		}
+
+		size := typeSizes[f.Type]
+
+		switch {
+		case f.Type == "char":
+			str := buf[:f.length()]
+			if n := bytes.IndexByte(str, 0); n >= 0 {
+				str = str[:n]
+			}
+			m.values[f.index] = string(str)
+
+		case f.ArrayLength > 0:
+			v := m.values[f.index]
+			for i := range f.ArrayLength {
+				setArrayBits(v, i, getBits(buf[i*size:], size))
+			}
+
+		default:
+			m.values[f.index] = bitsToValue(f, getBits(buf, size))
+		}
+
+		buf = buf[f.size():]
+	}
+}
+
+// reset sets all fields to zero, reusing existing arrays.
+func (m *Message) reset() {
+	for i, f := range m.def.Fields {
+		switch {
+		case f.Type == "char":
+			m.values[i] = ""
+
+		case f.ArrayLength > 0:
+			if arr := reflect.ValueOf(m.values[i]); arr.IsValid() && arr.Len() == f.ArrayLength {
+				arr.Clear()
+				continue
+			}
+			typ := scalarType(f)
+			m.values[i] = reflect.MakeSlice(reflect.SliceOf(typ), f.ArrayLength, f.ArrayLength).Interface()
+
+		default:
+			m.values[i] = reflect.Zero(scalarType(f)).Interface()
+		}
+	}
+}
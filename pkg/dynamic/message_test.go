@@ -0,0 +1,254 @@
+package dynamic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+var testEnumModeFlag = &dynamic.EnumDefinition{
+	Name:    "MAV_MODE_FLAG",
+	Bitmask: true,
+	Entries: []*dynamic.EnumEntry{
+		{Name: "MAV_MODE_FLAG_SAFETY_ARMED", Value: 128},
+		{Name: "MAV_MODE_FLAG_MANUAL_INPUT_ENABLED", Value: 64},
+		{Name: "MAV_MODE_FLAG_HIL_ENABLED", Value: 32},
+	},
+}
+
+var testEnumSeverity = &dynamic.EnumDefinition{
+	Name: "MAV_SEVERITY",
+	Entries: []*dynamic.EnumEntry{
+		{Name: "MAV_SEVERITY_EMERGENCY", Value: 0},
+		{Name: "MAV_SEVERITY_ALERT", Value: 1},
+		{Name: "MAV_SEVERITY_CRITICAL", Value: 2},
+	},
+}
+
+func testDefHeartbeat() *dynamic.MessageDefinition {
+	return &dynamic.MessageDefinition{
+		ID:   0,
+		Name: "HEARTBEAT",
+		Fields: []*dynamic.FieldDefinition{
+			{Name: "type", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_TYPE"}},
+			{Name: "autopilot", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_AUTOPILOT"}},
+			{Name: "base_mode", Type: "uint8_t", Enum: testEnumModeFlag},
+			{Name: "custom_mode", Type: "uint32_t"},
+			{Name: "system_status", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "MAV_STATE"}},
+			{Name: "mavlink_version", Type: "uint8_t"},
+		},
+	}
+}
+
+func testDefStatustext() *dynamic.MessageDefinition {
+	return &dynamic.MessageDefinition{
+		ID:   253,
+		Name: "STATUSTEXT",
+		Fields: []*dynamic.FieldDefinition{
+			{Name: "severity", Type: "uint8_t", Enum: testEnumSeverity},
+			{Name: "text", Type: "char", ArrayLength: 50},
+			{Name: "id", Type: "uint16_t", Extension: true},
+			{Name: "chunk_seq", Type: "uint8_t", Extension: true},
+		},
+	}
+}
+
+func testDefHilActuatorControls() *dynamic.MessageDefinition {
+	return &dynamic.MessageDefinition{
+		ID:   93,
+		Name: "HIL_ACTUATOR_CONTROLS",
+		Fields: []*dynamic.FieldDefinition{
+			{Name: "time_usec", Type: "uint64_t"},
+			{Name: "controls", Type: "float", ArrayLength: 16},
+			{Name: "mode", Type: "uint8_t", Enum: testEnumModeFlag},
+			{Name: "flags", Type: "uint64_t", Enum: &dynamic.EnumDefinition{Name: "HIL_ACTUATOR_CONTROLS_FLAGS", Bitmask: true}},
+		},
+	}
+}
+
+func TestMessageCompatibility(t *testing.T) {
+	for _, ca := range []struct {
+		name   string
+		def    *dynamic.MessageDefinition
+		values map[string]any
+		gen    message.Message
+	}{
+		{
+			"heartbeat",
+			testDefHeartbeat(),
+			map[string]any{
+				"type":            6,
+				"autopilot":       8,
+				"base_mode":       192,
+				"custom_mode":     uint32(123456),
+				"system_status":   4,
+				"mavlink_version": 3,
+			},
+			&common.MessageHeartbeat{
+				Type:           6,
+				Autopilot:      8,
+				BaseMode:       192,
+				CustomMode:     123456,
+				SystemStatus:   4,
+				MavlinkVersion: 3,
+			},
+		},
+		{
+			"statustext",
+			testDefStatustext(),
+			map[string]any{
+				"severity":  2,
+				"text":      "PreArm: check failed",
+				"id":        1234,
+				"chunk_seq": 2,
+			},
+			&common.MessageStatustext{
+				Severity: 2,
+				Text:     "PreArm: check failed",
+				Id:       1234,
+				ChunkSeq: 2,
+			},
+		},
+		{
+			"arrays",
+			testDefHilActuatorControls(),
+			map[string]any{
+				"time_usec": 987654321,
+				"controls":  []float64{0.5, -1, 0, 0.25},
+				"mode":      128,
+				"flags":     1,
+			},
+			&common.MessageHilActuatorControls{
+				TimeUsec: 987654321,
+				Controls: [16]float32{0.5, -1, 0, 0.25},
+				Mode:     128,
+				Flags:    1,
+			},
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			err := ca.def.Initialize()
+			require.NoError(t, err)
+
+			dm := ca.def.NewMessage()
+			for k, v := range ca.values {
+				err = dm.Set(k, v)
+				require.NoError(t, err)
+			}
+
+			genRW := &message.ReadWriter{Message: ca.gen}
+			err = genRW.Initialize()
+			require.NoError(t, err)
+
+			dynRW := &message.ReadWriter{Message: dm}
+			err = dynRW.Initialize()
+			require.NoError(t, err)
+
+			require.Equal(t, genRW.CRCExtra(), dynRW.CRCExtra())
+			require.Equal(t, genRW.CRCExtra(), ca.def.CRCExtra())
+
+			for _, isV2 := range []bool{false, true} {
+				raw := dynRW.Write(dm, isV2)
+				require.Equal(t, genRW.Write(ca.gen, isV2), raw)
+
+				dec, err2 := dynRW.Read(raw, isV2)
+				require.NoError(t, err2)
+
+				dec2 := ca.def.NewMessage()
+				err2 = dynRW.ReadInto(raw, isV2, dec2)
+				require.NoError(t, err2)
+				require.Equal(t, dec, dec2)
+
+				for f, v := range dec.(*dynamic.Message).All() {
+					if !isV2 && f.Extension {
+						continue
+					}
+					orig, _ := dm.Get(f.Name)
+					require.Equal(t, orig, v)
+				}
+			}
+		})
+	}
+}
+
+func TestMessageGetSet(t *testing.T) {
+	def := testDefHilActuatorControls()
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	m := def.NewMessage()
+
+	v, ok := m.Get("time_usec")
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(0), v)
+
+	v, ok = m.Get("controls")
+	require.Equal(t, true, ok)
+	require.Equal(t, make([]float32, 16), v)
+
+	_, ok = m.Get("missing")
+	require.Equal(t, false, ok)
+
+	err = m.Set("mode", common.MAV_MODE_FLAG_SAFETY_ARMED)
+	require.NoError(t, err)
+	v, _ = m.Get("mode")
+	require.Equal(t, uint64(128), v)
+
+	err = m.Set("controls", [2]int{1, 2})
+	require.NoError(t, err)
+	v, _ = m.Get("controls")
+	require.Equal(t, append([]float32{1, 2}, make([]float32, 14)...), v)
+
+	err = m.Set("missing", 1)
+	require.EqualError(t, err, "field 'missing' does not exist")
+
+	err = m.Set("time_usec", "test")
+	require.EqualError(t, err, "field 'time_usec': expected a number, got string")
+
+	err = m.Set("controls", make([]float32, 17))
+	require.EqualError(t, err, "field 'controls': array is too long (17 > 16)")
+
+	err = m.Set("controls", 1)
+	require.EqualError(t, err, "field 'controls': expected a numeric slice, got int")
+
+	var names []string
+	for f := range m.All() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"time_usec", "flags", "controls", "mode"}, names)
+}
+
+func TestMessageString(t *testing.T) {
+	def := testDefStatustext()
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	m := def.NewMessage()
+	err = m.Set("severity", 2)
+	require.NoError(t, err)
+	err = m.Set("text", "test")
+	require.NoError(t, err)
+
+	require.Equal(t, `STATUSTEXT{severity: MAV_SEVERITY_CRITICAL, text: "test", id: 0, chunk_seq: 0}`, m.String())
+}
+
+func TestMessageReadIntoErrors(t *testing.T) {
+	def1 := testDefHeartbeat()
+	err := def1.Initialize()
+	require.NoError(t, err)
+
+	def2 := testDefStatustext()
+	err = def2.Initialize()
+	require.NoError(t, err)
+
+	rw := &message.ReadWriter{Message: def1.NewMessage()}
+	err = rw.Initialize()
+	require.NoError(t, err)
+
+	err = rw.ReadInto(&message.MessageRaw{}, true, def2.NewMessage())
+	require.EqualError(t, err, "wrong destination message: expected ID 0, got 253")
+}
@@ -0,0 +1,356 @@
+package events
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var argumentSizes = map[string]int{
+	"uint8_t":  1,
+	"int8_t":   1,
+	"uint16_t": 2,
+	"int16_t":  2,
+	"uint32_t": 4,
+	"int32_t":  4,
+	"uint64_t": 8,
+	"int64_t":  8,
+	"float":    4,
+}
+
+type enumEntryJSON struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type enumJSON struct {
+	Type       string                    `json:"type"`
+	IsBitfield bool                      `json:"is_bitfield"`
+	Separator  string                    `json:"separator"`
+	Entries    map[string]*enumEntryJSON `json:"entries"`
+}
+
+type argumentJSON struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type eventJSON struct {
+	Name        string          `json:"name"`
+	Message     string          `json:"message"`
+	Description string          `json:"description"`
+	Arguments   []*argumentJSON `json:"arguments"`
+}
+
+type componentJSON struct {
+	Namespace   string               `json:"namespace"`
+	Enums       map[string]*enumJSON `json:"enums"`
+	EventGroups map[string]*struct {
+		Events map[string]*eventJSON `json:"events"`
+	} `json:"event_groups"`
+}
+
+type definitionsJSON struct {
+	Version    int                       `json:"version"`
+	Components map[string]*componentJSON `json:"components"`
+}
+
+type enumEntry struct {
+	value       uint64
+	description string
+}
+
+type enumDefinition struct {
+	typ        string
+	isBitfield bool
+	separator  string
+	entries    []*enumEntry
+}
+
+type argumentDefinition struct {
+	typ  string
+	enum *enumDefinition
+}
+
+// EventDefinition is the definition of an event.
+type EventDefinition struct {
+	// name of the event.
+	Name string
+
+	// message of the event, with placeholders of arguments.
+	Message string
+
+	// description of the event.
+	Description string
+
+	arguments []*argumentDefinition
+}
+
+// Definitions contains definitions of events, that are used to render them into text.
+// They are provided by components through the component metadata service,
+// in the JSON format of libevents.
+type Definitions struct {
+	events map[uint32]*EventDefinition
+}
+
+// ParseDefinitions parses definitions in the JSON format of libevents.
+func ParseDefinitions(r io.Reader) (*Definitions, error) {
+	var in definitionsJSON
+	err := json.NewDecoder(r).Decode(&in)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Definitions{
+		events: make(map[uint32]*EventDefinition),
+	}
+
+	for compKey, comp := range in.Components {
+		compID, err := strconv.ParseUint(compKey, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid component ID '%s'", compKey)
+		}
+
+		enums := make(map[string]*enumDefinition)
+
+		for name, enum := range comp.Enums {
+			if _, ok := argumentSizes[enum.Type]; !ok {
+				return nil, fmt.Errorf("enum %s: unsupported type '%s'", name, enum.Type)
+			}
+
+			def := &enumDefinition{
+				typ:        enum.Type,
+				isBitfield: enum.IsBitfield,
+				separator:  enum.Separator,
+			}
+			if def.separator == "" {
+				def.separator = "|"
+			}
+
+			for valueStr, entry := range enum.Entries {
+				value, err := strconv.ParseUint(valueStr, 0, 64)
+				if err != nil {
+					return nil, fmt.Errorf("enum %s: invalid value '%s'", name, valueStr)
+				}
+
+				description := entry.Description
+				if description == "" {
+					description = entry.Name
+				}
+
+				def.entries = append(def.entries, &enumEntry{value: value, description: description})
+			}
+
+			sort.Slice(def.entries, func(i, j int) bool {
+				return def.entries[i].value < def.entries[j].value
+			})
+
+			enums[name] = def
+			enums[comp.Namespace+"::enums::"+name] = def
+		}
+
+		for _, group := range comp.EventGroups {
+			for subIDStr, event := range group.Events {
+				subID, err := strconv.ParseUint(subIDStr, 10, 24)
+				if err != nil {
+					return nil, fmt.Errorf("invalid event ID '%s'", subIDStr)
+				}
+
+				def := &EventDefinition{
+					Name:        event.Name,
+					Message:     event.Message,
+					Description: event.Description,
+				}
+
+				for _, arg := range event.Arguments {
+					argDef := &argumentDefinition{typ: arg.Type}
+
+					if _, ok := argumentSizes[arg.Type]; !ok {
+						enum, ok := enums[arg.Type]
+						if !ok {
+							return nil, fmt.Errorf("event %s: unsupported argument type '%s'", event.Name, arg.Type)
+						}
+						argDef.typ = enum.typ
+						argDef.enum = enum
+					}
+
+					def.arguments = append(def.arguments, argDef)
+				}
+
+				d.events[uint32(compID)<<24|uint32(subID)] = def
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// Get returns the definition of an event, or nil if the event is not defined.
+func (d *Definitions) Get(id uint32) *EventDefinition {
+	return d.events[id]
+}
+
+// Fill fills name and text of an event.
+// It returns false if the event is not defined.
+func (d *Definitions) Fill(e *Event) bool {
+	def, ok := d.events[e.ID]
+	if !ok {
+		return false
+	}
+
+	e.Name = def.Name
+	e.Text = def.render(e.Arguments[:])
+	return true
+}
+
+func decodeArgument(typ string, buf []byte) (uint64, int64, float64) {
+	switch typ {
+	case "uint8_t":
+		v := uint64(buf[0])
+		return v, int64(v), float64(v)
+	case "int8_t":
+		v := int64(int8(buf[0]))
+		return uint64(v), v, float64(v)
+	case "uint16_t":
+		v := uint64(binary.LittleEndian.Uint16(buf))
+		return v, int64(v), float64(v)
+	case "int16_t":
+		v := int64(int16(binary.LittleEndian.Uint16(buf)))
+		return uint64(v), v, float64(v)
+	case "uint32_t":
+		v := uint64(binary.LittleEndian.Uint32(buf))
+		return v, int64(v), float64(v)
+	case "int32_t":
+		v := int64(int32(binary.LittleEndian.Uint32(buf)))
+		return uint64(v), v, float64(v)
+	case "uint64_t":
+		v := binary.LittleEndian.Uint64(buf)
+		return v, int64(v), float64(v)
+	case "int64_t":
+		v := int64(binary.LittleEndian.Uint64(buf))
+		return uint64(v), v, float64(v)
+	default: // float
+		v := float64(math.Float32frombits(binary.LittleEndian.Uint32(buf)))
+		return uint64(v), int64(v), v
+	}
+}
+
+func (e *enumDefinition) format(v uint64) string {
+	if !e.isBitfield {
+		for _, entry := range e.entries {
+			if entry.value == v {
+				return entry.description
+			}
+		}
+		return strconv.FormatUint(v, 10)
+	}
+
+	var parts []string
+	for _, entry := range e.entries {
+		if entry.value != 0 && v&entry.value == entry.value {
+			parts = append(parts, entry.description)
+		}
+	}
+	return strings.Join(parts, e.separator)
+}
+
+// formatArgument converts an argument into a string.
+// The precision of floats can be set by the format specification (i.e. {1:.2}),
+// while units are ignored.
+func (def *EventDefinition) formatArgument(args []byte, i int, spec string) string {
+	if i < 0 || i >= len(def.arguments) {
+		return ""
+	}
+
+	offset := 0
+	for _, arg := range def.arguments[:i] {
+		offset += argumentSizes[arg.typ]
+	}
+
+	arg := def.arguments[i]
+	size := argumentSizes[arg.typ]
+	if offset+size > len(args) {
+		return ""
+	}
+
+	u, s, f := decodeArgument(arg.typ, args[offset:offset+size])
+
+	switch {
+	case arg.enum != nil:
+		return arg.enum.format(u)
+
+	case arg.typ == "float":
+		prec := -1
+		if strings.HasPrefix(spec, ".") {
+			end := 1
+			for end < len(spec) && spec[end] >= '0' && spec[end] <= '9' {
+				end++
+			}
+			if p, err := strconv.Atoi(spec[1:end]); err == nil {
+				prec = p
+			}
+		}
+		return strconv.FormatFloat(f, 'f', prec, 32)
+
+	case strings.HasPrefix(arg.typ, "u"):
+		return strconv.FormatUint(u, 10)
+
+	default:
+		return strconv.FormatInt(s, 10)
+	}
+}
+
+// render replaces placeholders of arguments ({1}, {2:.1m}) and removes tags (<param>, <profile>).
+func (def *EventDefinition) render(args []byte) string {
+	var b strings.Builder
+	msg := def.Message
+
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+
+		switch {
+		// escaped character
+		case c == '\\' && i+1 < len(msg):
+			i++
+			b.WriteByte(msg[i])
+
+		case c == '{':
+			end := strings.IndexByte(msg[i:], '}')
+			if end < 0 {
+				b.WriteString(msg[i:])
+				return b.String()
+			}
+
+			placeholder := msg[i+1 : i+end]
+			spec := ""
+			if pos := strings.IndexByte(placeholder, ':'); pos >= 0 {
+				spec = placeholder[pos+1:]
+				placeholder = placeholder[:pos]
+			}
+
+			if n, err := strconv.Atoi(placeholder); err == nil {
+				b.WriteString(def.formatArgument(args, n-1, spec))
+			}
+
+			i += end
+
+		case c == '<':
+			end := strings.IndexByte(msg[i:], '>')
+			if end < 0 {
+				b.WriteString(msg[i:])
+				return b.String()
+			}
+			i += end
+
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
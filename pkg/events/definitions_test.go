@@ -0,0 +1,141 @@
+package events_test
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/events"
+)
+
+const testDefinitions = `{
+  "version": 2,
+  "components": {
+    "1": {
+      "namespace": "px4",
+      "enums": {
+        "arming_state_t": {
+          "type": "uint8_t",
+          "entries": {
+            "0": {"name": "init", "description": "Init"},
+            "1": {"name": "standby", "description": "Standby"}
+          }
+        },
+        "health_component_t": {
+          "type": "uint16_t",
+          "is_bitfield": true,
+          "separator": ", ",
+          "entries": {
+            "1": {"name": "gyro", "description": "Gyro"},
+            "2": {"name": "accel", "description": "Accelerometer"},
+            "4": {"name": "mag", "description": "Magnetometer"}
+          }
+        }
+      },
+      "event_groups": {
+        "default": {
+          "events": {
+            "16": {
+              "name": "arming_denied",
+              "message": "Arming denied in state {1}: <param>COM_ARM_CHK</param> failed for {2}",
+              "arguments": [
+                {"type": "px4::enums::arming_state_t", "name": "state"},
+                {"type": "health_component_t", "name": "components"}
+              ]
+            },
+            "17": {
+              "name": "altitude",
+              "message": "Altitude \\{m\\}: {1:.1m}, offset {2}, count {3}",
+              "arguments": [
+                {"type": "float", "name": "alt"},
+                {"type": "int16_t", "name": "offset"},
+                {"type": "uint32_t", "name": "count"}
+              ]
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestDefinitions(t *testing.T) {
+	d, err := events.ParseDefinitions(strings.NewReader(testDefinitions))
+	require.NoError(t, err)
+
+	require.Nil(t, d.Get(0x01000001))
+	require.Equal(t, "arming_denied", d.Get(0x01000010).Name)
+
+	e := &events.Event{ID: 0x01000010}
+	e.Arguments[0] = 1
+	binary.LittleEndian.PutUint16(e.Arguments[1:], 5)
+	require.True(t, d.Fill(e))
+	require.Equal(t, "arming_denied", e.Name)
+	require.Equal(t, "Arming denied in state Standby: COM_ARM_CHK failed for Gyro, Magnetometer", e.Text)
+
+	e = &events.Event{ID: 0x01000011}
+	binary.LittleEndian.PutUint32(e.Arguments[0:], math.Float32bits(12.345))
+	binary.LittleEndian.PutUint16(e.Arguments[4:], uint16(0xFFFE))
+	binary.LittleEndian.PutUint32(e.Arguments[6:], 100000)
+	require.True(t, d.Fill(e))
+	require.Equal(t, "Altitude {m}: 12.3, offset -2, count 100000", e.Text)
+
+	e = &events.Event{ID: 0x02000010}
+	require.False(t, d.Fill(e))
+	require.Empty(t, e.Text)
+}
+
+func TestDefinitionsErrors(t *testing.T) {
+	for _, ca := range []struct {
+		name string
+		json string
+		err  string
+	}{
+		{
+			"invalid json",
+			`{`,
+			"unexpected EOF",
+		},
+		{
+			"invalid component",
+			`{"components": {"a": {}}}`,
+			"invalid component ID 'a'",
+		},
+		{
+			"invalid event ID",
+			`{"components": {"1": {"event_groups": {"default": {"events": {"x": {}}}}}}}`,
+			"invalid event ID 'x'",
+		},
+		{
+			"unsupported argument",
+			`{"components": {"1": {"event_groups": {"default": {"events": {"1": ` +
+				`{"name": "a", "arguments": [{"type": "char"}]}}}}}}}`,
+			"event a: unsupported argument type 'char'",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			_, err := events.ParseDefinitions(strings.NewReader(ca.json))
+			require.EqualError(t, err, ca.err)
+		})
+	}
+}
+
+func TestReceiverDefinitions(t *testing.T) {
+	d, err := events.ParseDefinitions(strings.NewReader(testDefinitions))
+	require.NoError(t, err)
+
+	r := &events.Receiver{Definitions: d}
+	err = r.Initialize()
+	require.NoError(t, err)
+
+	msg := &common.MessageEvent{Id: 0x01000010, Sequence: 1}
+	msg.Arguments[1] = 2
+
+	evts := r.HandleMessage(1, 1, msg)
+	require.Len(t, evts, 1)
+	require.Equal(t, "Arming denied in state Init: COM_ARM_CHK failed for Accelerometer", evts[0].Text)
+}
@@ -0,0 +1,352 @@
+// Package events contains a receiver of the events microservice.
+//
+// Specification: https://mavlink.io/en/services/events.html
+package events
+
+import (
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+const (
+	defaultTimeout = 1 * time.Second
+	defaultRetries = 3
+)
+
+// seqDiff returns the distance between two sequence numbers,
+// taking into account wrap-around.
+func seqDiff(a uint16, b uint16) int16 {
+	return int16(a - b)
+}
+
+// LogLevel is the log level of an event.
+type LogLevel uint8
+
+// log levels.
+const (
+	LogLevelEmergency LogLevel = iota
+	LogLevelAlert
+	LogLevelCritical
+	LogLevelError
+	LogLevelWarning
+	LogLevelNotice
+	LogLevelInfo
+	LogLevelDebug
+	LogLevelProtocol
+	LogLevelDisabled
+)
+
+var logLevelLabels = []string{
+	"emergency",
+	"alert",
+	"critical",
+	"error",
+	"warning",
+	"notice",
+	"info",
+	"debug",
+	"protocol",
+	"disabled",
+}
+
+// String implements fmt.Stringer.
+func (l LogLevel) String() string {
+	if int(l) < len(logLevelLabels) {
+		return logLevelLabels[l]
+	}
+	return "unknown"
+}
+
+// Event is an event received from a component.
+type Event struct {
+	// system and component that sent the event.
+	SystemID    uint8
+	ComponentID uint8
+
+	// ID of the event.
+	ID uint32
+
+	// sequence number of the event.
+	Sequence uint16
+
+	// time since boot of the component when the event happened.
+	TimeBootMs uint32
+
+	// log level shown to users.
+	LogLevel LogLevel
+
+	// log level used for logging purposes.
+	InternalLogLevel LogLevel
+
+	// raw arguments.
+	Arguments [40]uint8
+
+	// name of the event. It is filled when definitions are available.
+	Name string
+
+	// text of the event, with arguments. It is filled when definitions are available.
+	Text string
+}
+
+type componentKey struct {
+	systemID    uint8
+	componentID uint8
+}
+
+type componentState struct {
+	expected      uint16
+	pending       map[uint16]*common.MessageEvent
+	requesting    bool
+	requestedLast uint16
+	requestTime   time.Time
+	retries       int
+}
+
+// Receiver receives events of components.
+// It keeps track of sequence numbers of every component, requests events that
+// have been missed, and returns events in order and without duplicates.
+// It must be fed with events of a Node:
+//
+//	for evt := range node.Events() {
+//		for _, e := range receiver.Handle(evt) {
+//			log.Println(e.Text)
+//		}
+//	}
+//
+// Timeouts are checked when messages are handled. Since components broadcast
+// CURRENT_EVENT_SEQUENCE periodically, this happens regularly.
+// It is not safe for concurrent use.
+type Receiver struct {
+	// function used to send REQUEST_EVENT messages,
+	// that must be routed to the component.
+	OnRequest func(msg *common.MessageRequestEvent)
+
+	// (optional) definitions used to fill names and texts of events.
+	Definitions *Definitions
+
+	// (optional) timeout of requests. It defaults to 1 second.
+	Timeout time.Duration
+
+	// (optional) number of times a request is repeated before
+	// missing events are considered lost. It defaults to 3.
+	Retries int
+
+	components map[componentKey]*componentState
+}
+
+// Initialize initializes a Receiver.
+func (r *Receiver) Initialize() error {
+	if r.Timeout == 0 {
+		r.Timeout = defaultTimeout
+	}
+	if r.Retries == 0 {
+		r.Retries = defaultRetries
+	}
+
+	r.components = make(map[componentKey]*componentState)
+
+	return nil
+}
+
+// Handle processes an event of a Node.
+// It returns received events.
+func (r *Receiver) Handle(evt gomavlib.Event) []*Event {
+	if evt, ok := evt.(*gomavlib.EventFrame); ok {
+		return r.HandleMessage(evt.SystemID(), evt.ComponentID(), evt.Message())
+	}
+	return nil
+}
+
+// HandleMessage processes a message sent by a system and component.
+// It returns received events.
+func (r *Receiver) HandleMessage(systemID uint8, componentID uint8, msg message.Message) []*Event {
+	var ret []*Event
+
+	key := componentKey{systemID, componentID}
+
+	switch msg := msg.(type) {
+	case *common.MessageEvent:
+		st, ok := r.components[key]
+		if !ok {
+			st = &componentState{
+				expected: msg.Sequence,
+				pending:  make(map[uint16]*common.MessageEvent),
+			}
+			r.components[key] = st
+		}
+		ret = r.processEvent(key, st, msg, ret)
+
+	case *common.MessageCurrentEventSequence:
+		st, ok := r.components[key]
+
+		// first sequence or component rebooted: previous events are not requested
+		if !ok || (msg.Flags&common.MAV_EVENT_CURRENT_SEQUENCE_FLAGS_RESET) != 0 {
+			r.components[key] = &componentState{
+				expected: msg.Sequence + 1,
+				pending:  make(map[uint16]*common.MessageEvent),
+			}
+			break
+		}
+
+		// events have been missed
+		if _, ok := st.pending[msg.Sequence]; !ok && seqDiff(msg.Sequence, st.expected) >= 0 {
+			r.request(key, st, msg.Sequence)
+		}
+
+	case *common.MessageResponseEventError:
+		st, ok := r.components[key]
+		if !ok {
+			break
+		}
+
+		// events before the oldest available one are lost
+		if seqDiff(msg.SequenceOldestAvailable, st.expected) > 0 {
+			st.expected = msg.SequenceOldestAvailable
+			ret = r.drain(key, st, ret)
+		}
+	}
+
+	return r.checkTimeouts(ret)
+}
+
+func (r *Receiver) processEvent(
+	key componentKey,
+	st *componentState,
+	msg *common.MessageEvent,
+	ret []*Event,
+) []*Event {
+	d := seqDiff(msg.Sequence, st.expected)
+
+	switch {
+	// duplicate
+	case d < 0:
+		return ret
+
+	case d == 0:
+		ret = append(ret, r.newEvent(key, msg))
+		st.expected++
+		return r.drain(key, st, ret)
+
+	// there's a gap: store the event and request missing ones
+	default:
+		st.pending[msg.Sequence] = msg
+		r.request(key, st, msg.Sequence-1)
+		return ret
+	}
+}
+
+// drain returns pending events that are next in sequence.
+func (r *Receiver) drain(key componentKey, st *componentState, ret []*Event) []*Event {
+	for {
+		msg, ok := st.pending[st.expected]
+		if !ok {
+			break
+		}
+		delete(st.pending, st.expected)
+		ret = append(ret, r.newEvent(key, msg))
+		st.expected++
+	}
+
+	// remove events that are older than the expected one
+	for seq := range st.pending {
+		if seqDiff(seq, st.expected) < 0 {
+			delete(st.pending, seq)
+		}
+	}
+
+	if st.requesting {
+		if seqDiff(st.requestedLast, st.expected) < 0 {
+			st.requesting = false
+		} else {
+			// progress has been made, restart the timeout
+			st.requestTime = time.Now()
+			st.retries = 0
+		}
+	}
+
+	return ret
+}
+
+// request requests events between the expected one and last, unless they have already been requested.
+func (r *Receiver) request(key componentKey, st *componentState, last uint16) {
+	if st.requesting && seqDiff(last, st.requestedLast) <= 0 {
+		return
+	}
+
+	st.requesting = true
+	st.requestedLast = last
+	st.requestTime = time.Now()
+	st.retries = 0
+
+	r.sendRequest(key, st)
+}
+
+func (r *Receiver) sendRequest(key componentKey, st *componentState) {
+	if r.OnRequest != nil {
+		r.OnRequest(&common.MessageRequestEvent{
+			TargetSystem:    key.systemID,
+			TargetComponent: key.componentID,
+			FirstSequence:   st.expected,
+			LastSequence:    st.requestedLast,
+		})
+	}
+}
+
+func (r *Receiver) checkTimeouts(ret []*Event) []*Event {
+	now := time.Now()
+
+	for key, st := range r.components {
+		if !st.requesting || now.Sub(st.requestTime) < r.Timeout {
+			continue
+		}
+
+		if st.retries < r.Retries {
+			st.retries++
+			st.requestTime = now
+			r.sendRequest(key, st)
+			continue
+		}
+
+		// give up and skip missing events
+		st.requesting = false
+
+		for len(st.pending) != 0 {
+			first := true
+			for seq := range st.pending {
+				if first || seqDiff(seq, st.expected) < 0 {
+					st.expected = seq
+					first = false
+				}
+			}
+			ret = r.drain(key, st, ret)
+		}
+
+		if seqDiff(st.requestedLast+1, st.expected) > 0 {
+			st.expected = st.requestedLast + 1
+		}
+	}
+
+	return ret
+}
+
+func (r *Receiver) newEvent(key componentKey, msg *common.MessageEvent) *Event {
+	e := &Event{
+		SystemID:         key.systemID,
+		ComponentID:      key.componentID,
+		ID:               msg.Id,
+		Sequence:         msg.Sequence,
+		TimeBootMs:       msg.EventTimeBootMs,
+		LogLevel:         LogLevel(msg.LogLevels & 0x0F),
+		InternalLogLevel: LogLevel(msg.LogLevels >> 4),
+		Arguments:        msg.Arguments,
+	}
+
+	if r.Definitions != nil {
+		r.Definitions.Fill(e)
+	}
+
+	return e
+}
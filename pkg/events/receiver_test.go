@@ -0,0 +1,144 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/events"
+	"github.com/bluenviron/gomavlib/v4/pkg/frame"
+)
+
+func sequences(evts []*events.Event) []uint16 {
+	ret := []uint16{}
+	for _, e := range evts {
+		ret = append(ret, e.Sequence)
+	}
+	return ret
+}
+
+func newEvent(seq uint16) *common.MessageEvent {
+	return &common.MessageEvent{
+		Id:        0x01000010,
+		Sequence:  seq,
+		LogLevels: 0x64,
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	var requests []*common.MessageRequestEvent
+
+	r := &events.Receiver{
+		OnRequest: func(msg *common.MessageRequestEvent) {
+			requests = append(requests, msg)
+		},
+	}
+	err := r.Initialize()
+	require.NoError(t, err)
+
+	evts := r.Handle(&gomavlib.EventFrame{
+		Frame: &frame.V2Frame{
+			SystemID:    1,
+			ComponentID: 1,
+			Message:     newEvent(10),
+		},
+	})
+	require.Equal(t, []*events.Event{{
+		SystemID:         1,
+		ComponentID:      1,
+		ID:               0x01000010,
+		Sequence:         10,
+		LogLevel:         events.LogLevelWarning,
+		InternalLogLevel: events.LogLevelInfo,
+	}}, evts)
+
+	// duplicate
+	require.Empty(t, r.HandleMessage(1, 1, newEvent(10)))
+
+	// gap
+	require.Empty(t, r.HandleMessage(1, 1, newEvent(13)))
+	require.Empty(t, r.HandleMessage(1, 1, newEvent(13)))
+	require.Empty(t, r.HandleMessage(1, 1, newEvent(12)))
+	require.Equal(t, []*common.MessageRequestEvent{{
+		TargetSystem:    1,
+		TargetComponent: 1,
+		FirstSequence:   11,
+		LastSequence:    12,
+	}}, requests)
+
+	require.Equal(t, []uint16{11, 12, 13}, sequences(r.HandleMessage(1, 1, newEvent(11))))
+
+	// events of other components are tracked separately
+	require.Equal(t, []uint16{3}, sequences(r.HandleMessage(1, 2, newEvent(3))))
+	require.Equal(t, []uint16{4}, sequences(r.HandleMessage(1, 2, newEvent(4))))
+
+	// missed events are detected with CURRENT_EVENT_SEQUENCE
+	require.Empty(t, r.HandleMessage(1, 1, &common.MessageCurrentEventSequence{Sequence: 13}))
+	require.Empty(t, r.HandleMessage(1, 1, &common.MessageCurrentEventSequence{Sequence: 15}))
+	require.Equal(t, &common.MessageRequestEvent{
+		TargetSystem:    1,
+		TargetComponent: 1,
+		FirstSequence:   14,
+		LastSequence:    15,
+	}, requests[len(requests)-1])
+
+	// events that are not available anymore are skipped
+	require.Empty(t, r.HandleMessage(1, 1, &common.MessageResponseEventError{
+		Sequence:                14,
+		SequenceOldestAvailable: 15,
+	}))
+	require.Equal(t, []uint16{15}, sequences(r.HandleMessage(1, 1, newEvent(15))))
+
+	// wrap-around
+	require.Empty(t, r.HandleMessage(1, 3, &common.MessageCurrentEventSequence{Sequence: 65534}))
+	require.Equal(t, []uint16{65535, 0, 1}, sequences(append(append(
+		r.HandleMessage(1, 3, newEvent(65535)),
+		r.HandleMessage(1, 3, newEvent(0))...),
+		r.HandleMessage(1, 3, newEvent(1))...)))
+
+	// reset
+	require.Empty(t, r.HandleMessage(1, 3, &common.MessageCurrentEventSequence{
+		Sequence: 100,
+		Flags:    common.MAV_EVENT_CURRENT_SEQUENCE_FLAGS_RESET,
+	}))
+	require.Equal(t, []uint16{101}, sequences(r.HandleMessage(1, 3, newEvent(101))))
+}
+
+func TestReceiverTimeout(t *testing.T) {
+	var requests []*common.MessageRequestEvent
+
+	r := &events.Receiver{
+		OnRequest: func(msg *common.MessageRequestEvent) {
+			requests = append(requests, msg)
+		},
+		Timeout: 10 * time.Millisecond,
+		Retries: 1,
+	}
+	err := r.Initialize()
+	require.NoError(t, err)
+
+	require.Equal(t, []uint16{1}, sequences(r.HandleMessage(1, 1, newEvent(1))))
+	require.Empty(t, r.HandleMessage(1, 1, newEvent(4)))
+	require.Len(t, requests, 1)
+
+	// request is repeated
+	time.Sleep(20 * time.Millisecond)
+	require.Empty(t, r.HandleMessage(1, 1, &common.MessageCurrentEventSequence{Sequence: 4}))
+	require.Len(t, requests, 2)
+	require.Equal(t, requests[0], requests[1])
+
+	// missing events are skipped
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, []uint16{4}, sequences(r.HandleMessage(1, 1, &common.MessageCurrentEventSequence{Sequence: 4})))
+	require.Len(t, requests, 2)
+
+	require.Equal(t, []uint16{5}, sequences(r.HandleMessage(1, 1, newEvent(5))))
+}
+
+func TestLogLevelString(t *testing.T) {
+	require.Equal(t, "warning", events.LogLevelWarning.String())
+	require.Equal(t, "unknown", events.LogLevel(15).String())
+}
@@ -0,0 +1,51 @@
+package flightmode
+
+import (
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+)
+
+// ardupilotEnumModes returns modes defined by an ArduPilot mode enum (i.e. COPTER_MODE).
+// Names are labels of the enum without prefix, like the ones used by the ArduPilot
+// ground stations (i.e. COPTER_MODE_ALT_HOLD is ALT_HOLD).
+func ardupilotEnumModes[T interface {
+	~uint64
+	String() string
+}](prefix string,
+) []*Mode {
+	var ret []*Mode
+
+	// custom modes fit into a byte.
+	// Values that are not defined by the enum are converted into numbers by String().
+	for v := range 256 {
+		label := T(v).String()
+		if strings.HasPrefix(label, prefix) {
+			ret = append(ret, &Mode{
+				Name:       strings.TrimPrefix(label, prefix),
+				CustomMode: uint32(v),
+			})
+		}
+	}
+
+	return ret
+}
+
+var (
+	ardupilotCopterModes  = ardupilotEnumModes[ardupilotmega.COPTER_MODE]("COPTER_MODE_")
+	ardupilotPlaneModes   = ardupilotEnumModes[ardupilotmega.PLANE_MODE]("PLANE_MODE_")
+	ardupilotRoverModes   = ardupilotEnumModes[ardupilotmega.ROVER_MODE]("ROVER_MODE_")
+	ardupilotSubModes     = ardupilotEnumModes[ardupilotmega.SUB_MODE]("SUB_MODE_")
+	ardupilotTrackerModes = ardupilotEnumModes[ardupilotmega.TRACKER_MODE]("TRACKER_MODE_")
+)
+
+// VTOLs are handled by ArduPlane, boats by ArduRover.
+var ardupilotModes = map[VehicleClass][]*Mode{
+	VehicleClassCopter:  ardupilotCopterModes,
+	VehicleClassPlane:   ardupilotPlaneModes,
+	VehicleClassVTOL:    ardupilotPlaneModes,
+	VehicleClassRover:   ardupilotRoverModes,
+	VehicleClassBoat:    ardupilotRoverModes,
+	VehicleClassSub:     ardupilotSubModes,
+	VehicleClassTracker: ardupilotTrackerModes,
+}
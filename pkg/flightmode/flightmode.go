@@ -0,0 +1,255 @@
+// Package flightmode contains functions to decode and encode flight modes of ArduPilot and PX4.
+package flightmode
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+var nameReplacer = strings.NewReplacer(" ", "_", "-", "_")
+
+// normalizeName allows to look up modes regardless of case and separators
+// (i.e. "Alt Hold" is ALT_HOLD).
+func normalizeName(name string) string {
+	return strings.ToUpper(nameReplacer.Replace(strings.TrimSpace(name)))
+}
+
+// Mode is a flight mode.
+type Mode struct {
+	// name of the mode (i.e. ALT_HOLD).
+	Name string
+
+	// value of the custom_mode field of HEARTBEAT.
+	CustomMode uint32
+
+	// standard mode that corresponds to the mode, if any.
+	StandardMode common.MAV_STANDARD_MODE
+
+	// properties of the mode. They are provided by AVAILABLE_MODES only.
+	Properties common.MAV_MODE_PROPERTY
+}
+
+// Status is the status of a vehicle decoded from a heartbeat.
+type Status struct {
+	// autopilot of the vehicle.
+	Autopilot common.MAV_AUTOPILOT
+
+	// class of the vehicle.
+	VehicleClass VehicleClass
+
+	// whether the vehicle is armed.
+	Armed bool
+
+	// current flight mode. It is nil when the mode is unknown.
+	Mode *Mode
+
+	// raw custom mode.
+	CustomMode uint32
+}
+
+// Table contains flight modes of a vehicle.
+// It is filled with the modes of ArduPilot and PX4, and it can be replaced
+// with modes advertised by the autopilot through AVAILABLE_MODES.
+// It is not safe for concurrent use.
+type Table struct {
+	autopilot    common.MAV_AUTOPILOT
+	vehicleClass VehicleClass
+	modes        []*Mode
+
+	seq           uint8
+	pendingSeq    uint8
+	pendingNumber uint8
+	pending       map[uint8]*Mode
+}
+
+// NewTable allocates a Table for the given autopilot and vehicle type,
+// that can be read from a heartbeat.
+func NewTable(autopilot common.MAV_AUTOPILOT, typ common.MAV_TYPE) *Table {
+	t := &Table{
+		autopilot:    autopilot,
+		vehicleClass: VehicleClassFromType(typ),
+	}
+
+	switch autopilot {
+	case common.MAV_AUTOPILOT_ARDUPILOTMEGA:
+		t.modes = ardupilotModes[t.vehicleClass]
+
+	case common.MAV_AUTOPILOT_PX4:
+		t.modes = px4Modes
+	}
+
+	return t
+}
+
+// Modes returns available modes.
+func (t *Table) Modes() []*Mode {
+	return t.modes
+}
+
+// ByName returns the mode with the given name, or nil if the mode is not available.
+// Names are compared regardless of case, spaces and dashes.
+func (t *Table) ByName(name string) *Mode {
+	name = normalizeName(name)
+	for _, m := range t.modes {
+		if normalizeName(m.Name) == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// ByCustomMode returns the mode that corresponds to a custom mode,
+// or nil if the mode is not available.
+func (t *Table) ByCustomMode(customMode uint32) *Mode {
+	for _, m := range t.modes {
+		if m.CustomMode == customMode {
+			return m
+		}
+	}
+	return nil
+}
+
+// Decode decodes a heartbeat.
+func (t *Table) Decode(hb *common.MessageHeartbeat) *Status {
+	s := &Status{
+		Autopilot:    hb.Autopilot,
+		VehicleClass: VehicleClassFromType(hb.Type),
+		Armed:        (hb.BaseMode & common.MAV_MODE_FLAG_SAFETY_ARMED) != 0,
+		CustomMode:   hb.CustomMode,
+	}
+
+	if (hb.BaseMode & common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED) != 0 {
+		s.Mode = t.ByCustomMode(hb.CustomMode)
+	}
+
+	return s
+}
+
+func (t *Table) mode(name string) (*Mode, error) {
+	m := t.ByName(name)
+	if m == nil {
+		return nil, fmt.Errorf("mode '%s' is not available", name)
+	}
+	return m, nil
+}
+
+// SetModeMessage encodes a mode change into a SET_MODE message.
+func (t *Table) SetModeMessage(name string, targetSystem uint8) (*common.MessageSetMode, error) {
+	m, err := t.mode(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.MessageSetMode{
+		TargetSystem: targetSystem,
+		BaseMode:     common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+		CustomMode:   m.CustomMode,
+	}, nil
+}
+
+// SetModeCommand encodes a mode change into a COMMAND_LONG message.
+// MAV_CMD_DO_SET_MODE is filled in the way expected by the autopilot.
+// Standard modes of other autopilots are set with MAV_CMD_DO_SET_STANDARD_MODE.
+func (t *Table) SetModeCommand(
+	name string,
+	targetSystem uint8,
+	targetComponent uint8,
+) (*common.MessageCommandLong, error) {
+	m, err := t.mode(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &common.MessageCommandLong{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Command:         common.MAV_CMD_DO_SET_MODE,
+		Param1:          float32(common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED),
+	}
+
+	switch {
+	case t.autopilot == common.MAV_AUTOPILOT_PX4:
+		mainMode, subMode := px4SplitCustomMode(m.CustomMode)
+		cmd.Param2 = float32(mainMode)
+		cmd.Param3 = float32(subMode)
+
+	case t.autopilot != common.MAV_AUTOPILOT_ARDUPILOTMEGA && m.StandardMode != 0:
+		cmd.Command = common.MAV_CMD_DO_SET_STANDARD_MODE
+		cmd.Param1 = float32(m.StandardMode)
+
+	default:
+		cmd.Param2 = float32(m.CustomMode)
+	}
+
+	return cmd, nil
+}
+
+// RequestAvailableModes returns a command that requests all AVAILABLE_MODES messages.
+func (t *Table) RequestAvailableModes(targetSystem uint8, targetComponent uint8) *common.MessageCommandLong {
+	return &common.MessageCommandLong{
+		TargetSystem:    targetSystem,
+		TargetComponent: targetComponent,
+		Command:         common.MAV_CMD_REQUEST_MESSAGE,
+		Param1:          float32((&common.MessageAvailableModes{}).GetID()),
+	}
+}
+
+func modeFromAvailableModes(msg *common.MessageAvailableModes) *Mode {
+	name := msg.ModeName
+	if name == "" {
+		name = strings.TrimPrefix(msg.StandardMode.String(), "MAV_STANDARD_MODE_")
+	}
+
+	return &Mode{
+		Name:         name,
+		CustomMode:   msg.CustomMode,
+		StandardMode: msg.StandardMode,
+		Properties:   msg.Properties,
+	}
+}
+
+// HandleAvailableModes processes an AVAILABLE_MODES message.
+// When all modes advertised by the autopilot have been received,
+// they replace the modes of the table and true is returned.
+func (t *Table) HandleAvailableModes(msg *common.MessageAvailableModes) bool {
+	if msg.NumberModes == 0 || msg.ModeIndex == 0 || msg.ModeIndex > msg.NumberModes {
+		return false
+	}
+
+	// restart when the set of modes changes
+	if t.pending == nil || msg.NumberModes != t.pendingNumber || (msg.Seq != 0 && msg.Seq != t.pendingSeq) {
+		t.pending = make(map[uint8]*Mode)
+		t.pendingNumber = msg.NumberModes
+		t.pendingSeq = msg.Seq
+	}
+
+	t.pending[msg.ModeIndex] = modeFromAvailableModes(msg)
+
+	if len(t.pending) != int(t.pendingNumber) {
+		return false
+	}
+
+	modes := make([]*Mode, t.pendingNumber)
+	for i := range modes {
+		modes[i] = t.pending[uint8(i+1)]
+	}
+
+	t.modes = modes
+	t.seq = t.pendingSeq
+	t.pending = nil
+
+	return true
+}
+
+// HandleAvailableModesMonitor processes an AVAILABLE_MODES_MONITOR message.
+// It returns true when the set of modes has changed and must be requested again.
+func (t *Table) HandleAvailableModesMonitor(msg *common.MessageAvailableModesMonitor) bool {
+	return msg.Seq != 0 && msg.Seq != t.seq
+}
+
+// Decode decodes a heartbeat by using the modes of ArduPilot and PX4.
+func Decode(hb *common.MessageHeartbeat) *Status {
+	return NewTable(hb.Autopilot, hb.Type).Decode(hb)
+}
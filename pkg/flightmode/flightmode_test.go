@@ -0,0 +1,278 @@
+package flightmode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/flightmode"
+)
+
+func TestVehicleClassFromType(t *testing.T) {
+	for _, ca := range []struct {
+		typ   common.MAV_TYPE
+		class flightmode.VehicleClass
+	}{
+		{common.MAV_TYPE_QUADROTOR, flightmode.VehicleClassCopter},
+		{common.MAV_TYPE_HELICOPTER, flightmode.VehicleClassCopter},
+		{common.MAV_TYPE_FIXED_WING, flightmode.VehicleClassPlane},
+		{common.MAV_TYPE_VTOL_TILTROTOR, flightmode.VehicleClassVTOL},
+		{common.MAV_TYPE_GROUND_ROVER, flightmode.VehicleClassRover},
+		{common.MAV_TYPE_SURFACE_BOAT, flightmode.VehicleClassBoat},
+		{common.MAV_TYPE_SUBMARINE, flightmode.VehicleClassSub},
+		{common.MAV_TYPE_ANTENNA_TRACKER, flightmode.VehicleClassTracker},
+		{common.MAV_TYPE_GCS, flightmode.VehicleClassUnknown},
+	} {
+		t.Run(ca.class.String(), func(t *testing.T) {
+			require.Equal(t, ca.class, flightmode.VehicleClassFromType(ca.typ))
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, ca := range []struct {
+		name  string
+		hb    *common.MessageHeartbeat
+		class flightmode.VehicleClass
+		armed bool
+		mode  string
+	}{
+		{
+			"ardupilot copter",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_QUADROTOR,
+				Autopilot:  common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED | common.MAV_MODE_FLAG_SAFETY_ARMED,
+				CustomMode: uint32(ardupilotmega.COPTER_MODE_ALT_HOLD),
+			},
+			flightmode.VehicleClassCopter,
+			true,
+			"ALT_HOLD",
+		},
+		{
+			"ardupilot plane",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_FIXED_WING,
+				Autopilot:  common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode: uint32(ardupilotmega.PLANE_MODE_FLY_BY_WIRE_A),
+			},
+			flightmode.VehicleClassPlane,
+			false,
+			"FLY_BY_WIRE_A",
+		},
+		{
+			"ardupilot boat",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_SURFACE_BOAT,
+				Autopilot:  common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode: uint32(ardupilotmega.ROVER_MODE_HOLD),
+			},
+			flightmode.VehicleClassBoat,
+			false,
+			"HOLD",
+		},
+		{
+			"px4 mission",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_QUADROTOR,
+				Autopilot:  common.MAV_AUTOPILOT_PX4,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED | common.MAV_MODE_FLAG_SAFETY_ARMED,
+				CustomMode: 4<<16 | 4<<24,
+			},
+			flightmode.VehicleClassCopter,
+			true,
+			"MISSION",
+		},
+		{
+			"px4 position",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_VTOL_TAILSITTER,
+				Autopilot:  common.MAV_AUTOPILOT_PX4,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode: 3 << 16,
+			},
+			flightmode.VehicleClassVTOL,
+			false,
+			"POSCTL",
+		},
+		{
+			"unknown mode",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_QUADROTOR,
+				Autopilot:  common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode: 200,
+			},
+			flightmode.VehicleClassCopter,
+			false,
+			"",
+		},
+		{
+			"custom mode disabled",
+			&common.MessageHeartbeat{
+				Type:       common.MAV_TYPE_QUADROTOR,
+				Autopilot:  common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				CustomMode: uint32(ardupilotmega.COPTER_MODE_ALT_HOLD),
+			},
+			flightmode.VehicleClassCopter,
+			false,
+			"",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			s := flightmode.Decode(ca.hb)
+			require.Equal(t, ca.hb.Autopilot, s.Autopilot)
+			require.Equal(t, ca.class, s.VehicleClass)
+			require.Equal(t, ca.armed, s.Armed)
+			require.Equal(t, ca.hb.CustomMode, s.CustomMode)
+
+			if ca.mode == "" {
+				require.Nil(t, s.Mode)
+			} else {
+				require.Equal(t, ca.mode, s.Mode.Name)
+			}
+		})
+	}
+}
+
+func TestSetMode(t *testing.T) {
+	for _, ca := range []struct {
+		name      string
+		autopilot common.MAV_AUTOPILOT
+		typ       common.MAV_TYPE
+		mode      string
+		msg       *common.MessageSetMode
+		cmd       *common.MessageCommandLong
+	}{
+		{
+			"ardupilot",
+			common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+			common.MAV_TYPE_HEXAROTOR,
+			"smart rtl",
+			&common.MessageSetMode{
+				TargetSystem: 1,
+				BaseMode:     common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode:   uint32(ardupilotmega.COPTER_MODE_SMART_RTL),
+			},
+			&common.MessageCommandLong{
+				TargetSystem:    1,
+				TargetComponent: 2,
+				Command:         common.MAV_CMD_DO_SET_MODE,
+				Param1:          1,
+				Param2:          21,
+			},
+		},
+		{
+			"px4",
+			common.MAV_AUTOPILOT_PX4,
+			common.MAV_TYPE_FIXED_WING,
+			"RTL",
+			&common.MessageSetMode{
+				TargetSystem: 1,
+				BaseMode:     common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode:   4<<16 | 5<<24,
+			},
+			&common.MessageCommandLong{
+				TargetSystem:    1,
+				TargetComponent: 2,
+				Command:         common.MAV_CMD_DO_SET_MODE,
+				Param1:          1,
+				Param2:          4,
+				Param3:          5,
+			},
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			tbl := flightmode.NewTable(ca.autopilot, ca.typ)
+
+			msg, err := tbl.SetModeMessage(ca.mode, 1)
+			require.NoError(t, err)
+			require.Equal(t, ca.msg, msg)
+
+			cmd, err := tbl.SetModeCommand(ca.mode, 1, 2)
+			require.NoError(t, err)
+			require.Equal(t, ca.cmd, cmd)
+		})
+	}
+}
+
+func TestSetModeErrors(t *testing.T) {
+	tbl := flightmode.NewTable(common.MAV_AUTOPILOT_ARDUPILOTMEGA, common.MAV_TYPE_QUADROTOR)
+	_, err := tbl.SetModeMessage("FLY_BY_WIRE_A", 1)
+	require.EqualError(t, err, "mode 'FLY_BY_WIRE_A' is not available")
+
+	tbl = flightmode.NewTable(common.MAV_AUTOPILOT_GENERIC, common.MAV_TYPE_QUADROTOR)
+	require.Empty(t, tbl.Modes())
+	_, err = tbl.SetModeCommand("LAND", 1, 1)
+	require.EqualError(t, err, "mode 'LAND' is not available")
+}
+
+func TestAvailableModes(t *testing.T) {
+	tbl := flightmode.NewTable(common.MAV_AUTOPILOT_GENERIC, common.MAV_TYPE_QUADROTOR)
+
+	require.Equal(t, &common.MessageCommandLong{
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Command:         common.MAV_CMD_REQUEST_MESSAGE,
+		Param1:          435,
+	}, tbl.RequestAvailableModes(1, 1))
+
+	require.True(t, tbl.HandleAvailableModesMonitor(&common.MessageAvailableModesMonitor{Seq: 3}))
+
+	ok := tbl.HandleAvailableModes(&common.MessageAvailableModes{
+		NumberModes:  2,
+		ModeIndex:    2,
+		StandardMode: common.MAV_STANDARD_MODE_LAND,
+		CustomMode:   20,
+		Seq:          3,
+	})
+	require.False(t, ok)
+
+	ok = tbl.HandleAvailableModes(&common.MessageAvailableModes{
+		NumberModes: 2,
+		ModeIndex:   1,
+		CustomMode:  10,
+		Properties:  common.MAV_MODE_PROPERTY_ADVANCED,
+		ModeName:    "Acro",
+		Seq:         3,
+	})
+	require.True(t, ok)
+
+	require.Equal(t, []*flightmode.Mode{
+		{Name: "Acro", CustomMode: 10, Properties: common.MAV_MODE_PROPERTY_ADVANCED},
+		{Name: "LAND", CustomMode: 20, StandardMode: common.MAV_STANDARD_MODE_LAND},
+	}, tbl.Modes())
+
+	require.False(t, tbl.HandleAvailableModesMonitor(&common.MessageAvailableModesMonitor{Seq: 3}))
+	require.True(t, tbl.HandleAvailableModesMonitor(&common.MessageAvailableModesMonitor{Seq: 4}))
+
+	s := tbl.Decode(&common.MessageHeartbeat{
+		Type:       common.MAV_TYPE_QUADROTOR,
+		BaseMode:   common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+		CustomMode: 10,
+	})
+	require.Equal(t, "Acro", s.Mode.Name)
+
+	cmd, err := tbl.SetModeCommand("land", 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, &common.MessageCommandLong{
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Command:         common.MAV_CMD_DO_SET_STANDARD_MODE,
+		Param1:          float32(common.MAV_STANDARD_MODE_LAND),
+	}, cmd)
+
+	cmd, err = tbl.SetModeCommand("acro", 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, &common.MessageCommandLong{
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Command:         common.MAV_CMD_DO_SET_MODE,
+		Param1:          1,
+		Param2:          10,
+	}, cmd)
+}
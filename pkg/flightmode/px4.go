@@ -0,0 +1,68 @@
+package flightmode
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+// PX4 packs a main mode and a sub mode into the custom mode.
+// https://github.com/PX4/PX4-Autopilot/blob/main/src/modules/commander/px4_custom_mode.h
+const (
+	px4MainModeManual     = 1
+	px4MainModeAltctl     = 2
+	px4MainModePosctl     = 3
+	px4MainModeAuto       = 4
+	px4MainModeAcro       = 5
+	px4MainModeOffboard   = 6
+	px4MainModeStabilized = 7
+	px4MainModeRattitude  = 8
+)
+
+const (
+	px4SubModeAutoReady        = 1
+	px4SubModeAutoTakeoff      = 2
+	px4SubModeAutoLoiter       = 3
+	px4SubModeAutoMission      = 4
+	px4SubModeAutoRTL          = 5
+	px4SubModeAutoLand         = 6
+	px4SubModeAutoFollowTarget = 8
+	px4SubModeAutoPrecland     = 9
+	px4SubModeAutoVTOLTakeoff  = 10
+	px4SubModePosctlOrbit      = 1
+)
+
+func px4CustomMode(mainMode uint8, subMode uint8) uint32 {
+	return uint32(mainMode)<<16 | uint32(subMode)<<24
+}
+
+func px4SplitCustomMode(customMode uint32) (uint8, uint8) {
+	return uint8(customMode >> 16), uint8(customMode >> 24)
+}
+
+func px4Mode(name string, mainMode uint8, subMode uint8, standardMode common.MAV_STANDARD_MODE) *Mode {
+	return &Mode{
+		Name:         name,
+		CustomMode:   px4CustomMode(mainMode, subMode),
+		StandardMode: standardMode,
+	}
+}
+
+// names are the same used by pymavlink.
+var px4Modes = []*Mode{
+	px4Mode("MANUAL", px4MainModeManual, 0, 0),
+	px4Mode("STABILIZED", px4MainModeStabilized, 0, 0),
+	px4Mode("ACRO", px4MainModeAcro, 0, 0),
+	px4Mode("RATTITUDE", px4MainModeRattitude, 0, 0),
+	px4Mode("ALTCTL", px4MainModeAltctl, 0, common.MAV_STANDARD_MODE_ALTITUDE_HOLD),
+	px4Mode("POSCTL", px4MainModePosctl, 0, common.MAV_STANDARD_MODE_POSITION_HOLD),
+	px4Mode("ORBIT", px4MainModePosctl, px4SubModePosctlOrbit, common.MAV_STANDARD_MODE_ORBIT),
+	px4Mode("READY", px4MainModeAuto, px4SubModeAutoReady, 0),
+	px4Mode("TAKEOFF", px4MainModeAuto, px4SubModeAutoTakeoff, common.MAV_STANDARD_MODE_TAKEOFF),
+	px4Mode("LOITER", px4MainModeAuto, px4SubModeAutoLoiter, 0),
+	px4Mode("MISSION", px4MainModeAuto, px4SubModeAutoMission, common.MAV_STANDARD_MODE_MISSION),
+	px4Mode("RTL", px4MainModeAuto, px4SubModeAutoRTL, common.MAV_STANDARD_MODE_SAFE_RECOVERY),
+	px4Mode("LAND", px4MainModeAuto, px4SubModeAutoLand, common.MAV_STANDARD_MODE_LAND),
+	px4Mode("FOLLOWME", px4MainModeAuto, px4SubModeAutoFollowTarget, 0),
+	px4Mode("PRECLAND", px4MainModeAuto, px4SubModeAutoPrecland, 0),
+	px4Mode("VTOL_TAKEOFF", px4MainModeAuto, px4SubModeAutoVTOLTakeoff, 0),
+	px4Mode("OFFBOARD", px4MainModeOffboard, 0, 0),
+}
@@ -0,0 +1,81 @@
+package flightmode
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+// VehicleClass is a class of vehicles that share the same flight modes.
+type VehicleClass int
+
+// vehicle classes.
+const (
+	VehicleClassUnknown VehicleClass = iota
+	VehicleClassCopter
+	VehicleClassPlane
+	VehicleClassVTOL
+	VehicleClassRover
+	VehicleClassBoat
+	VehicleClassSub
+	VehicleClassTracker
+)
+
+var vehicleClassLabels = map[VehicleClass]string{
+	VehicleClassUnknown: "unknown",
+	VehicleClassCopter:  "copter",
+	VehicleClassPlane:   "plane",
+	VehicleClassVTOL:    "vtol",
+	VehicleClassRover:   "rover",
+	VehicleClassBoat:    "boat",
+	VehicleClassSub:     "sub",
+	VehicleClassTracker: "tracker",
+}
+
+// String implements fmt.Stringer.
+func (c VehicleClass) String() string {
+	if l, ok := vehicleClassLabels[c]; ok {
+		return l
+	}
+	return vehicleClassLabels[VehicleClassUnknown]
+}
+
+// VehicleClassFromType returns the class of a vehicle type reported by heartbeats.
+func VehicleClassFromType(typ common.MAV_TYPE) VehicleClass {
+	switch typ {
+	case common.MAV_TYPE_QUADROTOR,
+		common.MAV_TYPE_COAXIAL,
+		common.MAV_TYPE_HELICOPTER,
+		common.MAV_TYPE_HEXAROTOR,
+		common.MAV_TYPE_OCTOROTOR,
+		common.MAV_TYPE_TRICOPTER,
+		common.MAV_TYPE_DODECAROTOR,
+		common.MAV_TYPE_DECAROTOR,
+		common.MAV_TYPE_GENERIC_MULTIROTOR:
+		return VehicleClassCopter
+
+	case common.MAV_TYPE_FIXED_WING,
+		common.MAV_TYPE_FLAPPING_WING:
+		return VehicleClassPlane
+
+	case common.MAV_TYPE_VTOL_TAILSITTER_DUOROTOR,
+		common.MAV_TYPE_VTOL_TAILSITTER_QUADROTOR,
+		common.MAV_TYPE_VTOL_TILTROTOR,
+		common.MAV_TYPE_VTOL_FIXEDROTOR,
+		common.MAV_TYPE_VTOL_TAILSITTER,
+		common.MAV_TYPE_VTOL_TILTWING:
+		return VehicleClassVTOL
+
+	case common.MAV_TYPE_GROUND_ROVER:
+		return VehicleClassRover
+
+	case common.MAV_TYPE_SURFACE_BOAT:
+		return VehicleClassBoat
+
+	case common.MAV_TYPE_SUBMARINE:
+		return VehicleClassSub
+
+	case common.MAV_TYPE_ANTENNA_TRACKER:
+		return VehicleClassTracker
+	}
+
+	return VehicleClassUnknown
+}
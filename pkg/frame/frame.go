@@ -27,6 +27,6 @@ type Frame interface {
 	// generates the checksum of the frame.
 	GenerateChecksum(byte) uint16
 
-	unmarshal(*bufio.Reader) error
+	unmarshal(*bufio.Reader, *message.MessageRaw, *V2Signature) error
 	marshalTo([]byte, []byte) (int, error)
 }
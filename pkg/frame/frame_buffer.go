@@ -0,0 +1,15 @@
+package frame
+
+import (
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// FrameBuffer is a reusable storage for frames read with Reader.ReadInto.
+// It allows to read frames without allocating memory.
+type FrameBuffer struct { //nolint:revive
+	v1        V1Frame
+	v2        V2Frame
+	msg       message.MessageRaw
+	signature V2Signature
+	payload   [255]byte
+}
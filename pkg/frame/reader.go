@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/dialect"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
 )
 
@@ -15,6 +16,10 @@ const (
 )
 
 func hasStringFields(msg message.Message) bool {
+	if dm, ok := msg.(*dynamic.Message); ok {
+		return dm.Definition().HasStrings()
+	}
+
 	typ := reflect.TypeOf(msg).Elem()
 
 	for i := range typ.NumField() {
@@ -54,6 +59,14 @@ type Reader struct {
 	// If not provided, messages are decoded into the MessageRaw struct.
 	DialectRW *dialect.ReadWriter
 
+	// (optional) table used to validate the checksum and the length
+	// of messages that are not in the dialect.
+	// These messages are not decoded and are returned as MessageRaw.
+	MessageTable dialect.MessageTable
+
+	// (optional) discard messages that are neither in the dialect nor in MessageTable.
+	DropUnknownMessages bool
+
 	// (optional) secret key used to validate incoming frames.
 	// Non-signed frames are discarded. This feature requires v2 frames.
 	InKey *V2Key
@@ -77,48 +90,125 @@ func (r *Reader) Initialize() error {
 // Read reads a Frame from the reader.
 // It must not be called by multiple routines in parallel.
 func (r *Reader) Read() (Frame, error) {
-	magicByte, err := r.BufByteReader.ReadByte()
+	f, mp, err := r.readFrame(nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// decode message if in dialect
+	if mp != nil {
+		_, isV2 := f.(*V2Frame)
+		rawMessage := f.GetMessage().(*message.MessageRaw)
+
+		var msg message.Message
+		msg, err = mp.Read(rawMessage, isV2)
+		if err != nil {
+			return nil, newError("unable to decode message: %s", err.Error())
+		}
+
+		// some libraries generate non-standard messages, in particular:
+		// - messages with junk after string termination
+		// - v2 messages with trailing empty bytes
+		// The specification says that we must support these messages (and we are)
+		// but there might be troubles when re-encoding them, since checksum is different.
+		// re-compute the checksum.
+		if hasStringFields(msg) || (isV2 && hasEmptyBytes(rawMessage.Payload)) {
+			raw2 := mp.Write(msg, isV2)
+			switch f := f.(type) {
+			case *V1Frame:
+				f.Message = raw2
+				f.Checksum = f.GenerateChecksum(mp.CRCExtra())
+			case *V2Frame:
+				f.Message = raw2
+				f.Checksum = f.GenerateChecksum(mp.CRCExtra())
+			}
+		}
+
+		switch f := f.(type) {
+		case *V1Frame:
+			f.Message = msg
+		case *V2Frame:
+			f.Message = msg
+		}
+	}
+
+	return f, nil
+}
+
+// ReadInto reads a Frame from the reader without allocating memory,
+// by reusing the storage provided by fb.
+// Checksum and signature are validated as in Read, but messages are not decoded:
+// the returned frame always contains a *message.MessageRaw, that can be decoded
+// with message.ReadWriter.ReadInto.
+// The returned frame is valid until the next call to ReadInto with the same FrameBuffer.
+// It must not be called by multiple routines in parallel.
+func (r *Reader) ReadInto(fb *FrameBuffer) (Frame, error) {
+	f, _, err := r.readFrame(fb)
+	return f, err
+}
+
+// readFrame reads a frame and validates its signature and checksum.
+// It returns the message ReadWriter too, when the message is in the dialect.
+func (r *Reader) readFrame(fb *FrameBuffer) (Frame, *message.ReadWriter, error) {
+	magicByte, err := r.BufByteReader.ReadByte()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var f Frame
 
 	switch magicByte {
 	case V1MagicByte:
-		f = &V1Frame{}
+		if fb != nil {
+			fb.v1 = V1Frame{}
+			f = &fb.v1
+		} else {
+			f = &V1Frame{}
+		}
 
 	case V2MagicByte:
-		f = &V2Frame{}
+		if fb != nil {
+			fb.v2 = V2Frame{}
+			f = &fb.v2
+		} else {
+			f = &V2Frame{}
+		}
 
 	default:
-		return nil, newError("invalid magic byte: %x", magicByte)
+		return nil, nil, newError("invalid magic byte: %x", magicByte)
 	}
 
-	err = f.unmarshal(r.BufByteReader)
+	if fb != nil {
+		fb.msg.Payload = fb.payload[:0]
+		err = f.unmarshal(r.BufByteReader, &fb.msg, &fb.signature)
+	} else {
+		err = f.unmarshal(r.BufByteReader, &message.MessageRaw{}, nil)
+	}
 	if err != nil {
-		return nil, newError("%s", err.Error())
+		return nil, nil, newError("%s", err.Error())
 	}
 
 	if r.InKey != nil {
 		ff, ok := f.(*V2Frame)
 		if !ok {
-			return nil, newError("signature required but packet is not v2")
+			return nil, nil, newError("signature required but packet is not v2")
 		}
 
 		if ff.Signature == nil {
-			return nil, newError("signature not present")
+			return nil, nil, newError("signature not present")
 		}
 
-		if sig := ff.GenerateSignature(r.InKey); subtle.ConstantTimeCompare(sig[:], ff.Signature[:]) != 1 {
-			return nil, newError("wrong signature")
+		var sig V2Signature
+		ff.GenerateSignatureInto(r.InKey, &sig)
+		if subtle.ConstantTimeCompare(sig[:], ff.Signature[:]) != 1 {
+			return nil, nil, newError("wrong signature")
 		}
 
 		// in UDP, packet order is not guaranteed. Therefore, we accept frames
 		// with a timestamp within 10 seconds with respect to the previous
 		if r.curReadSignatureTime > 0 &&
 			ff.SignatureTimestamp < (r.curReadSignatureTime-(10*100000)) {
-			return nil, newError("signature timestamp is too old")
+			return nil, nil, newError("signature timestamp is too old")
 		}
 
 		if ff.SignatureTimestamp > r.curReadSignatureTime {
@@ -126,49 +216,43 @@ func (r *Reader) Read() (Frame, error) {
 		}
 	}
 
-	// decode message if in dialect and validate checksum
+	// validate checksum if message is in dialect
 	if r.DialectRW != nil {
 		if mp := r.DialectRW.GetMessage(f.GetMessage().GetID()); mp != nil {
 			if sum := f.GenerateChecksum(mp.CRCExtra()); sum != f.GetChecksum() {
-				return nil, newError("wrong checksum, expected %.4x, got %.4x, message id is %d",
+				return nil, nil, newError("wrong checksum, expected %.4x, got %.4x, message id is %d",
 					sum, f.GetChecksum(), f.GetMessage().GetID())
 			}
 
-			_, isV2 := f.(*V2Frame)
-			rawMessage := f.GetMessage().(*message.MessageRaw)
+			return f, mp, nil
+		}
+	}
 
-			var msg message.Message
-			msg, err = mp.Read(rawMessage, isV2)
-			if err != nil {
-				return nil, newError("unable to decode message: %s", err.Error())
-			}
+	// validate checksum and length if message is in table
+	if e, ok := r.MessageTable[f.GetMessage().GetID()]; ok {
+		if sum := f.GenerateChecksum(e.CRCExtra); sum != f.GetChecksum() {
+			return nil, nil, newError("wrong checksum, expected %.4x, got %.4x, message id is %d",
+				sum, f.GetChecksum(), f.GetMessage().GetID())
+		}
 
-			// some libraries generate non-standard messages, in particular:
-			// - messages with junk after string termination
-			// - v2 messages with trailing empty bytes
-			// The specification says that we must support these messages (and we are)
-			// but there might be troubles when re-encoding them, since checksum is different.
-			// re-compute the checksum.
-			if hasStringFields(msg) || (isV2 && hasEmptyBytes(rawMessage.Payload)) {
-				raw2 := mp.Write(msg, isV2)
-				switch f := f.(type) {
-				case *V1Frame:
-					f.Message = raw2
-					f.Checksum = f.GenerateChecksum(mp.CRCExtra())
-				case *V2Frame:
-					f.Message = raw2
-					f.Checksum = f.GenerateChecksum(mp.CRCExtra())
-				}
-			}
+		payloadLen := len(f.GetMessage().(*message.MessageRaw).Payload)
 
-			switch f := f.(type) {
-			case *V1Frame:
-				f.Message = msg
-			case *V2Frame:
-				f.Message = msg
+		if _, isV2 := f.(*V2Frame); isV2 {
+			if payloadLen > int(e.MaxLength) {
+				return nil, nil, newError("wrong payload length, expected at most %d, got %d, message id is %d",
+					e.MaxLength, payloadLen, f.GetMessage().GetID())
 			}
+		} else if payloadLen != int(e.MinLength) {
+			return nil, nil, newError("wrong payload length, expected %d, got %d, message id is %d",
+				e.MinLength, payloadLen, f.GetMessage().GetID())
 		}
+
+		return f, nil, nil
 	}
 
-	return f, nil
+	if r.DropUnknownMessages {
+		return nil, nil, newError("unknown message id %d", f.GetMessage().GetID())
+	}
+
+	return f, nil, nil
 }
@@ -432,6 +432,129 @@ func TestReader(t *testing.T) {
 	}
 }
 
+func TestReaderReadInto(t *testing.T) {
+	var fb FrameBuffer
+
+	for _, ca := range casesReadWrite {
+		t.Run(ca.name, func(t *testing.T) {
+			reader := &Reader{
+				BufByteReader: bufio.NewReaderSize(bytes.NewReader(ca.raw), bufferSize),
+				DialectRW:     ca.dialectRW,
+				InKey:         ca.key,
+			}
+			err := reader.Initialize()
+			require.NoError(t, err)
+
+			fr, err := reader.ReadInto(&fb)
+			require.NoError(t, err)
+			require.IsType(t, &message.MessageRaw{}, fr.GetMessage())
+			require.Equal(t, ca.frame.GetSequenceNumber(), fr.GetSequenceNumber())
+			require.Equal(t, ca.frame.GetMessage().GetID(), fr.GetMessage().GetID())
+
+			// message is not decoded, therefore the frame can be re-encoded as is
+			var buf bytes.Buffer
+			writer := &Writer{ByteWriter: &buf}
+			err = writer.Initialize()
+			require.NoError(t, err)
+
+			err = writer.Write(fr)
+			require.NoError(t, err)
+			require.Equal(t, ca.raw, buf.Bytes())
+		})
+	}
+}
+
+type repeatReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	n := copy(p, r.buf[r.pos:])
+	r.pos = (r.pos + n) % len(r.buf)
+	return n, nil
+}
+
+func TestReaderReadIntoAllocs(t *testing.T) {
+	ca := casesReadWrite[6]
+	require.Equal(t, "v2 frame with decoded message, signed", ca.name)
+
+	reader := &Reader{
+		BufByteReader: bufio.NewReaderSize(&repeatReader{buf: ca.raw}, bufferSize),
+		DialectRW:     ca.dialectRW,
+	}
+	err := reader.Initialize()
+	require.NoError(t, err)
+
+	mp := ca.dialectRW.GetMessage(0)
+	var fb FrameBuffer
+	var msg MessageHeartbeat
+
+	allocs := testing.AllocsPerRun(100, func() {
+		fr, err2 := reader.ReadInto(&fb)
+		if err2 != nil {
+			panic(err2)
+		}
+
+		err2 = mp.ReadInto(fr.GetMessage().(*message.MessageRaw), true, &msg)
+		if err2 != nil {
+			panic(err2)
+		}
+	})
+	require.Equal(t, float64(0), allocs)
+	require.Equal(t, ca.frame.GetMessage(), &msg)
+}
+
+func BenchmarkReaderRead(b *testing.B) {
+	ca := casesReadWrite[6]
+
+	reader := &Reader{
+		BufByteReader: bufio.NewReaderSize(&repeatReader{buf: ca.raw}, bufferSize),
+		DialectRW:     ca.dialectRW,
+	}
+	err := reader.Initialize()
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, err = reader.Read()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReaderReadInto(b *testing.B) {
+	ca := casesReadWrite[6]
+
+	reader := &Reader{
+		BufByteReader: bufio.NewReaderSize(&repeatReader{buf: ca.raw}, bufferSize),
+		DialectRW:     ca.dialectRW,
+	}
+	err := reader.Initialize()
+	require.NoError(b, err)
+
+	mp := ca.dialectRW.GetMessage(0)
+	var fb FrameBuffer
+	var msg MessageHeartbeat
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		var fr Frame
+		fr, err = reader.ReadInto(&fb)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		err = mp.ReadInto(fr.GetMessage().(*message.MessageRaw), true, &msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestReaderErrorSignatureTimestamp(t *testing.T) {
 	var buf bytes.Buffer
 
@@ -490,3 +613,104 @@ func TestReaderErrorSignatureTimestamp(t *testing.T) {
 	_, err = reader.Read()
 	require.EqualError(t, err, "signature timestamp is too old")
 }
+
+func TestReaderMessageTable(t *testing.T) {
+	table, err := dialect.NewMessageTable(&dialect.Dialect{
+		Version:  3,
+		Messages: []message.Message{&MessageHeartbeat{}},
+	})
+	require.NoError(t, err)
+
+	for _, ca := range []struct {
+		name   string
+		frame  Frame
+		crc    byte
+		drop   bool
+		errStr string
+	}{
+		{
+			"v1 valid",
+			&V1Frame{Message: &message.MessageRaw{ID: 0, Payload: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}}},
+			50,
+			false,
+			"",
+		},
+		{
+			"v2 valid, truncated",
+			&V2Frame{Message: &message.MessageRaw{ID: 0, Payload: []byte{1, 2, 3, 4}}},
+			50,
+			false,
+			"",
+		},
+		{
+			"unknown",
+			&V2Frame{Message: &message.MessageRaw{ID: 12, Payload: []byte{1, 2, 3, 4}}},
+			0,
+			false,
+			"",
+		},
+		{
+			"wrong checksum",
+			&V2Frame{Message: &message.MessageRaw{ID: 0, Payload: []byte{1, 2, 3, 4}}},
+			51,
+			false,
+			"wrong checksum, expected 0d8b, got 1c02, message id is 0",
+		},
+		{
+			"v1 wrong length",
+			&V1Frame{Message: &message.MessageRaw{ID: 0, Payload: []byte{1, 2, 3, 4}}},
+			50,
+			false,
+			"wrong payload length, expected 9, got 4, message id is 0",
+		},
+		{
+			"v2 wrong length",
+			&V2Frame{Message: &message.MessageRaw{ID: 0, Payload: bytes.Repeat([]byte{1}, 10)}},
+			50,
+			false,
+			"wrong payload length, expected at most 9, got 10, message id is 0",
+		},
+		{
+			"unknown, dropped",
+			&V2Frame{Message: &message.MessageRaw{ID: 12, Payload: []byte{1, 2, 3, 4}}},
+			0,
+			true,
+			"unknown message id 12",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			switch f := ca.frame.(type) {
+			case *V1Frame:
+				f.Checksum = f.GenerateChecksum(ca.crc)
+			case *V2Frame:
+				f.Checksum = f.GenerateChecksum(ca.crc)
+			}
+
+			var buf bytes.Buffer
+			writer := &Writer{ByteWriter: &buf}
+			err = writer.Initialize()
+			require.NoError(t, err)
+
+			err = writer.Write(ca.frame)
+			require.NoError(t, err)
+
+			reader := &Reader{
+				BufByteReader:       bufio.NewReaderSize(&buf, bufferSize),
+				MessageTable:        table,
+				DropUnknownMessages: ca.drop,
+			}
+			err = reader.Initialize()
+			require.NoError(t, err)
+
+			var fr Frame
+			fr, err = reader.Read()
+			if ca.errStr != "" {
+				require.EqualError(t, err, ca.errStr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, ca.frame, fr)
+		})
+	}
+}
@@ -17,6 +17,13 @@ type ReadWriter struct {
 	// If not provided, messages are decoded into the MessageRaw struct.
 	DialectRW *dialect.ReadWriter
 
+	// (optional) table used to validate the checksum and the length
+	// of messages that are not in the dialect.
+	MessageTable dialect.MessageTable
+
+	// (optional) discard messages that are neither in the dialect nor in MessageTable.
+	DropUnknownMessages bool
+
 	// (optional) secret key used to validate incoming frames.
 	// Non-signed frames are discarded. This feature requires v2 frames.
 	InKey *V2Key
@@ -32,9 +39,11 @@ func (rw *ReadWriter) Initialize() error {
 	}
 
 	r := &Reader{
-		BufByteReader: bufio.NewReaderSize(rw.ByteReadWriter, bufferSize),
-		DialectRW:     rw.DialectRW,
-		InKey:         rw.InKey,
+		BufByteReader:       bufio.NewReaderSize(rw.ByteReadWriter, bufferSize),
+		DialectRW:           rw.DialectRW,
+		MessageTable:        rw.MessageTable,
+		DropUnknownMessages: rw.DropUnknownMessages,
+		InKey:               rw.InKey,
 	}
 	err := r.Initialize()
 	if err != nil {
@@ -24,6 +24,23 @@ func peekAndDiscard(br *bufio.Reader, size int) ([]byte, error) {
 	return buf, nil
 }
 
+// readPayload reads a payload into msg, reusing its buffer when possible.
+func readPayload(br *bufio.Reader, msg *message.MessageRaw, msgLen byte) error {
+	if msgLen == 0 {
+		msg.Payload = msg.Payload[:0]
+		return nil
+	}
+
+	if cap(msg.Payload) >= int(msgLen) {
+		msg.Payload = msg.Payload[:msgLen]
+	} else {
+		msg.Payload = make([]byte, msgLen)
+	}
+
+	_, err := io.ReadFull(br, msg.Payload)
+	return err
+}
+
 // V1Frame is a Mavlink V1 frame.
 type V1Frame struct {
 	SequenceNumber byte
@@ -75,7 +92,7 @@ func (f V1Frame) GenerateChecksum(crcExtra byte) uint16 {
 	return h.Sum16()
 }
 
-func (f *V1Frame) unmarshal(br *bufio.Reader) error {
+func (f *V1Frame) unmarshal(br *bufio.Reader, msg *message.MessageRaw, _ *V2Signature) error {
 	// header
 	buf, err := peekAndDiscard(br, 5)
 	if err != nil {
@@ -89,18 +106,12 @@ func (f *V1Frame) unmarshal(br *bufio.Reader) error {
 	msgID := buf[4]
 
 	// message
-	var msgEncoded []byte
-	if msgLen > 0 {
-		msgEncoded = make([]byte, msgLen)
-		_, err = io.ReadFull(br, msgEncoded)
-		if err != nil {
-			return err
-		}
-	}
-	f.Message = &message.MessageRaw{
-		ID:      uint32(msgID),
-		Payload: msgEncoded,
+	msg.ID = uint32(msgID)
+	err = readPayload(br, msg, msgLen)
+	if err != nil {
+		return err
 	}
+	f.Message = msg
 
 	// checksum
 	buf, err = peekAndDiscard(br, 2)
@@ -5,7 +5,6 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
-	"io"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/message"
 	"github.com/bluenviron/gomavlib/v4/pkg/x25"
@@ -123,36 +122,45 @@ func (f V2Frame) GenerateChecksum(crcExtra byte) uint16 {
 
 // GenerateSignature generates the frame signature.
 func (f V2Frame) GenerateSignature(key *V2Key) *V2Signature {
+	sig := new(V2Signature)
+	f.GenerateSignatureInto(key, sig)
+	return sig
+}
+
+// GenerateSignatureInto generates the frame signature and writes it into sig.
+// Unlike GenerateSignature, it does not allocate.
+func (f V2Frame) GenerateSignatureInto(key *V2Key, sig *V2Signature) {
 	msg := f.GetMessage().(*message.MessageRaw)
-	h := sha256.New()
+
+	// secret key + header + payload + checksum + link id + timestamp
+	var buf [32 + 10 + 255 + 2 + 1 + 6]byte
 
 	// secret key
-	h.Write(key[:])
+	n := copy(buf[:], key[:])
 
 	// the signature covers the whole message, excluding the signature itself
-	buf := make([]byte, 6)
-	h.Write([]byte{V2MagicByte})
-	h.Write([]byte{byte(len(msg.Payload))})
-	h.Write([]byte{f.IncompatibilityFlag})
-	h.Write([]byte{f.CompatibilityFlag})
-	h.Write([]byte{f.SequenceNumber})
-	h.Write([]byte{f.SystemID})
-	h.Write([]byte{f.ComponentID})
-	uint24Encode(buf, msg.GetID())
-	h.Write(buf[:3])
-	h.Write(msg.Payload)
-	binary.LittleEndian.PutUint16(buf, f.Checksum)
-	h.Write(buf[:2])
-	h.Write([]byte{f.SignatureLinkID})
-	uint48Encode(buf, f.SignatureTimestamp)
-	h.Write(buf)
+	buf[n] = V2MagicByte
+	buf[n+1] = byte(len(msg.Payload))
+	buf[n+2] = f.IncompatibilityFlag
+	buf[n+3] = f.CompatibilityFlag
+	buf[n+4] = f.SequenceNumber
+	buf[n+5] = f.SystemID
+	buf[n+6] = f.ComponentID
+	uint24Encode(buf[n+7:], msg.GetID())
+	n += 10
+	n += copy(buf[n:], msg.Payload)
+	binary.LittleEndian.PutUint16(buf[n:], f.Checksum)
+	n += 2
+	buf[n] = f.SignatureLinkID
+	n++
+	uint48Encode(buf[n:], f.SignatureTimestamp)
+	n += 6
 
-	sig := new(V2Signature)
-	copy(sig[:], h.Sum(nil)[:6])
-	return sig
+	sum := sha256.Sum256(buf[:n])
+	copy(sig[:], sum[:6])
 }
 
-func (f *V2Frame) unmarshal(br *bufio.Reader) error {
+func (f *V2Frame) unmarshal(br *bufio.Reader, msg *message.MessageRaw, sig *V2Signature) error {
 	// header
 	buf, err := peekAndDiscard(br, 9)
 	if err != nil {
@@ -173,18 +181,12 @@ func (f *V2Frame) unmarshal(br *bufio.Reader) error {
 	}
 
 	// message
-	var msgEncoded []byte
-	if msgLen > 0 {
-		msgEncoded = make([]byte, msgLen)
-		_, err = io.ReadFull(br, msgEncoded)
-		if err != nil {
-			return err
-		}
-	}
-	f.Message = &message.MessageRaw{
-		ID:      msgID,
-		Payload: msgEncoded,
+	msg.ID = msgID
+	err = readPayload(br, msg, msgLen)
+	if err != nil {
+		return err
 	}
+	f.Message = msg
 
 	// checksum
 	buf, err = peekAndDiscard(br, 2)
@@ -202,8 +204,11 @@ func (f *V2Frame) unmarshal(br *bufio.Reader) error {
 
 		f.SignatureLinkID = buf[0]
 		f.SignatureTimestamp = uint48Decode(buf[1:])
-		f.Signature = new(V2Signature)
-		copy(f.Signature[:], buf[7:])
+		if sig == nil {
+			sig = new(V2Signature)
+		}
+		copy(sig[:], buf[7:])
+		f.Signature = sig
 	}
 
 	return nil
@@ -1,11 +1,13 @@
 package frame_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/bluenviron/gomavlib/v4/pkg/frame"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
 )
 
 func TestV2Frame(t *testing.T) {
@@ -21,3 +23,27 @@ func TestV2Frame(t *testing.T) {
 	require.Equal(t, uint8(123), f.GetSequenceNumber())
 	require.Equal(t, uint16(31415), f.GetChecksum())
 }
+
+func TestV2FrameGenerateSignatureInto(t *testing.T) {
+	f := &frame.V2Frame{
+		IncompatibilityFlag: frame.V2FlagSigned,
+		SequenceNumber:      123,
+		SystemID:            56,
+		ComponentID:         89,
+		Message: &message.MessageRaw{
+			ID:      4,
+			Payload: bytes.Repeat([]byte{0x10}, 255),
+		},
+		Checksum:           31415,
+		SignatureLinkID:    1,
+		SignatureTimestamp: 2,
+	}
+	key := frame.NewV2Key(bytes.Repeat([]byte("\x4F"), 32))
+
+	var sig frame.V2Signature
+	allocs := testing.AllocsPerRun(100, func() {
+		f.GenerateSignatureInto(key, &sig)
+	})
+	require.Equal(t, float64(0), allocs)
+	require.Equal(t, f.GenerateSignature(key), &sig)
+}
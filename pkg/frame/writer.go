@@ -55,35 +55,47 @@ func (w *Writer) Initialize() error {
 // Write writes a Frame.
 // It must not be called by multiple routines in parallel.
 func (w *Writer) Write(fr Frame) error {
+	n, err := w.encodeTo(w.bw, fr)
+	if err != nil {
+		return err
+	}
+
+	// do not check n, since io.Writer is not allowed to return n < len(buf)
+	// without throwing an error
+	_, err = w.ByteWriter.Write(w.bw[:n])
+	return err
+}
+
+// Encode encodes a Frame into bytes, without writing it.
+// The returned buffer is not reused by the Writer and can be shared
+// among multiple routines or written multiple times.
+func (w *Writer) Encode(fr Frame) ([]byte, error) {
+	buf := make([]byte, bufferSize)
+	n, err := w.encodeTo(buf, fr)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func (w *Writer) encodeTo(buf []byte, fr Frame) (int, error) {
 	if fr.GetMessage() == nil {
-		return fmt.Errorf("message is nil")
+		return 0, fmt.Errorf("message is nil")
 	}
 
 	// encode message if it is not already encoded
 	if _, ok := fr.GetMessage().(*message.MessageRaw); !ok {
 		if w.DialectRW == nil {
-			return fmt.Errorf("dialect is nil")
+			return 0, fmt.Errorf("dialect is nil")
 		}
 
 		mp := w.DialectRW.GetMessage(fr.GetMessage().GetID())
 		if mp == nil {
-			return fmt.Errorf("message is not in the dialect")
+			return 0, fmt.Errorf("message is not in the dialect")
 		}
 
 		encodeMessageInFrame(fr, mp)
 	}
 
-	return w.writeFrameInner(fr)
-}
-
-func (w *Writer) writeFrameInner(fr Frame) error {
-	n, err := fr.marshalTo(w.bw, fr.GetMessage().(*message.MessageRaw).Payload)
-	if err != nil {
-		return err
-	}
-
-	// do not check n, since io.Writer is not allowed to return n < len(buf)
-	// without throwing an error
-	_, err = w.ByteWriter.Write(w.bw[:n])
-	return err
+	return fr.marshalTo(buf, fr.GetMessage().(*message.MessageRaw).Payload)
 }
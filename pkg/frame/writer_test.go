@@ -38,6 +38,29 @@ func TestWriterWrite(t *testing.T) {
 	}
 }
 
+func TestWriterEncode(t *testing.T) {
+	for _, ca := range casesReadWrite {
+		switch ca.name {
+		case "v2 frame with missing empty byte truncation",
+			"v1 frame with junk after string termination":
+			continue
+		}
+
+		t.Run(ca.name, func(t *testing.T) {
+			writer := &Writer{
+				ByteWriter: &bytes.Buffer{},
+				DialectRW:  ca.dialectRW,
+			}
+			err := writer.Initialize()
+			require.NoError(t, err)
+
+			byts, err := writer.Encode(ca.frame)
+			require.NoError(t, err)
+			require.Equal(t, ca.raw, byts)
+		})
+	}
+}
+
 func TestWriterWriteErrors(t *testing.T) {
 	for _, ca := range []struct {
 		name      string
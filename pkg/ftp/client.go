@@ -0,0 +1,217 @@
+// Package ftp contains a client of the MAVLink FTP protocol.
+//
+// Specification: https://mavlink.io/en/services/ftp.html
+package ftp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+const (
+	defaultTimeout = 1 * time.Second
+	defaultRetries = 3
+
+	// the file size is sent by the server, therefore it can't be trusted
+	// when allocating memory.
+	maxPreallocatedSize = 1024 * 1024
+)
+
+// Client is a MAVLink FTP client.
+// Responses must be passed to Handle(), that can be called from a different goroutine:
+//
+//	go func() {
+//		for evt := range node.Events() {
+//			client.Handle(evt)
+//		}
+//	}()
+//
+//	buf, err := client.ReadFile(ctx, "/etc/extras/component_general.json.xz")
+//
+// Operations are executed one at a time.
+type Client struct {
+	// function used to send messages to the server.
+	Write func(msg message.Message) error
+
+	// system and component ID of the server.
+	TargetSystem    uint8
+	TargetComponent uint8
+
+	// (optional) time to wait for a response. It defaults to 1 second.
+	Timeout time.Duration
+
+	// (optional) number of times a request is repeated before failing. It defaults to 3.
+	Retries int
+
+	opMutex   sync.Mutex
+	seq       uint16
+	responses chan *payload
+}
+
+// Initialize initializes a Client.
+func (c *Client) Initialize() error {
+	if c.Write == nil {
+		return fmt.Errorf("Write is not set")
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.Retries == 0 {
+		c.Retries = defaultRetries
+	}
+
+	c.responses = make(chan *payload, 16)
+
+	return nil
+}
+
+// Handle processes an event of a Node.
+func (c *Client) Handle(evt gomavlib.Event) {
+	if evt, ok := evt.(*gomavlib.EventFrame); ok {
+		c.HandleMessage(evt.SystemID(), evt.ComponentID(), evt.Message())
+	}
+}
+
+// HandleMessage processes a message sent by a system and component.
+func (c *Client) HandleMessage(systemID uint8, componentID uint8, msg message.Message) {
+	m, ok := msg.(*common.MessageFileTransferProtocol)
+	if !ok || systemID != c.TargetSystem || componentID != c.TargetComponent {
+		return
+	}
+
+	var p payload
+	if p.unmarshal(m.Payload) != nil {
+		return
+	}
+
+	// responses that are not awaited are discarded
+	select {
+	case c.responses <- &p:
+	default:
+	}
+}
+
+// do sends a request and waits for the corresponding response.
+func (c *Client) do(ctx context.Context, req *payload) (*payload, error) {
+	c.seq++
+	req.seq = c.seq
+
+	msg := &common.MessageFileTransferProtocol{
+		TargetSystem:    c.TargetSystem,
+		TargetComponent: c.TargetComponent,
+		Payload:         req.marshal(),
+	}
+
+	for range c.Retries + 1 {
+		err := c.Write(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := c.wait(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+		if !errors.Is(err, errTimeout) {
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("%s: no response", req.opcode)
+}
+
+var errTimeout = errors.New("timeout")
+
+func (c *Client) wait(ctx context.Context, req *payload) (*payload, error) {
+	timer := time.NewTimer(c.Timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case res := <-c.responses:
+			if res.seq != req.seq+1 || res.reqOpcode != req.opcode {
+				continue
+			}
+
+			if res.opcode == common.MAV_FTP_OPCODE_NAK {
+				return nil, errorFromNAK(res)
+			}
+
+			return res, nil
+
+		case <-timer.C:
+			return nil, errTimeout
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// ReadFile reads a file.
+func (c *Client) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	if len(path) > maxDataSize {
+		return nil, fmt.Errorf("path is too long")
+	}
+
+	c.opMutex.Lock()
+	defer c.opMutex.Unlock()
+
+	res, err := c.do(ctx, &payload{
+		opcode: common.MAV_FTP_OPCODE_OPENFILERO,
+		data:   []byte(path),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.data) < 4 {
+		return nil, fmt.Errorf("invalid response to %s", common.MAV_FTP_OPCODE_OPENFILERO)
+	}
+
+	session := res.session
+	size := binary.LittleEndian.Uint32(res.data)
+
+	defer c.do(context.Background(), &payload{ //nolint:errcheck
+		opcode:  common.MAV_FTP_OPCODE_TERMINATESESSION,
+		session: session,
+	})
+
+	buf := make([]byte, 0, min(size, maxPreallocatedSize))
+
+	for uint32(len(buf)) < size {
+		res, err = c.do(ctx, &payload{
+			opcode:   common.MAV_FTP_OPCODE_READFILE,
+			session:  session,
+			offset:   uint32(len(buf)),
+			readSize: maxDataSize,
+		})
+		if err != nil {
+			var ftpErr *Error
+			if errors.As(err, &ftpErr) && ftpErr.Code == common.MAV_FTP_ERR_EOF {
+				break
+			}
+			return nil, err
+		}
+
+		if res.offset != uint32(len(buf)) || len(res.data) == 0 {
+			return nil, fmt.Errorf("invalid response to %s", common.MAV_FTP_OPCODE_READFILE)
+		}
+
+		buf = append(buf, res.data...)
+	}
+
+	if uint32(len(buf)) != size {
+		return nil, fmt.Errorf("file size is %d, but %d bytes were read", size, len(buf))
+	}
+
+	return buf, nil
+}
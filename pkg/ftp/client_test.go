@@ -0,0 +1,177 @@
+package ftp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/ftp"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+// testServer is a minimal server that serves files in read-only mode.
+type testServer struct {
+	files    map[string][]byte
+	client   *ftp.Client
+	dropNext bool
+	size     uint32
+	opcodes  []common.MAV_FTP_OPCODE
+	session  []byte
+}
+
+func (s *testServer) write(msg message.Message) error {
+	req := msg.(*common.MessageFileTransferProtocol).Payload
+	opcode := common.MAV_FTP_OPCODE(req[3])
+	s.opcodes = append(s.opcodes, opcode)
+
+	if s.dropNext {
+		s.dropNext = false
+		return nil
+	}
+
+	var res [251]uint8
+	binary.LittleEndian.PutUint16(res[0:], binary.LittleEndian.Uint16(req[0:])+1)
+	res[2] = req[2]
+	res[3] = uint8(common.MAV_FTP_OPCODE_ACK)
+	res[5] = uint8(opcode)
+	copy(res[8:12], req[8:12])
+
+	nak := func(code common.MAV_FTP_ERR) {
+		res[3] = uint8(common.MAV_FTP_OPCODE_NAK)
+		res[4] = 1
+		res[12] = uint8(code)
+	}
+
+	switch opcode {
+	case common.MAV_FTP_OPCODE_OPENFILERO:
+		f, ok := s.files[string(req[12:12+req[4]])]
+		if !ok {
+			nak(common.MAV_FTP_ERR_FILENOTFOUND)
+			break
+		}
+		s.session = f
+		res[2] = 3
+		res[4] = 4
+		size := uint32(len(f))
+		if s.size != 0 {
+			size = s.size
+		}
+		binary.LittleEndian.PutUint32(res[12:], size)
+
+	case common.MAV_FTP_OPCODE_READFILE:
+		offset := int(binary.LittleEndian.Uint32(req[8:]))
+		if offset >= len(s.session) {
+			nak(common.MAV_FTP_ERR_EOF)
+			break
+		}
+		n := copy(res[12:12+min(int(req[4]), 239)], s.session[offset:])
+		res[4] = uint8(n)
+
+	case common.MAV_FTP_OPCODE_TERMINATESESSION:
+		s.session = nil
+
+	default:
+		nak(common.MAV_FTP_ERR_UNKNOWNCOMMAND)
+	}
+
+	s.client.HandleMessage(1, 1, &common.MessageFileTransferProtocol{Payload: res})
+	return nil
+}
+
+func newTestClient(t *testing.T, s *testServer) *ftp.Client {
+	c := &ftp.Client{
+		Write:           s.write,
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Timeout:         50 * time.Millisecond,
+		Retries:         1,
+	}
+	err := c.Initialize()
+	require.NoError(t, err)
+	s.client = c
+	return c
+}
+
+func TestClientReadFile(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 60)
+
+	s := &testServer{files: map[string][]byte{"/test.json": content}}
+	c := newTestClient(t, s)
+
+	// responses of other components are ignored
+	c.HandleMessage(1, 2, &common.MessageFileTransferProtocol{})
+
+	buf, err := c.ReadFile(context.Background(), "/test.json")
+	require.NoError(t, err)
+	require.Equal(t, content, buf)
+
+	require.Equal(t, []common.MAV_FTP_OPCODE{
+		common.MAV_FTP_OPCODE_OPENFILERO,
+		common.MAV_FTP_OPCODE_READFILE,
+		common.MAV_FTP_OPCODE_READFILE,
+		common.MAV_FTP_OPCODE_READFILE,
+		common.MAV_FTP_OPCODE_TERMINATESESSION,
+	}, s.opcodes)
+}
+
+func TestClientRetry(t *testing.T) {
+	s := &testServer{
+		files:    map[string][]byte{"/test.json": []byte("{}")},
+		dropNext: true,
+	}
+	c := newTestClient(t, s)
+
+	buf, err := c.ReadFile(context.Background(), "/test.json")
+	require.NoError(t, err)
+	require.Equal(t, []byte("{}"), buf)
+	require.Equal(t, common.MAV_FTP_OPCODE_OPENFILERO, s.opcodes[1])
+}
+
+func TestClientWrongSize(t *testing.T) {
+	s := &testServer{
+		files: map[string][]byte{"/test.json": []byte("{}")},
+		size:  math.MaxUint32,
+	}
+	c := newTestClient(t, s)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	_, err := c.ReadFile(context.Background(), "/test.json")
+	require.EqualError(t, err, "file size is 4294967295, but 2 bytes were read")
+
+	// memory is not allocated according to the size declared by the server
+	runtime.ReadMemStats(&after)
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64*1024*1024))
+}
+
+func TestClientErrors(t *testing.T) {
+	s := &testServer{files: map[string][]byte{}}
+	c := newTestClient(t, s)
+
+	_, err := c.ReadFile(context.Background(), "/missing.json")
+	require.EqualError(t, err, "MAV_FTP_OPCODE_OPENFILERO failed: MAV_FTP_ERR_FILENOTFOUND")
+
+	c = &ftp.Client{
+		Write:   func(message.Message) error { return nil },
+		Timeout: 10 * time.Millisecond,
+		Retries: 1,
+	}
+	err = c.Initialize()
+	require.NoError(t, err)
+
+	_, err = c.ReadFile(context.Background(), "/test.json")
+	require.EqualError(t, err, "MAV_FTP_OPCODE_OPENFILERO: no response")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = c.ReadFile(ctx, "/test.json")
+	require.ErrorIs(t, err, context.Canceled)
+}
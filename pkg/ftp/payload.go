@@ -0,0 +1,96 @@
+package ftp
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+const (
+	headerSize  = 12
+	maxDataSize = 251 - headerSize
+)
+
+// payload is the content of the payload field of FILE_TRANSFER_PROTOCOL.
+type payload struct {
+	seq           uint16
+	session       uint8
+	opcode        common.MAV_FTP_OPCODE
+	reqOpcode     common.MAV_FTP_OPCODE
+	burstComplete bool
+	offset        uint32
+	data          []byte
+
+	// size of the data to read, used by requests without data.
+	readSize uint8
+}
+
+func (p *payload) marshal() [251]uint8 {
+	var buf [251]uint8
+	binary.LittleEndian.PutUint16(buf[0:], p.seq)
+	buf[2] = p.session
+	buf[3] = uint8(p.opcode)
+	if p.readSize != 0 {
+		buf[4] = p.readSize
+	} else {
+		buf[4] = uint8(len(p.data))
+	}
+	buf[5] = uint8(p.reqOpcode)
+	if p.burstComplete {
+		buf[6] = 1
+	}
+	binary.LittleEndian.PutUint32(buf[8:], p.offset)
+	copy(buf[headerSize:], p.data)
+	return buf
+}
+
+func (p *payload) unmarshal(buf [251]uint8) error {
+	size := int(buf[4])
+	if size > maxDataSize {
+		return fmt.Errorf("invalid data size (%d)", size)
+	}
+
+	p.seq = binary.LittleEndian.Uint16(buf[0:])
+	p.session = buf[2]
+	p.opcode = common.MAV_FTP_OPCODE(buf[3])
+	p.reqOpcode = common.MAV_FTP_OPCODE(buf[5])
+	p.burstComplete = buf[6] != 0
+	p.offset = binary.LittleEndian.Uint32(buf[8:])
+	p.data = append([]byte(nil), buf[headerSize:headerSize+size]...)
+	return nil
+}
+
+// Error is an error returned by the server.
+type Error struct {
+	// request that failed.
+	Opcode common.MAV_FTP_OPCODE
+
+	// error code.
+	Code common.MAV_FTP_ERR
+
+	// errno, filled when Code is MAV_FTP_ERR_FAILERRNO.
+	Errno uint8
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Code == common.MAV_FTP_ERR_FAILERRNO {
+		return fmt.Sprintf("%s failed: %s (errno %d)", e.Opcode, e.Code, e.Errno)
+	}
+	return fmt.Sprintf("%s failed: %s", e.Opcode, e.Code)
+}
+
+func errorFromNAK(p *payload) *Error {
+	e := &Error{
+		Opcode: p.reqOpcode,
+		Code:   common.MAV_FTP_ERR_FAIL,
+	}
+	if len(p.data) >= 1 {
+		e.Code = common.MAV_FTP_ERR(p.data[0])
+	}
+	if len(p.data) >= 2 {
+		e.Errno = p.data[1]
+	}
+	return e
+}
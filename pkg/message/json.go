@@ -0,0 +1,237 @@
+package message
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// EncodeJSONFloat encodes a float into a JSON value.
+// Since NaN and infinite values are not supported by JSON,
+// they are encoded into the strings "NaN", "+Inf" and "-Inf".
+func EncodeJSONFloat(v float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`)
+	case math.IsInf(v, 1):
+		return []byte(`"+Inf"`)
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`)
+	}
+	return strconv.AppendFloat(nil, v, 'g', -1, bitSize)
+}
+
+// DecodeJSONFloat decodes a float from a JSON value.
+// It is the inverse of EncodeJSONFloat.
+func DecodeJSONFloat(raw json.RawMessage) (float64, error) {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "+Inf":
+			return math.Inf(1), nil
+		case "-Inf":
+			return math.Inf(-1), nil
+		}
+		return 0, fmt.Errorf("invalid float '%s'", s)
+	}
+
+	var v float64
+	err := json.Unmarshal(raw, &v)
+	return v, err
+}
+
+func encodeJSONValue(buf *bytes.Buffer, v reflect.Value, f *decEncoderField) error {
+	if f.isEnum {
+		if tm, ok := v.Interface().(encoding.TextMarshaler); ok {
+			label, err := tm.MarshalText()
+			if err != nil {
+				return err
+			}
+			enc, _ := json.Marshal(string(label))
+			buf.Write(enc)
+			return nil
+		}
+		buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Float32:
+		buf.Write(EncodeJSONFloat(v.Float(), 32))
+
+	case reflect.Float64:
+		buf.Write(EncodeJSONFloat(v.Float(), 64))
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		buf.WriteString(strconv.FormatInt(v.Int(), 10))
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+
+	case reflect.String:
+		enc, _ := json.Marshal(v.String())
+		buf.Write(enc)
+	}
+
+	return nil
+}
+
+func decodeJSONValue(v reflect.Value, raw json.RawMessage, f *decEncoderField) error {
+	if f.isEnum {
+		if tu, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			var label string
+			if json.Unmarshal(raw, &label) == nil {
+				return tu.UnmarshalText([]byte(label))
+			}
+		}
+
+		var n uint64
+		err := json.Unmarshal(raw, &n)
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		n, err := DecodeJSONFloat(raw)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+		return nil
+
+	case reflect.String:
+		var s string
+		err := json.Unmarshal(raw, &s)
+		if err != nil {
+			return err
+		}
+		if len(s) > int(f.arrayLength) {
+			return fmt.Errorf("string is too long (%d > %d)", len(s), f.arrayLength)
+		}
+		v.SetString(s)
+		return nil
+	}
+
+	// integers
+	return json.Unmarshal(raw, v.Addr().Interface())
+}
+
+// EncodeJSON encodes a message into JSON.
+// Keys are the field names of the XML definition, in the same order,
+// enums are encoded into their labels and NaN or infinite floats are encoded as strings.
+func (rw *ReadWriter) EncodeJSON(msg Message) ([]byte, error) {
+	if rw.dynamic {
+		return json.Marshal(msg)
+	}
+
+	if reflect.TypeOf(msg).Elem() != rw.elemType {
+		return nil, fmt.Errorf("wrong message type: expected %v, got %T", rw.elemType, msg)
+	}
+
+	rv := reflect.ValueOf(msg).Elem()
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, f := range rw.declFields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+
+		enc, _ := json.Marshal(f.name)
+		buf.Write(enc)
+		buf.WriteByte(':')
+
+		target := rv.Field(f.index)
+
+		if target.Kind() == reflect.Array {
+			buf.WriteByte('[')
+			for j := range target.Len() {
+				if j != 0 {
+					buf.WriteByte(',')
+				}
+				err := encodeJSONValue(&buf, target.Index(j), f)
+				if err != nil {
+					return nil, fmt.Errorf("field '%s': %w", f.name, err)
+				}
+			}
+			buf.WriteByte(']')
+		} else {
+			err := encodeJSONValue(&buf, target, f)
+			if err != nil {
+				return nil, fmt.Errorf("field '%s': %w", f.name, err)
+			}
+		}
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// DecodeJSON decodes a message from JSON.
+// It is the inverse of EncodeJSON. Enums can be provided as labels or as numbers.
+// Fields that are not provided are set to zero.
+func (rw *ReadWriter) DecodeJSON(buf []byte) (Message, error) {
+	if rw.dynamic {
+		msg := rw.Message.(Dynamic).NewMessage()
+		err := json.Unmarshal(buf, msg)
+		if err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+
+	var raws map[string]json.RawMessage
+	err := json.Unmarshal(buf, &raws)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := reflect.New(rw.elemType)
+	rv := msg.Elem()
+
+	for name, raw := range raws {
+		f, ok := rw.fieldsByName[name]
+		if !ok {
+			return nil, fmt.Errorf("field '%s' does not exist", name)
+		}
+
+		target := rv.Field(f.index)
+
+		if target.Kind() == reflect.Array {
+			var raws2 []json.RawMessage
+			err = json.Unmarshal(raw, &raws2)
+			if err != nil {
+				return nil, fmt.Errorf("field '%s': %w", name, err)
+			}
+
+			if len(raws2) > target.Len() {
+				return nil, fmt.Errorf("field '%s': array is too long (%d > %d)", name, len(raws2), target.Len())
+			}
+
+			for j, raw2 := range raws2 {
+				err = decodeJSONValue(target.Index(j), raw2, f)
+				if err != nil {
+					return nil, fmt.Errorf("field '%s': %w", name, err)
+				}
+			}
+		} else {
+			err = decodeJSONValue(target, raw, f)
+			if err != nil {
+				return nil, fmt.Errorf("field '%s': %w", name, err)
+			}
+		}
+	}
+
+	return msg.Interface().(Message), nil
+}
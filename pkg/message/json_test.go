@@ -0,0 +1,143 @@
+package message_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+func TestEncodeDecodeJSON(t *testing.T) {
+	for _, ca := range []struct {
+		name string
+		msg  message.Message
+		enc  string
+	}{
+		{
+			"enums",
+			&common.MessageHeartbeat{
+				Type:           common.MAV_TYPE_QUADROTOR,
+				Autopilot:      common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:       common.MAV_MODE_FLAG_SAFETY_ARMED | common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED,
+				CustomMode:     4,
+				SystemStatus:   common.MAV_STATE_ACTIVE,
+				MavlinkVersion: 3,
+			},
+			`{"type":"MAV_TYPE_QUADROTOR","autopilot":"MAV_AUTOPILOT_ARDUPILOTMEGA",` +
+				`"base_mode":"MAV_MODE_FLAG_SAFETY_ARMED | MAV_MODE_FLAG_CUSTOM_MODE_ENABLED","custom_mode":4,` +
+				`"system_status":"MAV_STATE_ACTIVE","mavlink_version":3}`,
+		},
+		{
+			"strings and extensions",
+			&common.MessageStatustext{
+				Severity: common.MAV_SEVERITY_INFO,
+				Text:     "hello \"world\"",
+				Id:       12,
+				ChunkSeq: 1,
+			},
+			`{"severity":"MAV_SEVERITY_INFO","text":"hello \"world\"","id":12,"chunk_seq":1}`,
+		},
+		{
+			"arrays and special floats",
+			&common.MessageAttitudeQuaternionCov{
+				TimeUsec:   123,
+				Q:          [4]float32{1, 0.5, float32(math.Inf(1)), float32(math.Inf(-1))},
+				Rollspeed:  -2.5,
+				Pitchspeed: 0,
+				Yawspeed:   1e20,
+				Covariance: [9]float32{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			},
+			`{"time_usec":123,"q":[1,0.5,"+Inf","-Inf"],"rollspeed":-2.5,"pitchspeed":0,"yawspeed":1e+20,` +
+				`"covariance":[1,2,3,4,5,6,7,8,9]}`,
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			rw := &message.ReadWriter{Message: ca.msg}
+			err := rw.Initialize()
+			require.NoError(t, err)
+
+			enc, err := rw.EncodeJSON(ca.msg)
+			require.NoError(t, err)
+			require.Equal(t, ca.enc, string(enc))
+
+			dec, err := rw.DecodeJSON(enc)
+			require.NoError(t, err)
+			require.Equal(t, ca.msg, dec)
+		})
+	}
+}
+
+func TestEncodeJSONNaN(t *testing.T) {
+	rw := &message.ReadWriter{Message: &common.MessageAttitude{}}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	enc, err := rw.EncodeJSON(&common.MessageAttitude{Roll: float32(math.NaN())})
+	require.NoError(t, err)
+	require.Equal(t, `{"time_boot_ms":0,"roll":"NaN","pitch":0,"yaw":0,"rollspeed":0,"pitchspeed":0,"yawspeed":0}`,
+		string(enc))
+
+	dec, err := rw.DecodeJSON(enc)
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(float64(dec.(*common.MessageAttitude).Roll)))
+}
+
+func TestDecodeJSONNumericEnums(t *testing.T) {
+	rw := &message.ReadWriter{Message: &common.MessageHeartbeat{}}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	dec, err := rw.DecodeJSON([]byte(`{"type":2,"base_mode":"MAV_MODE_FLAG_SAFETY_ARMED"}`))
+	require.NoError(t, err)
+	require.Equal(t, &common.MessageHeartbeat{
+		Type:     common.MAV_TYPE_QUADROTOR,
+		BaseMode: common.MAV_MODE_FLAG_SAFETY_ARMED,
+	}, dec)
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	for _, ca := range []struct {
+		name string
+		enc  string
+		err  string
+	}{
+		{
+			"missing field",
+			`{"missing":1}`,
+			"field 'missing' does not exist",
+		},
+		{
+			"invalid label",
+			`{"severity":"MISSING"}`,
+			"field 'severity': invalid label 'MISSING'",
+		},
+		{
+			"string too long",
+			`{"text":"` + strings.Repeat("a", 51) + `"}`,
+			"field 'text': string is too long (51 > 50)",
+		},
+		{
+			"invalid float",
+			`{"severity":1,"id":"NaN"}`,
+			"field 'id': json: cannot unmarshal string into Go value of type uint16",
+		},
+		{
+			"overflow",
+			`{"chunk_seq":256}`,
+			"field 'chunk_seq': json: cannot unmarshal number 256 into Go value of type uint8",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			rw := &message.ReadWriter{Message: &common.MessageStatustext{}}
+			err := rw.Initialize()
+			require.NoError(t, err)
+
+			_, err = rw.DecodeJSON([]byte(ca.enc))
+			require.EqualError(t, err, ca.err)
+		})
+	}
+}
@@ -7,6 +7,61 @@ type Message interface {
 	GetID() uint32
 }
 
+// Marshaler is implemented by messages that are able to encode and decode
+// themselves without using reflection.
+// It is implemented by messages generated by dialect-import.
+type Marshaler interface {
+	Message
+
+	// returns the CRC extra of the message.
+	MAVLinkCRCExtra() byte
+
+	// returns the size of the encoded message, before empty-byte truncation.
+	MAVLinkSize(isV2 bool) int
+
+	// encodes the message into buf, that must be at least MAVLinkSize() bytes long.
+	MarshalMAVLink(buf []byte, isV2 bool)
+
+	// decodes the message from buf, that must be at least MAVLinkSize() bytes long.
+	UnmarshalMAVLink(buf []byte, isV2 bool)
+}
+
+// Field describes a field of a message.
+type Field struct {
+	// name of the field, as written in the XML definition.
+	Name string
+
+	// type of the field, as written in the XML definition, without array length
+	// (i.e. "uint8_t", "float", "char").
+	Type string
+
+	// length of the array, or of the string in case of char fields.
+	// It is zero for scalar fields.
+	ArrayLength int
+
+	// whether the field is an enum.
+	IsEnum bool
+
+	// whether the field is an extension.
+	IsExtension bool
+}
+
+// Dynamic is implemented by messages whose definition is known at runtime only,
+// like the ones provided by the dynamic package.
+// Their fields are not inspected through reflection.
+type Dynamic interface {
+	Marshaler
+
+	// returns the name of the message, as written in the XML definition.
+	MAVLinkName() string
+
+	// returns the fields of the message, in wire order.
+	MAVLinkFields() []*Field
+
+	// allocates a message with the same definition, whose fields are set to zero.
+	NewMessage() Message
+}
+
 // MessageRaw is a special struct that contains an unencoded message.
 // It is used:
 //
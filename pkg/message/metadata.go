@@ -0,0 +1,290 @@
+package message
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var invalidConstants = map[string]string{
+	"INT8_MIN":   strconv.FormatInt(-1<<7, 10),
+	"INT8_MAX":   strconv.FormatInt(1<<7-1, 10),
+	"UINT8_MAX":  strconv.FormatUint(1<<8-1, 10),
+	"INT16_MIN":  strconv.FormatInt(-1<<15, 10),
+	"INT16_MAX":  strconv.FormatInt(1<<15-1, 10),
+	"UINT16_MAX": strconv.FormatUint(1<<16-1, 10),
+	"INT32_MIN":  strconv.FormatInt(-1<<31, 10),
+	"INT32_MAX":  strconv.FormatInt(1<<31-1, 10),
+	"UINT32_MAX": strconv.FormatUint(1<<32-1, 10),
+	"INT64_MIN":  strconv.FormatInt(-1<<63, 10),
+	"INT64_MAX":  strconv.FormatInt(1<<63-1, 10),
+	"UINT64_MAX": strconv.FormatUint(1<<64-1, 10),
+}
+
+func matchesInvalid(v reflect.Value, invalid string) bool {
+	invalid = strings.TrimSpace(invalid)
+	if c, ok := invalidConstants[invalid]; ok {
+		invalid = c
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if invalid == "NaN" {
+			return v.Float() != v.Float()
+		}
+		f, err := strconv.ParseFloat(invalid, 64)
+		return err == nil && v.Float() == f
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(invalid, 0, 64)
+		return err == nil && v.Int() == i
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(invalid, 0, 64)
+		return err == nil && v.Uint() == u
+	}
+
+	return false
+}
+
+// FieldMetadata contains metadata of a message field, as written in the XML definition.
+type FieldMetadata struct {
+	// name of the field.
+	Name string
+
+	// type of the field, without array length (i.e. "uint8_t", "float", "char").
+	Type string
+
+	// length of the array, or of the string in case of char fields.
+	// It is zero for scalar fields.
+	ArrayLength int
+
+	// whether the field is an extension.
+	Extension bool
+
+	// name of the enum of the field.
+	Enum string
+
+	// whether the field is a bitmask.
+	Bitmask bool
+
+	// units of the field (i.e. "cm/s", "degE7").
+	Units string
+
+	// description of the field.
+	Description string
+
+	// value that indicates that the field is not available (i.e. "UINT16_MAX", "NaN", "[UINT16_MAX]").
+	Invalid string
+
+	// multiplier that must be applied to the value (i.e. "1E-2").
+	Multiplier string
+
+	// minimum value of the field.
+	MinValue string
+
+	// maximum value of the field.
+	MaxValue string
+
+	// increment of the field.
+	Increment string
+
+	// whether the field is the instance number of a multi-instance message.
+	Instance bool
+
+	// format used to print the field.
+	PrintFormat string
+}
+
+// IsInvalid returns whether a value of the field is equal to the invalid value,
+// that indicates that the field is not available.
+// In case of arrays, Invalid can be "[value]", that requires all elements to be equal to value,
+// or "[value:]", that requires the first element to be equal to value.
+func (f *FieldMetadata) IsInvalid(v any) bool {
+	if f.Invalid == "" {
+		return false
+	}
+
+	rv := reflect.ValueOf(v)
+
+	if rv.Kind() == reflect.Array || rv.Kind() == reflect.Slice {
+		if rv.Len() == 0 {
+			return false
+		}
+
+		invalid := strings.TrimSuffix(strings.TrimPrefix(f.Invalid, "["), "]")
+
+		if first, ok := strings.CutSuffix(invalid, ":"); ok {
+			return matchesInvalid(rv.Index(0), first)
+		}
+
+		for i := range rv.Len() {
+			if !matchesInvalid(rv.Index(i), invalid) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return matchesInvalid(rv, f.Invalid)
+}
+
+// MessageMetadata contains metadata of a message, as written in the XML definition.
+type MessageMetadata struct {
+	// ID of the message.
+	ID uint32
+
+	// name of the message.
+	Name string
+
+	// description of the message.
+	Description string
+
+	// whether the message is deprecated.
+	Deprecated bool
+
+	// date since the message is deprecated (i.e. "2020-06").
+	DeprecatedSince string
+
+	// name of the message that replaces this one.
+	ReplacedBy string
+
+	// whether the message is a work in progress, that may change or be removed.
+	WIP bool
+
+	// fields of the message, in the order of the XML definition.
+	Fields []*FieldMetadata
+}
+
+// Field returns metadata of a field.
+func (m *MessageMetadata) Field(name string) *FieldMetadata {
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// EnumEntryMetadata contains metadata of an enum entry, as written in the XML definition.
+type EnumEntryMetadata struct {
+	// name of the entry.
+	Name string
+
+	// value of the entry.
+	Value uint64
+
+	// description of the entry.
+	Description string
+
+	// whether the entry is deprecated.
+	Deprecated bool
+
+	// whether the entry is a work in progress, that may change or be removed.
+	WIP bool
+}
+
+// EnumMetadata contains metadata of an enum, as written in the XML definition.
+type EnumMetadata struct {
+	// name of the enum.
+	Name string
+
+	// description of the enum.
+	Description string
+
+	// whether the enum is a bitmask.
+	Bitmask bool
+
+	// whether the enum is deprecated.
+	Deprecated bool
+
+	// whether the enum is a work in progress, that may change or be removed.
+	WIP bool
+
+	// entries of the enum, in the order of the XML definition.
+	Entries []*EnumEntryMetadata
+}
+
+// Entry returns the entry with the given value.
+func (e *EnumMetadata) Entry(value uint64) *EnumEntryMetadata {
+	for _, entry := range e.Entries {
+		if entry.Value == value {
+			return entry
+		}
+	}
+	return nil
+}
+
+// EntryByName returns the entry with the given name.
+func (e *EnumMetadata) EntryByName(name string) *EnumEntryMetadata {
+	for _, entry := range e.Entries {
+		if entry.Name == name {
+			return entry
+		}
+	}
+	return nil
+}
+
+// MetadataProvider is implemented by messages that are able to provide their metadata.
+// It is implemented by messages generated by dialect-import.
+type MetadataProvider interface {
+	Message
+
+	// returns metadata of the message.
+	MAVLinkMetadata() *MessageMetadata
+}
+
+// GetMetadata returns metadata of a message.
+// If the message does not implement MetadataProvider,
+// metadata is extracted from the message struct and contains names, types and enums only.
+func GetMetadata(msg Message) (*MessageMetadata, error) {
+	if mp, ok := msg.(MetadataProvider); ok {
+		if md := mp.MAVLinkMetadata(); md != nil {
+			return md, nil
+		}
+	}
+
+	rw := &ReadWriter{Message: msg}
+	err := rw.Initialize()
+	if err != nil {
+		return nil, err
+	}
+
+	md := &MessageMetadata{
+		ID:   msg.GetID(),
+		Name: rw.name,
+	}
+
+	if rw.dynamic {
+		for _, f := range rw.wireFields {
+			md.Fields = append(md.Fields, &FieldMetadata{
+				Name:        f.Name,
+				Type:        f.Type,
+				ArrayLength: f.ArrayLength,
+				Extension:   f.IsExtension,
+			})
+		}
+		return md, nil
+	}
+
+	for _, f := range rw.declFields {
+		fm := &FieldMetadata{
+			Name:        f.name,
+			Type:        fieldTypeString[f.ftype],
+			Extension:   f.isExtension,
+			ArrayLength: int(f.arrayLength),
+		}
+
+		if f.isEnum {
+			typ := rw.elemType.Field(f.index).Type
+			if typ.Kind() == reflect.Array {
+				typ = typ.Elem()
+			}
+			fm.Enum = typ.Name()
+		}
+
+		md.Fields = append(md.Fields, fm)
+	}
+
+	return md, nil
+}
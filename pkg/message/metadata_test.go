@@ -0,0 +1,114 @@
+package message_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+type MessageWithMetadata struct {
+	Value uint16
+}
+
+func (*MessageWithMetadata) GetID() uint32 {
+	return 12
+}
+
+func (*MessageWithMetadata) MAVLinkMetadata() *message.MessageMetadata {
+	return &message.MessageMetadata{
+		ID:   12,
+		Name: "WITH_METADATA",
+		Fields: []*message.FieldMetadata{
+			{Name: "value", Type: "uint16_t", Units: "cm", Invalid: "UINT16_MAX"},
+		},
+	}
+}
+
+func TestFieldMetadataIsInvalid(t *testing.T) {
+	for _, ca := range []struct {
+		name    string
+		invalid string
+		value   any
+		ok      bool
+	}{
+		{"none", "", uint16(0), false},
+		{"uint16 max", "UINT16_MAX", uint16(65535), true},
+		{"uint16 valid", "UINT16_MAX", uint16(65534), false},
+		{"int32 max", "INT32_MAX", int32(math.MaxInt32), true},
+		{"int16 min", "INT16_MIN", int16(math.MinInt16), true},
+		{"uint64 max", "UINT64_MAX", uint64(math.MaxUint64), true},
+		{"number", "-1", int8(-1), true},
+		{"hex", "0xFF", uint8(255), true},
+		{"nan", "NaN", float32(math.NaN()), true},
+		{"nan valid", "NaN", float32(1), false},
+		{"float number", "0", float64(0), true},
+		{"array all", "[UINT16_MAX]", [2]uint16{65535, 65535}, true},
+		{"array all valid", "[UINT16_MAX]", [2]uint16{65535, 1}, false},
+		{"array first", "[NaN:]", [3]float32{float32(math.NaN()), 1, 2}, true},
+		{"array first valid", "[NaN:]", [3]float32{0, float32(math.NaN()), 2}, false},
+		{"slice", "[0]", []uint8{0, 0}, true},
+		{"string", "0", "0", false},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			f := &message.FieldMetadata{Invalid: ca.invalid}
+			require.Equal(t, ca.ok, f.IsInvalid(ca.value))
+		})
+	}
+}
+
+func TestGetMetadata(t *testing.T) {
+	md, err := message.GetMetadata(&MessageWithMetadata{})
+	require.NoError(t, err)
+	require.Equal(t, "cm", md.Field("value").Units)
+	require.True(t, md.Field("value").IsInvalid(uint16(65535)))
+	require.Nil(t, md.Field("missing"))
+
+	// metadata extracted from the struct
+	md, err = message.GetMetadata(&MessageHeartbeat{})
+	require.NoError(t, err)
+	require.Equal(t, &message.MessageMetadata{
+		ID:   0,
+		Name: "HEARTBEAT",
+		Fields: []*message.FieldMetadata{
+			{Name: "type", Type: "uint8_t", Enum: "MAV_TYPE"},
+			{Name: "autopilot", Type: "uint8_t", Enum: "MAV_AUTOPILOT"},
+			{Name: "base_mode", Type: "uint8_t", Enum: "MAV_MODE_FLAG"},
+			{Name: "custom_mode", Type: "uint32_t"},
+			{Name: "system_status", Type: "uint8_t", Enum: "MAV_STATE"},
+			{Name: "mavlink_version", Type: "uint8_t"},
+		},
+	}, md)
+
+	md, err = message.GetMetadata(&common.MessageStatustext{})
+	require.NoError(t, err)
+	require.Equal(t, &message.FieldMetadata{
+		Name:        "text",
+		Type:        "char",
+		ArrayLength: 50,
+		Description: "Status text message, without null termination character. UTF-8 encoded.",
+	}, md.Field("text"))
+	require.Equal(t, "uint16_t", md.Field("id").Type)
+	require.True(t, md.Field("id").Extension)
+
+	_, err = message.GetMetadata(&Invalid{})
+	require.Error(t, err)
+}
+
+func TestEnumMetadataEntry(t *testing.T) {
+	e := &message.EnumMetadata{
+		Name: "A_TYPE",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "A_TYPE_A", Value: 0},
+			{Name: "A_TYPE_B", Value: 1, Deprecated: true},
+		},
+	}
+
+	require.Equal(t, e.Entries[1], e.Entry(1))
+	require.Nil(t, e.Entry(2))
+	require.Equal(t, e.Entries[0], e.EntryByName("A_TYPE_A"))
+	require.Nil(t, e.EntryByName("A_TYPE_C"))
+}
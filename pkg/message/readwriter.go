@@ -269,16 +269,34 @@ type ReadWriter struct {
 	Message Message
 
 	fields       []*decEncoderField
+	declFields   []*decEncoderField
+	fieldsByName map[string]*decEncoderField
+	name         string
+	wireFields   []*Field
 	sizeNormal   byte
 	sizeExtended byte
 	elemType     reflect.Type
 	crcExtra     byte
+	marshaler    bool
+	dynamic      bool
 }
 
 // Initialize initializes a ReadWriter.
 func (rw *ReadWriter) Initialize() error {
 	rw.elemType = reflect.TypeOf(rw.Message).Elem()
 
+	// definition is provided by the message itself
+	if m, ok := rw.Message.(Dynamic); ok {
+		rw.name = m.MAVLinkName()
+		rw.wireFields = m.MAVLinkFields()
+		rw.crcExtra = m.MAVLinkCRCExtra()
+		rw.sizeNormal = byte(m.MAVLinkSize(false))
+		rw.sizeExtended = byte(m.MAVLinkSize(true))
+		rw.marshaler = true
+		rw.dynamic = true
+		return nil
+	}
+
 	rw.fields = make([]*decEncoderField, rw.elemType.NumField())
 
 	// get name
@@ -286,6 +304,7 @@ func (rw *ReadWriter) Initialize() error {
 		return fmt.Errorf("struct name must begin with 'Message'")
 	}
 	msgName := msgGoToDef(rw.elemType.Name()[len("Message"):])
+	rw.name = msgName
 
 	// collect message fields
 	for i := 0; i < rw.elemType.NumField(); i++ {
@@ -380,6 +399,14 @@ func (rw *ReadWriter) Initialize() error {
 		}
 	}
 
+	rw.declFields = make([]*decEncoderField, len(rw.fields))
+	copy(rw.declFields, rw.fields)
+
+	rw.fieldsByName = make(map[string]*decEncoderField)
+	for _, f := range rw.fields {
+		rw.fieldsByName[f.name] = f
+	}
+
 	// reorder fields as described in
 	// https://mavlink.io/en/guide/serialization.html#field_reordering
 	sort.Slice(rw.fields, func(i, j int) bool {
@@ -393,6 +420,17 @@ func (rw *ReadWriter) Initialize() error {
 		return rw.fields[i].index < rw.fields[j].index
 	})
 
+	rw.wireFields = make([]*Field, len(rw.fields))
+	for i, f := range rw.fields {
+		rw.wireFields[i] = &Field{
+			Name:        f.name,
+			Type:        fieldTypeString[f.ftype],
+			ArrayLength: int(f.arrayLength),
+			IsEnum:      f.isEnum,
+			IsExtension: f.isExtension,
+		}
+	}
+
 	// generate CRC extra
 	// https://mavlink.io/en/guide/serialization.html#crc_extra
 	rw.crcExtra = func() byte {
@@ -416,6 +454,20 @@ func (rw *ReadWriter) Initialize() error {
 		return byte((sum & 0xFF) ^ (sum >> 8))
 	}()
 
+	// use generated methods if available, after checking that they are consistent
+	if m, ok := rw.Message.(Marshaler); ok {
+		if m.MAVLinkCRCExtra() != rw.crcExtra {
+			return fmt.Errorf("generated CRC extra (%d) does not match the computed one (%d)",
+				m.MAVLinkCRCExtra(), rw.crcExtra)
+		}
+
+		if m.MAVLinkSize(false) != int(rw.sizeNormal) || m.MAVLinkSize(true) != int(rw.sizeExtended) {
+			return fmt.Errorf("generated size does not match the computed one")
+		}
+
+		rw.marshaler = true
+	}
+
 	return nil
 }
 
@@ -424,10 +476,19 @@ func (rw *ReadWriter) CRCExtra() byte {
 	return rw.crcExtra
 }
 
+// Name returns the name of the message, as written in the XML definition.
+func (rw *ReadWriter) Name() string {
+	return rw.name
+}
+
+// Fields returns the fields of the message, in wire order.
+func (rw *ReadWriter) Fields() []*Field {
+	return rw.wireFields
+}
+
 // Read converts a *MessageRaw into a Message.
 func (rw *ReadWriter) Read(m *MessageRaw, isV2 bool) (Message, error) {
 	payload := m.Payload
-	rmsg := reflect.New(rw.elemType)
 
 	if isV2 {
 		// in V2 buffer length can be > message or < message
@@ -443,6 +504,72 @@ func (rw *ReadWriter) Read(m *MessageRaw, isV2 bool) (Message, error) {
 		}
 	}
 
+	if rw.dynamic {
+		msg := rw.Message.(Dynamic).NewMessage()
+		msg.(Marshaler).UnmarshalMAVLink(payload, isV2)
+		return msg, nil
+	}
+
+	rmsg := reflect.New(rw.elemType)
+
+	if rw.marshaler {
+		rmsg.Interface().(Marshaler).UnmarshalMAVLink(payload, isV2)
+	} else {
+		rw.decode(rmsg.Elem(), payload, isV2)
+	}
+
+	return rmsg.Interface().(Message), nil
+}
+
+// ReadInto converts a *MessageRaw into an existing Message, that must have
+// the same type of the ReadWriter message.
+// Unlike Read, it does not allocate memory, except for string fields.
+func (rw *ReadWriter) ReadInto(m *MessageRaw, isV2 bool, dest Message) error {
+	if reflect.TypeOf(dest) != reflect.PointerTo(rw.elemType) {
+		return fmt.Errorf("wrong destination type: expected *%s, got %T", rw.elemType.Name(), dest)
+	}
+
+	if rw.dynamic && dest.GetID() != rw.Message.GetID() {
+		return fmt.Errorf("wrong destination message: expected ID %d, got %d", rw.Message.GetID(), dest.GetID())
+	}
+
+	payload := m.Payload
+
+	if isV2 {
+		// in V2 buffer length can be > message or < message
+		// in this latter case it must be filled with zeros to support empty-byte de-truncation
+		// and extension fields
+		if len(payload) < int(rw.sizeExtended) {
+			var buf [255]byte
+			copy(buf[:], payload)
+			payload = buf[:rw.sizeExtended]
+		}
+	} else {
+		// in V1 buffer must fit message perfectly
+		if len(payload) != int(rw.sizeNormal) {
+			return fmt.Errorf("wrong size: expected %d, got %d", rw.sizeNormal, len(payload))
+		}
+	}
+
+	// dynamic messages reset themselves while decoding
+	if rw.dynamic {
+		dest.(Marshaler).UnmarshalMAVLink(payload, isV2)
+		return nil
+	}
+
+	rmsg := reflect.ValueOf(dest).Elem()
+	rmsg.SetZero()
+
+	if rw.marshaler {
+		dest.(Marshaler).UnmarshalMAVLink(payload, isV2)
+	} else {
+		rw.decode(rmsg, payload, isV2)
+	}
+
+	return nil
+}
+
+func (rw *ReadWriter) decode(rmsg reflect.Value, payload []byte, isV2 bool) {
 	// decode field by field
 	for _, f := range rw.fields {
 		// skip extensions in V1 frames
@@ -450,7 +577,7 @@ func (rw *ReadWriter) Read(m *MessageRaw, isV2 bool) (Message, error) {
 			continue
 		}
 
-		target := rmsg.Elem().Field(f.index)
+		target := rmsg.Field(f.index)
 
 		switch target.Kind() {
 		case reflect.Array:
@@ -465,8 +592,12 @@ func (rw *ReadWriter) Read(m *MessageRaw, isV2 bool) (Message, error) {
 			payload = payload[n:]
 		}
 	}
+}
 
-	return rmsg.Interface().(Message), nil
+// Size returns the length of the payload of the message.
+// In case of v2, extensions are included.
+func (rw *ReadWriter) Size(isV2 bool) int {
+	return int(rw.size(isV2))
 }
 
 func (rw *ReadWriter) size(isV2 bool) uint8 {
@@ -478,9 +609,40 @@ func (rw *ReadWriter) size(isV2 bool) uint8 {
 
 // Write converts a Message into a *MessageRaw.
 func (rw *ReadWriter) Write(msg Message, isV2 bool) *MessageRaw {
-	buf := make([]byte, rw.size(isV2))
-	start := buf
+	m := &MessageRaw{}
+	rw.WriteInto(m, msg, isV2)
+	return m
+}
 
+// WriteInto converts a Message into an existing *MessageRaw.
+// Unlike Write, it does not allocate memory when the payload buffer of dest
+// is large enough to contain the message.
+func (rw *ReadWriter) WriteInto(dest *MessageRaw, msg Message, isV2 bool) {
+	size := int(rw.size(isV2))
+
+	buf := dest.Payload
+	if buf != nil && cap(buf) >= size {
+		buf = buf[:size]
+		clear(buf)
+	} else {
+		buf = make([]byte, size)
+	}
+
+	if rw.marshaler {
+		msg.(Marshaler).MarshalMAVLink(buf, isV2)
+	} else {
+		rw.encode(buf, msg, isV2)
+	}
+
+	if isV2 {
+		buf = removeEmptyBytes(buf)
+	}
+
+	dest.ID = msg.GetID()
+	dest.Payload = buf
+}
+
+func (rw *ReadWriter) encode(buf []byte, msg Message, isV2 bool) {
 	// encode field by field
 	for _, f := range rw.fields {
 		// skip extensions in V1 frames
@@ -503,15 +665,4 @@ func (rw *ReadWriter) Write(msg Message, isV2 bool) *MessageRaw {
 			buf = buf[n:]
 		}
 	}
-
-	buf = start
-
-	if isV2 {
-		buf = removeEmptyBytes(buf)
-	}
-
-	return &MessageRaw{
-		ID:      msg.GetID(),
-		Payload: buf,
-	}
 }
@@ -2,6 +2,8 @@ package message_test
 
 import (
 	"bytes"
+	"encoding/binary"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -545,6 +547,86 @@ func TestWrite(t *testing.T) {
 	}
 }
 
+func TestReadInto(t *testing.T) {
+	for _, c := range casesReadWriter {
+		t.Run(c.name, func(t *testing.T) {
+			rw := &message.ReadWriter{Message: c.parsed}
+			err := rw.Initialize()
+			require.NoError(t, err)
+
+			rawMsg := &message.MessageRaw{
+				ID:      c.parsed.GetID(),
+				Payload: c.raw,
+			}
+
+			dest := reflect.New(reflect.TypeOf(c.parsed).Elem())
+
+			err = rw.ReadInto(rawMsg, c.isV2, dest.Interface().(message.Message))
+			require.NoError(t, err)
+			require.Equal(t, c.parsed, dest.Interface())
+		})
+	}
+}
+
+func TestReadIntoErrors(t *testing.T) {
+	rw := &message.ReadWriter{Message: &MessageHeartbeat{}}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	err = rw.ReadInto(&message.MessageRaw{}, true, &MessageOpticalFlow{})
+	require.EqualError(t, err, "wrong destination type: expected *MessageHeartbeat, got *message_test.MessageOpticalFlow")
+
+	err = rw.ReadInto(&message.MessageRaw{}, false, &MessageHeartbeat{})
+	require.EqualError(t, err, "wrong size: expected 9, got 0")
+}
+
+func TestWriteInto(t *testing.T) {
+	for _, c := range casesReadWriter {
+		t.Run(c.name, func(t *testing.T) {
+			rw := &message.ReadWriter{Message: c.parsed}
+			err := rw.Initialize()
+			require.NoError(t, err)
+
+			// reuse a dirty buffer
+			msgRaw := &message.MessageRaw{
+				Payload: bytes.Repeat([]byte{0xFF}, 255),
+			}
+			rw.WriteInto(msgRaw, c.parsed, c.isV2)
+			require.Equal(t, c.parsed.GetID(), msgRaw.ID)
+			require.Equal(t, c.raw, msgRaw.Payload)
+		})
+	}
+}
+
+func TestReadIntoWriteIntoAllocs(t *testing.T) {
+	msg := &MessageHeartbeat{
+		Type:           1,
+		Autopilot:      2,
+		BaseMode:       3,
+		CustomMode:     4,
+		SystemStatus:   5,
+		MavlinkVersion: 3,
+	}
+
+	rw := &message.ReadWriter{Message: &MessageHeartbeat{}}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	msgRaw := &message.MessageRaw{Payload: make([]byte, 0, 255)}
+	var dest MessageHeartbeat
+
+	allocs := testing.AllocsPerRun(100, func() {
+		rw.WriteInto(msgRaw, msg, true)
+
+		err2 := rw.ReadInto(msgRaw, true, &dest)
+		if err2 != nil {
+			panic(err2)
+		}
+	})
+	require.Equal(t, float64(0), allocs)
+	require.Equal(t, msg, &dest)
+}
+
 func FuzzReadWriter(f *testing.F) {
 	for _, ca := range casesReadWriter {
 		f.Add(ca.raw, ca.parsed.GetID(), false, false)
@@ -570,3 +652,141 @@ func FuzzReadWriter(f *testing.F) {
 		rw.Write(msg, v2Out)
 	})
 }
+
+// MessageHeartbeatGen mirrors the code generated by dialect-import.
+type MessageHeartbeatGen MessageHeartbeat
+
+func (*MessageHeartbeatGen) GetID() uint32 {
+	return 0
+}
+
+func (*MessageHeartbeatGen) MAVLinkCRCExtra() byte {
+	return 235
+}
+
+func (*MessageHeartbeatGen) MAVLinkSize(_ bool) int {
+	return 9
+}
+
+func (m *MessageHeartbeatGen) MarshalMAVLink(buf []byte, _ bool) {
+	binary.LittleEndian.PutUint32(buf[0:], m.CustomMode)
+	buf[4] = byte(m.Type)
+	buf[5] = byte(m.Autopilot)
+	buf[6] = byte(m.BaseMode)
+	buf[7] = byte(m.SystemStatus)
+	buf[8] = m.MavlinkVersion
+}
+
+func (m *MessageHeartbeatGen) UnmarshalMAVLink(buf []byte, _ bool) {
+	m.CustomMode = binary.LittleEndian.Uint32(buf[0:])
+	m.Type = MAV_TYPE(buf[4])
+	m.Autopilot = MAV_AUTOPILOT(buf[5])
+	m.BaseMode = MAV_MODE_FLAG(buf[6])
+	m.SystemStatus = MAV_STATE(buf[7])
+	m.MavlinkVersion = buf[8]
+}
+
+type MessageInvalidMarshaler struct {
+	A uint8
+}
+
+func (*MessageInvalidMarshaler) GetID() uint32 {
+	return 1
+}
+
+func (*MessageInvalidMarshaler) MAVLinkCRCExtra() byte {
+	return 12
+}
+
+func (*MessageInvalidMarshaler) MAVLinkSize(_ bool) int {
+	return 1
+}
+
+func (*MessageInvalidMarshaler) MarshalMAVLink(_ []byte, _ bool) {}
+
+func (*MessageInvalidMarshaler) UnmarshalMAVLink(_ []byte, _ bool) {}
+
+func TestMarshaler(t *testing.T) {
+	msg := &MessageHeartbeat{
+		Type:           1,
+		Autopilot:      2,
+		BaseMode:       3,
+		CustomMode:     4,
+		SystemStatus:   5,
+		MavlinkVersion: 3,
+	}
+
+	rwRef := &message.ReadWriter{Message: &MessageHeartbeat{}}
+	err := rwRef.Initialize()
+	require.NoError(t, err)
+
+	rw := &message.ReadWriter{Message: &MessageHeartbeatGen{}}
+	err = rw.Initialize()
+	require.NoError(t, err)
+
+	for _, isV2 := range []bool{false, true} {
+		msgRaw := rw.Write((*MessageHeartbeatGen)(msg), isV2)
+		require.Equal(t, rwRef.Write(msg, isV2), msgRaw)
+
+		dec, err := rw.Read(msgRaw, isV2)
+		require.NoError(t, err)
+		require.Equal(t, (*MessageHeartbeatGen)(msg), dec)
+
+		var dest MessageHeartbeatGen
+		err = rw.ReadInto(msgRaw, isV2, &dest)
+		require.NoError(t, err)
+		require.Equal(t, (*MessageHeartbeatGen)(msg), &dest)
+	}
+}
+
+func TestMarshalerErrors(t *testing.T) {
+	rw := &message.ReadWriter{Message: &MessageInvalidMarshaler{}}
+	err := rw.Initialize()
+	require.EqualError(t, err, "generated CRC extra (12) does not match the computed one (71)")
+}
+
+func benchmarkReadWrite(b *testing.B, msg message.Message, dest message.Message) {
+	rw := &message.ReadWriter{Message: msg}
+	err := rw.Initialize()
+	require.NoError(b, err)
+
+	msgRaw := &message.MessageRaw{Payload: make([]byte, 0, 255)}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		rw.WriteInto(msgRaw, msg, true)
+
+		err = rw.ReadInto(msgRaw, true, dest)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadWriteReflection(b *testing.B) {
+	benchmarkReadWrite(b, &MessageHeartbeat{CustomMode: 4, MavlinkVersion: 3}, &MessageHeartbeat{})
+}
+
+func BenchmarkReadWriteGenerated(b *testing.B) {
+	benchmarkReadWrite(b, &MessageHeartbeatGen{CustomMode: 4, MavlinkVersion: 3}, &MessageHeartbeatGen{})
+}
+
+func TestReadWriterFields(t *testing.T) {
+	rw := &message.ReadWriter{Message: &MessageHeartbeat{}}
+	err := rw.Initialize()
+	require.NoError(t, err)
+
+	require.Equal(t, "HEARTBEAT", rw.Name())
+	require.Equal(t, []*message.Field{
+		{Name: "custom_mode", Type: "uint32_t"},
+		{Name: "type", Type: "uint8_t", IsEnum: true},
+		{Name: "autopilot", Type: "uint8_t", IsEnum: true},
+		{Name: "base_mode", Type: "uint8_t", IsEnum: true},
+		{Name: "system_status", Type: "uint8_t", IsEnum: true},
+		{Name: "mavlink_version", Type: "uint8_t"},
+	}, rw.Fields())
+	require.Equal(t, 9, rw.Size(false))
+	require.Equal(t, 9, rw.Size(true))
+}
@@ -0,0 +1,263 @@
+package message
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError is the error returned when a message is not valid.
+type ValidationError struct {
+	// name of the message.
+	Message string
+
+	// name of the field.
+	Field string
+
+	// reason why the field is not valid.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("field '%s' of message %s: %s", e.Field, e.Message, e.Reason)
+}
+
+// valueGetter is implemented by messages whose values are not stored into struct fields,
+// like the ones provided by the dynamic package.
+type valueGetter interface {
+	Get(name string) (any, bool)
+}
+
+// enumValidator is implemented by generated enums.
+type enumValidator interface {
+	IsValid() bool
+}
+
+var fieldTypeMax = map[fieldType]uint64{
+	typeUint8:  math.MaxUint8,
+	typeInt8:   math.MaxUint8,
+	typeUint16: math.MaxUint16,
+	typeUint32: math.MaxUint32,
+	typeInt32:  math.MaxUint32,
+	typeUint64: math.MaxUint64,
+}
+
+type validatedField struct {
+	ftype       fieldType
+	arrayLength int
+	isEnum      bool
+	enum        string
+	metadata    *FieldMetadata
+}
+
+func isEnumValueDefined(v reflect.Value, enum *EnumMetadata) bool {
+	if ev, ok := v.Interface().(enumValidator); ok {
+		return ev.IsValid()
+	}
+
+	if enum == nil {
+		return true
+	}
+
+	u := v.Uint()
+
+	if enum.Bitmask {
+		var mask uint64
+		for _, entry := range enum.Entries {
+			mask |= entry.Value
+		}
+		return u&^mask == 0
+	}
+
+	return enum.Entry(u) != nil
+}
+
+func validateScalar(v reflect.Value, f *validatedField, enums map[string]*EnumMetadata) string {
+	if f.isEnum {
+		u := v.Uint()
+		if u > fieldTypeMax[f.ftype] {
+			return fmt.Sprintf("value %d overflows type %s", u, fieldTypeString[f.ftype])
+		}
+
+		if !isEnumValueDefined(v, enums[f.enum]) {
+			return fmt.Sprintf("value %d is not defined by enum %s", u, f.enum)
+		}
+	}
+
+	md := f.metadata
+	if md == nil {
+		return ""
+	}
+
+	// the invalid value, that means that the field is not available, is always allowed
+	invalid := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(md.Invalid, "["), "]"), ":")
+	if invalid != "" && matchesInvalid(v, invalid) {
+		return ""
+	}
+
+	var fv float64
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		fv = v.Float()
+		if math.IsNaN(fv) {
+			// NaN is commonly used to mean "not set" or "use default",
+			// therefore it is rejected only when the field has a range.
+			if md.MinValue != "" || md.MaxValue != "" {
+				return "value is NaN"
+			}
+			return ""
+		}
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fv = float64(v.Int())
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		fv = float64(v.Uint())
+
+	default:
+		return ""
+	}
+
+	if md.MinValue != "" {
+		if minValue, err := strconv.ParseFloat(md.MinValue, 64); err == nil && fv < minValue {
+			return fmt.Sprintf("value %v is less than %s", fv, md.MinValue)
+		}
+	}
+
+	if md.MaxValue != "" {
+		if maxValue, err := strconv.ParseFloat(md.MaxValue, 64); err == nil && fv > maxValue {
+			return fmt.Sprintf("value %v is greater than %s", fv, md.MaxValue)
+		}
+	}
+
+	return ""
+}
+
+func validateValue(v reflect.Value, f *validatedField, enums map[string]*EnumMetadata) string {
+	switch {
+	case f.ftype == typeChar:
+		if v.Len() > f.arrayLength {
+			return fmt.Sprintf("string is too long (%d > %d)", v.Len(), f.arrayLength)
+		}
+		return ""
+
+	case f.arrayLength > 0:
+		if v.Len() > f.arrayLength {
+			return fmt.Sprintf("array is too long (%d > %d)", v.Len(), f.arrayLength)
+		}
+
+		for i := range v.Len() {
+			if reason := validateScalar(v.Index(i), f, enums); reason != "" {
+				return fmt.Sprintf("element %d: %s", i, reason)
+			}
+		}
+		return ""
+
+	default:
+		return validateScalar(v, f, enums)
+	}
+}
+
+// Validate checks that values of a message are allowed by its definition:
+//   - values of enum fields must fit into the field and must be defined by the enum,
+//     or be combinations of defined flags in case of bitmasks;
+//   - strings and arrays must not be longer than the field.
+//
+// When the message provides metadata (i.e. it is generated or loaded from a XML definition),
+// numeric values must also be between minValue and maxValue, and floats with a range
+// must not be NaN, unless NaN is the invalid value of the field.
+// Enums are checked through their IsValid() method, if available, or through enums,
+// that can be provided by dialect.Dialect.Enums.
+func (rw *ReadWriter) Validate(msg Message, enums map[string]*EnumMetadata) error {
+	var md *MessageMetadata
+	if mp, ok := msg.(MetadataProvider); ok {
+		md = mp.MAVLinkMetadata()
+	}
+
+	fieldMetadata := func(name string) *FieldMetadata {
+		if md == nil {
+			return nil
+		}
+		return md.Field(name)
+	}
+
+	if rw.dynamic {
+		getter, ok := msg.(valueGetter)
+		if !ok {
+			return nil
+		}
+
+		for _, f := range rw.wireFields {
+			v, _ := getter.Get(f.Name)
+			if v == nil {
+				continue
+			}
+
+			vf := &validatedField{
+				ftype:       fieldTypeFromString(f.Type),
+				arrayLength: f.ArrayLength,
+				isEnum:      f.IsEnum,
+				metadata:    fieldMetadata(f.Name),
+			}
+			if vf.metadata != nil {
+				vf.enum = vf.metadata.Enum
+			}
+
+			if reason := validateValue(reflect.ValueOf(v), vf, enums); reason != "" {
+				return &ValidationError{Message: rw.name, Field: f.Name, Reason: reason}
+			}
+		}
+
+		return nil
+	}
+
+	rv := reflect.ValueOf(msg).Elem()
+
+	for _, f := range rw.declFields {
+		vf := &validatedField{
+			ftype:       f.ftype,
+			arrayLength: int(f.arrayLength),
+			isEnum:      f.isEnum,
+			metadata:    fieldMetadata(f.name),
+		}
+
+		if f.isEnum {
+			typ := rw.elemType.Field(f.index).Type
+			if typ.Kind() == reflect.Array {
+				typ = typ.Elem()
+			}
+			vf.enum = typ.Name()
+		}
+
+		if reason := validateValue(rv.Field(f.index), vf, enums); reason != "" {
+			return &ValidationError{Message: rw.name, Field: f.name, Reason: reason}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that values of a message are allowed by its definition.
+// See ReadWriter.Validate for details.
+func Validate(msg Message, enums map[string]*EnumMetadata) error {
+	rw := &ReadWriter{Message: msg}
+	err := rw.Initialize()
+	if err != nil {
+		return err
+	}
+
+	return rw.Validate(msg, enums)
+}
+
+func fieldTypeFromString(s string) fieldType {
+	for typ, str := range fieldTypeString {
+		if str == s {
+			return typ
+		}
+	}
+	return 0
+}
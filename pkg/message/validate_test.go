@@ -0,0 +1,240 @@
+package message_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/dynamic"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+type VALIDATE_MODE uint64 //nolint:revive
+
+type VALIDATE_FLAGS uint64 //nolint:revive
+
+type MessageValidate struct {
+	Mode     VALIDATE_MODE  `mavenum:"uint8"`
+	Flags    VALIDATE_FLAGS `mavenum:"uint16"`
+	Altitude float32
+	Speed    float32
+	Values   [2]int16
+	Name     string `mavlen:"4"`
+}
+
+func (*MessageValidate) GetID() uint32 {
+	return 13
+}
+
+func (*MessageValidate) MAVLinkMetadata() *message.MessageMetadata {
+	return &message.MessageMetadata{
+		ID:   13,
+		Name: "VALIDATE",
+		Fields: []*message.FieldMetadata{
+			{Name: "mode", Type: "uint8_t", Enum: "VALIDATE_MODE"},
+			{Name: "flags", Type: "uint16_t", Enum: "VALIDATE_FLAGS", Bitmask: true},
+			{Name: "altitude", Type: "float", Invalid: "NaN", MinValue: "-10", MaxValue: "100"},
+			{Name: "speed", Type: "float", MinValue: "0"},
+			{Name: "values", Type: "int16_t", ArrayLength: 2, MinValue: "0"},
+			{Name: "name", Type: "char", ArrayLength: 4},
+		},
+	}
+}
+
+var validateEnums = map[string]*message.EnumMetadata{
+	"VALIDATE_MODE": {
+		Name: "VALIDATE_MODE",
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VALIDATE_MODE_A", Value: 0},
+			{Name: "VALIDATE_MODE_B", Value: 1},
+		},
+	},
+	"VALIDATE_FLAGS": {
+		Name:    "VALIDATE_FLAGS",
+		Bitmask: true,
+		Entries: []*message.EnumEntryMetadata{
+			{Name: "VALIDATE_FLAGS_A", Value: 1},
+			{Name: "VALIDATE_FLAGS_B", Value: 4},
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for _, ca := range []struct {
+		name string
+		msg  *MessageValidate
+		err  string
+	}{
+		{
+			"valid",
+			&MessageValidate{Mode: 1, Flags: 5, Altitude: 50, Values: [2]int16{1, 2}, Name: "abcd"},
+			"",
+		},
+		{
+			"invalid value",
+			&MessageValidate{Altitude: float32(math.NaN())},
+			"",
+		},
+		{
+			"enum",
+			&MessageValidate{Mode: 2},
+			"field 'mode' of message VALIDATE: value 2 is not defined by enum VALIDATE_MODE",
+		},
+		{
+			"enum overflow",
+			&MessageValidate{Mode: 256},
+			"field 'mode' of message VALIDATE: value 256 overflows type uint8_t",
+		},
+		{
+			"bitmask",
+			&MessageValidate{Flags: 3},
+			"field 'flags' of message VALIDATE: value 3 is not defined by enum VALIDATE_FLAGS",
+		},
+		{
+			"min value",
+			&MessageValidate{Altitude: -11},
+			"field 'altitude' of message VALIDATE: value -11 is less than -10",
+		},
+		{
+			"max value",
+			&MessageValidate{Altitude: 101},
+			"field 'altitude' of message VALIDATE: value 101 is greater than 100",
+		},
+		{
+			"nan",
+			&MessageValidate{Speed: float32(math.NaN())},
+			"field 'speed' of message VALIDATE: value is NaN",
+		},
+		{
+			"array element",
+			&MessageValidate{Values: [2]int16{0, -1}},
+			"field 'values' of message VALIDATE: element 1: value -1 is less than 0",
+		},
+		{
+			"string",
+			&MessageValidate{Name: "abcde"},
+			"field 'name' of message VALIDATE: string is too long (5 > 4)",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			err := message.Validate(ca.msg, validateEnums)
+			if ca.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, ca.err)
+			}
+		})
+	}
+}
+
+func TestValidateStandardDialect(t *testing.T) {
+	for _, ca := range []struct {
+		name string
+		msg  message.Message
+		err  string
+	}{
+		{
+			"valid",
+			&common.MessageHeartbeat{
+				Type:     common.MAV_TYPE_QUADROTOR,
+				BaseMode: common.MAV_MODE_FLAG_SAFETY_ARMED | common.MAV_MODE_FLAG_GUIDED_ENABLED,
+			},
+			"",
+		},
+		{
+			"enum",
+			&common.MessageHeartbeat{Type: 200},
+			"field 'type' of message HEARTBEAT: value 200 is not defined by enum MAV_TYPE",
+		},
+		{
+			"enum overflow",
+			&common.MessageHeartbeat{Type: 300},
+			"field 'type' of message HEARTBEAT: value 300 overflows type uint8_t",
+		},
+		{
+			"bitmask",
+			&common.MessageEstimatorStatus{Flags: common.ESTIMATOR_ATTITUDE | 0x1000},
+			"field 'flags' of message ESTIMATOR_STATUS: value 4097 is not defined by enum ESTIMATOR_STATUS_FLAGS",
+		},
+		{
+			"string",
+			&common.MessageStatustext{Text: strings.Repeat("a", 51)},
+			"field 'text' of message STATUSTEXT: string is too long (51 > 50)",
+		},
+		{
+			"nan param",
+			&common.MessageCommandLong{
+				Command: common.MAV_CMD_NAV_TAKEOFF,
+				Param4:  float32(math.NaN()),
+			},
+			"",
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			err := message.Validate(ca.msg, common.Dialect.Enums)
+			if ca.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, ca.err)
+			}
+		})
+	}
+}
+
+func TestValidateWithoutMetadata(t *testing.T) {
+	// enums are checked only when they are known
+	err := message.Validate(&MessageHeartbeat{Type: 200}, nil)
+	require.NoError(t, err)
+
+	err = message.Validate(&MessageHeartbeat{Type: 200}, validateEnums)
+	require.NoError(t, err)
+}
+
+func TestValidateDynamic(t *testing.T) {
+	def := &dynamic.MessageDefinition{
+		ID:   13,
+		Name: "VALIDATE",
+		Fields: []*dynamic.FieldDefinition{
+			{Name: "mode", Type: "uint8_t", Enum: &dynamic.EnumDefinition{Name: "VALIDATE_MODE"}},
+			{Name: "altitude", Type: "float"},
+			{Name: "name", Type: "char", ArrayLength: 4},
+		},
+		Metadata: &message.MessageMetadata{
+			ID:   13,
+			Name: "VALIDATE",
+			Fields: []*message.FieldMetadata{
+				{Name: "mode", Type: "uint8_t", Enum: "VALIDATE_MODE"},
+				{Name: "altitude", Type: "float", MaxValue: "100"},
+				{Name: "name", Type: "char", ArrayLength: 4},
+			},
+		},
+	}
+	err := def.Initialize()
+	require.NoError(t, err)
+
+	msg := def.NewMessage()
+	err = message.Validate(msg, validateEnums)
+	require.NoError(t, err)
+
+	err = msg.Set("mode", 3)
+	require.NoError(t, err)
+	err = message.Validate(msg, validateEnums)
+	require.EqualError(t, err, "field 'mode' of message VALIDATE: value 3 is not defined by enum VALIDATE_MODE")
+
+	err = msg.Set("mode", 1)
+	require.NoError(t, err)
+	err = msg.Set("altitude", 200)
+	require.NoError(t, err)
+	err = message.Validate(msg, validateEnums)
+	require.EqualError(t, err, "field 'altitude' of message VALIDATE: value 200 is greater than 100")
+
+	err = msg.Set("altitude", 0)
+	require.NoError(t, err)
+	err = msg.Set("name", "abcdef")
+	require.NoError(t, err)
+	err = message.Validate(msg, validateEnums)
+	require.EqualError(t, err, "field 'name' of message VALIDATE: string is too long (6 > 4)")
+}
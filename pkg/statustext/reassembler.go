@@ -0,0 +1,175 @@
+// Package statustext contains a reassembler and a splitter of chunked status texts (STATUSTEXT).
+package statustext
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+const (
+	// maximum length of the text of a STATUSTEXT.
+	chunkLength = 50
+
+	defaultTimeout = 2 * time.Second
+)
+
+// Text is a status text, that may be composed of multiple chunks.
+type Text struct {
+	// system and component that sent the text.
+	SystemID    uint8
+	ComponentID uint8
+
+	// severity of the text.
+	Severity common.MAV_SEVERITY
+
+	// content of the text.
+	Text string
+
+	// whether some chunks were not received before the timeout.
+	Incomplete bool
+}
+
+type textKey struct {
+	systemID    uint8
+	componentID uint8
+	id          uint16
+}
+
+type pendingText struct {
+	seq      uint64 // order of arrival
+	first    time.Time
+	severity common.MAV_SEVERITY
+	chunks   map[uint8]string
+	last     int // sequence number of the last chunk, -1 if not received yet
+}
+
+func (p *pendingText) complete() bool {
+	return p.last >= 0 && len(p.chunks) == p.last+1
+}
+
+func (p *pendingText) join() string {
+	seqs := make([]int, 0, len(p.chunks))
+	for seq := range p.chunks {
+		seqs = append(seqs, int(seq))
+	}
+	sort.Ints(seqs)
+
+	var b strings.Builder
+	for _, seq := range seqs {
+		b.WriteString(p.chunks[uint8(seq)])
+	}
+	return b.String()
+}
+
+// Reassembler reassembles chunked status texts.
+// Chunks can be received out of order. Texts whose chunks are not all received
+// within Timeout are returned as incomplete.
+// It is not safe for concurrent use.
+type Reassembler struct {
+	// (optional) maximum time between the first and the last chunk of a text.
+	// It defaults to 2 seconds.
+	Timeout time.Duration
+
+	pending map[textKey]*pendingText
+	seq     uint64
+}
+
+// Initialize initializes a Reassembler.
+func (r *Reassembler) Initialize() error {
+	if r.Timeout == 0 {
+		r.Timeout = defaultTimeout
+	}
+
+	r.pending = make(map[textKey]*pendingText)
+
+	return nil
+}
+
+// Push processes a STATUSTEXT sent by a system and component.
+// It returns texts that are complete, and texts that have timed out.
+func (r *Reassembler) Push(systemID uint8, componentID uint8, msg *common.MessageStatustext) []*Text {
+	ret := r.Expire()
+
+	// text is not chunked
+	if msg.Id == 0 {
+		return append(ret, &Text{
+			SystemID:    systemID,
+			ComponentID: componentID,
+			Severity:    msg.Severity,
+			Text:        msg.Text,
+		})
+	}
+
+	key := textKey{systemID, componentID, msg.Id}
+
+	p, ok := r.pending[key]
+	if !ok {
+		r.seq++
+		p = &pendingText{
+			seq:      r.seq,
+			first:    time.Now(),
+			severity: msg.Severity,
+			chunks:   make(map[uint8]string),
+			last:     -1,
+		}
+		r.pending[key] = p
+	}
+
+	p.chunks[msg.ChunkSeq] = msg.Text
+
+	// the last chunk is the one that is shorter than the maximum length
+	if len(msg.Text) < chunkLength {
+		p.last = int(msg.ChunkSeq)
+	}
+
+	if !p.complete() {
+		return ret
+	}
+
+	delete(r.pending, key)
+
+	return append(ret, &Text{
+		SystemID:    systemID,
+		ComponentID: componentID,
+		Severity:    p.severity,
+		Text:        p.join(),
+	})
+}
+
+// Expire returns texts that have timed out, and removes them.
+// Texts are returned in order of arrival of their first chunk.
+// It is called automatically by Push.
+func (r *Reassembler) Expire() []*Text {
+	now := time.Now()
+	var keys []textKey
+
+	for key, p := range r.pending {
+		if now.Sub(p.first) >= r.Timeout {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return r.pending[keys[i]].seq < r.pending[keys[j]].seq
+	})
+
+	var ret []*Text
+
+	for _, key := range keys {
+		p := r.pending[key]
+		delete(r.pending, key)
+
+		ret = append(ret, &Text{
+			SystemID:    key.systemID,
+			ComponentID: key.componentID,
+			Severity:    p.severity,
+			Text:        p.join(),
+			Incomplete:  true,
+		})
+	}
+
+	return ret
+}
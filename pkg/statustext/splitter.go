@@ -0,0 +1,59 @@
+package statustext
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+)
+
+// Splitter splits texts into STATUSTEXT messages.
+// Texts longer than 50 characters are split into chunks that share the same ID.
+// It is safe for concurrent use.
+type Splitter struct {
+	lastID atomic.Uint32
+}
+
+func (s *Splitter) nextID() uint16 {
+	for {
+		// zero is reserved to texts that are not chunked
+		if id := uint16(s.lastID.Add(1)); id != 0 {
+			return id
+		}
+	}
+}
+
+// Split splits a text into STATUSTEXT messages.
+func (s *Splitter) Split(severity common.MAV_SEVERITY, text string) ([]*common.MessageStatustext, error) {
+	if len(text) <= chunkLength {
+		return []*common.MessageStatustext{{
+			Severity: severity,
+			Text:     text,
+		}}, nil
+	}
+
+	// when the length is a multiple of the chunk length,
+	// an additional empty chunk marks the end of the text.
+	count := len(text)/chunkLength + 1
+	if count > math.MaxUint8+1 {
+		return nil, fmt.Errorf("text is too long (%d characters)", len(text))
+	}
+
+	id := s.nextID()
+	ret := make([]*common.MessageStatustext, count)
+
+	for i := range count {
+		start := i * chunkLength
+		end := min(start+chunkLength, len(text))
+
+		ret[i] = &common.MessageStatustext{
+			Severity: severity,
+			Text:     text[start:end],
+			Id:       id,
+			ChunkSeq: uint8(i),
+		}
+	}
+
+	return ret, nil
+}
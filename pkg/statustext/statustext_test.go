@@ -0,0 +1,173 @@
+package statustext_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/statustext"
+)
+
+func TestSplit(t *testing.T) {
+	for _, ca := range []struct {
+		name   string
+		text   string
+		chunks []string
+	}{
+		{
+			"short",
+			"PreArm: Gyros not calibrated",
+			[]string{"PreArm: Gyros not calibrated"},
+		},
+		{
+			"long",
+			strings.Repeat("a", 50) + "bbb",
+			[]string{strings.Repeat("a", 50), "bbb"},
+		},
+		{
+			"multiple of chunk length",
+			strings.Repeat("a", 100),
+			[]string{strings.Repeat("a", 50), strings.Repeat("a", 50), ""},
+		},
+	} {
+		t.Run(ca.name, func(t *testing.T) {
+			s := &statustext.Splitter{}
+			msgs, err := s.Split(common.MAV_SEVERITY_WARNING, ca.text)
+			require.NoError(t, err)
+			require.Len(t, msgs, len(ca.chunks))
+
+			for i, msg := range msgs {
+				require.Equal(t, common.MAV_SEVERITY_WARNING, msg.Severity)
+				require.Equal(t, ca.chunks[i], msg.Text)
+
+				if len(ca.chunks) == 1 {
+					require.Equal(t, uint16(0), msg.Id)
+				} else {
+					require.Equal(t, uint16(1), msg.Id)
+					require.Equal(t, uint8(i), msg.ChunkSeq)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitIDs(t *testing.T) {
+	s := &statustext.Splitter{}
+	text := strings.Repeat("a", 60)
+
+	msgs, err := s.Split(common.MAV_SEVERITY_INFO, text)
+	require.NoError(t, err)
+	require.Equal(t, uint16(1), msgs[0].Id)
+
+	msgs, err = s.Split(common.MAV_SEVERITY_INFO, text)
+	require.NoError(t, err)
+	require.Equal(t, uint16(2), msgs[0].Id)
+}
+
+func TestSplitError(t *testing.T) {
+	s := &statustext.Splitter{}
+	_, err := s.Split(common.MAV_SEVERITY_INFO, strings.Repeat("a", 50*256))
+	require.EqualError(t, err, "text is too long (12800 characters)")
+}
+
+func TestReassembler(t *testing.T) {
+	r := &statustext.Reassembler{}
+	err := r.Initialize()
+	require.NoError(t, err)
+
+	// not chunked
+	texts := r.Push(1, 1, &common.MessageStatustext{
+		Severity: common.MAV_SEVERITY_INFO,
+		Text:     "Armed",
+	})
+	require.Equal(t, []*statustext.Text{{
+		SystemID:    1,
+		ComponentID: 1,
+		Severity:    common.MAV_SEVERITY_INFO,
+		Text:        "Armed",
+	}}, texts)
+
+	text := "PreArm: " + strings.Repeat("x", 100)
+	s := &statustext.Splitter{}
+	msgs, err := s.Split(common.MAV_SEVERITY_CRITICAL, text)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+
+	// out of order, interleaved with chunks of another sender
+	require.Empty(t, r.Push(1, 1, msgs[2]))
+	require.Empty(t, r.Push(2, 1, msgs[0]))
+	require.Empty(t, r.Push(1, 1, msgs[0]))
+	texts = r.Push(1, 1, msgs[1])
+	require.Equal(t, []*statustext.Text{{
+		SystemID:    1,
+		ComponentID: 1,
+		Severity:    common.MAV_SEVERITY_CRITICAL,
+		Text:        text,
+	}}, texts)
+
+	require.Empty(t, r.Expire())
+}
+
+func TestReassemblerTimeout(t *testing.T) {
+	r := &statustext.Reassembler{
+		Timeout: 10 * time.Millisecond,
+	}
+	err := r.Initialize()
+	require.NoError(t, err)
+
+	s := &statustext.Splitter{}
+	msgs, err := s.Split(common.MAV_SEVERITY_ERROR, strings.Repeat("a", 50)+"b")
+	require.NoError(t, err)
+
+	require.Empty(t, r.Push(1, 1, msgs[0]))
+
+	time.Sleep(20 * time.Millisecond)
+
+	texts := r.Push(1, 1, &common.MessageStatustext{Text: "next"})
+	require.Equal(t, []*statustext.Text{
+		{
+			SystemID:    1,
+			ComponentID: 1,
+			Severity:    common.MAV_SEVERITY_ERROR,
+			Text:        strings.Repeat("a", 50),
+			Incomplete:  true,
+		},
+		{
+			SystemID:    1,
+			ComponentID: 1,
+			Text:        "next",
+		},
+	}, texts)
+
+	// late chunk of an expired text
+	require.Empty(t, r.Push(1, 1, msgs[1]))
+}
+
+func TestReassemblerExpireOrder(t *testing.T) {
+	r := &statustext.Reassembler{
+		Timeout: 10 * time.Millisecond,
+	}
+	err := r.Initialize()
+	require.NoError(t, err)
+
+	// first chunks of texts, in order of arrival
+	for _, id := range []uint16{5, 2, 9, 1, 7, 3} {
+		require.Empty(t, r.Push(1, uint8(id), &common.MessageStatustext{
+			Text: strings.Repeat("a", 50),
+			Id:   id,
+		}))
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	texts := r.Expire()
+	require.Len(t, texts, 6)
+
+	for i, id := range []uint8{5, 2, 9, 1, 7, 3} {
+		require.Equal(t, id, texts[i].ComponentID)
+		require.True(t, texts[i].Incomplete)
+	}
+}
@@ -0,0 +1,54 @@
+package streamwriter
+
+import (
+	"sync"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/frame"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+)
+
+type sharedMessageKey struct {
+	version         Version
+	systemID        byte
+	componentID     byte
+	key             *frame.V2Key
+	sequenceNumber  byte
+	signatureLinkID byte
+}
+
+type sharedMessageEntry struct {
+	byts []byte
+	err  error
+}
+
+// SharedMessage is a message that is written by multiple Writers.
+// It caches encoded frames, in order to avoid encoding the same frame
+// multiple times.
+type SharedMessage struct {
+	// message to write.
+	Message message.Message
+
+	//
+	// private
+	//
+
+	mutex   sync.Mutex
+	encoded map[sharedMessageKey]sharedMessageEntry
+}
+
+func (sm *SharedMessage) get(key sharedMessageKey, encode func() ([]byte, error)) ([]byte, error) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if entry, ok := sm.encoded[key]; ok {
+		return entry.byts, entry.err
+	}
+
+	if sm.encoded == nil {
+		sm.encoded = make(map[sharedMessageKey]sharedMessageEntry)
+	}
+
+	byts, err := encode()
+	sm.encoded[key] = sharedMessageEntry{byts, err}
+	return byts, err
+}
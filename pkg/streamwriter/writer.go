@@ -97,15 +97,58 @@ func (w *Writer) Initialize() error {
 
 // Write writes a message.
 func (w *Writer) Write(msg message.Message) error {
-	if w.Version == V1 {
-		return w.writeInner(&frame.V1Frame{Message: msg})
+	fr, err := w.encode(msg)
+	if err != nil {
+		return err
+	}
+
+	return w.FrameWriter.Write(fr)
+}
+
+// WriteShared writes a message shared with other Writers.
+// The frame is encoded once for every group of Writers that would produce
+// the same bytes, and the result is reused by all Writers of the group.
+// It can be called by multiple Writers in parallel on the same SharedMessage.
+func (w *Writer) WriteShared(sm *SharedMessage) error {
+	key := sharedMessageKey{
+		version:        w.Version,
+		systemID:       w.SystemID,
+		componentID:    w.ComponentID,
+		key:            w.Key,
+		sequenceNumber: w.nextSeqNumber,
+	}
+	if w.Key != nil {
+		key.signatureLinkID = w.SignatureLinkID
+	}
+
+	byts, err := sm.get(key, func() ([]byte, error) {
+		fr, err := w.encode(sm.Message)
+		if err != nil {
+			return nil, err
+		}
+		return w.FrameWriter.Encode(fr)
+	})
+	if err != nil {
+		return err
 	}
-	return w.writeInner(&frame.V2Frame{Message: msg})
+
+	// increase sequence number even when bytes are reused
+	w.nextSeqNumber = key.sequenceNumber + 1
+
+	_, err = w.FrameWriter.ByteWriter.Write(byts)
+	return err
 }
 
-func (w *Writer) writeInner(fr frame.Frame) error {
+func (w *Writer) encode(msg message.Message) (frame.Frame, error) {
+	var fr frame.Frame
+	if w.Version == V1 {
+		fr = &frame.V1Frame{Message: msg}
+	} else {
+		fr = &frame.V2Frame{Message: msg}
+	}
+
 	if fr.GetMessage() == nil {
-		return fmt.Errorf("message is nil")
+		return nil, fmt.Errorf("message is nil")
 	}
 
 	// fill SequenceNumber, SystemID, ComponentID, CompatibilityFlag, IncompatibilityFlag
@@ -130,12 +173,12 @@ func (w *Writer) writeInner(fr frame.Frame) error {
 	w.nextSeqNumber++
 
 	if w.FrameWriter.DialectRW == nil {
-		return fmt.Errorf("dialect is nil")
+		return nil, fmt.Errorf("dialect is nil")
 	}
 
 	mp := w.FrameWriter.DialectRW.GetMessage(fr.GetMessage().GetID())
 	if mp == nil {
-		return fmt.Errorf("message is not in the dialect")
+		return nil, fmt.Errorf("message is not in the dialect")
 	}
 
 	// encode message if it is not already encoded
@@ -159,5 +202,5 @@ func (w *Writer) writeInner(fr frame.Frame) error {
 		ff.Signature = ff.GenerateSignature(w.Key)
 	}
 
-	return w.FrameWriter.Write(fr)
+	return fr, nil
 }
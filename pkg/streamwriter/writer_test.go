@@ -3,6 +3,7 @@ package streamwriter
 import (
 	"bytes"
 	"encoding/binary"
+	"io"
 	"testing"
 	"time"
 
@@ -194,3 +195,127 @@ func TestWriteMessageErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteShared(t *testing.T) {
+	var bufs [3]bytes.Buffer
+	var writers [3]*Writer
+
+	for i := range writers {
+		fw := &frame.Writer{
+			ByteWriter: &bufs[i],
+			DialectRW:  testDialectRW,
+		}
+		err := fw.Initialize()
+		require.NoError(t, err)
+
+		writers[i] = &Writer{
+			FrameWriter: fw,
+			Version:     V2,
+			SystemID:    1,
+		}
+		err = writers[i].Initialize()
+		require.NoError(t, err)
+	}
+
+	msg := &MessageTest5{15, 7}
+
+	// desynchronize sequence number of the last writer
+	err := writers[2].Write(msg)
+	require.NoError(t, err)
+	bufs[2].Reset()
+
+	sm := &SharedMessage{Message: msg}
+
+	for _, w := range writers {
+		err = w.WriteShared(sm)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, sm.encoded, 2)
+	require.Equal(t, bufs[0].Bytes(), bufs[1].Bytes())
+	require.NotEqual(t, bufs[0].Bytes(), bufs[2].Bytes())
+
+	for i, w := range writers {
+		rw := &frame.ReadWriter{
+			ByteReadWriter: &bufs[i],
+			DialectRW:      testDialectRW,
+		}
+		err = rw.Initialize()
+		require.NoError(t, err)
+
+		var fr frame.Frame
+		fr, err = rw.Read()
+		require.NoError(t, err)
+		require.Equal(t, msg, fr.GetMessage())
+
+		if i == 2 {
+			require.Equal(t, byte(1), fr.GetSequenceNumber())
+		} else {
+			require.Equal(t, byte(0), fr.GetSequenceNumber())
+		}
+
+		require.Equal(t, fr.GetSequenceNumber()+1, w.nextSeqNumber)
+	}
+}
+
+func benchmarkWriters(b *testing.B, count int) []*Writer {
+	writers := make([]*Writer, count)
+
+	for i := range writers {
+		fw := &frame.Writer{
+			ByteWriter: io.Discard,
+			DialectRW:  testDialectRW,
+		}
+		err := fw.Initialize()
+		require.NoError(b, err)
+
+		writers[i] = &Writer{
+			FrameWriter: fw,
+			Version:     V2,
+			SystemID:    1,
+		}
+		err = writers[i].Initialize()
+		require.NoError(b, err)
+	}
+
+	return writers
+}
+
+var benchmarkMessage = &MessageHeartbeat{
+	Type:           1,
+	Autopilot:      2,
+	BaseMode:       3,
+	CustomMode:     4,
+	SystemStatus:   5,
+	MavlinkVersion: 3,
+}
+
+func BenchmarkWrite100Writers(b *testing.B) {
+	writers := benchmarkWriters(b, 100)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for _, w := range writers {
+			err := w.Write(benchmarkMessage)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkWriteShared100Writers(b *testing.B) {
+	writers := benchmarkWriters(b, 100)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		sm := &SharedMessage{Message: benchmarkMessage}
+
+		for _, w := range writers {
+			err := w.WriteShared(sm)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package vehicle
+
+import (
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/flightmode"
+)
+
+// Field is a group of values of the state, that are updated together.
+// Fields can be combined together with the OR operator.
+type Field uint32
+
+// fields.
+const (
+	FieldStatus Field = 1 << iota
+	FieldPosition
+	FieldAttitude
+	FieldSpeed
+	FieldBattery
+	FieldBatteries
+	FieldGPS
+	FieldLandedState
+	FieldHome
+	FieldStatusTexts
+)
+
+var fieldLabels = []string{
+	"status",
+	"position",
+	"attitude",
+	"speed",
+	"battery",
+	"batteries",
+	"gps",
+	"landed state",
+	"home",
+	"status texts",
+}
+
+// String implements fmt.Stringer.
+func (f Field) String() string {
+	ret := ""
+	for i, l := range fieldLabels {
+		if f&(1<<i) != 0 {
+			if ret != "" {
+				ret += "|"
+			}
+			ret += l
+		}
+	}
+	return ret
+}
+
+// Status contains values decoded from HEARTBEAT.
+type Status struct {
+	Autopilot    common.MAV_AUTOPILOT
+	Type         common.MAV_TYPE
+	VehicleClass flightmode.VehicleClass
+	SystemStatus common.MAV_STATE
+	Armed        bool
+
+	// name of the flight mode, empty if the mode is unknown.
+	Mode       string
+	CustomMode uint32
+}
+
+// Position contains values decoded from GLOBAL_POSITION_INT.
+type Position struct {
+	// latitude and longitude, in degrees.
+	Latitude  float64
+	Longitude float64
+
+	// altitude (MSL) and altitude above home, in meters.
+	Altitude         float64
+	RelativeAltitude float64
+
+	// speed (NED), in m/s.
+	VX float32
+	VY float32
+	VZ float32
+
+	// heading, in degrees. NaN if unknown.
+	Heading float32
+}
+
+// Attitude contains values decoded from ATTITUDE.
+type Attitude struct {
+	// angles, in radians.
+	Roll  float32
+	Pitch float32
+	Yaw   float32
+
+	// angular speeds, in rad/s.
+	RollSpeed  float32
+	PitchSpeed float32
+	YawSpeed   float32
+}
+
+// Speed contains values decoded from VFR_HUD.
+type Speed struct {
+	// speeds, in m/s.
+	Airspeed    float32
+	Groundspeed float32
+	ClimbRate   float32
+
+	// heading, in degrees.
+	Heading int16
+
+	// throttle, in percent.
+	Throttle uint16
+}
+
+// Battery contains values decoded from SYS_STATUS or BATTERY_STATUS.
+type Battery struct {
+	// voltage, in V. NaN if unknown.
+	Voltage float32
+
+	// current, in A. NaN if unknown.
+	Current float32
+
+	// remaining energy, in percent. -1 if unknown.
+	Remaining int8
+
+	// consumed charge, in mAh. -1 if unknown. It is provided by BATTERY_STATUS only.
+	Consumed int32
+
+	// temperature, in degrees Celsius. NaN if unknown. It is provided by BATTERY_STATUS only.
+	Temperature float32
+}
+
+// GPS contains values decoded from GPS_RAW_INT.
+type GPS struct {
+	FixType           common.GPS_FIX_TYPE
+	SatellitesVisible uint8
+
+	// dilution of precision. NaN if unknown.
+	HDOP float32
+	VDOP float32
+
+	// latitude and longitude, in degrees.
+	Latitude  float64
+	Longitude float64
+
+	// altitude (MSL), in meters.
+	Altitude float64
+}
+
+// LandedState contains values decoded from EXTENDED_SYS_STATE.
+type LandedState struct {
+	LandedState common.MAV_LANDED_STATE
+	VTOLState   common.MAV_VTOL_STATE
+}
+
+// Home contains values decoded from HOME_POSITION.
+type Home struct {
+	// latitude and longitude, in degrees.
+	Latitude  float64
+	Longitude float64
+
+	// altitude (MSL), in meters.
+	Altitude float64
+}
+
+// StatusText is a text decoded from one or more STATUSTEXT.
+type StatusText struct {
+	Time     time.Time
+	Severity common.MAV_SEVERITY
+	Text     string
+
+	// whether some chunks of the text were not received.
+	Incomplete bool
+}
+
+// State is a snapshot of the state of a vehicle.
+type State struct {
+	SystemID    uint8
+	Status      Status
+	Position    Position
+	Attitude    Attitude
+	Speed       Speed
+	Battery     Battery
+	Batteries   map[uint8]Battery
+	GPS         GPS
+	LandedState LandedState
+	Home        Home
+	StatusTexts []StatusText
+
+	// time of the last update of every field.
+	Updated map[Field]time.Time
+}
+
+func (s *State) clone() *State {
+	ret := *s
+
+	ret.Batteries = make(map[uint8]Battery, len(s.Batteries))
+	for id, b := range s.Batteries {
+		ret.Batteries[id] = b
+	}
+
+	ret.StatusTexts = append([]StatusText(nil), s.StatusTexts...)
+
+	ret.Updated = make(map[Field]time.Time, len(s.Updated))
+	for f, t := range s.Updated {
+		ret.Updated[f] = t
+	}
+
+	return &ret
+}
+
+// Stale returns fields that have been received at least once
+// and have not been updated since timeout.
+func (s *State) Stale(timeout time.Duration) Field {
+	var ret Field
+	now := time.Now()
+
+	for f, t := range s.Updated {
+		if f != FieldStatusTexts && now.Sub(t) >= timeout {
+			ret |= f
+		}
+	}
+
+	return ret
+}
+
+// sameValues compares structs made of scalars. NaNs are considered equal.
+func sameValues(a any, b any) bool {
+	va := reflect.ValueOf(a)
+	vb := reflect.ValueOf(b)
+
+	for i := range va.NumField() {
+		fa := va.Field(i)
+		fb := vb.Field(i)
+
+		switch fa.Kind() {
+		case reflect.Float32, reflect.Float64:
+			x, y := fa.Float(), fb.Float()
+			if x != y && (!math.IsNaN(x) || !math.IsNaN(y)) {
+				return false
+			}
+
+		default:
+			if !fa.Equal(fb) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,401 @@
+// Package vehicle contains a model of the state of vehicles, aggregated from their telemetry.
+package vehicle
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/flightmode"
+	"github.com/bluenviron/gomavlib/v4/pkg/message"
+	"github.com/bluenviron/gomavlib/v4/pkg/statustext"
+)
+
+const (
+	defaultStatusTextsMax = 32
+)
+
+func degrees(v int32) float64 {
+	return float64(v) / 1e7
+}
+
+func meters(v int32) float64 {
+	return float64(v) / 1000
+}
+
+func unknownUint16(v uint16, scale float32) float32 {
+	if v == math.MaxUint16 {
+		return float32(math.NaN())
+	}
+	return float32(v) / scale
+}
+
+// Tracker maintains the state of vehicles, aggregated from their telemetry.
+// It must be fed with events of a Node, that must use the common dialect
+// or a dialect that includes it (i.e. ardupilotmega):
+//
+//	for evt := range node.Events() {
+//		tracker.Handle(evt)
+//	}
+//
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	// (optional) maximum number of status texts kept for every vehicle.
+	// It defaults to 32.
+	StatusTextsMax int
+
+	// (optional) maximum time between the first and the last chunk of a status text.
+	// Texts whose chunks are not all received within this time are added as incomplete.
+	// It defaults to 2 seconds.
+	StatusTextTimeout time.Duration
+
+	// (optional) function called when fields of a vehicle change.
+	// It is called in the goroutine that calls Handle().
+	OnChange func(systemID uint8, fields Field)
+
+	mutex        sync.RWMutex
+	states       map[uint8]*State
+	reassemblers map[uint8]*statustext.Reassembler
+}
+
+// Initialize initializes a Tracker.
+func (t *Tracker) Initialize() error {
+	if t.StatusTextsMax == 0 {
+		t.StatusTextsMax = defaultStatusTextsMax
+	}
+
+	t.states = make(map[uint8]*State)
+	t.reassemblers = make(map[uint8]*statustext.Reassembler)
+
+	return nil
+}
+
+// Handle processes an event of a Node.
+// Events that are not frames are ignored.
+func (t *Tracker) Handle(evt gomavlib.Event) {
+	if evt, ok := evt.(*gomavlib.EventFrame); ok {
+		t.HandleMessage(evt.SystemID(), evt.ComponentID(), evt.Message())
+	}
+}
+
+// HandleMessage processes a message sent by a system and component.
+// Messages that are not part of the telemetry are ignored.
+// Chunked status texts are reassembled before being added to the state.
+func (t *Tracker) HandleMessage(systemID uint8, componentID uint8, msg message.Message) {
+	changed := t.update(systemID, componentID, msg)
+
+	if changed != 0 && t.OnChange != nil {
+		t.OnChange(systemID, changed)
+	}
+}
+
+func (t *Tracker) update(systemID uint8, componentID uint8, msg message.Message) Field {
+	switch msg.(type) {
+	case *common.MessageHeartbeat,
+		*common.MessageGlobalPositionInt,
+		*common.MessageAttitude,
+		*common.MessageVfrHud,
+		*common.MessageSysStatus,
+		*common.MessageBatteryStatus,
+		*common.MessageGpsRawInt,
+		*common.MessageExtendedSysState,
+		*common.MessageHomePosition,
+		*common.MessageStatustext:
+
+	default:
+		return 0
+	}
+
+	// heartbeats of ground stations and peripherals
+	if hb, ok := msg.(*common.MessageHeartbeat); ok && hb.Autopilot == common.MAV_AUTOPILOT_INVALID {
+		return 0
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	s, ok := t.states[systemID]
+	if !ok {
+		s = &State{
+			SystemID:  systemID,
+			Batteries: make(map[uint8]Battery),
+			Updated:   make(map[Field]time.Time),
+		}
+		t.states[systemID] = s
+
+		r := &statustext.Reassembler{
+			Timeout: t.StatusTextTimeout,
+		}
+		r.Initialize() //nolint:errcheck
+		t.reassemblers[systemID] = r
+	}
+
+	now := time.Now()
+	var field Field
+	changed := false
+
+	var expired Field
+	if t.expireStatusTexts(systemID, now) {
+		expired = FieldStatusTexts
+	}
+
+	switch msg := msg.(type) {
+	case *common.MessageHeartbeat:
+		field = FieldStatus
+		fs := flightmode.Decode(msg)
+		v := Status{
+			Autopilot:    msg.Autopilot,
+			Type:         msg.Type,
+			VehicleClass: fs.VehicleClass,
+			SystemStatus: msg.SystemStatus,
+			Armed:        fs.Armed,
+			CustomMode:   msg.CustomMode,
+		}
+		if fs.Mode != nil {
+			v.Mode = fs.Mode.Name
+		}
+		changed = !sameValues(s.Status, v)
+		s.Status = v
+
+	case *common.MessageGlobalPositionInt:
+		field = FieldPosition
+		v := Position{
+			Latitude:         degrees(msg.Lat),
+			Longitude:        degrees(msg.Lon),
+			Altitude:         meters(msg.Alt),
+			RelativeAltitude: meters(msg.RelativeAlt),
+			VX:               float32(msg.Vx) / 100,
+			VY:               float32(msg.Vy) / 100,
+			VZ:               float32(msg.Vz) / 100,
+			Heading:          unknownUint16(msg.Hdg, 100),
+		}
+		changed = !sameValues(s.Position, v)
+		s.Position = v
+
+	case *common.MessageAttitude:
+		field = FieldAttitude
+		v := Attitude{
+			Roll:       msg.Roll,
+			Pitch:      msg.Pitch,
+			Yaw:        msg.Yaw,
+			RollSpeed:  msg.Rollspeed,
+			PitchSpeed: msg.Pitchspeed,
+			YawSpeed:   msg.Yawspeed,
+		}
+		changed = !sameValues(s.Attitude, v)
+		s.Attitude = v
+
+	case *common.MessageVfrHud:
+		field = FieldSpeed
+		v := Speed{
+			Airspeed:    msg.Airspeed,
+			Groundspeed: msg.Groundspeed,
+			ClimbRate:   msg.Climb,
+			Heading:     msg.Heading,
+			Throttle:    msg.Throttle,
+		}
+		changed = !sameValues(s.Speed, v)
+		s.Speed = v
+
+	case *common.MessageSysStatus:
+		field = FieldBattery
+		v := Battery{
+			Voltage:     unknownUint16(msg.VoltageBattery, 1000),
+			Current:     float32(math.NaN()),
+			Remaining:   msg.BatteryRemaining,
+			Consumed:    -1,
+			Temperature: float32(math.NaN()),
+		}
+		if msg.CurrentBattery != -1 {
+			v.Current = float32(msg.CurrentBattery) / 100
+		}
+		changed = !sameValues(s.Battery, v)
+		s.Battery = v
+
+	case *common.MessageBatteryStatus:
+		field = FieldBatteries
+		v := batteryFromBatteryStatus(msg)
+		changed = !sameValues(s.Batteries[msg.Id], v)
+		s.Batteries[msg.Id] = v
+
+	case *common.MessageGpsRawInt:
+		field = FieldGPS
+		v := GPS{
+			FixType:           msg.FixType,
+			SatellitesVisible: msg.SatellitesVisible,
+			HDOP:              unknownUint16(msg.Eph, 100),
+			VDOP:              unknownUint16(msg.Epv, 100),
+			Latitude:          degrees(msg.Lat),
+			Longitude:         degrees(msg.Lon),
+			Altitude:          meters(msg.Alt),
+		}
+		changed = !sameValues(s.GPS, v)
+		s.GPS = v
+
+	case *common.MessageExtendedSysState:
+		field = FieldLandedState
+		v := LandedState{
+			LandedState: msg.LandedState,
+			VTOLState:   msg.VtolState,
+		}
+		changed = !sameValues(s.LandedState, v)
+		s.LandedState = v
+
+	case *common.MessageHomePosition:
+		field = FieldHome
+		v := Home{
+			Latitude:  degrees(msg.Latitude),
+			Longitude: degrees(msg.Longitude),
+			Altitude:  meters(msg.Altitude),
+		}
+		changed = !sameValues(s.Home, v)
+		s.Home = v
+
+	case *common.MessageStatustext:
+		field = FieldStatusTexts
+		if !t.addStatusTexts(s, t.reassemblers[systemID].Push(systemID, componentID, msg), now) {
+			return expired
+		}
+		changed = true
+	}
+
+	_, received := s.Updated[field]
+	s.Updated[field] = now
+
+	if !changed && received {
+		return expired
+	}
+	return field | expired
+}
+
+func (t *Tracker) addStatusTexts(s *State, texts []*statustext.Text, now time.Time) bool {
+	if len(texts) == 0 {
+		return false
+	}
+
+	for _, text := range texts {
+		s.StatusTexts = append(s.StatusTexts, StatusText{
+			Time:       now,
+			Severity:   text.Severity,
+			Text:       text.Text,
+			Incomplete: text.Incomplete,
+		})
+	}
+	if len(s.StatusTexts) > t.StatusTextsMax {
+		s.StatusTexts = s.StatusTexts[len(s.StatusTexts)-t.StatusTextsMax:]
+	}
+
+	return true
+}
+
+// expireStatusTexts adds status texts of a system whose chunks have timed out.
+// The reassembler is otherwise expired only when a new STATUSTEXT is received.
+func (t *Tracker) expireStatusTexts(systemID uint8, now time.Time) bool {
+	s := t.states[systemID]
+
+	if !t.addStatusTexts(s, t.reassemblers[systemID].Expire(), now) {
+		return false
+	}
+
+	s.Updated[FieldStatusTexts] = now
+	return true
+}
+
+func batteryFromBatteryStatus(msg *common.MessageBatteryStatus) Battery {
+	v := Battery{
+		Current:     float32(math.NaN()),
+		Remaining:   msg.BatteryRemaining,
+		Consumed:    msg.CurrentConsumed,
+		Temperature: float32(math.NaN()),
+	}
+
+	// voltage is the sum of cell voltages
+	voltage := uint32(0)
+	known := false
+	for _, cell := range append(msg.Voltages[:], msg.VoltagesExt[:]...) {
+		if cell != math.MaxUint16 && cell != 0 {
+			voltage += uint32(cell)
+			known = true
+		}
+	}
+	if known {
+		v.Voltage = float32(voltage) / 1000
+	} else {
+		v.Voltage = float32(math.NaN())
+	}
+
+	if msg.CurrentBattery != -1 {
+		v.Current = float32(msg.CurrentBattery) / 100
+	}
+
+	if msg.Temperature != math.MaxInt16 {
+		v.Temperature = float32(msg.Temperature) / 100
+	}
+
+	return v
+}
+
+// Systems returns IDs of systems that have sent telemetry, in ascending order.
+func (t *Tracker) Systems() []uint8 {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	ret := make([]uint8, 0, len(t.states))
+	for id := range t.states {
+		ret = append(ret, id)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i] < ret[j]
+	})
+
+	return ret
+}
+
+// State returns a snapshot of the state of a system, or nil if the system is unknown.
+// Status texts whose chunks have timed out are added to the state before taking the snapshot.
+func (t *Tracker) State(systemID uint8) *State {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	s, ok := t.states[systemID]
+	if !ok {
+		return nil
+	}
+
+	t.expireStatusTexts(systemID, time.Now())
+
+	return s.clone()
+}
+
+// Stale returns systems whose fields have not been updated since timeout,
+// together with stale fields.
+// Status texts whose chunks have timed out are added to the states.
+func (t *Tracker) Stale(timeout time.Duration) map[uint8]Field {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ret := make(map[uint8]Field)
+	now := time.Now()
+
+	for id, s := range t.states {
+		t.expireStatusTexts(id, now)
+
+		if f := s.Stale(timeout); f != 0 {
+			ret[id] = f
+		}
+	}
+
+	return ret
+}
+
+// Remove removes the state of a system.
+func (t *Tracker) Remove(systemID uint8) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.states, systemID)
+	delete(t.reassemblers, systemID)
+}
@@ -0,0 +1,262 @@
+package vehicle_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/ardupilotmega"
+	"github.com/bluenviron/gomavlib/v4/pkg/dialects/common"
+	"github.com/bluenviron/gomavlib/v4/pkg/flightmode"
+	"github.com/bluenviron/gomavlib/v4/pkg/frame"
+	"github.com/bluenviron/gomavlib/v4/pkg/statustext"
+	"github.com/bluenviron/gomavlib/v4/pkg/vehicle"
+)
+
+func TestTracker(t *testing.T) {
+	type change struct {
+		systemID uint8
+		fields   vehicle.Field
+	}
+	var changes []change
+
+	tr := &vehicle.Tracker{
+		StatusTextsMax: 2,
+		OnChange: func(systemID uint8, fields vehicle.Field) {
+			changes = append(changes, change{systemID, fields})
+		},
+	}
+	err := tr.Initialize()
+	require.NoError(t, err)
+
+	tr.Handle(&gomavlib.EventFrame{
+		Frame: &frame.V2Frame{
+			SystemID:    3,
+			ComponentID: 1,
+			Message: &common.MessageHeartbeat{
+				Type:         common.MAV_TYPE_QUADROTOR,
+				Autopilot:    common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+				BaseMode:     common.MAV_MODE_FLAG_CUSTOM_MODE_ENABLED | common.MAV_MODE_FLAG_SAFETY_ARMED,
+				CustomMode:   uint32(ardupilotmega.COPTER_MODE_GUIDED),
+				SystemStatus: common.MAV_STATE_ACTIVE,
+			},
+		},
+	})
+
+	// ground stations are ignored
+	tr.HandleMessage(255, 190, &common.MessageHeartbeat{
+		Type:      common.MAV_TYPE_GCS,
+		Autopilot: common.MAV_AUTOPILOT_INVALID,
+	})
+
+	pos := &common.MessageGlobalPositionInt{
+		Lat:         453456789,
+		Lon:         91234567,
+		Alt:         120500,
+		RelativeAlt: 20500,
+		Vx:          150,
+		Vy:          -50,
+		Vz:          10,
+		Hdg:         math.MaxUint16,
+	}
+	tr.HandleMessage(3, 1, pos)
+	tr.HandleMessage(3, 1, pos) // same values, no change
+
+	tr.HandleMessage(3, 1, &common.MessageSysStatus{
+		VoltageBattery:   12600,
+		CurrentBattery:   -1,
+		BatteryRemaining: 80,
+	})
+
+	tr.HandleMessage(3, 1, &common.MessageBatteryStatus{
+		Id:          1,
+		Temperature: math.MaxInt16,
+		Voltages: [10]uint16{
+			4200, 4100, 4000, math.MaxUint16, math.MaxUint16,
+			math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16,
+		},
+		CurrentBattery:   1550,
+		CurrentConsumed:  300,
+		BatteryRemaining: 75,
+	})
+
+	tr.HandleMessage(3, 1, &common.MessageGpsRawInt{
+		FixType:           common.GPS_FIX_TYPE_3D_FIX,
+		SatellitesVisible: 12,
+		Eph:               120,
+		Epv:               math.MaxUint16,
+		Lat:               453456789,
+		Lon:               91234567,
+		Alt:               120000,
+	})
+
+	tr.HandleMessage(3, 1, &common.MessageExtendedSysState{
+		LandedState: common.MAV_LANDED_STATE_IN_AIR,
+	})
+
+	tr.HandleMessage(3, 1, &common.MessageHomePosition{
+		Latitude:  453450000,
+		Longitude: 91230000,
+		Altitude:  100000,
+	})
+
+	for _, text := range []string{"first", "second", "third"} {
+		tr.HandleMessage(3, 1, &common.MessageStatustext{
+			Severity: common.MAV_SEVERITY_INFO,
+			Text:     text,
+		})
+	}
+
+	// messages outside of the telemetry are ignored
+	tr.HandleMessage(4, 1, &common.MessageCommandLong{})
+
+	require.Equal(t, []uint8{3}, tr.Systems())
+	require.Nil(t, tr.State(4))
+
+	require.Equal(t, []change{
+		{3, vehicle.FieldStatus},
+		{3, vehicle.FieldPosition},
+		{3, vehicle.FieldBattery},
+		{3, vehicle.FieldBatteries},
+		{3, vehicle.FieldGPS},
+		{3, vehicle.FieldLandedState},
+		{3, vehicle.FieldHome},
+		{3, vehicle.FieldStatusTexts},
+		{3, vehicle.FieldStatusTexts},
+		{3, vehicle.FieldStatusTexts},
+	}, changes)
+
+	s := tr.State(3)
+	require.Equal(t, vehicle.Status{
+		Autopilot:    common.MAV_AUTOPILOT_ARDUPILOTMEGA,
+		Type:         common.MAV_TYPE_QUADROTOR,
+		VehicleClass: flightmode.VehicleClassCopter,
+		SystemStatus: common.MAV_STATE_ACTIVE,
+		Armed:        true,
+		Mode:         "GUIDED",
+		CustomMode:   4,
+	}, s.Status)
+
+	require.InDelta(t, 45.3456789, s.Position.Latitude, 1e-9)
+	require.InDelta(t, 9.1234567, s.Position.Longitude, 1e-9)
+	require.Equal(t, 120.5, s.Position.Altitude)
+	require.Equal(t, 20.5, s.Position.RelativeAltitude)
+	require.Equal(t, float32(1.5), s.Position.VX)
+	require.True(t, math.IsNaN(float64(s.Position.Heading)))
+
+	require.Equal(t, float32(12.6), s.Battery.Voltage)
+	require.True(t, math.IsNaN(float64(s.Battery.Current)))
+	require.Equal(t, int8(80), s.Battery.Remaining)
+
+	b := s.Batteries[1]
+	require.Equal(t, float32(12.3), b.Voltage)
+	require.Equal(t, float32(15.5), b.Current)
+	require.Equal(t, int32(300), b.Consumed)
+	require.True(t, math.IsNaN(float64(b.Temperature)))
+
+	require.Equal(t, common.GPS_FIX_TYPE_3D_FIX, s.GPS.FixType)
+	require.Equal(t, float32(1.2), s.GPS.HDOP)
+	require.True(t, math.IsNaN(float64(s.GPS.VDOP)))
+
+	require.Equal(t, common.MAV_LANDED_STATE_IN_AIR, s.LandedState.LandedState)
+	require.Equal(t, 100.0, s.Home.Altitude)
+
+	require.Len(t, s.StatusTexts, 2)
+	require.Equal(t, "second", s.StatusTexts[0].Text)
+	require.Equal(t, "third", s.StatusTexts[1].Text)
+
+	require.Len(t, s.Updated, 8)
+	require.NotContains(t, s.Updated, vehicle.FieldAttitude)
+
+	// snapshots are not modified by updates
+	tr.HandleMessage(3, 1, &common.MessageStatustext{Text: "fourth"})
+	require.Equal(t, "third", s.StatusTexts[1].Text)
+
+	require.Equal(t, vehicle.Field(0), s.Stale(time.Hour))
+	require.Equal(t, vehicle.FieldStatus|vehicle.FieldPosition|vehicle.FieldBattery|
+		vehicle.FieldBatteries|vehicle.FieldGPS|vehicle.FieldLandedState|vehicle.FieldHome, s.Stale(0))
+	require.Equal(t, map[uint8]vehicle.Field{}, tr.Stale(time.Hour))
+	require.Equal(t, map[uint8]vehicle.Field{3: s.Stale(0)}, tr.Stale(0))
+
+	tr.Remove(3)
+	require.Empty(t, tr.Systems())
+}
+
+func TestTrackerChunkedStatusText(t *testing.T) {
+	changes := 0
+
+	tr := &vehicle.Tracker{
+		OnChange: func(_ uint8, fields vehicle.Field) {
+			require.Equal(t, vehicle.FieldStatusTexts, fields)
+			changes++
+		},
+	}
+	err := tr.Initialize()
+	require.NoError(t, err)
+
+	text := "PreArm: " + strings.Repeat("x", 60)
+
+	s := &statustext.Splitter{}
+	msgs, err := s.Split(common.MAV_SEVERITY_CRITICAL, text)
+	require.NoError(t, err)
+
+	tr.HandleMessage(1, 1, msgs[1])
+	require.Equal(t, 0, changes)
+
+	tr.HandleMessage(1, 1, msgs[0])
+	require.Equal(t, 1, changes)
+
+	st := tr.State(1)
+	require.Len(t, st.StatusTexts, 1)
+	require.Equal(t, text, st.StatusTexts[0].Text)
+	require.Equal(t, common.MAV_SEVERITY_CRITICAL, st.StatusTexts[0].Severity)
+	require.False(t, st.StatusTexts[0].Incomplete)
+}
+
+func TestTrackerStatusTextTimeout(t *testing.T) {
+	var changes []vehicle.Field
+
+	tr := &vehicle.Tracker{
+		StatusTextTimeout: 10 * time.Millisecond,
+		OnChange: func(_ uint8, fields vehicle.Field) {
+			changes = append(changes, fields)
+		},
+	}
+	err := tr.Initialize()
+	require.NoError(t, err)
+
+	s := &statustext.Splitter{}
+	msgs, err := s.Split(common.MAV_SEVERITY_CRITICAL, "PreArm: "+strings.Repeat("x", 60))
+	require.NoError(t, err)
+
+	// texts that time out are added when the state is read
+	tr.HandleMessage(1, 1, msgs[0])
+	require.Empty(t, changes)
+
+	time.Sleep(20 * time.Millisecond)
+
+	st := tr.State(1)
+	require.Len(t, st.StatusTexts, 1)
+	require.Equal(t, msgs[0].Text, st.StatusTexts[0].Text)
+	require.True(t, st.StatusTexts[0].Incomplete)
+	require.Contains(t, st.Updated, vehicle.FieldStatusTexts)
+
+	// texts that time out are added when any message of the system is received
+	tr.HandleMessage(2, 1, msgs[0])
+
+	time.Sleep(20 * time.Millisecond)
+
+	tr.HandleMessage(2, 1, &common.MessageHeartbeat{
+		Type:      common.MAV_TYPE_QUADROTOR,
+		Autopilot: common.MAV_AUTOPILOT_PX4,
+	})
+	require.Equal(t, []vehicle.Field{vehicle.FieldStatus | vehicle.FieldStatusTexts}, changes)
+}
+
+func TestFieldString(t *testing.T) {
+	require.Equal(t, "status|gps", (vehicle.FieldStatus | vehicle.FieldGPS).String())
+}
@@ -10,9 +10,15 @@ export DOCKERFILE_DIALECTS
 dialects:
 	echo "$$DOCKERFILE_DIALECTS" | docker build . -f - -t temp
 	docker run --rm -it -v $(shell pwd):/s temp \
-	make dialects-nodocker
+	make dialects-nodocker DIALECTS_FLAGS="$(DIALECTS_FLAGS)"
 	make format
 
 dialects-nodocker:
 	$(eval export CGO_ENABLED = 0)
-	go run ./cmd/dialects-gen
+	go run ./cmd/dialects-gen $(DIALECTS_FLAGS)
+
+dialects-check:
+	docker run --rm -v $(shell pwd):/app -w /app \
+	-e CGO_ENABLED=0 \
+	$(LINT_IMAGE) \
+	go run ./cmd/dialects-gen --check $(DIALECTS_FLAGS)
@@ -0,0 +1,92 @@
+package gomavlib
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	defaultWriteBatchingMTU        = 1400
+	defaultWriteBatchingMaxLatency = 20 * time.Millisecond
+)
+
+// WriteBatching contains the settings of outgoing frame coalescing.
+// When enabled, outgoing frames are not written immediately but are accumulated
+// and written together, in order to reduce per-packet overhead on satellite
+// and cellular links. In case of datagram-based endpoints, each batch is sent
+// in a single datagram.
+// Batches are written when they reach the MTU, when the oldest frame has been
+// waiting for MaxLatency, or when Node.Flush() is called.
+type WriteBatching struct {
+	// (optional) maximum size of a batch, in bytes.
+	// Frames are never split between batches.
+	// It defaults to 1400.
+	MTU int
+
+	// (optional) maximum time a frame is kept in a batch before being written.
+	// It defaults to 20 milliseconds.
+	MaxLatency time.Duration
+}
+
+type batchWriter struct {
+	WriteBatching
+	w io.Writer
+
+	buf   []byte
+	timer *time.Timer
+}
+
+func (bw *batchWriter) initialize() {
+	if bw.MTU == 0 {
+		bw.MTU = defaultWriteBatchingMTU
+	}
+	if bw.MaxLatency == 0 {
+		bw.MaxLatency = defaultWriteBatchingMaxLatency
+	}
+
+	bw.buf = make([]byte, 0, bw.MTU)
+	bw.timer = time.NewTimer(bw.MaxLatency)
+	bw.timer.Stop()
+}
+
+// Write implements io.Writer.
+func (bw *batchWriter) Write(p []byte) (int, error) {
+	if len(bw.buf)+len(p) > bw.MTU {
+		err := bw.flush()
+		if err != nil {
+			return 0, err
+		}
+
+		// frame doesn't fit into a batch: write it alone
+		if len(p) > bw.MTU {
+			return bw.w.Write(p)
+		}
+	}
+
+	if len(bw.buf) == 0 {
+		bw.timer.Reset(bw.MaxLatency)
+	}
+
+	bw.buf = append(bw.buf, p...)
+
+	if len(bw.buf) == bw.MTU {
+		err := bw.flush()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+func (bw *batchWriter) flush() error {
+	if len(bw.buf) == 0 {
+		return nil
+	}
+
+	bw.timer.Stop()
+
+	_, err := bw.w.Write(bw.buf)
+	bw.buf = bw.buf[:0]
+	return err
+}
@@ -0,0 +1,199 @@
+package gomavlib
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bluenviron/gomavlib/v4/pkg/frame"
+)
+
+func TestWriteBatching(t *testing.T) {
+	for _, ca := range []string{"flush", "mtu", "latency"} {
+		t.Run(ca, func(t *testing.T) {
+			node := &Node{
+				Dialect:     testDialect,
+				OutVersion:  V2,
+				OutSystemID: 10,
+				Endpoints: []Endpoint{&EndpointUDPServer{
+					Address: "127.0.0.1:5607",
+					WriteBatching: &WriteBatching{
+						MTU:        21 * 2,
+						MaxLatency: 500 * time.Millisecond,
+					},
+				}},
+				HeartbeatDisable: true,
+			}
+			err := node.Initialize()
+			require.NoError(t, err)
+			defer node.Close()
+
+			conn, err := net.Dial("udp", "127.0.0.1:5607")
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// open the channel
+			_, err = conn.Write([]byte{
+				0xfd, 0x09, 0x00, 0x00, 0x00, 0x0b, 0x01, 0x00,
+				0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x06, 0x05,
+				0x04, 0x02, 0x01, 0xb4, 0xde,
+			})
+			require.NoError(t, err)
+
+			evt := <-node.Events()
+			require.IsType(t, &EventChannelOpen{}, evt)
+
+			evt = <-node.Events()
+			require.IsType(t, &EventFrame{}, evt)
+
+			buf := make([]byte, 1024)
+
+			readDatagram := func() int {
+				conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+				n, err2 := conn.Read(buf)
+				require.NoError(t, err2)
+				return n
+			}
+
+			start := time.Now()
+
+			switch ca {
+			case "flush":
+				err = node.WriteMessageAll(testMessage)
+				require.NoError(t, err)
+
+				node.Flush()
+
+				require.Equal(t, 21, readDatagram())
+				require.Less(t, time.Since(start), 500*time.Millisecond)
+
+			case "mtu":
+				for range 3 {
+					err = node.WriteMessageAll(testMessage)
+					require.NoError(t, err)
+				}
+
+				require.Equal(t, 42, readDatagram())
+				require.Less(t, time.Since(start), 500*time.Millisecond)
+
+				require.Equal(t, 21, readDatagram())
+				require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+
+			case "latency":
+				err = node.WriteMessageAll(testMessage)
+				require.NoError(t, err)
+
+				require.Equal(t, 21, readDatagram())
+				require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+			}
+
+			require.Equal(t, byte(frame.V2MagicByte), buf[0])
+		})
+	}
+}
+
+// blockingReadWriter is a ReadWriteCloser whose writes are blocked until the gate is opened.
+type blockingReadWriter struct {
+	entered   chan struct{}
+	gate      chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mutex   sync.Mutex
+	written int
+}
+
+func (rw *blockingReadWriter) Read(_ []byte) (int, error) {
+	<-rw.closed
+	return 0, io.EOF
+}
+
+func (rw *blockingReadWriter) Write(p []byte) (int, error) {
+	select {
+	case rw.entered <- struct{}{}:
+	default:
+	}
+
+	<-rw.gate
+
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+	rw.written += len(p)
+
+	return len(p), nil
+}
+
+func (rw *blockingReadWriter) Close() error {
+	rw.closeOnce.Do(func() {
+		close(rw.closed)
+	})
+	return nil
+}
+
+func (rw *blockingReadWriter) bytesWritten() int {
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+	return rw.written
+}
+
+func TestWriteBatchingFlushFullBuffer(t *testing.T) {
+	rw := &blockingReadWriter{
+		entered: make(chan struct{}, 1),
+		gate:    make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+
+	node := &Node{
+		Dialect:     testDialect,
+		OutVersion:  V2,
+		OutSystemID: 10,
+		Endpoints: []Endpoint{&EndpointCustomClient{
+			Connect: func(_ context.Context) (net.Conn, error) {
+				return &rwcToConn{rw}, nil
+			},
+			WriteBatching: &WriteBatching{
+				MTU:        21 * 3,
+				MaxLatency: 10 * time.Second,
+			},
+		}},
+		HeartbeatDisable: true,
+	}
+	err := node.Initialize()
+	require.NoError(t, err)
+	defer node.Close()
+
+	evt := <-node.Events()
+	require.IsType(t, &EventChannelOpen{}, evt)
+
+	// fill a batch, whose write blocks the writer
+	for range 3 {
+		err = node.WriteMessageAll(testMessage)
+		require.NoError(t, err)
+	}
+	<-rw.entered
+
+	// fill the write buffer. Messages that do not fit are dropped.
+	for range writeBufferSize + 1 {
+		err = node.WriteMessageAll(testMessage)
+		require.NoError(t, err)
+	}
+
+	// the last queued frame does not fill a batch, and is written by Flush
+	node.Flush()
+	close(rw.gate)
+
+	expected := (3 + writeBufferSize) * 21
+	start := time.Now()
+
+	for rw.bytesWritten() < expected {
+		require.Less(t, time.Since(start), 2*time.Second)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Equal(t, expected, rw.bytesWritten())
+}